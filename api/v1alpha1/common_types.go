@@ -37,6 +37,7 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // CopyMethodType defines the methods for creating point-in-time copies of
@@ -58,17 +59,229 @@ const (
 	// Namespace annotation to indicate that elevated permissions are ok for movers
 	PrivilegedMoversNamespaceAnnotation = "volsync.backube/privileged-movers"
 
+	// Namespace annotation that restricts the movers that may be used in the
+	// namespace to a comma-separated list of mover names (e.g.,
+	// "restic,rsync-tls"). ExternalMoverName refers to external movers.
+	AllowedMoversNamespaceAnnotation = "volsync.backube/allowed-movers"
+	ExternalMoverName                = "external"
+
+	// Namespace annotation that, when "true", pauses the movers of all
+	// ReplicationSources and ReplicationDestinations in the namespace
+	SuspendNamespaceAnnotation = "volsync.backube/suspend"
+
 	// Annotation on ReplicationSource or ReplicationDestination to enable running the mover job in debug mode
 	EnableDebugMoverAnnotation = "volsync.backube/enable-debug-mover"
 )
 
+// SnapshotContentPolicyType defines how VolumeSnapshotContents with a Retain
+// deletion policy are handled once the VolSync VolumeSnapshot they were created
+// for has been deleted.
+// +kubebuilder:validation:Enum=Ignore;Delete;Rebind
+type SnapshotContentPolicyType string
+
+const (
+	// SnapshotContentPolicyIgnore leaves retained contents in place. They are
+	// reported in the volsync_retained_snapshot_contents metric.
+	SnapshotContentPolicyIgnore SnapshotContentPolicyType = "Ignore"
+	// SnapshotContentPolicyDelete deletes retained contents (and the
+	// underlying storage snapshots).
+	SnapshotContentPolicyDelete SnapshotContentPolicyType = "Delete"
+	// SnapshotContentPolicyRebind binds retained contents to a new
+	// VolumeSnapshot so that they can be reused.
+	SnapshotContentPolicyRebind SnapshotContentPolicyType = "Rebind"
+
+	// Label applied to VolumeSnapshotContents with a Retain deletion policy
+	// that were created from VolSync VolumeSnapshots. The value is the
+	// snapshotContentPolicy of the owner.
+	SnapshotContentPolicyLabel = "volsync.backube/snapshot-content-policy"
+	// Annotation recording the owner (kind/name) of the VolumeSnapshot that a
+	// VolumeSnapshotContent was created for. The owner is in the namespace of
+	// the content's volumeSnapshotRef.
+	SnapshotContentOwnerAnnotation = "volsync.backube/snapshot-owner"
+)
+
+// MoverOS is the operating system of the nodes that run the mover pods.
+// +kubebuilder:validation:Enum=linux;windows
+type MoverOS string
+
+const (
+	// MoverOSLinux runs the mover on Linux nodes using the usual mover image.
+	MoverOSLinux MoverOS = "linux"
+	// MoverOSWindows runs the mover on Windows nodes using the Windows variant
+	// of the mover image. Linux-specific security settings are not applied to
+	// the mover pod.
+	MoverOSWindows MoverOS = "windows"
+)
+
+// SecurityProfileType selects the security settings of the mover pods.
+// +kubebuilder:validation:Enum=Default;Hardened
+type SecurityProfileType string
+
+const (
+	// SecurityProfileDefault uses the mover's usual security settings.
+	SecurityProfileDefault SecurityProfileType = "Default"
+	// SecurityProfileHardened requires the mover to run as a non-root user with
+	// a read-only root filesystem, the RuntimeDefault seccomp profile, all
+	// capabilities dropped, and without the privileged SCC.
+	SecurityProfileHardened SecurityProfileType = "Hardened"
+
+	// Names of the hardening measures reported in the status
+	SecurityMeasureNonRoot                = "NonRoot"
+	SecurityMeasureReadOnlyRootFilesystem = "ReadOnlyRootFilesystem"
+	SecurityMeasureSeccompRuntimeDefault  = "SeccompRuntimeDefault"
+	SecurityMeasureCapabilitiesDropped    = "CapabilitiesDropped"
+	SecurityMeasureUnprivileged           = "Unprivileged"
+)
+
+// SecurityMeasure reports whether a hardening measure is applied to the mover
+type SecurityMeasure struct {
+	// name of the measure.
+	Name string `json:"name"`
+	// active is true if the measure is applied to the mover.
+	Active bool `json:"active"`
+}
+
+// ErrorPolicyMode determines how a file-based mover handles files that it is
+// unable to copy.
+// +kubebuilder:validation:Enum=FailFast;Tolerate
+type ErrorPolicyMode string
+
+const (
+	// ErrorPolicyFailFast fails the synchronization if any file can not be
+	// copied.
+	ErrorPolicyFailFast ErrorPolicyMode = "FailFast"
+	// ErrorPolicyTolerate completes the synchronization as long as the number
+	// of files that could not be copied is within the error budget.
+	ErrorPolicyTolerate ErrorPolicyMode = "Tolerate"
+
+	// Maximum number of failed paths that are listed in the mover status
+	MaxFailedPaths = 50
+)
+
+// ErrorPolicy determines how a file-based mover handles files that it is
+// unable to copy (e.g., because they are unreadable or vanish during the
+// sync).
+type ErrorPolicy struct {
+	// mode is either FailFast (the default), which fails the synchronization
+	// on any error, or Tolerate, which marks the synchronization as
+	// PartiallyCompleted when the errors are within the budget below.
+	//+optional
+	Mode ErrorPolicyMode `json:"mode,omitempty"`
+	// maxErrors is the maximum number of paths that may fail when tolerating
+	// errors.
+	//+kubebuilder:validation:Minimum=0
+	//+optional
+	MaxErrors *int32 `json:"maxErrors,omitempty"`
+	// maxErrorPercent is the maximum percentage of the paths that may fail
+	// when tolerating errors. If neither maxErrors nor maxErrorPercent is
+	// set, any number of errors is tolerated.
+	//+kubebuilder:validation:Minimum=0
+	//+kubebuilder:validation:Maximum=100
+	//+optional
+	MaxErrorPercent *int32 `json:"maxErrorPercent,omitempty"`
+}
+
+// SecurityStatus reports the hardening measures that are applied to the mover
+type SecurityStatus struct {
+	// profile is the security profile in effect.
+	//+optional
+	Profile SecurityProfileType `json:"profile,omitempty"`
+	// measures lists the hardening measures and whether they are active.
+	//+optional
+	Measures []SecurityMeasure `json:"measures,omitempty"`
+}
+
 const (
 	ConditionSynchronizing     string = "Synchronizing"
 	SynchronizingReasonSync    string = "SyncInProgress"
 	SynchronizingReasonSched   string = "WaitingForSchedule"
 	SynchronizingReasonManual  string = "WaitingForManual"
 	SynchronizingReasonCleanup string = "CleaningUp"
+	SynchronizingReasonQueued  string = "WaitingForSyncSlot"
 	SynchronizingReasonError   string = "Error"
+	// The mover is not allowed by the namespace's allowed-movers annotation
+	SynchronizingReasonMoverNotAllowed string = "MoverNotAllowed"
+	// The mover cannot satisfy the Hardened security profile
+	SynchronizingReasonSecurityProfile string = "SecurityProfileUnsatisfiable"
+	// A synchronization that is due has been put off, e.g. by spec.ioGate
+	SynchronizingReasonDeferred string = "SyncDeferred"
+	// Waiting to retry a failed mover Job (see spec.retryPolicy)
+	SynchronizingReasonRetryBackoff string = "WaitingToRetry"
+	// The retries permitted by spec.retryPolicy have been used up
+	SynchronizingReasonRetriesExhausted string = "RetriesExhausted"
+)
+
+const (
+	// ConditionPaused is set while replication is paused by something other
+	// than the object's own spec.paused
+	ConditionPaused string = "Paused"
+	// The namespace carries the suspend annotation
+	PausedReasonNamespaceSuspended string = "NamespaceSuspended"
+)
+
+const (
+	// ConditionSyncBlocked is set when a pre-flight check finds that the
+	// synchronization can not proceed
+	ConditionSyncBlocked string = "SyncBlocked"
+	// Creating the copy of the source would exceed a ResourceQuota
+	SyncBlockedReasonQuotaExceeded string = "QuotaExceeded"
+	// The storage does not support cloning the source
+	SyncBlockedReasonCloneUnsupported string = "CloneUnsupported"
+	// The VolumeSnapshotClass does not exist or does not match the volume's
+	// CSI driver
+	SyncBlockedReasonSnapshotClassInvalid string = "SnapshotClassInvalid"
+)
+
+const (
+	// ConditionRepositoryReady reports the result of the repository check of
+	// the mover (restic ensureRepository)
+	ConditionRepositoryReady string = "RepositoryReady"
+	// The repository check is in progress
+	RepositoryReadyReasonChecking string = "Checking"
+	// The repository exists and can be accessed
+	RepositoryReadyReasonReady string = "Ready"
+	// The repository could not be initialized or accessed
+	RepositoryReadyReasonCheckFailed string = "CheckFailed"
+)
+
+const (
+	// ConditionSyncTimedOut is set while mover Jobs are being stopped for
+	// running longer than moverTimeout
+	ConditionSyncTimedOut string = "SyncTimedOut"
+	// The mover Job exceeded moverTimeout and is retried after a delay
+	SyncTimedOutReasonMoverTimeout string = "MoverTimeout"
+)
+
+// Conditions that report the step of the synchronization that the mover is
+// at. The events that are recorded when they change use the same reasons.
+const (
+	// ConditionSourcePVCReady reports whether the copy of the source PVC that
+	// the mover reads from is ready
+	ConditionSourcePVCReady string = "SourcePVCReady"
+	// ConditionSnapshotReady reports whether the VolumeSnapshot of the current
+	// synchronization is ready to use
+	ConditionSnapshotReady string = "SnapshotReady"
+	// ConditionJobRunning reports the state of the mover Job
+	ConditionJobRunning string = "JobRunning"
+	// ConditionCleanupComplete reports whether the temporary objects of the
+	// last synchronization have been removed
+	ConditionCleanupComplete string = "CleanupComplete"
+	// The object is being created or has not become ready yet
+	PhaseReasonWaiting string = "Waiting"
+	// The object is ready to use
+	PhaseReasonReady string = "Ready"
+	// The mover Job has been created, but its pod is not running
+	PhaseReasonJobPending string = "Pending"
+	// The mover Job's pod is running
+	PhaseReasonJobRunning string = "Running"
+	// The mover Job completed successfully
+	PhaseReasonJobSucceeded string = "Succeeded"
+	// The mover Job failed and will be retried
+	PhaseReasonJobFailed string = "Failed"
+	// A synchronization is in progress, so its temporary objects exist
+	PhaseReasonSyncInProgress string = "SyncInProgress"
+	// The temporary objects have been removed
+	PhaseReasonCleanedUp string = "CleanedUp"
 )
 
 const (
@@ -122,6 +335,20 @@ type SyncthingPeerStatus struct {
 	IntroducedBy string `json:"introducedBy,omitempty"`
 	// A friendly name to associate the given device.
 	Name string `json:"name,omitempty"`
+	// Total number of bytes received from the peer.
+	//+optional
+	InBytesTotal int64 `json:"inBytesTotal,omitempty"`
+	// Total number of bytes sent to the peer.
+	//+optional
+	OutBytesTotal int64 `json:"outBytesTotal,omitempty"`
+	// Number of bytes the peer still needs to receive to be in sync with the
+	// local device.
+	//+optional
+	NeedBytes int64 `json:"needBytes,omitempty"`
+	// Percentage (0-100) of the shared data that the peer has in sync with the
+	// local device.
+	//+optional
+	Completion *int32 `json:"completion,omitempty"`
 }
 
 type MoverResult string
@@ -129,11 +356,395 @@ type MoverResult string
 const (
 	MoverResultSuccessful MoverResult = "Successful"
 	MoverResultFailed     MoverResult = "Failed"
+	// The mover completed, but some paths could not be copied (see
+	// spec.errorPolicy)
+	MoverResultPartiallyCompleted MoverResult = "PartiallyCompleted"
+)
+
+// MoverErrorCode is a machine-readable classification of the reason a mover
+// failed
+// +kubebuilder:validation:Enum=RepoLocked;RepoNotFound;AuthFailed;PermissionDenied;NoSpace;NetworkTimeout;ConnectionFailed;MoverTimeout;Unknown
+type MoverErrorCode string
+
+const (
+	// The repository is locked by another client
+	MoverErrorCodeRepoLocked MoverErrorCode = "RepoLocked"
+	// The repository or bucket does not exist
+	MoverErrorCodeRepoNotFound MoverErrorCode = "RepoNotFound"
+	// The credentials or keys were rejected by the remote
+	MoverErrorCodeAuthFailed MoverErrorCode = "AuthFailed"
+	// The mover was not permitted to read or write the data
+	MoverErrorCodePermissionDenied MoverErrorCode = "PermissionDenied"
+	// A volume that is written by the mover is full
+	MoverErrorCodeNoSpace MoverErrorCode = "NoSpace"
+	// An operation over the network timed out
+	MoverErrorCodeNetworkTimeout MoverErrorCode = "NetworkTimeout"
+	// The remote could not be reached
+	MoverErrorCodeConnectionFailed MoverErrorCode = "ConnectionFailed"
+	// The mover Job ran longer than moverTimeout
+	MoverErrorCodeMoverTimeout MoverErrorCode = "MoverTimeout"
+	// The mover failed for a reason that was not recognized
+	MoverErrorCodeUnknown MoverErrorCode = "Unknown"
 )
 
 type MoverStatus struct {
 	Result MoverResult `json:"result,omitempty"`
-	Logs   string      `json:"logs,omitempty"`
+	// errorCode classifies the reason the mover failed, based on its logs, so
+	// that automation can react to different failures. It is only set when
+	// the result is Failed.
+	//+optional
+	ErrorCode MoverErrorCode `json:"errorCode,omitempty"`
+	Logs      string         `json:"logs,omitempty"`
+	// startTime is the time the mover Job started running.
+	//+optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// completionTime is the time the mover Job finished, either successfully
+	// or by failing.
+	//+optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// duration is the time between startTime and completionTime.
+	//+optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+	// jobName is the name of the mover Job.
+	//+optional
+	JobName string `json:"jobName,omitempty"`
+	// progress of the mover Job while it is running (for movers that support
+	// it).
+	//+optional
+	Progress *MoverProgress `json:"progress,omitempty"`
+	// failedPathCount is the number of paths that the mover was unable to
+	// copy (for movers that support spec.errorPolicy).
+	//+optional
+	FailedPathCount int32 `json:"failedPathCount,omitempty"`
+	// failedPaths lists (up to 50 of) the paths that the mover was unable to
+	// copy.
+	//+optional
+	FailedPaths []string `json:"failedPaths,omitempty"`
+	// timeouts is the number of consecutive mover Jobs that were stopped for
+	// running longer than moverTimeout. It is reset when a Job succeeds.
+	//+optional
+	Timeouts int32 `json:"timeouts,omitempty"`
+	// lastTimeoutTime is when a mover Job was last stopped for running longer
+	// than moverTimeout.
+	//+optional
+	LastTimeoutTime *metav1.Time `json:"lastTimeoutTime,omitempty"`
+}
+
+// MoverProgress reports the progress of a running mover
+type MoverProgress struct {
+	// percentComplete is the percentage of the data that has been
+	// transferred.
+	//+optional
+	PercentComplete *int32 `json:"percentComplete,omitempty"`
+	// bytesTransferred is the amount of data that has been transferred.
+	//+optional
+	BytesTransferred int64 `json:"bytesTransferred,omitempty"`
+	// totalBytes is the total amount of data to transfer. It may grow while
+	// the mover is scanning the data.
+	//+optional
+	TotalBytes int64 `json:"totalBytes,omitempty"`
+	// eta is the estimated time until the transfer completes.
+	//+optional
+	ETA *metav1.Duration `json:"eta,omitempty"`
+	// lastUpdateTime is the time the progress was last updated.
+	//+optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// SyncHistoryEntry records the outcome of a single synchronization attempt
+type SyncHistoryEntry struct {
+	// startTime is the time the synchronization (or mover Job) started.
+	//+optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// completionTime is the time the synchronization (or mover Job) finished.
+	//+optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// duration is the time between startTime and completionTime.
+	//+optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+	// result of the attempt.
+	Result MoverResult `json:"result"`
+	// moverJob is the name of the mover Job that performed the attempt, if
+	// known.
+	//+optional
+	MoverJob string `json:"moverJob,omitempty"`
+	// bytesTransferred is the number of bytes sent during the attempt, if the
+	// mover reports it.
+	//+optional
+	BytesTransferred *int64 `json:"bytesTransferred,omitempty"`
+	// message provides additional detail about the result.
+	//+optional
+	Message string `json:"message,omitempty"`
+}
+
+// RetryPolicy controls how failed mover Jobs are retried during a
+// synchronization
+type RetryPolicy struct {
+	// maxRetries is the number of times a failed mover Job is retried during a
+	// synchronization. If omitted, the number of retries is not limited.
+	//+kubebuilder:validation:Minimum=0
+	//+optional
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+	// backoff is the delay before retrying a failed mover Job. It doubles with
+	// each consecutive failure, up to 1 hour. Defaults to 1m.
+	//+optional
+	Backoff *metav1.Duration `json:"backoff,omitempty"`
+	// retryDeadline is how long after the first failed mover Job of a
+	// synchronization the Job may be retried. If omitted, retries are not
+	// limited in time.
+	//+optional
+	RetryDeadline *metav1.Duration `json:"retryDeadline,omitempty"`
+}
+
+// RetryStatus records the failed mover Jobs of the current synchronization
+type RetryStatus struct {
+	// attempts is the number of mover Jobs that have failed.
+	Attempts int32 `json:"attempts"`
+	// firstFailureTime is when the first of the mover Jobs failed.
+	//+optional
+	FirstFailureTime *metav1.Time `json:"firstFailureTime,omitempty"`
+	// lastFailureTime is when the most recent mover Job failed.
+	//+optional
+	LastFailureTime *metav1.Time `json:"lastFailureTime,omitempty"`
+	// nextRetryTime is when the mover Job will be retried.
+	//+optional
+	NextRetryTime *metav1.Time `json:"nextRetryTime,omitempty"`
+	// exhausted is true once the retries permitted by the retry policy have
+	// been used up. The mover Job is then retried at the next scheduled
+	// synchronization time.
+	//+optional
+	Exhausted bool `json:"exhausted,omitempty"`
+}
+
+// CleanupWarning describes a temporary object that should have been removed at
+// the end of a synchronization but is still present
+type CleanupWarning struct {
+	// kind of the object.
+	Kind string `json:"kind"`
+	// name of the object.
+	Name string `json:"name"`
+	// reason describes why the object has not been removed.
+	Reason string `json:"reason"`
+	// attempts is the number of times VolSync has retried deleting the object.
+	//+optional
+	Attempts int32 `json:"attempts,omitempty"`
+	// lastAttempt is the time of the most recent deletion attempt.
+	//+optional
+	LastAttempt *metav1.Time `json:"lastAttempt,omitempty"`
+}
+
+// NotificationEventType is a synchronization result that a notification can be
+// sent for
+// +kubebuilder:validation:Enum=Succeeded;Failed
+type NotificationEventType string
+
+const (
+	NotificationEventSucceeded NotificationEventType = "Succeeded"
+	NotificationEventFailed    NotificationEventType = "Failed"
+)
+
+// NotificationFormat is the payload format of a notification
+// +kubebuilder:validation:Enum=JSON;Slack
+type NotificationFormat string
+
+const (
+	// NotificationFormatJSON sends a JSON document describing the result
+	NotificationFormatJSON NotificationFormat = "JSON"
+	// NotificationFormatSlack sends a Slack-compatible incoming webhook payload
+	NotificationFormatSlack NotificationFormat = "Slack"
+)
+
+// NotificationSpec configures where notifications of synchronization results
+// are sent.
+type NotificationSpec struct {
+	// url is the http(s) endpoint that notifications are POSTed to.
+	//+kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url"`
+	// authSecret is the name of a Secret in the same namespace. Its "token" key
+	// is sent as a bearer token in the Authorization header.
+	//+optional
+	AuthSecret *string `json:"authSecret,omitempty"`
+	// events is the list of synchronization results to send notifications for.
+	// Defaults to all results.
+	//+optional
+	Events []NotificationEventType `json:"events,omitempty"`
+	// format of the notification payload. Defaults to "JSON".
+	//+optional
+	Format NotificationFormat `json:"format,omitempty"`
+}
+
+// RestoreProvenance describes the origin of the data that was restored into a
+// destination volume.
+type RestoreProvenance struct {
+	// source identifies the ReplicationSource (namespace/name) that created the
+	// restored data, if known.
+	//+optional
+	Source string `json:"source,omitempty"`
+	// snapshotID is the ID of the backup that was restored.
+	//+optional
+	SnapshotID string `json:"snapshotID,omitempty"`
+	// snapshotTime is the time the restored backup was taken.
+	//+optional
+	SnapshotTime *metav1.Time `json:"snapshotTime,omitempty"`
+	// restoreTime is the time the restore completed.
+	//+optional
+	RestoreTime *metav1.Time `json:"restoreTime,omitempty"`
+	// volsyncVersion is the version of the mover that performed the restore.
+	//+optional
+	VolSyncVersion string `json:"volsyncVersion,omitempty"`
+	// manifestChecksum is the SHA-256 checksum of the provenance manifest that
+	// was written to the destination volume, if one was written.
+	//+optional
+	ManifestChecksum string `json:"manifestChecksum,omitempty"`
+}
+
+// RestoreVerificationResult is the outcome of verifying the restored data
+// against the checksums recorded in the repository
+type RestoreVerificationResult string
+
+const (
+	// The restored data matches the backup
+	RestoreVerificationPassed RestoreVerificationResult = "Passed"
+	// The restored data does not match the backup
+	RestoreVerificationFailed RestoreVerificationResult = "Failed"
+)
+
+// RestoreVerification describes the verification of the data restored into a
+// destination volume (see the mover's verifyChecksum option).
+type RestoreVerification struct {
+	// result of the verification.
+	//+kubebuilder:validation:Enum=Passed;Failed
+	Result RestoreVerificationResult `json:"result"`
+	// time the verification completed.
+	//+optional
+	Time *metav1.Time `json:"time,omitempty"`
+	// message contains details about a failed verification.
+	//+optional
+	Message string `json:"message,omitempty"`
+}
+
+// RestoreSize compares the amount of data in the snapshot that is restored
+// into a destination volume with the space on the volume (see the mover's
+// skipSizeValidation option).
+type RestoreSize struct {
+	// snapshotID is the snapshot that was measured.
+	//+optional
+	SnapshotID string `json:"snapshotID,omitempty"`
+	// sizeBytes is the amount of data in the snapshot.
+	SizeBytes int64 `json:"sizeBytes"`
+	// capacityBytes is the space on the destination volume that was available
+	// for the restored data.
+	CapacityBytes int64 `json:"capacityBytes"`
+	// fits is false if the restore was refused because the data does not fit
+	// on the destination volume.
+	Fits bool `json:"fits"`
+	// time the size was measured.
+	//+optional
+	Time *metav1.Time `json:"time,omitempty"`
+}
+
+// ConnectionTestStatus is the result of the connection test requested by the
+// connectionTest field of the mover
+type ConnectionTestStatus struct {
+	// trigger is the value of the connectionTest field that was tested.
+	Trigger string `json:"trigger"`
+	// succeeded is true if the mover was able to access the repository.
+	Succeeded bool `json:"succeeded"`
+	// latency is the time taken to access the repository.
+	//+optional
+	Latency *metav1.Duration `json:"latency,omitempty"`
+	// time the connection test completed.
+	//+optional
+	Time *metav1.Time `json:"time,omitempty"`
+	// message contains the output of the connection test.
+	//+optional
+	Message string `json:"message,omitempty"`
+}
+
+// MoverSecretProvider is a source of mover credentials other than a Kubernetes
+// Secret
+type MoverSecretProvider string
+
+const (
+	// The credentials are mounted by the Secrets Store CSI driver
+	MoverSecretProviderCSISecretsStore MoverSecretProvider = "CSISecretsStore"
+)
+
+// MoverSecretRef refers to credentials that are mounted into the mover from an
+// external secret store rather than being read from a Kubernetes Secret
+type MoverSecretRef struct {
+	// provider of the credentials.
+	//+kubebuilder:validation:Enum=CSISecretsStore
+	Provider MoverSecretProvider `json:"provider"`
+	// secretProviderClass is the name of the SecretProviderClass, in the same
+	// namespace, that describes the credentials to mount.
+	//+kubebuilder:validation:MinLength=1
+	SecretProviderClass string `json:"secretProviderClass"`
+}
+
+// RcloneRemoteSpec describes an rclone remote using typed fields instead of a
+// user-supplied rclone.conf. VolSync generates the configuration, in a Secret
+// that it owns, from these fields and the credentials of the provider.
+// +kubebuilder:validation:XValidation:rule="(has(self.s3) ? 1 : 0) + (has(self.azureBlob) ? 1 : 0) + (has(self.googleCloudStorage) ? 1 : 0) == 1",message="exactly one of s3, azureBlob, or googleCloudStorage must be specified"
+// +kubebuilder:validation:XValidation:rule="(has(self.envAuth) && self.envAuth) != has(self.credentialsSecretName)",message="exactly one of envAuth or credentialsSecretName must be specified"
+type RcloneRemoteSpec struct {
+	// envAuth has rclone obtain the credentials of the provider from the
+	// environment of the mover (e.g., workload identity) rather than from
+	// credentialsSecretName.
+	//+optional
+	EnvAuth bool `json:"envAuth,omitempty"`
+	// credentialsSecretName is the name of a Secret holding the credentials of
+	// the provider. The keys that are required depend on the provider:
+	// s3 requires access_key_id and secret_access_key (session_token is
+	// optional), azureBlob requires exactly one of key or sas_url, and
+	// googleCloudStorage requires service_account_credentials.
+	//+kubebuilder:validation:MinLength=1
+	//+optional
+	CredentialsSecretName *string `json:"credentialsSecretName,omitempty"`
+	// s3 configures an S3-compatible object store.
+	//+optional
+	S3 *RcloneS3Remote `json:"s3,omitempty"`
+	// azureBlob configures Azure Blob Storage.
+	//+optional
+	AzureBlob *RcloneAzureBlobRemote `json:"azureBlob,omitempty"`
+	// googleCloudStorage configures Google Cloud Storage.
+	//+optional
+	GoogleCloudStorage *RcloneGoogleCloudStorageRemote `json:"googleCloudStorage,omitempty"`
+}
+
+// RcloneS3Remote configures an rclone remote of type s3
+// +kubebuilder:validation:XValidation:rule="!has(self.provider) || self.provider == 'AWS' || has(self.endpoint)",message="endpoint is required for S3 providers other than AWS"
+type RcloneS3Remote struct {
+	// provider is the S3 implementation, as named by rclone (e.g., AWS, Minio,
+	// Ceph). Defaults to AWS.
+	//+optional
+	Provider string `json:"provider,omitempty"`
+	// region of the bucket.
+	//+optional
+	Region string `json:"region,omitempty"`
+	// endpoint is the URL of the S3 API.
+	//+optional
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+// RcloneAzureBlobRemote configures an rclone remote of type azureblob
+type RcloneAzureBlobRemote struct {
+	// account is the name of the storage account.
+	//+kubebuilder:validation:MinLength=1
+	Account string `json:"account"`
+}
+
+// RcloneGoogleCloudStorageRemote configures an rclone remote of type google
+// cloud storage
+type RcloneGoogleCloudStorageRemote struct {
+	// projectNumber is the project number of the bucket. It is only needed to
+	// create buckets.
+	//+optional
+	ProjectNumber string `json:"projectNumber,omitempty"`
+	// bucketPolicyOnly should be set if the bucket uses uniform bucket-level
+	// access.
+	//+optional
+	BucketPolicyOnly bool `json:"bucketPolicyOnly,omitempty"`
 }
 
 type CustomCASpec struct {
@@ -149,6 +760,37 @@ type CustomCASpec struct {
 	Key string `json:"key,omitempty"`
 }
 
+// ApplicationAffinityMode selects how the data mover is scheduled relative to
+// the application pods that use the same PVC
+type ApplicationAffinityMode string
+
+const (
+	// Schedule the mover on the node of the application pods
+	ApplicationAffinityModeAffinity ApplicationAffinityMode = "Affinity"
+	// Prefer to schedule the mover away from the application pods
+	ApplicationAffinityModeAntiAffinity ApplicationAffinityMode = "AntiAffinity"
+)
+
+// MoverServiceAccountToken is a token of the data mover's service account that
+// is projected into the mover pods, e.g., to authenticate to a proxy or to
+// Vault.
+type MoverServiceAccountToken struct {
+	// name is the name of the file holding the token. It is mounted at
+	// /var/run/secrets/volsync/tokens/<name>.
+	//+kubebuilder:validation:MinLength=1
+	//+kubebuilder:validation:MaxLength=253
+	//+kubebuilder:validation:Pattern=`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`
+	Name string `json:"name"`
+	// audience is the intended audience of the token.
+	//+kubebuilder:validation:MinLength=1
+	Audience string `json:"audience"`
+	// expirationSeconds is the requested lifetime of the token. The kubelet
+	// refreshes the token before it expires. Defaults to 1 hour.
+	//+kubebuilder:validation:Minimum=600
+	//+optional
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
+}
+
 type MoverConfig struct {
 	// MoverSecurityContext allows specifying the PodSecurityContext that will
 	// be used by the data mover
@@ -163,6 +805,10 @@ type MoverConfig struct {
 	// These will be in addition to any labels that VolSync may add
 	// +optional
 	MoverPodLabels map[string]string `json:"moverPodLabels,omitempty"`
+	// Annotations that should be added to data mover pods
+	// These will be in addition to any annotations that VolSync may add
+	// +optional
+	MoverPodAnnotations map[string]string `json:"moverPodAnnotations,omitempty"`
 	// Resources represents compute resources required by the data mover container.
 	// Immutable.
 	// More info: https://kubernetes.io/docs/concepts/configuration/manage-compute-resources-container/
@@ -172,4 +818,54 @@ type MoverConfig struct {
 	MoverResources *corev1.ResourceRequirements `json:"moverResources,omitempty"`
 	// MoverAffinity allows specifying the PodAffinity that will be used by the data mover
 	MoverAffinity *corev1.Affinity `json:"moverAffinity,omitempty"`
+	// MoverApplicationAffinity adds pod affinity ("Affinity") or a preferred
+	// pod anti-affinity ("AntiAffinity") between the data mover and the pods
+	// that are currently using the replicated PVC. Affinity keeps the mover on
+	// the same node as the application, as is needed for ReadWriteOnce local
+	// volumes. The terms are added to those of MoverAffinity.
+	//+kubebuilder:validation:Enum=Affinity;AntiAffinity
+	//+optional
+	MoverApplicationAffinity *ApplicationAffinityMode `json:"moverApplicationAffinity,omitempty"`
+	// MoverImage overrides the container image of the data mover for this
+	// object. The image must be permitted by the operator's
+	// --allowed-mover-images flag.
+	//+optional
+	MoverImage *string `json:"moverImage,omitempty"`
+	// MoverPriorityClassName is the name of the PriorityClass of the data
+	// mover pods. A low priority allows the movers to be preempted by
+	// production workloads.
+	//+optional
+	MoverPriorityClassName *string `json:"moverPriorityClassName,omitempty"`
+	// MoverSchedulerName is the name of the scheduler that schedules the data
+	// mover pods. By default, the cluster's default scheduler is used.
+	//+optional
+	MoverSchedulerName *string `json:"moverSchedulerName,omitempty"`
+	// MoverEnv are additional environment variables for the data mover
+	// containers. Values may come from Secrets, ConfigMaps, or the pod's
+	// fields. Variables that VolSync sets take precedence.
+	//+kubebuilder:validation:MaxItems=64
+	//+optional
+	MoverEnv []corev1.EnvVar `json:"moverEnv,omitempty"`
+	// MoverServiceAccountTokens are tokens of the data mover's service
+	// account, for the given audiences, that are projected into the data
+	// mover containers under /var/run/secrets/volsync/tokens.
+	//+kubebuilder:validation:MaxItems=8
+	//+listType=map
+	//+listMapKey=name
+	//+optional
+	MoverServiceAccountTokens []MoverServiceAccountToken `json:"moverServiceAccountTokens,omitempty"`
+	// MoverTimeout is the longest a mover Job may run. A Job that runs longer
+	// is deleted and recreated after a delay that doubles with each
+	// consecutive timeout (from 1 minute up to 1 hour), and the SyncTimedOut
+	// condition is set. By default, mover Jobs may run indefinitely. It is
+	// not used by Syncthing, whose mover runs continuously.
+	//+optional
+	MoverTimeout *metav1.Duration `json:"moverTimeout,omitempty"`
+	// ProtectFromEviction keeps the data mover pods from being evicted, e.g.
+	// by node drains or the cluster autoscaler, so that long-running
+	// synchronizations are not restarted from scratch. A PodDisruptionBudget
+	// is created for each mover Job, and the pods are annotated as not safe
+	// to evict for the cluster autoscaler.
+	//+optional
+	ProtectFromEviction *bool `json:"protectFromEviction,omitempty"`
 }
@@ -35,6 +35,37 @@ const (
 	EvRSrcPVCTimeoutWaitingForCopyTrigger  = "SrcPVCTimeoutWaitingForCopyTrigger" // Warning
 	EvRSrcPVCCopyTriggerReceived           = "SrcPVCCopyTriggerReceived"
 	EvRSrcPVCCopyUsingCopyTriggerCompleted = "SrcPVCCopyUsingCopyTriggerCompleted"
+	EvRStale                               = "Stale"          // Warning
+	EvRStaleSuspended                      = "StaleSuspended" // Warning
+	EvRStaleDeleting                       = "StaleDeleting"  // Warning
+	EvRSyncHookSucceeded                   = "SyncHookSucceeded"
+	EvRSyncHookFailed                      = "SyncHookFailed" // Warning
+	EvRWorkloadsScaledDown                 = "WorkloadsScaledDown"
+	EvRWorkloadsScaledUp                   = "WorkloadsScaledUp"
+	EvRDowntimeBudgetExceeded              = "DowntimeBudgetExceeded" // Warning
+	EvRSnapContentDeleted                  = "VolumeSnapshotContentDeleted"
+	EvRSnapContentRebound                  = "VolumeSnapshotContentRebound"
+	EvRCapacityExhaustion                  = "CapacityExhaustionProjected" // Warning
+	EvRPVCExpanded                         = "PersistentVolumeClaimExpanded"
+	EvRPVCAdopted                          = "PersistentVolumeClaimAdopted"
+	EvRStaleLocksRemoved                   = "StaleLocksRemoved" // Warning
+	EvRPromoted                            = "Promoted"
+	EvRPromotionFailed                     = "PromotionFailed" // Warning
+	EvRRoleChanged                         = "RoleChanged"
+	EvRSnapExpired                         = "VolumeSnapshotExpired"
+	EvRSyncTimedOut                        = "SyncTimedOut" // Warning
+	EvRSrcPVCReady                         = "SourcePVCReady"
+	EvRSnapReady                           = "VolumeSnapshotReady"
+	EvRMoverJobStarted                     = "MoverJobStarted"
+	EvRMoverJobSucceeded                   = "MoverJobSucceeded"
+	EvRMoverJobFailed                      = "MoverJobFailed" // Warning
+	EvRCleanupComplete                     = "CleanupComplete"
+	EvRConnectionTestSucceeded             = "ConnectionTestSucceeded"
+	EvRConnectionTestFailed                = "ConnectionTestFailed" // Warning
+	EvRStandbyValidationPassed             = "StandbyValidationPassed"
+	EvRStandbyValidationFailed             = "StandbyValidationFailed" // Warning
+	EvRIdentityRotated                     = "IdentityRotated"
+	EvRCopyMethodFallback                  = "CopyMethodFallback" // Warning
 )
 
 // ReplicationSource/ReplicationDestination Event "action" strings: Things the controller "does"
@@ -45,6 +76,11 @@ const (
 	EvACreatePVC                     = "CreatePersistentVolumeClaim"
 	EvACreateSnap                    = "CreateVolumeSnapshot"
 	EvACreateSrcCopyUsingCopyTrigger = "CreateSrcCopyUsingCopyTrigger"
+	EvARunSyncHook                   = "RunSyncHook"
+	EvAScaleWorkloads                = "ScaleWorkloads"
+	EvAExpandPVC                     = "ExpandPersistentVolumeClaim"
+	EvAAdoptPVC                      = "AdoptPersistentVolumeClaim"
+	EvAUnlockRepository              = "UnlockRepository"
 )
 
 // Volume Populator Event "reason" strings
@@ -55,4 +91,28 @@ const (
 	EvRVolPopPVCReplicationDestNoLatestImage = "VolSyncPopulatorReplicationDestinationNoLatestImage"
 	EvRVolPopPVCCreationSuccess              = "VolSyncPopulatorPVCCreated"
 	EvRVolPopPVCCreationError                = "VolSyncPopulatorPVCCreationError"
+	EvVolPopPVCReplicationSourceMissing      = "VolSyncPopulatorReplicationSourceMissing"
+	EvRVolPopPVCRestoreStarted               = "VolSyncPopulatorRestoreStarted"
+	EvRVolPopPVCRestoreFailed                = "VolSyncPopulatorRestoreFailed"
+	EvRVolPopPVCRestoreCompleted             = "VolSyncPopulatorRestoreCompleted"
+	EvRVolPopPVCCrossNamespaceDenied         = "VolSyncPopulatorCrossNamespaceDenied"
+)
+
+// RestoreFanout Event "reason" strings
+const (
+	EvRFanoutRestoreStarted   = "RestoreStarted"
+	EvRFanoutRestoreCompleted = "RestoreCompleted"
+	EvRFanoutRestoreFailed    = "RestoreFailed" // Warning
+)
+
+// ReplicationCredentialSync Event "reason" strings
+const (
+	EvRCredentialsUpdated    = "CredentialsUpdated"
+	EvRCredentialsSyncFailed = "CredentialsSyncFailed" // Warning
+)
+
+// ReplicationLink Event "reason" strings
+const (
+	EvRLinkHealthy   = "LinkHealthy"
+	EvRLinkUnhealthy = "LinkUnhealthy" // Warning
 )
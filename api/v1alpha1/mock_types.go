@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This file may be used, at your option, according to either the GNU AGPL 3.0 or
+the Apache V2 license.
+
+---
+This program is free software: you can redistribute it and/or modify it under
+the terms of the GNU Affero General Public License as published by the Free
+Software Foundation, either version 3 of the License, or (at your option) any
+later version.
+
+This program is distributed in the hope that it will be useful, but WITHOUT ANY
+WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A
+PARTICULAR PURPOSE.  See the GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License along
+with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+---
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// +kubebuilder:validation:Required
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// MockSettings configure the behavior of the mock mover's Job
+type MockSettings struct {
+	// duration is how long each mover Job runs before it completes. Defaults
+	// to 10s.
+	//+optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+	// fail causes the mover Job to exit with an error so that the handling of
+	// failed synchronizations can be exercised.
+	//+optional
+	Fail bool `json:"fail,omitempty"`
+}
+
+/********************************************************************
+ * Replication source types
+ ********************************************************************/
+
+type ReplicationSourceMockSpec struct {
+	ReplicationSourceVolumeOptions `json:",inline"`
+	MockSettings                   `json:",inline"`
+
+	MoverConfig `json:",inline"`
+}
+
+/********************************************************************
+ * Replication destination types
+ ********************************************************************/
+
+type ReplicationDestinationMockSpec struct {
+	ReplicationDestinationVolumeOptions `json:",inline"`
+	MockSettings                        `json:",inline"`
+
+	MoverConfig `json:",inline"`
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This file may be used, at your option, according to either the GNU AGPL 3.0 or
+the Apache V2 license.
+
+---
+This program is free software: you can redistribute it and/or modify it under
+the terms of the GNU Affero General Public License as published by the Free
+Software Foundation, either version 3 of the License, or (at your option) any
+later version.
+
+This program is distributed in the hope that it will be useful, but WITHOUT ANY
+WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A
+PARTICULAR PURPOSE.  See the GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License along
+with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+---
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// +kubebuilder:validation:Required
+package v1alpha1
+
+/********************************************************************
+ * Common types
+ ********************************************************************/
+
+// NFSExport identifies the NFS export that the nfs mover replicates to or from
+type NFSExport struct {
+	// server is the hostname or IP address of the NFS server.
+	//+kubebuilder:validation:MinLength=1
+	Server string `json:"server"`
+	// path is the path of the export on the NFS server.
+	//+kubebuilder:validation:MinLength=1
+	Path string `json:"path"`
+	// mountOptions are the options used to mount the export (e.g.,
+	// ["nfsvers=4.1", "hard"]). Mounting with options uses the inline volumes
+	// of the NFS CSI driver (nfs.csi.k8s.io), which must be installed in the
+	// cluster. Without options, the export is mounted by the kubelet.
+	//+optional
+	MountOptions []string `json:"mountOptions,omitempty"`
+}
+
+/********************************************************************
+ * Replication source types
+ ********************************************************************/
+
+// ReplicationSourceNFSSpec defines the configuration of a ReplicationSource
+// that copies the source volume to an NFS export
+type ReplicationSourceNFSSpec struct {
+	ReplicationSourceVolumeOptions `json:",inline"`
+	NFSExport                      `json:",inline"`
+
+	MoverConfig `json:",inline"`
+}
+
+/********************************************************************
+ * Replication destination types
+ ********************************************************************/
+
+// ReplicationDestinationNFSSpec defines the configuration of a
+// ReplicationDestination that copies the data on an NFS export to the
+// destination volume
+type ReplicationDestinationNFSSpec struct {
+	ReplicationDestinationVolumeOptions `json:",inline"`
+	NFSExport                           `json:",inline"`
+
+	MoverConfig `json:",inline"`
+}
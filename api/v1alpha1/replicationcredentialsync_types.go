@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This file may be used, at your option, according to either the GNU AGPL 3.0 or
+the Apache V2 license.
+
+---
+This program is free software: you can redistribute it and/or modify it under
+the terms of the GNU Affero General Public License as published by the Free
+Software Foundation, either version 3 of the License, or (at your option) any
+later version.
+
+This program is distributed in the hope that it will be useful, but WITHOUT ANY
+WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A
+PARTICULAR PURPOSE.  See the GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License along
+with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+---
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ConditionCredentialsSynchronized           string = "Synchronized"
+	CredentialsSyncReasonSuccess               string = "Success"
+	CredentialsSyncReasonWaitingForDestination string = "WaitingForDestination"
+	CredentialsSyncReasonRemoteUnreachable     string = "RemoteUnreachable"
+	CredentialsSyncReasonError                 string = "Error"
+
+	// The key of the kubeconfig in a KubeconfigSecretRef, if not specified
+	DefaultKubeconfigSecretKey string = "kubeconfig"
+)
+
+// KubeconfigSecretRef refers to a Secret holding a kubeconfig that is used to
+// connect to another cluster.
+type KubeconfigSecretRef struct {
+	// name is the name of the Secret, in the same namespace as the object
+	// that refers to it.
+	//+kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// key is the key in the Secret that holds the kubeconfig. Defaults to
+	// "kubeconfig".
+	//+optional
+	Key string `json:"key,omitempty"`
+}
+
+// RemoteObjectReference identifies an object in another cluster.
+type RemoteObjectReference struct {
+	// namespace is the namespace of the object in the remote cluster.
+	//+kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+	// name is the name of the object in the remote cluster.
+	//+kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// ReplicationCredentialSyncSpec defines the desired state of
+// ReplicationCredentialSync
+type ReplicationCredentialSyncSpec struct {
+	// kubeconfigSecretRef is the Secret holding the kubeconfig of the cluster
+	// that has the ReplicationDestination. The credentials in it need to be
+	// able to get the ReplicationDestination and its key Secret.
+	KubeconfigSecretRef KubeconfigSecretRef `json:"kubeconfigSecretRef"`
+	// replicationDestination is the rsync-tls or rsync ReplicationDestination
+	// in the remote cluster whose keys and address are copied.
+	ReplicationDestination RemoteObjectReference `json:"replicationDestination"`
+	// replicationSource is the name of a ReplicationSource in this namespace
+	// that is updated to use the copied keys and the address of the
+	// destination. If not set, only the key Secret is kept up to date.
+	//+optional
+	ReplicationSource *string `json:"replicationSource,omitempty"`
+	// keySecretName is the name of the Secret in this namespace that the keys
+	// are copied to. Defaults to the name of the ReplicationCredentialSync.
+	//+optional
+	KeySecretName *string `json:"keySecretName,omitempty"`
+	// refreshInterval is how often the remote cluster is checked for changes
+	// to the keys or address. Defaults to 5m.
+	//+optional
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+	// paused can be used to stop synchronizing the credentials.
+	//+optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// ReplicationCredentialSyncStatus defines the observed state of
+// ReplicationCredentialSync
+type ReplicationCredentialSyncStatus struct {
+	// keySecretName is the Secret in this namespace that holds the copied
+	// keys.
+	//+optional
+	KeySecretName *string `json:"keySecretName,omitempty"`
+	// address is the address of the ReplicationDestination.
+	//+optional
+	Address *string `json:"address,omitempty"`
+	// port is the port of the ReplicationDestination.
+	//+optional
+	Port *int32 `json:"port,omitempty"`
+	// lastCheckTime is when the remote cluster was last checked.
+	//+optional
+	LastCheckTime *metav1.Time `json:"lastCheckTime,omitempty"`
+	// lastUpdateTime is when the keys or address last changed.
+	//+optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+	// conditions represent the latest available observations of the
+	// synchronization of the credentials.
+	//+optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// A ReplicationCredentialSync copies the connection details of an rsync-tls
+// or rsync ReplicationDestination in another cluster (its key Secret and
+// address) into this namespace and keeps them up to date, so that a
+// ReplicationSource can connect to it without the keys being copied by hand.
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Address",type="string",JSONPath=`.status.address`
+// +kubebuilder:printcolumn:name="Last update",type="string",format="date-time",JSONPath=`.status.lastUpdateTime`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`
+type ReplicationCredentialSync struct {
+	metav1.TypeMeta `json:",inline"`
+	//+optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// spec is the desired state of the ReplicationCredentialSync.
+	Spec ReplicationCredentialSyncSpec `json:"spec,omitempty"`
+	// status is the observed state of the ReplicationCredentialSync as
+	// determined by the controller.
+	//+optional
+	Status *ReplicationCredentialSyncStatus `json:"status,omitempty"`
+}
+
+// ReplicationCredentialSyncList contains a list of ReplicationCredentialSync
+// +kubebuilder:object:root=true
+type ReplicationCredentialSyncList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReplicationCredentialSync `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ReplicationCredentialSync{}, &ReplicationCredentialSyncList{})
+}
@@ -40,6 +40,253 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// StalePolicyType is the action taken once a ReplicationDestination has gone
+// stale.
+// +kubebuilder:validation:Enum=Alert;Suspend;Delete
+type StalePolicyType string
+
+const (
+	// StalePolicyAlert only reports that the destination is stale
+	StalePolicyAlert StalePolicyType = "Alert"
+	// StalePolicySuspend pauses the destination once it is stale
+	StalePolicySuspend StalePolicyType = "Suspend"
+	// StalePolicyDelete deletes the destination (and the resources it owns)
+	// once it has been stale for a grace period
+	StalePolicyDelete StalePolicyType = "Delete"
+)
+
+const (
+	ConditionStale             string = "Stale"
+	StaleReasonRecent          string = "RecentlySynced"
+	StaleReasonStale           string = "NoRecentSync"
+	StaleReasonSuspended       string = "Suspended"
+	StaleReasonResumed         string = "Resumed"
+	StaleReasonPendingDeletion string = "PendingDeletion"
+
+	// The CapacityForecast condition is True when the destination volume is
+	// projected to run out of space within the forecast horizon
+	ConditionCapacityForecast              string = "CapacityForecast"
+	CapacityForecastReasonInsufficientData string = "InsufficientData"
+	CapacityForecastReasonSufficient       string = "CapacitySufficient"
+	CapacityForecastReasonExhaustion       string = "ExhaustionProjected"
+	CapacityForecastReasonExpanded         string = "Expanded"
+
+	// Maximum number of volume usage samples kept for forecasting
+	MaxCapacitySamples = 10
+)
+
+const (
+	// The Promoted condition is True once the latest image of the destination
+	// has been turned into a standalone PVC (see spec.promote)
+	ConditionPromoted             string = "Promoted"
+	PromotedReasonWaitingForImage string = "WaitingForImage"
+	PromotedReasonPromoted        string = "Promoted"
+	PromotedReasonError           string = "Error"
+)
+
+// PromoteSpec requests that the latest image of a ReplicationDestination be
+// made into a standalone PVC for use after a failover.
+type PromoteSpec struct {
+	// pvcName is the name of the PVC to create from the latest image. If the
+	// latest image is itself a PVC (copyMethod: Direct), that PVC is promoted
+	// instead and this field is ignored. Defaults to the name of the
+	// ReplicationDestination.
+	//+kubebuilder:validation:MinLength=1
+	//+optional
+	PVCName *string `json:"pvcName,omitempty"`
+	// storageClassName is the StorageClass of the PVC created from a
+	// VolumeSnapshot. Defaults to the storageClassName of the destination
+	// volume options.
+	//+optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// annotations are added to the promoted PVC, for example to mark it for
+	// a ReplicationSource that will replicate it back to the original site.
+	//+optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// PromotionStatus records the promotion of a ReplicationDestination
+type PromotionStatus struct {
+	// pvcName is the name of the promoted PVC.
+	PVCName string `json:"pvcName"`
+	// image is the image that the PVC was promoted from.
+	//+optional
+	Image *corev1.TypedLocalObjectReference `json:"image,omitempty"`
+	// promotionTime is when the promotion was carried out.
+	PromotionTime metav1.Time `json:"promotionTime"`
+}
+
+const (
+	// The StandbyValidated condition reports the result of the most recent
+	// validation of the latest image (see spec.standbyValidation)
+	ConditionStandbyValidated     string = "StandbyValidated"
+	StandbyValidatedReasonWaiting string = "WaitingForImage"
+	StandbyValidatedReasonPassed  string = "ValidationPassed"
+	StandbyValidatedReasonFailed  string = "ValidationFailed"
+	StandbyValidatedReasonError   string = "Error"
+)
+
+// StandbyValidationSpec configures the periodic validation of the latest
+// image of a ReplicationDestination. The image is mounted read-only at /data
+// (or attached at /dev/block for block volumes) in a Job that runs the given
+// command. The image is considered usable if the command exits successfully.
+type StandbyValidationSpec struct {
+	JobHook `json:",inline"`
+	// interval is the time between validations. Defaults to 24h.
+	//+optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// timeout is how long the validation Job may run before it is considered
+	// to have failed. Defaults to 1h.
+	//+optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// StandbyValidationStatus is the result of the most recent validation of the
+// latest image
+type StandbyValidationStatus struct {
+	// result is Passed if the validation command succeeded and Failed
+	// otherwise.
+	Result RestoreVerificationResult `json:"result"`
+	// image is the image that was validated.
+	//+optional
+	Image *corev1.TypedLocalObjectReference `json:"image,omitempty"`
+	// time is when the validation finished.
+	Time metav1.Time `json:"time"`
+	// message holds the end of the output of the validation command.
+	//+optional
+	Message string `json:"message,omitempty"`
+}
+
+// RetainedImage is an image of the destination that is kept after a newer one
+// has been made
+type RetainedImage struct {
+	// image is the VolumeSnapshot holding the replicated data.
+	Image corev1.TypedLocalObjectReference `json:"image"`
+	// syncTime is when the synchronization that made the image completed.
+	//+optional
+	SyncTime *metav1.Time `json:"syncTime,omitempty"`
+}
+
+// Steps of a restore into a PVC that is in use by workloads, recorded in
+// status.workloadCoordination
+const (
+	WorkloadStepScalingDown      string = "ScalingDown"
+	WorkloadStepWaitingForDetach string = "WaitingForDetach"
+	WorkloadStepRestoring        string = "Restoring"
+	WorkloadStepScalingUp        string = "ScalingUp"
+	WorkloadStepCompleted        string = "Completed"
+	WorkloadStepBudgetExceeded   string = "DowntimeBudgetExceeded"
+)
+
+// WorkloadCoordinationSpec configures stopping the workloads that use the
+// destination PVC while data is restored into it.
+type WorkloadCoordinationSpec struct {
+	// maxDowntime is the downtime budget: the maximum amount of time the
+	// workloads may be stopped. If the restore has not completed by then, it is
+	// stopped, the workloads are scaled back up, and the restore is retried.
+	//+optional
+	MaxDowntime *metav1.Duration `json:"maxDowntime,omitempty"`
+	// evictPods evicts pods using the destination PVC that are not part of a
+	// Deployment or StatefulSet. If false, the restore waits for them to
+	// terminate. Defaults to false.
+	//+optional
+	EvictPods bool `json:"evictPods,omitempty"`
+}
+
+// ScaledWorkload is a workload that was scaled down for a restore
+type ScaledWorkload struct {
+	// kind is Deployment or StatefulSet
+	Kind string `json:"kind"`
+	// name of the workload
+	Name string `json:"name"`
+	// replicas is the number of replicas to restore once the restore is done
+	Replicas int32 `json:"replicas"`
+}
+
+// WorkloadTimelineEntry records a step of a restore into an in-use PVC
+type WorkloadTimelineEntry struct {
+	// time the step started
+	Time metav1.Time `json:"time"`
+	// step is one of ScalingDown, WaitingForDetach, Restoring, ScalingUp,
+	// Completed, or DowntimeBudgetExceeded
+	Step string `json:"step"`
+	// message provides additional details
+	//+optional
+	Message string `json:"message,omitempty"`
+}
+
+// WorkloadCoordinationStatus describes the most recent restore into the
+// destination PVC that stopped the workloads using it.
+type WorkloadCoordinationStatus struct {
+	// step is the current step of the restore. It is empty when no restore is
+	// in progress.
+	//+optional
+	Step string `json:"step,omitempty"`
+	// downtimeStart is when the workloads were stopped
+	//+optional
+	DowntimeStart *metav1.Time `json:"downtimeStart,omitempty"`
+	// scaledWorkloads are the workloads that were scaled down
+	//+optional
+	ScaledWorkloads []ScaledWorkload `json:"scaledWorkloads,omitempty"`
+	// timeline of the steps of the most recent restore
+	//+optional
+	Timeline []WorkloadTimelineEntry `json:"timeline,omitempty"`
+}
+
+// CapacityForecastSpec configures the forecasting of the growth of the
+// destination volume.
+type CapacityForecastSpec struct {
+	// horizon is how far ahead the usage of the volume is forecast. The
+	// CapacityForecast condition is raised (or the volume is expanded) when it
+	// is projected to be full within this time. Defaults to 7 days.
+	//+optional
+	Horizon *metav1.Duration `json:"horizon,omitempty"`
+	// autoExpand expands the destination PVC ahead of need, if its
+	// StorageClass allows volume expansion.
+	//+optional
+	AutoExpand bool `json:"autoExpand,omitempty"`
+	// expansionPercent is the percentage by which the PVC is grown when it is
+	// expanded. Defaults to 25.
+	//+kubebuilder:validation:Minimum=1
+	//+kubebuilder:validation:Maximum=1000
+	//+optional
+	ExpansionPercent *int32 `json:"expansionPercent,omitempty"`
+	// maxCapacity is the largest size the PVC will be expanded to.
+	//+optional
+	MaxCapacity *resource.Quantity `json:"maxCapacity,omitempty"`
+}
+
+// CapacitySample is a measurement of the usage of the destination volume
+type CapacitySample struct {
+	// time the usage was measured.
+	Time metav1.Time `json:"time"`
+	// usedBytes is the amount of space used on the volume.
+	UsedBytes int64 `json:"usedBytes"`
+	// capacityBytes is the size of the filesystem on the volume.
+	CapacityBytes int64 `json:"capacityBytes"`
+}
+
+// CapacityForecastStatus reports the usage trend of the destination volume
+type CapacityForecastStatus struct {
+	// pvcName is the name of the destination PVC that is measured.
+	//+optional
+	PVCName string `json:"pvcName,omitempty"`
+	// samples are the most recent measurements of the volume's usage, as
+	// reported by the mover after each synchronization.
+	//+optional
+	Samples []CapacitySample `json:"samples,omitempty"`
+	// growthBytesPerDay is the average growth of the used space.
+	//+optional
+	GrowthBytesPerDay *int64 `json:"growthBytesPerDay,omitempty"`
+	// projectedFullTime is when the volume is projected to be full, if its
+	// usage is growing.
+	//+optional
+	ProjectedFullTime *metav1.Time `json:"projectedFullTime,omitempty"`
+	// lastExpansionTime is the time the PVC was last expanded by VolSync.
+	//+optional
+	LastExpansionTime *metav1.Time `json:"lastExpansionTime,omitempty"`
+}
+
 // ReplicationDestinationTriggerSpec defines when a volume will be synchronized
 // with the source.
 type ReplicationDestinationTriggerSpec struct {
@@ -50,6 +297,12 @@ type ReplicationDestinationTriggerSpec struct {
 	//+kubebuilder:validation:Pattern=`^(@(annually|yearly|monthly|weekly|daily|hourly))|((((\d+,)*\d+|(\d+(\/|-)\d+)|\*(\/\d+)?)\s?){5})$`
 	//+optional
 	Schedule *string `json:"schedule,omitempty"`
+	// timeZone is the IANA name of the time zone (e.g., "America/New_York")
+	// that the schedule is interpreted in. Defaults to the time zone of the
+	// operator (normally UTC).
+	//+kubebuilder:validation:MinLength=1
+	//+optional
+	TimeZone *string `json:"timeZone,omitempty"`
 	// manual is a string value that schedules a manual trigger.
 	// Once a sync completes then status.lastManualSync is set to the same string value.
 	// A consumer of a manual trigger should set spec.trigger.manual to a known value
@@ -96,6 +349,70 @@ type ReplicationDestinationVolumeOptions struct {
 	// The default is false.
 	//+optional
 	CleanupTempPVC bool `json:"cleanupTempPVC,omitempty"`
+	// adoptDestinationPVC allows VolSync to take over management of the
+	// pre-existing PVC named by destinationPVC. The PVC is labeled as adopted
+	// by this ReplicationDestination and, depending on the options below, may
+	// be deleted along with it or expanded to match capacity. If not set, a
+	// provided destinationPVC is used as-is and never modified.
+	//+optional
+	AdoptDestinationPVC *AdoptDestinationPVCSpec `json:"adoptDestinationPVC,omitempty"`
+	// snapshotMetadata adds labels and annotations to the VolumeSnapshot that
+	// is taken of the destination after each synchronization when the
+	// copyMethod is Snapshot.
+	//+optional
+	SnapshotMetadata *SnapshotMetadataSpec `json:"snapshotMetadata,omitempty"`
+	// snapshotRetention deletes the VolumeSnapshots of the destination's
+	// images (latestImage and latestImages) once they are too old or too
+	// many, also while the destination is paused.
+	//+optional
+	SnapshotRetention *SnapshotRetentionPolicy `json:"snapshotRetention,omitempty"`
+}
+
+// SnapshotRetentionPolicy limits how long and how many of its VolumeSnapshots
+// a ReplicationDestination keeps. Snapshots labeled do-not-delete are never
+// deleted.
+type SnapshotRetentionPolicy struct {
+	// maxAge is the age after which a snapshot is deleted, even if it is the
+	// latestImage.
+	//+optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+	// maxCount is the number of the most recent snapshots that are kept.
+	//+kubebuilder:validation:Minimum=1
+	//+optional
+	MaxCount *int32 `json:"maxCount,omitempty"`
+}
+
+// SnapshotMetadataSpec describes the labels and annotations of the
+// VolumeSnapshots created by a ReplicationDestination. The values are Go
+// templates that may refer to:
+//   - {{ .Name }} and {{ .Namespace }} of the ReplicationDestination
+//   - {{ .SyncTime }}, the time of the snapshot in RFC 3339 format, and
+//     {{ .SyncTimestamp }}, the same time as YYYYMMDDhhmmss (usable in labels)
+//   - {{ .SourceName }} and {{ .SourceNamespace }} of the ReplicationSource
+//     that created the data, if the mover reports it (restic)
+type SnapshotMetadataSpec struct {
+	// labels to add to the VolumeSnapshot. The rendered values must be valid
+	// label values.
+	//+optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// annotations to add to the VolumeSnapshot.
+	//+optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// AdoptDestinationPVCSpec controls how VolSync manages an adopted
+// destinationPVC.
+type AdoptDestinationPVCSpec struct {
+	// deleteWithDestination makes the ReplicationDestination the controlling
+	// owner of the PVC so that it is garbage collected when the
+	// ReplicationDestination is deleted. Adoption fails if the PVC is already
+	// controlled by another object.
+	//+optional
+	DeleteWithDestination bool `json:"deleteWithDestination,omitempty"`
+	// resize allows VolSync to expand the PVC when capacity is larger than the
+	// PVC's current storage request. PVCs are never shrunk.
+	//+optional
+	Resize bool `json:"resize,omitempty"`
 }
 
 type ReplicationDestinationRsyncSpec struct {
@@ -131,16 +448,32 @@ type ReplicationDestinationRsyncSpec struct {
 	// sshUser is the username for outgoing SSH connections. Defaults to "root".
 	//+optional
 	SSHUser *string `json:"sshUser,omitempty"`
+	// hostKeyRotationInterval is how often the destination's SSH host key is
+	// replaced when the keys are generated by VolSync (i.e., sshKeys is not
+	// set). The new host key is added to the source's Secret ahead of time so
+	// that sources accept both keys during the changeover. Host keys are not
+	// rotated if this is not set.
+	//+optional
+	HostKeyRotationInterval *metav1.Duration `json:"hostKeyRotationInterval,omitempty"`
 	// MoverServiceAccount allows specifying the name of the service account
 	// that will be used by the data mover. This should only be used by advanced
 	// users who want to override the service account normally used by the mover.
 	// The service account needs to exist in the same namespace as the ReplicationDestination.
 	//+optional
 	MoverServiceAccount *string `json:"moverServiceAccount,omitempty"`
+	// MoverImage overrides the container image of the data mover for this
+	// object. The image must be permitted by the operator's
+	// --allowed-mover-images flag.
+	//+optional
+	MoverImage *string `json:"moverImage,omitempty"`
 	// Labels that should be added to data mover pods
 	// These will be in addition to any labels that VolSync may add
 	// +optional
 	MoverPodLabels map[string]string `json:"moverPodLabels,omitempty"`
+	// Annotations that should be added to data mover pods
+	// These will be in addition to any annotations that VolSync may add
+	// +optional
+	MoverPodAnnotations map[string]string `json:"moverPodAnnotations,omitempty"`
 	// Resources represents compute resources required by the data mover container.
 	// Immutable.
 	// More info: https://kubernetes.io/docs/concepts/configuration/manage-compute-resources-container/
@@ -148,9 +481,39 @@ type ReplicationDestinationRsyncSpec struct {
 	// pod being unschedulable or crashing due to limited resources.
 	// +optional
 	MoverResources *corev1.ResourceRequirements `json:"moverResources,omitempty"`
+	// MoverPriorityClassName is the name of the PriorityClass of the data
+	// mover pods. A low priority allows the movers to be preempted by
+	// production workloads.
+	//+optional
+	MoverPriorityClassName *string `json:"moverPriorityClassName,omitempty"`
+	// MoverSchedulerName is the name of the scheduler that schedules the data
+	// mover pods. By default, the cluster's default scheduler is used.
+	//+optional
+	MoverSchedulerName *string `json:"moverSchedulerName,omitempty"`
+	// MoverEnv are additional environment variables for the data mover
+	// containers. Values may come from Secrets, ConfigMaps, or the pod's
+	// fields. Variables that VolSync sets take precedence.
+	//+kubebuilder:validation:MaxItems=64
+	//+optional
+	MoverEnv []corev1.EnvVar `json:"moverEnv,omitempty"`
+	// MoverServiceAccountTokens are tokens of the data mover's service
+	// account, for the given audiences, that are projected into the data
+	// mover containers under /var/run/secrets/volsync/tokens.
+	//+kubebuilder:validation:MaxItems=8
+	//+listType=map
+	//+listMapKey=name
+	//+optional
+	MoverServiceAccountTokens []MoverServiceAccountToken `json:"moverServiceAccountTokens,omitempty"`
+	// MoverTimeout is the longest a mover Job may run. A Job that runs longer
+	// is deleted and recreated after a delay that doubles with each
+	// consecutive timeout (from 1 minute up to 1 hour), and the SyncTimedOut
+	// condition is set. By default, mover Jobs may run indefinitely.
+	//+optional
+	MoverTimeout *metav1.Duration `json:"moverTimeout,omitempty"`
 }
 
 // ReplicationDestinationRcloneSpec defines the field for rclone in replicationDestination.
+// +kubebuilder:validation:XValidation:rule="!has(self.remote) || (!has(self.rcloneConfig) && !has(self.rcloneConfigSection))",message="remote cannot be combined with rcloneConfig or rcloneConfigSection"
 type ReplicationDestinationRcloneSpec struct {
 	ReplicationDestinationVolumeOptions `json:",inline"`
 	//RcloneConfigSection is the section in rclone_config file to use for the current job.
@@ -159,8 +522,37 @@ type ReplicationDestinationRcloneSpec struct {
 	RcloneDestPath *string `json:"rcloneDestPath,omitempty"`
 	// RcloneConfig is the rclone secret name
 	RcloneConfig *string `json:"rcloneConfig,omitempty"`
+	// remote describes the rclone remote using typed fields. VolSync
+	// generates the rclone configuration from it, so rcloneConfig and
+	// rcloneConfigSection must not be set.
+	//+optional
+	Remote *RcloneRemoteSpec `json:"remote,omitempty"`
+	// connectionTest is a string value that requests a read-only check of the
+	// connection to the remote. A short Job runs `rclone lsd` with
+	// the configured credentials, independently of the synchronizations, and
+	// the result is recorded in status.connectionTest. The check runs again
+	// only when connectionTest is set to a different value.
+	//+optional
+	ConnectionTest string `json:"connectionTest,omitempty"`
+	// parallelism is the number of files that the mover transfers
+	// concurrently (--transfers), with twice as many checkers. Defaults to 10
+	// transfers and 8 checkers.
+	//+kubebuilder:validation:Minimum=1
+	//+kubebuilder:validation:Maximum=64
+	//+optional
+	Parallelism *int32 `json:"parallelism,omitempty"`
 	// customCA is a custom CA that will be used to verify the remote
 	CustomCA CustomCASpec `json:"customCA,omitempty"`
+	// moverOS is the operating system of the nodes that the mover runs on.
+	// Set to "windows" to synchronize PVCs that can only be mounted on Windows
+	// nodes (e.g., SMB/CIFS-backed volumes). Defaults to "linux".
+	//+optional
+	MoverOS MoverOS `json:"moverOS,omitempty"`
+	// verifyChecksum compares the checksums of the restored files with those
+	// of the files in the remote after the sync. The synchronization fails if
+	// they do not match. Defaults to false.
+	//+optional
+	VerifyChecksum bool `json:"verifyChecksum,omitempty"`
 
 	MoverConfig `json:",inline"`
 }
@@ -190,6 +582,20 @@ type ReplicationDestinationSpec struct {
 	// rsyncTLS defines the configuration when using Rsync-based replication over TLS.
 	//+optional
 	RsyncTLS *ReplicationDestinationRsyncTLSSpec `json:"rsyncTLS,omitempty"`
+	// block defines the configuration when using diskrsync-based replication
+	// of block mode volumes.
+	//+optional
+	Block *ReplicationDestinationBlockSpec `json:"block,omitempty"`
+	// nfs defines the configuration when copying the data from an NFS export
+	// that is mounted directly by the mover.
+	//+optional
+	NFS *ReplicationDestinationNFSSpec `json:"nfs,omitempty"`
+	// mock defines the configuration of a mover that runs a Job through the
+	// full synchronization lifecycle without moving any data. It is only
+	// available when the operator is started with --enable-mock-mover and
+	// is intended for testing automation that is built on VolSync.
+	//+optional
+	Mock *ReplicationDestinationMockSpec `json:"mock,omitempty"`
 	// rclone defines the configuration when using Rclone-based replication.
 	//+optional
 	Rclone *ReplicationDestinationRcloneSpec `json:"rclone,omitempty"`
@@ -203,6 +609,74 @@ type ReplicationDestinationSpec struct {
 	// paused can be used to temporarily stop replication. Defaults to "false".
 	//+optional
 	Paused bool `json:"paused,omitempty"`
+	// snapshotContentPolicy determines what happens to the
+	// VolumeSnapshotContents of VolSync snapshots whose VolumeSnapshotClass
+	// has a Retain deletion policy once the snapshots are deleted. Ignore
+	// (the default) leaves them in place, Delete removes them, and Rebind
+	// binds them to a new VolumeSnapshot so that they can be reused.
+	//+optional
+	SnapshotContentPolicy SnapshotContentPolicyType `json:"snapshotContentPolicy,omitempty"`
+	// securityProfile selects the security settings of the mover pods.
+	// "Default" uses the mover's usual settings. "Hardened" requires the mover
+	// to run as a non-root user (moverSecurityContext.runAsUser) with a
+	// read-only root filesystem, the RuntimeDefault seccomp profile, all
+	// capabilities dropped, and without privileged mover permissions.
+	//+optional
+	SecurityProfile SecurityProfileType `json:"securityProfile,omitempty"`
+	// staleAfter is the amount of time without a completed synchronization
+	// after which the destination is considered stale (e.g., because its
+	// source has been deleted). Staleness is not checked if this is not set.
+	//+optional
+	StaleAfter *metav1.Duration `json:"staleAfter,omitempty"`
+	// stalePolicy is the action taken once the destination is stale. "Alert"
+	// (the default) only reports it, "Suspend" pauses the destination, and
+	// "Delete" deletes the destination after a grace period.
+	//+optional
+	StalePolicy StalePolicyType `json:"stalePolicy,omitempty"`
+	// notifications configures sending notifications of synchronization
+	// results to a webhook.
+	//+optional
+	Notifications *NotificationSpec `json:"notifications,omitempty"`
+	// retryPolicy controls how failed mover Jobs are retried during a
+	// synchronization. If omitted, a failed mover Job is retried immediately.
+	//+optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+	// workloadCoordination, when set, allows restoring into a destinationPVC
+	// that is in use: before each synchronization, the Deployments and
+	// StatefulSets with pods using the PVC are scaled down and, once the
+	// volume has been detached, the data is restored and they are scaled back
+	// up.
+	//+optional
+	WorkloadCoordination *WorkloadCoordinationSpec `json:"workloadCoordination,omitempty"`
+	// populatorNamespaces lists the namespaces, other than its own, whose PVCs
+	// may use this ReplicationDestination as their dataSourceRef. The latest
+	// image is made available to these PVCs by binding a copy of its
+	// VolumeSnapshotContent to a VolumeSnapshot in the namespace of the PVC.
+	//+optional
+	PopulatorNamespaces []string `json:"populatorNamespaces,omitempty"`
+	// capacityForecast, when set, tracks the usage of the destination volume
+	// after each synchronization (for movers that report it) to forecast when
+	// it will be full, and optionally expands it ahead of need.
+	//+optional
+	CapacityForecast *CapacityForecastSpec `json:"capacityForecast,omitempty"`
+	// promote finalizes the latest image into a standalone PVC that is not
+	// owned by the ReplicationDestination, and pauses the destination. It is
+	// intended for failing over to the destination site.
+	//+optional
+	Promote *PromoteSpec `json:"promote,omitempty"`
+	// keepLatestImages is the number of the most recent images (including
+	// latestImage) that are retained and listed in status.latestImages.
+	// Older images are deleted. Only images that are VolumeSnapshots are
+	// retained. Defaults to 1.
+	//+kubebuilder:validation:Minimum=1
+	//+kubebuilder:validation:Maximum=64
+	//+optional
+	KeepLatestImages *int32 `json:"keepLatestImages,omitempty"`
+	// standbyValidation periodically checks that the latest image is usable
+	// (e.g., with a database consistency check or fsck) by running a command
+	// against it in a Job, and reports the result in status.
+	//+optional
+	StandbyValidation *StandbyValidationSpec `json:"standbyValidation,omitempty"`
 }
 
 type ReplicationDestinationRsyncStatus struct {
@@ -220,6 +694,15 @@ type ReplicationDestinationRsyncStatus struct {
 	// connections.
 	//+optional
 	Port *int32 `json:"port,omitempty"`
+	// hostKeyFingerprints are the SHA256 fingerprints of the SSH host keys
+	// that sources should accept: the current key and, while a rotation is
+	// pending, the next key.
+	//+optional
+	HostKeyFingerprints []string `json:"hostKeyFingerprints,omitempty"`
+	// lastHostKeyRotation is when the current SSH host key was put into use.
+	// Only set when spec.rsync.hostKeyRotationInterval is set.
+	//+optional
+	LastHostKeyRotation *metav1.Time `json:"lastHostKeyRotation,omitempty"`
 }
 
 type ReplicationDestinationResticCA CustomCASpec
@@ -227,8 +710,40 @@ type ReplicationDestinationResticCA CustomCASpec
 // ReplicationDestinationResticSpec defines the field for restic in replicationDestination.
 type ReplicationDestinationResticSpec struct {
 	ReplicationDestinationVolumeOptions `json:",inline"`
+	// Will be used for the dynamic destination PVC created by VolSync. Set to
+	// "Block" to restore the backup of a volumeMode: Block PVC.
+	// Defaults to "Filesystem"
+	//+optional
+	VolumeMode *corev1.PersistentVolumeMode `json:"volumeMode,omitempty"`
 	// Repository is the secret name containing repository info
 	Repository string `json:"repository,omitempty"`
+	// repositorySecretRef mounts the repository credentials into the mover
+	// from an external secret store instead of the repository Secret. Each
+	// credential must be a file named after the restic environment variable
+	// (e.g., RESTIC_REPOSITORY, RESTIC_PASSWORD). When set, repository is
+	// ignored.
+	//+optional
+	RepositorySecretRef *MoverSecretRef `json:"repositorySecretRef,omitempty"`
+	// ensureRepository runs a separate, short Job before each synchronization
+	// that initializes the repository if it does not exist and verifies that
+	// it can be accessed with the provided credentials. The result is reported
+	// in the RepositoryReady condition.
+	//+optional
+	EnsureRepository bool `json:"ensureRepository,omitempty"`
+	// connectionTest is a string value that requests a read-only check of the
+	// connection to the repository. A short Job runs `restic cat config` with
+	// the configured credentials, independently of the synchronizations, and
+	// the result is recorded in status.connectionTest. The check runs again
+	// only when connectionTest is set to a different value.
+	//+optional
+	ConnectionTest string `json:"connectionTest,omitempty"`
+	// parallelism is the number of concurrent connections to the repository
+	// backend. Backups also read this many files concurrently. By default,
+	// restic uses 5 connections and reads 2 files at a time.
+	//+kubebuilder:validation:Minimum=1
+	//+kubebuilder:validation:Maximum=64
+	//+optional
+	Parallelism *int32 `json:"parallelism,omitempty"`
 	// customCA is a custom CA that will be used to verify the remote
 	CustomCA ReplicationDestinationResticCA `json:"customCA,omitempty"`
 	// cacheCapacity can be used to set the size of the restic metadata cache volume
@@ -241,6 +756,12 @@ type ReplicationDestinationResticSpec struct {
 	// accessModes can be used to set the accessModes of restic metadata cache volume
 	//+optional
 	CacheAccessModes []corev1.PersistentVolumeAccessMode `json:"cacheAccessModes,omitempty"`
+	// cacheType is the kind of volume used for the restic metadata cache: a
+	// PVC that is kept between synchronizations, a generic ephemeral volume,
+	// or an emptyDir (limited to cacheCapacity). Ephemeral caches are rebuilt
+	// by each synchronization. Defaults to PVC.
+	//+optional
+	CacheType *ResticCacheType `json:"cacheType,omitempty"`
 	// Set this to true to delete the restic cache PVC (dynamically provisioned
 	// by VolSync) at the end of each successful ReplicationDestination sync iteration.
 	// Cache PVCs will always be deleted if the owning ReplicationDestination is
@@ -255,11 +776,67 @@ type ReplicationDestinationResticSpec struct {
 	// +kubebuilder:validation:Format="date-time"
 	//+optional
 	RestoreAsOf *string `json:"restoreAsOf,omitempty"`
+	// snapshotID is the ID (full or abbreviated) of the restic snapshot to
+	// restore. When set, restoreAsOf and previous are ignored and the restore
+	// fails if the snapshot does not exist in the repository.
+	//+kubebuilder:validation:Pattern=`^[0-9a-f]{8,64}$`
+	//+optional
+	SnapshotID *string `json:"snapshotID,omitempty"`
 	// enableFileDeletion will pass the --delete flag to the restic restore command.
 	// This will remove files and directories in the pvc that do not exist in the snapshot being restored.
 	// Defaults to false.
 	//+optional
 	EnableFileDeletion bool `json:"enableFileDeletion,omitempty"`
+	// writeProvenance will write a provenance manifest (.volsync-provenance.json)
+	// describing the restored data into the root of the destination volume.
+	// Defaults to false.
+	//+optional
+	WriteProvenance bool `json:"writeProvenance,omitempty"`
+	// filesystemQuotas applies the filesystem project quotas saved with the
+	// restored backup (see the ReplicationSource's restic.filesystemQuotas) to
+	// the destination volume after the restore. It requires a privileged mover.
+	// Defaults to false.
+	//+optional
+	FilesystemQuotas bool `json:"filesystemQuotas,omitempty"`
+	// verifyChecksum verifies the content of the restored files against the
+	// checksums recorded in the repository when they were backed up. The
+	// synchronization fails if the restored data does not match.
+	// Defaults to false.
+	//+optional
+	VerifyChecksum bool `json:"verifyChecksum,omitempty"`
+	// atomicRestore restores into an empty directory on the destination volume
+	// and then moves the restored files into place, so that partially restored
+	// data is never visible in the volume. The volume must have room for a
+	// second copy of the data. Files that are not in the snapshot are removed.
+	// Defaults to false.
+	//+optional
+	AtomicRestore bool `json:"atomicRestore,omitempty"`
+	// skipSizeValidation restores without first checking that the data in
+	// the snapshot fits on the destination volume. The check can refuse a
+	// restore that would fit, for example on a filesystem that compresses the
+	// data. Defaults to false.
+	//+optional
+	SkipSizeValidation bool `json:"skipSizeValidation,omitempty"`
+	// tags limits the snapshots that are considered for the restore to those
+	// that have all of these tags. They can be Go templates referencing
+	// {{ .Namespace }} and {{ .Name }} of the ReplicationDestination and
+	// {{ .PersistentVolumeClaim }} (the destinationPVC).
+	//+kubebuilder:validation:MaxItems=16
+	//+kubebuilder:validation:items:Pattern=`^[^,]+$`
+	//+optional
+	Tags []string `json:"tags,omitempty"`
+	// host limits the snapshots that are considered for the restore to those
+	// of this host name. It can be a template, as with tags. By default,
+	// snapshots of all hosts are considered.
+	//+kubebuilder:validation:MinLength=1
+	//+optional
+	Host *string `json:"host,omitempty"`
+	// repositoryLayout describes how the repository is found. With "Velero",
+	// RESTIC_REPOSITORY in the repository Secret is the location of a Velero
+	// BackupStorageLocation, and the repository is the one Velero keeps there
+	// for the namespace of the ReplicationDestination. Defaults to "Default".
+	//+optional
+	RepositoryLayout ResticRepositoryLayout `json:"repositoryLayout,omitempty"`
 
 	MoverConfig `json:",inline"`
 }
@@ -287,13 +864,68 @@ type ReplicationDestinationStatus struct {
 	// image.
 	//+optional
 	LatestImage *corev1.TypedLocalObjectReference `json:"latestImage,omitempty"`
+	// latestImages lists the images retained by spec.keepLatestImages, newest
+	// (latestImage) first.
+	//+optional
+	LatestImages []RetainedImage `json:"latestImages,omitempty"`
 	// Logs/Summary from latest mover job
 	//+optional
 	LatestMoverStatus *MoverStatus `json:"latestMoverStatus,omitempty"`
+	// history lists the most recent synchronization attempts, newest first.
+	// The number of entries retained is limited by the operator.
+	//+optional
+	History []SyncHistoryEntry `json:"history,omitempty"`
+	// retries records the failed mover Jobs of the current synchronization
+	// (see spec.retryPolicy).
+	//+optional
+	Retries *RetryStatus `json:"retries,omitempty"`
+	// cleanupWarnings lists temporary objects from previous synchronizations
+	// that VolSync was unable to remove.
+	//+optional
+	CleanupWarnings []CleanupWarning `json:"cleanupWarnings,omitempty"`
+	// provenance describes the data that was restored by the most recent
+	// synchronization (for movers that support it).
+	//+optional
+	Provenance *RestoreProvenance `json:"provenance,omitempty"`
+	// verification is the result of verifying the data restored by the most
+	// recent synchronization (see the mover's verifyChecksum option).
+	//+optional
+	Verification *RestoreVerification `json:"verification,omitempty"`
+	// restoreSize is the size of the data restored by the most recent
+	// synchronization, compared with the space on the destination volume (for
+	// movers that support it). It can be used to right-size the volume.
+	//+optional
+	RestoreSize *RestoreSize `json:"restoreSize,omitempty"`
+	// workloadCoordination describes the stopping and restarting of the
+	// workloads using the destination PVC (see spec.workloadCoordination).
+	//+optional
+	WorkloadCoordination *WorkloadCoordinationStatus `json:"workloadCoordination,omitempty"`
+	// capacityForecast reports the usage trend of the destination volume (see
+	// spec.capacityForecast).
+	//+optional
+	CapacityForecast *CapacityForecastStatus `json:"capacityForecast,omitempty"`
+	// security reports the hardening measures that are applied to the mover
+	// (see spec.securityProfile).
+	//+optional
+	Security *SecurityStatus `json:"security,omitempty"`
+	// connectionTest is the result of the most recent connection test
+	// requested by the connectionTest field of the mover.
+	//+optional
+	ConnectionTest *ConnectionTestStatus `json:"connectionTest,omitempty"`
+	// promotion records the PVC created by spec.promote.
+	//+optional
+	Promotion *PromotionStatus `json:"promotion,omitempty"`
+	// standbyValidation is the result of the most recent validation of the
+	// latest image (see spec.standbyValidation).
+	//+optional
+	StandbyValidation *StandbyValidationStatus `json:"standbyValidation,omitempty"`
 	// rsync contains status information for Rsync-based replication.
 	Rsync *ReplicationDestinationRsyncStatus `json:"rsync,omitempty"`
 	// rsyncTLS contains status information for Rsync-based replication over TLS.
 	RsyncTLS *ReplicationDestinationRsyncTLSStatus `json:"rsyncTLS,omitempty"`
+	// block contains status information for diskrsync-based replication of
+	// block mode volumes.
+	Block *ReplicationDestinationBlockStatus `json:"block,omitempty"`
 	// external contains provider-specific status information. For more details,
 	// please see the documentation of the specific replication provider being
 	// used.
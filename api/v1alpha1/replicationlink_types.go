@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This file may be used, at your option, according to either the GNU AGPL 3.0 or
+the Apache V2 license.
+
+---
+This program is free software: you can redistribute it and/or modify it under
+the terms of the GNU Affero General Public License as published by the Free
+Software Foundation, either version 3 of the License, or (at your option) any
+later version.
+
+This program is distributed in the hope that it will be useful, but WITHOUT ANY
+WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A
+PARTICULAR PURPOSE.  See the GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License along
+with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+---
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ConditionLinkHealthy                string = "Healthy"
+	LinkHealthyReasonHealthy            string = "Healthy"
+	LinkHealthyReasonSourceFailing      string = "SourceFailing"
+	LinkHealthyReasonDestinationFailing string = "DestinationFailing"
+	LinkHealthyReasonLagExceeded        string = "LagExceeded"
+	LinkHealthyReasonUnreachable        string = "Unreachable"
+	LinkHealthyReasonInvalidEndpoint    string = "InvalidEndpoint"
+)
+
+// ReplicationLinkEndpoint identifies the ReplicationSource or
+// ReplicationDestination at one end of a ReplicationLink.
+type ReplicationLinkEndpoint struct {
+	// kubeconfigSecretRef is the Secret holding the kubeconfig of the cluster
+	// that has the object. The credentials in it need to be able to get the
+	// object. If not set, the object is in this cluster.
+	//+optional
+	KubeconfigSecretRef   *KubeconfigSecretRef `json:"kubeconfigSecretRef,omitempty"`
+	RemoteObjectReference `json:",inline"`
+}
+
+// ReplicationLinkSpec defines the desired state of ReplicationLink
+type ReplicationLinkSpec struct {
+	// source is the ReplicationSource that sends the data.
+	Source ReplicationLinkEndpoint `json:"source"`
+	// destination is the ReplicationDestination that receives the data.
+	Destination ReplicationLinkEndpoint `json:"destination"`
+	// maxLag is how old the most recent synchronization that completed on
+	// both ends may be before the link is reported as unhealthy. If not set,
+	// the lag is reported but not checked.
+	//+optional
+	MaxLag *metav1.Duration `json:"maxLag,omitempty"`
+	// refreshInterval is how often the status of the two ends is retrieved.
+	// Defaults to 5m.
+	//+optional
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+	// paused can be used to stop retrieving the status of the two ends.
+	//+optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// ReplicationLinkEndpointStatus is the status of one end of a
+// ReplicationLink, as retrieved from its cluster.
+type ReplicationLinkEndpointStatus struct {
+	// reachable indicates whether the object could be retrieved.
+	Reachable bool `json:"reachable"`
+	// message describes why the object could not be retrieved, or the
+	// state of its synchronizations.
+	//+optional
+	Message string `json:"message,omitempty"`
+	// lastSyncTime is the time of the most recent successful synchronization.
+	//+optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+	// lastSyncDuration is how long the most recent successful
+	// synchronization took.
+	//+optional
+	LastSyncDuration *metav1.Duration `json:"lastSyncDuration,omitempty"`
+	// nextSyncTime is when the next synchronization is scheduled to start.
+	//+optional
+	NextSyncTime *metav1.Time `json:"nextSyncTime,omitempty"`
+	// synchronizing is the reason of the Synchronizing condition of the
+	// object (e.g., SyncInProgress or WaitingForSchedule).
+	//+optional
+	Synchronizing string `json:"synchronizing,omitempty"`
+	// latestMoverResult is the result of the most recent mover Job.
+	//+optional
+	LatestMoverResult MoverResult `json:"latestMoverResult,omitempty"`
+}
+
+// ReplicationLinkStatus defines the observed state of ReplicationLink
+type ReplicationLinkStatus struct {
+	// source is the status of the ReplicationSource.
+	//+optional
+	Source *ReplicationLinkEndpointStatus `json:"source,omitempty"`
+	// destination is the status of the ReplicationDestination.
+	//+optional
+	Destination *ReplicationLinkEndpointStatus `json:"destination,omitempty"`
+	// lag is the time since the most recent synchronization that completed
+	// on both ends, i.e. how far the data of the destination may be behind
+	// that of the source.
+	//+optional
+	Lag *metav1.Duration `json:"lag,omitempty"`
+	// lastCheckTime is when the status of the two ends was last retrieved.
+	//+optional
+	LastCheckTime *metav1.Time `json:"lastCheckTime,omitempty"`
+	// conditions represent the latest available observations of the health
+	// of the link.
+	//+optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// A ReplicationLink correlates a ReplicationSource and a
+// ReplicationDestination, which may be in different clusters, and presents
+// their combined health and replication lag in one place.
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Healthy",type="string",JSONPath=`.status.conditions[?(@.type=="Healthy")].status`
+// +kubebuilder:printcolumn:name="Lag",type="string",JSONPath=`.status.lag`
+// +kubebuilder:printcolumn:name="Last check",type="string",format="date-time",JSONPath=`.status.lastCheckTime`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`
+type ReplicationLink struct {
+	metav1.TypeMeta `json:",inline"`
+	//+optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// spec is the desired state of the ReplicationLink.
+	Spec ReplicationLinkSpec `json:"spec,omitempty"`
+	// status is the observed state of the ReplicationLink as determined by
+	// the controller.
+	//+optional
+	Status *ReplicationLinkStatus `json:"status,omitempty"`
+}
+
+// ReplicationLinkList contains a list of ReplicationLink
+// +kubebuilder:object:root=true
+type ReplicationLinkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReplicationLink `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ReplicationLink{}, &ReplicationLinkList{})
+}
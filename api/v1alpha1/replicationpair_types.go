@@ -0,0 +1,217 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This file may be used, at your option, according to either the GNU AGPL 3.0 or
+the Apache V2 license.
+
+---
+This program is free software: you can redistribute it and/or modify it under
+the terms of the GNU Affero General Public License as published by the Free
+Software Foundation, either version 3 of the License, or (at your option) any
+later version.
+
+This program is distributed in the hope that it will be useful, but WITHOUT ANY
+WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A
+PARTICULAR PURPOSE.  See the GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License along
+with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+---
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// Label applied to objects created on behalf of a ReplicationPair. The
+	// value is the name of the pair.
+	ReplicationPairLabel = "volsync.backube/replication-pair"
+
+	ConditionPairReconciled            string = "Reconciled"
+	PairReconciledReasonSuccess        string = "Success"
+	PairReconciledReasonError          string = "Error"
+	PairReconciledReasonWaitingForSync string = "WaitingForSync"
+)
+
+// ReplicationPairRole is the direction of replication for one side of a
+// ReplicationPair.
+// +kubebuilder:validation:Enum=Source;Destination
+type ReplicationPairRole string
+
+const (
+	// The PVC is replicated to the peer by a ReplicationSource
+	ReplicationPairRoleSource ReplicationPairRole = "Source"
+	// The PVC is replicated into from the peer by a ReplicationDestination
+	ReplicationPairRoleDestination ReplicationPairRole = "Destination"
+)
+
+// ReplicationPairVolumeOptions are the volume options that apply in either
+// role.
+type ReplicationPairVolumeOptions struct {
+	// copyMethod describes how a point-in-time (PiT) image of the volume
+	// should be created, both as a source and as a destination.
+	CopyMethod CopyMethodType `json:"copyMethod,omitempty"`
+	// storageClassName can be used to override the StorageClass of the PiT
+	// image.
+	//+optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// volumeSnapshotClassName can be used to specify the VSC to be used if
+	// copyMethod is Snapshot. If not set, the default VSC is used.
+	//+optional
+	VolumeSnapshotClassName *string `json:"volumeSnapshotClassName,omitempty"`
+}
+
+// ReplicationPairRsyncTLSSpec configures replication over rsync-tls between
+// the two sides of the pair.
+type ReplicationPairRsyncTLSSpec struct {
+	ReplicationPairVolumeOptions `json:",inline"`
+	// keySecret is the name of a Secret that contains the TLS pre-shared key.
+	// The same key must exist on both sides, so that either side can connect
+	// to the other after a role change.
+	//+kubebuilder:validation:MinLength=1
+	KeySecret string `json:"keySecret"`
+	// address is the address of the peer's destination. It is used while in
+	// the Source role.
+	//+optional
+	Address *string `json:"address,omitempty"`
+	// port is the port of the peer's destination. It is used while in the
+	// Source role. Defaults to 8000.
+	//+kubebuilder:validation:Minimum=0
+	//+kubebuilder:validation:Maximum=65535
+	//+optional
+	Port *int32 `json:"port,omitempty"`
+	// serviceType determines the Service type that is created while in the
+	// Destination role. Defaults to ClusterIP.
+	//+optional
+	ServiceType *corev1.ServiceType `json:"serviceType,omitempty"`
+}
+
+// ReplicationPairResticSpec configures replication through a restic
+// repository that is shared by the two sides of the pair.
+type ReplicationPairResticSpec struct {
+	ReplicationPairVolumeOptions `json:",inline"`
+	// repository is the name of the Secret holding the restic repository
+	// configuration. The Secret must point to the same repository on both
+	// sides.
+	//+kubebuilder:validation:MinLength=1
+	Repository string `json:"repository"`
+	// retain is the retention policy applied while in the Source role.
+	//+optional
+	Retain *ResticRetainPolicy `json:"retain,omitempty"`
+	// pruneIntervalDays is the interval between prunes of the repository
+	// while in the Source role.
+	//+optional
+	PruneIntervalDays *int32 `json:"pruneIntervalDays,omitempty"`
+}
+
+// ReplicationPairSpec defines the desired state of ReplicationPair
+// +kubebuilder:validation:XValidation:rule="has(self.rsyncTLS) != has(self.restic)",message="exactly one of rsyncTLS or restic must be specified"
+type ReplicationPairSpec struct {
+	// role is the current direction of replication for this side of the
+	// pair. Changing it replaces the ReplicationSource with a
+	// ReplicationDestination (or vice versa) that uses the same PVC and
+	// connection details.
+	Role ReplicationPairRole `json:"role"`
+	// pvcName is the PVC that is replicated: the source volume in the Source
+	// role and the destination volume in the Destination role.
+	//+kubebuilder:validation:MinLength=1
+	PVCName string `json:"pvcName"`
+	// trigger determines when synchronizations are performed. In the
+	// Destination role it is only used by restic.
+	//+optional
+	Trigger *ReplicationSourceTriggerSpec `json:"trigger,omitempty"`
+	// rsyncTLS replicates the PVC with the rsync-tls mover.
+	//+optional
+	RsyncTLS *ReplicationPairRsyncTLSSpec `json:"rsyncTLS,omitempty"`
+	// restic replicates the PVC through a restic repository.
+	//+optional
+	Restic *ReplicationPairResticSpec `json:"restic,omitempty"`
+	// paused is passed on to the ReplicationSource or ReplicationDestination.
+	//+optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// ReplicationPairRsyncTLSStatus is the connection information for the peer
+// while in the Destination role.
+type ReplicationPairRsyncTLSStatus struct {
+	// address is the address that the peer should connect to.
+	//+optional
+	Address *string `json:"address,omitempty"`
+	// port is the port that the peer should connect to.
+	//+optional
+	Port *int32 `json:"port,omitempty"`
+}
+
+// ReplicationPairStatus defines the observed state of ReplicationPair
+type ReplicationPairStatus struct {
+	// role is the role of the ReplicationSource or ReplicationDestination
+	// that currently exists for the pair.
+	//+optional
+	Role ReplicationPairRole `json:"role,omitempty"`
+	// lastRoleChangeTime is the time at which role last changed.
+	//+optional
+	LastRoleChangeTime *metav1.Time `json:"lastRoleChangeTime,omitempty"`
+	// lastSyncTime is the time of the most recent successful synchronization
+	// in the current role.
+	//+optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+	// rsyncTLS contains the address that the peer should use to connect
+	// while this side is in the Destination role.
+	//+optional
+	RsyncTLS *ReplicationPairRsyncTLSStatus `json:"rsyncTLS,omitempty"`
+	// conditions represent the latest available observations of the pair's
+	// state.
+	//+optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// A ReplicationPair is one side of a replication relationship between two
+// clusters. It creates a ReplicationSource or a ReplicationDestination for
+// its PVC, depending on its role, so that the direction of replication can be
+// reversed (e.g., to fail back after a failover) by changing the role on each
+// side.
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Role",type="string",JSONPath=`.spec.role`
+// +kubebuilder:printcolumn:name="Current",type="string",JSONPath=`.status.role`
+// +kubebuilder:printcolumn:name="Last sync",type="string",format="date-time",JSONPath=`.status.lastSyncTime`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`
+type ReplicationPair struct {
+	metav1.TypeMeta `json:",inline"`
+	//+optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// spec is the desired state of the ReplicationPair.
+	Spec ReplicationPairSpec `json:"spec,omitempty"`
+	// status is the observed state of the ReplicationPair as determined by
+	// the controller.
+	//+optional
+	Status *ReplicationPairStatus `json:"status,omitempty"`
+}
+
+// ReplicationPairList contains a list of ReplicationPair
+// +kubebuilder:object:root=true
+type ReplicationPairList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReplicationPair `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ReplicationPair{}, &ReplicationPairList{})
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This file may be used, at your option, according to either the GNU AGPL 3.0 or
+the Apache V2 license.
+
+---
+This program is free software: you can redistribute it and/or modify it under
+the terms of the GNU Affero General Public License as published by the Free
+Software Foundation, either version 3 of the License, or (at your option) any
+later version.
+
+This program is distributed in the hope that it will be useful, but WITHOUT ANY
+WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A
+PARTICULAR PURPOSE.  See the GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License along
+with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+---
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// Label applied to objects created on behalf of a ReplicationPolicy. The
+	// value is the name of the policy.
+	ReplicationPolicyLabel = "volsync.backube/replication-policy"
+
+	ConditionPolicyReconciled      string = "Reconciled"
+	PolicyReconciledReasonSuccess  string = "Success"
+	PolicyReconciledReasonError    string = "Error"
+	PolicyReconciledReasonTemplate string = "InvalidTemplate"
+
+	ConditionPolicySourcesHealthy     string = "SourcesHealthy"
+	PolicySourcesHealthyReasonHealthy string = "AllSourcesHealthy"
+	PolicySourcesHealthyReasonFailing string = "SourcesFailing"
+
+	// Maximum number of failing sources that are listed in the status of a
+	// ReplicationPolicy
+	MaxPolicyFailingSources = 10
+)
+
+// ReplicationPolicyRepositoryTemplate describes a Secret that is used as a
+// template for the per-PVC restic repository Secrets.
+type ReplicationPolicyRepositoryTemplate struct {
+	// name of the template Secret.
+	Name string `json:"name"`
+	// namespace of the template Secret.
+	Namespace string `json:"namespace"`
+}
+
+// ReplicationPolicySourceTemplate is used to generate the ReplicationSources
+// for the PVCs that are selected by a ReplicationPolicy.
+type ReplicationPolicySourceTemplate struct {
+	// labels are added to the generated ReplicationSources.
+	//+optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// annotations are added to the generated ReplicationSources.
+	//+optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// spec of the generated ReplicationSources. The sourcePVC field is ignored
+	// and will be set to the name of each selected PVC.
+	Spec ReplicationSourceSpec `json:"spec"`
+}
+
+// ReplicationPolicySpec defines the desired state of ReplicationPolicy
+type ReplicationPolicySpec struct {
+	// namespaceSelector limits the namespaces that are considered when
+	// selecting PVCs. If not specified, PVCs in all namespaces are considered.
+	//+optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// pvcSelector selects the PersistentVolumeClaims that should be replicated
+	// according to this policy.
+	PVCSelector metav1.LabelSelector `json:"pvcSelector"`
+	// sourceTemplate is the template for the ReplicationSource that will be
+	// created for each selected PVC.
+	SourceTemplate ReplicationPolicySourceTemplate `json:"sourceTemplate"`
+	// repositoryTemplate, when using restic, refers to a Secret whose values
+	// are Go templates. A copy of the Secret, with the templates rendered, is
+	// created in the namespace of each selected PVC and used as the restic
+	// repository. The following fields are available to the templates:
+	// .PolicyName, .Namespace, and .PVCName.
+	//+optional
+	RepositoryTemplate *ReplicationPolicyRepositoryTemplate `json:"repositoryTemplate,omitempty"`
+	// paused can be used to temporarily stop creating, updating, and deleting
+	// ReplicationSources for this policy.
+	//+optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// ReplicationPolicyFailingSource identifies a ReplicationSource managed by a
+// ReplicationPolicy that is reporting an error.
+type ReplicationPolicyFailingSource struct {
+	// name of the ReplicationSource.
+	Name string `json:"name"`
+	// namespace of the ReplicationSource.
+	Namespace string `json:"namespace"`
+	// message describes the error.
+	//+optional
+	Message string `json:"message,omitempty"`
+}
+
+// ReplicationPolicyStatus defines the observed state of ReplicationPolicy
+type ReplicationPolicyStatus struct {
+	// matchedPVCs is the number of PVCs currently selected by the policy.
+	//+optional
+	MatchedPVCs int32 `json:"matchedPVCs,omitempty"`
+	// replicationSources is the number of ReplicationSources currently managed
+	// by the policy.
+	//+optional
+	ReplicationSources int32 `json:"replicationSources,omitempty"`
+	// healthySources is the number of managed ReplicationSources that are not
+	// reporting an error.
+	//+optional
+	HealthySources int32 `json:"healthySources,omitempty"`
+	// failingSources is the number of managed ReplicationSources that are
+	// reporting an error, either from the most recent synchronization attempt
+	// or from the most recent mover Job.
+	//+optional
+	FailingSources int32 `json:"failingSources,omitempty"`
+	// failing lists (up to 10 of) the managed ReplicationSources that are
+	// reporting an error.
+	//+optional
+	Failing []ReplicationPolicyFailingSource `json:"failing,omitempty"`
+	// lastReconcileTime is the time the policy was last reconciled.
+	//+optional
+	LastReconcileTime *metav1.Time `json:"lastReconcileTime,omitempty"`
+	// conditions represent the latest available observations of the
+	// policy's state.
+	//+optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// A ReplicationPolicy is a cluster-scoped VolSync resource that creates and
+// manages a ReplicationSource for each PVC that matches its selectors.
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="PVCs",type="integer",JSONPath=`.status.matchedPVCs`
+// +kubebuilder:printcolumn:name="Sources",type="integer",JSONPath=`.status.replicationSources`
+// +kubebuilder:printcolumn:name="Failing",type="integer",JSONPath=`.status.failingSources`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`
+type ReplicationPolicy struct {
+	metav1.TypeMeta `json:",inline"`
+	//+optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// spec is the desired state of the ReplicationPolicy.
+	Spec ReplicationPolicySpec `json:"spec,omitempty"`
+	// status is the observed state of the ReplicationPolicy as determined by
+	// the controller.
+	//+optional
+	Status *ReplicationPolicyStatus `json:"status,omitempty"`
+}
+
+// ReplicationPolicyList contains a list of ReplicationPolicy
+// +kubebuilder:object:root=true
+type ReplicationPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReplicationPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ReplicationPolicy{}, &ReplicationPolicyList{})
+}
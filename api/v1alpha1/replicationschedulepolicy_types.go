@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This file may be used, at your option, according to either the GNU AGPL 3.0 or
+the Apache V2 license.
+
+---
+This program is free software: you can redistribute it and/or modify it under
+the terms of the GNU Affero General Public License as published by the Free
+Software Foundation, either version 3 of the License, or (at your option) any
+later version.
+
+This program is distributed in the hope that it will be useful, but WITHOUT ANY
+WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A
+PARTICULAR PURPOSE.  See the GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License along
+with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+---
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScheduleWindow is a recurring period of time.
+type ScheduleWindow struct {
+	// start is a cronspec (e.g., "0 22 * * *") for the times at which the
+	// window opens.
+	// nolint:lll
+	//+kubebuilder:validation:Pattern=`^(@(annually|yearly|monthly|weekly|daily|hourly))|((((\d+,)*\d+|(\d+(\/|-)\d+)|\*(\/\d+)?)\s?){5})$`
+	Start string `json:"start"`
+	// duration is how long the window stays open after each start.
+	Duration metav1.Duration `json:"duration"`
+}
+
+// ScheduleFreeze is a one-time period of time, such as a maintenance freeze.
+type ScheduleFreeze struct {
+	// start is the time at which the freeze begins.
+	Start metav1.Time `json:"start"`
+	// end is the time at which the freeze is over.
+	End metav1.Time `json:"end"`
+}
+
+// ReplicationSchedulePolicySpec defines when the ReplicationSources that
+// reference the policy may start synchronizing.
+type ReplicationSchedulePolicySpec struct {
+	// timeZone is the name of the IANA time zone (e.g., "America/New_York")
+	// that the windows are interpreted in. Defaults to the time zone of the
+	// operator (normally UTC).
+	//+kubebuilder:validation:MinLength=1
+	//+optional
+	TimeZone *string `json:"timeZone,omitempty"`
+	// allowedWindows are the only periods in which synchronizations may
+	// start. If empty, synchronizations may start at any time outside of the
+	// blackouts and freezes.
+	//+optional
+	AllowedWindows []ScheduleWindow `json:"allowedWindows,omitempty"`
+	// blackouts are recurring periods in which synchronizations may not
+	// start (e.g., a month-end close).
+	//+optional
+	Blackouts []ScheduleWindow `json:"blackouts,omitempty"`
+	// freezes are one-time periods in which synchronizations may not start.
+	//+optional
+	Freezes []ScheduleFreeze `json:"freezes,omitempty"`
+}
+
+// A ReplicationSchedulePolicy restricts when the ReplicationSources in its
+// namespace that reference it (via spec.schedulePolicy) may start
+// synchronizing. Synchronizations that come due outside of its allowed
+// windows, or during a blackout or freeze, are put off until the next time
+// they are permitted.
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Time zone",type="string",JSONPath=`.spec.timeZone`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`
+type ReplicationSchedulePolicy struct {
+	metav1.TypeMeta `json:",inline"`
+	//+optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// spec is the desired state of the ReplicationSchedulePolicy.
+	Spec ReplicationSchedulePolicySpec `json:"spec,omitempty"`
+}
+
+// ReplicationSchedulePolicyList contains a list of ReplicationSchedulePolicy
+// +kubebuilder:object:root=true
+type ReplicationSchedulePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReplicationSchedulePolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ReplicationSchedulePolicy{}, &ReplicationSchedulePolicyList{})
+}
@@ -50,6 +50,12 @@ type ReplicationSourceTriggerSpec struct {
 	//+kubebuilder:validation:Pattern=`^(@(annually|yearly|monthly|weekly|daily|hourly))|((((\d+,)*\d+|(\d+(\/|-)\d+)|\*(\/\d+)?)\s?){5})$`
 	//+optional
 	Schedule *string `json:"schedule,omitempty"`
+	// timeZone is the IANA name of the time zone (e.g., "America/New_York")
+	// that the schedule is interpreted in. Defaults to the time zone of the
+	// operator (normally UTC).
+	//+kubebuilder:validation:MinLength=1
+	//+optional
+	TimeZone *string `json:"timeZone,omitempty"`
 	// manual is a string value that schedules a manual trigger.
 	// Once a sync completes then status.lastManualSync is set to the same string value.
 	// A consumer of a manual trigger should set spec.trigger.manual to a known value
@@ -60,6 +66,168 @@ type ReplicationSourceTriggerSpec struct {
 	Manual string `json:"manual,omitempty"`
 }
 
+// ReplicationSourceHooksSpec defines actions that are run around the creation
+// of the point-in-time copy (clone or snapshot) of the source volume.
+type ReplicationSourceHooksSpec struct {
+	// preSync is run before the clone or snapshot of the source volume is
+	// created. The copy is only taken once the hook has completed
+	// successfully.
+	//+optional
+	PreSync *SyncHook `json:"preSync,omitempty"`
+	// postSync is run once the clone or snapshot of the source volume is
+	// ready.
+	//+optional
+	PostSync *SyncHook `json:"postSync,omitempty"`
+}
+
+// SyncHook defines a single hook action. Exactly one of exec or job must be
+// specified.
+type SyncHook struct {
+	// exec runs a command in the pods selected by a label selector.
+	//+optional
+	Exec *ExecHook `json:"exec,omitempty"`
+	// job runs a Job to completion.
+	//+optional
+	Job *JobHook `json:"job,omitempty"`
+	// timeout is the maximum amount of time the hook may take before it is
+	// considered to have failed. Defaults to 5m.
+	//+optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// ExecHook runs a command in running application pods.
+type ExecHook struct {
+	// selector selects the pods, in the namespace of the ReplicationSource, in
+	// which the command is run. At least one running pod must match.
+	Selector metav1.LabelSelector `json:"selector"`
+	// container is the name of the container in which to run the command. If
+	// not specified, the first container of the pod is used.
+	//+optional
+	Container string `json:"container,omitempty"`
+	// command is the command (and its arguments) to run.
+	//+kubebuilder:validation:MinItems=1
+	Command []string `json:"command"`
+}
+
+// JobHook runs a Job in the namespace of the ReplicationSource.
+type JobHook struct {
+	// image is the container image to run.
+	Image string `json:"image"`
+	// command is the entrypoint of the container. If not specified, the
+	// image's default entrypoint is used.
+	//+optional
+	Command []string `json:"command,omitempty"`
+	// args are the arguments to the entrypoint.
+	//+optional
+	Args []string `json:"args,omitempty"`
+	// serviceAccountName is the name of the ServiceAccount the Job runs as.
+	// If not specified, the namespace's default ServiceAccount is used.
+	//+optional
+	ServiceAccountName *string `json:"serviceAccountName,omitempty"`
+}
+
+// ReplicationSourceIntermediateRetentionSpec determines how long the
+// snapshots of the source volume that VolSync takes for each synchronization
+// are kept once the synchronization has completed.
+// +kubebuilder:validation:XValidation:rule="has(self.duration) || has(self.count)",message="at least one of duration or count must be set"
+type ReplicationSourceIntermediateRetentionSpec struct {
+	// duration is how long each snapshot is kept after it was taken. If
+	// omitted, snapshots are only pruned based on count.
+	//+optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+	// count is the maximum number of snapshots to keep. The most recent
+	// snapshots are kept. If omitted, snapshots are only pruned based on
+	// duration.
+	//+kubebuilder:validation:Minimum=1
+	//+optional
+	Count *int32 `json:"count,omitempty"`
+}
+
+// ReplicationSourceCopyTriggerSpec allows an external controller to determine
+// when the point-in-time copy (clone or snapshot) of the source volume is
+// taken.
+type ReplicationSourceCopyTriggerSpec struct {
+	// trigger is an opaque value set by the coordinating controller. When a
+	// synchronization needs a copy of the source volume, VolSync waits until
+	// trigger differs from status.copyTrigger.latestTrigger before taking the
+	// copy.
+	//+optional
+	Trigger string `json:"trigger,omitempty"`
+}
+
+// ReplicationSourceIOGateSpec puts off synchronizations while the source volume
+// is busy, based on a Prometheus query.
+type ReplicationSourceIOGateSpec struct {
+	// prometheus is the query that measures the IO activity of the source
+	// volume.
+	Prometheus IOGatePrometheusSpec `json:"prometheus"`
+	// threshold is the value of the query below which a synchronization is
+	// started. For example, "20Mi" for a query that returns bytes per second.
+	Threshold resource.Quantity `json:"threshold"`
+	// maxDelay is the longest a synchronization is put off for. Once it has
+	// elapsed, the synchronization starts regardless of the IO activity.
+	// Defaults to 1h.
+	//+optional
+	MaxDelay *metav1.Duration `json:"maxDelay,omitempty"`
+	// checkInterval is how often the query is evaluated while a
+	// synchronization is put off. Defaults to 1m.
+	//+optional
+	CheckInterval *metav1.Duration `json:"checkInterval,omitempty"`
+}
+
+// IOGatePrometheusSpec is a Prometheus instant query
+type IOGatePrometheusSpec struct {
+	// url is the base URL of the Prometheus API, e.g.
+	// http://prometheus.monitoring.svc:9090
+	//+kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url"`
+	// query is the PromQL query, a Go template that can reference
+	// {{ .Namespace }} and {{ .PersistentVolumeClaim }} of the source PVC. The
+	// largest value of the result is compared with the threshold, and an
+	// empty result is treated as 0.
+	//+kubebuilder:validation:MinLength=1
+	Query string `json:"query"`
+	// authSecret is the name of a Secret in the same namespace. Its "token" key
+	// is sent as a bearer token in the Authorization header.
+	//+optional
+	AuthSecret *string `json:"authSecret,omitempty"`
+}
+
+// ReplicationSourceIOGateStatus reports the state of spec.ioGate
+type ReplicationSourceIOGateStatus struct {
+	// deferredSince is the time the synchronization that is due was first put
+	// off, if it is currently being put off.
+	//+optional
+	DeferredSince *metav1.Time `json:"deferredSince,omitempty"`
+	// lastValue is the result of the most recent evaluation of the query.
+	//+optional
+	LastValue string `json:"lastValue,omitempty"`
+	// lastCheckTime is the time of the most recent evaluation of the query.
+	//+optional
+	LastCheckTime *metav1.Time `json:"lastCheckTime,omitempty"`
+}
+
+// ReplicationSourceCopyTriggerStatus reports the progress of copies that are
+// coordinated via spec.copyTrigger.
+type ReplicationSourceCopyTriggerStatus struct {
+	// latestTrigger is the value of spec.copyTrigger.trigger for which the
+	// most recent copy of the source volume was completed.
+	//+optional
+	LatestTrigger string `json:"latestTrigger,omitempty"`
+	// waitingSince is the time at which VolSync started waiting for a new
+	// trigger value.
+	//+optional
+	WaitingSince *metav1.Time `json:"waitingSince,omitempty"`
+}
+
+const (
+	ConditionCopyTriggered          string = "CopyTriggered"
+	CopyTriggeredReasonWaiting      string = "WaitingForTrigger"
+	CopyTriggeredReasonTimeout      string = "TriggerTimeout"
+	CopyTriggeredReasonInProgress   string = "CopyInProgress"
+	CopyTriggeredReasonCopyComplete string = "CopyCompleted"
+)
+
 // ReplicationSourceExternalSpec defines the configuration when using an
 // external replication provider.
 type ReplicationSourceExternalSpec struct {
@@ -91,6 +259,32 @@ type ReplicationSourceVolumeOptions struct {
 	// copyMethod is Snapshot. If not set, the default VSC is used.
 	//+optional
 	VolumeSnapshotClassName *string `json:"volumeSnapshotClassName,omitempty"`
+	// copyMethodFallback makes a copyMethod of Clone fall back to Snapshot
+	// when the volume can not be cloned: the storage does not support cloning,
+	// or the clone is not provisioned within a few minutes. The method that was
+	// used is reported in status.lastCopyMethod.
+	//+optional
+	CopyMethodFallback bool `json:"copyMethodFallback,omitempty"`
+}
+
+// RsyncProxyJumpSpec describes an SSH jump host for the rsync mover.
+type RsyncProxyJumpSpec struct {
+	// host is the name or IP address of the jump host.
+	//+kubebuilder:validation:MinLength=1
+	Host string `json:"host"`
+	// port is the SSH port of the jump host. Defaults to 22.
+	//+kubebuilder:validation:Minimum=1
+	//+kubebuilder:validation:Maximum=65535
+	//+optional
+	Port *int32 `json:"port,omitempty"`
+	// sshUser is the username on the jump host. Defaults to "root".
+	//+optional
+	SSHUser *string `json:"sshUser,omitempty"`
+	// sshKeys is the name of a Secret that contains the private key used to
+	// authenticate to the jump host ("proxy") and the jump host's public host
+	// key ("proxy-host.pub").
+	//+kubebuilder:validation:MinLength=1
+	SSHKeys string `json:"sshKeys"`
 }
 
 type ReplicationSourceRsyncSpec struct {
@@ -103,7 +297,10 @@ type ReplicationSourceRsyncSpec struct {
 	// SSH connections.
 	//+optional
 	ServiceType *corev1.ServiceType `json:"serviceType,omitempty"`
-	// address is the remote address to connect to for replication.
+	// address is the remote host (name or IP address) to connect to for
+	// replication. IPv6 addresses may be given with or without brackets.
+	// Including the port in the address (e.g., "[fd00::1]:2222") is deprecated
+	// and port should be used instead.
 	//+optional
 	Address *string `json:"address,omitempty"`
 	// port is the SSH port to connect to for replication. Defaults to 22.
@@ -111,22 +308,49 @@ type ReplicationSourceRsyncSpec struct {
 	//+kubebuilder:validation:Maximum=65535
 	//+optional
 	Port *int32 `json:"port,omitempty"`
+	// proxyJump is an SSH jump host through which the connection to address
+	// is made.
+	//+optional
+	ProxyJump *RsyncProxyJumpSpec `json:"proxyJump,omitempty"`
 	// path is the remote path to rsync to. Defaults to "/"
 	//+optional
 	Path *string `json:"path,omitempty"`
 	// sshUser is the username for outgoing SSH connections. Defaults to "root".
 	//+optional
 	SSHUser *string `json:"sshUser,omitempty"`
+	// sparse enables efficient handling of sparse and preallocated files. In
+	// addition to preserving holes, files are preallocated on the destination
+	// and the destination filesystem is checked for sparse file support.
+	// Defaults to "false".
+	//+optional
+	Sparse bool `json:"sparse,omitempty"`
+	// extraOptions are added to the rsync command of the mover. Only the
+	// following options are permitted: --bwlimit=RATE, --compress-level=N,
+	// --timeout=SECONDS, --partial, --inplace, --append-verify, --whole-file,
+	// --checksum, and --numeric-ids.
+	//+kubebuilder:validation:MaxItems=16
+	//+kubebuilder:validation:items:Pattern=`^--(bwlimit=[0-9]+(\.[0-9]+)?[KMGkmg]?|compress-level=[0-9]|timeout=[0-9]+|partial|inplace|append-verify|whole-file|checksum|numeric-ids)$`
+	//+optional
+	ExtraOptions []string `json:"extraOptions,omitempty"`
 	// MoverServiceAccount allows specifying the name of the service account
 	// that will be used by the data mover. This should only be used by advanced
 	// users who want to override the service account normally used by the mover.
 	// The service account needs to exist in the same namespace as the ReplicationSource.
 	//+optional
 	MoverServiceAccount *string `json:"moverServiceAccount,omitempty"`
+	// MoverImage overrides the container image of the data mover for this
+	// object. The image must be permitted by the operator's
+	// --allowed-mover-images flag.
+	//+optional
+	MoverImage *string `json:"moverImage,omitempty"`
 	// Labels that should be added to data mover pods
 	// These will be in addition to any labels that VolSync may add
 	// +optional
 	MoverPodLabels map[string]string `json:"moverPodLabels,omitempty"`
+	// Annotations that should be added to data mover pods
+	// These will be in addition to any annotations that VolSync may add
+	// +optional
+	MoverPodAnnotations map[string]string `json:"moverPodAnnotations,omitempty"`
 	// Resources represents compute resources required by the data mover container.
 	// Immutable.
 	// More info: https://kubernetes.io/docs/concepts/configuration/manage-compute-resources-container/
@@ -134,9 +358,39 @@ type ReplicationSourceRsyncSpec struct {
 	// pod being unschedulable or crashing due to limited resources.
 	// +optional
 	MoverResources *corev1.ResourceRequirements `json:"moverResources,omitempty"`
+	// MoverPriorityClassName is the name of the PriorityClass of the data
+	// mover pods. A low priority allows the movers to be preempted by
+	// production workloads.
+	//+optional
+	MoverPriorityClassName *string `json:"moverPriorityClassName,omitempty"`
+	// MoverSchedulerName is the name of the scheduler that schedules the data
+	// mover pods. By default, the cluster's default scheduler is used.
+	//+optional
+	MoverSchedulerName *string `json:"moverSchedulerName,omitempty"`
+	// MoverEnv are additional environment variables for the data mover
+	// containers. Values may come from Secrets, ConfigMaps, or the pod's
+	// fields. Variables that VolSync sets take precedence.
+	//+kubebuilder:validation:MaxItems=64
+	//+optional
+	MoverEnv []corev1.EnvVar `json:"moverEnv,omitempty"`
+	// MoverServiceAccountTokens are tokens of the data mover's service
+	// account, for the given audiences, that are projected into the data
+	// mover containers under /var/run/secrets/volsync/tokens.
+	//+kubebuilder:validation:MaxItems=8
+	//+listType=map
+	//+listMapKey=name
+	//+optional
+	MoverServiceAccountTokens []MoverServiceAccountToken `json:"moverServiceAccountTokens,omitempty"`
+	// MoverTimeout is the longest a mover Job may run. A Job that runs longer
+	// is deleted and recreated after a delay that doubles with each
+	// consecutive timeout (from 1 minute up to 1 hour), and the SyncTimedOut
+	// condition is set. By default, mover Jobs may run indefinitely.
+	//+optional
+	MoverTimeout *metav1.Duration `json:"moverTimeout,omitempty"`
 }
 
 // ReplicationSourceRcloneSpec defines the field for rclone in replicationSource.
+// +kubebuilder:validation:XValidation:rule="!has(self.remote) || (!has(self.rcloneConfig) && !has(self.rcloneConfigSection))",message="remote cannot be combined with rcloneConfig or rcloneConfigSection"
 type ReplicationSourceRcloneSpec struct {
 	ReplicationSourceVolumeOptions `json:",inline"`
 	//RcloneConfigSection is the section in rclone_config file to use for the current job.
@@ -145,8 +399,42 @@ type ReplicationSourceRcloneSpec struct {
 	RcloneDestPath *string `json:"rcloneDestPath,omitempty"`
 	// RcloneConfig is the rclone secret name
 	RcloneConfig *string `json:"rcloneConfig,omitempty"`
+	// rcloneSourcePath is a remote path to sync from in place of the
+	// sourcePVC. The mover then copies the data from this path to
+	// rcloneDestPath without mounting a volume, using server-side copies when
+	// both paths are on the same remote.
+	//+optional
+	RcloneSourcePath *string `json:"rcloneSourcePath,omitempty"`
+	// rcloneSourceConfigSection is the section in the rclone config file of the
+	// remote that rcloneSourcePath is on. Defaults to rcloneConfigSection.
+	//+optional
+	RcloneSourceConfigSection *string `json:"rcloneSourceConfigSection,omitempty"`
+	// remote describes the rclone remote using typed fields. VolSync
+	// generates the rclone configuration from it, so rcloneConfig and
+	// rcloneConfigSection must not be set.
+	//+optional
+	Remote *RcloneRemoteSpec `json:"remote,omitempty"`
+	// connectionTest is a string value that requests a read-only check of the
+	// connection to the remote. A short Job runs `rclone lsd` with
+	// the configured credentials, independently of the synchronizations, and
+	// the result is recorded in status.connectionTest. The check runs again
+	// only when connectionTest is set to a different value.
+	//+optional
+	ConnectionTest string `json:"connectionTest,omitempty"`
+	// parallelism is the number of files that the mover transfers
+	// concurrently (--transfers), with twice as many checkers. Defaults to 10
+	// transfers and 8 checkers.
+	//+kubebuilder:validation:Minimum=1
+	//+kubebuilder:validation:Maximum=64
+	//+optional
+	Parallelism *int32 `json:"parallelism,omitempty"`
 	// customCA is a custom CA that will be used to verify the remote
 	CustomCA CustomCASpec `json:"customCA,omitempty"`
+	// moverOS is the operating system of the nodes that the mover runs on.
+	// Set to "windows" to synchronize PVCs that can only be mounted on Windows
+	// nodes (e.g., SMB/CIFS-backed volumes). Defaults to "linux".
+	//+optional
+	MoverOS MoverOS `json:"moverOS,omitempty"`
 
 	MoverConfig `json:",inline"`
 }
@@ -174,17 +462,51 @@ type ResticRetainPolicy struct {
 	// Last defines the number of snapshots to be kept
 	//+optional
 	Last *string `json:"last,omitempty"`
+	// groupBy is the restic --group-by option of forget: the retention is
+	// applied separately to each group of snapshots with the same host, paths
+	// and/or tags. Defaults to restic's default of "host,paths".
+	//+kubebuilder:validation:Pattern=`^(host|paths|tags)(,(host|paths|tags))*$`
+	//+optional
+	GroupBy *string `json:"groupBy,omitempty"`
 }
 
 type ReplicationSourceResticCA CustomCASpec
 
 // ReplicationSourceResticSpec defines the field for restic in replicationSource.
+// +kubebuilder:validation:XValidation:rule="!has(self.detectBitRot) || !self.detectBitRot || !has(self.cacheType) || self.cacheType == 'PVC'",message="detectBitRot requires a cacheType of PVC to keep the checksums between synchronizations"
 type ReplicationSourceResticSpec struct {
 	ReplicationSourceVolumeOptions `json:",inline"`
 	// PruneIntervalDays define how often to prune the repository
 	PruneIntervalDays *int32 `json:"pruneIntervalDays,omitempty"`
 	// Repository is the secret name containing repository info
 	Repository string `json:"repository,omitempty"`
+	// repositorySecretRef mounts the repository credentials into the mover
+	// from an external secret store instead of the repository Secret. Each
+	// credential must be a file named after the restic environment variable
+	// (e.g., RESTIC_REPOSITORY, RESTIC_PASSWORD). When set, repository is
+	// ignored.
+	//+optional
+	RepositorySecretRef *MoverSecretRef `json:"repositorySecretRef,omitempty"`
+	// ensureRepository runs a separate, short Job before each synchronization
+	// that initializes the repository if it does not exist and verifies that
+	// it can be accessed with the provided credentials. The result is reported
+	// in the RepositoryReady condition.
+	//+optional
+	EnsureRepository bool `json:"ensureRepository,omitempty"`
+	// connectionTest is a string value that requests a read-only check of the
+	// connection to the repository. A short Job runs `restic cat config` with
+	// the configured credentials, independently of the synchronizations, and
+	// the result is recorded in status.connectionTest. The check runs again
+	// only when connectionTest is set to a different value.
+	//+optional
+	ConnectionTest string `json:"connectionTest,omitempty"`
+	// parallelism is the number of concurrent connections to the repository
+	// backend. Backups also read this many files concurrently. By default,
+	// restic uses 5 connections and reads 2 files at a time.
+	//+kubebuilder:validation:Minimum=1
+	//+kubebuilder:validation:Maximum=64
+	//+optional
+	Parallelism *int32 `json:"parallelism,omitempty"`
 	// customCA is a custom CA that will be used to verify the remote
 	CustomCA ReplicationSourceResticCA `json:"customCA,omitempty"`
 	// ResticRetainPolicy define the retain policy
@@ -200,6 +522,17 @@ type ReplicationSourceResticSpec struct {
 	// CacheAccessModes can be used to set the accessModes of restic metadata cache volume
 	//+optional
 	CacheAccessModes []corev1.PersistentVolumeAccessMode `json:"cacheAccessModes,omitempty"`
+	// cacheType is the kind of volume used for the restic metadata cache: a
+	// PVC that is kept between synchronizations, a generic ephemeral volume,
+	// or an emptyDir (limited to cacheCapacity). Ephemeral caches are rebuilt
+	// by each synchronization. Defaults to PVC.
+	//+optional
+	CacheType *ResticCacheType `json:"cacheType,omitempty"`
+	// cacheCleanupPolicy limits the growth of the restic metadata cache. The
+	// policy is applied by the mover before each backup, and the space used by
+	// the cache is reported in status.restic.cacheUsage.
+	//+optional
+	CacheCleanupPolicy *ResticCacheCleanupPolicy `json:"cacheCleanupPolicy,omitempty"`
 	// unlock is a string value that schedules an unlock on the restic repository during
 	// the next sync operation.
 	// Once a sync completes then status.restic.lastUnlocked is set to the same string value.
@@ -209,10 +542,163 @@ type ReplicationSourceResticSpec struct {
 	// then ran a backup.
 	// Unlock will not be run again unless spec.restic.unlock is set to a different value.
 	Unlock string `json:"unlock,omitempty"`
+	// automaticUnlock allows the mover to recover from a backup that fails
+	// because the repository is locked. If every lock on the repository is
+	// older than staleLockAge, the locks are removed and the backup is retried
+	// within the same sync. The most recent automatic unlock is recorded in
+	// status.restic.lastAutomaticUnlock.
+	//+optional
+	AutomaticUnlock *ResticAutomaticUnlockSpec `json:"automaticUnlock,omitempty"`
+	// keepCopyPointSnapshot, when set, preserves the VolumeSnapshot of the source
+	// PVC taken for each backup (copyMethod: Snapshot) after the backup has
+	// completed successfully, providing a local restore point in addition to the
+	// copy in the restic repository. The value is the number of these snapshots
+	// to retain; older ones are deleted.
+	//+kubebuilder:validation:Minimum=1
+	//+optional
+	KeepCopyPointSnapshot *int32 `json:"keepCopyPointSnapshot,omitempty"`
+	// objectLock sets the immutability (S3 object lock) requirements of the
+	// repository. The state of the repository bucket is reported in the
+	// Immutable condition.
+	//+optional
+	ObjectLock *ResticObjectLockSpec `json:"objectLock,omitempty"`
+	// changePassword is the name of a Secret whose NEW_PASSWORD key holds a new
+	// password for the repository. During the next sync, the new password is
+	// added as a repository key and the key of the current password is removed.
+	// Once status.restic.lastPasswordChange.secretName matches, subsequent
+	// syncs use the new password from this Secret.
+	//+optional
+	ChangePassword string `json:"changePassword,omitempty"`
+	// filesystemQuotas saves the filesystem project quotas (XFS or ext4
+	// project IDs and limits) of the source volume in the repository with each
+	// backup, so they can be re-established on restore. It requires a
+	// privileged mover. Defaults to false.
+	//+optional
+	FilesystemQuotas bool `json:"filesystemQuotas,omitempty"`
+	// detectBitRot maintains a database of file checksums on the cache volume.
+	// Before each backup, files whose modification time and size are unchanged
+	// since the previous sync are checked against it. If the contents of any
+	// have changed (indicating silent corruption), the backup is not made and
+	// the files are reported in status.restic.suspectedCorruptFiles.
+	// The database is lost with each synchronization on an ephemeral cache,
+	// so this requires a cacheType of PVC. Defaults to false.
+	//+optional
+	DetectBitRot bool `json:"detectBitRot,omitempty"`
+	// integrityManifest saves a manifest of the SHA-256 digest of every file
+	// in each backup into the repository, alongside the backup, as evidence
+	// of exactly what was captured. The manifest of the most recent backup is
+	// referenced from status.restic.lastIntegrityManifest. Defaults to false.
+	//+optional
+	IntegrityManifest bool `json:"integrityManifest,omitempty"`
+	// repositoryStats collects statistics of the repository after each backup
+	// (restic stats) and reports them in status.restic.repositoryStats and as
+	// metrics. This reads the metadata of every snapshot in the repository,
+	// so it adds to the time of each backup. Defaults to false.
+	//+optional
+	RepositoryStats bool `json:"repositoryStats,omitempty"`
+	// tags are added to each backup in addition to the tags VolSync uses
+	// itself. They can be Go templates referencing {{ .Namespace }} and
+	// {{ .Name }} of the ReplicationSource and {{ .PersistentVolumeClaim }}
+	// (the sourcePVC). When set, the retain policy only applies to the
+	// snapshots that have all of these tags, so that sources sharing a
+	// repository do not prune each other's backups.
+	//+kubebuilder:validation:MaxItems=16
+	//+kubebuilder:validation:items:Pattern=`^[^,]+$`
+	//+optional
+	Tags []string `json:"tags,omitempty"`
+	// host is the host name recorded in each backup. The retain policy only
+	// applies to the snapshots of this host. It can be a template, as with
+	// tags. Defaults to "volsync".
+	//+kubebuilder:validation:MinLength=1
+	//+optional
+	Host *string `json:"host,omitempty"`
+	// repositoryLayout describes how the repository is found. With "Velero",
+	// RESTIC_REPOSITORY in the repository Secret is the location of a Velero
+	// BackupStorageLocation, and the repository is the one Velero keeps there
+	// for the namespace of the ReplicationSource. Defaults to "Default", where
+	// RESTIC_REPOSITORY is the repository itself.
+	//+optional
+	RepositoryLayout ResticRepositoryLayout `json:"repositoryLayout,omitempty"`
 
 	MoverConfig `json:",inline"`
 }
 
+// ResticCacheType is the kind of volume that holds the restic metadata cache
+// +kubebuilder:validation:Enum=PVC;Ephemeral;EmptyDir
+type ResticCacheType string
+
+const (
+	// The cache is kept in a PVC that persists between synchronizations
+	ResticCacheTypePVC ResticCacheType = "PVC"
+	// The cache is kept in a generic ephemeral volume that is provisioned
+	// for, and deleted with, each mover Pod
+	ResticCacheTypeEphemeral ResticCacheType = "Ephemeral"
+	// The cache is kept in an emptyDir volume on the node
+	ResticCacheTypeEmptyDir ResticCacheType = "EmptyDir"
+)
+
+// ResticRepositoryLayout describes where a restic repository is found relative
+// to the RESTIC_REPOSITORY of the repository Secret
+// +kubebuilder:validation:Enum=Default;Velero
+type ResticRepositoryLayout string
+
+const (
+	// RESTIC_REPOSITORY is the repository
+	ResticRepositoryLayoutDefault ResticRepositoryLayout = "Default"
+	// RESTIC_REPOSITORY is the location of a Velero BackupStorageLocation and
+	// the repository is the one Velero uses for the namespace
+	// (<location>/restic/<namespace>)
+	ResticRepositoryLayoutVelero ResticRepositoryLayout = "Velero"
+)
+
+// ResticCacheCleanupPolicy describes how the restic metadata cache is pruned
+type ResticCacheCleanupPolicy struct {
+	// maxAgeDays removes cache directories of repositories that have not been
+	// used for this many days (restic cache --cleanup --max-age).
+	//+kubebuilder:validation:Minimum=1
+	//+optional
+	MaxAgeDays *int32 `json:"maxAgeDays,omitempty"`
+	// maxSize is the largest the cache may be before a backup. If the cache is
+	// larger, its contents are removed and restic rebuilds it as needed.
+	//+optional
+	MaxSize *resource.Quantity `json:"maxSize,omitempty"`
+}
+
+// ResticAutomaticUnlockSpec configures the automatic removal of stale
+// repository locks
+type ResticAutomaticUnlockSpec struct {
+	// staleLockAge is how old a lock must be before it is considered stale.
+	// Restic refreshes the locks of running operations every 5 minutes, so
+	// values below 30 minutes risk removing the lock of a live operation.
+	// Defaults to 1 hour.
+	//+optional
+	StaleLockAge *metav1.Duration `json:"staleLockAge,omitempty"`
+}
+
+// ResticObjectLockSpec describes the immutability requirements of an S3 restic
+// repository
+type ResticObjectLockSpec struct {
+	// required prevents backups from running unless object lock is enabled on
+	// the repository bucket.
+	//+optional
+	Required bool `json:"required,omitempty"`
+	// minRetentionDays is the minimum default retention period (in days) that
+	// the object lock configuration of the repository bucket must apply to new
+	// objects. Backups are not run if object lock is not enabled or the
+	// default retention is shorter.
+	//+kubebuilder:validation:Minimum=1
+	//+optional
+	MinRetentionDays *int32 `json:"minRetentionDays,omitempty"`
+}
+
+const (
+	ConditionImmutable               string = "Immutable"
+	ImmutableReasonEnabled           string = "ObjectLockEnabled"
+	ImmutableReasonDisabled          string = "ObjectLockDisabled"
+	ImmutableReasonRetentionTooShort string = "RetentionTooShort"
+	ImmutableReasonCheckFailed       string = "CheckFailed"
+)
+
 // ReplicationSourceResticStatus defines the field for ReplicationSourceStatus in ReplicationSourceStatus
 type ReplicationSourceResticStatus struct {
 	// lastPruned in the object holding the time of last pruned
@@ -222,6 +708,131 @@ type ReplicationSourceResticStatus struct {
 	// restic repository.
 	//+optional
 	LastUnlocked string `json:"lastUnlocked,omitempty"`
+	// lastAutomaticUnlock records the most recent removal of stale locks by
+	// spec.restic.automaticUnlock.
+	//+optional
+	LastAutomaticUnlock *ResticAutomaticUnlockStatus `json:"lastAutomaticUnlock,omitempty"`
+	// objectLock is the object lock configuration of the repository bucket, if
+	// spec.restic.objectLock is set.
+	//+optional
+	ObjectLock *ResticObjectLockStatus `json:"objectLock,omitempty"`
+	// lastPasswordChange records the most recent change of the repository
+	// password requested via spec.restic.changePassword.
+	//+optional
+	LastPasswordChange *ResticPasswordChangeStatus `json:"lastPasswordChange,omitempty"`
+	// suspectedCorruptFiles lists (up to 20 of) the files that were found to
+	// have changed without their modification time changing when
+	// spec.restic.detectBitRot is set.
+	//+optional
+	SuspectedCorruptFiles []string `json:"suspectedCorruptFiles,omitempty"`
+	// suspectedCorruptFileCount is the total number of files that were found
+	// to have changed without their modification time changing.
+	//+optional
+	SuspectedCorruptFileCount int32 `json:"suspectedCorruptFileCount,omitempty"`
+	// cacheUsage is the space used by the restic metadata cache at the end of
+	// the most recent backup.
+	//+optional
+	CacheUsage *resource.Quantity `json:"cacheUsage,omitempty"`
+	// lastIntegrityManifest references the integrity manifest of the most
+	// recent backup when spec.restic.integrityManifest is set.
+	//+optional
+	LastIntegrityManifest *ResticIntegrityManifestStatus `json:"lastIntegrityManifest,omitempty"`
+	// repositoryStats are the statistics of the repository after the most
+	// recent backup when spec.restic.repositoryStats is set.
+	//+optional
+	RepositoryStats *ResticRepositoryStats `json:"repositoryStats,omitempty"`
+}
+
+// ResticRepositoryStats are the statistics of a restic repository
+type ResticRepositoryStats struct {
+	// storedSize is the space used by the data in the repository, after
+	// deduplication and compression.
+	//+optional
+	StoredSize *resource.Quantity `json:"storedSize,omitempty"`
+	// uncompressedSize is the size of the data in the repository after
+	// deduplication, before compression.
+	//+optional
+	UncompressedSize *resource.Quantity `json:"uncompressedSize,omitempty"`
+	// restoreSize is the total size of all snapshots in the repository, as if
+	// each of them were restored.
+	//+optional
+	RestoreSize *resource.Quantity `json:"restoreSize,omitempty"`
+	// deduplicationRatio is restoreSize divided by uncompressedSize: how many
+	// times larger the snapshots are than the unique data they contain.
+	//+optional
+	DeduplicationRatio string `json:"deduplicationRatio,omitempty"`
+	// snapshotCount is the number of snapshots in the repository, from all
+	// sources.
+	//+optional
+	SnapshotCount int64 `json:"snapshotCount,omitempty"`
+	// sourceSnapshotCount is the number of snapshots in the repository that
+	// were made by this ReplicationSource.
+	//+optional
+	SourceSnapshotCount int64 `json:"sourceSnapshotCount,omitempty"`
+	// time is when the statistics were collected.
+	//+optional
+	Time *metav1.Time `json:"time,omitempty"`
+}
+
+// ResticIntegrityManifestStatus references the manifest of file digests that
+// was saved with a backup
+type ResticIntegrityManifestStatus struct {
+	// snapshotID is the ID of the backup that the manifest describes.
+	SnapshotID string `json:"snapshotID"`
+	// manifestSnapshotID is the ID of the snapshot in the repository that
+	// holds the manifest (as the file volsync-manifest).
+	ManifestSnapshotID string `json:"manifestSnapshotID"`
+	// sha256 is the SHA-256 digest of the manifest, to detect tampering with
+	// it.
+	SHA256 string `json:"sha256"`
+	// files is the number of files listed in the manifest.
+	//+optional
+	Files int64 `json:"files,omitempty"`
+	// time is when the manifest was saved.
+	//+optional
+	Time *metav1.Time `json:"time,omitempty"`
+}
+
+// ResticAutomaticUnlockStatus records the automatic removal of stale locks
+// from a restic repository
+type ResticAutomaticUnlockStatus struct {
+	// time is when the stale locks were removed.
+	//+optional
+	Time *metav1.Time `json:"time,omitempty"`
+	// staleLocks is the number of locks that were removed.
+	//+optional
+	StaleLocks int32 `json:"staleLocks,omitempty"`
+	// oldestLockAge is the age of the oldest lock that was removed.
+	//+optional
+	OldestLockAge *metav1.Duration `json:"oldestLockAge,omitempty"`
+}
+
+// ResticPasswordChangeStatus records a change of the password of a restic
+// repository
+type ResticPasswordChangeStatus struct {
+	// secretName is the name of the spec.restic.changePassword Secret that
+	// holds the password the repository was changed to.
+	SecretName string `json:"secretName"`
+	// time is when the password change completed.
+	//+optional
+	Time *metav1.Time `json:"time,omitempty"`
+}
+
+// ResticObjectLockStatus is the object lock configuration of the bucket of an
+// S3 restic repository
+type ResticObjectLockStatus struct {
+	// enabled indicates whether object lock is enabled on the bucket.
+	Enabled bool `json:"enabled"`
+	// mode is the default retention mode of the bucket (GOVERNANCE or
+	// COMPLIANCE).
+	//+optional
+	Mode string `json:"mode,omitempty"`
+	// retentionDays is the default retention period of the bucket.
+	//+optional
+	RetentionDays *int32 `json:"retentionDays,omitempty"`
+	// lastChecked is when the configuration was last retrieved.
+	//+optional
+	LastChecked *metav1.Time `json:"lastChecked,omitempty"`
 }
 
 // define the Syncthing field
@@ -240,24 +851,85 @@ type ReplicationSourceSyncthingSpec struct {
 	// Used to set the accessModes of Syncthing config volume.
 	//+optional
 	ConfigAccessModes []corev1.PersistentVolumeAccessMode `json:"configAccessModes,omitempty"`
+	// rotateIdentity, when changed to a new value, replaces the Syncthing
+	// device certificate (and therefore the device ID) with a newly
+	// generated one. Syncthing ReplicationSources in this cluster that list
+	// the previous device ID as a peer are updated to the new one.
+	//+optional
+	RotateIdentity string `json:"rotateIdentity,omitempty"`
 
 	MoverConfig `json:",inline"`
 }
 
 // ReplicationSourceSpec defines the desired state of ReplicationSource
+// +kubebuilder:validation:XValidation:rule="!has(self.sourcePVCs) || !has(self.sourcePVC) || size(self.sourcePVC) == 0",message="only one of sourcePVC or sourcePVCs may be specified"
+// +kubebuilder:validation:XValidation:rule="!has(self.sourcePVCs) || has(self.restic)",message="sourcePVCs is only supported by the restic mover"
+// +kubebuilder:validation:XValidation:rule="!has(self.rclone) || !has(self.rclone.rcloneSourcePath) || !has(self.sourcePVC) || size(self.sourcePVC) == 0",message="sourcePVC cannot be combined with rclone.rcloneSourcePath"
 type ReplicationSourceSpec struct {
 	// sourcePVC is the name of the PersistentVolumeClaim (PVC) to replicate.
 	SourcePVC string `json:"sourcePVC,omitempty"`
+	// sourcePVCs are the names of several PVCs (e.g., of one application) to
+	// back up together in a single mover Job, in place of sourcePVC. Each PVC
+	// is mounted under /data/<pvc name>, so the backup contains one top-level
+	// directory per PVC. Only supported by the restic mover.
+	//+optional
+	//+listType=set
+	//+kubebuilder:validation:MinItems=1
+	//+kubebuilder:validation:MaxItems=32
+	SourcePVCs []string `json:"sourcePVCs,omitempty"`
 	// trigger determines when the latest state of the volume will be captured
 	// (and potentially replicated to the destination).
 	//+optional
 	Trigger *ReplicationSourceTriggerSpec `json:"trigger,omitempty"`
+	// hooks defines actions to run before and after the point-in-time copy of
+	// the source volume is taken. Hooks are only run when the copyMethod is
+	// Clone or Snapshot.
+	//+optional
+	Hooks *ReplicationSourceHooksSpec `json:"hooks,omitempty"`
+	// copyTrigger allows an external controller to coordinate when the clone
+	// or snapshot of the source volume is taken. It replaces the
+	// volsync.backube/use-copy-trigger PVC annotations, which are ignored when
+	// this is set. Copy triggers are only used when the copyMethod is Clone or
+	// Snapshot.
+	//+optional
+	CopyTrigger *ReplicationSourceCopyTriggerSpec `json:"copyTrigger,omitempty"`
+	// intermediateRetention keeps the snapshots of the source volume taken
+	// for each synchronization (copyMethod: Snapshot) instead of deleting them
+	// when the synchronization completes, so that they can be used for a
+	// quick local rollback. Retained snapshots are labeled with
+	// volsync.backube/intermediate-of: <uid of the ReplicationSource>.
+	//+optional
+	IntermediateRetention *ReplicationSourceIntermediateRetentionSpec `json:"intermediateRetention,omitempty"`
+	// ioGate puts off the start of synchronizations until the IO activity on
+	// the source volume, as reported by Prometheus, drops below a threshold or
+	// a maximum delay has elapsed.
+	//+optional
+	IOGate *ReplicationSourceIOGateSpec `json:"ioGate,omitempty"`
+	// schedulePolicy is the name of a ReplicationSchedulePolicy in the same
+	// namespace that restricts when synchronizations may start.
+	//+kubebuilder:validation:MinLength=1
+	//+optional
+	SchedulePolicy *string `json:"schedulePolicy,omitempty"`
 	// rsync defines the configuration when using Rsync-based replication.
 	//+optional
 	Rsync *ReplicationSourceRsyncSpec `json:"rsync,omitempty"`
 	// rsyncTLS defines the configuration when using Rsync-based replication over TLS.
 	//+optional
 	RsyncTLS *ReplicationSourceRsyncTLSSpec `json:"rsyncTLS,omitempty"`
+	// block defines the configuration when using diskrsync-based replication
+	// of block mode volumes.
+	//+optional
+	Block *ReplicationSourceBlockSpec `json:"block,omitempty"`
+	// nfs defines the configuration when copying the data to an NFS export
+	// that is mounted directly by the mover.
+	//+optional
+	NFS *ReplicationSourceNFSSpec `json:"nfs,omitempty"`
+	// mock defines the configuration of a mover that runs a Job through the
+	// full synchronization lifecycle without moving any data. It is only
+	// available when the operator is started with --enable-mock-mover and
+	// is intended for testing automation that is built on VolSync.
+	//+optional
+	Mock *ReplicationSourceMockSpec `json:"mock,omitempty"`
 	// rclone defines the configuration when using Rclone-based replication.
 	//+optional
 	Rclone *ReplicationSourceRcloneSpec `json:"rclone,omitempty"`
@@ -274,6 +946,37 @@ type ReplicationSourceSpec struct {
 	// paused can be used to temporarily stop replication. Defaults to "false".
 	//+optional
 	Paused bool `json:"paused,omitempty"`
+	// snapshotContentPolicy determines what happens to the
+	// VolumeSnapshotContents of VolSync snapshots whose VolumeSnapshotClass
+	// has a Retain deletion policy once the snapshots are deleted. Ignore
+	// (the default) leaves them in place, Delete removes them, and Rebind
+	// binds them to a new VolumeSnapshot so that they can be reused.
+	//+optional
+	SnapshotContentPolicy SnapshotContentPolicyType `json:"snapshotContentPolicy,omitempty"`
+	// securityProfile selects the security settings of the mover pods.
+	// "Default" uses the mover's usual settings. "Hardened" requires the mover
+	// to run as a non-root user (moverSecurityContext.runAsUser) with a
+	// read-only root filesystem, the RuntimeDefault seccomp profile, all
+	// capabilities dropped, and without privileged mover permissions.
+	//+optional
+	SecurityProfile SecurityProfileType `json:"securityProfile,omitempty"`
+	// errorPolicy determines whether the synchronization fails when some files
+	// can not be copied. It is supported by the restic and rsync movers.
+	//+optional
+	ErrorPolicy *ErrorPolicy `json:"errorPolicy,omitempty"`
+	// notifications configures sending notifications of synchronization
+	// results to a webhook.
+	//+optional
+	Notifications *NotificationSpec `json:"notifications,omitempty"`
+	// retryPolicy controls how failed mover Jobs are retried during a
+	// synchronization. If omitted, a failed mover Job is retried immediately.
+	//+optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+	// enforceReadOnlySource mounts the source data read-only in the mover so
+	// that the replication can not modify it. Replication methods that require
+	// write access to the source (e.g., syncthing) will refuse to run.
+	//+optional
+	EnforceReadOnlySource bool `json:"enforceReadOnlySource,omitempty"`
 }
 
 type ReplicationSourceRsyncStatus struct {
@@ -291,6 +994,26 @@ type ReplicationSourceRsyncStatus struct {
 	// connections.
 	//+optional
 	Port *int32 `json:"port,omitempty"`
+	// transferStats reports the amount of data moved by the most recent
+	// synchronization.
+	//+optional
+	TransferStats *RsyncTransferStats `json:"transferStats,omitempty"`
+}
+
+// RsyncTransferStats describes the data moved by an rsync synchronization
+type RsyncTransferStats struct {
+	// logicalBytes is the total size of the files that were transferred, as
+	// seen by applications (i.e., including any holes in sparse files).
+	//+optional
+	LogicalBytes int64 `json:"logicalBytes,omitempty"`
+	// physicalBytes is the number of bytes that were actually sent to the
+	// destination.
+	//+optional
+	PhysicalBytes int64 `json:"physicalBytes,omitempty"`
+	// destinationSparseSupported indicates whether the destination filesystem
+	// supports sparse files. It is only checked when sparse is enabled.
+	//+optional
+	DestinationSparseSupported *bool `json:"destinationSparseSupported,omitempty"`
 }
 
 type ReplicationSourceSyncthingStatus struct {
@@ -300,6 +1023,20 @@ type ReplicationSourceSyncthingStatus struct {
 	ID string `json:"ID,omitempty"`
 	// Service address where Syncthing is exposed to the rest of the world
 	Address string `json:"address,omitempty"`
+	// certificateExpiry is when the device certificate expires. It is only
+	// known for device certificates that are managed by VolSync.
+	//+optional
+	CertificateExpiry *metav1.Time `json:"certificateExpiry,omitempty"`
+	// lastRotateIdentity is the spec.syncthing.rotateIdentity value of the
+	// most recent rotation of the device certificate.
+	//+optional
+	LastRotateIdentity string `json:"lastRotateIdentity,omitempty"`
+	// previousID is the device ID before the most recent rotation.
+	//+optional
+	PreviousID string `json:"previousID,omitempty"`
+	// lastRotationTime is when the device certificate was last rotated.
+	//+optional
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
 }
 
 // ReplicationSourceStatus defines the observed state of ReplicationSource
@@ -324,10 +1061,54 @@ type ReplicationSourceStatus struct {
 	// Logs/Summary from latest mover job
 	//+optional
 	LatestMoverStatus *MoverStatus `json:"latestMoverStatus,omitempty"`
+	// history lists the most recent synchronization attempts, newest first.
+	// The number of entries retained is limited by the operator.
+	//+optional
+	History []SyncHistoryEntry `json:"history,omitempty"`
+	// retries records the failed mover Jobs of the current synchronization
+	// (see spec.retryPolicy).
+	//+optional
+	Retries *RetryStatus `json:"retries,omitempty"`
+	// cleanupWarnings lists temporary objects from previous synchronizations
+	// that VolSync was unable to remove.
+	//+optional
+	CleanupWarnings []CleanupWarning `json:"cleanupWarnings,omitempty"`
+	// readOnlySourceEnforced indicates that the source data was mounted
+	// read-only in the mover during the most recent synchronization, as
+	// required by spec.enforceReadOnlySource.
+	//+optional
+	ReadOnlySourceEnforced bool `json:"readOnlySourceEnforced,omitempty"`
+	// copyTrigger reports the progress of copies of the source volume that
+	// are coordinated via spec.copyTrigger.
+	//+optional
+	CopyTrigger *ReplicationSourceCopyTriggerStatus `json:"copyTrigger,omitempty"`
+	// lastCopyMethod is the method that was used to copy the source volume
+	// for the most recent synchronization.
+	//+optional
+	LastCopyMethod CopyMethodType `json:"lastCopyMethod,omitempty"`
+	// copyMethodFallback is set when copyMethodFallback replaced a copyMethod
+	// of Clone with Snapshot.
+	//+optional
+	CopyMethodFallback *CopyMethodFallbackStatus `json:"copyMethodFallback,omitempty"`
+	// ioGate reports whether the synchronization that is due is being put off
+	// by spec.ioGate.
+	//+optional
+	IOGate *ReplicationSourceIOGateStatus `json:"ioGate,omitempty"`
+	// security reports the hardening measures that are applied to the mover
+	// (see spec.securityProfile).
+	//+optional
+	Security *SecurityStatus `json:"security,omitempty"`
+	// connectionTest is the result of the most recent connection test
+	// requested by the connectionTest field of the mover.
+	//+optional
+	ConnectionTest *ConnectionTestStatus `json:"connectionTest,omitempty"`
 	// rsync contains status information for Rsync-based replication.
 	Rsync *ReplicationSourceRsyncStatus `json:"rsync,omitempty"`
 	// rsyncTLS contains status information for Rsync-based replication over TLS.
 	RsyncTLS *ReplicationSourceRsyncTLSStatus `json:"rsyncTLS,omitempty"`
+	// block contains status information for diskrsync-based replication of
+	// block mode volumes.
+	Block *ReplicationSourceBlockStatus `json:"block,omitempty"`
 	// external contains provider-specific status information. For more details,
 	// please see the documentation of the specific replication provider being
 	// used.
@@ -343,6 +1124,20 @@ type ReplicationSourceStatus struct {
 	Syncthing *ReplicationSourceSyncthingStatus `json:"syncthing,omitempty"`
 }
 
+// CopyMethodFallbackStatus records why the source volume is copied with a
+// Snapshot instead of a Clone
+type CopyMethodFallbackStatus struct {
+	// message is the reason the volume could not be cloned.
+	Message string `json:"message"`
+	// observedGeneration is the generation of the ReplicationSource when the
+	// fallback happened. Cloning is tried again once the ReplicationSource is
+	// changed.
+	ObservedGeneration int64 `json:"observedGeneration"`
+	// time is when the fallback happened.
+	//+optional
+	Time *metav1.Time `json:"time,omitempty"`
+}
+
 // A ReplicationSource is a VolSync resource that you can use to define the source PVC and replication mover type,
 // enabling you to replicate or synchronize PVC data to a remote location.
 // +kubebuilder:object:root=true
@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This file may be used, at your option, according to either the GNU AGPL 3.0 or
+the Apache V2 license.
+
+---
+This program is free software: you can redistribute it and/or modify it under
+the terms of the GNU Affero General Public License as published by the Free
+Software Foundation, either version 3 of the License, or (at your option) any
+later version.
+
+This program is distributed in the hope that it will be useful, but WITHOUT ANY
+WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A
+PARTICULAR PURPOSE.  See the GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License along
+with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+---
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// Label applied to objects created on behalf of a RestoreFanout. The value
+	// is the name of the RestoreFanout.
+	RestoreFanoutLabel = "volsync.backube/restore-fanout"
+
+	ConditionFanoutCompleted       string = "Completed"
+	FanoutCompletedReasonRestoring string = "Restoring"
+	FanoutCompletedReasonSucceeded string = "AllTargetsRestored"
+	FanoutCompletedReasonFailed    string = "TargetsFailed"
+	FanoutCompletedReasonError     string = "Error"
+
+	// Default number of targets that are restored concurrently
+	DefaultFanoutParallelism int32 = 2
+)
+
+// RestoreFanoutTargetPhase is the progress of the restore into one target.
+// +kubebuilder:validation:Enum=Pending;Restoring;Completed;Failed
+type RestoreFanoutTargetPhase string
+
+const (
+	RestoreFanoutTargetPending   RestoreFanoutTargetPhase = "Pending"
+	RestoreFanoutTargetRestoring RestoreFanoutTargetPhase = "Restoring"
+	RestoreFanoutTargetCompleted RestoreFanoutTargetPhase = "Completed"
+	RestoreFanoutTargetFailed    RestoreFanoutTargetPhase = "Failed"
+)
+
+// RestoreFanoutRepository identifies the restic repository Secret that is
+// restored from.
+type RestoreFanoutRepository struct {
+	// name of the repository Secret.
+	Name string `json:"name"`
+	// namespace of the repository Secret.
+	Namespace string `json:"namespace"`
+}
+
+// RestoreFanoutTarget is a PVC that the snapshot is restored into.
+type RestoreFanoutTarget struct {
+	// namespace of the PVC.
+	Namespace string `json:"namespace"`
+	// pvcName is the name of the PVC. If it does not exist, it is created
+	// using the capacity, accessModes, and storageClassName from the restic
+	// section. Defaults to the name of the RestoreFanout.
+	//+optional
+	PVCName string `json:"pvcName,omitempty"`
+}
+
+// RestoreFanoutSpec defines the desired state of RestoreFanout
+type RestoreFanoutSpec struct {
+	// repository refers to the Secret holding the restic repository
+	// configuration. A copy of the Secret is made in each target namespace.
+	Repository RestoreFanoutRepository `json:"repository"`
+	// restic configures the restore. The snapshot to restore is chosen with
+	// snapshotID, restoreAsOf, or previous. The repository,
+	// repositorySecretRef, copyMethod, and destinationPVC fields are ignored.
+	Restic ReplicationDestinationResticSpec `json:"restic"`
+	// targets lists the PVCs to restore into.
+	//+optional
+	Targets []RestoreFanoutTarget `json:"targets,omitempty"`
+	// namespaceSelector generates an additional target in each namespace
+	// matching the selector, using the name of the RestoreFanout as the PVC
+	// name.
+	//+optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// parallelism is the maximum number of targets that are restored at the
+	// same time. Defaults to 2.
+	//+kubebuilder:validation:Minimum=1
+	//+optional
+	Parallelism *int32 `json:"parallelism,omitempty"`
+}
+
+// RestoreFanoutTargetStatus is the progress of the restore into one target.
+type RestoreFanoutTargetStatus struct {
+	// namespace of the PVC.
+	Namespace string `json:"namespace"`
+	// pvcName is the name of the PVC.
+	PVCName string `json:"pvcName"`
+	// phase of the restore into this target.
+	Phase RestoreFanoutTargetPhase `json:"phase"`
+	// message describes any error.
+	//+optional
+	Message string `json:"message,omitempty"`
+	// completionTime is when the restore into this target finished.
+	//+optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// RestoreFanoutStatus defines the observed state of RestoreFanout
+type RestoreFanoutStatus struct {
+	// targets is the progress of each target.
+	//+optional
+	Targets []RestoreFanoutTargetStatus `json:"targets,omitempty"`
+	// total is the number of targets.
+	//+optional
+	Total int32 `json:"total,omitempty"`
+	// restoring is the number of targets currently being restored.
+	//+optional
+	Restoring int32 `json:"restoring,omitempty"`
+	// completed is the number of targets that have been restored.
+	//+optional
+	Completed int32 `json:"completed,omitempty"`
+	// failed is the number of targets whose restore failed.
+	//+optional
+	Failed int32 `json:"failed,omitempty"`
+	// completionTime is when all targets finished.
+	//+optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// conditions represent the latest available observations of the
+	// fanout's state.
+	//+optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// A RestoreFanout is a cluster-scoped VolSync resource that restores the same
+// restic snapshot into PVCs in many namespaces, running a bounded number of
+// restores in parallel.
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Total",type="integer",JSONPath=`.status.total`
+// +kubebuilder:printcolumn:name="Completed",type="integer",JSONPath=`.status.completed`
+// +kubebuilder:printcolumn:name="Failed",type="integer",JSONPath=`.status.failed`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`
+type RestoreFanout struct {
+	metav1.TypeMeta `json:",inline"`
+	//+optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// spec is the desired state of the RestoreFanout.
+	Spec RestoreFanoutSpec `json:"spec,omitempty"`
+	// status is the observed state of the RestoreFanout as determined by the
+	// controller.
+	//+optional
+	Status *RestoreFanoutStatus `json:"status,omitempty"`
+}
+
+// RestoreFanoutList contains a list of RestoreFanout
+// +kubebuilder:object:root=true
+type RestoreFanoutList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RestoreFanout `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RestoreFanout{}, &RestoreFanoutList{})
+}
@@ -46,6 +46,11 @@ type ReplicationSourceRsyncTLSSpec struct {
 	// be used for authentication. If not provided, the key will be generated.
 	//+optional
 	KeySecret *string `json:"keySecret,omitempty"`
+	// keySecretRef mounts the TLS pre-shared key into the mover from an
+	// external secret store instead of a Secret. The key must be a file named
+	// psk.txt. When set, keySecret is ignored and no key is generated.
+	//+optional
+	KeySecretRef *MoverSecretRef `json:"keySecretRef,omitempty"`
 	// address is the remote address to connect to for replication.
 	//+optional
 	Address *string `json:"address,omitempty"`
@@ -54,6 +59,12 @@ type ReplicationSourceRsyncTLSSpec struct {
 	//+kubebuilder:validation:Maximum=65535
 	//+optional
 	Port *int32 `json:"port,omitempty"`
+	// sparse enables efficient handling of sparse and preallocated files. In
+	// addition to preserving holes, files are preallocated on the destination
+	// and the destination filesystem is checked for sparse file support.
+	// Defaults to "false".
+	//+optional
+	Sparse bool `json:"sparse,omitempty"`
 
 	MoverConfig `json:",inline"`
 }
@@ -64,6 +75,10 @@ type ReplicationSourceRsyncTLSStatus struct {
 	// the key Secret will be generated and named here.
 	//+optional
 	KeySecret *string `json:"keySecret,omitempty"`
+	// transferStats reports the amount of data moved by the most recent
+	// synchronization.
+	//+optional
+	TransferStats *RsyncTransferStats `json:"transferStats,omitempty"`
 }
 
 /********************************************************************
@@ -80,6 +95,11 @@ type ReplicationDestinationRsyncTLSSpec struct {
 	// be used for authentication. If not provided, the key will be generated.
 	//+optional
 	KeySecret *string `json:"keySecret,omitempty"`
+	// keySecretRef mounts the TLS pre-shared key into the mover from an
+	// external secret store instead of a Secret. The key must be a file named
+	// psk.txt. When set, keySecret is ignored and no key is generated.
+	//+optional
+	KeySecretRef *MoverSecretRef `json:"keySecretRef,omitempty"`
 	// serviceType determines the Service type that will be created for incoming
 	// TLS connections.
 	//+optional
@@ -89,10 +109,41 @@ type ReplicationDestinationRsyncTLSSpec struct {
 	// will be used instead of any VolSync default values.
 	//+optional
 	ServiceAnnotations *map[string]string `json:"serviceAnnotations,omitempty"`
+	// externalAddress is the address that sources use to reach this
+	// destination from outside the cluster (e.g., a DNS name maintained by
+	// external-dns). It is published in .status.rsyncTLS.address in place of
+	// the address of the Service. If not set, the hostname in an
+	// external-dns.alpha.kubernetes.io/hostname annotation of the Service or
+	// TCPRoute is used.
+	//+optional
+	ExternalAddress *string `json:"externalAddress,omitempty"`
+	// tcpRoute exposes the destination through a Gateway API TCPRoute attached
+	// to an existing Gateway. The Service remains the backend of the route.
+	//+optional
+	TCPRoute *RsyncTLSTCPRouteSpec `json:"tcpRoute,omitempty"`
 
 	MoverConfig `json:",inline"`
 }
 
+// RsyncTLSTCPRouteSpec selects the Gateway listener that a
+// ReplicationDestination is exposed through
+type RsyncTLSTCPRouteSpec struct {
+	// gatewayName is the name of the Gateway to attach the TCPRoute to.
+	//+kubebuilder:validation:MinLength=1
+	GatewayName string `json:"gatewayName"`
+	// gatewayNamespace is the namespace of the Gateway. Defaults to the
+	// namespace of the ReplicationDestination.
+	//+optional
+	GatewayNamespace *string `json:"gatewayNamespace,omitempty"`
+	// sectionName is the name of the TCP listener of the Gateway to attach to.
+	// Defaults to the first TCP listener of the Gateway.
+	//+optional
+	SectionName *string `json:"sectionName,omitempty"`
+	// annotations are added to the TCPRoute.
+	//+optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
 type ReplicationDestinationRsyncTLSStatus struct {
 	// keySecret is the name of a Secret that contains the TLS pre-shared key to
 	// be used for authentication. If not provided in .spec.rsyncTLS.keySecret,
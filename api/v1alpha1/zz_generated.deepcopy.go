@@ -44,302 +44,316 @@ import (
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CustomCASpec) DeepCopyInto(out *CustomCASpec) {
+func (in *AdoptDestinationPVCSpec) DeepCopyInto(out *AdoptDestinationPVCSpec) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomCASpec.
-func (in *CustomCASpec) DeepCopy() *CustomCASpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdoptDestinationPVCSpec.
+func (in *AdoptDestinationPVCSpec) DeepCopy() *AdoptDestinationPVCSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CustomCASpec)
+	out := new(AdoptDestinationPVCSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MoverConfig) DeepCopyInto(out *MoverConfig) {
+func (in *CapacityForecastSpec) DeepCopyInto(out *CapacityForecastSpec) {
 	*out = *in
-	if in.MoverSecurityContext != nil {
-		in, out := &in.MoverSecurityContext, &out.MoverSecurityContext
-		*out = new(v1.PodSecurityContext)
-		(*in).DeepCopyInto(*out)
+	if in.Horizon != nil {
+		in, out := &in.Horizon, &out.Horizon
+		*out = new(metav1.Duration)
+		**out = **in
 	}
-	if in.MoverServiceAccount != nil {
-		in, out := &in.MoverServiceAccount, &out.MoverServiceAccount
-		*out = new(string)
+	if in.ExpansionPercent != nil {
+		in, out := &in.ExpansionPercent, &out.ExpansionPercent
+		*out = new(int32)
 		**out = **in
 	}
-	if in.MoverPodLabels != nil {
-		in, out := &in.MoverPodLabels, &out.MoverPodLabels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	if in.MaxCapacity != nil {
+		in, out := &in.MaxCapacity, &out.MaxCapacity
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityForecastSpec.
+func (in *CapacityForecastSpec) DeepCopy() *CapacityForecastSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityForecastSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityForecastStatus) DeepCopyInto(out *CapacityForecastStatus) {
+	*out = *in
+	if in.Samples != nil {
+		in, out := &in.Samples, &out.Samples
+		*out = make([]CapacitySample, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.MoverResources != nil {
-		in, out := &in.MoverResources, &out.MoverResources
-		*out = new(v1.ResourceRequirements)
-		(*in).DeepCopyInto(*out)
+	if in.GrowthBytesPerDay != nil {
+		in, out := &in.GrowthBytesPerDay, &out.GrowthBytesPerDay
+		*out = new(int64)
+		**out = **in
 	}
-	if in.MoverAffinity != nil {
-		in, out := &in.MoverAffinity, &out.MoverAffinity
-		*out = new(v1.Affinity)
-		(*in).DeepCopyInto(*out)
+	if in.ProjectedFullTime != nil {
+		in, out := &in.ProjectedFullTime, &out.ProjectedFullTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastExpansionTime != nil {
+		in, out := &in.LastExpansionTime, &out.LastExpansionTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MoverConfig.
-func (in *MoverConfig) DeepCopy() *MoverConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityForecastStatus.
+func (in *CapacityForecastStatus) DeepCopy() *CapacityForecastStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MoverConfig)
+	out := new(CapacityForecastStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MoverStatus) DeepCopyInto(out *MoverStatus) {
+func (in *CapacitySample) DeepCopyInto(out *CapacitySample) {
 	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MoverStatus.
-func (in *MoverStatus) DeepCopy() *MoverStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacitySample.
+func (in *CapacitySample) DeepCopy() *CapacitySample {
 	if in == nil {
 		return nil
 	}
-	out := new(MoverStatus)
+	out := new(CapacitySample)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationDestination) DeepCopyInto(out *ReplicationDestination) {
+func (in *CleanupWarning) DeepCopyInto(out *CleanupWarning) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(ReplicationDestinationStatus)
-		(*in).DeepCopyInto(*out)
+	if in.LastAttempt != nil {
+		in, out := &in.LastAttempt, &out.LastAttempt
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestination.
-func (in *ReplicationDestination) DeepCopy() *ReplicationDestination {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CleanupWarning.
+func (in *CleanupWarning) DeepCopy() *CleanupWarning {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationDestination)
+	out := new(CleanupWarning)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ReplicationDestination) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationDestinationExternalSpec) DeepCopyInto(out *ReplicationDestinationExternalSpec) {
+func (in *ConnectionTestStatus) DeepCopyInto(out *ConnectionTestStatus) {
 	*out = *in
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.Latency != nil {
+		in, out := &in.Latency, &out.Latency
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestinationExternalSpec.
-func (in *ReplicationDestinationExternalSpec) DeepCopy() *ReplicationDestinationExternalSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionTestStatus.
+func (in *ConnectionTestStatus) DeepCopy() *ConnectionTestStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationDestinationExternalSpec)
+	out := new(ConnectionTestStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationDestinationList) DeepCopyInto(out *ReplicationDestinationList) {
+func (in *CopyMethodFallbackStatus) DeepCopyInto(out *CopyMethodFallbackStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ReplicationDestination, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestinationList.
-func (in *ReplicationDestinationList) DeepCopy() *ReplicationDestinationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CopyMethodFallbackStatus.
+func (in *CopyMethodFallbackStatus) DeepCopy() *CopyMethodFallbackStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationDestinationList)
+	out := new(CopyMethodFallbackStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ReplicationDestinationList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomCASpec) DeepCopyInto(out *CustomCASpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomCASpec.
+func (in *CustomCASpec) DeepCopy() *CustomCASpec {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(CustomCASpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationDestinationRcloneSpec) DeepCopyInto(out *ReplicationDestinationRcloneSpec) {
+func (in *ErrorPolicy) DeepCopyInto(out *ErrorPolicy) {
 	*out = *in
-	in.ReplicationDestinationVolumeOptions.DeepCopyInto(&out.ReplicationDestinationVolumeOptions)
-	if in.RcloneConfigSection != nil {
-		in, out := &in.RcloneConfigSection, &out.RcloneConfigSection
-		*out = new(string)
-		**out = **in
-	}
-	if in.RcloneDestPath != nil {
-		in, out := &in.RcloneDestPath, &out.RcloneDestPath
-		*out = new(string)
+	if in.MaxErrors != nil {
+		in, out := &in.MaxErrors, &out.MaxErrors
+		*out = new(int32)
 		**out = **in
 	}
-	if in.RcloneConfig != nil {
-		in, out := &in.RcloneConfig, &out.RcloneConfig
-		*out = new(string)
+	if in.MaxErrorPercent != nil {
+		in, out := &in.MaxErrorPercent, &out.MaxErrorPercent
+		*out = new(int32)
 		**out = **in
 	}
-	out.CustomCA = in.CustomCA
-	in.MoverConfig.DeepCopyInto(&out.MoverConfig)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestinationRcloneSpec.
-func (in *ReplicationDestinationRcloneSpec) DeepCopy() *ReplicationDestinationRcloneSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPolicy.
+func (in *ErrorPolicy) DeepCopy() *ErrorPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationDestinationRcloneSpec)
+	out := new(ErrorPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationDestinationResticCA) DeepCopyInto(out *ReplicationDestinationResticCA) {
+func (in *ExecHook) DeepCopyInto(out *ExecHook) {
 	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestinationResticCA.
-func (in *ReplicationDestinationResticCA) DeepCopy() *ReplicationDestinationResticCA {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecHook.
+func (in *ExecHook) DeepCopy() *ExecHook {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationDestinationResticCA)
+	out := new(ExecHook)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationDestinationResticSpec) DeepCopyInto(out *ReplicationDestinationResticSpec) {
+func (in *IOGatePrometheusSpec) DeepCopyInto(out *IOGatePrometheusSpec) {
 	*out = *in
-	in.ReplicationDestinationVolumeOptions.DeepCopyInto(&out.ReplicationDestinationVolumeOptions)
-	out.CustomCA = in.CustomCA
-	if in.CacheCapacity != nil {
-		in, out := &in.CacheCapacity, &out.CacheCapacity
-		x := (*in).DeepCopy()
-		*out = &x
-	}
-	if in.CacheStorageClassName != nil {
-		in, out := &in.CacheStorageClassName, &out.CacheStorageClassName
+	if in.AuthSecret != nil {
+		in, out := &in.AuthSecret, &out.AuthSecret
 		*out = new(string)
 		**out = **in
 	}
-	if in.CacheAccessModes != nil {
-		in, out := &in.CacheAccessModes, &out.CacheAccessModes
-		*out = make([]v1.PersistentVolumeAccessMode, len(*in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IOGatePrometheusSpec.
+func (in *IOGatePrometheusSpec) DeepCopy() *IOGatePrometheusSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IOGatePrometheusSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobHook) DeepCopyInto(out *JobHook) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.Previous != nil {
-		in, out := &in.Previous, &out.Previous
-		*out = new(int32)
-		**out = **in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.RestoreAsOf != nil {
-		in, out := &in.RestoreAsOf, &out.RestoreAsOf
+	if in.ServiceAccountName != nil {
+		in, out := &in.ServiceAccountName, &out.ServiceAccountName
 		*out = new(string)
 		**out = **in
 	}
-	in.MoverConfig.DeepCopyInto(&out.MoverConfig)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestinationResticSpec.
-func (in *ReplicationDestinationResticSpec) DeepCopy() *ReplicationDestinationResticSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobHook.
+func (in *JobHook) DeepCopy() *JobHook {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationDestinationResticSpec)
+	out := new(JobHook)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationDestinationRsyncSpec) DeepCopyInto(out *ReplicationDestinationRsyncSpec) {
+func (in *KubeconfigSecretRef) DeepCopyInto(out *KubeconfigSecretRef) {
 	*out = *in
-	in.ReplicationDestinationVolumeOptions.DeepCopyInto(&out.ReplicationDestinationVolumeOptions)
-	if in.VolumeMode != nil {
-		in, out := &in.VolumeMode, &out.VolumeMode
-		*out = new(v1.PersistentVolumeMode)
-		**out = **in
-	}
-	if in.SSHKeys != nil {
-		in, out := &in.SSHKeys, &out.SSHKeys
-		*out = new(string)
-		**out = **in
-	}
-	if in.ServiceType != nil {
-		in, out := &in.ServiceType, &out.ServiceType
-		*out = new(v1.ServiceType)
-		**out = **in
-	}
-	if in.ServiceAnnotations != nil {
-		in, out := &in.ServiceAnnotations, &out.ServiceAnnotations
-		*out = new(map[string]string)
-		if **in != nil {
-			in, out := *in, *out
-			*out = make(map[string]string, len(*in))
-			for key, val := range *in {
-				(*out)[key] = val
-			}
-		}
-	}
-	if in.Address != nil {
-		in, out := &in.Address, &out.Address
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeconfigSecretRef.
+func (in *KubeconfigSecretRef) DeepCopy() *KubeconfigSecretRef {
+	if in == nil {
+		return nil
 	}
-	if in.Port != nil {
-		in, out := &in.Port, &out.Port
-		*out = new(int32)
+	out := new(KubeconfigSecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MockSettings) DeepCopyInto(out *MockSettings) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
 		**out = **in
 	}
-	if in.Path != nil {
-		in, out := &in.Path, &out.Path
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MockSettings.
+func (in *MockSettings) DeepCopy() *MockSettings {
+	if in == nil {
+		return nil
 	}
-	if in.SSHUser != nil {
-		in, out := &in.SSHUser, &out.SSHUser
-		*out = new(string)
-		**out = **in
+	out := new(MockSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MoverConfig) DeepCopyInto(out *MoverConfig) {
+	*out = *in
+	if in.MoverSecurityContext != nil {
+		in, out := &in.MoverSecurityContext, &out.MoverSecurityContext
+		*out = new(v1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.MoverServiceAccount != nil {
 		in, out := &in.MoverServiceAccount, &out.MoverServiceAccount
@@ -353,333 +367,3797 @@ func (in *ReplicationDestinationRsyncSpec) DeepCopyInto(out *ReplicationDestinat
 			(*out)[key] = val
 		}
 	}
+	if in.MoverPodAnnotations != nil {
+		in, out := &in.MoverPodAnnotations, &out.MoverPodAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.MoverResources != nil {
 		in, out := &in.MoverResources, &out.MoverResources
 		*out = new(v1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
-}
-
+	if in.MoverAffinity != nil {
+		in, out := &in.MoverAffinity, &out.MoverAffinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MoverApplicationAffinity != nil {
+		in, out := &in.MoverApplicationAffinity, &out.MoverApplicationAffinity
+		*out = new(ApplicationAffinityMode)
+		**out = **in
+	}
+	if in.MoverImage != nil {
+		in, out := &in.MoverImage, &out.MoverImage
+		*out = new(string)
+		**out = **in
+	}
+	if in.MoverPriorityClassName != nil {
+		in, out := &in.MoverPriorityClassName, &out.MoverPriorityClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.MoverSchedulerName != nil {
+		in, out := &in.MoverSchedulerName, &out.MoverSchedulerName
+		*out = new(string)
+		**out = **in
+	}
+	if in.MoverEnv != nil {
+		in, out := &in.MoverEnv, &out.MoverEnv
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MoverServiceAccountTokens != nil {
+		in, out := &in.MoverServiceAccountTokens, &out.MoverServiceAccountTokens
+		*out = make([]MoverServiceAccountToken, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MoverTimeout != nil {
+		in, out := &in.MoverTimeout, &out.MoverTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ProtectFromEviction != nil {
+		in, out := &in.ProtectFromEviction, &out.ProtectFromEviction
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MoverConfig.
+func (in *MoverConfig) DeepCopy() *MoverConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MoverConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MoverProgress) DeepCopyInto(out *MoverProgress) {
+	*out = *in
+	if in.PercentComplete != nil {
+		in, out := &in.PercentComplete, &out.PercentComplete
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ETA != nil {
+		in, out := &in.ETA, &out.ETA
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MoverProgress.
+func (in *MoverProgress) DeepCopy() *MoverProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(MoverProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MoverSecretRef) DeepCopyInto(out *MoverSecretRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MoverSecretRef.
+func (in *MoverSecretRef) DeepCopy() *MoverSecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(MoverSecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MoverServiceAccountToken) DeepCopyInto(out *MoverServiceAccountToken) {
+	*out = *in
+	if in.ExpirationSeconds != nil {
+		in, out := &in.ExpirationSeconds, &out.ExpirationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MoverServiceAccountToken.
+func (in *MoverServiceAccountToken) DeepCopy() *MoverServiceAccountToken {
+	if in == nil {
+		return nil
+	}
+	out := new(MoverServiceAccountToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MoverStatus) DeepCopyInto(out *MoverStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(MoverProgress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailedPaths != nil {
+		in, out := &in.FailedPaths, &out.FailedPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastTimeoutTime != nil {
+		in, out := &in.LastTimeoutTime, &out.LastTimeoutTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MoverStatus.
+func (in *MoverStatus) DeepCopy() *MoverStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MoverStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NFSExport) DeepCopyInto(out *NFSExport) {
+	*out = *in
+	if in.MountOptions != nil {
+		in, out := &in.MountOptions, &out.MountOptions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NFSExport.
+func (in *NFSExport) DeepCopy() *NFSExport {
+	if in == nil {
+		return nil
+	}
+	out := new(NFSExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationSpec) DeepCopyInto(out *NotificationSpec) {
+	*out = *in
+	if in.AuthSecret != nil {
+		in, out := &in.AuthSecret, &out.AuthSecret
+		*out = new(string)
+		**out = **in
+	}
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]NotificationEventType, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSpec.
+func (in *NotificationSpec) DeepCopy() *NotificationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromoteSpec) DeepCopyInto(out *PromoteSpec) {
+	*out = *in
+	if in.PVCName != nil {
+		in, out := &in.PVCName, &out.PVCName
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromoteSpec.
+func (in *PromoteSpec) DeepCopy() *PromoteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PromoteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionStatus) DeepCopyInto(out *PromotionStatus) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(v1.TypedLocalObjectReference)
+		(*in).DeepCopyInto(*out)
+	}
+	in.PromotionTime.DeepCopyInto(&out.PromotionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStatus.
+func (in *PromotionStatus) DeepCopy() *PromotionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RcloneAzureBlobRemote) DeepCopyInto(out *RcloneAzureBlobRemote) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RcloneAzureBlobRemote.
+func (in *RcloneAzureBlobRemote) DeepCopy() *RcloneAzureBlobRemote {
+	if in == nil {
+		return nil
+	}
+	out := new(RcloneAzureBlobRemote)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RcloneGoogleCloudStorageRemote) DeepCopyInto(out *RcloneGoogleCloudStorageRemote) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RcloneGoogleCloudStorageRemote.
+func (in *RcloneGoogleCloudStorageRemote) DeepCopy() *RcloneGoogleCloudStorageRemote {
+	if in == nil {
+		return nil
+	}
+	out := new(RcloneGoogleCloudStorageRemote)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RcloneRemoteSpec) DeepCopyInto(out *RcloneRemoteSpec) {
+	*out = *in
+	if in.CredentialsSecretName != nil {
+		in, out := &in.CredentialsSecretName, &out.CredentialsSecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(RcloneS3Remote)
+		**out = **in
+	}
+	if in.AzureBlob != nil {
+		in, out := &in.AzureBlob, &out.AzureBlob
+		*out = new(RcloneAzureBlobRemote)
+		**out = **in
+	}
+	if in.GoogleCloudStorage != nil {
+		in, out := &in.GoogleCloudStorage, &out.GoogleCloudStorage
+		*out = new(RcloneGoogleCloudStorageRemote)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RcloneRemoteSpec.
+func (in *RcloneRemoteSpec) DeepCopy() *RcloneRemoteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RcloneRemoteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RcloneS3Remote) DeepCopyInto(out *RcloneS3Remote) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RcloneS3Remote.
+func (in *RcloneS3Remote) DeepCopy() *RcloneS3Remote {
+	if in == nil {
+		return nil
+	}
+	out := new(RcloneS3Remote)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteObjectReference) DeepCopyInto(out *RemoteObjectReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteObjectReference.
+func (in *RemoteObjectReference) DeepCopy() *RemoteObjectReference {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteObjectReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationCredentialSync) DeepCopyInto(out *ReplicationCredentialSync) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(ReplicationCredentialSyncStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationCredentialSync.
+func (in *ReplicationCredentialSync) DeepCopy() *ReplicationCredentialSync {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationCredentialSync)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReplicationCredentialSync) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationCredentialSyncList) DeepCopyInto(out *ReplicationCredentialSyncList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ReplicationCredentialSync, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationCredentialSyncList.
+func (in *ReplicationCredentialSyncList) DeepCopy() *ReplicationCredentialSyncList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationCredentialSyncList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReplicationCredentialSyncList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationCredentialSyncSpec) DeepCopyInto(out *ReplicationCredentialSyncSpec) {
+	*out = *in
+	out.KubeconfigSecretRef = in.KubeconfigSecretRef
+	out.ReplicationDestination = in.ReplicationDestination
+	if in.ReplicationSource != nil {
+		in, out := &in.ReplicationSource, &out.ReplicationSource
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeySecretName != nil {
+		in, out := &in.KeySecretName, &out.KeySecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationCredentialSyncSpec.
+func (in *ReplicationCredentialSyncSpec) DeepCopy() *ReplicationCredentialSyncSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationCredentialSyncSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationCredentialSyncStatus) DeepCopyInto(out *ReplicationCredentialSyncStatus) {
+	*out = *in
+	if in.KeySecretName != nil {
+		in, out := &in.KeySecretName, &out.KeySecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LastCheckTime != nil {
+		in, out := &in.LastCheckTime, &out.LastCheckTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationCredentialSyncStatus.
+func (in *ReplicationCredentialSyncStatus) DeepCopy() *ReplicationCredentialSyncStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationCredentialSyncStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationDestination) DeepCopyInto(out *ReplicationDestination) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(ReplicationDestinationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestination.
+func (in *ReplicationDestination) DeepCopy() *ReplicationDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReplicationDestination) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationDestinationBlockSpec) DeepCopyInto(out *ReplicationDestinationBlockSpec) {
+	*out = *in
+	in.ReplicationDestinationVolumeOptions.DeepCopyInto(&out.ReplicationDestinationVolumeOptions)
+	if in.KeySecret != nil {
+		in, out := &in.KeySecret, &out.KeySecret
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceType != nil {
+		in, out := &in.ServiceType, &out.ServiceType
+		*out = new(v1.ServiceType)
+		**out = **in
+	}
+	if in.ServiceAnnotations != nil {
+		in, out := &in.ServiceAnnotations, &out.ServiceAnnotations
+		*out = new(map[string]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make(map[string]string, len(*in))
+			for key, val := range *in {
+				(*out)[key] = val
+			}
+		}
+	}
+	in.MoverConfig.DeepCopyInto(&out.MoverConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestinationBlockSpec.
+func (in *ReplicationDestinationBlockSpec) DeepCopy() *ReplicationDestinationBlockSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationDestinationBlockSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationDestinationBlockStatus) DeepCopyInto(out *ReplicationDestinationBlockStatus) {
+	*out = *in
+	if in.KeySecret != nil {
+		in, out := &in.KeySecret, &out.KeySecret
+		*out = new(string)
+		**out = **in
+	}
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestinationBlockStatus.
+func (in *ReplicationDestinationBlockStatus) DeepCopy() *ReplicationDestinationBlockStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationDestinationBlockStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationDestinationExternalSpec) DeepCopyInto(out *ReplicationDestinationExternalSpec) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestinationExternalSpec.
+func (in *ReplicationDestinationExternalSpec) DeepCopy() *ReplicationDestinationExternalSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationDestinationExternalSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationDestinationList) DeepCopyInto(out *ReplicationDestinationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ReplicationDestination, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestinationList.
+func (in *ReplicationDestinationList) DeepCopy() *ReplicationDestinationList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationDestinationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReplicationDestinationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationDestinationMockSpec) DeepCopyInto(out *ReplicationDestinationMockSpec) {
+	*out = *in
+	in.ReplicationDestinationVolumeOptions.DeepCopyInto(&out.ReplicationDestinationVolumeOptions)
+	in.MockSettings.DeepCopyInto(&out.MockSettings)
+	in.MoverConfig.DeepCopyInto(&out.MoverConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestinationMockSpec.
+func (in *ReplicationDestinationMockSpec) DeepCopy() *ReplicationDestinationMockSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationDestinationMockSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationDestinationNFSSpec) DeepCopyInto(out *ReplicationDestinationNFSSpec) {
+	*out = *in
+	in.ReplicationDestinationVolumeOptions.DeepCopyInto(&out.ReplicationDestinationVolumeOptions)
+	in.NFSExport.DeepCopyInto(&out.NFSExport)
+	in.MoverConfig.DeepCopyInto(&out.MoverConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestinationNFSSpec.
+func (in *ReplicationDestinationNFSSpec) DeepCopy() *ReplicationDestinationNFSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationDestinationNFSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationDestinationRcloneSpec) DeepCopyInto(out *ReplicationDestinationRcloneSpec) {
+	*out = *in
+	in.ReplicationDestinationVolumeOptions.DeepCopyInto(&out.ReplicationDestinationVolumeOptions)
+	if in.RcloneConfigSection != nil {
+		in, out := &in.RcloneConfigSection, &out.RcloneConfigSection
+		*out = new(string)
+		**out = **in
+	}
+	if in.RcloneDestPath != nil {
+		in, out := &in.RcloneDestPath, &out.RcloneDestPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.RcloneConfig != nil {
+		in, out := &in.RcloneConfig, &out.RcloneConfig
+		*out = new(string)
+		**out = **in
+	}
+	if in.Remote != nil {
+		in, out := &in.Remote, &out.Remote
+		*out = new(RcloneRemoteSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Parallelism != nil {
+		in, out := &in.Parallelism, &out.Parallelism
+		*out = new(int32)
+		**out = **in
+	}
+	out.CustomCA = in.CustomCA
+	in.MoverConfig.DeepCopyInto(&out.MoverConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestinationRcloneSpec.
+func (in *ReplicationDestinationRcloneSpec) DeepCopy() *ReplicationDestinationRcloneSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationDestinationRcloneSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationDestinationResticCA) DeepCopyInto(out *ReplicationDestinationResticCA) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestinationResticCA.
+func (in *ReplicationDestinationResticCA) DeepCopy() *ReplicationDestinationResticCA {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationDestinationResticCA)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationDestinationResticSpec) DeepCopyInto(out *ReplicationDestinationResticSpec) {
+	*out = *in
+	in.ReplicationDestinationVolumeOptions.DeepCopyInto(&out.ReplicationDestinationVolumeOptions)
+	if in.VolumeMode != nil {
+		in, out := &in.VolumeMode, &out.VolumeMode
+		*out = new(v1.PersistentVolumeMode)
+		**out = **in
+	}
+	if in.RepositorySecretRef != nil {
+		in, out := &in.RepositorySecretRef, &out.RepositorySecretRef
+		*out = new(MoverSecretRef)
+		**out = **in
+	}
+	if in.Parallelism != nil {
+		in, out := &in.Parallelism, &out.Parallelism
+		*out = new(int32)
+		**out = **in
+	}
+	out.CustomCA = in.CustomCA
+	if in.CacheCapacity != nil {
+		in, out := &in.CacheCapacity, &out.CacheCapacity
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.CacheStorageClassName != nil {
+		in, out := &in.CacheStorageClassName, &out.CacheStorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.CacheAccessModes != nil {
+		in, out := &in.CacheAccessModes, &out.CacheAccessModes
+		*out = make([]v1.PersistentVolumeAccessMode, len(*in))
+		copy(*out, *in)
+	}
+	if in.CacheType != nil {
+		in, out := &in.CacheType, &out.CacheType
+		*out = new(ResticCacheType)
+		**out = **in
+	}
+	if in.Previous != nil {
+		in, out := &in.Previous, &out.Previous
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RestoreAsOf != nil {
+		in, out := &in.RestoreAsOf, &out.RestoreAsOf
+		*out = new(string)
+		**out = **in
+	}
+	if in.SnapshotID != nil {
+		in, out := &in.SnapshotID, &out.SnapshotID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(string)
+		**out = **in
+	}
+	in.MoverConfig.DeepCopyInto(&out.MoverConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestinationResticSpec.
+func (in *ReplicationDestinationResticSpec) DeepCopy() *ReplicationDestinationResticSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationDestinationResticSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationDestinationRsyncSpec) DeepCopyInto(out *ReplicationDestinationRsyncSpec) {
+	*out = *in
+	in.ReplicationDestinationVolumeOptions.DeepCopyInto(&out.ReplicationDestinationVolumeOptions)
+	if in.VolumeMode != nil {
+		in, out := &in.VolumeMode, &out.VolumeMode
+		*out = new(v1.PersistentVolumeMode)
+		**out = **in
+	}
+	if in.SSHKeys != nil {
+		in, out := &in.SSHKeys, &out.SSHKeys
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceType != nil {
+		in, out := &in.ServiceType, &out.ServiceType
+		*out = new(v1.ServiceType)
+		**out = **in
+	}
+	if in.ServiceAnnotations != nil {
+		in, out := &in.ServiceAnnotations, &out.ServiceAnnotations
+		*out = new(map[string]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make(map[string]string, len(*in))
+			for key, val := range *in {
+				(*out)[key] = val
+			}
+		}
+	}
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.SSHUser != nil {
+		in, out := &in.SSHUser, &out.SSHUser
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostKeyRotationInterval != nil {
+		in, out := &in.HostKeyRotationInterval, &out.HostKeyRotationInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MoverServiceAccount != nil {
+		in, out := &in.MoverServiceAccount, &out.MoverServiceAccount
+		*out = new(string)
+		**out = **in
+	}
+	if in.MoverImage != nil {
+		in, out := &in.MoverImage, &out.MoverImage
+		*out = new(string)
+		**out = **in
+	}
+	if in.MoverPodLabels != nil {
+		in, out := &in.MoverPodLabels, &out.MoverPodLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MoverPodAnnotations != nil {
+		in, out := &in.MoverPodAnnotations, &out.MoverPodAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MoverResources != nil {
+		in, out := &in.MoverResources, &out.MoverResources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MoverPriorityClassName != nil {
+		in, out := &in.MoverPriorityClassName, &out.MoverPriorityClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.MoverSchedulerName != nil {
+		in, out := &in.MoverSchedulerName, &out.MoverSchedulerName
+		*out = new(string)
+		**out = **in
+	}
+	if in.MoverEnv != nil {
+		in, out := &in.MoverEnv, &out.MoverEnv
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MoverServiceAccountTokens != nil {
+		in, out := &in.MoverServiceAccountTokens, &out.MoverServiceAccountTokens
+		*out = make([]MoverServiceAccountToken, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MoverTimeout != nil {
+		in, out := &in.MoverTimeout, &out.MoverTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestinationRsyncSpec.
 func (in *ReplicationDestinationRsyncSpec) DeepCopy() *ReplicationDestinationRsyncSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationDestinationRsyncSpec)
+	out := new(ReplicationDestinationRsyncSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationDestinationRsyncStatus) DeepCopyInto(out *ReplicationDestinationRsyncStatus) {
+	*out = *in
+	if in.SSHKeys != nil {
+		in, out := &in.SSHKeys, &out.SSHKeys
+		*out = new(string)
+		**out = **in
+	}
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.HostKeyFingerprints != nil {
+		in, out := &in.HostKeyFingerprints, &out.HostKeyFingerprints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastHostKeyRotation != nil {
+		in, out := &in.LastHostKeyRotation, &out.LastHostKeyRotation
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestinationRsyncStatus.
+func (in *ReplicationDestinationRsyncStatus) DeepCopy() *ReplicationDestinationRsyncStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationDestinationRsyncStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationDestinationRsyncTLSSpec) DeepCopyInto(out *ReplicationDestinationRsyncTLSSpec) {
+	*out = *in
+	in.ReplicationDestinationVolumeOptions.DeepCopyInto(&out.ReplicationDestinationVolumeOptions)
+	if in.VolumeMode != nil {
+		in, out := &in.VolumeMode, &out.VolumeMode
+		*out = new(v1.PersistentVolumeMode)
+		**out = **in
+	}
+	if in.KeySecret != nil {
+		in, out := &in.KeySecret, &out.KeySecret
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeySecretRef != nil {
+		in, out := &in.KeySecretRef, &out.KeySecretRef
+		*out = new(MoverSecretRef)
+		**out = **in
+	}
+	if in.ServiceType != nil {
+		in, out := &in.ServiceType, &out.ServiceType
+		*out = new(v1.ServiceType)
+		**out = **in
+	}
+	if in.ServiceAnnotations != nil {
+		in, out := &in.ServiceAnnotations, &out.ServiceAnnotations
+		*out = new(map[string]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make(map[string]string, len(*in))
+			for key, val := range *in {
+				(*out)[key] = val
+			}
+		}
+	}
+	if in.ExternalAddress != nil {
+		in, out := &in.ExternalAddress, &out.ExternalAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.TCPRoute != nil {
+		in, out := &in.TCPRoute, &out.TCPRoute
+		*out = new(RsyncTLSTCPRouteSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.MoverConfig.DeepCopyInto(&out.MoverConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestinationRsyncTLSSpec.
+func (in *ReplicationDestinationRsyncTLSSpec) DeepCopy() *ReplicationDestinationRsyncTLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationDestinationRsyncTLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationDestinationRsyncTLSStatus) DeepCopyInto(out *ReplicationDestinationRsyncTLSStatus) {
+	*out = *in
+	if in.KeySecret != nil {
+		in, out := &in.KeySecret, &out.KeySecret
+		*out = new(string)
+		**out = **in
+	}
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestinationRsyncTLSStatus.
+func (in *ReplicationDestinationRsyncTLSStatus) DeepCopy() *ReplicationDestinationRsyncTLSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationDestinationRsyncTLSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationDestinationSpec) DeepCopyInto(out *ReplicationDestinationSpec) {
+	*out = *in
+	if in.Trigger != nil {
+		in, out := &in.Trigger, &out.Trigger
+		*out = new(ReplicationDestinationTriggerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rsync != nil {
+		in, out := &in.Rsync, &out.Rsync
+		*out = new(ReplicationDestinationRsyncSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RsyncTLS != nil {
+		in, out := &in.RsyncTLS, &out.RsyncTLS
+		*out = new(ReplicationDestinationRsyncTLSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Block != nil {
+		in, out := &in.Block, &out.Block
+		*out = new(ReplicationDestinationBlockSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NFS != nil {
+		in, out := &in.NFS, &out.NFS
+		*out = new(ReplicationDestinationNFSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mock != nil {
+		in, out := &in.Mock, &out.Mock
+		*out = new(ReplicationDestinationMockSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rclone != nil {
+		in, out := &in.Rclone, &out.Rclone
+		*out = new(ReplicationDestinationRcloneSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Restic != nil {
+		in, out := &in.Restic, &out.Restic
+		*out = new(ReplicationDestinationResticSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(ReplicationDestinationExternalSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StaleAfter != nil {
+		in, out := &in.StaleAfter, &out.StaleAfter
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = new(NotificationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkloadCoordination != nil {
+		in, out := &in.WorkloadCoordination, &out.WorkloadCoordination
+		*out = new(WorkloadCoordinationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PopulatorNamespaces != nil {
+		in, out := &in.PopulatorNamespaces, &out.PopulatorNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CapacityForecast != nil {
+		in, out := &in.CapacityForecast, &out.CapacityForecast
+		*out = new(CapacityForecastSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Promote != nil {
+		in, out := &in.Promote, &out.Promote
+		*out = new(PromoteSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KeepLatestImages != nil {
+		in, out := &in.KeepLatestImages, &out.KeepLatestImages
+		*out = new(int32)
+		**out = **in
+	}
+	if in.StandbyValidation != nil {
+		in, out := &in.StandbyValidation, &out.StandbyValidation
+		*out = new(StandbyValidationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestinationSpec.
+func (in *ReplicationDestinationSpec) DeepCopy() *ReplicationDestinationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationDestinationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationDestinationStatus) DeepCopyInto(out *ReplicationDestinationStatus) {
+	*out = *in
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSyncStartTime != nil {
+		in, out := &in.LastSyncStartTime, &out.LastSyncStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSyncDuration != nil {
+		in, out := &in.LastSyncDuration, &out.LastSyncDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.NextSyncTime != nil {
+		in, out := &in.NextSyncTime, &out.NextSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LatestImage != nil {
+		in, out := &in.LatestImage, &out.LatestImage
+		*out = new(v1.TypedLocalObjectReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LatestImages != nil {
+		in, out := &in.LatestImages, &out.LatestImages
+		*out = make([]RetainedImage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LatestMoverStatus != nil {
+		in, out := &in.LatestMoverStatus, &out.LatestMoverStatus
+		*out = new(MoverStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]SyncHistoryEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Retries != nil {
+		in, out := &in.Retries, &out.Retries
+		*out = new(RetryStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CleanupWarnings != nil {
+		in, out := &in.CleanupWarnings, &out.CleanupWarnings
+		*out = make([]CleanupWarning, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Provenance != nil {
+		in, out := &in.Provenance, &out.Provenance
+		*out = new(RestoreProvenance)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(RestoreVerification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RestoreSize != nil {
+		in, out := &in.RestoreSize, &out.RestoreSize
+		*out = new(RestoreSize)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkloadCoordination != nil {
+		in, out := &in.WorkloadCoordination, &out.WorkloadCoordination
+		*out = new(WorkloadCoordinationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CapacityForecast != nil {
+		in, out := &in.CapacityForecast, &out.CapacityForecast
+		*out = new(CapacityForecastStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = new(SecurityStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectionTest != nil {
+		in, out := &in.ConnectionTest, &out.ConnectionTest
+		*out = new(ConnectionTestStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Promotion != nil {
+		in, out := &in.Promotion, &out.Promotion
+		*out = new(PromotionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StandbyValidation != nil {
+		in, out := &in.StandbyValidation, &out.StandbyValidation
+		*out = new(StandbyValidationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rsync != nil {
+		in, out := &in.Rsync, &out.Rsync
+		*out = new(ReplicationDestinationRsyncStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RsyncTLS != nil {
+		in, out := &in.RsyncTLS, &out.RsyncTLS
+		*out = new(ReplicationDestinationRsyncTLSStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Block != nil {
+		in, out := &in.Block, &out.Block
+		*out = new(ReplicationDestinationBlockStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestinationStatus.
+func (in *ReplicationDestinationStatus) DeepCopy() *ReplicationDestinationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationDestinationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationDestinationTriggerSpec) DeepCopyInto(out *ReplicationDestinationTriggerSpec) {
+	*out = *in
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestinationTriggerSpec.
+func (in *ReplicationDestinationTriggerSpec) DeepCopy() *ReplicationDestinationTriggerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationDestinationTriggerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationDestinationVolumeOptions) DeepCopyInto(out *ReplicationDestinationVolumeOptions) {
+	*out = *in
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AccessModes != nil {
+		in, out := &in.AccessModes, &out.AccessModes
+		*out = make([]v1.PersistentVolumeAccessMode, len(*in))
+		copy(*out, *in)
+	}
+	if in.VolumeSnapshotClassName != nil {
+		in, out := &in.VolumeSnapshotClassName, &out.VolumeSnapshotClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationPVC != nil {
+		in, out := &in.DestinationPVC, &out.DestinationPVC
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdoptDestinationPVC != nil {
+		in, out := &in.AdoptDestinationPVC, &out.AdoptDestinationPVC
+		*out = new(AdoptDestinationPVCSpec)
+		**out = **in
+	}
+	if in.SnapshotMetadata != nil {
+		in, out := &in.SnapshotMetadata, &out.SnapshotMetadata
+		*out = new(SnapshotMetadataSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SnapshotRetention != nil {
+		in, out := &in.SnapshotRetention, &out.SnapshotRetention
+		*out = new(SnapshotRetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestinationVolumeOptions.
+func (in *ReplicationDestinationVolumeOptions) DeepCopy() *ReplicationDestinationVolumeOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationDestinationVolumeOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationLink) DeepCopyInto(out *ReplicationLink) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(ReplicationLinkStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationLink.
+func (in *ReplicationLink) DeepCopy() *ReplicationLink {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationLink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReplicationLink) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationLinkEndpoint) DeepCopyInto(out *ReplicationLinkEndpoint) {
+	*out = *in
+	if in.KubeconfigSecretRef != nil {
+		in, out := &in.KubeconfigSecretRef, &out.KubeconfigSecretRef
+		*out = new(KubeconfigSecretRef)
+		**out = **in
+	}
+	out.RemoteObjectReference = in.RemoteObjectReference
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationLinkEndpoint.
+func (in *ReplicationLinkEndpoint) DeepCopy() *ReplicationLinkEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationLinkEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationLinkEndpointStatus) DeepCopyInto(out *ReplicationLinkEndpointStatus) {
+	*out = *in
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSyncDuration != nil {
+		in, out := &in.LastSyncDuration, &out.LastSyncDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.NextSyncTime != nil {
+		in, out := &in.NextSyncTime, &out.NextSyncTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationLinkEndpointStatus.
+func (in *ReplicationLinkEndpointStatus) DeepCopy() *ReplicationLinkEndpointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationLinkEndpointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationLinkList) DeepCopyInto(out *ReplicationLinkList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ReplicationLink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationLinkList.
+func (in *ReplicationLinkList) DeepCopy() *ReplicationLinkList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationLinkList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReplicationLinkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationLinkSpec) DeepCopyInto(out *ReplicationLinkSpec) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+	in.Destination.DeepCopyInto(&out.Destination)
+	if in.MaxLag != nil {
+		in, out := &in.MaxLag, &out.MaxLag
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationLinkSpec.
+func (in *ReplicationLinkSpec) DeepCopy() *ReplicationLinkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationLinkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationLinkStatus) DeepCopyInto(out *ReplicationLinkStatus) {
+	*out = *in
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(ReplicationLinkEndpointStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Destination != nil {
+		in, out := &in.Destination, &out.Destination
+		*out = new(ReplicationLinkEndpointStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Lag != nil {
+		in, out := &in.Lag, &out.Lag
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.LastCheckTime != nil {
+		in, out := &in.LastCheckTime, &out.LastCheckTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationLinkStatus.
+func (in *ReplicationLinkStatus) DeepCopy() *ReplicationLinkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationLinkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationPair) DeepCopyInto(out *ReplicationPair) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(ReplicationPairStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationPair.
+func (in *ReplicationPair) DeepCopy() *ReplicationPair {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationPair)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReplicationPair) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationPairList) DeepCopyInto(out *ReplicationPairList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ReplicationPair, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationPairList.
+func (in *ReplicationPairList) DeepCopy() *ReplicationPairList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationPairList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReplicationPairList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationPairResticSpec) DeepCopyInto(out *ReplicationPairResticSpec) {
+	*out = *in
+	in.ReplicationPairVolumeOptions.DeepCopyInto(&out.ReplicationPairVolumeOptions)
+	if in.Retain != nil {
+		in, out := &in.Retain, &out.Retain
+		*out = new(ResticRetainPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PruneIntervalDays != nil {
+		in, out := &in.PruneIntervalDays, &out.PruneIntervalDays
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationPairResticSpec.
+func (in *ReplicationPairResticSpec) DeepCopy() *ReplicationPairResticSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationPairResticSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationPairRsyncTLSSpec) DeepCopyInto(out *ReplicationPairRsyncTLSSpec) {
+	*out = *in
+	in.ReplicationPairVolumeOptions.DeepCopyInto(&out.ReplicationPairVolumeOptions)
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ServiceType != nil {
+		in, out := &in.ServiceType, &out.ServiceType
+		*out = new(v1.ServiceType)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationPairRsyncTLSSpec.
+func (in *ReplicationPairRsyncTLSSpec) DeepCopy() *ReplicationPairRsyncTLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationPairRsyncTLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationPairRsyncTLSStatus) DeepCopyInto(out *ReplicationPairRsyncTLSStatus) {
+	*out = *in
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationPairRsyncTLSStatus.
+func (in *ReplicationPairRsyncTLSStatus) DeepCopy() *ReplicationPairRsyncTLSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationPairRsyncTLSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationPairSpec) DeepCopyInto(out *ReplicationPairSpec) {
+	*out = *in
+	if in.Trigger != nil {
+		in, out := &in.Trigger, &out.Trigger
+		*out = new(ReplicationSourceTriggerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RsyncTLS != nil {
+		in, out := &in.RsyncTLS, &out.RsyncTLS
+		*out = new(ReplicationPairRsyncTLSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Restic != nil {
+		in, out := &in.Restic, &out.Restic
+		*out = new(ReplicationPairResticSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationPairSpec.
+func (in *ReplicationPairSpec) DeepCopy() *ReplicationPairSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationPairSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationPairStatus) DeepCopyInto(out *ReplicationPairStatus) {
+	*out = *in
+	if in.LastRoleChangeTime != nil {
+		in, out := &in.LastRoleChangeTime, &out.LastRoleChangeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.RsyncTLS != nil {
+		in, out := &in.RsyncTLS, &out.RsyncTLS
+		*out = new(ReplicationPairRsyncTLSStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationPairStatus.
+func (in *ReplicationPairStatus) DeepCopy() *ReplicationPairStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationPairStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationPairVolumeOptions) DeepCopyInto(out *ReplicationPairVolumeOptions) {
+	*out = *in
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.VolumeSnapshotClassName != nil {
+		in, out := &in.VolumeSnapshotClassName, &out.VolumeSnapshotClassName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationPairVolumeOptions.
+func (in *ReplicationPairVolumeOptions) DeepCopy() *ReplicationPairVolumeOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationPairVolumeOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationPolicy) DeepCopyInto(out *ReplicationPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(ReplicationPolicyStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationPolicy.
+func (in *ReplicationPolicy) DeepCopy() *ReplicationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReplicationPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationPolicyFailingSource) DeepCopyInto(out *ReplicationPolicyFailingSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationPolicyFailingSource.
+func (in *ReplicationPolicyFailingSource) DeepCopy() *ReplicationPolicyFailingSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationPolicyFailingSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationPolicyList) DeepCopyInto(out *ReplicationPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ReplicationPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationPolicyList.
+func (in *ReplicationPolicyList) DeepCopy() *ReplicationPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReplicationPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationPolicyRepositoryTemplate) DeepCopyInto(out *ReplicationPolicyRepositoryTemplate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationPolicyRepositoryTemplate.
+func (in *ReplicationPolicyRepositoryTemplate) DeepCopy() *ReplicationPolicyRepositoryTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationPolicyRepositoryTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationPolicySourceTemplate) DeepCopyInto(out *ReplicationPolicySourceTemplate) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationPolicySourceTemplate.
+func (in *ReplicationPolicySourceTemplate) DeepCopy() *ReplicationPolicySourceTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationPolicySourceTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationPolicySpec) DeepCopyInto(out *ReplicationPolicySpec) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.PVCSelector.DeepCopyInto(&out.PVCSelector)
+	in.SourceTemplate.DeepCopyInto(&out.SourceTemplate)
+	if in.RepositoryTemplate != nil {
+		in, out := &in.RepositoryTemplate, &out.RepositoryTemplate
+		*out = new(ReplicationPolicyRepositoryTemplate)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationPolicySpec.
+func (in *ReplicationPolicySpec) DeepCopy() *ReplicationPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationPolicyStatus) DeepCopyInto(out *ReplicationPolicyStatus) {
+	*out = *in
+	if in.Failing != nil {
+		in, out := &in.Failing, &out.Failing
+		*out = make([]ReplicationPolicyFailingSource, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastReconcileTime != nil {
+		in, out := &in.LastReconcileTime, &out.LastReconcileTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationPolicyStatus.
+func (in *ReplicationPolicyStatus) DeepCopy() *ReplicationPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSchedulePolicy) DeepCopyInto(out *ReplicationSchedulePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSchedulePolicy.
+func (in *ReplicationSchedulePolicy) DeepCopy() *ReplicationSchedulePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSchedulePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReplicationSchedulePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSchedulePolicyList) DeepCopyInto(out *ReplicationSchedulePolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ReplicationSchedulePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSchedulePolicyList.
+func (in *ReplicationSchedulePolicyList) DeepCopy() *ReplicationSchedulePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSchedulePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReplicationSchedulePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSchedulePolicySpec) DeepCopyInto(out *ReplicationSchedulePolicySpec) {
+	*out = *in
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllowedWindows != nil {
+		in, out := &in.AllowedWindows, &out.AllowedWindows
+		*out = make([]ScheduleWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.Blackouts != nil {
+		in, out := &in.Blackouts, &out.Blackouts
+		*out = make([]ScheduleWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.Freezes != nil {
+		in, out := &in.Freezes, &out.Freezes
+		*out = make([]ScheduleFreeze, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSchedulePolicySpec.
+func (in *ReplicationSchedulePolicySpec) DeepCopy() *ReplicationSchedulePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSchedulePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSource) DeepCopyInto(out *ReplicationSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(ReplicationSourceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSource.
+func (in *ReplicationSource) DeepCopy() *ReplicationSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReplicationSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSourceBlockSpec) DeepCopyInto(out *ReplicationSourceBlockSpec) {
+	*out = *in
+	in.ReplicationSourceVolumeOptions.DeepCopyInto(&out.ReplicationSourceVolumeOptions)
+	if in.KeySecret != nil {
+		in, out := &in.KeySecret, &out.KeySecret
+		*out = new(string)
+		**out = **in
+	}
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	in.MoverConfig.DeepCopyInto(&out.MoverConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceBlockSpec.
+func (in *ReplicationSourceBlockSpec) DeepCopy() *ReplicationSourceBlockSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSourceBlockSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSourceBlockStatus) DeepCopyInto(out *ReplicationSourceBlockStatus) {
+	*out = *in
+	if in.KeySecret != nil {
+		in, out := &in.KeySecret, &out.KeySecret
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceBlockStatus.
+func (in *ReplicationSourceBlockStatus) DeepCopy() *ReplicationSourceBlockStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSourceBlockStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSourceCopyTriggerSpec) DeepCopyInto(out *ReplicationSourceCopyTriggerSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceCopyTriggerSpec.
+func (in *ReplicationSourceCopyTriggerSpec) DeepCopy() *ReplicationSourceCopyTriggerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSourceCopyTriggerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSourceCopyTriggerStatus) DeepCopyInto(out *ReplicationSourceCopyTriggerStatus) {
+	*out = *in
+	if in.WaitingSince != nil {
+		in, out := &in.WaitingSince, &out.WaitingSince
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceCopyTriggerStatus.
+func (in *ReplicationSourceCopyTriggerStatus) DeepCopy() *ReplicationSourceCopyTriggerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSourceCopyTriggerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSourceExternalSpec) DeepCopyInto(out *ReplicationSourceExternalSpec) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceExternalSpec.
+func (in *ReplicationSourceExternalSpec) DeepCopy() *ReplicationSourceExternalSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSourceExternalSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSourceHooksSpec) DeepCopyInto(out *ReplicationSourceHooksSpec) {
+	*out = *in
+	if in.PreSync != nil {
+		in, out := &in.PreSync, &out.PreSync
+		*out = new(SyncHook)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PostSync != nil {
+		in, out := &in.PostSync, &out.PostSync
+		*out = new(SyncHook)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceHooksSpec.
+func (in *ReplicationSourceHooksSpec) DeepCopy() *ReplicationSourceHooksSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSourceHooksSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSourceIOGateSpec) DeepCopyInto(out *ReplicationSourceIOGateSpec) {
+	*out = *in
+	in.Prometheus.DeepCopyInto(&out.Prometheus)
+	out.Threshold = in.Threshold.DeepCopy()
+	if in.MaxDelay != nil {
+		in, out := &in.MaxDelay, &out.MaxDelay
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.CheckInterval != nil {
+		in, out := &in.CheckInterval, &out.CheckInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceIOGateSpec.
+func (in *ReplicationSourceIOGateSpec) DeepCopy() *ReplicationSourceIOGateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSourceIOGateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSourceIOGateStatus) DeepCopyInto(out *ReplicationSourceIOGateStatus) {
+	*out = *in
+	if in.DeferredSince != nil {
+		in, out := &in.DeferredSince, &out.DeferredSince
+		*out = (*in).DeepCopy()
+	}
+	if in.LastCheckTime != nil {
+		in, out := &in.LastCheckTime, &out.LastCheckTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceIOGateStatus.
+func (in *ReplicationSourceIOGateStatus) DeepCopy() *ReplicationSourceIOGateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSourceIOGateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSourceIntermediateRetentionSpec) DeepCopyInto(out *ReplicationSourceIntermediateRetentionSpec) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceIntermediateRetentionSpec.
+func (in *ReplicationSourceIntermediateRetentionSpec) DeepCopy() *ReplicationSourceIntermediateRetentionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSourceIntermediateRetentionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSourceList) DeepCopyInto(out *ReplicationSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ReplicationSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceList.
+func (in *ReplicationSourceList) DeepCopy() *ReplicationSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReplicationSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSourceMockSpec) DeepCopyInto(out *ReplicationSourceMockSpec) {
+	*out = *in
+	in.ReplicationSourceVolumeOptions.DeepCopyInto(&out.ReplicationSourceVolumeOptions)
+	in.MockSettings.DeepCopyInto(&out.MockSettings)
+	in.MoverConfig.DeepCopyInto(&out.MoverConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceMockSpec.
+func (in *ReplicationSourceMockSpec) DeepCopy() *ReplicationSourceMockSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSourceMockSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSourceNFSSpec) DeepCopyInto(out *ReplicationSourceNFSSpec) {
+	*out = *in
+	in.ReplicationSourceVolumeOptions.DeepCopyInto(&out.ReplicationSourceVolumeOptions)
+	in.NFSExport.DeepCopyInto(&out.NFSExport)
+	in.MoverConfig.DeepCopyInto(&out.MoverConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceNFSSpec.
+func (in *ReplicationSourceNFSSpec) DeepCopy() *ReplicationSourceNFSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSourceNFSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSourceRcloneSpec) DeepCopyInto(out *ReplicationSourceRcloneSpec) {
+	*out = *in
+	in.ReplicationSourceVolumeOptions.DeepCopyInto(&out.ReplicationSourceVolumeOptions)
+	if in.RcloneConfigSection != nil {
+		in, out := &in.RcloneConfigSection, &out.RcloneConfigSection
+		*out = new(string)
+		**out = **in
+	}
+	if in.RcloneDestPath != nil {
+		in, out := &in.RcloneDestPath, &out.RcloneDestPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.RcloneConfig != nil {
+		in, out := &in.RcloneConfig, &out.RcloneConfig
+		*out = new(string)
+		**out = **in
+	}
+	if in.RcloneSourcePath != nil {
+		in, out := &in.RcloneSourcePath, &out.RcloneSourcePath
+		*out = new(string)
+		**out = **in
+	}
+	if in.RcloneSourceConfigSection != nil {
+		in, out := &in.RcloneSourceConfigSection, &out.RcloneSourceConfigSection
+		*out = new(string)
+		**out = **in
+	}
+	if in.Remote != nil {
+		in, out := &in.Remote, &out.Remote
+		*out = new(RcloneRemoteSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Parallelism != nil {
+		in, out := &in.Parallelism, &out.Parallelism
+		*out = new(int32)
+		**out = **in
+	}
+	out.CustomCA = in.CustomCA
+	in.MoverConfig.DeepCopyInto(&out.MoverConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceRcloneSpec.
+func (in *ReplicationSourceRcloneSpec) DeepCopy() *ReplicationSourceRcloneSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSourceRcloneSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSourceResticCA) DeepCopyInto(out *ReplicationSourceResticCA) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceResticCA.
+func (in *ReplicationSourceResticCA) DeepCopy() *ReplicationSourceResticCA {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSourceResticCA)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSourceResticSpec) DeepCopyInto(out *ReplicationSourceResticSpec) {
+	*out = *in
+	in.ReplicationSourceVolumeOptions.DeepCopyInto(&out.ReplicationSourceVolumeOptions)
+	if in.PruneIntervalDays != nil {
+		in, out := &in.PruneIntervalDays, &out.PruneIntervalDays
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RepositorySecretRef != nil {
+		in, out := &in.RepositorySecretRef, &out.RepositorySecretRef
+		*out = new(MoverSecretRef)
+		**out = **in
+	}
+	if in.Parallelism != nil {
+		in, out := &in.Parallelism, &out.Parallelism
+		*out = new(int32)
+		**out = **in
+	}
+	out.CustomCA = in.CustomCA
+	if in.Retain != nil {
+		in, out := &in.Retain, &out.Retain
+		*out = new(ResticRetainPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CacheCapacity != nil {
+		in, out := &in.CacheCapacity, &out.CacheCapacity
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.CacheStorageClassName != nil {
+		in, out := &in.CacheStorageClassName, &out.CacheStorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.CacheAccessModes != nil {
+		in, out := &in.CacheAccessModes, &out.CacheAccessModes
+		*out = make([]v1.PersistentVolumeAccessMode, len(*in))
+		copy(*out, *in)
+	}
+	if in.CacheType != nil {
+		in, out := &in.CacheType, &out.CacheType
+		*out = new(ResticCacheType)
+		**out = **in
+	}
+	if in.CacheCleanupPolicy != nil {
+		in, out := &in.CacheCleanupPolicy, &out.CacheCleanupPolicy
+		*out = new(ResticCacheCleanupPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutomaticUnlock != nil {
+		in, out := &in.AutomaticUnlock, &out.AutomaticUnlock
+		*out = new(ResticAutomaticUnlockSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KeepCopyPointSnapshot != nil {
+		in, out := &in.KeepCopyPointSnapshot, &out.KeepCopyPointSnapshot
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ObjectLock != nil {
+		in, out := &in.ObjectLock, &out.ObjectLock
+		*out = new(ResticObjectLockSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(string)
+		**out = **in
+	}
+	in.MoverConfig.DeepCopyInto(&out.MoverConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceResticSpec.
+func (in *ReplicationSourceResticSpec) DeepCopy() *ReplicationSourceResticSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSourceResticSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSourceResticStatus) DeepCopyInto(out *ReplicationSourceResticStatus) {
+	*out = *in
+	if in.LastPruned != nil {
+		in, out := &in.LastPruned, &out.LastPruned
+		*out = (*in).DeepCopy()
+	}
+	if in.LastAutomaticUnlock != nil {
+		in, out := &in.LastAutomaticUnlock, &out.LastAutomaticUnlock
+		*out = new(ResticAutomaticUnlockStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ObjectLock != nil {
+		in, out := &in.ObjectLock, &out.ObjectLock
+		*out = new(ResticObjectLockStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastPasswordChange != nil {
+		in, out := &in.LastPasswordChange, &out.LastPasswordChange
+		*out = new(ResticPasswordChangeStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SuspectedCorruptFiles != nil {
+		in, out := &in.SuspectedCorruptFiles, &out.SuspectedCorruptFiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CacheUsage != nil {
+		in, out := &in.CacheUsage, &out.CacheUsage
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.LastIntegrityManifest != nil {
+		in, out := &in.LastIntegrityManifest, &out.LastIntegrityManifest
+		*out = new(ResticIntegrityManifestStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RepositoryStats != nil {
+		in, out := &in.RepositoryStats, &out.RepositoryStats
+		*out = new(ResticRepositoryStats)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceResticStatus.
+func (in *ReplicationSourceResticStatus) DeepCopy() *ReplicationSourceResticStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSourceResticStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSourceRsyncSpec) DeepCopyInto(out *ReplicationSourceRsyncSpec) {
+	*out = *in
+	in.ReplicationSourceVolumeOptions.DeepCopyInto(&out.ReplicationSourceVolumeOptions)
+	if in.SSHKeys != nil {
+		in, out := &in.SSHKeys, &out.SSHKeys
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceType != nil {
+		in, out := &in.ServiceType, &out.ServiceType
+		*out = new(v1.ServiceType)
+		**out = **in
+	}
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ProxyJump != nil {
+		in, out := &in.ProxyJump, &out.ProxyJump
+		*out = new(RsyncProxyJumpSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.SSHUser != nil {
+		in, out := &in.SSHUser, &out.SSHUser
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExtraOptions != nil {
+		in, out := &in.ExtraOptions, &out.ExtraOptions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MoverServiceAccount != nil {
+		in, out := &in.MoverServiceAccount, &out.MoverServiceAccount
+		*out = new(string)
+		**out = **in
+	}
+	if in.MoverImage != nil {
+		in, out := &in.MoverImage, &out.MoverImage
+		*out = new(string)
+		**out = **in
+	}
+	if in.MoverPodLabels != nil {
+		in, out := &in.MoverPodLabels, &out.MoverPodLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MoverPodAnnotations != nil {
+		in, out := &in.MoverPodAnnotations, &out.MoverPodAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MoverResources != nil {
+		in, out := &in.MoverResources, &out.MoverResources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MoverPriorityClassName != nil {
+		in, out := &in.MoverPriorityClassName, &out.MoverPriorityClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.MoverSchedulerName != nil {
+		in, out := &in.MoverSchedulerName, &out.MoverSchedulerName
+		*out = new(string)
+		**out = **in
+	}
+	if in.MoverEnv != nil {
+		in, out := &in.MoverEnv, &out.MoverEnv
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MoverServiceAccountTokens != nil {
+		in, out := &in.MoverServiceAccountTokens, &out.MoverServiceAccountTokens
+		*out = make([]MoverServiceAccountToken, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MoverTimeout != nil {
+		in, out := &in.MoverTimeout, &out.MoverTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceRsyncSpec.
+func (in *ReplicationSourceRsyncSpec) DeepCopy() *ReplicationSourceRsyncSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSourceRsyncSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSourceRsyncStatus) DeepCopyInto(out *ReplicationSourceRsyncStatus) {
+	*out = *in
+	if in.SSHKeys != nil {
+		in, out := &in.SSHKeys, &out.SSHKeys
+		*out = new(string)
+		**out = **in
+	}
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TransferStats != nil {
+		in, out := &in.TransferStats, &out.TransferStats
+		*out = new(RsyncTransferStats)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceRsyncStatus.
+func (in *ReplicationSourceRsyncStatus) DeepCopy() *ReplicationSourceRsyncStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSourceRsyncStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSourceRsyncTLSSpec) DeepCopyInto(out *ReplicationSourceRsyncTLSSpec) {
+	*out = *in
+	in.ReplicationSourceVolumeOptions.DeepCopyInto(&out.ReplicationSourceVolumeOptions)
+	if in.KeySecret != nil {
+		in, out := &in.KeySecret, &out.KeySecret
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeySecretRef != nil {
+		in, out := &in.KeySecretRef, &out.KeySecretRef
+		*out = new(MoverSecretRef)
+		**out = **in
+	}
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	in.MoverConfig.DeepCopyInto(&out.MoverConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceRsyncTLSSpec.
+func (in *ReplicationSourceRsyncTLSSpec) DeepCopy() *ReplicationSourceRsyncTLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSourceRsyncTLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSourceRsyncTLSStatus) DeepCopyInto(out *ReplicationSourceRsyncTLSStatus) {
+	*out = *in
+	if in.KeySecret != nil {
+		in, out := &in.KeySecret, &out.KeySecret
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransferStats != nil {
+		in, out := &in.TransferStats, &out.TransferStats
+		*out = new(RsyncTransferStats)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceRsyncTLSStatus.
+func (in *ReplicationSourceRsyncTLSStatus) DeepCopy() *ReplicationSourceRsyncTLSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSourceRsyncTLSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSourceSpec) DeepCopyInto(out *ReplicationSourceSpec) {
+	*out = *in
+	if in.SourcePVCs != nil {
+		in, out := &in.SourcePVCs, &out.SourcePVCs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Trigger != nil {
+		in, out := &in.Trigger, &out.Trigger
+		*out = new(ReplicationSourceTriggerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(ReplicationSourceHooksSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CopyTrigger != nil {
+		in, out := &in.CopyTrigger, &out.CopyTrigger
+		*out = new(ReplicationSourceCopyTriggerSpec)
+		**out = **in
+	}
+	if in.IntermediateRetention != nil {
+		in, out := &in.IntermediateRetention, &out.IntermediateRetention
+		*out = new(ReplicationSourceIntermediateRetentionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IOGate != nil {
+		in, out := &in.IOGate, &out.IOGate
+		*out = new(ReplicationSourceIOGateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SchedulePolicy != nil {
+		in, out := &in.SchedulePolicy, &out.SchedulePolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.Rsync != nil {
+		in, out := &in.Rsync, &out.Rsync
+		*out = new(ReplicationSourceRsyncSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RsyncTLS != nil {
+		in, out := &in.RsyncTLS, &out.RsyncTLS
+		*out = new(ReplicationSourceRsyncTLSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Block != nil {
+		in, out := &in.Block, &out.Block
+		*out = new(ReplicationSourceBlockSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NFS != nil {
+		in, out := &in.NFS, &out.NFS
+		*out = new(ReplicationSourceNFSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mock != nil {
+		in, out := &in.Mock, &out.Mock
+		*out = new(ReplicationSourceMockSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rclone != nil {
+		in, out := &in.Rclone, &out.Rclone
+		*out = new(ReplicationSourceRcloneSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Restic != nil {
+		in, out := &in.Restic, &out.Restic
+		*out = new(ReplicationSourceResticSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Syncthing != nil {
+		in, out := &in.Syncthing, &out.Syncthing
+		*out = new(ReplicationSourceSyncthingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(ReplicationSourceExternalSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ErrorPolicy != nil {
+		in, out := &in.ErrorPolicy, &out.ErrorPolicy
+		*out = new(ErrorPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = new(NotificationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceSpec.
+func (in *ReplicationSourceSpec) DeepCopy() *ReplicationSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSourceStatus) DeepCopyInto(out *ReplicationSourceStatus) {
+	*out = *in
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSyncStartTime != nil {
+		in, out := &in.LastSyncStartTime, &out.LastSyncStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSyncDuration != nil {
+		in, out := &in.LastSyncDuration, &out.LastSyncDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.NextSyncTime != nil {
+		in, out := &in.NextSyncTime, &out.NextSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LatestMoverStatus != nil {
+		in, out := &in.LatestMoverStatus, &out.LatestMoverStatus
+		*out = new(MoverStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]SyncHistoryEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Retries != nil {
+		in, out := &in.Retries, &out.Retries
+		*out = new(RetryStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CleanupWarnings != nil {
+		in, out := &in.CleanupWarnings, &out.CleanupWarnings
+		*out = make([]CleanupWarning, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CopyTrigger != nil {
+		in, out := &in.CopyTrigger, &out.CopyTrigger
+		*out = new(ReplicationSourceCopyTriggerStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CopyMethodFallback != nil {
+		in, out := &in.CopyMethodFallback, &out.CopyMethodFallback
+		*out = new(CopyMethodFallbackStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IOGate != nil {
+		in, out := &in.IOGate, &out.IOGate
+		*out = new(ReplicationSourceIOGateStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = new(SecurityStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectionTest != nil {
+		in, out := &in.ConnectionTest, &out.ConnectionTest
+		*out = new(ConnectionTestStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rsync != nil {
+		in, out := &in.Rsync, &out.Rsync
+		*out = new(ReplicationSourceRsyncStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RsyncTLS != nil {
+		in, out := &in.RsyncTLS, &out.RsyncTLS
+		*out = new(ReplicationSourceRsyncTLSStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Block != nil {
+		in, out := &in.Block, &out.Block
+		*out = new(ReplicationSourceBlockStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Restic != nil {
+		in, out := &in.Restic, &out.Restic
+		*out = new(ReplicationSourceResticStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Syncthing != nil {
+		in, out := &in.Syncthing, &out.Syncthing
+		*out = new(ReplicationSourceSyncthingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceStatus.
+func (in *ReplicationSourceStatus) DeepCopy() *ReplicationSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSourceSyncthingSpec) DeepCopyInto(out *ReplicationSourceSyncthingSpec) {
+	*out = *in
+	if in.Peers != nil {
+		in, out := &in.Peers, &out.Peers
+		*out = make([]SyncthingPeer, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceType != nil {
+		in, out := &in.ServiceType, &out.ServiceType
+		*out = new(v1.ServiceType)
+		**out = **in
+	}
+	if in.ConfigCapacity != nil {
+		in, out := &in.ConfigCapacity, &out.ConfigCapacity
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.ConfigStorageClassName != nil {
+		in, out := &in.ConfigStorageClassName, &out.ConfigStorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConfigAccessModes != nil {
+		in, out := &in.ConfigAccessModes, &out.ConfigAccessModes
+		*out = make([]v1.PersistentVolumeAccessMode, len(*in))
+		copy(*out, *in)
+	}
+	in.MoverConfig.DeepCopyInto(&out.MoverConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceSyncthingSpec.
+func (in *ReplicationSourceSyncthingSpec) DeepCopy() *ReplicationSourceSyncthingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSourceSyncthingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSourceSyncthingStatus) DeepCopyInto(out *ReplicationSourceSyncthingStatus) {
+	*out = *in
+	if in.Peers != nil {
+		in, out := &in.Peers, &out.Peers
+		*out = make([]SyncthingPeerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CertificateExpiry != nil {
+		in, out := &in.CertificateExpiry, &out.CertificateExpiry
+		*out = (*in).DeepCopy()
+	}
+	if in.LastRotationTime != nil {
+		in, out := &in.LastRotationTime, &out.LastRotationTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceSyncthingStatus.
+func (in *ReplicationSourceSyncthingStatus) DeepCopy() *ReplicationSourceSyncthingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSourceSyncthingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSourceTriggerSpec) DeepCopyInto(out *ReplicationSourceTriggerSpec) {
+	*out = *in
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceTriggerSpec.
+func (in *ReplicationSourceTriggerSpec) DeepCopy() *ReplicationSourceTriggerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSourceTriggerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationSourceVolumeOptions) DeepCopyInto(out *ReplicationSourceVolumeOptions) {
+	*out = *in
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AccessModes != nil {
+		in, out := &in.AccessModes, &out.AccessModes
+		*out = make([]v1.PersistentVolumeAccessMode, len(*in))
+		copy(*out, *in)
+	}
+	if in.VolumeSnapshotClassName != nil {
+		in, out := &in.VolumeSnapshotClassName, &out.VolumeSnapshotClassName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceVolumeOptions.
+func (in *ReplicationSourceVolumeOptions) DeepCopy() *ReplicationSourceVolumeOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationSourceVolumeOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResticAutomaticUnlockSpec) DeepCopyInto(out *ResticAutomaticUnlockSpec) {
+	*out = *in
+	if in.StaleLockAge != nil {
+		in, out := &in.StaleLockAge, &out.StaleLockAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResticAutomaticUnlockSpec.
+func (in *ResticAutomaticUnlockSpec) DeepCopy() *ResticAutomaticUnlockSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResticAutomaticUnlockSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResticAutomaticUnlockStatus) DeepCopyInto(out *ResticAutomaticUnlockStatus) {
+	*out = *in
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = (*in).DeepCopy()
+	}
+	if in.OldestLockAge != nil {
+		in, out := &in.OldestLockAge, &out.OldestLockAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResticAutomaticUnlockStatus.
+func (in *ResticAutomaticUnlockStatus) DeepCopy() *ResticAutomaticUnlockStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResticAutomaticUnlockStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResticCacheCleanupPolicy) DeepCopyInto(out *ResticCacheCleanupPolicy) {
+	*out = *in
+	if in.MaxAgeDays != nil {
+		in, out := &in.MaxAgeDays, &out.MaxAgeDays
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResticCacheCleanupPolicy.
+func (in *ResticCacheCleanupPolicy) DeepCopy() *ResticCacheCleanupPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ResticCacheCleanupPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResticIntegrityManifestStatus) DeepCopyInto(out *ResticIntegrityManifestStatus) {
+	*out = *in
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResticIntegrityManifestStatus.
+func (in *ResticIntegrityManifestStatus) DeepCopy() *ResticIntegrityManifestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResticIntegrityManifestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResticObjectLockSpec) DeepCopyInto(out *ResticObjectLockSpec) {
+	*out = *in
+	if in.MinRetentionDays != nil {
+		in, out := &in.MinRetentionDays, &out.MinRetentionDays
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResticObjectLockSpec.
+func (in *ResticObjectLockSpec) DeepCopy() *ResticObjectLockSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResticObjectLockSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResticObjectLockStatus) DeepCopyInto(out *ResticObjectLockStatus) {
+	*out = *in
+	if in.RetentionDays != nil {
+		in, out := &in.RetentionDays, &out.RetentionDays
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LastChecked != nil {
+		in, out := &in.LastChecked, &out.LastChecked
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResticObjectLockStatus.
+func (in *ResticObjectLockStatus) DeepCopy() *ResticObjectLockStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResticObjectLockStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResticPasswordChangeStatus) DeepCopyInto(out *ResticPasswordChangeStatus) {
+	*out = *in
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResticPasswordChangeStatus.
+func (in *ResticPasswordChangeStatus) DeepCopy() *ResticPasswordChangeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResticPasswordChangeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResticRepositoryStats) DeepCopyInto(out *ResticRepositoryStats) {
+	*out = *in
+	if in.StoredSize != nil {
+		in, out := &in.StoredSize, &out.StoredSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.UncompressedSize != nil {
+		in, out := &in.UncompressedSize, &out.UncompressedSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.RestoreSize != nil {
+		in, out := &in.RestoreSize, &out.RestoreSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResticRepositoryStats.
+func (in *ResticRepositoryStats) DeepCopy() *ResticRepositoryStats {
+	if in == nil {
+		return nil
+	}
+	out := new(ResticRepositoryStats)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationDestinationRsyncStatus) DeepCopyInto(out *ReplicationDestinationRsyncStatus) {
+func (in *ResticRetainPolicy) DeepCopyInto(out *ResticRetainPolicy) {
 	*out = *in
-	if in.SSHKeys != nil {
-		in, out := &in.SSHKeys, &out.SSHKeys
+	if in.Hourly != nil {
+		in, out := &in.Hourly, &out.Hourly
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Daily != nil {
+		in, out := &in.Daily, &out.Daily
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Weekly != nil {
+		in, out := &in.Weekly, &out.Weekly
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Monthly != nil {
+		in, out := &in.Monthly, &out.Monthly
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Yearly != nil {
+		in, out := &in.Yearly, &out.Yearly
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Within != nil {
+		in, out := &in.Within, &out.Within
 		*out = new(string)
 		**out = **in
 	}
-	if in.Address != nil {
-		in, out := &in.Address, &out.Address
+	if in.Last != nil {
+		in, out := &in.Last, &out.Last
 		*out = new(string)
 		**out = **in
 	}
-	if in.Port != nil {
-		in, out := &in.Port, &out.Port
-		*out = new(int32)
+	if in.GroupBy != nil {
+		in, out := &in.GroupBy, &out.GroupBy
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestinationRsyncStatus.
-func (in *ReplicationDestinationRsyncStatus) DeepCopy() *ReplicationDestinationRsyncStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResticRetainPolicy.
+func (in *ResticRetainPolicy) DeepCopy() *ResticRetainPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationDestinationRsyncStatus)
+	out := new(ResticRetainPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationDestinationRsyncTLSSpec) DeepCopyInto(out *ReplicationDestinationRsyncTLSSpec) {
+func (in *RestoreFanout) DeepCopyInto(out *RestoreFanout) {
 	*out = *in
-	in.ReplicationDestinationVolumeOptions.DeepCopyInto(&out.ReplicationDestinationVolumeOptions)
-	if in.VolumeMode != nil {
-		in, out := &in.VolumeMode, &out.VolumeMode
-		*out = new(v1.PersistentVolumeMode)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(RestoreFanoutStatus)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.KeySecret != nil {
-		in, out := &in.KeySecret, &out.KeySecret
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreFanout.
+func (in *RestoreFanout) DeepCopy() *RestoreFanout {
+	if in == nil {
+		return nil
 	}
-	if in.ServiceType != nil {
-		in, out := &in.ServiceType, &out.ServiceType
-		*out = new(v1.ServiceType)
-		**out = **in
+	out := new(RestoreFanout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RestoreFanout) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.ServiceAnnotations != nil {
-		in, out := &in.ServiceAnnotations, &out.ServiceAnnotations
-		*out = new(map[string]string)
-		if **in != nil {
-			in, out := *in, *out
-			*out = make(map[string]string, len(*in))
-			for key, val := range *in {
-				(*out)[key] = val
-			}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreFanoutList) DeepCopyInto(out *RestoreFanoutList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RestoreFanout, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	in.MoverConfig.DeepCopyInto(&out.MoverConfig)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestinationRsyncTLSSpec.
-func (in *ReplicationDestinationRsyncTLSSpec) DeepCopy() *ReplicationDestinationRsyncTLSSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreFanoutList.
+func (in *RestoreFanoutList) DeepCopy() *RestoreFanoutList {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationDestinationRsyncTLSSpec)
+	out := new(RestoreFanoutList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RestoreFanoutList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationDestinationRsyncTLSStatus) DeepCopyInto(out *ReplicationDestinationRsyncTLSStatus) {
+func (in *RestoreFanoutRepository) DeepCopyInto(out *RestoreFanoutRepository) {
 	*out = *in
-	if in.KeySecret != nil {
-		in, out := &in.KeySecret, &out.KeySecret
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreFanoutRepository.
+func (in *RestoreFanoutRepository) DeepCopy() *RestoreFanoutRepository {
+	if in == nil {
+		return nil
 	}
-	if in.Address != nil {
-		in, out := &in.Address, &out.Address
-		*out = new(string)
-		**out = **in
+	out := new(RestoreFanoutRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreFanoutSpec) DeepCopyInto(out *RestoreFanoutSpec) {
+	*out = *in
+	out.Repository = in.Repository
+	in.Restic.DeepCopyInto(&out.Restic)
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]RestoreFanoutTarget, len(*in))
+		copy(*out, *in)
 	}
-	if in.Port != nil {
-		in, out := &in.Port, &out.Port
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Parallelism != nil {
+		in, out := &in.Parallelism, &out.Parallelism
 		*out = new(int32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestinationRsyncTLSStatus.
-func (in *ReplicationDestinationRsyncTLSStatus) DeepCopy() *ReplicationDestinationRsyncTLSStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreFanoutSpec.
+func (in *RestoreFanoutSpec) DeepCopy() *RestoreFanoutSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationDestinationRsyncTLSStatus)
+	out := new(RestoreFanoutSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationDestinationSpec) DeepCopyInto(out *ReplicationDestinationSpec) {
+func (in *RestoreFanoutStatus) DeepCopyInto(out *RestoreFanoutStatus) {
 	*out = *in
-	if in.Trigger != nil {
-		in, out := &in.Trigger, &out.Trigger
-		*out = new(ReplicationDestinationTriggerSpec)
-		(*in).DeepCopyInto(*out)
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]RestoreFanoutTargetStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Rsync != nil {
-		in, out := &in.Rsync, &out.Rsync
-		*out = new(ReplicationDestinationRsyncSpec)
-		(*in).DeepCopyInto(*out)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
 	}
-	if in.RsyncTLS != nil {
-		in, out := &in.RsyncTLS, &out.RsyncTLS
-		*out = new(ReplicationDestinationRsyncTLSSpec)
-		(*in).DeepCopyInto(*out)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Rclone != nil {
-		in, out := &in.Rclone, &out.Rclone
-		*out = new(ReplicationDestinationRcloneSpec)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreFanoutStatus.
+func (in *RestoreFanoutStatus) DeepCopy() *RestoreFanoutStatus {
+	if in == nil {
+		return nil
 	}
-	if in.Restic != nil {
-		in, out := &in.Restic, &out.Restic
-		*out = new(ReplicationDestinationResticSpec)
-		(*in).DeepCopyInto(*out)
+	out := new(RestoreFanoutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreFanoutTarget) DeepCopyInto(out *RestoreFanoutTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreFanoutTarget.
+func (in *RestoreFanoutTarget) DeepCopy() *RestoreFanoutTarget {
+	if in == nil {
+		return nil
 	}
-	if in.External != nil {
-		in, out := &in.External, &out.External
-		*out = new(ReplicationDestinationExternalSpec)
-		(*in).DeepCopyInto(*out)
+	out := new(RestoreFanoutTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreFanoutTargetStatus) DeepCopyInto(out *RestoreFanoutTargetStatus) {
+	*out = *in
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestinationSpec.
-func (in *ReplicationDestinationSpec) DeepCopy() *ReplicationDestinationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreFanoutTargetStatus.
+func (in *RestoreFanoutTargetStatus) DeepCopy() *RestoreFanoutTargetStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationDestinationSpec)
+	out := new(RestoreFanoutTargetStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationDestinationStatus) DeepCopyInto(out *ReplicationDestinationStatus) {
+func (in *RestoreProvenance) DeepCopyInto(out *RestoreProvenance) {
 	*out = *in
-	if in.LastSyncTime != nil {
-		in, out := &in.LastSyncTime, &out.LastSyncTime
+	if in.SnapshotTime != nil {
+		in, out := &in.SnapshotTime, &out.SnapshotTime
 		*out = (*in).DeepCopy()
 	}
-	if in.LastSyncStartTime != nil {
-		in, out := &in.LastSyncStartTime, &out.LastSyncStartTime
+	if in.RestoreTime != nil {
+		in, out := &in.RestoreTime, &out.RestoreTime
 		*out = (*in).DeepCopy()
 	}
-	if in.LastSyncDuration != nil {
-		in, out := &in.LastSyncDuration, &out.LastSyncDuration
-		*out = new(metav1.Duration)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreProvenance.
+func (in *RestoreProvenance) DeepCopy() *RestoreProvenance {
+	if in == nil {
+		return nil
 	}
-	if in.NextSyncTime != nil {
-		in, out := &in.NextSyncTime, &out.NextSyncTime
+	out := new(RestoreProvenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreSize) DeepCopyInto(out *RestoreSize) {
+	*out = *in
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
 		*out = (*in).DeepCopy()
 	}
-	if in.LatestImage != nil {
-		in, out := &in.LatestImage, &out.LatestImage
-		*out = new(v1.TypedLocalObjectReference)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.LatestMoverStatus != nil {
-		in, out := &in.LatestMoverStatus, &out.LatestMoverStatus
-		*out = new(MoverStatus)
-		**out = **in
-	}
-	if in.Rsync != nil {
-		in, out := &in.Rsync, &out.Rsync
-		*out = new(ReplicationDestinationRsyncStatus)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.RsyncTLS != nil {
-		in, out := &in.RsyncTLS, &out.RsyncTLS
-		*out = new(ReplicationDestinationRsyncTLSStatus)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.External != nil {
-		in, out := &in.External, &out.External
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreSize.
+func (in *RestoreSize) DeepCopy() *RestoreSize {
+	if in == nil {
+		return nil
 	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	out := new(RestoreSize)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreVerification) DeepCopyInto(out *RestoreVerification) {
+	*out = *in
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestinationStatus.
-func (in *ReplicationDestinationStatus) DeepCopy() *ReplicationDestinationStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreVerification.
+func (in *RestoreVerification) DeepCopy() *RestoreVerification {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationDestinationStatus)
+	out := new(RestoreVerification)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationDestinationTriggerSpec) DeepCopyInto(out *ReplicationDestinationTriggerSpec) {
+func (in *RetainedImage) DeepCopyInto(out *RetainedImage) {
 	*out = *in
-	if in.Schedule != nil {
-		in, out := &in.Schedule, &out.Schedule
-		*out = new(string)
-		**out = **in
+	in.Image.DeepCopyInto(&out.Image)
+	if in.SyncTime != nil {
+		in, out := &in.SyncTime, &out.SyncTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestinationTriggerSpec.
-func (in *ReplicationDestinationTriggerSpec) DeepCopy() *ReplicationDestinationTriggerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetainedImage.
+func (in *RetainedImage) DeepCopy() *RetainedImage {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationDestinationTriggerSpec)
+	out := new(RetainedImage)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationDestinationVolumeOptions) DeepCopyInto(out *ReplicationDestinationVolumeOptions) {
+func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
 	*out = *in
-	if in.Capacity != nil {
-		in, out := &in.Capacity, &out.Capacity
-		x := (*in).DeepCopy()
-		*out = &x
-	}
-	if in.StorageClassName != nil {
-		in, out := &in.StorageClassName, &out.StorageClassName
-		*out = new(string)
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int32)
 		**out = **in
 	}
-	if in.AccessModes != nil {
-		in, out := &in.AccessModes, &out.AccessModes
-		*out = make([]v1.PersistentVolumeAccessMode, len(*in))
-		copy(*out, *in)
-	}
-	if in.VolumeSnapshotClassName != nil {
-		in, out := &in.VolumeSnapshotClassName, &out.VolumeSnapshotClassName
-		*out = new(string)
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(metav1.Duration)
 		**out = **in
 	}
-	if in.DestinationPVC != nil {
-		in, out := &in.DestinationPVC, &out.DestinationPVC
-		*out = new(string)
+	if in.RetryDeadline != nil {
+		in, out := &in.RetryDeadline, &out.RetryDeadline
+		*out = new(metav1.Duration)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationDestinationVolumeOptions.
-func (in *ReplicationDestinationVolumeOptions) DeepCopy() *ReplicationDestinationVolumeOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicy.
+func (in *RetryPolicy) DeepCopy() *RetryPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationDestinationVolumeOptions)
+	out := new(RetryPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationSource) DeepCopyInto(out *ReplicationSource) {
+func (in *RetryStatus) DeepCopyInto(out *RetryStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(ReplicationSourceStatus)
-		(*in).DeepCopyInto(*out)
+	if in.FirstFailureTime != nil {
+		in, out := &in.FirstFailureTime, &out.FirstFailureTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastFailureTime != nil {
+		in, out := &in.LastFailureTime, &out.LastFailureTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextRetryTime != nil {
+		in, out := &in.NextRetryTime, &out.NextRetryTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSource.
-func (in *ReplicationSource) DeepCopy() *ReplicationSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryStatus.
+func (in *RetryStatus) DeepCopy() *RetryStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationSource)
+	out := new(RetryStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ReplicationSource) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RsyncProxyJumpSpec) DeepCopyInto(out *RsyncProxyJumpSpec) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
 	}
-	return nil
+	if in.SSHUser != nil {
+		in, out := &in.SSHUser, &out.SSHUser
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RsyncProxyJumpSpec.
+func (in *RsyncProxyJumpSpec) DeepCopy() *RsyncProxyJumpSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RsyncProxyJumpSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationSourceExternalSpec) DeepCopyInto(out *ReplicationSourceExternalSpec) {
+func (in *RsyncTLSTCPRouteSpec) DeepCopyInto(out *RsyncTLSTCPRouteSpec) {
 	*out = *in
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
+	if in.GatewayNamespace != nil {
+		in, out := &in.GatewayNamespace, &out.GatewayNamespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.SectionName != nil {
+		in, out := &in.SectionName, &out.SectionName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
@@ -687,616 +4165,381 @@ func (in *ReplicationSourceExternalSpec) DeepCopyInto(out *ReplicationSourceExte
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceExternalSpec.
-func (in *ReplicationSourceExternalSpec) DeepCopy() *ReplicationSourceExternalSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RsyncTLSTCPRouteSpec.
+func (in *RsyncTLSTCPRouteSpec) DeepCopy() *RsyncTLSTCPRouteSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationSourceExternalSpec)
+	out := new(RsyncTLSTCPRouteSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationSourceList) DeepCopyInto(out *ReplicationSourceList) {
+func (in *RsyncTransferStats) DeepCopyInto(out *RsyncTransferStats) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ReplicationSource, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.DestinationSparseSupported != nil {
+		in, out := &in.DestinationSparseSupported, &out.DestinationSparseSupported
+		*out = new(bool)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceList.
-func (in *ReplicationSourceList) DeepCopy() *ReplicationSourceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RsyncTransferStats.
+func (in *RsyncTransferStats) DeepCopy() *RsyncTransferStats {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationSourceList)
+	out := new(RsyncTransferStats)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ReplicationSourceList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationSourceRcloneSpec) DeepCopyInto(out *ReplicationSourceRcloneSpec) {
+func (in *ScaledWorkload) DeepCopyInto(out *ScaledWorkload) {
 	*out = *in
-	in.ReplicationSourceVolumeOptions.DeepCopyInto(&out.ReplicationSourceVolumeOptions)
-	if in.RcloneConfigSection != nil {
-		in, out := &in.RcloneConfigSection, &out.RcloneConfigSection
-		*out = new(string)
-		**out = **in
-	}
-	if in.RcloneDestPath != nil {
-		in, out := &in.RcloneDestPath, &out.RcloneDestPath
-		*out = new(string)
-		**out = **in
-	}
-	if in.RcloneConfig != nil {
-		in, out := &in.RcloneConfig, &out.RcloneConfig
-		*out = new(string)
-		**out = **in
-	}
-	out.CustomCA = in.CustomCA
-	in.MoverConfig.DeepCopyInto(&out.MoverConfig)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceRcloneSpec.
-func (in *ReplicationSourceRcloneSpec) DeepCopy() *ReplicationSourceRcloneSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaledWorkload.
+func (in *ScaledWorkload) DeepCopy() *ScaledWorkload {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationSourceRcloneSpec)
+	out := new(ScaledWorkload)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationSourceResticCA) DeepCopyInto(out *ReplicationSourceResticCA) {
+func (in *ScheduleFreeze) DeepCopyInto(out *ScheduleFreeze) {
 	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceResticCA.
-func (in *ReplicationSourceResticCA) DeepCopy() *ReplicationSourceResticCA {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleFreeze.
+func (in *ScheduleFreeze) DeepCopy() *ScheduleFreeze {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationSourceResticCA)
+	out := new(ScheduleFreeze)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationSourceResticSpec) DeepCopyInto(out *ReplicationSourceResticSpec) {
+func (in *ScheduleWindow) DeepCopyInto(out *ScheduleWindow) {
 	*out = *in
-	in.ReplicationSourceVolumeOptions.DeepCopyInto(&out.ReplicationSourceVolumeOptions)
-	if in.PruneIntervalDays != nil {
-		in, out := &in.PruneIntervalDays, &out.PruneIntervalDays
-		*out = new(int32)
-		**out = **in
-	}
-	out.CustomCA = in.CustomCA
-	if in.Retain != nil {
-		in, out := &in.Retain, &out.Retain
-		*out = new(ResticRetainPolicy)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.CacheCapacity != nil {
-		in, out := &in.CacheCapacity, &out.CacheCapacity
-		x := (*in).DeepCopy()
-		*out = &x
-	}
-	if in.CacheStorageClassName != nil {
-		in, out := &in.CacheStorageClassName, &out.CacheStorageClassName
-		*out = new(string)
-		**out = **in
-	}
-	if in.CacheAccessModes != nil {
-		in, out := &in.CacheAccessModes, &out.CacheAccessModes
-		*out = make([]v1.PersistentVolumeAccessMode, len(*in))
-		copy(*out, *in)
-	}
-	in.MoverConfig.DeepCopyInto(&out.MoverConfig)
+	out.Duration = in.Duration
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceResticSpec.
-func (in *ReplicationSourceResticSpec) DeepCopy() *ReplicationSourceResticSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleWindow.
+func (in *ScheduleWindow) DeepCopy() *ScheduleWindow {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationSourceResticSpec)
+	out := new(ScheduleWindow)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationSourceResticStatus) DeepCopyInto(out *ReplicationSourceResticStatus) {
+func (in *SecurityMeasure) DeepCopyInto(out *SecurityMeasure) {
 	*out = *in
-	if in.LastPruned != nil {
-		in, out := &in.LastPruned, &out.LastPruned
-		*out = (*in).DeepCopy()
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceResticStatus.
-func (in *ReplicationSourceResticStatus) DeepCopy() *ReplicationSourceResticStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityMeasure.
+func (in *SecurityMeasure) DeepCopy() *SecurityMeasure {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationSourceResticStatus)
+	out := new(SecurityMeasure)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationSourceRsyncSpec) DeepCopyInto(out *ReplicationSourceRsyncSpec) {
+func (in *SecurityStatus) DeepCopyInto(out *SecurityStatus) {
 	*out = *in
-	in.ReplicationSourceVolumeOptions.DeepCopyInto(&out.ReplicationSourceVolumeOptions)
-	if in.SSHKeys != nil {
-		in, out := &in.SSHKeys, &out.SSHKeys
-		*out = new(string)
-		**out = **in
-	}
-	if in.ServiceType != nil {
-		in, out := &in.ServiceType, &out.ServiceType
-		*out = new(v1.ServiceType)
-		**out = **in
-	}
-	if in.Address != nil {
-		in, out := &in.Address, &out.Address
-		*out = new(string)
-		**out = **in
-	}
-	if in.Port != nil {
-		in, out := &in.Port, &out.Port
-		*out = new(int32)
-		**out = **in
-	}
-	if in.Path != nil {
-		in, out := &in.Path, &out.Path
-		*out = new(string)
-		**out = **in
-	}
-	if in.SSHUser != nil {
-		in, out := &in.SSHUser, &out.SSHUser
-		*out = new(string)
-		**out = **in
-	}
-	if in.MoverServiceAccount != nil {
-		in, out := &in.MoverServiceAccount, &out.MoverServiceAccount
-		*out = new(string)
-		**out = **in
-	}
-	if in.MoverPodLabels != nil {
-		in, out := &in.MoverPodLabels, &out.MoverPodLabels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.MoverResources != nil {
-		in, out := &in.MoverResources, &out.MoverResources
-		*out = new(v1.ResourceRequirements)
-		(*in).DeepCopyInto(*out)
+	if in.Measures != nil {
+		in, out := &in.Measures, &out.Measures
+		*out = make([]SecurityMeasure, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceRsyncSpec.
-func (in *ReplicationSourceRsyncSpec) DeepCopy() *ReplicationSourceRsyncSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityStatus.
+func (in *SecurityStatus) DeepCopy() *SecurityStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationSourceRsyncSpec)
+	out := new(SecurityStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationSourceRsyncStatus) DeepCopyInto(out *ReplicationSourceRsyncStatus) {
+func (in *SnapshotMetadataSpec) DeepCopyInto(out *SnapshotMetadataSpec) {
 	*out = *in
-	if in.SSHKeys != nil {
-		in, out := &in.SSHKeys, &out.SSHKeys
-		*out = new(string)
-		**out = **in
-	}
-	if in.Address != nil {
-		in, out := &in.Address, &out.Address
-		*out = new(string)
-		**out = **in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	if in.Port != nil {
-		in, out := &in.Port, &out.Port
-		*out = new(int32)
-		**out = **in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
 }
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceRsyncStatus.
-func (in *ReplicationSourceRsyncStatus) DeepCopy() *ReplicationSourceRsyncStatus {
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotMetadataSpec.
+func (in *SnapshotMetadataSpec) DeepCopy() *SnapshotMetadataSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationSourceRsyncStatus)
+	out := new(SnapshotMetadataSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationSourceRsyncTLSSpec) DeepCopyInto(out *ReplicationSourceRsyncTLSSpec) {
+func (in *SnapshotRetentionPolicy) DeepCopyInto(out *SnapshotRetentionPolicy) {
 	*out = *in
-	in.ReplicationSourceVolumeOptions.DeepCopyInto(&out.ReplicationSourceVolumeOptions)
-	if in.KeySecret != nil {
-		in, out := &in.KeySecret, &out.KeySecret
-		*out = new(string)
-		**out = **in
-	}
-	if in.Address != nil {
-		in, out := &in.Address, &out.Address
-		*out = new(string)
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(metav1.Duration)
 		**out = **in
 	}
-	if in.Port != nil {
-		in, out := &in.Port, &out.Port
+	if in.MaxCount != nil {
+		in, out := &in.MaxCount, &out.MaxCount
 		*out = new(int32)
 		**out = **in
 	}
-	in.MoverConfig.DeepCopyInto(&out.MoverConfig)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceRsyncTLSSpec.
-func (in *ReplicationSourceRsyncTLSSpec) DeepCopy() *ReplicationSourceRsyncTLSSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotRetentionPolicy.
+func (in *SnapshotRetentionPolicy) DeepCopy() *SnapshotRetentionPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationSourceRsyncTLSSpec)
+	out := new(SnapshotRetentionPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationSourceRsyncTLSStatus) DeepCopyInto(out *ReplicationSourceRsyncTLSStatus) {
+func (in *StandbyValidationSpec) DeepCopyInto(out *StandbyValidationSpec) {
 	*out = *in
-	if in.KeySecret != nil {
-		in, out := &in.KeySecret, &out.KeySecret
-		*out = new(string)
+	in.JobHook.DeepCopyInto(&out.JobHook)
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceRsyncTLSStatus.
-func (in *ReplicationSourceRsyncTLSStatus) DeepCopy() *ReplicationSourceRsyncTLSStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StandbyValidationSpec.
+func (in *StandbyValidationSpec) DeepCopy() *StandbyValidationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationSourceRsyncTLSStatus)
+	out := new(StandbyValidationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationSourceSpec) DeepCopyInto(out *ReplicationSourceSpec) {
+func (in *StandbyValidationStatus) DeepCopyInto(out *StandbyValidationStatus) {
 	*out = *in
-	if in.Trigger != nil {
-		in, out := &in.Trigger, &out.Trigger
-		*out = new(ReplicationSourceTriggerSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Rsync != nil {
-		in, out := &in.Rsync, &out.Rsync
-		*out = new(ReplicationSourceRsyncSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.RsyncTLS != nil {
-		in, out := &in.RsyncTLS, &out.RsyncTLS
-		*out = new(ReplicationSourceRsyncTLSSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Rclone != nil {
-		in, out := &in.Rclone, &out.Rclone
-		*out = new(ReplicationSourceRcloneSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Restic != nil {
-		in, out := &in.Restic, &out.Restic
-		*out = new(ReplicationSourceResticSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Syncthing != nil {
-		in, out := &in.Syncthing, &out.Syncthing
-		*out = new(ReplicationSourceSyncthingSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.External != nil {
-		in, out := &in.External, &out.External
-		*out = new(ReplicationSourceExternalSpec)
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(v1.TypedLocalObjectReference)
 		(*in).DeepCopyInto(*out)
 	}
+	in.Time.DeepCopyInto(&out.Time)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceSpec.
-func (in *ReplicationSourceSpec) DeepCopy() *ReplicationSourceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StandbyValidationStatus.
+func (in *StandbyValidationStatus) DeepCopy() *StandbyValidationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationSourceSpec)
+	out := new(StandbyValidationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationSourceStatus) DeepCopyInto(out *ReplicationSourceStatus) {
+func (in *SyncHistoryEntry) DeepCopyInto(out *SyncHistoryEntry) {
 	*out = *in
-	if in.LastSyncTime != nil {
-		in, out := &in.LastSyncTime, &out.LastSyncTime
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
 		*out = (*in).DeepCopy()
 	}
-	if in.LastSyncStartTime != nil {
-		in, out := &in.LastSyncStartTime, &out.LastSyncStartTime
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
 		*out = (*in).DeepCopy()
 	}
-	if in.LastSyncDuration != nil {
-		in, out := &in.LastSyncDuration, &out.LastSyncDuration
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
 		*out = new(metav1.Duration)
 		**out = **in
 	}
-	if in.NextSyncTime != nil {
-		in, out := &in.NextSyncTime, &out.NextSyncTime
-		*out = (*in).DeepCopy()
-	}
-	if in.LatestMoverStatus != nil {
-		in, out := &in.LatestMoverStatus, &out.LatestMoverStatus
-		*out = new(MoverStatus)
+	if in.BytesTransferred != nil {
+		in, out := &in.BytesTransferred, &out.BytesTransferred
+		*out = new(int64)
 		**out = **in
 	}
-	if in.Rsync != nil {
-		in, out := &in.Rsync, &out.Rsync
-		*out = new(ReplicationSourceRsyncStatus)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.RsyncTLS != nil {
-		in, out := &in.RsyncTLS, &out.RsyncTLS
-		*out = new(ReplicationSourceRsyncTLSStatus)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.External != nil {
-		in, out := &in.External, &out.External
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Restic != nil {
-		in, out := &in.Restic, &out.Restic
-		*out = new(ReplicationSourceResticStatus)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Syncthing != nil {
-		in, out := &in.Syncthing, &out.Syncthing
-		*out = new(ReplicationSourceSyncthingStatus)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceStatus.
-func (in *ReplicationSourceStatus) DeepCopy() *ReplicationSourceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncHistoryEntry.
+func (in *SyncHistoryEntry) DeepCopy() *SyncHistoryEntry {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationSourceStatus)
+	out := new(SyncHistoryEntry)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationSourceSyncthingSpec) DeepCopyInto(out *ReplicationSourceSyncthingSpec) {
+func (in *SyncHook) DeepCopyInto(out *SyncHook) {
 	*out = *in
-	if in.Peers != nil {
-		in, out := &in.Peers, &out.Peers
-		*out = make([]SyncthingPeer, len(*in))
-		copy(*out, *in)
-	}
-	if in.ServiceType != nil {
-		in, out := &in.ServiceType, &out.ServiceType
-		*out = new(v1.ServiceType)
-		**out = **in
+	if in.Exec != nil {
+		in, out := &in.Exec, &out.Exec
+		*out = new(ExecHook)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ConfigCapacity != nil {
-		in, out := &in.ConfigCapacity, &out.ConfigCapacity
-		x := (*in).DeepCopy()
-		*out = &x
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(JobHook)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ConfigStorageClassName != nil {
-		in, out := &in.ConfigStorageClassName, &out.ConfigStorageClassName
-		*out = new(string)
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
 		**out = **in
 	}
-	if in.ConfigAccessModes != nil {
-		in, out := &in.ConfigAccessModes, &out.ConfigAccessModes
-		*out = make([]v1.PersistentVolumeAccessMode, len(*in))
-		copy(*out, *in)
-	}
-	in.MoverConfig.DeepCopyInto(&out.MoverConfig)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceSyncthingSpec.
-func (in *ReplicationSourceSyncthingSpec) DeepCopy() *ReplicationSourceSyncthingSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncHook.
+func (in *SyncHook) DeepCopy() *SyncHook {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationSourceSyncthingSpec)
+	out := new(SyncHook)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationSourceSyncthingStatus) DeepCopyInto(out *ReplicationSourceSyncthingStatus) {
+func (in *SyncthingPeer) DeepCopyInto(out *SyncthingPeer) {
 	*out = *in
-	if in.Peers != nil {
-		in, out := &in.Peers, &out.Peers
-		*out = make([]SyncthingPeerStatus, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceSyncthingStatus.
-func (in *ReplicationSourceSyncthingStatus) DeepCopy() *ReplicationSourceSyncthingStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncthingPeer.
+func (in *SyncthingPeer) DeepCopy() *SyncthingPeer {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationSourceSyncthingStatus)
+	out := new(SyncthingPeer)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationSourceTriggerSpec) DeepCopyInto(out *ReplicationSourceTriggerSpec) {
+func (in *SyncthingPeerStatus) DeepCopyInto(out *SyncthingPeerStatus) {
 	*out = *in
-	if in.Schedule != nil {
-		in, out := &in.Schedule, &out.Schedule
-		*out = new(string)
+	if in.Completion != nil {
+		in, out := &in.Completion, &out.Completion
+		*out = new(int32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceTriggerSpec.
-func (in *ReplicationSourceTriggerSpec) DeepCopy() *ReplicationSourceTriggerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncthingPeerStatus.
+func (in *SyncthingPeerStatus) DeepCopy() *SyncthingPeerStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationSourceTriggerSpec)
+	out := new(SyncthingPeerStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReplicationSourceVolumeOptions) DeepCopyInto(out *ReplicationSourceVolumeOptions) {
+func (in *WorkloadCoordinationSpec) DeepCopyInto(out *WorkloadCoordinationSpec) {
 	*out = *in
-	if in.Capacity != nil {
-		in, out := &in.Capacity, &out.Capacity
-		x := (*in).DeepCopy()
-		*out = &x
-	}
-	if in.StorageClassName != nil {
-		in, out := &in.StorageClassName, &out.StorageClassName
-		*out = new(string)
-		**out = **in
-	}
-	if in.AccessModes != nil {
-		in, out := &in.AccessModes, &out.AccessModes
-		*out = make([]v1.PersistentVolumeAccessMode, len(*in))
-		copy(*out, *in)
-	}
-	if in.VolumeSnapshotClassName != nil {
-		in, out := &in.VolumeSnapshotClassName, &out.VolumeSnapshotClassName
-		*out = new(string)
+	if in.MaxDowntime != nil {
+		in, out := &in.MaxDowntime, &out.MaxDowntime
+		*out = new(metav1.Duration)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationSourceVolumeOptions.
-func (in *ReplicationSourceVolumeOptions) DeepCopy() *ReplicationSourceVolumeOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadCoordinationSpec.
+func (in *WorkloadCoordinationSpec) DeepCopy() *WorkloadCoordinationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationSourceVolumeOptions)
+	out := new(WorkloadCoordinationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResticRetainPolicy) DeepCopyInto(out *ResticRetainPolicy) {
+func (in *WorkloadCoordinationStatus) DeepCopyInto(out *WorkloadCoordinationStatus) {
 	*out = *in
-	if in.Hourly != nil {
-		in, out := &in.Hourly, &out.Hourly
-		*out = new(int32)
-		**out = **in
-	}
-	if in.Daily != nil {
-		in, out := &in.Daily, &out.Daily
-		*out = new(int32)
-		**out = **in
-	}
-	if in.Weekly != nil {
-		in, out := &in.Weekly, &out.Weekly
-		*out = new(int32)
-		**out = **in
-	}
-	if in.Monthly != nil {
-		in, out := &in.Monthly, &out.Monthly
-		*out = new(int32)
-		**out = **in
-	}
-	if in.Yearly != nil {
-		in, out := &in.Yearly, &out.Yearly
-		*out = new(int32)
-		**out = **in
-	}
-	if in.Within != nil {
-		in, out := &in.Within, &out.Within
-		*out = new(string)
-		**out = **in
+	if in.DowntimeStart != nil {
+		in, out := &in.DowntimeStart, &out.DowntimeStart
+		*out = (*in).DeepCopy()
 	}
-	if in.Last != nil {
-		in, out := &in.Last, &out.Last
-		*out = new(string)
-		**out = **in
+	if in.ScaledWorkloads != nil {
+		in, out := &in.ScaledWorkloads, &out.ScaledWorkloads
+		*out = make([]ScaledWorkload, len(*in))
+		copy(*out, *in)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResticRetainPolicy.
-func (in *ResticRetainPolicy) DeepCopy() *ResticRetainPolicy {
-	if in == nil {
-		return nil
+	if in.Timeline != nil {
+		in, out := &in.Timeline, &out.Timeline
+		*out = make([]WorkloadTimelineEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	out := new(ResticRetainPolicy)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SyncthingPeer) DeepCopyInto(out *SyncthingPeer) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncthingPeer.
-func (in *SyncthingPeer) DeepCopy() *SyncthingPeer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadCoordinationStatus.
+func (in *WorkloadCoordinationStatus) DeepCopy() *WorkloadCoordinationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SyncthingPeer)
+	out := new(WorkloadCoordinationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SyncthingPeerStatus) DeepCopyInto(out *SyncthingPeerStatus) {
+func (in *WorkloadTimelineEntry) DeepCopyInto(out *WorkloadTimelineEntry) {
 	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncthingPeerStatus.
-func (in *SyncthingPeerStatus) DeepCopy() *SyncthingPeerStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadTimelineEntry.
+func (in *WorkloadTimelineEntry) DeepCopy() *WorkloadTimelineEntry {
 	if in == nil {
 		return nil
 	}
-	out := new(SyncthingPeerStatus)
+	out := new(WorkloadTimelineEntry)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -0,0 +1,43 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/backube/volsync/controllers/mover"
+)
+
+// runConnectionTest runs the connection test requested in the spec of the
+// mover, if the mover supports them, and requeues for it in result
+func runConnectionTest(ctx context.Context, m mover.Mover, result ctrl.Result) (ctrl.Result, error) {
+	tester, ok := m.(mover.ConnectionTester)
+	if !ok {
+		return result, nil
+	}
+	testResult, err := tester.TestConnection(ctx)
+	if err != nil || testResult.Completed || testResult.RetryAfter == nil {
+		return result, err
+	}
+	if after := *testResult.RetryAfter; result.RequeueAfter == 0 || after < result.RequeueAfter {
+		result.RequeueAfter = after
+	}
+	return result, nil
+}
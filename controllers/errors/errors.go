@@ -26,3 +26,15 @@ type CopyTriggerTimeoutError struct {
 func (e *CopyTriggerTimeoutError) Error() string {
 	return fmt.Sprintf("Timed out waiting for copy-trigger to be modified for pvc %s", e.SourcePVC)
 }
+
+// SyncBlockedError is returned when a pre-flight check finds that a
+// synchronization can not proceed until the user intervenes
+type SyncBlockedError struct {
+	// Reason is the reason of the SyncBlocked condition
+	Reason  string
+	Message string
+}
+
+func (e *SyncBlockedError) Error() string {
+	return e.Message
+}
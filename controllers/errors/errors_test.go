@@ -69,4 +69,24 @@ var _ = Describe("errors tests", func() {
 			})
 		})
 	})
+
+	Describe("SyncBlockedError", func() {
+		var errSt error
+		BeforeEach(func() {
+			errSt = &vsErrors.SyncBlockedError{
+				Reason:  "QuotaExceeded",
+				Message: "the clone would exceed the quota",
+			}
+		})
+
+		It("Should print out the message", func() {
+			Expect(errSt.Error()).To(Equal("the clone would exceed the quota"))
+		})
+		It("Should be comparable with errors.As() when wrapped", func() {
+			errWrap := fmt.Errorf("unable to create clone: %w", errSt)
+			var syncBlockedError *vsErrors.SyncBlockedError
+			Expect(errors.As(errWrap, &syncBlockedError)).To(BeTrue())
+			Expect(syncBlockedError.Reason).To(Equal("QuotaExceeded"))
+		})
+	})
 })
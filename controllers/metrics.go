@@ -33,6 +33,11 @@ type volsyncMetrics struct {
 	MissedIntervals prometheus.Counter
 	OutOfSync       prometheus.Gauge
 	SyncDurations   prometheus.Observer
+	// Only set for sources
+	SuspectedCorruptFiles    prometheus.Gauge
+	ResticRepositorySize     prometheus.Gauge
+	ResticDeduplicationRatio prometheus.Gauge
+	ResticSourceSnapshots    prometheus.Gauge
 }
 
 var (
@@ -69,6 +74,87 @@ var (
 		},
 		metricLabels,
 	)
+	suspectedCorruptFiles = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "suspected_corrupt_files",
+			Namespace: metricsNamespace,
+			Help:      "The number of source files that changed without their modification time changing",
+		},
+		metricLabels,
+	)
+	resticRepositorySize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "restic_repository_size_bytes",
+			Namespace: metricsNamespace,
+			Help:      "The space used by the data in the restic repository, after deduplication and compression",
+		},
+		metricLabels,
+	)
+	resticDeduplicationRatio = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "restic_deduplication_ratio",
+			Namespace: metricsNamespace,
+			Help:      "The total size of the snapshots in the restic repository divided by the size of their unique data",
+		},
+		metricLabels,
+	)
+	resticSourceSnapshots = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "restic_snapshots",
+			Namespace: metricsNamespace,
+			Help:      "The number of snapshots in the restic repository made by the ReplicationSource",
+		},
+		metricLabels,
+	)
+	retainedSnapshotContents = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "retained_snapshot_contents",
+			Namespace: metricsNamespace,
+			Help: "The number of VolumeSnapshotContents with a Retain deletion policy that were created " +
+				"from VolSync snapshots, by whether their VolumeSnapshot still exists (bound) or not (orphaned)",
+		},
+		[]string{
+			"obj_namespace", // Namespace of the VolumeSnapshot
+			"state",         // "bound" or "orphaned"
+		},
+	)
+	statusBackfillCompleted = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name:      "status_backfill_completed",
+			Namespace: metricsNamespace,
+			Help:      "Set to 1 once the startup status backfill has processed every object without error",
+		},
+	)
+	statusBackfillObjects = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "status_backfill_objects",
+			Namespace: metricsNamespace,
+			Help:      "The number of objects the startup status backfill updated or failed to update",
+		},
+		[]string{
+			"result", // "updated" or "failed"
+		},
+	)
+	orphansFound = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "orphaned_objects",
+			Namespace: metricsNamespace,
+			Help:      "The number of objects whose VolSync owner no longer exists, as of the latest orphan sweep",
+		},
+		[]string{
+			"kind", // Kind of the orphaned object (Job, PersistentVolumeClaim, etc.)
+		},
+	)
+	orphansDeleted = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name:      "orphaned_objects_deleted_total",
+			Namespace: metricsNamespace,
+			Help:      "The number of orphaned objects deleted by the orphan collector",
+		},
+		[]string{
+			"kind", // Kind of the orphaned object (Job, PersistentVolumeClaim, etc.)
+		},
+	)
 )
 
 func newVolSyncMetrics(labels prometheus.Labels) volsyncMetrics {
@@ -76,10 +162,16 @@ func newVolSyncMetrics(labels prometheus.Labels) volsyncMetrics {
 		MissedIntervals: missedIntervals.With(labels),
 		OutOfSync:       outOfSync.With(labels),
 		SyncDurations:   syncDurations.With(labels),
+
+		SuspectedCorruptFiles:    suspectedCorruptFiles.With(labels),
+		ResticRepositorySize:     resticRepositorySize.With(labels),
+		ResticDeduplicationRatio: resticDeduplicationRatio.With(labels),
+		ResticSourceSnapshots:    resticSourceSnapshots.With(labels),
 	}
 }
 
 func init() {
 	// Register custom metrics with the global prometheus registry
-	metrics.Registry.MustRegister(missedIntervals, outOfSync, syncDurations)
+	metrics.Registry.MustRegister(missedIntervals, outOfSync, syncDurations, suspectedCorruptFiles,
+		resticRepositorySize, resticDeduplicationRatio, resticSourceSnapshots, retainedSnapshotContents, statusBackfillCompleted, statusBackfillObjects, orphansFound, orphansDeleted)
 }
@@ -0,0 +1,321 @@
+//go:build !disable_block
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package block
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/mover"
+)
+
+const (
+	maxWait  = "60s"
+	interval = "1s"
+)
+
+var _ = Describe("Block mover properly registers", func() {
+	When("Block's registration function is called", func() {
+		BeforeEach(func() {
+			Expect(Register()).To(Succeed())
+		})
+
+		It("is added to the mover catalog", func() {
+			found := false
+			for _, v := range mover.Catalog {
+				if _, ok := v.(*Builder); ok {
+					found = true
+				}
+			}
+			Expect(found).To(BeTrue())
+		})
+	})
+})
+
+var _ = Describe("Block mover ignores other movers", func() {
+	logger := zap.New(zap.UseDevMode(true), zap.WriteTo(GinkgoWriter))
+	It("ignores an RS that isn't for the block mover", func() {
+		rs := &volsyncv1alpha1.ReplicationSource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cr",
+				Namespace: "blah",
+			},
+			Spec: volsyncv1alpha1.ReplicationSourceSpec{
+				RsyncTLS: &volsyncv1alpha1.ReplicationSourceRsyncTLSSpec{},
+			},
+		}
+		m, e := commonBuilderForTestSuite.FromSource(k8sClient, logger, &events.FakeRecorder{}, rs,
+			true /* privileged */)
+		Expect(m).To(BeNil())
+		Expect(e).NotTo(HaveOccurred())
+	})
+	It("ignores an RD that isn't for the block mover", func() {
+		rd := &volsyncv1alpha1.ReplicationDestination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cr",
+				Namespace: "blah",
+			},
+			Spec: volsyncv1alpha1.ReplicationDestinationSpec{
+				RsyncTLS: &volsyncv1alpha1.ReplicationDestinationRsyncTLSSpec{},
+			},
+		}
+		m, e := commonBuilderForTestSuite.FromDestination(k8sClient, logger, &events.FakeRecorder{}, rd,
+			true /* privileged */)
+		Expect(m).To(BeNil())
+		Expect(e).NotTo(HaveOccurred())
+	})
+})
+
+func newTestPVC(name, namespace string, volumeMode corev1.PersistentVolumeMode) *corev1.PersistentVolumeClaim {
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{
+				corev1.ReadWriteOnce,
+			},
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					"storage": resource.MustParse("2Gi"),
+				},
+			},
+			VolumeMode: &volumeMode,
+		},
+	}
+}
+
+var _ = Describe("Block mover as a source", func() {
+	var ns *corev1.Namespace
+	logger := zap.New(zap.UseDevMode(true), zap.WriteTo(GinkgoWriter))
+	var rs *volsyncv1alpha1.ReplicationSource
+	var sPVC *corev1.PersistentVolumeClaim
+	var mover *Mover
+	BeforeEach(func() {
+		ns = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "block-",
+			},
+		}
+		Expect(k8sClient.Create(ctx, ns)).To(Succeed())
+
+		sPVC = newTestPVC("s", ns.Name, corev1.PersistentVolumeBlock)
+		Expect(k8sClient.Create(ctx, sPVC)).To(Succeed())
+
+		rs = &volsyncv1alpha1.ReplicationSource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "rs",
+				Namespace: ns.Name,
+			},
+			Spec: volsyncv1alpha1.ReplicationSourceSpec{
+				SourcePVC: sPVC.Name,
+				Trigger:   &volsyncv1alpha1.ReplicationSourceTriggerSpec{},
+				Block: &volsyncv1alpha1.ReplicationSourceBlockSpec{
+					ReplicationSourceVolumeOptions: volsyncv1alpha1.ReplicationSourceVolumeOptions{
+						CopyMethod: volsyncv1alpha1.CopyMethodDirect,
+					},
+				},
+			},
+		}
+	})
+	JustBeforeEach(func() {
+		Expect(k8sClient.Create(ctx, rs)).To(Succeed())
+		rs.Status = &volsyncv1alpha1.ReplicationSourceStatus{}
+
+		m, err := commonBuilderForTestSuite.FromSource(k8sClient, logger, &events.FakeRecorder{}, rs,
+			true /* privileged */)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(m).NotTo(BeNil())
+		mover, _ = m.(*Mover)
+		Expect(mover).NotTo(BeNil())
+	})
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, ns)).To(Succeed())
+	})
+
+	It("generates a TLS key and publishes it in the status", func() {
+		var keyName *string
+		Eventually(func() *string {
+			keyName, _ = mover.ensureSecrets(ctx)
+			return keyName
+		}, maxWait, interval).ShouldNot(BeNil())
+		Expect(rs.Status.Block).NotTo(BeNil())
+		Expect(*rs.Status.Block.KeySecret).To(Equal(*keyName))
+	})
+
+	When("the mover job is created", func() {
+		var sa *corev1.ServiceAccount
+		var keySecret *corev1.Secret
+		var remoteAddr string
+		BeforeEach(func() {
+			remoteAddr = "remote.example.com"
+			rs.Spec.Block.Address = &remoteAddr
+
+			sa = &corev1.ServiceAccount{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "thesa",
+					Namespace: ns.Name,
+				},
+			}
+			Expect(k8sClient.Create(ctx, sa)).To(Succeed())
+			keySecret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "testkeys",
+					Namespace: ns.Name,
+				},
+				StringData: map[string]string{
+					"psk.txt": "1:00000000000000000000000000000000",
+				},
+			}
+			Expect(k8sClient.Create(ctx, keySecret)).To(Succeed())
+		})
+
+		It("runs the block client with the device attached", func() {
+			j, e := mover.ensureJob(ctx, sPVC, sa, keySecret.GetName())
+			Expect(e).NotTo(HaveOccurred())
+			Expect(j).To(BeNil()) // hasn't completed
+
+			job := &batchv1.Job{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "volsync-block-src-" + rs.Name,
+				Namespace: ns.Name}, job)).To(Succeed())
+			c := job.Spec.Template.Spec.Containers[0]
+			Expect(c.Command).To(Equal([]string{"/bin/bash", "-c", "/mover-block/client.sh"}))
+			Expect(c.Image).To(Equal(defaultBlockContainerImage))
+			Expect(c.Env).To(ContainElement(corev1.EnvVar{Name: "DESTINATION_ADDRESS", Value: remoteAddr}))
+			Expect(c.VolumeDevices).To(ConsistOf(corev1.VolumeDevice{Name: dataVolumeName, DevicePath: devicePath}))
+			for _, vm := range c.VolumeMounts {
+				Expect(vm.Name).NotTo(Equal(dataVolumeName))
+			}
+		})
+	})
+
+	When("the source PVC is not a block volume", func() {
+		BeforeEach(func() {
+			fsPVC := newTestPVC("fs", ns.Name, corev1.PersistentVolumeFilesystem)
+			Expect(k8sClient.Create(ctx, fsPVC)).To(Succeed())
+			rs.Spec.SourcePVC = fsPVC.Name
+		})
+		It("refuses to synchronize", func() {
+			_, err := mover.Synchronize(ctx)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("volumeMode"))
+		})
+	})
+})
+
+var _ = Describe("Block mover as a destination", func() {
+	var ns *corev1.Namespace
+	logger := zap.New(zap.UseDevMode(true), zap.WriteTo(GinkgoWriter))
+	var rd *volsyncv1alpha1.ReplicationDestination
+	var mover *Mover
+	BeforeEach(func() {
+		ns = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "block-",
+			},
+		}
+		Expect(k8sClient.Create(ctx, ns)).To(Succeed())
+
+		capacity := resource.MustParse("2Gi")
+		rd = &volsyncv1alpha1.ReplicationDestination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "rd",
+				Namespace: ns.Name,
+			},
+			Spec: volsyncv1alpha1.ReplicationDestinationSpec{
+				Trigger: &volsyncv1alpha1.ReplicationDestinationTriggerSpec{},
+				Block: &volsyncv1alpha1.ReplicationDestinationBlockSpec{
+					ReplicationDestinationVolumeOptions: volsyncv1alpha1.ReplicationDestinationVolumeOptions{
+						AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+						Capacity:    &capacity,
+						CopyMethod:  volsyncv1alpha1.CopyMethodSnapshot,
+					},
+				},
+			},
+		}
+	})
+	JustBeforeEach(func() {
+		Expect(k8sClient.Create(ctx, rd)).To(Succeed())
+		rd.Status = &volsyncv1alpha1.ReplicationDestinationStatus{}
+
+		m, err := commonBuilderForTestSuite.FromDestination(k8sClient, logger, &events.FakeRecorder{}, rd,
+			true /* privileged */)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(m).NotTo(BeNil())
+		mover, _ = m.(*Mover)
+		Expect(mover).NotTo(BeNil())
+	})
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, ns)).To(Succeed())
+	})
+
+	It("creates a block mode dynamic PVC", func() {
+		pvc, e := mover.ensureDestinationPVC(ctx)
+		Expect(e).NotTo(HaveOccurred())
+		Expect(pvc).NotTo(BeNil())
+		Expect(pvc.Spec.VolumeMode).NotTo(BeNil())
+		Expect(*pvc.Spec.VolumeMode).To(Equal(corev1.PersistentVolumeBlock))
+	})
+
+	It("creates a service for incoming connections", func() {
+		_, err := mover.ensureServiceAndPublishAddress(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		svc := &corev1.Service{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "volsync-block-dst-" + rd.Name,
+			Namespace: ns.Name}, svc)).To(Succeed())
+		Expect(svc.Spec.Ports).To(HaveLen(1))
+		Expect(svc.Spec.Ports[0].Port).To(Equal(int32(8000)))
+		Expect(svc.Spec.Selector).To(Equal(mover.serviceSelector()))
+	})
+
+	It("runs the block server when the job is created", func() {
+		pvc, e := mover.ensureDestinationPVC(ctx)
+		Expect(e).NotTo(HaveOccurred())
+		sa := &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "thesa",
+				Namespace: ns.Name,
+			},
+		}
+		Expect(k8sClient.Create(ctx, sa)).To(Succeed())
+
+		j, e := mover.ensureJob(ctx, pvc, sa, "keys")
+		Expect(e).NotTo(HaveOccurred())
+		Expect(j).To(BeNil()) // hasn't completed
+
+		job := &batchv1.Job{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: "volsync-block-dst-" + rd.Name,
+			Namespace: ns.Name}, job)).To(Succeed())
+		Expect(job.Spec.Template.Spec.Containers[0].Command).To(Equal(
+			[]string{"/bin/bash", "-c", "/mover-block/server.sh"}))
+	})
+})
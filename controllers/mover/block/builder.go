@@ -0,0 +1,229 @@
+//go:build !disable_block
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package block
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/viper"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/mover"
+	"github.com/backube/volsync/controllers/utils"
+	"github.com/backube/volsync/controllers/volumehandler"
+)
+
+const (
+	blockMoverName = "block"
+	// defaultBlockContainerImage is the default container image for the
+	// block data mover
+	defaultBlockContainerImage = "quay.io/backube/volsync:latest"
+	// Command line flag will be checked first
+	// If command line flag not set, the RELATED_IMAGE_ env var will be used
+	blockContainerImageFlag   = "block-container-image"
+	blockContainerImageEnvVar = "RELATED_IMAGE_BLOCK_CONTAINER"
+)
+
+type Builder struct {
+	viper *viper.Viper  // For unit tests to be able to override - global viper will be used by default in Register()
+	flags *flag.FlagSet // For unit tests to be able to override - global flags will be used by default in Register()
+}
+
+var _ mover.Builder = &Builder{}
+
+func Register() error {
+	// Use global viper & command line flags
+	b, err := newBuilder(viper.GetViper(), flag.CommandLine)
+	if err != nil {
+		return err
+	}
+
+	mover.Register(b)
+	return nil
+}
+
+func newBuilder(viper *viper.Viper, flags *flag.FlagSet) (*Builder, error) {
+	b := &Builder{
+		viper: viper,
+		flags: flags,
+	}
+
+	// Set default block container image - will be used if both command line flag and env var are not set
+	b.viper.SetDefault(blockContainerImageFlag, defaultBlockContainerImage)
+
+	// Setup command line flag for the block container image
+	b.flags.String(blockContainerImageFlag, defaultBlockContainerImage,
+		"The container image for the block data mover")
+	// Viper will check for command line flag first, then fallback to the env var
+	err := b.viper.BindEnv(blockContainerImageFlag, blockContainerImageEnvVar)
+
+	return b, err
+}
+
+func (rb *Builder) Name() string { return blockMoverName }
+
+func (rb *Builder) VersionInfo() string {
+	return fmt.Sprintf("Block container: %s", rb.getBlockContainerImage())
+}
+
+// getBlockContainerImage is the container image name of the block data mover
+func (rb *Builder) getBlockContainerImage() string {
+	return rb.viper.GetString(blockContainerImageFlag)
+}
+
+func (rb *Builder) FromSource(client client.Client, logger logr.Logger,
+	eventRecorder events.EventRecorder,
+	source *volsyncv1alpha1.ReplicationSource, privileged bool) (mover.Mover, error) {
+	// Only build if the CR belongs to us
+	if source.Spec.Block == nil {
+		return nil, nil
+	}
+
+	// Make sure there's a place to write status info
+	if source.Status.Block == nil {
+		source.Status.Block = &volsyncv1alpha1.ReplicationSourceBlockStatus{}
+	}
+
+	if source.Status.LatestMoverStatus == nil {
+		source.Status.LatestMoverStatus = &volsyncv1alpha1.MoverStatus{}
+	}
+
+	vh, err := volumehandler.NewVolumeHandler(
+		volumehandler.WithClient(client),
+		volumehandler.WithRecorder(eventRecorder),
+		volumehandler.WithOwner(source),
+		volumehandler.FromSource(&source.Spec.Block.ReplicationSourceVolumeOptions),
+		volumehandler.SyncHooks(source.Spec.Hooks),
+		volumehandler.IntermediateRetention(source.Spec.IntermediateRetention, source.Status),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	isSource := true
+
+	saHandler := utils.NewSAHandler(client, source, isSource, privileged,
+		source.Spec.Block.MoverServiceAccount)
+
+	containerImage, err := utils.MoverImage(rb.getBlockContainerImage(), source.Spec.Block.MoverImage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Mover{
+		client:             client,
+		logger:             logger.WithValues("method", "Block"),
+		eventRecorder:      eventRecorder,
+		owner:              source,
+		vh:                 vh,
+		saHandler:          saHandler,
+		containerImage:     containerImage,
+		key:                source.Spec.Block.KeySecret,
+		serviceType:        nil,
+		serviceAnnotations: nil,
+		address:            source.Spec.Block.Address,
+		port:               source.Spec.Block.Port,
+		isSource:           isSource,
+		paused:             source.Spec.Paused,
+		readOnlySource:     source.Spec.EnforceReadOnlySource,
+		mainPVCName:        &source.Spec.SourcePVC,
+		privileged:         privileged,
+		sourceStatus:       source.Status.Block,
+		latestMoverStatus:  source.Status.LatestMoverStatus,
+		moverConfig:        source.Spec.Block.MoverConfig,
+	}, nil
+}
+
+//nolint:funlen
+func (rb *Builder) FromDestination(client client.Client, logger logr.Logger,
+	eventRecorder events.EventRecorder,
+	destination *volsyncv1alpha1.ReplicationDestination, privileged bool) (mover.Mover, error) {
+	// Only build if the CR belongs to us
+	if destination.Spec.Block == nil {
+		return nil, nil
+	}
+
+	// Make sure there's a place to write status info
+	if destination.Status.Block == nil {
+		destination.Status.Block = &volsyncv1alpha1.ReplicationDestinationBlockStatus{}
+	}
+
+	if destination.Status.LatestMoverStatus == nil {
+		destination.Status.LatestMoverStatus = &volsyncv1alpha1.MoverStatus{}
+	}
+
+	vh, err := volumehandler.NewVolumeHandler(
+		volumehandler.WithClient(client),
+		volumehandler.WithRecorder(eventRecorder),
+		volumehandler.WithOwner(destination),
+		volumehandler.FromDestination(&destination.Spec.Block.ReplicationDestinationVolumeOptions),
+		volumehandler.VolumeMode(ptr.To(corev1.PersistentVolumeBlock)), // Dynamic dest PVC is always block mode
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	isSource := false
+
+	saHandler := utils.NewSAHandler(client, destination, isSource, privileged,
+		destination.Spec.Block.MoverServiceAccount)
+
+	var svcAnnotations map[string]string
+	if destination.Spec.Block.ServiceAnnotations != nil {
+		// If nil we will assume VolSync can set defaults
+		// if not nil, we will assume we will use the users settings (and empty map will mean
+		// we do not set any annotations at all on the service)
+		svcAnnotations = *destination.Spec.Block.ServiceAnnotations
+	}
+
+	containerImage, err := utils.MoverImage(rb.getBlockContainerImage(), destination.Spec.Block.MoverImage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Mover{
+		client:             client,
+		logger:             logger.WithValues("method", "Block"),
+		eventRecorder:      eventRecorder,
+		owner:              destination,
+		vh:                 vh,
+		saHandler:          saHandler,
+		containerImage:     containerImage,
+		key:                destination.Spec.Block.KeySecret,
+		serviceType:        destination.Spec.Block.ServiceType,
+		serviceAnnotations: svcAnnotations,
+		address:            nil,
+		port:               nil,
+		isSource:           isSource,
+		paused:             destination.Spec.Paused,
+		mainPVCName:        destination.Spec.Block.DestinationPVC,
+		cleanupTempPVC:     destination.Spec.Block.CleanupTempPVC,
+		privileged:         privileged,
+		destStatus:         destination.Status.Block,
+		latestMoverStatus:  destination.Status.LatestMoverStatus,
+		moverConfig:        destination.Spec.Block.MoverConfig,
+	}, nil
+}
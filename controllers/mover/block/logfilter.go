@@ -0,0 +1,67 @@
+//go:build !disable_block
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package block
+
+import (
+	"regexp"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var blockRegex = regexp.MustCompile(
+	`([dD]iskrsync completed in)|` +
+		`([sS]uccessfully completed sync)|` +
+		`(total size)`)
+
+var blockRegexFailures = regexp.MustCompile(
+	`^\s*(disk[rR]sync)|` +
+		`([fF]ail)|` +
+		`([eE]rror)`)
+
+// Filter diskrsync log lines for a successful move job
+func LogLineFilterSuccess(line string) *string {
+	if blockRegex.MatchString(line) {
+		return &line
+	}
+	return nil
+}
+
+func LogLineFilterFailure(line string) *string {
+	// Match first against the same stuff we do for success
+	if blockRegex.MatchString(line) {
+		return &line
+	}
+
+	// Also match some specific failure lines
+	if blockRegexFailures.MatchString(line) {
+		return &line
+	}
+
+	return nil
+}
+
+// errorCodePatterns classify the failures of diskrsync over stunnel
+var errorCodePatterns = []utils.ErrorCodePattern{
+	{
+		Code:  volsyncv1alpha1.MoverErrorCodeAuthFailed,
+		Regex: regexp.MustCompile(`(?i)(psk identity not found)|(no psk identity)|(ssl routines)`),
+	},
+}
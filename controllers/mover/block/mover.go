@@ -0,0 +1,568 @@
+//go:build !disable_block
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package block
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	vserrors "github.com/backube/volsync/controllers/errors"
+	"github.com/backube/volsync/controllers/mover"
+	"github.com/backube/volsync/controllers/utils"
+	"github.com/backube/volsync/controllers/volumehandler"
+)
+
+const (
+	devicePath       = "/dev/block"
+	dataVolumeName   = "data"
+	tlsContainerPort = 8000
+
+	volSyncBlockPrefix = mover.VolSyncPrefix + "block-"
+)
+
+// Mover is the reconciliation logic for the diskrsync-based block data mover.
+type Mover struct {
+	client             client.Client
+	logger             logr.Logger
+	eventRecorder      events.EventRecorder
+	owner              client.Object
+	vh                 *volumehandler.VolumeHandler
+	saHandler          utils.SAHandler
+	containerImage     string
+	key                *string
+	serviceType        *corev1.ServiceType
+	serviceAnnotations map[string]string
+	address            *string
+	port               *int32
+	isSource           bool
+	paused             bool
+	readOnlySource     bool
+	mainPVCName        *string
+	privileged         bool
+	latestMoverStatus  *volsyncv1alpha1.MoverStatus
+	moverConfig        volsyncv1alpha1.MoverConfig
+	// Source-only fields
+	sourceStatus *volsyncv1alpha1.ReplicationSourceBlockStatus
+	// Destination-only fields
+	destStatus     *volsyncv1alpha1.ReplicationDestinationBlockStatus
+	cleanupTempPVC bool
+}
+
+var _ mover.Mover = &Mover{}
+
+// All object types that are temporary/per-iteration should be listed here. The
+// individual objects to be cleaned up must also be marked.
+var cleanupTypes = []client.Object{
+	&corev1.PersistentVolumeClaim{},
+	&snapv1.VolumeSnapshot{},
+	&batchv1.Job{},
+}
+
+func (m *Mover) Name() string { return blockMoverName }
+
+func (m *Mover) Synchronize(ctx context.Context) (mover.Result, error) {
+	var err error
+
+	// Allocate temporary data PVC
+	var dataPVC *corev1.PersistentVolumeClaim
+	if m.isSource {
+		dataPVC, err = m.ensureSourcePVC(ctx)
+	} else {
+		dataPVC, err = m.ensureDestinationPVC(ctx)
+	}
+	if dataPVC == nil || err != nil {
+		return mover.InProgress(), err
+	}
+
+	// This mover transfers the raw device, so filesystem volumes are not supported
+	if !utils.PvcIsBlockMode(dataPVC) {
+		err = fmt.Errorf("PVC %s must have volumeMode: %s", dataPVC.GetName(), corev1.PersistentVolumeBlock)
+		m.logger.Error(err, "unsupported volumeMode")
+		return mover.InProgress(), err
+	}
+
+	// Ensure service (if required) and publish the address in the status
+	cont, err := m.ensureServiceAndPublishAddress(ctx)
+	if !cont || err != nil {
+		return mover.InProgress(), err
+	}
+
+	// Ensure Secrets/keys
+	pskSecretName, err := m.ensureSecrets(ctx)
+	if pskSecretName == nil || err != nil {
+		return mover.InProgress(), err
+	}
+
+	// Prepare ServiceAccount, role, rolebinding
+	sa, err := m.saHandler.Reconcile(ctx, m.logger)
+	if sa == nil || err != nil {
+		return mover.InProgress(), err
+	}
+
+	// Ensure mover Job
+	job, err := m.ensureJob(ctx, dataPVC, sa, *pskSecretName)
+	if job == nil || err != nil {
+		return mover.InProgress(), err
+	}
+
+	// On the destination, preserve the image and return it
+	if !m.isSource {
+		image, err := m.vh.EnsureImage(ctx, m.logger, dataPVC)
+		if image == nil || err != nil {
+			return mover.InProgress(), err
+		}
+		return mover.CompleteWithImage(image), nil
+	}
+
+	// On the source, just signal completion
+	return mover.Complete(), nil
+}
+
+func (m *Mover) ensureServiceAndPublishAddress(ctx context.Context) (bool, error) {
+	if m.address != nil || m.isSource {
+		// Connection will be outbound. Don't need a Service
+		return true, nil
+	}
+
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      volSyncBlockPrefix + m.direction() + "-" + m.owner.GetName(),
+			Namespace: m.owner.GetNamespace(),
+		},
+	}
+	svcDesc := blockSvcDescription{
+		Context:     ctx,
+		Client:      m.client,
+		Service:     service,
+		Owner:       m.owner,
+		Type:        m.serviceType,
+		Selector:    m.serviceSelector(),
+		Port:        m.port,
+		Annotations: m.serviceAnnotations,
+	}
+	err := svcDesc.Reconcile(m.logger)
+	if err != nil {
+		return false, err
+	}
+
+	return m.publishSvcAddress(service)
+}
+
+func (m *Mover) publishSvcAddress(service *corev1.Service) (bool, error) {
+	address := utils.GetServiceAddress(service)
+	if address == "" {
+		// We don't have an address yet, try again later
+		m.updateStatusAddress(nil)
+		if service.CreationTimestamp.Add(mover.ServiceAddressTimeout).Before(time.Now()) {
+			m.eventRecorder.Eventf(m.owner, service, corev1.EventTypeWarning,
+				volsyncv1alpha1.EvRSvcNoAddress, volsyncv1alpha1.EvANone,
+				"waiting for an address to be assigned to %s; ensure the proper serviceType was specified",
+				utils.KindAndName(m.client.Scheme(), service))
+		}
+		return false, nil
+	}
+	m.updateStatusAddress(&address)
+
+	m.logger.V(1).Info("Service addr published", "address", address)
+	return true, nil
+}
+
+func (m *Mover) updateStatusAddress(address *string) {
+	publishEvent := false
+	if !m.isSource {
+		if m.destStatus.Address == nil ||
+			address != nil && *m.destStatus.Address != *address {
+			publishEvent = true
+		}
+		m.destStatus.Address = address
+	}
+	if publishEvent && address != nil {
+		m.eventRecorder.Eventf(m.owner, nil, corev1.EventTypeNormal,
+			volsyncv1alpha1.EvRSvcAddress, volsyncv1alpha1.EvANone,
+			"listening on address %s for incoming connections", *address)
+	}
+}
+
+func (m *Mover) updateStatusPSK(pskSecretName *string) {
+	if m.isSource {
+		m.sourceStatus.KeySecret = pskSecretName
+	} else {
+		m.destStatus.KeySecret = pskSecretName
+	}
+}
+
+// Will ensure the secret exists or create secrets if necessary
+// - Returns the name of the secret that should be used in the replication job
+func (m *Mover) ensureSecrets(ctx context.Context) (*string, error) {
+	// If user provided key, use that
+	if m.key != nil {
+		keySecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      *m.key,
+				Namespace: m.owner.GetNamespace(),
+			},
+		}
+		fields := []string{"psk.txt"}
+		if err := utils.GetAndValidateSecret(ctx, m.client, m.logger, keySecret, fields...); err != nil {
+			m.logger.Error(err, "Key Secret does not contain the proper fields")
+			return nil, err
+		}
+		return m.key, nil
+	}
+
+	keySecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      volSyncBlockPrefix + m.owner.GetName(),
+			Namespace: m.owner.GetNamespace(),
+		},
+	}
+
+	err := m.client.Get(ctx, client.ObjectKeyFromObject(keySecret), keySecret)
+	if client.IgnoreNotFound(err) != nil {
+		m.logger.Error(err, "error retreiving key")
+		return nil, err
+	}
+
+	if kerrors.IsNotFound(err) {
+		keyData := make([]byte, 64)
+		if _, err := rand.Read(keyData); err != nil {
+			m.logger.Error(err, "error generating key")
+			return nil, err
+		}
+		keySecret.StringData = map[string]string{
+			"psk.txt": "volsync:" + hex.EncodeToString(keyData),
+		}
+		if err := ctrl.SetControllerReference(m.owner, keySecret, m.client.Scheme()); err != nil {
+			m.logger.Error(err, utils.ErrUnableToSetControllerRef)
+			return nil, err
+		}
+		utils.SetOwnedByVolSync(keySecret)
+
+		if err := m.client.Create(ctx, keySecret); err != nil {
+			m.logger.Error(err, "error creating key Secret")
+			return nil, err
+		}
+	}
+
+	m.updateStatusPSK(&keySecret.Name)
+	return &keySecret.Name, nil
+}
+
+func (m *Mover) direction() string {
+	dir := "src"
+	if !m.isSource {
+		dir = "dst"
+	}
+	return dir
+}
+
+func (m *Mover) serviceSelector() map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":      m.direction() + "-" + m.owner.GetName(),
+		"app.kubernetes.io/component": "block-mover",
+		"app.kubernetes.io/part-of":   "volsync",
+	}
+}
+
+func (m *Mover) Cleanup(ctx context.Context) (mover.Result, error) {
+	m.logger.V(1).Info("Starting cleanup", "m.mainPVCName", m.mainPVCName, "m.isSource", m.isSource)
+	if !m.isSource {
+		m.logger.V(1).Info("removing snapshot annotations from pvc")
+		// Cleanup the snapshot annotation on pvc for replicationDestination scenario so that
+		// on the next sync (if snapshot CopyMethod is being used) a new snapshot will be created rather than re-using
+		_, destPVCName := m.getDestinationPVCName()
+		err := m.vh.RemoveSnapshotAnnotationFromPVC(ctx, m.logger, destPVCName)
+		if err != nil {
+			return mover.InProgress(), err
+		}
+	}
+
+	err := utils.CleanupObjects(ctx, m.client, m.logger, m.owner, cleanupTypes)
+	if err != nil {
+		return mover.InProgress(), err
+	}
+	m.logger.V(1).Info("Cleanup complete")
+	return mover.Complete(), nil
+}
+
+func (m *Mover) ensureSourcePVC(ctx context.Context) (*corev1.PersistentVolumeClaim, error) {
+	srcPVC := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      *m.mainPVCName,
+			Namespace: m.owner.GetNamespace(),
+		},
+	}
+	if err := m.client.Get(ctx, client.ObjectKeyFromObject(srcPVC), srcPVC); err != nil {
+		m.logger.Error(err, "unable to get source PVC", "PVC", client.ObjectKeyFromObject(srcPVC))
+		return nil, err
+	}
+	dataName := mover.VolSyncPrefix + m.owner.GetName() + "-" + m.direction()
+	pvc, err := m.vh.EnsurePVCFromSrc(ctx, m.logger, srcPVC, dataName, true)
+	if err != nil {
+		// If the error was a copy TriggerTimeoutError, update the latestMoverStatus to indicate error
+		var copyTriggerTimeoutError *vserrors.CopyTriggerTimeoutError
+		if errors.As(err, &copyTriggerTimeoutError) {
+			utils.UpdateMoverStatusFailed(m.latestMoverStatus, copyTriggerTimeoutError.Error())
+			// Don't return error - we want to keep reconciling at the normal in-progress rate
+			// but just indicate in the latestMoverStatus that there is an error (we've been waiting
+			// for the user to update the copy Trigger for too long)
+			return pvc, nil
+		}
+	}
+	return pvc, err
+}
+
+func (m *Mover) ensureDestinationPVC(ctx context.Context) (*corev1.PersistentVolumeClaim, error) {
+	isProvidedPVC, dataPVCName := m.getDestinationPVCName()
+	if isProvidedPVC {
+		return m.vh.UseProvidedPVC(ctx, dataPVCName)
+	}
+	// Need to allocate the incoming data volume
+	return m.vh.EnsureNewPVC(ctx, m.logger, dataPVCName, m.cleanupTempPVC)
+}
+
+func (m *Mover) getDestinationPVCName() (bool, string) {
+	if m.mainPVCName == nil {
+		newPvcName := mover.VolSyncPrefix + m.owner.GetName() + "-" + m.direction()
+		return false, newPvcName
+	}
+	return true, *m.mainPVCName
+}
+
+//nolint:funlen
+func (m *Mover) ensureJob(ctx context.Context, dataPVC *corev1.PersistentVolumeClaim,
+	sa *corev1.ServiceAccount, pskSecretName string) (*batchv1.Job, error) {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      volSyncBlockPrefix + m.direction() + "-" + m.owner.GetName(),
+			Namespace: m.owner.GetNamespace(),
+		},
+	}
+	logger := m.logger.WithValues("job", client.ObjectKeyFromObject(job))
+
+	// Stop a Job that has been running for too long and wait before starting
+	// it over
+	if wait, err := utils.EnforceMoverTimeout(ctx, m.client, logger, m.eventRecorder, m.owner, job,
+		m.moverConfig.MoverTimeout, m.latestMoverStatus); wait || err != nil {
+		return nil, err
+	}
+
+	op, err := utils.CreateOrUpdateDeleteOnImmutableErr(ctx, m.client, job, logger, func() error {
+		if err := ctrl.SetControllerReference(m.owner, job, m.client.Scheme()); err != nil {
+			logger.Error(err, utils.ErrUnableToSetControllerRef)
+			return err
+		}
+		utils.SetOwnedByVolSync(job)
+		utils.MarkForCleanup(m.owner, job)
+
+		job.Spec.Template.ObjectMeta.Name = job.Name
+		utils.AddAllLabels(&job.Spec.Template, m.serviceSelector())
+		utils.SetOwnedByVolSync(&job.Spec.Template) // ensure the Job's Pod gets the ownership label
+		backoffLimit := int32(2)
+		job.Spec.BackoffLimit = &backoffLimit
+
+		parallelism := int32(1)
+		if m.paused {
+			parallelism = int32(0)
+		}
+		job.Spec.Parallelism = &parallelism
+
+		readOnlyVolume := false
+
+		containerEnv := []corev1.EnvVar{}
+		containerCmd := []string{"/bin/bash", "-c", "/mover-block/server.sh"} // cmd for replicationDestination job
+		if m.isSource {
+			// Set dest address/port if necessary
+			if m.address != nil {
+				containerEnv = append(containerEnv, corev1.EnvVar{Name: "DESTINATION_ADDRESS", Value: *m.address})
+			}
+			if m.port != nil {
+				connectPort := strconv.Itoa(int(*m.port))
+				containerEnv = append(containerEnv, corev1.EnvVar{Name: "DESTINATION_PORT", Value: connectPort})
+			}
+			// Set container cmd for the replicationSource job
+			containerCmd = []string{"/bin/bash", "-c", "/mover-block/client.sh"}
+
+			// Set read-only for volume in repl source job spec if the PVC only supports read-only or
+			// the spec requires the source to be read-only
+			readOnlyVolume = m.readOnlySource || utils.PvcIsReadOnly(dataPVC)
+		}
+		podSpec := &job.Spec.Template.Spec
+		podSpec.Containers = []corev1.Container{{
+			Name:    "block",
+			Env:     containerEnv,
+			Command: containerCmd,
+			Image:   m.containerImage,
+			SecurityContext: &corev1.SecurityContext{
+				AllowPrivilegeEscalation: ptr.To(false),
+				Capabilities: &corev1.Capabilities{
+					Drop: []corev1.Capability{"ALL"},
+				},
+				Privileged:             ptr.To(false),
+				ReadOnlyRootFilesystem: ptr.To(true),
+			},
+		}}
+		job.Spec.Template.Spec.Containers[0].VolumeMounts = []corev1.VolumeMount{
+			{Name: "keys", MountPath: "/keys"},
+			{Name: "tempdir", MountPath: "/tmp"},
+		}
+		job.Spec.Template.Spec.Containers[0].VolumeDevices = []corev1.VolumeDevice{
+			{Name: dataVolumeName, DevicePath: devicePath},
+		}
+		podSpec.RestartPolicy = corev1.RestartPolicyNever
+		podSpec.ServiceAccountName = sa.Name
+		podSpec.Volumes = []corev1.Volume{
+			{Name: dataVolumeName, VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: dataPVC.Name,
+					ReadOnly:  readOnlyVolume,
+				}},
+			},
+			{Name: "keys", VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName:  pskSecretName,
+					DefaultMode: ptr.To[int32](0600),
+				}},
+			},
+			{Name: "tempdir", VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{
+					Medium: corev1.StorageMediumMemory,
+				}},
+			},
+		}
+		if m.vh.IsCopyMethodDirect() {
+			affinity, err := utils.AffinityFromVolume(ctx, m.client, logger, dataPVC)
+			if err != nil {
+				logger.Error(err, "unable to determine proper affinity", "PVC", client.ObjectKeyFromObject(dataPVC))
+				return err
+			}
+			podSpec.NodeSelector = affinity.NodeSelector
+			podSpec.Tolerations = affinity.Tolerations
+		}
+
+		// Update the job securityContext, podLabels and resourceRequirements from moverConfig (if specified)
+		utils.UpdatePodTemplateSpecFromMoverConfig(&job.Spec.Template, m.moverConfig, corev1.ResourceRequirements{})
+
+		// Keep the mover off of nodes it cannot run on
+		if err := utils.SetMoverNodeAffinity(ctx, m.client, logger, &job.Spec.Template); err != nil {
+			return err
+		}
+		if err := utils.SetMoverApplicationAffinity(ctx, m.client, logger, &job.Spec.Template,
+			m.moverConfig.MoverApplicationAffinity, m.owner.GetNamespace(), m.mainPVCName); err != nil {
+			return err
+		}
+
+		if m.privileged {
+			podSpec.Containers[0].Env = append(podSpec.Containers[0].Env, corev1.EnvVar{
+				Name:  "PRIVILEGED_MOVER",
+				Value: "1",
+			})
+			podSpec.Containers[0].SecurityContext.Capabilities.Add = []corev1.Capability{
+				"DAC_OVERRIDE", // Read/write all files
+				"CHOWN",        // chown files
+				"FOWNER",       // Set permission bits & times
+				"SETGID",       // Set process GID/supplemental groups
+			}
+			podSpec.Containers[0].SecurityContext.RunAsUser = ptr.To[int64](0)
+		} else {
+			podSpec.Containers[0].Env = append(podSpec.Containers[0].Env, corev1.EnvVar{
+				Name:  "PRIVILEGED_MOVER",
+				Value: "0",
+			})
+		}
+
+		// Enforce the security profile last so that it is not overridden
+		utils.ApplySecurityProfile(&job.Spec.Template, m.owner)
+
+		// Run mover in debug mode if required
+		podSpec.Containers[0].Env = utils.AppendDebugMoverEnvVar(m.owner, podSpec.Containers[0].Env)
+
+		logger.V(1).Info("Job has PVC", "PVC", dataPVC, "DS", dataPVC.Spec.DataSource)
+		return nil
+	})
+	if err == nil {
+		utils.SetJobRunningCondition(m.eventRecorder, m.owner, job)
+		err = utils.EnsureEvictionProtection(ctx, m.client, logger, job, m.moverConfig.ProtectFromEviction)
+	}
+	// If Job had failed, delete it so it can be recreated
+	if job.Status.Failed >= *job.Spec.BackoffLimit {
+		// Update status with mover logs from failed job
+		errorCode := &utils.ErrorCodeCollector{Patterns: errorCodePatterns}
+		utils.UpdateMoverStatusForFailedJob(ctx, m.logger, m.latestMoverStatus, job.GetName(), job.GetNamespace(),
+			errorCode.Filter(LogLineFilterFailure))
+		errorCode.Apply(m.latestMoverStatus)
+
+		logger.Info("deleting job -- backoff limit reached")
+		mover.RecordJobFinished(blockMoverName, job, mover.JobResultFailed)
+		m.eventRecorder.Eventf(m.owner, job, corev1.EventTypeWarning,
+			volsyncv1alpha1.EvRTransferFailed, volsyncv1alpha1.EvADeleteMover, "mover Job backoff limit reached")
+		err = m.client.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground))
+		return nil, err
+	}
+	if err != nil {
+		mover.RecordJobError(blockMoverName, err)
+		logger.Error(err, "reconcile failed")
+		return nil, err
+	}
+
+	logger.V(1).Info("Job reconciled", "operation", op)
+	if op == ctrlutil.OperationResultCreated {
+		dir := "receive"
+		if m.isSource {
+			dir = "transmit"
+		}
+		m.eventRecorder.Eventf(m.owner, job, corev1.EventTypeNormal,
+			volsyncv1alpha1.EvRTransferStarted, volsyncv1alpha1.EvACreateMover, "starting %s to %s data",
+			utils.KindAndName(m.client.Scheme(), job), dir)
+	}
+
+	// Stop here if the job hasn't completed yet
+	if job.Status.Succeeded == 0 {
+		return nil, nil
+	}
+
+	logger.Info("job completed")
+	mover.RecordJobFinished(blockMoverName, job, mover.JobResultSucceeded)
+
+	// update status with mover logs from successful job
+	utils.UpdateMoverStatusForSuccessfulJob(ctx, m.logger, m.latestMoverStatus, job.GetName(), job.GetNamespace(),
+		LogLineFilterSuccess)
+
+	// We only continue reconciling if the block job has completed
+	return job, nil
+}
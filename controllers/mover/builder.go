@@ -30,6 +30,7 @@ import (
 var (
 	ErrNoMoverFound        = fmt.Errorf("a replication method must be specified")
 	ErrMultipleMoversFound = fmt.Errorf("only one replication method can be supplied")
+	ErrMoverNotAllowed     = fmt.Errorf("the replication method is not allowed in this namespace")
 )
 
 // Catalog is the list of the available Builders for the controller to use when
@@ -81,7 +82,11 @@ func GetDestinationMoverFromCatalog(client client.Client, logger logr.Logger,
 	var dataMover Mover
 	for _, builder := range Catalog {
 		candidate, err := builder.FromDestination(client, logger, eventRecorder, destination, privileged)
-		if err == nil && candidate != nil {
+		if err != nil {
+			// The builder claimed the CR, but it's not usable as specified
+			return nil, err
+		}
+		if candidate != nil {
 			if dataMover != nil {
 				// Found 2 movers claiming this CR...
 				return nil, ErrMultipleMoversFound
@@ -101,7 +106,11 @@ func GetSourceMoverFromCatalog(client client.Client, logger logr.Logger,
 	var dataMover Mover
 	for _, builder := range Catalog {
 		candidate, err := builder.FromSource(client, logger, eventRecorder, source, privileged)
-		if err == nil && candidate != nil {
+		if err != nil {
+			// The builder claimed the CR, but it's not usable as specified
+			return nil, err
+		}
+		if candidate != nil {
 			if dataMover != nil {
 				// Found 2 movers claiming this CR...
 				return nil, ErrMultipleMoversFound
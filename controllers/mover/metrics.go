@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package mover
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	batchv1 "k8s.io/api/batch/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/backube/volsync/controllers/utils"
+)
+
+// Reasons for the failure of a mover Job, used as the "reason" label of
+// volsync_mover_job_failures_total
+const (
+	// The Job failed more times than its backoffLimit
+	JobFailureBackoffLimit = "backofflimit"
+	// The Job had to be deleted because an immutable field needed to change
+	JobFailureImmutable = "immutable"
+	// The Job was rejected by the API server
+	JobFailureValidation = "validation"
+)
+
+// Outcomes of a mover Job, used as the "result" label of
+// volsync_mover_job_duration_seconds
+const (
+	JobResultSucceeded = "succeeded"
+	JobResultFailed    = "failed"
+)
+
+// Completed Jobs are remembered so that they are only observed once, even
+// though the movers see them on each reconcile until they are cleaned up. The
+// oldest are forgotten once this many have been observed.
+const maxObservedJobs = 1000
+
+var (
+	jobFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name:      "mover_job_failures_total",
+			Namespace: "volsync",
+			Help:      "The number of mover Jobs that failed, by mover type and reason",
+		},
+		[]string{
+			"mover",  // Mover type (restic, rclone, etc.)
+			"reason", // "backofflimit", "immutable", or "validation"
+		},
+	)
+	jobDurations = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:      "mover_job_duration_seconds",
+			Namespace: "volsync",
+			Help:      "Duration of the mover Jobs that finished, by mover type and outcome",
+			// 30s to ~17h
+			Buckets: prometheus.ExponentialBuckets(30, 2, 12),
+		},
+		[]string{
+			"mover",  // Mover type (restic, rclone, etc.)
+			"result", // "succeeded" or "failed"
+		},
+	)
+
+	observedJobsMutex sync.Mutex
+	observedJobs      = map[types.UID]bool{}
+	// The observed Jobs, oldest first
+	observedJobOrder []types.UID
+)
+
+func init() {
+	metrics.Registry.MustRegister(jobFailures, jobDurations)
+}
+
+// RecordJobError counts the failure to create or update a mover Job, if err
+// indicates that the Job was deleted due to an immutable field or that it was
+// rejected by the API server. Other (transient) errors are not counted.
+func RecordJobError(moverName string, err error) {
+	switch {
+	case errors.Is(err, utils.ErrDeletedOnImmutable):
+		jobFailures.WithLabelValues(moverName, JobFailureImmutable).Inc()
+	case kerrors.IsInvalid(err):
+		jobFailures.WithLabelValues(moverName, JobFailureValidation).Inc()
+	}
+}
+
+// RecordJobFinished records the duration of a mover Job that has succeeded or
+// has failed more times than its backoffLimit, counting it as a failure in the
+// latter case. Each Job is only recorded once.
+func RecordJobFinished(moverName string, job *batchv1.Job, result string) {
+	if !firstObservation(job.GetUID()) {
+		return
+	}
+	if result == JobResultFailed {
+		jobFailures.WithLabelValues(moverName, JobFailureBackoffLimit).Inc()
+	}
+	if job.Status.StartTime == nil {
+		return
+	}
+	end := time.Now()
+	if job.Status.CompletionTime != nil {
+		end = job.Status.CompletionTime.Time
+	}
+	jobDurations.WithLabelValues(moverName, result).Observe(end.Sub(job.Status.StartTime.Time).Seconds())
+}
+
+func firstObservation(uid types.UID) bool {
+	observedJobsMutex.Lock()
+	defer observedJobsMutex.Unlock()
+	if observedJobs[uid] {
+		return false
+	}
+	if len(observedJobOrder) >= maxObservedJobs {
+		// Jobs are short-lived, so the oldest entries are no longer needed
+		delete(observedJobs, observedJobOrder[0])
+		observedJobOrder = observedJobOrder[1:]
+	}
+	observedJobs[uid] = true
+	observedJobOrder = append(observedJobOrder, uid)
+	return true
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package mover
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	batchv1 "k8s.io/api/batch/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("Mover Job metrics", func() {
+	// The metrics are global, so each test uses its own mover name
+	var moverName string
+	var moverCount int
+
+	newJob := func(uid string) *batchv1.Job {
+		start := metav1.NewTime(time.Now().Add(-time.Minute))
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{UID: types.UID(uid)},
+			Status: batchv1.JobStatus{
+				StartTime:      &start,
+				CompletionTime: &metav1.Time{Time: start.Add(30 * time.Second)},
+			},
+		}
+	}
+	failures := func(reason string) float64 {
+		return testutil.ToFloat64(jobFailures.WithLabelValues(moverName, reason))
+	}
+	durations := func(result string) uint64 {
+		metric := &dto.Metric{}
+		Expect(jobDurations.WithLabelValues(moverName, result).(prometheus.Histogram).Write(metric)).To(Succeed())
+		return metric.GetHistogram().GetSampleCount()
+	}
+
+	BeforeEach(func() {
+		moverCount++
+		moverName = fmt.Sprintf("test-mover-%d", moverCount)
+		observedJobsMutex.Lock()
+		observedJobs = map[types.UID]bool{}
+		observedJobOrder = nil
+		observedJobsMutex.Unlock()
+	})
+
+	It("counts Jobs that were deleted or rejected, but not transient errors", func() {
+		RecordJobError(moverName, fmt.Errorf("unable to update job: %w", utils.ErrDeletedOnImmutable))
+		RecordJobError(moverName, kerrors.NewInvalid(schema.GroupKind{Group: "batch", Kind: "Job"}, "job",
+			field.ErrorList{field.Required(field.NewPath("spec"), "")}))
+		RecordJobError(moverName, kerrors.NewConflict(schema.GroupResource{Group: "batch", Resource: "jobs"},
+			"job", errors.New("the object has been modified")))
+		Expect(failures(JobFailureImmutable)).To(Equal(float64(1)))
+		Expect(failures(JobFailureValidation)).To(Equal(float64(1)))
+	})
+
+	It("records each finished Job once", func() {
+		succeeded := newJob("succeeded")
+		failed := newJob("failed")
+		for i := 0; i < 3; i++ {
+			// The movers see a finished Job on each reconcile
+			RecordJobFinished(moverName, succeeded, JobResultSucceeded)
+			RecordJobFinished(moverName, failed, JobResultFailed)
+		}
+		Expect(durations(JobResultSucceeded)).To(Equal(uint64(1)))
+		Expect(durations(JobResultFailed)).To(Equal(uint64(1)))
+		Expect(failures(JobFailureBackoffLimit)).To(Equal(float64(1)))
+	})
+
+	It("only forgets the oldest Jobs", func() {
+		oldest := newJob("oldest")
+		RecordJobFinished(moverName, oldest, JobResultFailed)
+		var latest *batchv1.Job
+		for i := 1; i < maxObservedJobs; i++ {
+			latest = newJob(fmt.Sprintf("job-%d", i))
+			RecordJobFinished(moverName, latest, JobResultFailed)
+		}
+		Expect(failures(JobFailureBackoffLimit)).To(Equal(float64(maxObservedJobs)))
+
+		// One more Job pushes out the oldest one, but the others are
+		// still remembered
+		RecordJobFinished(moverName, newJob("new"), JobResultFailed)
+		RecordJobFinished(moverName, latest, JobResultFailed)
+		RecordJobFinished(moverName, newJob("job-1"), JobResultFailed)
+		Expect(failures(JobFailureBackoffLimit)).To(Equal(float64(maxObservedJobs + 1)))
+		Expect(firstObservation(oldest.GetUID())).To(BeTrue())
+	})
+})
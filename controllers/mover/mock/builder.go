@@ -0,0 +1,217 @@
+//go:build !disable_mock
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package mock
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/viper"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/mover"
+	"github.com/backube/volsync/controllers/utils"
+	"github.com/backube/volsync/controllers/volumehandler"
+)
+
+const (
+	mockMoverName = "mock"
+	// defaultMockContainerImage is the default container image for the mock
+	// data mover. It only needs a shell.
+	defaultMockContainerImage = "quay.io/backube/volsync:latest"
+	// Command line flag will be checked first
+	// If command line flag not set, the RELATED_IMAGE_ env var will be used
+	mockContainerImageFlag   = "mock-container-image"
+	mockContainerImageEnvVar = "RELATED_IMAGE_MOCK_CONTAINER"
+	// The mock mover is disabled unless the operator is started with this flag
+	enableMockMoverFlag = "enable-mock-mover"
+)
+
+type Builder struct {
+	viper *viper.Viper  // For unit tests to be able to override - global viper will be used by default in Register()
+	flags *flag.FlagSet // For unit tests to be able to override - global flags will be used by default in Register()
+}
+
+var _ mover.Builder = &Builder{}
+
+func Register() error {
+	// Use global viper & command line flags
+	b, err := newBuilder(viper.GetViper(), flag.CommandLine)
+	if err != nil {
+		return err
+	}
+
+	mover.Register(b)
+	return nil
+}
+
+func newBuilder(viper *viper.Viper, flags *flag.FlagSet) (*Builder, error) {
+	b := &Builder{
+		viper: viper,
+		flags: flags,
+	}
+
+	// Set default mock container image - will be used if both command line flag and env var are not set
+	b.viper.SetDefault(mockContainerImageFlag, defaultMockContainerImage)
+	b.viper.SetDefault(enableMockMoverFlag, false)
+
+	// Setup command line flags for the mock container image and for enabling the mover
+	b.flags.String(mockContainerImageFlag, defaultMockContainerImage,
+		"The container image for the mock data mover")
+	b.flags.Bool(enableMockMoverFlag, false,
+		"Enable the mock data mover, which runs mover Jobs without moving any data (for testing)")
+	// Viper will check for command line flag first, then fallback to the env var
+	err := b.viper.BindEnv(mockContainerImageFlag, mockContainerImageEnvVar)
+
+	return b, err
+}
+
+func (rb *Builder) Name() string { return mockMoverName }
+
+func (rb *Builder) VersionInfo() string {
+	return fmt.Sprintf("Mock container: %s (enabled: %t)", rb.getMockContainerImage(), rb.isEnabled())
+}
+
+// getMockContainerImage is the container image name of the mock data mover
+func (rb *Builder) getMockContainerImage() string {
+	return rb.viper.GetString(mockContainerImageFlag)
+}
+
+// isEnabled returns true if the operator has been started with the mock mover
+// enabled
+func (rb *Builder) isEnabled() bool {
+	return rb.viper.GetBool(enableMockMoverFlag)
+}
+
+func (rb *Builder) errDisabled() error {
+	return fmt.Errorf("the mock mover is not enabled; the operator must be started with --%s",
+		enableMockMoverFlag)
+}
+
+func (rb *Builder) FromSource(client client.Client, logger logr.Logger,
+	eventRecorder events.EventRecorder,
+	source *volsyncv1alpha1.ReplicationSource, privileged bool) (mover.Mover, error) {
+	// Only build if the CR belongs to us
+	if source.Spec.Mock == nil {
+		return nil, nil
+	}
+	if !rb.isEnabled() {
+		return nil, rb.errDisabled()
+	}
+
+	if source.Status.LatestMoverStatus == nil {
+		source.Status.LatestMoverStatus = &volsyncv1alpha1.MoverStatus{}
+	}
+
+	vh, err := volumehandler.NewVolumeHandler(
+		volumehandler.WithClient(client),
+		volumehandler.WithRecorder(eventRecorder),
+		volumehandler.WithOwner(source),
+		volumehandler.FromSource(&source.Spec.Mock.ReplicationSourceVolumeOptions),
+		volumehandler.SyncHooks(source.Spec.Hooks),
+		volumehandler.IntermediateRetention(source.Spec.IntermediateRetention, source.Status),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	isSource := true
+
+	saHandler := utils.NewSAHandler(client, source, isSource, privileged,
+		source.Spec.Mock.MoverServiceAccount)
+
+	containerImage, err := utils.MoverImage(rb.getMockContainerImage(), source.Spec.Mock.MoverImage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Mover{
+		client:            client,
+		logger:            logger.WithValues("method", "Mock"),
+		eventRecorder:     eventRecorder,
+		owner:             source,
+		vh:                vh,
+		saHandler:         saHandler,
+		containerImage:    containerImage,
+		settings:          source.Spec.Mock.MockSettings,
+		isSource:          isSource,
+		paused:            source.Spec.Paused,
+		readOnlySource:    source.Spec.EnforceReadOnlySource,
+		mainPVCName:       &source.Spec.SourcePVC,
+		latestMoverStatus: source.Status.LatestMoverStatus,
+		moverConfig:       source.Spec.Mock.MoverConfig,
+	}, nil
+}
+
+func (rb *Builder) FromDestination(client client.Client, logger logr.Logger,
+	eventRecorder events.EventRecorder,
+	destination *volsyncv1alpha1.ReplicationDestination, privileged bool) (mover.Mover, error) {
+	// Only build if the CR belongs to us
+	if destination.Spec.Mock == nil {
+		return nil, nil
+	}
+	if !rb.isEnabled() {
+		return nil, rb.errDisabled()
+	}
+
+	if destination.Status.LatestMoverStatus == nil {
+		destination.Status.LatestMoverStatus = &volsyncv1alpha1.MoverStatus{}
+	}
+
+	vh, err := volumehandler.NewVolumeHandler(
+		volumehandler.WithClient(client),
+		volumehandler.WithRecorder(eventRecorder),
+		volumehandler.WithOwner(destination),
+		volumehandler.FromDestination(&destination.Spec.Mock.ReplicationDestinationVolumeOptions),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	isSource := false
+
+	saHandler := utils.NewSAHandler(client, destination, isSource, privileged,
+		destination.Spec.Mock.MoverServiceAccount)
+
+	containerImage, err := utils.MoverImage(rb.getMockContainerImage(), destination.Spec.Mock.MoverImage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Mover{
+		client:            client,
+		logger:            logger.WithValues("method", "Mock"),
+		eventRecorder:     eventRecorder,
+		owner:             destination,
+		vh:                vh,
+		saHandler:         saHandler,
+		containerImage:    containerImage,
+		settings:          destination.Spec.Mock.MockSettings,
+		isSource:          isSource,
+		paused:            destination.Spec.Paused,
+		mainPVCName:       destination.Spec.Mock.DestinationPVC,
+		cleanupTempPVC:    destination.Spec.Mock.CleanupTempPVC,
+		latestMoverStatus: destination.Status.LatestMoverStatus,
+		moverConfig:       destination.Spec.Mock.MoverConfig,
+	}, nil
+}
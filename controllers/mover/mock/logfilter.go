@@ -0,0 +1,35 @@
+//go:build !disable_mock
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package mock
+
+import (
+	"regexp"
+)
+
+// The mock mover's script prefixes all of its output
+var mockRegex = regexp.MustCompile(`^mock mover:`)
+
+// Filter the log lines of the mock mover Job
+func LogLineFilter(line string) *string {
+	if mockRegex.MatchString(line) {
+		return &line
+	}
+	return nil
+}
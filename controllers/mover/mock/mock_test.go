@@ -0,0 +1,191 @@
+//go:build !disable_mock
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package mock
+
+import (
+	"flag"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/mover"
+)
+
+var _ = Describe("Mock mover properly registers", func() {
+	When("Mock's registration function is called", func() {
+		BeforeEach(func() {
+			Expect(Register()).To(Succeed())
+		})
+
+		It("is added to the mover catalog", func() {
+			found := false
+			for _, v := range mover.Catalog {
+				if _, ok := v.(*Builder); ok {
+					found = true
+				}
+			}
+			Expect(found).To(BeTrue())
+		})
+	})
+})
+
+var _ = Describe("Mock mover is only available when enabled", func() {
+	logger := zap.New(zap.UseDevMode(true), zap.WriteTo(GinkgoWriter))
+	var rs *volsyncv1alpha1.ReplicationSource
+	var rd *volsyncv1alpha1.ReplicationDestination
+	BeforeEach(func() {
+		rs = &volsyncv1alpha1.ReplicationSource{
+			ObjectMeta: metav1.ObjectMeta{Name: "cr", Namespace: "blah"},
+			Spec:       volsyncv1alpha1.ReplicationSourceSpec{Mock: &volsyncv1alpha1.ReplicationSourceMockSpec{}},
+		}
+		rd = &volsyncv1alpha1.ReplicationDestination{
+			ObjectMeta: metav1.ObjectMeta{Name: "cr", Namespace: "blah"},
+			Spec: volsyncv1alpha1.ReplicationDestinationSpec{
+				Mock: &volsyncv1alpha1.ReplicationDestinationMockSpec{},
+			},
+		}
+	})
+
+	It("ignores CRs that aren't for the mock mover", func() {
+		rs.Spec.Mock = nil
+		m, e := commonBuilderForTestSuite.FromSource(k8sClient, logger, &events.FakeRecorder{}, rs, false)
+		Expect(m).To(BeNil())
+		Expect(e).NotTo(HaveOccurred())
+		rd.Spec.Mock = nil
+		m, e = commonBuilderForTestSuite.FromDestination(k8sClient, logger, &events.FakeRecorder{}, rd, false)
+		Expect(m).To(BeNil())
+		Expect(e).NotTo(HaveOccurred())
+	})
+
+	It("refuses to build a mover when disabled", func() {
+		b, err := newBuilder(viper.New(), flag.NewFlagSet("testfsetmockdisabled", flag.ExitOnError))
+		Expect(err).NotTo(HaveOccurred())
+		m, e := b.FromSource(k8sClient, logger, &events.FakeRecorder{}, rs, false)
+		Expect(m).To(BeNil())
+		Expect(e).To(MatchError(ContainSubstring(enableMockMoverFlag)))
+		m, e = b.FromDestination(k8sClient, logger, &events.FakeRecorder{}, rd, false)
+		Expect(m).To(BeNil())
+		Expect(e).To(HaveOccurred())
+	})
+
+	It("builds a mover when enabled", func() {
+		m, e := commonBuilderForTestSuite.FromSource(k8sClient, logger, &events.FakeRecorder{}, rs, false)
+		Expect(e).NotTo(HaveOccurred())
+		Expect(m).NotTo(BeNil())
+		Expect(m.Name()).To(Equal(mockMoverName))
+		Expect(rs.Status.LatestMoverStatus).NotTo(BeNil())
+		m, e = commonBuilderForTestSuite.FromDestination(k8sClient, logger, &events.FakeRecorder{}, rd, false)
+		Expect(e).NotTo(HaveOccurred())
+		Expect(m).NotTo(BeNil())
+	})
+})
+
+var _ = Describe("Mock mover as a source", func() {
+	var ns *corev1.Namespace
+	logger := zap.New(zap.UseDevMode(true), zap.WriteTo(GinkgoWriter))
+	var rs *volsyncv1alpha1.ReplicationSource
+	var sPVC *corev1.PersistentVolumeClaim
+	var m *Mover
+	BeforeEach(func() {
+		ns = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{GenerateName: "mock-"},
+		}
+		Expect(k8sClient.Create(ctx, ns)).To(Succeed())
+
+		sPVC = &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "s", Namespace: ns.Name},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, sPVC)).To(Succeed())
+
+		rs = &volsyncv1alpha1.ReplicationSource{
+			ObjectMeta: metav1.ObjectMeta{Name: "rs", Namespace: ns.Name},
+			Spec: volsyncv1alpha1.ReplicationSourceSpec{
+				SourcePVC: sPVC.Name,
+				Mock: &volsyncv1alpha1.ReplicationSourceMockSpec{
+					ReplicationSourceVolumeOptions: volsyncv1alpha1.ReplicationSourceVolumeOptions{
+						CopyMethod: volsyncv1alpha1.CopyMethodDirect,
+					},
+					MockSettings: volsyncv1alpha1.MockSettings{
+						Duration: &metav1.Duration{Duration: 30 * time.Second},
+						Fail:     true,
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, rs)).To(Succeed())
+	})
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, ns)).To(Succeed())
+	})
+	JustBeforeEach(func() {
+		mm, err := commonBuilderForTestSuite.FromSource(k8sClient, logger, &events.FakeRecorder{}, rs, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mm).NotTo(BeNil())
+		m, _ = mm.(*Mover)
+		Expect(m).NotTo(BeNil())
+	})
+
+	It("runs a Job that uses the source volume without moving data", func() {
+		result, err := m.Synchronize(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Completed).To(BeFalse())
+
+		job := &batchv1.Job{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: "volsync-mock-src-rs", Namespace: ns.Name},
+			job)).To(Succeed())
+		c := job.Spec.Template.Spec.Containers[0]
+		Expect(c.Image).To(Equal(defaultMockContainerImage))
+		Expect(c.Env).To(ContainElements(
+			corev1.EnvVar{Name: "DIRECTION", Value: "source"},
+			corev1.EnvVar{Name: "DURATION", Value: "30"},
+			corev1.EnvVar{Name: "FAIL", Value: "1"},
+		))
+		Expect(c.VolumeMounts).To(ContainElement(HaveField("MountPath", mountPath)))
+		Expect(job.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal(sPVC.Name))
+
+		// Completing the Job completes the synchronization
+		job.Status.Succeeded = int32(1)
+		Expect(k8sClient.Status().Update(ctx, job)).To(Succeed())
+		result, err = m.Synchronize(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Completed).To(BeTrue())
+
+		result, err = m.Cleanup(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Completed).To(BeTrue())
+	})
+})
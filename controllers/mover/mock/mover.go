@@ -0,0 +1,370 @@
+//go:build !disable_mock
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package mock
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	vserrors "github.com/backube/volsync/controllers/errors"
+	"github.com/backube/volsync/controllers/mover"
+	"github.com/backube/volsync/controllers/utils"
+	"github.com/backube/volsync/controllers/volumehandler"
+)
+
+const (
+	dataVolumeName  = "data"
+	mountPath       = "/data"
+	devicePath      = "/dev/block"
+	defaultDuration = 10 * time.Second
+
+	volSyncMockPrefix = mover.VolSyncPrefix + "mock-"
+)
+
+// mockScript is run by the mover Job in place of a data transfer. It only
+// reports what it would have done.
+const mockScript = `echo "mock mover: starting ${DIRECTION} synchronization"
+sleep "${DURATION}"
+if [ "${FAIL}" = "1" ]; then
+  echo "mock mover: synchronization failed"
+  exit 1
+fi
+echo "mock mover: synchronization completed"`
+
+// Mover is the reconciliation logic for the mock data mover. It goes through
+// all of the steps of a synchronization, but its Job does not move any data.
+type Mover struct {
+	client            client.Client
+	logger            logr.Logger
+	eventRecorder     events.EventRecorder
+	owner             client.Object
+	vh                *volumehandler.VolumeHandler
+	saHandler         utils.SAHandler
+	containerImage    string
+	settings          volsyncv1alpha1.MockSettings
+	isSource          bool
+	paused            bool
+	readOnlySource    bool
+	mainPVCName       *string
+	latestMoverStatus *volsyncv1alpha1.MoverStatus
+	moverConfig       volsyncv1alpha1.MoverConfig
+	// Destination-only fields
+	cleanupTempPVC bool
+}
+
+var _ mover.Mover = &Mover{}
+
+// All object types that are temporary/per-iteration should be listed here. The
+// individual objects to be cleaned up must also be marked.
+var cleanupTypes = []client.Object{
+	&corev1.PersistentVolumeClaim{},
+	&snapv1.VolumeSnapshot{},
+	&batchv1.Job{},
+}
+
+func (m *Mover) Name() string { return mockMoverName }
+
+func (m *Mover) Synchronize(ctx context.Context) (mover.Result, error) {
+	var err error
+
+	// Allocate temporary data PVC
+	var dataPVC *corev1.PersistentVolumeClaim
+	if m.isSource {
+		dataPVC, err = m.ensureSourcePVC(ctx)
+	} else {
+		dataPVC, err = m.ensureDestinationPVC(ctx)
+	}
+	if dataPVC == nil || err != nil {
+		return mover.InProgress(), err
+	}
+
+	// Prepare ServiceAccount, role, rolebinding
+	sa, err := m.saHandler.Reconcile(ctx, m.logger)
+	if sa == nil || err != nil {
+		return mover.InProgress(), err
+	}
+
+	// Ensure mover Job
+	job, err := m.ensureJob(ctx, dataPVC, sa)
+	if job == nil || err != nil {
+		return mover.InProgress(), err
+	}
+
+	// On the destination, preserve the image and return it
+	if !m.isSource {
+		image, err := m.vh.EnsureImage(ctx, m.logger, dataPVC)
+		if image == nil || err != nil {
+			return mover.InProgress(), err
+		}
+		return mover.CompleteWithImage(image), nil
+	}
+
+	// On the source, just signal completion
+	return mover.Complete(), nil
+}
+
+func (m *Mover) direction() string {
+	dir := "src"
+	if !m.isSource {
+		dir = "dst"
+	}
+	return dir
+}
+
+func (m *Mover) Cleanup(ctx context.Context) (mover.Result, error) {
+	m.logger.V(1).Info("Starting cleanup", "m.mainPVCName", m.mainPVCName, "m.isSource", m.isSource)
+	if !m.isSource {
+		m.logger.V(1).Info("removing snapshot annotations from pvc")
+		// Cleanup the snapshot annotation on pvc for replicationDestination scenario so that
+		// on the next sync (if snapshot CopyMethod is being used) a new snapshot will be created rather than re-using
+		_, destPVCName := m.getDestinationPVCName()
+		err := m.vh.RemoveSnapshotAnnotationFromPVC(ctx, m.logger, destPVCName)
+		if err != nil {
+			return mover.InProgress(), err
+		}
+	}
+
+	err := utils.CleanupObjects(ctx, m.client, m.logger, m.owner, cleanupTypes)
+	if err != nil {
+		return mover.InProgress(), err
+	}
+	m.logger.V(1).Info("Cleanup complete")
+	return mover.Complete(), nil
+}
+
+func (m *Mover) ensureSourcePVC(ctx context.Context) (*corev1.PersistentVolumeClaim, error) {
+	srcPVC := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      *m.mainPVCName,
+			Namespace: m.owner.GetNamespace(),
+		},
+	}
+	if err := m.client.Get(ctx, client.ObjectKeyFromObject(srcPVC), srcPVC); err != nil {
+		m.logger.Error(err, "unable to get source PVC", "PVC", client.ObjectKeyFromObject(srcPVC))
+		return nil, err
+	}
+	dataName := mover.VolSyncPrefix + m.owner.GetName() + "-" + m.direction()
+	pvc, err := m.vh.EnsurePVCFromSrc(ctx, m.logger, srcPVC, dataName, true)
+	if err != nil {
+		// If the error was a copy TriggerTimeoutError, update the latestMoverStatus to indicate error
+		var copyTriggerTimeoutError *vserrors.CopyTriggerTimeoutError
+		if errors.As(err, &copyTriggerTimeoutError) {
+			utils.UpdateMoverStatusFailed(m.latestMoverStatus, copyTriggerTimeoutError.Error())
+			// Don't return error - we want to keep reconciling at the normal in-progress rate
+			// but just indicate in the latestMoverStatus that there is an error
+			return pvc, nil
+		}
+	}
+	return pvc, err
+}
+
+func (m *Mover) ensureDestinationPVC(ctx context.Context) (*corev1.PersistentVolumeClaim, error) {
+	isProvidedPVC, dataPVCName := m.getDestinationPVCName()
+	if isProvidedPVC {
+		return m.vh.UseProvidedPVC(ctx, dataPVCName)
+	}
+	// Need to allocate the incoming data volume
+	return m.vh.EnsureNewPVC(ctx, m.logger, dataPVCName, m.cleanupTempPVC)
+}
+
+func (m *Mover) getDestinationPVCName() (bool, string) {
+	if m.mainPVCName == nil {
+		newPvcName := mover.VolSyncPrefix + m.owner.GetName() + "-" + m.direction()
+		return false, newPvcName
+	}
+	return true, *m.mainPVCName
+}
+
+// duration returns the number of seconds that the mover Job should run
+func (m *Mover) duration() int {
+	d := defaultDuration
+	if m.settings.Duration != nil && m.settings.Duration.Duration >= 0 {
+		d = m.settings.Duration.Duration
+	}
+	return int(d.Seconds())
+}
+
+//nolint:funlen
+func (m *Mover) ensureJob(ctx context.Context, dataPVC *corev1.PersistentVolumeClaim,
+	sa *corev1.ServiceAccount) (*batchv1.Job, error) {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      volSyncMockPrefix + m.direction() + "-" + m.owner.GetName(),
+			Namespace: m.owner.GetNamespace(),
+		},
+	}
+	logger := m.logger.WithValues("job", client.ObjectKeyFromObject(job))
+
+	op, err := utils.CreateOrUpdateDeleteOnImmutableErr(ctx, m.client, job, logger, func() error {
+		if err := ctrl.SetControllerReference(m.owner, job, m.client.Scheme()); err != nil {
+			logger.Error(err, utils.ErrUnableToSetControllerRef)
+			return err
+		}
+		utils.SetOwnedByVolSync(job)
+		utils.MarkForCleanup(m.owner, job)
+
+		job.Spec.Template.ObjectMeta.Name = job.Name
+		utils.SetOwnedByVolSync(&job.Spec.Template) // ensure the Job's Pod gets the ownership label
+		backoffLimit := int32(2)
+		job.Spec.BackoffLimit = &backoffLimit
+
+		parallelism := int32(1)
+		if m.paused {
+			parallelism = int32(0)
+		}
+		job.Spec.Parallelism = &parallelism
+
+		fail := "0"
+		if m.settings.Fail {
+			fail = "1"
+		}
+		direction := "destination"
+		if m.isSource {
+			direction = "source"
+		}
+
+		podSpec := &job.Spec.Template.Spec
+		podSpec.Containers = []corev1.Container{{
+			Name:    "mock",
+			Command: []string{"/bin/sh", "-c", mockScript},
+			Env: []corev1.EnvVar{
+				{Name: "DIRECTION", Value: direction},
+				{Name: "DURATION", Value: strconv.Itoa(m.duration())},
+				{Name: "FAIL", Value: fail},
+			},
+			Image: m.containerImage,
+			SecurityContext: &corev1.SecurityContext{
+				AllowPrivilegeEscalation: ptr.To(false),
+				Capabilities: &corev1.Capabilities{
+					Drop: []corev1.Capability{"ALL"},
+				},
+				Privileged:             ptr.To(false),
+				ReadOnlyRootFilesystem: ptr.To(true),
+			},
+		}}
+		// The volume is attached to the mover as it would be for a real
+		// transfer, but it is never accessed
+		readOnlyVolume := m.isSource && (m.readOnlySource || utils.PvcIsReadOnly(dataPVC))
+		if utils.PvcIsBlockMode(dataPVC) {
+			podSpec.Containers[0].VolumeDevices = []corev1.VolumeDevice{
+				{Name: dataVolumeName, DevicePath: devicePath},
+			}
+		} else {
+			podSpec.Containers[0].VolumeMounts = []corev1.VolumeMount{
+				{Name: dataVolumeName, MountPath: mountPath, ReadOnly: readOnlyVolume},
+			}
+		}
+		podSpec.RestartPolicy = corev1.RestartPolicyNever
+		podSpec.ServiceAccountName = sa.Name
+		podSpec.Volumes = []corev1.Volume{
+			{Name: dataVolumeName, VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: dataPVC.Name,
+					ReadOnly:  readOnlyVolume,
+				}},
+			},
+		}
+		if m.vh.IsCopyMethodDirect() {
+			affinity, err := utils.AffinityFromVolume(ctx, m.client, logger, dataPVC)
+			if err != nil {
+				logger.Error(err, "unable to determine proper affinity", "PVC", client.ObjectKeyFromObject(dataPVC))
+				return err
+			}
+			podSpec.NodeSelector = affinity.NodeSelector
+			podSpec.Tolerations = affinity.Tolerations
+		}
+
+		// Update the job securityContext, podLabels and resourceRequirements from moverConfig (if specified)
+		utils.UpdatePodTemplateSpecFromMoverConfig(&job.Spec.Template, m.moverConfig, corev1.ResourceRequirements{})
+
+		// Keep the mover off of nodes it cannot run on
+		if err := utils.SetMoverNodeAffinity(ctx, m.client, logger, &job.Spec.Template); err != nil {
+			return err
+		}
+		if err := utils.SetMoverApplicationAffinity(ctx, m.client, logger, &job.Spec.Template,
+			m.moverConfig.MoverApplicationAffinity, m.owner.GetNamespace(), m.mainPVCName); err != nil {
+			return err
+		}
+
+		// Enforce the security profile last so that it is not overridden
+		utils.ApplySecurityProfile(&job.Spec.Template, m.owner)
+
+		return nil
+	})
+	if err == nil {
+		utils.SetJobRunningCondition(m.eventRecorder, m.owner, job)
+		err = utils.EnsureEvictionProtection(ctx, m.client, logger, job, m.moverConfig.ProtectFromEviction)
+	}
+	// If Job had failed, delete it so it can be recreated
+	if job.Status.Failed >= *job.Spec.BackoffLimit {
+		// Update status with mover logs from failed job
+		errorCode := &utils.ErrorCodeCollector{}
+		utils.UpdateMoverStatusForFailedJob(ctx, m.logger, m.latestMoverStatus, job.GetName(), job.GetNamespace(),
+			errorCode.Filter(LogLineFilter))
+		errorCode.Apply(m.latestMoverStatus)
+
+		logger.Info("deleting job -- backoff limit reached")
+		mover.RecordJobFinished(mockMoverName, job, mover.JobResultFailed)
+		m.eventRecorder.Eventf(m.owner, job, corev1.EventTypeWarning,
+			volsyncv1alpha1.EvRTransferFailed, volsyncv1alpha1.EvADeleteMover, "mover Job backoff limit reached")
+		err = m.client.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground))
+		return nil, err
+	}
+	if err != nil {
+		mover.RecordJobError(mockMoverName, err)
+		logger.Error(err, "reconcile failed")
+		return nil, err
+	}
+
+	logger.V(1).Info("Job reconciled", "operation", op)
+	if op == ctrlutil.OperationResultCreated {
+		m.eventRecorder.Eventf(m.owner, job, corev1.EventTypeNormal,
+			volsyncv1alpha1.EvRTransferStarted, volsyncv1alpha1.EvACreateMover, "starting %s (mock, no data is moved)",
+			utils.KindAndName(m.client.Scheme(), job))
+	}
+
+	// Stop here if the job hasn't completed yet
+	if job.Status.Succeeded == 0 {
+		return nil, nil
+	}
+
+	logger.Info("job completed")
+	mover.RecordJobFinished(mockMoverName, job, mover.JobResultSucceeded)
+
+	// update status with mover logs from successful job
+	utils.UpdateMoverStatusForSuccessfulJob(ctx, m.logger, m.latestMoverStatus, job.GetName(), job.GetNamespace(),
+		LogLineFilter)
+
+	return job, nil
+}
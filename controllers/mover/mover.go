@@ -44,6 +44,15 @@ type Mover interface {
 	Cleanup(ctx context.Context) (Result, error)
 }
 
+// ConnectionTester is implemented by the data movers that can check their
+// access to the repository independently of the synchronizations
+type ConnectionTester interface {
+	// TestConnection begins or continues the connection test requested by the
+	// connectionTest field of the mover. The Result is Completed once no test
+	// is pending. Must be idempotent.
+	TestConnection(ctx context.Context) (Result, error)
+}
+
 // Result indicates the outcome of a synchronization attempt
 type Result struct {
 	// Completed is set to true if the synchronization has completed. RetryAfter
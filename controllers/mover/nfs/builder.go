@@ -0,0 +1,199 @@
+//go:build !disable_nfs
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package nfs
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/viper"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/mover"
+	"github.com/backube/volsync/controllers/utils"
+	"github.com/backube/volsync/controllers/volumehandler"
+)
+
+const (
+	nfsMoverName = "nfs"
+	// defaultNFSContainerImage is the default container image for the
+	// nfs data mover
+	defaultNFSContainerImage = "quay.io/backube/volsync:latest"
+	// Command line flag will be checked first
+	// If command line flag not set, the RELATED_IMAGE_ env var will be used
+	nfsContainerImageFlag   = "nfs-container-image"
+	nfsContainerImageEnvVar = "RELATED_IMAGE_NFS_CONTAINER"
+)
+
+type Builder struct {
+	viper *viper.Viper  // For unit tests to be able to override - global viper will be used by default in Register()
+	flags *flag.FlagSet // For unit tests to be able to override - global flags will be used by default in Register()
+}
+
+var _ mover.Builder = &Builder{}
+
+func Register() error {
+	// Use global viper & command line flags
+	b, err := newBuilder(viper.GetViper(), flag.CommandLine)
+	if err != nil {
+		return err
+	}
+
+	mover.Register(b)
+	return nil
+}
+
+func newBuilder(viper *viper.Viper, flags *flag.FlagSet) (*Builder, error) {
+	b := &Builder{
+		viper: viper,
+		flags: flags,
+	}
+
+	// Set default nfs container image - will be used if both command line flag and env var are not set
+	b.viper.SetDefault(nfsContainerImageFlag, defaultNFSContainerImage)
+
+	// Setup command line flag for the nfs container image
+	b.flags.String(nfsContainerImageFlag, defaultNFSContainerImage,
+		"The container image for the nfs data mover")
+	// Viper will check for command line flag first, then fallback to the env var
+	err := b.viper.BindEnv(nfsContainerImageFlag, nfsContainerImageEnvVar)
+
+	return b, err
+}
+
+func (rb *Builder) Name() string { return nfsMoverName }
+
+func (rb *Builder) VersionInfo() string {
+	return fmt.Sprintf("NFS container: %s", rb.getNFSContainerImage())
+}
+
+// getNFSContainerImage is the container image name of the nfs data mover
+func (rb *Builder) getNFSContainerImage() string {
+	return rb.viper.GetString(nfsContainerImageFlag)
+}
+
+func (rb *Builder) FromSource(client client.Client, logger logr.Logger,
+	eventRecorder events.EventRecorder,
+	source *volsyncv1alpha1.ReplicationSource, privileged bool) (mover.Mover, error) {
+	// Only build if the CR belongs to us
+	if source.Spec.NFS == nil {
+		return nil, nil
+	}
+
+	// Make sure there's a place to write status info
+	if source.Status.LatestMoverStatus == nil {
+		source.Status.LatestMoverStatus = &volsyncv1alpha1.MoverStatus{}
+	}
+
+	vh, err := volumehandler.NewVolumeHandler(
+		volumehandler.WithClient(client),
+		volumehandler.WithRecorder(eventRecorder),
+		volumehandler.WithOwner(source),
+		volumehandler.FromSource(&source.Spec.NFS.ReplicationSourceVolumeOptions),
+		volumehandler.SyncHooks(source.Spec.Hooks),
+		volumehandler.IntermediateRetention(source.Spec.IntermediateRetention, source.Status),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	isSource := true
+
+	saHandler := utils.NewSAHandler(client, source, isSource, privileged,
+		source.Spec.NFS.MoverServiceAccount)
+
+	containerImage, err := utils.MoverImage(rb.getNFSContainerImage(), source.Spec.NFS.MoverImage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Mover{
+		client:            client,
+		logger:            logger.WithValues("method", "NFS"),
+		eventRecorder:     eventRecorder,
+		owner:             source,
+		vh:                vh,
+		saHandler:         saHandler,
+		containerImage:    containerImage,
+		export:            source.Spec.NFS.NFSExport,
+		isSource:          isSource,
+		paused:            source.Spec.Paused,
+		readOnlySource:    source.Spec.EnforceReadOnlySource,
+		mainPVCName:       &source.Spec.SourcePVC,
+		privileged:        privileged,
+		latestMoverStatus: source.Status.LatestMoverStatus,
+		moverConfig:       source.Spec.NFS.MoverConfig,
+	}, nil
+}
+
+func (rb *Builder) FromDestination(client client.Client, logger logr.Logger,
+	eventRecorder events.EventRecorder,
+	destination *volsyncv1alpha1.ReplicationDestination, privileged bool) (mover.Mover, error) {
+	// Only build if the CR belongs to us
+	if destination.Spec.NFS == nil {
+		return nil, nil
+	}
+
+	// Make sure there's a place to write status info
+	if destination.Status.LatestMoverStatus == nil {
+		destination.Status.LatestMoverStatus = &volsyncv1alpha1.MoverStatus{}
+	}
+
+	vh, err := volumehandler.NewVolumeHandler(
+		volumehandler.WithClient(client),
+		volumehandler.WithRecorder(eventRecorder),
+		volumehandler.WithOwner(destination),
+		volumehandler.FromDestination(&destination.Spec.NFS.ReplicationDestinationVolumeOptions),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	isSource := false
+
+	saHandler := utils.NewSAHandler(client, destination, isSource, privileged,
+		destination.Spec.NFS.MoverServiceAccount)
+
+	containerImage, err := utils.MoverImage(rb.getNFSContainerImage(), destination.Spec.NFS.MoverImage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Mover{
+		client:            client,
+		logger:            logger.WithValues("method", "NFS"),
+		eventRecorder:     eventRecorder,
+		owner:             destination,
+		vh:                vh,
+		saHandler:         saHandler,
+		containerImage:    containerImage,
+		export:            destination.Spec.NFS.NFSExport,
+		isSource:          isSource,
+		paused:            destination.Spec.Paused,
+		mainPVCName:       destination.Spec.NFS.DestinationPVC,
+		cleanupTempPVC:    destination.Spec.NFS.CleanupTempPVC,
+		privileged:        privileged,
+		latestMoverStatus: destination.Status.LatestMoverStatus,
+		moverConfig:       destination.Spec.NFS.MoverConfig,
+	}, nil
+}
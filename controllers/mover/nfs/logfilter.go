@@ -0,0 +1,73 @@
+//go:build !disable_nfs
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package nfs
+
+import (
+	"regexp"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var nfsRegex = regexp.MustCompile(
+	`([tT]otal size)|` +
+		`([nN]umber of (regular )?files transferred)|` +
+		`([rR]sync completed in)`)
+
+var nfsRegexFailures = regexp.MustCompile(
+	`^\s*([rR]sync)|` +
+		`([mM]ount)|` +
+		`([fF]ail)|` +
+		`([eE]rror)`)
+
+// Filter rsync log lines for a successful move job
+func LogLineFilterSuccess(line string) *string {
+	if nfsRegex.MatchString(line) {
+		return &line
+	}
+	return nil
+}
+
+func LogLineFilterFailure(line string) *string {
+	// Match first against the same stuff we do for success
+	if nfsRegex.MatchString(line) {
+		return &line
+	}
+
+	// Also match some specific failure lines
+	if nfsRegexFailures.MatchString(line) {
+		return &line
+	}
+
+	return nil
+}
+
+// errorCodePatterns classify the failures of mounting and writing to the
+// export
+var errorCodePatterns = []utils.ErrorCodePattern{
+	{
+		Code:  volsyncv1alpha1.MoverErrorCodeAuthFailed,
+		Regex: regexp.MustCompile(`(access denied by server)`),
+	},
+	{
+		Code:  volsyncv1alpha1.MoverErrorCodeConnectionFailed,
+		Regex: regexp.MustCompile(`(server .* not responding)|([sS]tale file handle)`),
+	},
+}
@@ -0,0 +1,322 @@
+//go:build !disable_nfs
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package nfs
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/mover"
+)
+
+var _ = Describe("NFS mover properly registers", func() {
+	When("NFS's registration function is called", func() {
+		BeforeEach(func() {
+			Expect(Register()).To(Succeed())
+		})
+
+		It("is added to the mover catalog", func() {
+			found := false
+			for _, v := range mover.Catalog {
+				if _, ok := v.(*Builder); ok {
+					found = true
+				}
+			}
+			Expect(found).To(BeTrue())
+		})
+	})
+})
+
+var _ = Describe("NFS mover ignores other movers", func() {
+	logger := zap.New(zap.UseDevMode(true), zap.WriteTo(GinkgoWriter))
+	It("ignores an RS that isn't for the nfs mover", func() {
+		rs := &volsyncv1alpha1.ReplicationSource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cr",
+				Namespace: "blah",
+			},
+			Spec: volsyncv1alpha1.ReplicationSourceSpec{
+				RsyncTLS: &volsyncv1alpha1.ReplicationSourceRsyncTLSSpec{},
+			},
+		}
+		m, e := commonBuilderForTestSuite.FromSource(k8sClient, logger, &events.FakeRecorder{}, rs,
+			true /* privileged */)
+		Expect(m).To(BeNil())
+		Expect(e).NotTo(HaveOccurred())
+	})
+	It("ignores an RD that isn't for the nfs mover", func() {
+		rd := &volsyncv1alpha1.ReplicationDestination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cr",
+				Namespace: "blah",
+			},
+			Spec: volsyncv1alpha1.ReplicationDestinationSpec{
+				RsyncTLS: &volsyncv1alpha1.ReplicationDestinationRsyncTLSSpec{},
+			},
+		}
+		m, e := commonBuilderForTestSuite.FromDestination(k8sClient, logger, &events.FakeRecorder{}, rd,
+			true /* privileged */)
+		Expect(m).To(BeNil())
+		Expect(e).NotTo(HaveOccurred())
+	})
+})
+
+func newTestPVC(name, namespace string, volumeMode corev1.PersistentVolumeMode) *corev1.PersistentVolumeClaim {
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{
+				corev1.ReadWriteOnce,
+			},
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					"storage": resource.MustParse("2Gi"),
+				},
+			},
+			VolumeMode: &volumeMode,
+		},
+	}
+}
+
+func newTestSA(namespace string) *corev1.ServiceAccount {
+	return &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "thesa",
+			Namespace: namespace,
+		},
+	}
+}
+
+func findVolume(podSpec corev1.PodSpec, name string) *corev1.Volume {
+	for i := range podSpec.Volumes {
+		if podSpec.Volumes[i].Name == name {
+			return &podSpec.Volumes[i]
+		}
+	}
+	return nil
+}
+
+var _ = Describe("NFS mover as a source", func() {
+	var ns *corev1.Namespace
+	logger := zap.New(zap.UseDevMode(true), zap.WriteTo(GinkgoWriter))
+	var rs *volsyncv1alpha1.ReplicationSource
+	var sPVC *corev1.PersistentVolumeClaim
+	var mover *Mover
+	BeforeEach(func() {
+		ns = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "nfs-",
+			},
+		}
+		Expect(k8sClient.Create(ctx, ns)).To(Succeed())
+
+		sPVC = newTestPVC("s", ns.Name, corev1.PersistentVolumeFilesystem)
+		Expect(k8sClient.Create(ctx, sPVC)).To(Succeed())
+
+		rs = &volsyncv1alpha1.ReplicationSource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "rs",
+				Namespace: ns.Name,
+			},
+			Spec: volsyncv1alpha1.ReplicationSourceSpec{
+				SourcePVC: sPVC.Name,
+				Trigger:   &volsyncv1alpha1.ReplicationSourceTriggerSpec{},
+				NFS: &volsyncv1alpha1.ReplicationSourceNFSSpec{
+					ReplicationSourceVolumeOptions: volsyncv1alpha1.ReplicationSourceVolumeOptions{
+						CopyMethod: volsyncv1alpha1.CopyMethodDirect,
+					},
+					NFSExport: volsyncv1alpha1.NFSExport{
+						Server: "filer.example.com",
+						Path:   "/exports/dr",
+					},
+				},
+			},
+		}
+	})
+	JustBeforeEach(func() {
+		Expect(k8sClient.Create(ctx, rs)).To(Succeed())
+		rs.Status = &volsyncv1alpha1.ReplicationSourceStatus{}
+
+		m, err := commonBuilderForTestSuite.FromSource(k8sClient, logger, &events.FakeRecorder{}, rs,
+			true /* privileged */)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(m).NotTo(BeNil())
+		mover, _ = m.(*Mover)
+		Expect(mover).NotTo(BeNil())
+	})
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, ns)).To(Succeed())
+	})
+
+	It("copies the volume to a writable mount of the export", func() {
+		sa := newTestSA(ns.Name)
+		Expect(k8sClient.Create(ctx, sa)).To(Succeed())
+
+		j, e := mover.ensureJob(ctx, sPVC, sa)
+		Expect(e).NotTo(HaveOccurred())
+		Expect(j).To(BeNil()) // hasn't completed
+
+		job := &batchv1.Job{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: "volsync-nfs-src-" + rs.Name,
+			Namespace: ns.Name}, job)).To(Succeed())
+		c := job.Spec.Template.Spec.Containers[0]
+		Expect(c.Command).To(Equal([]string{"/bin/bash", "-c", "/mover-nfs/sync.sh"}))
+		Expect(c.Image).To(Equal(defaultNFSContainerImage))
+		Expect(c.Env).To(ContainElement(corev1.EnvVar{Name: "DIRECTION", Value: "source"}))
+		Expect(c.VolumeMounts).To(ContainElements(
+			corev1.VolumeMount{Name: dataVolumeName, MountPath: dataMountPath},
+			corev1.VolumeMount{Name: nfsVolumeName, MountPath: nfsMountPath},
+		))
+
+		vol := findVolume(job.Spec.Template.Spec, nfsVolumeName)
+		Expect(vol).NotTo(BeNil())
+		Expect(vol.NFS).To(Equal(&corev1.NFSVolumeSource{
+			Server:   "filer.example.com",
+			Path:     "/exports/dr",
+			ReadOnly: false,
+		}))
+	})
+
+	When("mount options are specified", func() {
+		BeforeEach(func() {
+			rs.Spec.NFS.MountOptions = []string{"nfsvers=4.1", "hard"}
+		})
+		It("mounts the export with the NFS CSI driver", func() {
+			sa := newTestSA(ns.Name)
+			Expect(k8sClient.Create(ctx, sa)).To(Succeed())
+
+			_, e := mover.ensureJob(ctx, sPVC, sa)
+			Expect(e).NotTo(HaveOccurred())
+
+			job := &batchv1.Job{}
+			Expect(k8sClient.Get(ctx, client.ObjectKey{Name: "volsync-nfs-src-" + rs.Name,
+				Namespace: ns.Name}, job)).To(Succeed())
+			vol := findVolume(job.Spec.Template.Spec, nfsVolumeName)
+			Expect(vol).NotTo(BeNil())
+			Expect(vol.NFS).To(BeNil())
+			Expect(vol.CSI).NotTo(BeNil())
+			Expect(vol.CSI.Driver).To(Equal("nfs.csi.k8s.io"))
+			Expect(vol.CSI.VolumeAttributes).To(Equal(map[string]string{
+				"server":       "filer.example.com",
+				"share":        "/exports/dr",
+				"mountOptions": "nfsvers=4.1,hard",
+			}))
+		})
+	})
+
+	When("the source PVC is a block volume", func() {
+		BeforeEach(func() {
+			blockPVC := newTestPVC("block", ns.Name, corev1.PersistentVolumeBlock)
+			Expect(k8sClient.Create(ctx, blockPVC)).To(Succeed())
+			rs.Spec.SourcePVC = blockPVC.Name
+		})
+		It("refuses to synchronize", func() {
+			_, err := mover.Synchronize(ctx)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("volumeMode"))
+		})
+	})
+})
+
+var _ = Describe("NFS mover as a destination", func() {
+	var ns *corev1.Namespace
+	logger := zap.New(zap.UseDevMode(true), zap.WriteTo(GinkgoWriter))
+	var rd *volsyncv1alpha1.ReplicationDestination
+	var mover *Mover
+	BeforeEach(func() {
+		ns = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "nfs-",
+			},
+		}
+		Expect(k8sClient.Create(ctx, ns)).To(Succeed())
+
+		capacity := resource.MustParse("2Gi")
+		rd = &volsyncv1alpha1.ReplicationDestination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "rd",
+				Namespace: ns.Name,
+			},
+			Spec: volsyncv1alpha1.ReplicationDestinationSpec{
+				Trigger: &volsyncv1alpha1.ReplicationDestinationTriggerSpec{},
+				NFS: &volsyncv1alpha1.ReplicationDestinationNFSSpec{
+					ReplicationDestinationVolumeOptions: volsyncv1alpha1.ReplicationDestinationVolumeOptions{
+						AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+						Capacity:    &capacity,
+						CopyMethod:  volsyncv1alpha1.CopyMethodSnapshot,
+					},
+					NFSExport: volsyncv1alpha1.NFSExport{
+						Server: "filer.example.com",
+						Path:   "/exports/dr",
+					},
+				},
+			},
+		}
+	})
+	JustBeforeEach(func() {
+		Expect(k8sClient.Create(ctx, rd)).To(Succeed())
+		rd.Status = &volsyncv1alpha1.ReplicationDestinationStatus{}
+
+		m, err := commonBuilderForTestSuite.FromDestination(k8sClient, logger, &events.FakeRecorder{}, rd,
+			true /* privileged */)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(m).NotTo(BeNil())
+		mover, _ = m.(*Mover)
+		Expect(mover).NotTo(BeNil())
+	})
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, ns)).To(Succeed())
+	})
+
+	It("restores the volume from a read-only mount of the export", func() {
+		pvc, e := mover.ensureDestinationPVC(ctx)
+		Expect(e).NotTo(HaveOccurred())
+		Expect(pvc).NotTo(BeNil())
+		sa := newTestSA(ns.Name)
+		Expect(k8sClient.Create(ctx, sa)).To(Succeed())
+
+		j, e := mover.ensureJob(ctx, pvc, sa)
+		Expect(e).NotTo(HaveOccurred())
+		Expect(j).To(BeNil()) // hasn't completed
+
+		job := &batchv1.Job{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: "volsync-nfs-dst-" + rd.Name,
+			Namespace: ns.Name}, job)).To(Succeed())
+		c := job.Spec.Template.Spec.Containers[0]
+		Expect(c.Env).To(ContainElement(corev1.EnvVar{Name: "DIRECTION", Value: "destination"}))
+
+		vol := findVolume(job.Spec.Template.Spec, nfsVolumeName)
+		Expect(vol).NotTo(BeNil())
+		Expect(vol.NFS).NotTo(BeNil())
+		Expect(vol.NFS.ReadOnly).To(BeTrue())
+	})
+})
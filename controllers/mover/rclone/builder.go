@@ -43,6 +43,11 @@ const (
 	// If command line flag not set, the RELATED_IMAGE_ env var will be used
 	rcloneContainerImageFlag   = "rclone-container-image"
 	rcloneContainerImageEnvVar = "RELATED_IMAGE_RCLONE_CONTAINER"
+	// defaultRcloneWindowsContainerImage is the default container image for
+	// the rclone data mover on Windows nodes (moverOS: windows)
+	defaultRcloneWindowsContainerImage = "quay.io/backube/volsync-windows:latest"
+	rcloneWindowsContainerImageFlag    = "rclone-windows-container-image"
+	rcloneWindowsContainerImageEnvVar  = "RELATED_IMAGE_RCLONE_WINDOWS_CONTAINER"
 )
 
 type Builder struct {
@@ -76,7 +81,15 @@ func newBuilder(viper *viper.Viper, flags *flag.FlagSet) (*Builder, error) {
 	b.flags.String(rcloneContainerImageFlag, defaultRcloneContainerImage,
 		"The container image for the rclone data mover")
 	// Viper will check for command line flag first, then fallback to the env var
-	err := b.viper.BindEnv(rcloneContainerImageFlag, rcloneContainerImageEnvVar)
+	if err := b.viper.BindEnv(rcloneContainerImageFlag, rcloneContainerImageEnvVar); err != nil {
+		return nil, err
+	}
+
+	// Same for the Windows variant of the rclone container image
+	b.viper.SetDefault(rcloneWindowsContainerImageFlag, defaultRcloneWindowsContainerImage)
+	b.flags.String(rcloneWindowsContainerImageFlag, defaultRcloneWindowsContainerImage,
+		"The container image for the rclone data mover on Windows nodes")
+	err := b.viper.BindEnv(rcloneWindowsContainerImageFlag, rcloneWindowsContainerImageEnvVar)
 
 	return b, err
 }
@@ -84,7 +97,8 @@ func newBuilder(viper *viper.Viper, flags *flag.FlagSet) (*Builder, error) {
 func (rb *Builder) Name() string { return rcloneMoverName }
 
 func (rb *Builder) VersionInfo() string {
-	return fmt.Sprintf("Rclone container: %s", rb.getRcloneContainerImage())
+	return fmt.Sprintf("Rclone container: %s, Rclone Windows container: %s",
+		rb.getRcloneContainerImage(), rb.getRcloneWindowsContainerImage())
 }
 
 // rcloneContainerImage is the container image name of the rclone data mover
@@ -92,6 +106,20 @@ func (rb *Builder) getRcloneContainerImage() string {
 	return rb.viper.GetString(rcloneContainerImageFlag)
 }
 
+// getRcloneWindowsContainerImage is the container image name of the rclone
+// data mover for Windows nodes
+func (rb *Builder) getRcloneWindowsContainerImage() string {
+	return rb.viper.GetString(rcloneWindowsContainerImageFlag)
+}
+
+// defaultImageFor returns the default rclone container image for the OS
+func (rb *Builder) defaultImageFor(moverOS volsyncv1alpha1.MoverOS) string {
+	if utils.IsWindowsMover(moverOS) {
+		return rb.getRcloneWindowsContainerImage()
+	}
+	return rb.getRcloneContainerImage()
+}
+
 func (rb *Builder) FromSource(client client.Client, logger logr.Logger,
 	eventRecorder events.EventRecorder,
 	source *volsyncv1alpha1.ReplicationSource, privileged bool,
@@ -110,6 +138,8 @@ func (rb *Builder) FromSource(client client.Client, logger logr.Logger,
 		volumehandler.WithRecorder(eventRecorder),
 		volumehandler.WithOwner(source),
 		volumehandler.FromSource(&source.Spec.Rclone.ReplicationSourceVolumeOptions),
+		volumehandler.SyncHooks(source.Spec.Hooks),
+		volumehandler.IntermediateRetention(source.Spec.IntermediateRetention, source.Status),
 	)
 	if err != nil {
 		return nil, err
@@ -120,24 +150,37 @@ func (rb *Builder) FromSource(client client.Client, logger logr.Logger,
 	saHandler := utils.NewSAHandler(client, source, isSource, privileged,
 		source.Spec.Rclone.MoverServiceAccount)
 
+	containerImage, err := utils.MoverImage(rb.defaultImageFor(source.Spec.Rclone.MoverOS),
+		source.Spec.Rclone.MoverImage)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Mover{
-		client:              client,
-		logger:              logger.WithValues("method", "Rclone"),
-		eventRecorder:       eventRecorder,
-		owner:               source,
-		vh:                  vh,
-		saHandler:           saHandler,
-		containerImage:      rb.getRcloneContainerImage(),
-		rcloneConfigSection: source.Spec.Rclone.RcloneConfigSection,
-		rcloneDestPath:      source.Spec.Rclone.RcloneDestPath,
-		rcloneConfig:        source.Spec.Rclone.RcloneConfig,
-		isSource:            isSource,
-		paused:              source.Spec.Paused,
-		mainPVCName:         &source.Spec.SourcePVC,
-		customCASpec:        source.Spec.Rclone.CustomCA,
-		privileged:          privileged,
-		latestMoverStatus:   source.Status.LatestMoverStatus,
-		moverConfig:         source.Spec.Rclone.MoverConfig,
+		client:                    client,
+		logger:                    logger.WithValues("method", "Rclone"),
+		eventRecorder:             eventRecorder,
+		owner:                     source,
+		vh:                        vh,
+		saHandler:                 saHandler,
+		containerImage:            containerImage,
+		rcloneConfigSection:       source.Spec.Rclone.RcloneConfigSection,
+		rcloneDestPath:            source.Spec.Rclone.RcloneDestPath,
+		rcloneConfig:              source.Spec.Rclone.RcloneConfig,
+		connectionTest:            source.Spec.Rclone.ConnectionTest,
+		rcloneSourcePath:          source.Spec.Rclone.RcloneSourcePath,
+		rcloneSourceConfigSection: source.Spec.Rclone.RcloneSourceConfigSection,
+		remote:                    source.Spec.Rclone.Remote,
+		parallelism:               source.Spec.Rclone.Parallelism,
+		isSource:                  isSource,
+		paused:                    source.Spec.Paused,
+		readOnlySource:            source.Spec.EnforceReadOnlySource,
+		mainPVCName:               &source.Spec.SourcePVC,
+		customCASpec:              source.Spec.Rclone.CustomCA,
+		privileged:                privileged,
+		latestMoverStatus:         source.Status.LatestMoverStatus,
+		moverConfig:               source.Spec.Rclone.MoverConfig,
+		moverOS:                   source.Spec.Rclone.MoverOS,
 	}, nil
 }
 
@@ -168,6 +211,12 @@ func (rb *Builder) FromDestination(client client.Client, logger logr.Logger,
 	saHandler := utils.NewSAHandler(client, destination, isSource, privileged,
 		destination.Spec.Rclone.MoverServiceAccount)
 
+	containerImage, err := utils.MoverImage(rb.defaultImageFor(destination.Spec.Rclone.MoverOS),
+		destination.Spec.Rclone.MoverImage)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Mover{
 		client:              client,
 		logger:              logger.WithValues("method", "Rclone"),
@@ -175,17 +224,23 @@ func (rb *Builder) FromDestination(client client.Client, logger logr.Logger,
 		owner:               destination,
 		vh:                  vh,
 		saHandler:           saHandler,
-		containerImage:      rb.getRcloneContainerImage(),
+		containerImage:      containerImage,
 		rcloneConfigSection: destination.Spec.Rclone.RcloneConfigSection,
 		rcloneDestPath:      destination.Spec.Rclone.RcloneDestPath,
 		rcloneConfig:        destination.Spec.Rclone.RcloneConfig,
+		connectionTest:      destination.Spec.Rclone.ConnectionTest,
+		remote:              destination.Spec.Rclone.Remote,
+		parallelism:         destination.Spec.Rclone.Parallelism,
 		isSource:            isSource,
 		paused:              destination.Spec.Paused,
 		mainPVCName:         destination.Spec.Rclone.DestinationPVC,
 		cleanupTempPVC:      destination.Spec.Rclone.CleanupTempPVC,
+		verifyChecksum:      destination.Spec.Rclone.VerifyChecksum,
+		destinationStatus:   destination.Status,
 		customCASpec:        destination.Spec.Rclone.CustomCA,
 		privileged:          privileged,
 		latestMoverStatus:   destination.Status.LatestMoverStatus,
 		moverConfig:         destination.Spec.Rclone.MoverConfig,
+		moverOS:             destination.Spec.Rclone.MoverOS,
 	}, nil
 }
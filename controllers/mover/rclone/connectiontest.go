@@ -0,0 +1,169 @@
+//go:build !disable_rclone
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package rclone
+
+import (
+	"context"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/backube/volsync/controllers/mover"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ mover.ConnectionTester = &Mover{}
+
+// TestConnection lists the remote path (rclone lsd) in a short Job of its own
+// when requested by spec.rclone.connectionTest, recording the result in
+// status.connectionTest
+func (m *Mover) TestConnection(ctx context.Context) (mover.Result, error) {
+	if !utils.ConnectionTestPending(m.owner, m.connectionTest) {
+		return mover.Complete(), nil
+	}
+
+	if err := m.validateSpec(); err != nil {
+		return mover.InProgress(), err
+	}
+	var rcloneConfigSecret *corev1.Secret
+	var err error
+	if m.remote != nil {
+		rcloneConfigSecret, err = m.ensureGeneratedConfig(ctx)
+	} else {
+		rcloneConfigSecret, err = m.validateRcloneConfig(ctx)
+	}
+	if rcloneConfigSecret == nil || err != nil {
+		return mover.InProgress(), err
+	}
+
+	sa, err := m.saHandler.Reconcile(ctx, m.logger)
+	if sa == nil || err != nil {
+		return mover.InProgress(), err
+	}
+
+	customCAObj, err := utils.ValidateCustomCA(ctx, m.client, m.logger,
+		m.owner.GetNamespace(), m.customCASpec)
+	if err != nil {
+		return mover.InProgress(), err
+	}
+
+	dir := "src"
+	if !m.isSource {
+		dir = "dst"
+	}
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      mover.VolSyncPrefix + "rclone-conntest-" + dir + "-" + m.owner.GetName(),
+			Namespace: m.owner.GetNamespace(),
+		},
+	}
+	logger := m.logger.WithValues("job", client.ObjectKeyFromObject(job))
+
+	// The Job is not marked for cleanup so that it is not removed by the
+	// cleanup of a synchronization that runs at the same time
+	_, err = utils.CreateOrUpdateDeleteOnImmutableErr(ctx, m.client, job, logger, func() error {
+		if err := ctrl.SetControllerReference(m.owner, job, m.client.Scheme()); err != nil {
+			logger.Error(err, utils.ErrUnableToSetControllerRef)
+			return err
+		}
+		utils.SetOwnedByVolSync(job)
+		job.Spec.Template.ObjectMeta.Name = job.Name
+		utils.SetOwnedByVolSync(&job.Spec.Template)
+		// A failed connection is reported right away
+		job.Spec.BackoffLimit = ptr.To[int32](0)
+		parallelism := int32(1)
+		if m.paused {
+			parallelism = int32(0)
+		}
+		job.Spec.Parallelism = &parallelism
+
+		envVars := utils.AppendRCloneEnvVars(rcloneConfigSecret, []corev1.EnvVar{})
+		envVars = append(envVars,
+			corev1.EnvVar{Name: "RCLONE_CONFIG", Value: "/rclone-config/rclone.conf"},
+			corev1.EnvVar{Name: "RCLONE_DEST_PATH", Value: *m.rcloneDestPath},
+			corev1.EnvVar{Name: "DIRECTION", Value: "connection-test"},
+			corev1.EnvVar{Name: "RCLONE_CONFIG_SECTION", Value: *m.rcloneConfigSection},
+			corev1.EnvVar{Name: "PRIVILEGED_MOVER", Value: "0"},
+		)
+		envVars = utils.AppendEnvVarsForClusterWideProxy(envVars)
+
+		podSpec := &job.Spec.Template.Spec
+		podSpec.Containers = []corev1.Container{{
+			Name:    "rclone",
+			Env:     envVars,
+			Command: m.command(),
+			Image:   m.containerImage,
+			SecurityContext: &corev1.SecurityContext{
+				AllowPrivilegeEscalation: ptr.To(false),
+				Capabilities: &corev1.Capabilities{
+					Drop: []corev1.Capability{"ALL"},
+				},
+				Privileged:             ptr.To(false),
+				ReadOnlyRootFilesystem: ptr.To(true),
+			},
+			VolumeMounts: []corev1.VolumeMount{
+				{Name: rcloneSecret, MountPath: "/rclone-config/"},
+				{Name: "tempdir", MountPath: "/tmp"},
+			},
+		}}
+		podSpec.RestartPolicy = corev1.RestartPolicyNever
+		podSpec.ServiceAccountName = sa.Name
+		podSpec.Volumes = []corev1.Volume{
+			{Name: rcloneSecret, VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName:  rcloneConfigSecret.Name,
+					DefaultMode: ptr.To[int32](0600),
+				}},
+			},
+			{Name: "tempdir", VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{
+					Medium: corev1.StorageMediumMemory,
+				}},
+			},
+		}
+		mountCustomCA(podSpec, customCAObj)
+
+		utils.UpdatePodTemplateSpecFromMoverConfig(&job.Spec.Template, m.moverConfig, corev1.ResourceRequirements{})
+		utils.ApplySecurityProfile(&job.Spec.Template, m.owner)
+		utils.SetMoverOS(&job.Spec.Template, m.moverOS)
+		return utils.SetMoverNodeAffinity(ctx, m.client, logger, &job.Spec.Template)
+	})
+	if err != nil {
+		logger.Error(err, "reconcile failed")
+		return mover.InProgress(), err
+	}
+
+	if job.Status.Succeeded == 0 && job.Status.Failed == 0 {
+		return mover.InProgress(), nil
+	}
+	// Credentials from the config must never be recorded in the status
+	secretValues := sensitiveConfigValues(string(rcloneConfigSecret.Data["rclone.conf"]))
+	utils.RecordConnectionTest(ctx, m.logger, m.eventRecorder, m.owner, job, m.connectionTest,
+		utils.AllLines, secretValues...)
+	logger.Info("deleting job -- connection test completed")
+	if err := m.client.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil {
+		return mover.InProgress(), client.IgnoreNotFound(err)
+	}
+	return mover.Complete(), nil
+}
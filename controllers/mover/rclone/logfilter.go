@@ -21,6 +21,9 @@ package rclone
 
 import (
 	"regexp"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
 )
 
 var rcloneRegex = regexp.MustCompile(
@@ -37,3 +40,16 @@ func LogLineFilterSuccess(line string) *string {
 	}
 	return nil
 }
+
+// errorCodePatterns classify the failures of rclone
+var errorCodePatterns = []utils.ErrorCodePattern{
+	{
+		Code: volsyncv1alpha1.MoverErrorCodeAuthFailed,
+		Regex: regexp.MustCompile(`(InvalidAccessKeyId)|(SignatureDoesNotMatch)|(AccessDenied)|` +
+			`(AuthorizationFailure)|(invalid_grant)|(401 Unauthorized)|(403 Forbidden)`),
+	},
+	{
+		Code:  volsyncv1alpha1.MoverErrorCodeRepoNotFound,
+		Regex: regexp.MustCompile(`(NoSuchBucket)|(ContainerNotFound)|(directory not found)`),
+	},
+}
@@ -22,7 +22,9 @@ package rclone
 import (
 	"context"
 	"errors"
+	"fmt"
 	"path"
+	"strconv"
 
 	"github.com/go-logr/logr"
 	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
@@ -61,15 +63,25 @@ type Mover struct {
 	rcloneConfigSection *string
 	rcloneDestPath      *string
 	rcloneConfig        *string
-	isSource            bool
-	paused              bool
-	mainPVCName         *string
-	customCASpec        volsyncv1alpha1.CustomCASpec
-	privileged          bool // true if the mover should have elevated privileges
-	latestMoverStatus   *volsyncv1alpha1.MoverStatus
-	moverConfig         volsyncv1alpha1.MoverConfig
+	connectionTest      string
+	// Source-only fields for syncing from a remote instead of a PVC
+	rcloneSourcePath          *string
+	rcloneSourceConfigSection *string
+	remote                    *volsyncv1alpha1.RcloneRemoteSpec
+	parallelism               *int32
+	isSource                  bool
+	paused                    bool
+	readOnlySource            bool
+	mainPVCName               *string
+	customCASpec              volsyncv1alpha1.CustomCASpec
+	privileged                bool // true if the mover should have elevated privileges
+	latestMoverStatus         *volsyncv1alpha1.MoverStatus
+	moverConfig               volsyncv1alpha1.MoverConfig
+	moverOS                   volsyncv1alpha1.MoverOS
 	// Destination-only fields
-	cleanupTempPVC bool
+	cleanupTempPVC    bool
+	verifyChecksum    bool
+	destinationStatus *volsyncv1alpha1.ReplicationDestinationStatus
 }
 
 var _ mover.Mover = &Mover{}
@@ -92,21 +104,31 @@ func (m *Mover) Synchronize(ctx context.Context) (mover.Result, error) {
 		return mover.InProgress(), err
 	}
 
-	// Validate rCloneConfig Secret
-	rcloneConfigSecret, err := m.validateRcloneConfig(ctx)
+	// Validate rCloneConfig Secret, or generate it from the typed remote
+	var rcloneConfigSecret *corev1.Secret
+	if m.remote != nil {
+		rcloneConfigSecret, err = m.ensureGeneratedConfig(ctx)
+	} else {
+		rcloneConfigSecret, err = m.validateRcloneConfig(ctx)
+	}
 	if rcloneConfigSecret == nil || err != nil {
 		return mover.InProgress(), err
 	}
 
-	// Allocate temporary data PVC
+	// Allocate temporary data PVC. There is none when syncing between two
+	// remotes.
 	var dataPVC *corev1.PersistentVolumeClaim
-	if m.isSource {
-		dataPVC, err = m.ensureSourcePVC(ctx)
+	if m.isRemoteSource() {
+		m.logger.V(1).Info("syncing from a remote, no data PVC needed")
 	} else {
-		dataPVC, err = m.ensureDestinationPVC(ctx)
-	}
-	if dataPVC == nil || err != nil {
-		return mover.InProgress(), err
+		if m.isSource {
+			dataPVC, err = m.ensureSourcePVC(ctx)
+		} else {
+			dataPVC, err = m.ensureDestinationPVC(ctx)
+		}
+		if dataPVC == nil || err != nil {
+			return mover.InProgress(), err
+		}
 	}
 
 	// Prepare ServiceAccount, role, rolebinding
@@ -163,6 +185,12 @@ func (m *Mover) Cleanup(ctx context.Context) (mover.Result, error) {
 	return mover.Complete(), nil
 }
 
+// isRemoteSource returns true if the source syncs from rcloneSourcePath
+// instead of a PVC
+func (m *Mover) isRemoteSource() bool {
+	return m.isSource && m.rcloneSourcePath != nil && len(*m.rcloneSourcePath) > 0
+}
+
 func (m *Mover) ensureSourcePVC(ctx context.Context) (*corev1.PersistentVolumeClaim, error) {
 	srcPVC := &corev1.PersistentVolumeClaim{
 		ObjectMeta: metav1.ObjectMeta{
@@ -220,8 +248,12 @@ func (m *Mover) ensureJob(ctx context.Context, dataPVC *corev1.PersistentVolumeC
 		dir = "src"
 		direction = "source"
 
-		// Set read-only for volume in source mover job spec if the PVC only supports read-only
-		readOnlyVolume = utils.PvcIsReadOnly(dataPVC)
+		// Set read-only for volume in source mover job spec if the PVC only supports read-only or
+		// the spec requires the source to be read-only
+		readOnlyVolume = dataPVC != nil && (m.readOnlySource || utils.PvcIsReadOnly(dataPVC))
+	}
+	if m.isRemoteSource() {
+		direction = "remote"
 	}
 
 	job := &batchv1.Job{
@@ -232,6 +264,13 @@ func (m *Mover) ensureJob(ctx context.Context, dataPVC *corev1.PersistentVolumeC
 	}
 	logger := m.logger.WithValues("job", client.ObjectKeyFromObject(job))
 
+	// Stop a Job that has been running for too long and wait before starting
+	// it over
+	if wait, err := utils.EnforceMoverTimeout(ctx, m.client, logger, m.eventRecorder, m.owner, job,
+		m.moverConfig.MoverTimeout, m.latestMoverStatus); wait || err != nil {
+		return nil, err
+	}
+
 	_, err := utils.CreateOrUpdateDeleteOnImmutableErr(ctx, m.client, job, logger, func() error {
 		if err := ctrl.SetControllerReference(m.owner, job, m.client.Scheme()); err != nil {
 			logger.Error(err, utils.ErrUnableToSetControllerRef)
@@ -258,14 +297,32 @@ func (m *Mover) ensureJob(ctx context.Context, dataPVC *corev1.PersistentVolumeC
 			{Name: "RCLONE_CONFIG", Value: "/rclone-config/rclone.conf"},
 			{Name: "RCLONE_DEST_PATH", Value: *m.rcloneDestPath},
 			{Name: "DIRECTION", Value: direction},
-			{Name: "MOUNT_PATH", Value: mountPath},
 			{Name: "RCLONE_CONFIG_SECTION", Value: *m.rcloneConfigSection},
 		}
+		if dataPVC != nil {
+			defaultEnvVars = append(defaultEnvVars, corev1.EnvVar{Name: "MOUNT_PATH", Value: mountPath})
+		}
+		if m.isRemoteSource() {
+			sourceSection := *m.rcloneConfigSection
+			if m.rcloneSourceConfigSection != nil && len(*m.rcloneSourceConfigSection) > 0 {
+				sourceSection = *m.rcloneSourceConfigSection
+			}
+			defaultEnvVars = append(defaultEnvVars,
+				corev1.EnvVar{Name: "RCLONE_SOURCE_PATH", Value: *m.rcloneSourcePath},
+				corev1.EnvVar{Name: "RCLONE_SOURCE_CONFIG_SECTION", Value: sourceSection})
+		}
 
 		// Add our defaults after RCLONE_ env vars so any duplicates will be
 		// overridden by the defaults
 		envVars = append(envVars, defaultEnvVars...)
 
+		if !m.isSource && m.verifyChecksum {
+			envVars = append(envVars, corev1.EnvVar{Name: "VERIFY_CHECKSUM", Value: "1"})
+		}
+		if m.parallelism != nil {
+			envVars = append(envVars, corev1.EnvVar{Name: "PARALLELISM", Value: strconv.Itoa(int(*m.parallelism))})
+		}
+
 		// Cluster-wide proxy settings
 		envVars = utils.AppendEnvVarsForClusterWideProxy(envVars)
 
@@ -275,7 +332,7 @@ func (m *Mover) ensureJob(ctx context.Context, dataPVC *corev1.PersistentVolumeC
 		job.Spec.Template.Spec.Containers = []corev1.Container{{
 			Name:    "rclone",
 			Env:     envVars,
-			Command: []string{"/bin/bash", "-c", "/mover-rclone/active.sh"},
+			Command: m.command(),
 			Image:   m.containerImage,
 			SecurityContext: &corev1.SecurityContext{
 				AllowPrivilegeEscalation: ptr.To(false),
@@ -286,7 +343,6 @@ func (m *Mover) ensureJob(ctx context.Context, dataPVC *corev1.PersistentVolumeC
 				ReadOnlyRootFilesystem: ptr.To(true),
 			},
 			VolumeMounts: []corev1.VolumeMount{
-				{Name: dataVolumeName, MountPath: mountPath},
 				{Name: rcloneSecret, MountPath: "/rclone-config/"},
 				{Name: "tempdir", MountPath: "/tmp"},
 			},
@@ -294,12 +350,6 @@ func (m *Mover) ensureJob(ctx context.Context, dataPVC *corev1.PersistentVolumeC
 		job.Spec.Template.Spec.RestartPolicy = corev1.RestartPolicyNever
 		job.Spec.Template.Spec.ServiceAccountName = sa.Name
 		job.Spec.Template.Spec.Volumes = []corev1.Volume{
-			{Name: dataVolumeName, VolumeSource: corev1.VolumeSource{
-				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
-					ClaimName: dataPVC.Name,
-					ReadOnly:  readOnlyVolume,
-				}},
-			},
 			{Name: rcloneSecret, VolumeSource: corev1.VolumeSource{
 				Secret: &corev1.SecretVolumeSource{
 					SecretName:  rcloneConfigSecret.Name,
@@ -312,36 +362,33 @@ func (m *Mover) ensureJob(ctx context.Context, dataPVC *corev1.PersistentVolumeC
 				}},
 			},
 		}
-		if m.vh.IsCopyMethodDirect() {
-			affinity, err := utils.AffinityFromVolume(ctx, m.client, logger, dataPVC)
-			if err != nil {
-				logger.Error(err, "unable to determine proper affinity", "PVC", client.ObjectKeyFromObject(dataPVC))
-				return err
+		if dataPVC != nil {
+			job.Spec.Template.Spec.Containers[0].VolumeMounts = append(
+				[]corev1.VolumeMount{{Name: dataVolumeName, MountPath: mountPath}},
+				job.Spec.Template.Spec.Containers[0].VolumeMounts...)
+			job.Spec.Template.Spec.Volumes = append([]corev1.Volume{
+				{Name: dataVolumeName, VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: dataPVC.Name,
+						ReadOnly:  readOnlyVolume,
+					}},
+				},
+			}, job.Spec.Template.Spec.Volumes...)
+			if m.vh.IsCopyMethodDirect() {
+				affinity, err := utils.AffinityFromVolume(ctx, m.client, logger, dataPVC)
+				if err != nil {
+					logger.Error(err, "unable to determine proper affinity", "PVC", client.ObjectKeyFromObject(dataPVC))
+					return err
+				}
+				job.Spec.Template.Spec.NodeSelector = affinity.NodeSelector
+				job.Spec.Template.Spec.Tolerations = affinity.Tolerations
 			}
-			job.Spec.Template.Spec.NodeSelector = affinity.NodeSelector
-			job.Spec.Template.Spec.Tolerations = affinity.Tolerations
+			logger.V(1).Info("Job has PVC", "PVC", dataPVC, "DS", dataPVC.Spec.DataSource)
 		}
-		logger.V(1).Info("Job has PVC", "PVC", dataPVC, "DS", dataPVC.Spec.DataSource)
 
 		podSpec := &job.Spec.Template.Spec
 
-		if customCAObj != nil {
-			// Tell mover where to find the cert
-			podSpec.Containers[0].Env = append(podSpec.Containers[0].Env, corev1.EnvVar{
-				Name:  "CUSTOM_CA",
-				Value: path.Join(rcloneCAMountPath, rcloneCAFilename),
-			})
-			// Mount the custom CA certificate
-			podSpec.Containers[0].VolumeMounts =
-				append(podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{
-					Name:      "custom-ca",
-					MountPath: rcloneCAMountPath,
-				})
-			podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
-				Name:         "custom-ca",
-				VolumeSource: customCAObj.GetVolumeSource(rcloneCAFilename),
-			})
-		}
+		mountCustomCA(podSpec, customCAObj)
 
 		// Update the job securityContext, podLabels and resourceRequirements from moverConfig (if specified)
 		utils.UpdatePodTemplateSpecFromMoverConfig(&job.Spec.Template, m.moverConfig, corev1.ResourceRequirements{})
@@ -366,40 +413,121 @@ func (m *Mover) ensureJob(ctx context.Context, dataPVC *corev1.PersistentVolumeC
 			})
 		}
 
+		// Enforce the security profile last so that it is not overridden
+		utils.ApplySecurityProfile(&job.Spec.Template, m.owner)
+		utils.SetMoverOS(&job.Spec.Template, m.moverOS)
+
+		// Keep the mover off of nodes it cannot run on
+		if err := utils.SetMoverNodeAffinity(ctx, m.client, logger, &job.Spec.Template); err != nil {
+			return err
+		}
+		appPVCName := m.mainPVCName
+		if dataPVC == nil {
+			appPVCName = nil
+		}
+		if err := utils.SetMoverApplicationAffinity(ctx, m.client, logger, &job.Spec.Template,
+			m.moverConfig.MoverApplicationAffinity, m.owner.GetNamespace(), appPVCName); err != nil {
+			return err
+		}
+
 		return nil
 	})
+	// Credentials from the config must never be recorded in the status
+	secretValues := sensitiveConfigValues(string(rcloneConfigSecret.Data["rclone.conf"]))
+	if err == nil {
+		utils.SetJobRunningCondition(m.eventRecorder, m.owner, job)
+		err = utils.EnsureEvictionProtection(ctx, m.client, logger, job, m.moverConfig.ProtectFromEviction)
+	}
 	// If Job had failed, delete it so it can be recreated
 	if job.Status.Failed >= *job.Spec.BackoffLimit {
 		// Update status with mover logs from failed job
+		verification := &utils.VerificationCollector{}
+		errorCode := &utils.ErrorCodeCollector{Patterns: errorCodePatterns}
 		utils.UpdateMoverStatusForFailedJob(ctx, m.logger, m.latestMoverStatus, job.GetName(), job.GetNamespace(),
-			utils.AllLines)
+			errorCode.Filter(verification.Filter(LogLineFilterFailure)), secretValues...)
+		errorCode.Apply(m.latestMoverStatus)
+		m.latestMoverStatus.Progress = nil
 
 		logger.Info("deleting job -- backoff limit reached")
+		mover.RecordJobFinished(rcloneMoverName, job, mover.JobResultFailed)
 		err = m.client.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground))
+		if err == nil && !m.isSource && verification.Failed() {
+			// Surface the mismatch in the Synchronizing condition
+			m.destinationStatus.Verification = verification.Result()
+			err = fmt.Errorf("restored data failed verification: %s", m.destinationStatus.Verification.Message)
+		}
 		return nil, err
 	}
 	if err != nil {
+		mover.RecordJobError(rcloneMoverName, err)
 		logger.Error(err, "reconcile failed")
 		return nil, err
 	}
 	// Stop here if the job hasn't completed yet
 	if job.Status.Succeeded == 0 {
+		// Report the progress of the sync while it is running
+		if job.Status.Active > 0 {
+			utils.UpdateMoverProgressForRunningJob(ctx, m.logger, m.latestMoverStatus, job.GetName(),
+				job.GetNamespace(), ParseProgress)
+		}
 		return nil, nil
 	}
 
 	logger.Info("job completed")
+	mover.RecordJobFinished(rcloneMoverName, job, mover.JobResultSucceeded)
 
 	// update status with mover logs from successful job
+	verification := &utils.VerificationCollector{}
+	volumeUsage := &utils.VolumeUsageCollector{}
 	utils.UpdateMoverStatusForSuccessfulJob(ctx, m.logger, m.latestMoverStatus, job.GetName(), job.GetNamespace(),
-		LogLineFilterSuccess)
+		verification.Filter(volumeUsage.Filter(LogLineFilterSuccess)), secretValues...)
+	m.latestMoverStatus.Progress = nil
+	if !m.isSource && m.destinationStatus != nil {
+		m.destinationStatus.Verification = verification.Result()
+		volumeUsage.Record(m.destinationStatus.CapacityForecast, dataPVC.Name)
+	}
 
 	// We only continue reconciling if the rclone job has completed
 	return job, nil
 }
 
+// mountCustomCA mounts the custom CA certificate (if any) into the mover
+func mountCustomCA(podSpec *corev1.PodSpec, customCAObj utils.CustomCAObject) {
+	if customCAObj == nil {
+		return
+	}
+	// Tell mover where to find the cert
+	podSpec.Containers[0].Env = append(podSpec.Containers[0].Env, corev1.EnvVar{
+		Name:  "CUSTOM_CA",
+		Value: path.Join(rcloneCAMountPath, rcloneCAFilename),
+	})
+	// Mount the custom CA certificate
+	podSpec.Containers[0].VolumeMounts =
+		append(podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      "custom-ca",
+			MountPath: rcloneCAMountPath,
+		})
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name:         "custom-ca",
+		VolumeSource: customCAObj.GetVolumeSource(rcloneCAFilename),
+	})
+}
+
+// command is the entrypoint of the mover container. The Windows variant of the
+// mover image provides a PowerShell port of the mover script.
+func (m *Mover) command() []string {
+	if utils.IsWindowsMover(m.moverOS) {
+		return []string{"pwsh", "-NoProfile", "-NonInteractive", "-File", "C:\\mover-rclone\\active.ps1"}
+	}
+	return []string{"/bin/bash", "-c", "/mover-rclone/active.sh"}
+}
+
 func (m *Mover) validateSpec() error {
 	m.logger.V(1).Info("Initiate Rclone Spec validation")
-	if m.rcloneConfig == nil || len(*m.rcloneConfig) == 0 {
+	if m.remote != nil {
+		// The config is generated, so there's no secret or section to check
+		m.rcloneConfigSection = ptr.To(generatedConfigSection)
+	} else if m.rcloneConfig == nil || len(*m.rcloneConfig) == 0 {
 		err := errors.New("unable to get Rclone config secret name")
 		m.logger.Error(err, "Rclone Spec validation error")
 		return err
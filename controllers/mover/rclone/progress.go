@@ -0,0 +1,75 @@
+//go:build !disable_rclone
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package rclone
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+// Prefix of the lines logged by the mover with the output of rclone's
+// core/stats remote control command
+const progressLinePrefix = "volsync-progress: "
+
+// The fields of rclone's core/stats that are used to report progress
+type rcloneStats struct {
+	Bytes      int64    `json:"bytes"`
+	TotalBytes int64    `json:"totalBytes"`
+	ETA        *float64 `json:"eta"`
+}
+
+// ParseProgress parses a progress line from the rclone mover log
+func ParseProgress(line string) *volsyncv1alpha1.MoverProgress {
+	statsJSON, found := strings.CutPrefix(line, progressLinePrefix)
+	if !found {
+		return nil
+	}
+	stats := rcloneStats{}
+	if err := json.Unmarshal([]byte(statsJSON), &stats); err != nil {
+		return nil
+	}
+
+	progress := &volsyncv1alpha1.MoverProgress{
+		BytesTransferred: stats.Bytes,
+		TotalBytes:       stats.TotalBytes,
+	}
+	if stats.TotalBytes > 0 {
+		progress.PercentComplete = ptr.To(int32(min(100, stats.Bytes*100/stats.TotalBytes)))
+	}
+	if stats.ETA != nil {
+		progress.ETA = &metav1.Duration{Duration: time.Duration(*stats.ETA) * time.Second}
+	}
+	return progress
+}
+
+// Filter rclone log lines for a failed mover job, dropping the progress
+// reports
+func LogLineFilterFailure(line string) *string {
+	if strings.HasPrefix(line, progressLinePrefix) {
+		return nil
+	}
+	return &line
+}
@@ -0,0 +1,60 @@
+//go:build !disable_rclone
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package rclone_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	rclone "github.com/backube/volsync/controllers/mover/rclone"
+)
+
+var _ = Describe("Rclone progress", func() {
+	//nolint:lll
+	statsLine := `volsync-progress: {	"bytes": 268435456,	"checks": 0,	"deletedDirs": 0,	"deletes": 0,	"elapsedTime": 62.5,	"errors": 0,	"eta": 187,	"fatalError": false,	"renames": 0,	"retryError": false,	"speed": 4294967.2,	"totalBytes": 1073741824,	"totalChecks": 0,	"totalTransfers": 10,	"transferTime": 60.1,	"transfers": 2}`
+
+	It("parses the stats reported by the mover", func() {
+		progress := rclone.ParseProgress(statsLine)
+		Expect(progress).NotTo(BeNil())
+		Expect(progress.BytesTransferred).To(Equal(int64(268435456)))
+		Expect(progress.TotalBytes).To(Equal(int64(1073741824)))
+		Expect(progress.PercentComplete).To(HaveValue(Equal(int32(25))))
+		Expect(progress.ETA.Duration).To(Equal(187 * time.Second))
+	})
+
+	It("handles stats without a total or an ETA", func() {
+		progress := rclone.ParseProgress(`volsync-progress: {"bytes": 0, "eta": null, "totalBytes": 0}`)
+		Expect(progress).NotTo(BeNil())
+		Expect(progress.PercentComplete).To(BeNil())
+		Expect(progress.ETA).To(BeNil())
+	})
+
+	It("ignores other lines", func() {
+		Expect(rclone.ParseProgress("Transferred:   1.000 GiB / 1.000 GiB, 100%")).To(BeNil())
+		Expect(rclone.ParseProgress("volsync-progress: not json")).To(BeNil())
+	})
+
+	It("drops progress lines from the logs of failed jobs", func() {
+		Expect(rclone.LogLineFilterFailure(statsLine)).To(BeNil())
+		Expect(rclone.LogLineFilterFailure("error: failed")).To(HaveValue(Equal("error: failed")))
+	})
+})
@@ -175,6 +175,39 @@ var _ = Describe("Rclone init flags and env vars", func() {
 			})
 		})
 
+		Context("When the mover runs on Windows nodes", func() {
+			It("Should use the default rclone Windows container image", func() {
+				Expect(builderForInitTests.getRcloneWindowsContainerImage()).To(
+					Equal(defaultRcloneWindowsContainerImage))
+
+				rs := &volsyncv1alpha1.ReplicationSource{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testrscr",
+						Namespace: "testing",
+					},
+					Spec: volsyncv1alpha1.ReplicationSourceSpec{
+						Rclone: &volsyncv1alpha1.ReplicationSourceRcloneSpec{
+							MoverOS: volsyncv1alpha1.MoverOSWindows,
+						},
+					},
+					Status: &volsyncv1alpha1.ReplicationSourceStatus{},
+				}
+				sourceMover, err := builderForInitTests.FromSource(k8sClient, logger, &events.FakeRecorder{}, rs,
+					true /* privileged */)
+				Expect(err).NotTo(HaveOccurred())
+				sourceRcloneMover, _ := sourceMover.(*Mover)
+				Expect(sourceRcloneMover.containerImage).To(Equal(defaultRcloneWindowsContainerImage))
+				Expect(sourceRcloneMover.command()[0]).To(Equal("pwsh"))
+			})
+
+			It("Should use the rclone Windows container image set by the cmd line flag", func() {
+				const cmdLineOverrideImageName = "test-rclone-windows-image-name:cmdlineoverride"
+				Expect(testPflagSet.Set("rclone-windows-container-image", cmdLineOverrideImageName)).To(Succeed())
+				Expect(builderForInitTests.getRcloneWindowsContainerImage()).To(Equal(cmdLineOverrideImageName))
+				Expect(builderForInitTests.getRcloneContainerImage()).To(Equal(defaultRcloneContainerImage))
+			})
+		})
+
 		Context("When rclone container image cmd line flag is not set and env var is", func() {
 			const envVarOverrideImageName = "test-rclone-image-name:setbyenvvar"
 			BeforeEach(func() {
@@ -1144,6 +1177,43 @@ var _ = Describe("Rclone as a source", func() {
 					validateJobEnvVars(job.Spec.Template.Spec.Containers[0].Env, true)
 				})
 
+				It("should pass the parallelism to the mover", func() {
+					mover.parallelism = ptr.To[int32](32)
+					j, e := mover.ensureJob(ctx, sPVC, sa, rcloneConfigSecret, nil) // Using sPVC as dataPVC (i.e. direct)
+					Expect(e).NotTo(HaveOccurred())
+					Expect(j).To(BeNil()) // hasn't completed
+					nsn := types.NamespacedName{Name: jobName, Namespace: ns.Name}
+					job = &batchv1.Job{}
+					Expect(k8sClient.Get(ctx, nsn, job)).To(Succeed())
+					Expect(job.Spec.Template.Spec.Containers[0].Env).To(ContainElement(
+						corev1.EnvVar{Name: "PARALLELISM", Value: "32"}))
+				})
+
+				When("syncing from another remote", func() {
+					BeforeEach(func() {
+						rs.Spec.SourcePVC = ""
+						rs.Spec.Rclone.RcloneSourcePath = ptr.To("bucket/source")
+						rs.Spec.Rclone.RcloneSourceConfigSection = ptr.To("other-remote")
+					})
+					It("should not mount a volume", func() {
+						j, e := mover.ensureJob(ctx, nil, sa, rcloneConfigSecret, nil)
+						Expect(e).NotTo(HaveOccurred())
+						Expect(j).To(BeNil()) // hasn't completed
+						nsn := types.NamespacedName{Name: jobName, Namespace: ns.Name}
+						job = &batchv1.Job{}
+						Expect(k8sClient.Get(ctx, nsn, job)).To(Succeed())
+
+						env := job.Spec.Template.Spec.Containers[0].Env
+						validateEnvVar(env, "DIRECTION", "remote")
+						validateEnvVar(env, "RCLONE_SOURCE_PATH", "bucket/source")
+						validateEnvVar(env, "RCLONE_SOURCE_CONFIG_SECTION", "other-remote")
+						validateEnvVar(env, "RCLONE_DEST_PATH", testRcloneDestPath)
+						for _, v := range job.Spec.Template.Spec.Volumes {
+							Expect(v.PersistentVolumeClaim).To(BeNil())
+						}
+					})
+				})
+
 				It("Should not have container resourceRequirements set by default", func() {
 					j, e := mover.ensureJob(ctx, sPVC, sa, rcloneConfigSecret, nil) // Using sPVC as dataPVC (i.e. direct)
 					Expect(e).NotTo(HaveOccurred())
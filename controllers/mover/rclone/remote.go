@@ -0,0 +1,217 @@
+//go:build !disable_rclone
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package rclone
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/mover"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+const (
+	// The section of the generated rclone.conf that holds the remote
+	generatedConfigSection = "volsync"
+)
+
+// Options of rclone remotes whose values must never appear in the status
+var sensitiveConfigKeys = map[string]bool{
+	"access_key_id":               true,
+	"secret_access_key":           true,
+	"session_token":               true,
+	"key":                         true,
+	"sas_url":                     true,
+	"service_account_credentials": true,
+	"client_secret":               true,
+	"token":                       true,
+	"password":                    true,
+	"pass":                        true,
+	"password2":                   true,
+	"bearer_token":                true,
+}
+
+// renderRemoteConfig generates an rclone.conf for the remote, taking the
+// credentials from creds (the data of the credentials Secret). It returns an
+// error if creds do not satisfy the requirements of the provider.
+func renderRemoteConfig(remote *volsyncv1alpha1.RcloneRemoteSpec, creds map[string][]byte) (string, error) {
+	options := map[string]string{}
+	var credKeys []string
+	switch {
+	case remote.S3 != nil:
+		options["type"] = "s3"
+		options["provider"] = "AWS"
+		if remote.S3.Provider != "" {
+			options["provider"] = remote.S3.Provider
+		}
+		options["region"] = remote.S3.Region
+		options["endpoint"] = remote.S3.Endpoint
+		credKeys = []string{"access_key_id", "secret_access_key", "session_token"}
+		if !remote.EnvAuth {
+			if err := requireKeys(creds, "access_key_id", "secret_access_key"); err != nil {
+				return "", err
+			}
+		}
+	case remote.AzureBlob != nil:
+		options["type"] = "azureblob"
+		options["account"] = remote.AzureBlob.Account
+		credKeys = []string{"key", "sas_url"}
+		if !remote.EnvAuth {
+			if err := requireOneKey(creds, "key", "sas_url"); err != nil {
+				return "", err
+			}
+		}
+	case remote.GoogleCloudStorage != nil:
+		options["type"] = "google cloud storage"
+		options["project_number"] = remote.GoogleCloudStorage.ProjectNumber
+		if remote.GoogleCloudStorage.BucketPolicyOnly {
+			options["bucket_policy_only"] = "true"
+		}
+		credKeys = []string{"service_account_credentials"}
+		if !remote.EnvAuth {
+			if err := requireKeys(creds, "service_account_credentials"); err != nil {
+				return "", err
+			}
+		}
+	default:
+		return "", fmt.Errorf("remote must specify a provider")
+	}
+	if remote.EnvAuth {
+		options["env_auth"] = "true"
+	} else {
+		for _, k := range credKeys {
+			if v, ok := creds[k]; ok {
+				// rclone.conf values are single-line
+				options[k] = strings.ReplaceAll(strings.TrimSpace(string(v)), "\n", "")
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(options))
+	for k, v := range options {
+		if v != "" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	var conf strings.Builder
+	fmt.Fprintf(&conf, "[%s]\n", generatedConfigSection)
+	for _, k := range keys {
+		fmt.Fprintf(&conf, "%s = %s\n", k, options[k])
+	}
+	return conf.String(), nil
+}
+
+func requireKeys(creds map[string][]byte, keys ...string) error {
+	for _, k := range keys {
+		if len(creds[k]) == 0 {
+			return fmt.Errorf("credentials secret is missing field: %v", k)
+		}
+	}
+	return nil
+}
+
+func requireOneKey(creds map[string][]byte, keys ...string) error {
+	found := 0
+	for _, k := range keys {
+		if len(creds[k]) > 0 {
+			found++
+		}
+	}
+	if found != 1 {
+		return fmt.Errorf("credentials secret must have exactly one of the fields: %v", keys)
+	}
+	return nil
+}
+
+// ensureGeneratedConfig renders the rclone configuration of the typed remote
+// into a Secret owned by the ReplicationSource/Destination
+func (m *Mover) ensureGeneratedConfig(ctx context.Context) (*corev1.Secret, error) {
+	var creds map[string][]byte
+	if m.remote.CredentialsSecretName != nil {
+		credSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      *m.remote.CredentialsSecretName,
+				Namespace: m.owner.GetNamespace(),
+			},
+		}
+		if err := m.client.Get(ctx, client.ObjectKeyFromObject(credSecret), credSecret); err != nil {
+			m.logger.Error(err, "failed to get credentials Secret",
+				"Secret", client.ObjectKeyFromObject(credSecret))
+			return nil, err
+		}
+		creds = credSecret.Data
+	}
+	conf, err := renderRemoteConfig(m.remote, creds)
+	if err != nil {
+		m.logger.Error(err, "unable to generate rclone config from remote")
+		return nil, err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      mover.VolSyncPrefix + "rclone-config-" + m.owner.GetName(),
+			Namespace: m.owner.GetNamespace(),
+		},
+	}
+	logger := m.logger.WithValues("rcloneConfig Secret", client.ObjectKeyFromObject(secret))
+	op, err := ctrlutil.CreateOrUpdate(ctx, m.client, secret, func() error {
+		if err := ctrl.SetControllerReference(m.owner, secret, m.client.Scheme()); err != nil {
+			logger.Error(err, utils.ErrUnableToSetControllerRef)
+			return err
+		}
+		utils.SetOwnedByVolSync(secret)
+		secret.Data = map[string][]byte{"rclone.conf": []byte(conf)}
+		return nil
+	})
+	if err != nil {
+		logger.Error(err, "reconcile failed")
+		return nil, err
+	}
+	logger.V(1).Info("generated rclone config reconciled", "operation", op)
+	return secret, nil
+}
+
+// sensitiveConfigValues returns the values of the options in an rclone.conf
+// that hold credentials
+func sensitiveConfigValues(conf string) []string {
+	var values []string
+	for _, line := range strings.Split(conf, "\n") {
+		k, v, found := strings.Cut(line, "=")
+		if !found {
+			continue
+		}
+		k = strings.TrimSpace(k)
+		v = strings.TrimSpace(v)
+		if sensitiveConfigKeys[k] && v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
@@ -0,0 +1,97 @@
+//go:build !disable_rclone
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package rclone
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("Rclone typed remotes", func() {
+	It("generates the config of an S3 remote", func() {
+		remote := &volsyncv1alpha1.RcloneRemoteSpec{
+			CredentialsSecretName: ptr.To("creds"),
+			S3: &volsyncv1alpha1.RcloneS3Remote{
+				Provider: "Minio",
+				Endpoint: "http://minio.minio.svc:9000",
+			},
+		}
+		conf, err := renderRemoteConfig(remote, map[string][]byte{
+			"access_key_id":     []byte("AKID"),
+			"secret_access_key": []byte("SECRET\n"),
+			"unrelated":         []byte("ignored"),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conf).To(Equal("[volsync]\n" +
+			"access_key_id = AKID\n" +
+			"endpoint = http://minio.minio.svc:9000\n" +
+			"provider = Minio\n" +
+			"secret_access_key = SECRET\n" +
+			"type = s3\n"))
+	})
+
+	It("requires the credentials of the provider", func() {
+		remote := &volsyncv1alpha1.RcloneRemoteSpec{
+			CredentialsSecretName: ptr.To("creds"),
+			S3:                    &volsyncv1alpha1.RcloneS3Remote{},
+		}
+		_, err := renderRemoteConfig(remote, map[string][]byte{"access_key_id": []byte("AKID")})
+		Expect(err).To(MatchError(ContainSubstring("secret_access_key")))
+	})
+
+	It("rejects mutually exclusive credentials", func() {
+		remote := &volsyncv1alpha1.RcloneRemoteSpec{
+			CredentialsSecretName: ptr.To("creds"),
+			AzureBlob:             &volsyncv1alpha1.RcloneAzureBlobRemote{Account: "acct"},
+		}
+		_, err := renderRemoteConfig(remote, map[string][]byte{
+			"key":     []byte("k"),
+			"sas_url": []byte("https://acct.blob.core.windows.net/?sig=x"),
+		})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("uses the environment for credentials when envAuth is set", func() {
+		remote := &volsyncv1alpha1.RcloneRemoteSpec{
+			EnvAuth:            true,
+			GoogleCloudStorage: &volsyncv1alpha1.RcloneGoogleCloudStorageRemote{BucketPolicyOnly: true},
+		}
+		conf, err := renderRemoteConfig(remote, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conf).To(Equal("[volsync]\n" +
+			"bucket_policy_only = true\n" +
+			"env_auth = true\n" +
+			"type = google cloud storage\n"))
+	})
+
+	It("redacts credentials from the logs recorded in the status", func() {
+		values := sensitiveConfigValues("[remote]\ntype = s3\naccess_key_id = AKID\nsecret_access_key = SECRET\n")
+		Expect(values).To(ConsistOf("AKID", "SECRET"))
+
+		redact := utils.NewRedactor(values)
+		Expect(redact("Failed to create file system: key AKID/SECRET rejected")).To(
+			Equal("Failed to create file system: key <redacted>/<redacted> rejected"))
+		Expect(redact("Transferred: 1 / 1, 100%")).To(Equal("Transferred: 1 / 1, 100%"))
+	})
+})
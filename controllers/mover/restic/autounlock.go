@@ -0,0 +1,82 @@
+//go:build !disable_restic
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package restic
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+const (
+	automaticUnlockPrefix = "VOLSYNC_AUTOMATIC_UNLOCK="
+	// Locks older than this are stale unless spec.restic.automaticUnlock
+	// says otherwise
+	defaultStaleLockAge = time.Hour
+)
+
+// automaticUnlockCollector picks the stale locks that the mover removed out
+// of the mover logs. The mover reports them as:
+//
+//	VOLSYNC_AUTOMATIC_UNLOCK=<lock count> <age of oldest lock in seconds>
+type automaticUnlockCollector struct {
+	status *volsyncv1alpha1.ResticAutomaticUnlockStatus
+}
+
+// filter wraps a log line filter, capturing the removed locks while passing
+// everything through to the wrapped filter
+func (c *automaticUnlockCollector) filter(next func(string) *string) func(string) *string {
+	return func(line string) *string {
+		if strings.HasPrefix(line, automaticUnlockPrefix) {
+			fields := strings.Fields(strings.TrimPrefix(line, automaticUnlockPrefix))
+			if len(fields) == 2 {
+				count, cErr := strconv.ParseInt(fields[0], 10, 32)
+				age, aErr := strconv.ParseInt(fields[1], 10, 64)
+				if cErr == nil && aErr == nil {
+					c.status = &volsyncv1alpha1.ResticAutomaticUnlockStatus{
+						Time:          ptr.To(metav1.Now()),
+						StaleLocks:    int32(count),
+						OldestLockAge: &metav1.Duration{Duration: time.Duration(age) * time.Second},
+					}
+				}
+			}
+		}
+		return next(line)
+	}
+}
+
+// staleLockAgeSeconds returns the value of the AUTOMATIC_UNLOCK_AGE
+// environment variable of the mover, which is empty if automatic unlock is
+// disabled
+func staleLockAgeSeconds(spec *volsyncv1alpha1.ResticAutomaticUnlockSpec) string {
+	if spec == nil {
+		return ""
+	}
+	age := defaultStaleLockAge
+	if spec.StaleLockAge != nil {
+		age = spec.StaleLockAge.Duration
+	}
+	return strconv.FormatInt(int64(age/time.Second), 10)
+}
@@ -0,0 +1,51 @@
+//go:build !disable_restic
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package restic
+
+import (
+	"strings"
+)
+
+const (
+	bitRotPrefix = "VOLSYNC_BITROT="
+	// Maximum number of suspected corrupt files listed in the status
+	maxSuspectedCorruptFiles = 20
+)
+
+// bitRotCollector picks the files that the mover found to have changed without
+// their modification time changing out of the mover logs
+type bitRotCollector struct {
+	files []string
+	count int32
+}
+
+// filter wraps a log line filter, capturing the suspected corrupt files while
+// passing everything through to the wrapped filter
+func (b *bitRotCollector) filter(next func(string) *string) func(string) *string {
+	return func(line string) *string {
+		if strings.HasPrefix(line, bitRotPrefix) {
+			b.count++
+			if len(b.files) < maxSuspectedCorruptFiles {
+				b.files = append(b.files, strings.TrimPrefix(line, bitRotPrefix))
+			}
+		}
+		return next(line)
+	}
+}
@@ -109,12 +109,27 @@ func (rb *Builder) FromSource(client client.Client, logger logr.Logger,
 		source.Status.LatestMoverStatus = &volsyncv1alpha1.MoverStatus{}
 	}
 
-	vh, err := volumehandler.NewVolumeHandler(
+	vhOptions := []volumehandler.VHOption{
 		volumehandler.WithClient(client),
 		volumehandler.WithRecorder(eventRecorder),
 		volumehandler.WithOwner(source),
 		volumehandler.FromSource(&source.Spec.Restic.ReplicationSourceVolumeOptions),
-	)
+		volumehandler.SyncHooks(source.Spec.Hooks),
+		volumehandler.IntermediateRetention(source.Spec.IntermediateRetention, source.Status),
+	}
+
+	// When keeping the copy point snapshots, each sync iteration needs its own
+	// uniquely named snapshot so that previous ones are not re-used
+	copyPointSnapshotName := ""
+	if source.Spec.Restic.KeepCopyPointSnapshot != nil &&
+		source.Spec.Restic.CopyMethod == volsyncv1alpha1.CopyMethodSnapshot &&
+		source.Status.LastSyncStartTime != nil {
+		copyPointSnapshotName = mover.VolSyncPrefix + source.GetName() + "-src-" +
+			source.Status.LastSyncStartTime.UTC().Format("20060102150405")
+		vhOptions = append(vhOptions, volumehandler.SnapshotName(copyPointSnapshotName))
+	}
+
+	vh, err := volumehandler.NewVolumeHandler(vhOptions...)
 	if err != nil {
 		return nil, err
 	}
@@ -124,6 +139,11 @@ func (rb *Builder) FromSource(client client.Client, logger logr.Logger,
 	saHandler := utils.NewSAHandler(client, source, isSource, privileged,
 		source.Spec.Restic.MoverServiceAccount)
 
+	containerImage, err := utils.MoverImage(rb.getResticContainerImage(), source.Spec.Restic.MoverImage)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Mover{
 		client:                client,
 		logger:                logger.WithValues("method", "Restic"),
@@ -131,19 +151,41 @@ func (rb *Builder) FromSource(client client.Client, logger logr.Logger,
 		owner:                 source,
 		vh:                    vh,
 		saHandler:             saHandler,
-		containerImage:        rb.getResticContainerImage(),
+		containerImage:        containerImage,
 		cacheAccessModes:      source.Spec.Restic.CacheAccessModes,
 		cacheCapacity:         source.Spec.Restic.CacheCapacity,
 		cacheStorageClassName: source.Spec.Restic.CacheStorageClassName,
+		cacheType:             source.Spec.Restic.CacheType,
 		repositoryName:        source.Spec.Restic.Repository,
+		repositorySecretRef:   source.Spec.Restic.RepositorySecretRef,
+		ensureRepository:      source.Spec.Restic.EnsureRepository,
+		connectionTest:        source.Spec.Restic.ConnectionTest,
+		parallelism:           source.Spec.Restic.Parallelism,
 		isSource:              isSource,
 		paused:                source.Spec.Paused,
+		readOnlySource:        source.Spec.EnforceReadOnlySource,
 		mainPVCName:           &source.Spec.SourcePVC,
+		sourcePVCNames:        source.Spec.SourcePVCs,
 		customCASpec:          volsyncv1alpha1.CustomCASpec(source.Spec.Restic.CustomCA),
 		privileged:            privileged,
 		pruneInterval:         source.Spec.Restic.PruneIntervalDays,
 		retainPolicy:          source.Spec.Restic.Retain,
 		unlock:                source.Spec.Restic.Unlock,
+		automaticUnlock:       source.Spec.Restic.AutomaticUnlock,
+		changePassword:        source.Spec.Restic.ChangePassword,
+		filesystemQuotas:      source.Spec.Restic.FilesystemQuotas,
+		detectBitRot:          source.Spec.Restic.DetectBitRot,
+		integrityManifest:     source.Spec.Restic.IntegrityManifest,
+		repositoryStats:       source.Spec.Restic.RepositoryStats,
+		tags:                  source.Spec.Restic.Tags,
+		host:                  source.Spec.Restic.Host,
+		repositoryLayout:      source.Spec.Restic.RepositoryLayout,
+		errorPolicy:           source.Spec.ErrorPolicy,
+		cacheCleanupPolicy:    source.Spec.Restic.CacheCleanupPolicy,
+		copyPointSnapshotName: copyPointSnapshotName,
+		keepCopyPointSnapshot: source.Spec.Restic.KeepCopyPointSnapshot,
+		objectLock:            source.Spec.Restic.ObjectLock,
+		conditions:            &source.Status.Conditions,
 		sourceStatus:          source.Status.Restic,
 		latestMoverStatus:     source.Status.LatestMoverStatus,
 		moverConfig:           source.Spec.Restic.MoverConfig,
@@ -167,6 +209,7 @@ func (rb *Builder) FromDestination(client client.Client, logger logr.Logger,
 		volumehandler.WithRecorder(eventRecorder),
 		volumehandler.WithOwner(destination),
 		volumehandler.FromDestination(&destination.Spec.Restic.ReplicationDestinationVolumeOptions),
+		volumehandler.VolumeMode(destination.Spec.Restic.VolumeMode), // Allow setting block mode for dynamic dest PVC
 	)
 	if err != nil {
 		return nil, err
@@ -177,6 +220,11 @@ func (rb *Builder) FromDestination(client client.Client, logger logr.Logger,
 	saHandler := utils.NewSAHandler(client, destination, isSource, privileged,
 		destination.Spec.Restic.MoverServiceAccount)
 
+	containerImage, err := utils.MoverImage(rb.getResticContainerImage(), destination.Spec.Restic.MoverImage)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Mover{
 		client:                      client,
 		logger:                      logger.WithValues("method", "Restic"),
@@ -184,12 +232,17 @@ func (rb *Builder) FromDestination(client client.Client, logger logr.Logger,
 		owner:                       destination,
 		vh:                          vh,
 		saHandler:                   saHandler,
-		containerImage:              rb.getResticContainerImage(),
+		containerImage:              containerImage,
 		cacheAccessModes:            destination.Spec.Restic.CacheAccessModes,
 		cacheCapacity:               destination.Spec.Restic.CacheCapacity,
 		cacheStorageClassName:       destination.Spec.Restic.CacheStorageClassName,
+		cacheType:                   destination.Spec.Restic.CacheType,
 		cleanupCachePVC:             destination.Spec.Restic.CleanupCachePVC,
 		repositoryName:              destination.Spec.Restic.Repository,
+		repositorySecretRef:         destination.Spec.Restic.RepositorySecretRef,
+		ensureRepository:            destination.Spec.Restic.EnsureRepository,
+		connectionTest:              destination.Spec.Restic.ConnectionTest,
+		parallelism:                 destination.Spec.Restic.Parallelism,
 		isSource:                    isSource,
 		paused:                      destination.Spec.Paused,
 		mainPVCName:                 destination.Spec.Restic.DestinationPVC,
@@ -197,8 +250,19 @@ func (rb *Builder) FromDestination(client client.Client, logger logr.Logger,
 		customCASpec:                volsyncv1alpha1.CustomCASpec(destination.Spec.Restic.CustomCA),
 		privileged:                  privileged,
 		restoreAsOf:                 destination.Spec.Restic.RestoreAsOf,
+		snapshotID:                  destination.Spec.Restic.SnapshotID,
 		previous:                    destination.Spec.Restic.Previous,
+		tags:                        destination.Spec.Restic.Tags,
+		host:                        destination.Spec.Restic.Host,
+		repositoryLayout:            destination.Spec.Restic.RepositoryLayout,
 		enableFileDeletionOnRestore: destination.Spec.Restic.EnableFileDeletion,
+		writeProvenance:             destination.Spec.Restic.WriteProvenance,
+		verifyChecksum:              destination.Spec.Restic.VerifyChecksum,
+		atomicRestore:               destination.Spec.Restic.AtomicRestore,
+		skipSizeValidation:          destination.Spec.Restic.SkipSizeValidation,
+		filesystemQuotas:            destination.Spec.Restic.FilesystemQuotas,
+		destinationStatus:           destination.Status,
+		conditions:                  &destination.Status.Conditions,
 		latestMoverStatus:           destination.Status.LatestMoverStatus,
 		moverConfig:                 destination.Spec.Restic.MoverConfig,
 	}, nil
@@ -0,0 +1,49 @@
+//go:build !disable_restic
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package restic
+
+import (
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const cacheUsagePrefix = "VOLSYNC_CACHE_USAGE="
+
+// cacheUsageCollector picks the space used by the restic cache out of the
+// mover logs
+type cacheUsageCollector struct {
+	usage *resource.Quantity
+}
+
+// filter wraps a log line filter, capturing the cache usage while passing
+// everything through to the wrapped filter
+func (c *cacheUsageCollector) filter(next func(string) *string) func(string) *string {
+	return func(line string) *string {
+		if strings.HasPrefix(line, cacheUsagePrefix) {
+			value := strings.TrimSpace(strings.TrimPrefix(line, cacheUsagePrefix))
+			if bytes, err := strconv.ParseInt(value, 10, 64); err == nil {
+				c.usage = resource.NewQuantity(bytes, resource.BinarySI)
+			}
+		}
+		return next(line)
+	}
+}
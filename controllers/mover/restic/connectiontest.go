@@ -0,0 +1,113 @@
+//go:build !disable_restic
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package restic
+
+import (
+	"context"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/backube/volsync/controllers/mover"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ mover.ConnectionTester = &Mover{}
+
+// TestConnection runs the read-only repository check requested by
+// spec.restic.connectionTest in a short Job of its own, recording the result
+// in status.connectionTest
+func (m *Mover) TestConnection(ctx context.Context) (mover.Result, error) {
+	if !utils.ConnectionTestPending(m.owner, m.connectionTest) {
+		return mover.Complete(), nil
+	}
+
+	sa, err := m.saHandler.Reconcile(ctx, m.logger)
+	if sa == nil || err != nil {
+		return mover.InProgress(), err
+	}
+
+	var repo *corev1.Secret
+	if m.repositorySecretRef == nil {
+		repo, err = m.validateRepository(ctx)
+		if repo == nil || err != nil {
+			return mover.InProgress(), err
+		}
+	} else if err := m.validateRepositorySecretRef(); err != nil {
+		return mover.InProgress(), err
+	}
+
+	customCAObj, err := utils.ValidateCustomCA(ctx, m.client, m.logger,
+		m.owner.GetNamespace(), m.customCASpec)
+	if err != nil {
+		return mover.InProgress(), err
+	}
+
+	dir := "src"
+	if !m.isSource {
+		dir = "dst"
+	}
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      mover.VolSyncPrefix + "conntest-" + dir + "-" + m.owner.GetName(),
+			Namespace: m.owner.GetNamespace(),
+		},
+	}
+	logger := m.logger.WithValues("job", client.ObjectKeyFromObject(job))
+
+	// The Job is not marked for cleanup so that it is not removed by the
+	// cleanup of a synchronization that runs at the same time
+	_, err = utils.CreateOrUpdateDeleteOnImmutableErr(ctx, m.client, job, logger, func() error {
+		if err := ctrl.SetControllerReference(m.owner, job, m.client.Scheme()); err != nil {
+			logger.Error(err, utils.ErrUnableToSetControllerRef)
+			return err
+		}
+		utils.SetOwnedByVolSync(job)
+		job.Spec.Template.ObjectMeta.Name = job.Name
+		utils.SetOwnedByVolSync(&job.Spec.Template)
+		// A failed connection is reported right away
+		job.Spec.BackoffLimit = ptr.To[int32](0)
+		parallelism := int32(1)
+		if m.paused {
+			parallelism = int32(0)
+		}
+		job.Spec.Parallelism = &parallelism
+		return m.setRepositoryJobTemplate(ctx, logger, job, sa, repo, customCAObj, "connection-test")
+	})
+	if err != nil {
+		logger.Error(err, "reconcile failed")
+		return mover.InProgress(), err
+	}
+
+	if job.Status.Succeeded == 0 && job.Status.Failed == 0 {
+		return mover.InProgress(), nil
+	}
+	utils.RecordConnectionTest(ctx, m.logger, m.eventRecorder, m.owner, job, m.connectionTest, utils.AllLines,
+		utils.SensitiveSecretValues(repo)...)
+	logger.Info("deleting job -- connection test completed")
+	if err := m.client.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil {
+		return mover.InProgress(), client.IgnoreNotFound(err)
+	}
+	return mover.Complete(), nil
+}
@@ -0,0 +1,60 @@
+//go:build !disable_restic
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package restic
+
+import (
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+const integrityManifestPrefix = "VOLSYNC_INTEGRITY_MANIFEST="
+
+// integrityManifestCollector picks the reference to the integrity manifest
+// that was saved with the backup out of the mover logs
+type integrityManifestCollector struct {
+	manifest *volsyncv1alpha1.ResticIntegrityManifestStatus
+}
+
+// filter wraps a log line filter, capturing the manifest reference while
+// passing everything through to the wrapped filter
+func (i *integrityManifestCollector) filter(next func(string) *string) func(string) *string {
+	return func(line string) *string {
+		if strings.HasPrefix(line, integrityManifestPrefix) {
+			// <backup ID> <manifest ID> <manifest SHA-256> <files>
+			fields := strings.Fields(strings.TrimPrefix(line, integrityManifestPrefix))
+			if len(fields) == 4 {
+				files, _ := strconv.ParseInt(fields[3], 10, 64)
+				i.manifest = &volsyncv1alpha1.ResticIntegrityManifestStatus{
+					SnapshotID:         fields[0],
+					ManifestSnapshotID: fields[1],
+					SHA256:             fields[2],
+					Files:              files,
+					Time:               ptr.To(metav1.Now()),
+				}
+			}
+		}
+		return next(line)
+	}
+}
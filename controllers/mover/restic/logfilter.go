@@ -21,6 +21,9 @@ package restic
 
 import (
 	"regexp"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
 )
 
 var resticRegex = regexp.MustCompile(
@@ -49,3 +52,25 @@ func LogLineFilterSuccess(line string) *string {
 	}
 	return nil
 }
+
+// errorCodePatterns classify the failures of restic
+var errorCodePatterns = []utils.ErrorCodePattern{
+	{
+		Code:  volsyncv1alpha1.MoverErrorCodeNoSpace,
+		Regex: regexp.MustCompile(`does not fit on the destination volume`),
+	},
+	{
+		Code:  volsyncv1alpha1.MoverErrorCodeRepoLocked,
+		Regex: regexp.MustCompile(`(repository is already locked)|(unable to create lock)`),
+	},
+	{
+		Code: volsyncv1alpha1.MoverErrorCodeAuthFailed,
+		Regex: regexp.MustCompile(`(wrong password or no key found)|(InvalidAccessKeyId)|(SignatureDoesNotMatch)|` +
+			`(AccessDenied)|(AuthorizationFailure)|(401 Unauthorized)|(403 Forbidden)`),
+	},
+	{
+		Code: volsyncv1alpha1.MoverErrorCodeRepoNotFound,
+		Regex: regexp.MustCompile(`(Is there a repository at the following location)|(NoSuchBucket)|` +
+			`(repository does not exist)|(unable to open config file)`),
+	},
+}
@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"path"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -48,12 +49,16 @@ import (
 const (
 	resticCacheMountPath = "/cache"
 	mountPath            = "/data"
+	devicePath           = "/dev/block"
 	dataVolumeName       = "data"
 	resticCache          = "cache"
 	resticCAMountPath    = "/customCA"
 	resticCAFilename     = "ca.crt"
 	credentialDir        = "/credentials"
-	gcsCredentialFile    = "gcs.json"
+	// Where credentials from spec.restic.repositorySecretRef are mounted
+	secretStoreMountPath  = "/secrets-store"
+	secretStoreVolumeName = "secrets-store"
+	gcsCredentialFile     = "gcs.json"
 )
 
 // Mover is the reconciliation logic for the Restic-based data mover.
@@ -68,25 +73,55 @@ type Mover struct {
 	cacheAccessModes      []corev1.PersistentVolumeAccessMode
 	cacheCapacity         *resource.Quantity
 	cacheStorageClassName *string
+	cacheType             *volsyncv1alpha1.ResticCacheType
 	repositoryName        string
+	repositorySecretRef   *volsyncv1alpha1.MoverSecretRef
+	repositoryLayout      volsyncv1alpha1.ResticRepositoryLayout
+	renderedRepository    string
+	ensureRepository      bool
+	connectionTest        string
+	parallelism           *int32
 	isSource              bool
 	paused                bool
+	readOnlySource        bool
 	mainPVCName           *string
 	customCASpec          volsyncv1alpha1.CustomCASpec
 	privileged            bool
 	latestMoverStatus     *volsyncv1alpha1.MoverStatus
 	moverConfig           volsyncv1alpha1.MoverConfig
+	filesystemQuotas      bool
+	conditions            *[]metav1.Condition
 	// Source-only fields
-	pruneInterval *int32
-	unlock        string
-	retainPolicy  *volsyncv1alpha1.ResticRetainPolicy
-	sourceStatus  *volsyncv1alpha1.ReplicationSourceResticStatus
+	sourcePVCNames        []string
+	sourceDataPVCs        []*corev1.PersistentVolumeClaim
+	pruneInterval         *int32
+	unlock                string
+	automaticUnlock       *volsyncv1alpha1.ResticAutomaticUnlockSpec
+	changePassword        string
+	detectBitRot          bool
+	integrityManifest     bool
+	repositoryStats       bool
+	errorPolicy           *volsyncv1alpha1.ErrorPolicy
+	cacheCleanupPolicy    *volsyncv1alpha1.ResticCacheCleanupPolicy
+	retainPolicy          *volsyncv1alpha1.ResticRetainPolicy
+	sourceStatus          *volsyncv1alpha1.ReplicationSourceResticStatus
+	copyPointSnapshotName string
+	keepCopyPointSnapshot *int32
+	objectLock            *volsyncv1alpha1.ResticObjectLockSpec
 	// Destination-only fields
 	previous                    *int32
+	tags                        []string
+	host                        *string
 	restoreAsOf                 *string
+	snapshotID                  *string
 	enableFileDeletionOnRestore bool
+	writeProvenance             bool
+	verifyChecksum              bool
+	atomicRestore               bool
+	skipSizeValidation          bool
 	cleanupTempPVC              bool
 	cleanupCachePVC             bool
+	destinationStatus           *volsyncv1alpha1.ReplicationDestinationStatus
 }
 
 var _ mover.Mover = &Mover{}
@@ -102,10 +137,25 @@ var cleanupTypes = []client.Object{
 func (m *Mover) Name() string { return resticMoverName }
 
 func (m *Mover) Synchronize(ctx context.Context) (mover.Result, error) {
+	// Reading and setting project quotas requires privileges
+	if m.filesystemQuotas && !m.privileged {
+		err := errors.New("filesystemQuotas requires a privileged mover")
+		m.logger.Error(err, "unable to synchronize")
+		return mover.InProgress(), err
+	}
+
 	var err error
 	// Allocate temporary data PVC
 	var dataPVC *corev1.PersistentVolumeClaim
-	if m.isSource {
+	if m.isSource && len(m.sourcePVCNames) > 0 {
+		m.sourceDataPVCs, err = m.ensureSourcePVCs(ctx)
+		if m.sourceDataPVCs == nil || err != nil {
+			return mover.InProgress(), err
+		}
+		// The first PVC stands in for the others when creating the cache and
+		// placing the Job
+		dataPVC = m.sourceDataPVCs[0]
+	} else if m.isSource {
 		dataPVC, err = m.ensureSourcePVC(ctx)
 	} else {
 		dataPVC, err = m.ensureDestinationPVC(ctx)
@@ -116,8 +166,13 @@ func (m *Mover) Synchronize(ctx context.Context) (mover.Result, error) {
 
 	// Allocate cache volume
 	// cleanupCachePVC will always be false for replicationsources - it's only set in the builder FromDestination()
-	cachePVC, err := m.ensureCache(ctx, dataPVC, m.cleanupCachePVC)
-	if cachePVC == nil || err != nil {
+	var cachePVC *corev1.PersistentVolumeClaim
+	if m.usesCachePVC() {
+		cachePVC, err = m.ensureCache(ctx, dataPVC, m.cleanupCachePVC)
+		if cachePVC == nil || err != nil {
+			return mover.InProgress(), err
+		}
+	} else if err := m.removeCachePVC(ctx); err != nil {
 		return mover.InProgress(), err
 	}
 
@@ -127,12 +182,26 @@ func (m *Mover) Synchronize(ctx context.Context) (mover.Result, error) {
 		return mover.InProgress(), err
 	}
 
-	// Validate Repository Secret
-	repo, err := m.validateRepository(ctx)
-	if repo == nil || err != nil {
+	// Validate Repository Secret. When the credentials come from a secret
+	// store, they are only available to the mover.
+	var repo *corev1.Secret
+	if m.repositorySecretRef == nil {
+		repo, err = m.validateRepository(ctx)
+		if repo == nil || err != nil {
+			return mover.InProgress(), err
+		}
+	} else if err := m.validateRepositorySecretRef(); err != nil {
+		m.logger.Error(err, "unable to synchronize")
 		return mover.InProgress(), err
 	}
 
+	// Validate the Secret with the new repository password
+	if m.isSource && m.changePassword != "" {
+		if err := m.validateChangePasswordSecret(ctx); err != nil {
+			return mover.InProgress(), err
+		}
+	}
+
 	// Validate custom CA if in spec
 	customCAObj, err := utils.ValidateCustomCA(ctx, m.client, m.logger,
 		m.owner.GetNamespace(), m.customCASpec)
@@ -141,6 +210,18 @@ func (m *Mover) Synchronize(ctx context.Context) (mover.Result, error) {
 		return mover.InProgress(), err
 	}
 
+	// Make sure the repository exists and is accessible before using it
+	if ready, err := m.ensureRepositoryReady(ctx, sa, repo, customCAObj); !ready || err != nil {
+		return mover.InProgress(), err
+	}
+
+	// Check the immutability of the repository before backing up to it
+	if m.isSource {
+		if err := m.ensureObjectLock(ctx, repo, customCAObj); err != nil {
+			return mover.InProgress(), err
+		}
+	}
+
 	// Start mover Job
 	job, err := m.ensureJob(ctx, cachePVC, dataPVC, sa, repo, customCAObj)
 	if job == nil || err != nil {
@@ -156,6 +237,15 @@ func (m *Mover) Synchronize(ctx context.Context) (mover.Result, error) {
 		return mover.CompleteWithImage(image), nil
 	}
 
+	// Preserve the snapshot that was used as the source of the backup
+	if m.copyPointSnapshotName != "" && m.keepCopyPointSnapshot != nil {
+		err = utils.KeepCopyPointSnapshot(ctx, m.client, m.logger, m.owner,
+			m.copyPointSnapshotName, int(*m.keepCopyPointSnapshot))
+		if err != nil {
+			return mover.InProgress(), err
+		}
+	}
+
 	// On the source, just signal completion
 	return mover.Complete(), nil
 }
@@ -215,22 +305,127 @@ func (m *Mover) ensureCache(ctx context.Context,
 	}
 
 	// Allocate cache volume
-	cacheName := mover.VolSyncPrefix + m.owner.GetName() + "-cache"
+	cacheName := m.cachePVCName()
 	m.logger.Info("allocating cache volume", "PVC", cacheName, "isTemporary", isTemporary)
 	return cacheVh.EnsureNewPVC(ctx, m.logger, cacheName, isTemporary)
 }
 
+func (m *Mover) cachePVCName() string {
+	return mover.VolSyncPrefix + m.owner.GetName() + "-cache"
+}
+
+// usesCachePVC returns true if the restic cache is kept in a PVC between
+// synchronizations
+func (m *Mover) usesCachePVC() bool {
+	return m.cacheType == nil || *m.cacheType == volsyncv1alpha1.ResticCacheTypePVC
+}
+
+// removeCachePVC deletes the cache PVC that was used before the cacheType was
+// changed to one that does not need it
+func (m *Mover) removeCachePVC(ctx context.Context) error {
+	pvc := &corev1.PersistentVolumeClaim{}
+	err := m.client.Get(ctx, client.ObjectKey{Name: m.cachePVCName(), Namespace: m.owner.GetNamespace()}, pvc)
+	if err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !metav1.IsControlledBy(pvc, m.owner) || !pvc.DeletionTimestamp.IsZero() {
+		return nil
+	}
+	m.logger.Info("deleting cache volume that is no longer used", "PVC", pvc.Name, "cacheType", *m.cacheType)
+	return client.IgnoreNotFound(m.client.Delete(ctx, pvc))
+}
+
+// cacheVolumeSource returns the volume that holds the restic cache in the
+// mover Pod
+func (m *Mover) cacheVolumeSource(cachePVC *corev1.PersistentVolumeClaim,
+	dataPVC *corev1.PersistentVolumeClaim) corev1.VolumeSource {
+	if m.usesCachePVC() {
+		return corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: cachePVC.Name,
+			},
+		}
+	}
+
+	cacheCapacity := resource.MustParse("1Gi")
+	if m.cacheCapacity != nil {
+		cacheCapacity = *m.cacheCapacity
+	}
+	if *m.cacheType == volsyncv1alpha1.ResticCacheTypeEmptyDir {
+		return corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{SizeLimit: &cacheCapacity},
+		}
+	}
+
+	// Same defaults as for the cache PVC
+	accessModes := m.cacheAccessModes
+	if accessModes == nil {
+		accessModes = m.vh.GetAccessModes()
+	}
+	if len(accessModes) == 0 {
+		accessModes = dataPVC.Spec.AccessModes
+	}
+	storageClassName := m.cacheStorageClassName
+	if storageClassName == nil {
+		storageClassName = m.vh.GetStorageClassName()
+	}
+	return corev1.VolumeSource{
+		Ephemeral: &corev1.EphemeralVolumeSource{
+			VolumeClaimTemplate: &corev1.PersistentVolumeClaimTemplate{
+				Spec: corev1.PersistentVolumeClaimSpec{
+					AccessModes:      accessModes,
+					StorageClassName: storageClassName,
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceStorage: cacheCapacity},
+					},
+				},
+			},
+		},
+	}
+}
+
 func (m *Mover) ensureSourcePVC(ctx context.Context) (*corev1.PersistentVolumeClaim, error) {
+	return m.ensureSourceCopy(ctx, *m.mainPVCName, mover.VolSyncPrefix+m.owner.GetName()+"-src")
+}
+
+// ensureSourcePVCs ensures the point-in-time copies of all of the sourcePVCs.
+// It returns nil until all of them are ready.
+func (m *Mover) ensureSourcePVCs(ctx context.Context) ([]*corev1.PersistentVolumeClaim, error) {
+	if m.copyPointSnapshotName != "" {
+		err := errors.New("keepCopyPointSnapshot is not supported with sourcePVCs")
+		m.logger.Error(err, "unable to synchronize")
+		return nil, err
+	}
+	pvcs := make([]*corev1.PersistentVolumeClaim, 0, len(m.sourcePVCNames))
+	for _, name := range m.sourcePVCNames {
+		pvc, err := m.ensureSourceCopy(ctx, name, mover.VolSyncPrefix+m.owner.GetName()+"-src-"+name)
+		if pvc == nil || err != nil {
+			return nil, err
+		}
+		if utils.PvcIsBlockMode(pvc) {
+			err = fmt.Errorf("PVC %s has volumeMode: %s, which is not supported with sourcePVCs",
+				name, corev1.PersistentVolumeBlock)
+			m.logger.Error(err, "unable to synchronize")
+			return nil, err
+		}
+		pvcs = append(pvcs, pvc)
+	}
+	return pvcs, nil
+}
+
+// ensureSourceCopy ensures the point-in-time copy (dataName) of the source
+// PVC srcName
+func (m *Mover) ensureSourceCopy(ctx context.Context, srcName string,
+	dataName string) (*corev1.PersistentVolumeClaim, error) {
 	srcPVC := &corev1.PersistentVolumeClaim{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      *m.mainPVCName,
+			Name:      srcName,
 			Namespace: m.owner.GetNamespace(),
 		},
 	}
 	if err := m.client.Get(ctx, client.ObjectKeyFromObject(srcPVC), srcPVC); err != nil {
 		return nil, err
 	}
-	dataName := mover.VolSyncPrefix + m.owner.GetName() + "-src"
 	pvc, err := m.vh.EnsurePVCFromSrc(ctx, m.logger, srcPVC, dataName, true)
 	if err != nil {
 		// If the error was a copy TriggerTimeoutError, update the latestMoverStatus to indicate error
@@ -276,9 +471,50 @@ func (m *Mover) validateRepository(ctx context.Context) (*corev1.Secret, error)
 		logger.Error(err, "Restic config secret does not contain the proper fields")
 		return nil, err
 	}
+
+	// A templated repository is rendered for this mover. The rendered
+	// repository replaces the template in the (in-memory) Secret so that the
+	// features reading it in the operator see the actual repository.
+	m.renderedRepository = ""
+	if repository := string(secret.Data["RESTIC_REPOSITORY"]); isRepositoryTemplate(repository) {
+		rendered, err := m.renderRepository(repository)
+		if err != nil {
+			logger.Error(err, "unable to render the restic repository")
+			return nil, err
+		}
+		m.renderedRepository = rendered
+		secret.Data["RESTIC_REPOSITORY"] = []byte(rendered)
+	}
 	return secret, nil
 }
 
+// Features that need the repository credentials in the operator can't be used
+// when the credentials are only mounted into the mover
+func (m *Mover) validateRepositorySecretRef() error {
+	if m.isSource && m.objectLock != nil {
+		return errors.New("objectLock can not be used with repositorySecretRef")
+	}
+	if m.isSource && m.changePassword != "" {
+		return errors.New("changePassword can not be used with repositorySecretRef")
+	}
+	return nil
+}
+
+func (m *Mover) validateChangePasswordSecret(ctx context.Context) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      m.changePassword,
+			Namespace: m.owner.GetNamespace(),
+		},
+	}
+	logger := m.logger.WithValues("changePasswordSecret", client.ObjectKeyFromObject(secret))
+	if err := utils.GetAndValidateSecret(ctx, m.client, logger, secret, "NEW_PASSWORD"); err != nil {
+		logger.Error(err, "Secret with the new repository password does not contain the proper fields")
+		return err
+	}
+	return nil
+}
+
 //nolint:funlen
 func (m *Mover) ensureJob(ctx context.Context, cachePVC *corev1.PersistentVolumeClaim,
 	dataPVC *corev1.PersistentVolumeClaim, sa *corev1.ServiceAccount, repo *corev1.Secret,
@@ -296,6 +532,13 @@ func (m *Mover) ensureJob(ctx context.Context, cachePVC *corev1.PersistentVolume
 	}
 	logger := m.logger.WithValues("job", client.ObjectKeyFromObject(job))
 
+	// Stop a Job that has been running for too long and wait before starting
+	// it over
+	if wait, err := utils.EnforceMoverTimeout(ctx, m.client, logger, m.eventRecorder, m.owner, job,
+		m.moverConfig.MoverTimeout, m.latestMoverStatus); wait || err != nil {
+		return nil, err
+	}
+
 	_, err := utils.CreateOrUpdateDeleteOnImmutableErr(ctx, m.client, job, logger, func() error {
 		if err := ctrl.SetControllerReference(m.owner, job, m.client.Scheme()); err != nil {
 			logger.Error(err, utils.ErrUnableToSetControllerRef)
@@ -315,27 +558,81 @@ func (m *Mover) ensureJob(ctx context.Context, cachePVC *corev1.PersistentVolume
 		forgetOptions := generateForgetOptions(m.retainPolicy)
 		// set default values
 		var restoreAsOf = ""
+		var snapshotID = ""
 		var previous = strconv.Itoa(int(int32(0)))
 		var restoreOptions = ""
+		var volsyncSource = ""
+		var writeProvenance = "0"
+		var verifyChecksum = "0"
+		var atomicRestore = "0"
+		var validateRestoreSize = "0"
+		var detectBitRot = "0"
+		if m.detectBitRot {
+			detectBitRot = "1"
+		}
+		var filesystemQuotas = "0"
+		if m.filesystemQuotas {
+			filesystemQuotas = "1"
+		}
+		var integrityManifest = "0"
+		if m.integrityManifest {
+			integrityManifest = "1"
+		}
+		var repositoryStats = "0"
+		if m.repositoryStats {
+			repositoryStats = "1"
+		}
+		var cacheMaxAgeDays = ""
+		var cacheMaxSize = ""
+		if m.isSource && m.cacheCleanupPolicy != nil {
+			if m.cacheCleanupPolicy.MaxAgeDays != nil {
+				cacheMaxAgeDays = strconv.Itoa(int(*m.cacheCleanupPolicy.MaxAgeDays))
+			}
+			if m.cacheCleanupPolicy.MaxSize != nil {
+				cacheMaxSize = strconv.FormatInt(m.cacheCleanupPolicy.MaxSize.Value(), 10)
+			}
+		}
 
 		readOnlyVolume := false
 		var actions []string
 		if m.isSource {
-			actions = []string{"backup"}
-
 			if m.shouldUnlock() {
 				// Run restic unlock before backup
-				actions = []string{"unlock", "backup"}
+				actions = append(actions, "unlock")
 			}
 
+			if m.shouldChangePassword() {
+				// Change the password before the backup so that the backup
+				// is done using the new password
+				actions = append(actions, "change-password")
+			}
+
+			actions = append(actions, "backup")
+
 			if m.shouldPrune(time.Now()) {
 				actions = append(actions, "prune")
 			}
 
-			// Set read-only for volume in source mover job spec if the PVC only supports read-only
-			readOnlyVolume = utils.PvcIsReadOnly(dataPVC)
+			// Set read-only for volume in source mover job spec if the PVC only supports read-only or
+			// the spec requires the source to be read-only
+			readOnlyVolume = m.readOnlySource || utils.PvcIsReadOnly(dataPVC)
+
+			// Backups are tagged w/ the source so restores can report their provenance
+			volsyncSource = client.ObjectKeyFromObject(m.owner).String()
 		} else {
 			actions = []string{"restore"}
+			if m.writeProvenance {
+				writeProvenance = "1"
+			}
+			if m.verifyChecksum {
+				verifyChecksum = "1"
+			}
+			if m.atomicRestore {
+				atomicRestore = "1"
+			}
+			if !m.skipSizeValidation {
+				validateRestoreSize = "1"
+			}
 			// set the restore selection options when the mover has them
 			if m.restoreAsOf != nil {
 				restoreAsOf = *m.restoreAsOf
@@ -343,6 +640,9 @@ func (m *Mover) ensureJob(ctx context.Context, cachePVC *corev1.PersistentVolume
 			if m.previous != nil {
 				previous = strconv.Itoa(int(*m.previous))
 			}
+			if m.snapshotID != nil {
+				snapshotID = *m.snapshotID
+			}
 
 			// Delete option for restores, default is false (mover.enableFileDeletionOnRestore is only set in the builder
 			// for replicationdestinations)
@@ -350,6 +650,18 @@ func (m *Mover) ensureJob(ctx context.Context, cachePVC *corev1.PersistentVolume
 				restoreOptions = "--delete"
 			}
 		}
+		// Block volumes are backed up as a single image of the device, so the
+		// file-based features do not apply
+		blockVolume := utils.PvcIsBlockMode(dataPVC)
+		if blockVolume {
+			detectBitRot = "0"
+			filesystemQuotas = "0"
+			writeProvenance = "0"
+			verifyChecksum = "0"
+			atomicRestore = "0"
+			validateRestoreSize = "0"
+			restoreOptions = ""
+		}
 		logger.Info("job actions", "actions", actions)
 		podSpec := &job.Spec.Template.Spec
 
@@ -359,70 +671,53 @@ func (m *Mover) ensureJob(ctx context.Context, cachePVC *corev1.PersistentVolume
 			{Name: "RESTIC_CACHE_DIR", Value: resticCacheMountPath},
 			{Name: "RESTORE_AS_OF", Value: restoreAsOf},
 			{Name: "SELECT_PREVIOUS", Value: previous},
+			{Name: "RESTORE_SNAPSHOT_ID", Value: snapshotID},
 			{Name: "RESTORE_OPTIONS", Value: restoreOptions},
-			// We populate environment variables from the restic repo
-			// Secret. They are taken 1-for-1 from the Secret into env vars.
-			// The allowed variables are defined by restic.
-			// https://restic.readthedocs.io/en/stable/040_backup.html#environment-variables
-			// Mandatory variables are needed to define the repository
-			// location and its password.
-			utils.EnvFromSecret(repo.Name, "RESTIC_REPOSITORY", false),
-			utils.EnvFromSecret(repo.Name, "RESTIC_PASSWORD", false),
-
-			// Optional variables
-			utils.EnvFromSecret(repo.Name, "RESTIC_COMPRESSION", true), // New in v0.14.0
-			utils.EnvFromSecret(repo.Name, "RESTIC_PACK_SIZE", true),   // New in v0.14.0
-
-			utils.EnvFromSecret(repo.Name, "RESTIC_READ_CONCURRENCY", true), // New in v0.15.0
-
-			// Optional variables based on what backend is used for restic
-			utils.EnvFromSecret(repo.Name, "AWS_ACCESS_KEY_ID", true),
-			utils.EnvFromSecret(repo.Name, "AWS_SECRET_ACCESS_KEY", true),
-			utils.EnvFromSecret(repo.Name, "AWS_SESSION_TOKEN", true), // New in v0.14.0
-			utils.EnvFromSecret(repo.Name, "AWS_DEFAULT_REGION", true),
-			utils.EnvFromSecret(repo.Name, "AWS_PROFILE", true),
-			// AWS_SHARED_CREDENTIALS_FILE <- not implementing
-			utils.EnvFromSecret(repo.Name, "RESTIC_AWS_ASSUME_ROLE_ARN", true),          // New in v0.17.0
-			utils.EnvFromSecret(repo.Name, "RESTIC_AWS_ASSUME_ROLE_SESSION_NAME", true), // New in v0.17.0
-			utils.EnvFromSecret(repo.Name, "RESTIC_AWS_ASSUME_ROLE_EXTERNAL_ID", true),  // New in v0.17.0
-			utils.EnvFromSecret(repo.Name, "RESTIC_AWS_ASSUME_ROLE_POLICY", true),       // New in v0.17.0
-			utils.EnvFromSecret(repo.Name, "RESTIC_AWS_ASSUME_ROLE_REGION", true),       // New in v0.17.0
-			utils.EnvFromSecret(repo.Name, "RESTIC_AWS_ASSUME_ROLE_STS_ENDPOINT", true), // New in v0.17.0
-			utils.EnvFromSecret(repo.Name, "ST_AUTH", true),
-			utils.EnvFromSecret(repo.Name, "ST_USER", true),
-			utils.EnvFromSecret(repo.Name, "ST_KEY", true),
-			utils.EnvFromSecret(repo.Name, "OS_AUTH_URL", true),
-			utils.EnvFromSecret(repo.Name, "OS_REGION_NAME", true),
-			utils.EnvFromSecret(repo.Name, "OS_USERNAME", true),
-			utils.EnvFromSecret(repo.Name, "OS_USER_ID", true),
-			utils.EnvFromSecret(repo.Name, "OS_PASSWORD", true),
-			utils.EnvFromSecret(repo.Name, "OS_TENANT_ID", true),
-			utils.EnvFromSecret(repo.Name, "OS_TENANT_NAME", true),
-			utils.EnvFromSecret(repo.Name, "OS_USER_DOMAIN_NAME", true),
-			utils.EnvFromSecret(repo.Name, "OS_USER_DOMAIN_ID", true),
-			utils.EnvFromSecret(repo.Name, "OS_PROJECT_NAME", true),
-			utils.EnvFromSecret(repo.Name, "OS_PROJECT_DOMAIN_NAME", true),
-			utils.EnvFromSecret(repo.Name, "OS_PROJECT_DOMAIN_ID", true),
-			utils.EnvFromSecret(repo.Name, "OS_TRUST_ID", true),
-			utils.EnvFromSecret(repo.Name, "OS_APPLICATION_CREDENTIAL_ID", true),
-			utils.EnvFromSecret(repo.Name, "OS_APPLICATION_CREDENTIAL_NAME", true),
-			utils.EnvFromSecret(repo.Name, "OS_APPLICATION_CREDENTIAL_SECRET", true),
-			utils.EnvFromSecret(repo.Name, "OS_STORAGE_URL", true),
-			utils.EnvFromSecret(repo.Name, "OS_AUTH_TOKEN", true),
-			utils.EnvFromSecret(repo.Name, "B2_ACCOUNT_ID", true),
-			utils.EnvFromSecret(repo.Name, "B2_ACCOUNT_KEY", true),
-			utils.EnvFromSecret(repo.Name, "AZURE_ACCOUNT_NAME", true),
-			utils.EnvFromSecret(repo.Name, "AZURE_ACCOUNT_KEY", true),
-			utils.EnvFromSecret(repo.Name, "AZURE_ACCOUNT_SAS", true),     // New in v0.14.0
-			utils.EnvFromSecret(repo.Name, "AZURE_ENDPOINT_SUFFIX", true), // New in v0.16.0
-			// AZURE_FORCE_CLI_CREDENTIAL <- not implementing, requires azure cli or local credentials stored from cli?
-			utils.EnvFromSecret(repo.Name, "GOOGLE_PROJECT_ID", true),
-			utils.EnvFromSecret(repo.Name, "RESTIC_REST_USERNAME", true), // New in v0.16.1
-			utils.EnvFromSecret(repo.Name, "RESTIC_REST_PASSWORD", true), // New in v0.16.1
+			{Name: "VOLSYNC_SOURCE", Value: volsyncSource},
+			{Name: "WRITE_PROVENANCE", Value: writeProvenance},
+			{Name: "VERIFY_CHECKSUM", Value: verifyChecksum},
+			{Name: "ATOMIC_RESTORE", Value: atomicRestore},
+			{Name: "VALIDATE_RESTORE_SIZE", Value: validateRestoreSize},
+			{Name: "FILESYSTEM_QUOTAS", Value: filesystemQuotas},
+			{Name: "DETECT_BITROT", Value: detectBitRot},
+			{Name: "INTEGRITY_MANIFEST", Value: integrityManifest},
+			{Name: "REPOSITORY_STATS", Value: repositoryStats},
+			{Name: "CACHE_MAX_AGE_DAYS", Value: cacheMaxAgeDays},
+			{Name: "CACHE_MAX_SIZE", Value: cacheMaxSize},
+		}
+		if m.parallelism != nil {
+			envVars = append(envVars, corev1.EnvVar{Name: "PARALLELISM", Value: strconv.Itoa(int(*m.parallelism))})
+		}
+		if blockVolume {
+			envVars = append(envVars, corev1.EnvVar{Name: "BLOCK_DEVICE", Value: devicePath})
+		}
+		snapshotTags, snapshotHost, err := renderSnapshotSelectors(m.owner, m.selectorPVCName(),
+			m.tags, m.host)
+		if err != nil {
+			logger.Error(err, "unable to synchronize")
+			return err
+		}
+		envVars = append(envVars,
+			corev1.EnvVar{Name: "SNAPSHOT_TAGS", Value: snapshotTags},
+			corev1.EnvVar{Name: "SNAPSHOT_HOST", Value: snapshotHost})
+		if m.isSource {
+			envVars = append(envVars, utils.ErrorPolicyEnvVars(m.errorPolicy)...)
+			envVars = append(envVars, corev1.EnvVar{
+				Name: "AUTOMATIC_UNLOCK_AGE", Value: staleLockAgeSeconds(m.automaticUnlock)})
 		}
 
-		// Rclone env vars for restic if they are in the secret
-		envVars = utils.AppendRCloneEnvVars(repo, envVars)
+		envVars = append(envVars, m.repositoryCredentialEnvVars(repo)...)
+
+		if m.shouldChangePassword() {
+			envVars = append(envVars, utils.EnvFromSecret(m.changePassword, "NEW_PASSWORD", false))
+		}
+
+		// Restores made for the volume populator identify themselves as such.
+		// Restic records the hostname in the locks it takes on the repository.
+		populatorIdentity := m.owner.GetAnnotations()[utils.PopulatorIdentityAnnotation]
+		if !m.isSource && populatorIdentity != "" {
+			envVars = append(envVars, corev1.EnvVar{Name: "VOLSYNC_IDENTITY", Value: populatorIdentity})
+		}
 
 		// Cluster-wide proxy settings
 		envVars = utils.AppendEnvVarsForClusterWideProxy(envVars)
@@ -445,31 +740,57 @@ func (m *Mover) ensureJob(ctx context.Context, cachePVC *corev1.PersistentVolume
 				ReadOnlyRootFilesystem: ptr.To(true),
 			},
 			VolumeMounts: []corev1.VolumeMount{
-				{Name: dataVolumeName, MountPath: mountPath},
 				{Name: resticCache, MountPath: resticCacheMountPath},
 				{Name: "tempdir", MountPath: "/tmp"},
 			},
 		}}
-		podSpec.RestartPolicy = corev1.RestartPolicyNever
-		podSpec.ServiceAccountName = sa.Name
-		podSpec.Volumes = []corev1.Volume{
+		dataVolumes := []corev1.Volume{
 			{Name: dataVolumeName, VolumeSource: corev1.VolumeSource{
 				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
 					ClaimName: dataPVC.Name,
 					ReadOnly:  readOnlyVolume,
 				}},
 			},
-			{Name: resticCache, VolumeSource: corev1.VolumeSource{
-				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
-					ClaimName: cachePVC.Name,
-				}},
-			},
+		}
+		switch {
+		case len(m.sourceDataPVCs) > 0:
+			// Each of the sourcePVCs gets its own directory under the mountPath
+			dataVolumes = nil
+			var dataMounts []corev1.VolumeMount
+			for i, pvc := range m.sourceDataPVCs {
+				name := fmt.Sprintf("%s-%d", dataVolumeName, i)
+				dataVolumes = append(dataVolumes, corev1.Volume{Name: name, VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: pvc.Name,
+						ReadOnly:  m.readOnlySource || utils.PvcIsReadOnly(pvc),
+					}},
+				})
+				dataMounts = append(dataMounts, corev1.VolumeMount{
+					Name: name, MountPath: path.Join(mountPath, m.sourcePVCNames[i])})
+			}
+			podSpec.Containers[0].VolumeMounts = append(dataMounts, podSpec.Containers[0].VolumeMounts...)
+		case blockVolume:
+			podSpec.Containers[0].VolumeDevices = []corev1.VolumeDevice{
+				{Name: dataVolumeName, DevicePath: devicePath},
+			}
+		default:
+			podSpec.Containers[0].VolumeMounts = append([]corev1.VolumeMount{
+				{Name: dataVolumeName, MountPath: mountPath},
+			}, podSpec.Containers[0].VolumeMounts...)
+		}
+		podSpec.RestartPolicy = corev1.RestartPolicyNever
+		podSpec.ServiceAccountName = sa.Name
+		if !m.isSource && populatorIdentity != "" {
+			podSpec.Hostname = strings.ReplaceAll(populatorIdentity, "/", "-")
+		}
+		podSpec.Volumes = append(dataVolumes, []corev1.Volume{
+			{Name: resticCache, VolumeSource: m.cacheVolumeSource(cachePVC, dataPVC)},
 			{Name: "tempdir", VolumeSource: corev1.VolumeSource{
 				EmptyDir: &corev1.EmptyDirVolumeSource{
 					Medium: corev1.StorageMediumMemory,
 				}},
 			},
-		}
+		}...)
 		if m.vh.IsCopyMethodDirect() {
 			affinity, err := utils.AffinityFromVolume(ctx, m.client, logger, dataPVC)
 			if err != nil {
@@ -479,58 +800,24 @@ func (m *Mover) ensureJob(ctx context.Context, cachePVC *corev1.PersistentVolume
 			podSpec.NodeSelector = affinity.NodeSelector
 			podSpec.Tolerations = affinity.Tolerations
 		}
-		if customCAObj != nil {
-			// Tell mover where to find the cert
-			podSpec.Containers[0].Env = append(podSpec.Containers[0].Env, corev1.EnvVar{
-				Name:  "CUSTOM_CA",
-				Value: path.Join(resticCAMountPath, resticCAFilename),
-			})
-			// Mount the custom CA certificate
-			podSpec.Containers[0].VolumeMounts =
-				append(podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{
-					Name:      "custom-ca",
-					MountPath: resticCAMountPath,
-				})
-			podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
-				Name:         "custom-ca",
-				VolumeSource: customCAObj.GetVolumeSource(resticCAFilename),
-			})
-		}
-		// We handle GOOGLE_APPLICATION_CREDENTIALS specially...
-		// restic expects it to be an env var pointing to a file w/ the
-		// credentials, but we have users provide the actual file data in the
-		// Secret under that key name. The following code sets the env var to be
-		// what restic expects, then mounts just that Secret key into the
-		// container, pointed to by the env var.
-		if _, ok := repo.Data["GOOGLE_APPLICATION_CREDENTIALS"]; ok {
-			container := &podSpec.Containers[0]
-			// Tell restic where to look for the credential file
-			container.Env = append(container.Env, corev1.EnvVar{
-				Name:  "GOOGLE_APPLICATION_CREDENTIALS",
-				Value: path.Join(credentialDir, gcsCredentialFile),
-			})
-			// Mount the credential file
-			container.VolumeMounts =
-				append(container.VolumeMounts, corev1.VolumeMount{
-					Name:      "gcs-credentials",
-					MountPath: credentialDir,
-				})
-			podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
-				Name: "gcs-credentials",
-				VolumeSource: corev1.VolumeSource{
-					Secret: &corev1.SecretVolumeSource{
-						SecretName: repo.Name,
-						Items: []corev1.KeyToPath{
-							{Key: "GOOGLE_APPLICATION_CREDENTIALS", Path: gcsCredentialFile},
-						},
-					},
-				},
-			})
-		}
+		m.mountRepositoryCredentials(podSpec, repo, customCAObj)
 
 		// Update the job securityContext, podLabels and resourceRequirements from moverConfig (if specified)
 		utils.UpdatePodTemplateSpecFromMoverConfig(&job.Spec.Template, m.moverConfig, corev1.ResourceRequirements{})
 
+		// Credentials that are not in the repository Secret may come from the
+		// workload identity of the ServiceAccount
+		utils.ApplyWorkloadIdentity(&job.Spec.Template, sa)
+
+		// Keep the mover off of nodes it cannot run on
+		if err := utils.SetMoverNodeAffinity(ctx, m.client, logger, &job.Spec.Template); err != nil {
+			return err
+		}
+		if err := utils.SetMoverApplicationAffinity(ctx, m.client, logger, &job.Spec.Template,
+			m.moverConfig.MoverApplicationAffinity, m.owner.GetNamespace(), m.mainPVCName); err != nil {
+			return err
+		}
+
 		if m.privileged {
 			podSpec.Containers[0].Env = append(podSpec.Containers[0].Env, corev1.EnvVar{
 				Name:  "PRIVILEGED_MOVER",
@@ -548,19 +835,56 @@ func (m *Mover) ensureJob(ctx context.Context, cachePVC *corev1.PersistentVolume
 				Value: "0",
 			})
 		}
+
+		// Enforce the security profile last so that it is not overridden
+		utils.ApplySecurityProfile(&job.Spec.Template, m.owner)
 		return nil
 	})
+	if err == nil {
+		utils.SetJobRunningCondition(m.eventRecorder, m.owner, job)
+		err = utils.EnsureEvictionProtection(ctx, m.client, logger, job, m.moverConfig.ProtectFromEviction)
+	}
 	// If Job had failed, delete it so it can be recreated
 	if job.Status.Failed >= *job.Spec.BackoffLimit {
 		// Update status with mover logs from failed job
+		bitRot := &bitRotCollector{}
+		verification := &utils.VerificationCollector{}
+		partial := &utils.PartialCompletionCollector{}
+		autoUnlock := &automaticUnlockCollector{}
+		restoreSize := &restoreSizeCollector{}
+		errorCode := &utils.ErrorCodeCollector{Patterns: errorCodePatterns}
 		utils.UpdateMoverStatusForFailedJob(ctx, m.logger, m.latestMoverStatus, job.GetName(), job.GetNamespace(),
-			utils.AllLines)
+			errorCode.Filter(bitRot.filter(verification.Filter(partial.Filter(restoreSize.filter(
+				autoUnlock.filter(utils.AllLines)))))), utils.SensitiveSecretValues(repo)...)
+		partial.Apply(m.latestMoverStatus)
+		errorCode.Apply(m.latestMoverStatus)
+		if m.isSource {
+			m.recordAutomaticUnlock(autoUnlock)
+		}
+		if m.isSource && m.detectBitRot {
+			m.updateSuspectedCorruption(bitRot)
+		}
 
 		logger.Info("deleting job -- backoff limit reached")
+		mover.RecordJobFinished(resticMoverName, job, mover.JobResultFailed)
 		err = m.client.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground))
+		if err == nil && !m.isSource && verification.Failed() {
+			// Surface the mismatch in the Synchronizing condition
+			m.destinationStatus.Verification = verification.Result()
+			err = fmt.Errorf("restored data failed verification: %s", m.destinationStatus.Verification.Message)
+		}
+		if err == nil && !m.isSource {
+			if size := restoreSize.result(); size != nil && !size.Fits {
+				// Surface the missing space in the Synchronizing condition
+				m.destinationStatus.RestoreSize = size
+				err = fmt.Errorf("snapshot %s needs %d bytes, but only %d bytes are available on the destination volume",
+					size.SnapshotID, size.SizeBytes, size.CapacityBytes)
+			}
+		}
 		return nil, err
 	}
 	if err != nil {
+		mover.RecordJobError(resticMoverName, err)
 		logger.Error(err, "reconcile failed")
 		return nil, err
 	}
@@ -571,6 +895,7 @@ func (m *Mover) ensureJob(ctx context.Context, cachePVC *corev1.PersistentVolume
 	}
 
 	logger.Info("job completed")
+	mover.RecordJobFinished(resticMoverName, job, mover.JobResultSucceeded)
 
 	if m.isSource {
 		if m.shouldUnlock() {
@@ -582,6 +907,18 @@ func (m *Mover) ensureJob(ctx context.Context, cachePVC *corev1.PersistentVolume
 			m.sourceStatus.LastUnlocked = ""
 		}
 
+		if m.shouldChangePassword() {
+			m.sourceStatus.LastPasswordChange = &volsyncv1alpha1.ResticPasswordChangeStatus{
+				SecretName: m.changePassword,
+				Time:       ptr.To(metav1.Now()),
+			}
+			logger.Info("password change completed", ".Status.Restic.LastPasswordChange.SecretName",
+				m.changePassword)
+		} else if m.changePassword == "" {
+			// Unset lastPasswordChange in status if changePassword is no longer set in the spec
+			m.sourceStatus.LastPasswordChange = nil
+		}
+
 		if m.shouldPrune(time.Now()) {
 			now := metav1.Now()
 			m.sourceStatus.LastPruned = &now
@@ -590,13 +927,132 @@ func (m *Mover) ensureJob(ctx context.Context, cachePVC *corev1.PersistentVolume
 	}
 
 	// update status with mover logs from successful job
+	provenance := &provenanceCollector{}
+	cacheUsage := &cacheUsageCollector{}
+	verification := &utils.VerificationCollector{}
+	partial := &utils.PartialCompletionCollector{}
+	volumeUsage := &utils.VolumeUsageCollector{}
+	autoUnlock := &automaticUnlockCollector{}
+	integrityManifest := &integrityManifestCollector{}
+	restoreSize := &restoreSizeCollector{}
+	repositoryStats := &repositoryStatsCollector{}
 	utils.UpdateMoverStatusForSuccessfulJob(ctx, m.logger, m.latestMoverStatus, job.GetName(), job.GetNamespace(),
-		LogLineFilterSuccess)
+		repositoryStats.filter(integrityManifest.filter(cacheUsage.filter(provenance.filter(verification.Filter(
+			partial.Filter(volumeUsage.Filter(restoreSize.filter(autoUnlock.filter(LogLineFilterSuccess))))))))),
+		utils.SensitiveSecretValues(repo)...)
+	partial.Apply(m.latestMoverStatus)
+
+	if m.isSource {
+		m.recordAutomaticUnlock(autoUnlock)
+		// No corruption was found if the backup was made
+		m.updateSuspectedCorruption(&bitRotCollector{})
+		if cacheUsage.usage != nil {
+			m.sourceStatus.CacheUsage = cacheUsage.usage
+		}
+		if integrityManifest.manifest != nil {
+			m.sourceStatus.LastIntegrityManifest = integrityManifest.manifest
+		} else if !m.integrityManifest {
+			m.sourceStatus.LastIntegrityManifest = nil
+		}
+		if repositoryStats.stats != nil {
+			m.sourceStatus.RepositoryStats = repositoryStats.stats
+		} else if !m.repositoryStats {
+			m.sourceStatus.RepositoryStats = nil
+		}
+	}
+
+	if !m.isSource && m.destinationStatus != nil {
+		// Nothing was restored if there is no provenance, so the provenance
+		// of a previous restore no longer describes the data
+		m.destinationStatus.Provenance = provenance.result(logger)
+		m.destinationStatus.Verification = verification.Result()
+		m.destinationStatus.RestoreSize = restoreSize.result()
+		volumeUsage.Record(m.destinationStatus.CapacityForecast, dataPVC.Name)
+	}
 
 	// We only continue reconciling if the restic job has completed
 	return job, nil
 }
 
+// repositoryCredentialEnvVars returns the environment variables that give the
+// mover access to the repository
+func (m *Mover) repositoryCredentialEnvVars(repo *corev1.Secret) []corev1.EnvVar {
+	var envVars []corev1.EnvVar
+	if repo == nil {
+		// The credentials are files in the secret store volume
+		envVars = []corev1.EnvVar{{Name: "CREDENTIALS_DIR", Value: secretStoreMountPath}}
+	} else {
+		envVars = m.repositoryEnvVars(repo)
+		// Rclone env vars for restic if they are in the secret
+		envVars = utils.AppendRCloneEnvVars(repo, envVars)
+	}
+	if m.repositoryLayout == volsyncv1alpha1.ResticRepositoryLayoutVelero {
+		// Velero keeps one repository per namespace below the location
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  "RESTIC_REPOSITORY_SUBPATH",
+			Value: path.Join("restic", m.owner.GetNamespace()),
+		})
+	}
+	return envVars
+}
+
+// mountRepositoryCredentials adds the custom CA and the credential files of
+// the repository to the (first) container of the mover
+func (m *Mover) mountRepositoryCredentials(podSpec *corev1.PodSpec, repo *corev1.Secret,
+	customCAObj utils.CustomCAObject) {
+	if customCAObj != nil {
+		// Tell mover where to find the cert
+		podSpec.Containers[0].Env = append(podSpec.Containers[0].Env, corev1.EnvVar{
+			Name:  "CUSTOM_CA",
+			Value: path.Join(resticCAMountPath, resticCAFilename),
+		})
+		// Mount the custom CA certificate
+		podSpec.Containers[0].VolumeMounts =
+			append(podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{
+				Name:      "custom-ca",
+				MountPath: resticCAMountPath,
+			})
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name:         "custom-ca",
+			VolumeSource: customCAObj.GetVolumeSource(resticCAFilename),
+		})
+	}
+	// We handle GOOGLE_APPLICATION_CREDENTIALS specially...
+	// restic expects it to be an env var pointing to a file w/ the
+	// credentials, but we have users provide the actual file data in the
+	// Secret under that key name. The following code sets the env var to be
+	// what restic expects, then mounts just that Secret key into the
+	// container, pointed to by the env var.
+	if repo == nil {
+		utils.AddSecretStoreVolume(podSpec, &podSpec.Containers[0], m.repositorySecretRef,
+			secretStoreVolumeName, secretStoreMountPath)
+	} else if _, ok := repo.Data["GOOGLE_APPLICATION_CREDENTIALS"]; ok {
+		container := &podSpec.Containers[0]
+		// Tell restic where to look for the credential file
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  "GOOGLE_APPLICATION_CREDENTIALS",
+			Value: path.Join(credentialDir, gcsCredentialFile),
+		})
+		// Mount the credential file
+		container.VolumeMounts =
+			append(container.VolumeMounts, corev1.VolumeMount{
+				Name:      "gcs-credentials",
+				MountPath: credentialDir,
+			})
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name: "gcs-credentials",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: repo.Name,
+					Items: []corev1.KeyToPath{
+						{Key: "GOOGLE_APPLICATION_CREDENTIALS", Path: gcsCredentialFile},
+					},
+				},
+			},
+		})
+	}
+}
+
 func (m *Mover) shouldPrune(current time.Time) bool {
 	delta := time.Hour * 24 * 7 // default prune every 7 days
 	if m.pruneInterval != nil {
@@ -617,6 +1073,122 @@ func (m *Mover) shouldUnlock() bool {
 	return false
 }
 
+// recordAutomaticUnlock notes the removal of stale locks by the mover, if any
+func (m *Mover) recordAutomaticUnlock(autoUnlock *automaticUnlockCollector) {
+	if autoUnlock.status == nil {
+		return
+	}
+	m.sourceStatus.LastAutomaticUnlock = autoUnlock.status
+	m.logger.Info("removed stale repository locks", "count", autoUnlock.status.StaleLocks,
+		"oldestLockAge", autoUnlock.status.OldestLockAge.Duration)
+	m.eventRecorder.Eventf(m.owner, nil, corev1.EventTypeWarning,
+		volsyncv1alpha1.EvRStaleLocksRemoved, volsyncv1alpha1.EvAUnlockRepository,
+		"removed %d stale lock(s) from the restic repository, the oldest was %s old",
+		autoUnlock.status.StaleLocks, autoUnlock.status.OldestLockAge.Duration)
+}
+
+func (m *Mover) updateSuspectedCorruption(bitRot *bitRotCollector) {
+	if bitRot.count > 0 {
+		m.logger.Info("files changed without their modification time changing, backup not made",
+			"count", bitRot.count)
+	}
+	m.sourceStatus.SuspectedCorruptFiles = bitRot.files
+	m.sourceStatus.SuspectedCorruptFileCount = bitRot.count
+}
+
+// repositoryEnvVars returns the environment variables that are populated from
+// the restic repository Secret
+func (m *Mover) repositoryEnvVars(repo *corev1.Secret) []corev1.EnvVar {
+	return []corev1.EnvVar{
+		// We populate environment variables from the restic repo
+		// Secret. They are taken 1-for-1 from the Secret into env vars.
+		// The allowed variables are defined by restic.
+		// https://restic.readthedocs.io/en/stable/040_backup.html#environment-variables
+		// Mandatory variables are needed to define the repository
+		// location and its password.
+		m.resticRepositoryEnvVar(repo),
+		m.resticPasswordEnvVar(repo),
+
+		// Optional variables
+		utils.EnvFromSecret(repo.Name, "RESTIC_COMPRESSION", true), // New in v0.14.0
+		utils.EnvFromSecret(repo.Name, "RESTIC_PACK_SIZE", true),   // New in v0.14.0
+
+		utils.EnvFromSecret(repo.Name, "RESTIC_READ_CONCURRENCY", true), // New in v0.15.0
+
+		// Optional variables based on what backend is used for restic
+		utils.EnvFromSecret(repo.Name, "AWS_ACCESS_KEY_ID", true),
+		utils.EnvFromSecret(repo.Name, "AWS_SECRET_ACCESS_KEY", true),
+		utils.EnvFromSecret(repo.Name, "AWS_SESSION_TOKEN", true), // New in v0.14.0
+		utils.EnvFromSecret(repo.Name, "AWS_DEFAULT_REGION", true),
+		utils.EnvFromSecret(repo.Name, "AWS_PROFILE", true),
+		// AWS_SHARED_CREDENTIALS_FILE <- not implementing
+		utils.EnvFromSecret(repo.Name, "RESTIC_AWS_ASSUME_ROLE_ARN", true),          // New in v0.17.0
+		utils.EnvFromSecret(repo.Name, "RESTIC_AWS_ASSUME_ROLE_SESSION_NAME", true), // New in v0.17.0
+		utils.EnvFromSecret(repo.Name, "RESTIC_AWS_ASSUME_ROLE_EXTERNAL_ID", true),  // New in v0.17.0
+		utils.EnvFromSecret(repo.Name, "RESTIC_AWS_ASSUME_ROLE_POLICY", true),       // New in v0.17.0
+		utils.EnvFromSecret(repo.Name, "RESTIC_AWS_ASSUME_ROLE_REGION", true),       // New in v0.17.0
+		utils.EnvFromSecret(repo.Name, "RESTIC_AWS_ASSUME_ROLE_STS_ENDPOINT", true), // New in v0.17.0
+		utils.EnvFromSecret(repo.Name, "ST_AUTH", true),
+		utils.EnvFromSecret(repo.Name, "ST_USER", true),
+		utils.EnvFromSecret(repo.Name, "ST_KEY", true),
+		utils.EnvFromSecret(repo.Name, "OS_AUTH_URL", true),
+		utils.EnvFromSecret(repo.Name, "OS_REGION_NAME", true),
+		utils.EnvFromSecret(repo.Name, "OS_USERNAME", true),
+		utils.EnvFromSecret(repo.Name, "OS_USER_ID", true),
+		utils.EnvFromSecret(repo.Name, "OS_PASSWORD", true),
+		utils.EnvFromSecret(repo.Name, "OS_TENANT_ID", true),
+		utils.EnvFromSecret(repo.Name, "OS_TENANT_NAME", true),
+		utils.EnvFromSecret(repo.Name, "OS_USER_DOMAIN_NAME", true),
+		utils.EnvFromSecret(repo.Name, "OS_USER_DOMAIN_ID", true),
+		utils.EnvFromSecret(repo.Name, "OS_PROJECT_NAME", true),
+		utils.EnvFromSecret(repo.Name, "OS_PROJECT_DOMAIN_NAME", true),
+		utils.EnvFromSecret(repo.Name, "OS_PROJECT_DOMAIN_ID", true),
+		utils.EnvFromSecret(repo.Name, "OS_TRUST_ID", true),
+		utils.EnvFromSecret(repo.Name, "OS_APPLICATION_CREDENTIAL_ID", true),
+		utils.EnvFromSecret(repo.Name, "OS_APPLICATION_CREDENTIAL_NAME", true),
+		utils.EnvFromSecret(repo.Name, "OS_APPLICATION_CREDENTIAL_SECRET", true),
+		utils.EnvFromSecret(repo.Name, "OS_STORAGE_URL", true),
+		utils.EnvFromSecret(repo.Name, "OS_AUTH_TOKEN", true),
+		utils.EnvFromSecret(repo.Name, "B2_ACCOUNT_ID", true),
+		utils.EnvFromSecret(repo.Name, "B2_ACCOUNT_KEY", true),
+		utils.EnvFromSecret(repo.Name, "AZURE_ACCOUNT_NAME", true),
+		utils.EnvFromSecret(repo.Name, "AZURE_ACCOUNT_KEY", true),
+		utils.EnvFromSecret(repo.Name, "AZURE_ACCOUNT_SAS", true),     // New in v0.14.0
+		utils.EnvFromSecret(repo.Name, "AZURE_ENDPOINT_SUFFIX", true), // New in v0.16.0
+		// AZURE_FORCE_CLI_CREDENTIAL <- not implementing, requires azure cli or local credentials stored from cli?
+		utils.EnvFromSecret(repo.Name, "GOOGLE_PROJECT_ID", true),
+		utils.EnvFromSecret(repo.Name, "RESTIC_REST_USERNAME", true), // New in v0.16.1
+		utils.EnvFromSecret(repo.Name, "RESTIC_REST_PASSWORD", true), // New in v0.16.1
+	}
+}
+
+// A templated repository is passed to the mover as the rendered value
+func (m *Mover) resticRepositoryEnvVar(repo *corev1.Secret) corev1.EnvVar {
+	if m.renderedRepository != "" {
+		return corev1.EnvVar{Name: "RESTIC_REPOSITORY", Value: m.renderedRepository}
+	}
+	return utils.EnvFromSecret(repo.Name, "RESTIC_REPOSITORY", false)
+}
+
+func (m *Mover) shouldChangePassword() bool {
+	if m.changePassword == "" {
+		return false
+	}
+	last := m.sourceStatus.LastPasswordChange
+	return last == nil || last.SecretName != m.changePassword
+}
+
+// Once the password has been changed, the new password is used in place of
+// the one in the repository Secret
+func (m *Mover) resticPasswordEnvVar(repo *corev1.Secret) corev1.EnvVar {
+	if m.isSource && m.changePassword != "" && !m.shouldChangePassword() {
+		envVar := utils.EnvFromSecret(m.changePassword, "NEW_PASSWORD", false)
+		envVar.Name = "RESTIC_PASSWORD"
+		return envVar
+	}
+	return utils.EnvFromSecret(repo.Name, "RESTIC_PASSWORD", false)
+}
+
 func generateForgetOptions(policy *volsyncv1alpha1.ResticRetainPolicy) string {
 	const defaultForget = "--keep-last 1"
 
@@ -648,7 +1220,10 @@ func generateForgetOptions(policy *volsyncv1alpha1.ResticRetainPolicy) string {
 	}
 
 	if len(forget) == 0 { // Retain policy was present, but empty
-		return defaultForget
+		forget = defaultForget
+	}
+	if policy.GroupBy != nil {
+		forget += fmt.Sprintf(" --group-by %s", *policy.GroupBy)
 	}
 	return forget
 }
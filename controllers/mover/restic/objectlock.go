@@ -0,0 +1,282 @@
+//go:build !disable_restic
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package restic
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+const (
+	// How often the object lock configuration of the repository is re-checked
+	objectLockCheckInterval = time.Hour
+	objectLockCheckTimeout  = 30 * time.Second
+	// Region used to sign requests if AWS_DEFAULT_REGION isn't set
+	defaultS3Region = "us-east-1"
+	// SHA-256 of an empty request body
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// S3 GetObjectLockConfiguration response
+type objectLockConfiguration struct {
+	ObjectLockEnabled string `xml:"ObjectLockEnabled"`
+	Rule              *struct {
+		DefaultRetention struct {
+			Mode  string `xml:"Mode"`
+			Days  int32  `xml:"Days"`
+			Years int32  `xml:"Years"`
+		} `xml:"DefaultRetention"`
+	} `xml:"Rule"`
+}
+
+type s3Error struct {
+	Code string `xml:"Code"`
+}
+
+// ensureObjectLock checks the object lock configuration of the repository
+// bucket against spec.restic.objectLock, updating the status and the Immutable
+// condition. An error is returned if the requirements are not met.
+func (m *Mover) ensureObjectLock(ctx context.Context, repo *corev1.Secret,
+	customCAObj utils.CustomCAObject) error {
+	if m.objectLock == nil {
+		m.sourceStatus.ObjectLock = nil
+		apimeta.RemoveStatusCondition(m.conditions, volsyncv1alpha1.ConditionImmutable)
+		return nil
+	}
+	enforced := m.objectLock.Required || m.objectLock.MinRetentionDays != nil
+
+	status := m.sourceStatus.ObjectLock
+	if status == nil || status.LastChecked == nil || time.Since(status.LastChecked.Time) >= objectLockCheckInterval {
+		var err error
+		status, err = getObjectLockStatus(ctx, repo, customCAObj)
+		if err != nil {
+			m.logger.Error(err, "unable to check object lock configuration of the repository")
+			apimeta.SetStatusCondition(m.conditions, metav1.Condition{
+				Type:    volsyncv1alpha1.ConditionImmutable,
+				Status:  metav1.ConditionUnknown,
+				Reason:  volsyncv1alpha1.ImmutableReasonCheckFailed,
+				Message: err.Error(),
+			})
+			if enforced {
+				return err
+			}
+			return nil
+		}
+		m.sourceStatus.ObjectLock = status
+	}
+
+	var err error
+	switch {
+	case !status.Enabled:
+		err = errors.New("object lock is not enabled on the repository bucket")
+		apimeta.SetStatusCondition(m.conditions, metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionImmutable,
+			Status:  metav1.ConditionFalse,
+			Reason:  volsyncv1alpha1.ImmutableReasonDisabled,
+			Message: err.Error(),
+		})
+	case m.objectLock.MinRetentionDays != nil &&
+		(status.RetentionDays == nil || *status.RetentionDays < *m.objectLock.MinRetentionDays):
+		err = fmt.Errorf("default retention of the repository bucket is shorter than %d days",
+			*m.objectLock.MinRetentionDays)
+		apimeta.SetStatusCondition(m.conditions, metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionImmutable,
+			Status:  metav1.ConditionFalse,
+			Reason:  volsyncv1alpha1.ImmutableReasonRetentionTooShort,
+			Message: err.Error(),
+		})
+	default:
+		message := "Object lock is enabled on the repository bucket"
+		if status.RetentionDays != nil {
+			message += fmt.Sprintf(" with a default %s retention of %d days", status.Mode, *status.RetentionDays)
+		}
+		apimeta.SetStatusCondition(m.conditions, metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionImmutable,
+			Status:  metav1.ConditionTrue,
+			Reason:  volsyncv1alpha1.ImmutableReasonEnabled,
+			Message: message,
+		})
+	}
+	if err != nil && enforced {
+		return err
+	}
+	return nil
+}
+
+// getObjectLockStatus retrieves the object lock configuration of the bucket of
+// an S3 restic repository using the credentials in the repository Secret
+func getObjectLockStatus(ctx context.Context, repo *corev1.Secret,
+	customCAObj utils.CustomCAObject) (*volsyncv1alpha1.ResticObjectLockStatus, error) {
+	bucketURL, err := s3BucketURL(string(repo.Data["RESTIC_REPOSITORY"]))
+	if err != nil {
+		return nil, err
+	}
+	bucketURL.RawQuery = "object-lock="
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, bucketURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	// The operator signs the request itself, so it can not use the workload
+	// identity of the mover
+	if len(repo.Data["AWS_ACCESS_KEY_ID"]) == 0 || len(repo.Data["AWS_SECRET_ACCESS_KEY"]) == 0 {
+		return nil, errors.New("checking the object lock configuration requires AWS_ACCESS_KEY_ID and " +
+			"AWS_SECRET_ACCESS_KEY in the repository Secret")
+	}
+	region := string(repo.Data["AWS_DEFAULT_REGION"])
+	if region == "" {
+		region = defaultS3Region
+	}
+	signS3Request(req, string(repo.Data["AWS_ACCESS_KEY_ID"]), string(repo.Data["AWS_SECRET_ACCESS_KEY"]),
+		string(repo.Data["AWS_SESSION_TOKEN"]), region, time.Now())
+
+	client := &http.Client{Timeout: objectLockCheckTimeout}
+	if customCAObj != nil {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(customCAObj.GetCAData()) {
+			return nil, errors.New("unable to parse custom CA")
+		}
+		client.Transport = &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	return parseObjectLockResponse(resp.StatusCode, body, metav1.Now())
+}
+
+func parseObjectLockResponse(statusCode int, body []byte,
+	now metav1.Time) (*volsyncv1alpha1.ResticObjectLockStatus, error) {
+	status := &volsyncv1alpha1.ResticObjectLockStatus{LastChecked: &now}
+	if statusCode != http.StatusOK {
+		s3Err := s3Error{}
+		_ = xml.Unmarshal(body, &s3Err)
+		if s3Err.Code == "ObjectLockConfigurationNotFoundError" {
+			return status, nil
+		}
+		return nil, fmt.Errorf("unable to get object lock configuration: HTTP %d %s", statusCode, s3Err.Code)
+	}
+
+	config := objectLockConfiguration{}
+	if err := xml.Unmarshal(body, &config); err != nil {
+		return nil, fmt.Errorf("unable to parse object lock configuration: %w", err)
+	}
+	status.Enabled = config.ObjectLockEnabled == "Enabled"
+	if config.Rule != nil {
+		retention := config.Rule.DefaultRetention
+		status.Mode = retention.Mode
+		days := retention.Days + 365*retention.Years
+		if days > 0 {
+			status.RetentionDays = &days
+		}
+	}
+	return status, nil
+}
+
+// s3BucketURL returns the (path-style) URL of the bucket of a restic S3
+// repository, e.g. "s3:s3.amazonaws.com/bucket/path" or
+// "s3:http://minio:9000/bucket"
+func s3BucketURL(repository string) (*url.URL, error) {
+	endpoint, ok := strings.CutPrefix(repository, "s3:")
+	if !ok {
+		return nil, errors.New("object lock is only supported for s3 repositories")
+	}
+	if !strings.HasPrefix(endpoint, "http://") && !strings.HasPrefix(endpoint, "https://") {
+		endpoint = "https://" + endpoint
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse repository URL: %w", err)
+	}
+	bucket, _, _ := strings.Cut(strings.TrimPrefix(u.Path, "/"), "/")
+	if bucket == "" {
+		return nil, errors.New("repository URL does not contain a bucket name")
+	}
+	return &url.URL{Scheme: u.Scheme, Host: u.Host, Path: "/" + bucket}, nil
+}
+
+// signS3Request signs a request with no body using AWS signature version 4
+func signS3Request(req *http.Request, accessKey string, secretKey string, sessionToken string,
+	region string, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", emptyPayloadHash)
+	canonicalHeaders := "host:" + req.URL.Host + "\n" +
+		"x-amz-content-sha256:" + emptyPayloadHash + "\n" +
+		"x-amz-date:" + amzDate + "\n"
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	if sessionToken != "" {
+		req.Header.Set("x-amz-security-token", sessionToken)
+		canonicalHeaders += "x-amz-security-token:" + sessionToken + "\n"
+		signedHeaders += ";x-amz-security-token"
+	}
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders,
+		signedHeaders,
+		emptyPayloadHash,
+	}, "\n")
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	scope := date + "/" + region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+
+	key := hmacSHA256([]byte("AWS4"+secretKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+accessKey+"/"+scope+
+		", SignedHeaders="+signedHeaders+", Signature="+signature)
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
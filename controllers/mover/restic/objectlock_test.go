@@ -0,0 +1,169 @@
+//go:build !disable_restic
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package restic
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+const objectLockEnabledResponse = `<?xml version="1.0" encoding="UTF-8"?>
+<ObjectLockConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <ObjectLockEnabled>Enabled</ObjectLockEnabled>
+  <Rule>
+    <DefaultRetention>
+      <Mode>COMPLIANCE</Mode>
+      <Days>30</Days>
+    </DefaultRetention>
+  </Rule>
+</ObjectLockConfiguration>`
+
+const objectLockNotFoundResponse = `<?xml version="1.0" encoding="UTF-8"?>
+<Error><Code>ObjectLockConfigurationNotFoundError</Code></Error>`
+
+var _ = Describe("Restic repository object lock", func() {
+	var ctx = context.TODO()
+
+	It("determines the bucket URL from the repository", func() {
+		u, err := s3BucketURL("s3:s3.amazonaws.com/my-bucket/some/path")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(u.String()).To(Equal("https://s3.amazonaws.com/my-bucket"))
+
+		u, err = s3BucketURL("s3:http://minio.minio.svc:9000/restic")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(u.String()).To(Equal("http://minio.minio.svc:9000/restic"))
+
+		_, err = s3BucketURL("s3:http://minio:9000/")
+		Expect(err).To(HaveOccurred())
+		_, err = s3BucketURL("b2:bucket:path")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("parses the object lock configuration", func() {
+		now := metav1.Now()
+		status, err := parseObjectLockResponse(http.StatusOK, []byte(objectLockEnabledResponse), now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.Enabled).To(BeTrue())
+		Expect(status.Mode).To(Equal("COMPLIANCE"))
+		Expect(status.RetentionDays).To(Equal(ptr.To[int32](30)))
+
+		status, err = parseObjectLockResponse(http.StatusNotFound, []byte(objectLockNotFoundResponse), now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.Enabled).To(BeFalse())
+
+		_, err = parseObjectLockResponse(http.StatusForbidden, []byte("<Error><Code>AccessDenied</Code></Error>"), now)
+		Expect(err).To(HaveOccurred())
+	})
+
+	Context("when checking the repository", func() {
+		var server *httptest.Server
+		var response string
+		var authorization string
+		var m *Mover
+		var repo *corev1.Secret
+
+		BeforeEach(func() {
+			response = objectLockEnabledResponse
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				authorization = r.Header.Get("Authorization")
+				if r.URL.Path != "/restic" || !r.URL.Query().Has("object-lock") {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				if strings.Contains(response, "<Error>") {
+					w.WriteHeader(http.StatusNotFound)
+				}
+				_, _ = w.Write([]byte(response))
+			}))
+			repo = &corev1.Secret{
+				Data: map[string][]byte{
+					"RESTIC_REPOSITORY":     []byte("s3:" + server.URL + "/restic/myrs"),
+					"AWS_ACCESS_KEY_ID":     []byte("access"),
+					"AWS_SECRET_ACCESS_KEY": []byte("secret"),
+				},
+			}
+			m = &Mover{
+				logger:       zap.New(zap.UseDevMode(true), zap.WriteTo(GinkgoWriter)),
+				objectLock:   &volsyncv1alpha1.ResticObjectLockSpec{Required: true},
+				sourceStatus: &volsyncv1alpha1.ReplicationSourceResticStatus{},
+				conditions:   &[]metav1.Condition{},
+			}
+		})
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("reports an immutable repository", func() {
+			Expect(m.ensureObjectLock(ctx, repo, nil)).To(Succeed())
+			Expect(authorization).To(HavePrefix("AWS4-HMAC-SHA256 Credential=access/"))
+			Expect(m.sourceStatus.ObjectLock.Enabled).To(BeTrue())
+			Expect(apimeta.IsStatusConditionTrue(*m.conditions, volsyncv1alpha1.ConditionImmutable)).To(BeTrue())
+		})
+
+		It("fails if the retention is too short", func() {
+			m.objectLock.MinRetentionDays = ptr.To[int32](90)
+			Expect(m.ensureObjectLock(ctx, repo, nil)).NotTo(Succeed())
+			cond := apimeta.FindStatusCondition(*m.conditions, volsyncv1alpha1.ConditionImmutable)
+			Expect(cond.Reason).To(Equal(volsyncv1alpha1.ImmutableReasonRetentionTooShort))
+		})
+
+		It("fails if object lock is required but disabled", func() {
+			response = objectLockNotFoundResponse
+			Expect(m.ensureObjectLock(ctx, repo, nil)).NotTo(Succeed())
+			cond := apimeta.FindStatusCondition(*m.conditions, volsyncv1alpha1.ConditionImmutable)
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Reason).To(Equal(volsyncv1alpha1.ImmutableReasonDisabled))
+
+			// Only reported if not required
+			m.objectLock.Required = false
+			Expect(m.ensureObjectLock(ctx, repo, nil)).To(Succeed())
+		})
+
+		It("can not check the configuration without static credentials", func() {
+			delete(repo.Data, "AWS_ACCESS_KEY_ID")
+			delete(repo.Data, "AWS_SECRET_ACCESS_KEY")
+			Expect(m.ensureObjectLock(ctx, repo, nil)).To(MatchError(ContainSubstring("AWS_ACCESS_KEY_ID")))
+			cond := apimeta.FindStatusCondition(*m.conditions, volsyncv1alpha1.ConditionImmutable)
+			Expect(cond.Status).To(Equal(metav1.ConditionUnknown))
+		})
+
+		It("uses the cached configuration until it's time to check again", func() {
+			Expect(m.ensureObjectLock(ctx, repo, nil)).To(Succeed())
+			response = objectLockNotFoundResponse
+			Expect(m.ensureObjectLock(ctx, repo, nil)).To(Succeed())
+
+			m.sourceStatus.ObjectLock.LastChecked = &metav1.Time{Time: time.Now().Add(-2 * objectLockCheckInterval)}
+			Expect(m.ensureObjectLock(ctx, repo, nil)).NotTo(Succeed())
+		})
+	})
+})
@@ -0,0 +1,95 @@
+//go:build !disable_restic
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package restic
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+const (
+	provenancePrefix         = "VOLSYNC_PROVENANCE="
+	provenanceChecksumPrefix = "VOLSYNC_PROVENANCE_SHA256="
+)
+
+// Manifest as written by the mover script
+type provenanceManifest struct {
+	Source         string `json:"source"`
+	SnapshotID     string `json:"snapshotID"`
+	SnapshotTime   string `json:"snapshotTime"`
+	RestoreTime    string `json:"restoreTime"`
+	VolSyncVersion string `json:"volsyncVersion"`
+}
+
+// provenanceCollector picks the provenance manifest out of the mover logs
+type provenanceCollector struct {
+	manifest string
+	checksum string
+}
+
+// filter wraps a log line filter, capturing the provenance lines while
+// passing everything through to the wrapped filter
+func (p *provenanceCollector) filter(next func(string) *string) func(string) *string {
+	return func(line string) *string {
+		switch {
+		case strings.HasPrefix(line, provenanceChecksumPrefix):
+			p.checksum = strings.TrimSpace(strings.TrimPrefix(line, provenanceChecksumPrefix))
+		case strings.HasPrefix(line, provenancePrefix):
+			p.manifest = strings.TrimSpace(strings.TrimPrefix(line, provenancePrefix))
+		}
+		return next(line)
+	}
+}
+
+// result returns the provenance of the restored data, or nil if none was found
+// in the logs
+func (p *provenanceCollector) result(logger logr.Logger) *volsyncv1alpha1.RestoreProvenance {
+	if p.manifest == "" {
+		return nil
+	}
+	manifest := provenanceManifest{}
+	if err := json.Unmarshal([]byte(p.manifest), &manifest); err != nil {
+		logger.Error(err, "unable to parse provenance manifest", "manifest", p.manifest)
+		return nil
+	}
+
+	return &volsyncv1alpha1.RestoreProvenance{
+		Source:           manifest.Source,
+		SnapshotID:       manifest.SnapshotID,
+		SnapshotTime:     parseProvenanceTime(manifest.SnapshotTime),
+		RestoreTime:      parseProvenanceTime(manifest.RestoreTime),
+		VolSyncVersion:   manifest.VolSyncVersion,
+		ManifestChecksum: p.checksum,
+	}
+}
+
+func parseProvenanceTime(value string) *metav1.Time {
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return nil
+	}
+	return &metav1.Time{Time: t}
+}
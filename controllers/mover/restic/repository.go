@@ -0,0 +1,178 @@
+//go:build !disable_restic
+
+/*
+Copyright 2021 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package restic
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/mover"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+// Number of retries of the repository check Job
+const repositoryCheckBackoffLimit = 2
+
+// ensureRepositoryReady runs the repository check Job (spec.restic.ensureRepository)
+// before the synchronization, updating the RepositoryReady condition. It
+// returns true once the repository has been found (or initialized) during
+// this iteration.
+func (m *Mover) ensureRepositoryReady(ctx context.Context, sa *corev1.ServiceAccount,
+	repo *corev1.Secret, customCAObj utils.CustomCAObject) (bool, error) {
+	if !m.ensureRepository {
+		apimeta.RemoveStatusCondition(m.conditions, volsyncv1alpha1.ConditionRepositoryReady)
+		return true, nil
+	}
+
+	dir := "src"
+	if !m.isSource {
+		dir = "dst"
+	}
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      mover.VolSyncPrefix + "repo-" + dir + "-" + m.owner.GetName(),
+			Namespace: m.owner.GetNamespace(),
+		},
+	}
+	logger := m.logger.WithValues("job", client.ObjectKeyFromObject(job))
+
+	_, err := utils.CreateOrUpdateDeleteOnImmutableErr(ctx, m.client, job, logger, func() error {
+		if err := ctrl.SetControllerReference(m.owner, job, m.client.Scheme()); err != nil {
+			logger.Error(err, utils.ErrUnableToSetControllerRef)
+			return err
+		}
+		utils.SetOwnedByVolSync(job)
+		utils.MarkForCleanup(m.owner, job)
+		job.Spec.Template.ObjectMeta.Name = job.Name
+		utils.SetOwnedByVolSync(&job.Spec.Template)
+		job.Spec.BackoffLimit = ptr.To[int32](repositoryCheckBackoffLimit)
+		parallelism := int32(1)
+		if m.paused {
+			parallelism = int32(0)
+		}
+		job.Spec.Parallelism = &parallelism
+		return m.setRepositoryJobTemplate(ctx, logger, job, sa, repo, customCAObj, "ensure-repository")
+	})
+	if err != nil {
+		logger.Error(err, "reconcile failed")
+		return false, err
+	}
+
+	switch {
+	case job.Status.Failed >= repositoryCheckBackoffLimit:
+		status := &volsyncv1alpha1.MoverStatus{}
+		utils.UpdateMoverStatusForFailedJob(ctx, m.logger, status, job.GetName(), job.GetNamespace(),
+			utils.AllLines, utils.SensitiveSecretValues(repo)...)
+		message := strings.TrimSpace(status.Logs)
+		if message == "" {
+			message = "unable to initialize or access the repository"
+		}
+		apimeta.SetStatusCondition(m.conditions, metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionRepositoryReady,
+			Status:  metav1.ConditionFalse,
+			Reason:  volsyncv1alpha1.RepositoryReadyReasonCheckFailed,
+			Message: message,
+		})
+		logger.Info("deleting job -- repository check failed")
+		if err := m.client.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil {
+			return false, err
+		}
+		return false, errors.New("the repository is not ready")
+	case job.Status.Succeeded == 0:
+		apimeta.SetStatusCondition(m.conditions, metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionRepositoryReady,
+			Status:  metav1.ConditionUnknown,
+			Reason:  volsyncv1alpha1.RepositoryReadyReasonChecking,
+			Message: "Checking the repository",
+		})
+		return false, nil
+	}
+	apimeta.SetStatusCondition(m.conditions, metav1.Condition{
+		Type:    volsyncv1alpha1.ConditionRepositoryReady,
+		Status:  metav1.ConditionTrue,
+		Reason:  volsyncv1alpha1.RepositoryReadyReasonReady,
+		Message: "The repository exists and is accessible",
+	})
+	return true, nil
+}
+
+// setRepositoryJobTemplate fills in the pod template of a short Job that runs
+// a single action of the mover against the repository, without the data or
+// the cache volumes
+func (m *Mover) setRepositoryJobTemplate(ctx context.Context, logger logr.Logger, job *batchv1.Job,
+	sa *corev1.ServiceAccount, repo *corev1.Secret, customCAObj utils.CustomCAObject, action string) error {
+	envVars := []corev1.EnvVar{
+		// The action does not use the data or the cache volumes
+		{Name: "DATA_DIR", Value: "/tmp"},
+		{Name: "RESTIC_CACHE_DIR", Value: "/tmp/cache"},
+		{Name: "PRIVILEGED_MOVER", Value: "0"},
+	}
+	envVars = append(envVars, m.repositoryCredentialEnvVars(repo)...)
+	envVars = utils.AppendEnvVarsForClusterWideProxy(envVars)
+
+	podSpec := &job.Spec.Template.Spec
+	podSpec.Containers = []corev1.Container{{
+		Name:    "restic",
+		Env:     envVars,
+		Command: []string{"/mover-restic/entry.sh"},
+		Args:    []string{action},
+		Image:   m.containerImage,
+		SecurityContext: &corev1.SecurityContext{
+			AllowPrivilegeEscalation: ptr.To(false),
+			Capabilities: &corev1.Capabilities{
+				Drop: []corev1.Capability{"ALL"},
+			},
+			Privileged:             ptr.To(false),
+			ReadOnlyRootFilesystem: ptr.To(true),
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: "tempdir", MountPath: "/tmp"},
+		},
+	}}
+	podSpec.RestartPolicy = corev1.RestartPolicyNever
+	podSpec.ServiceAccountName = sa.Name
+	podSpec.Volumes = []corev1.Volume{
+		{Name: "tempdir", VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{
+				Medium: corev1.StorageMediumMemory,
+			}},
+		},
+	}
+	m.mountRepositoryCredentials(podSpec, repo, customCAObj)
+
+	utils.UpdatePodTemplateSpecFromMoverConfig(&job.Spec.Template, m.moverConfig, corev1.ResourceRequirements{})
+	utils.ApplyWorkloadIdentity(&job.Spec.Template, sa)
+	if err := utils.SetMoverNodeAffinity(ctx, m.client, logger, &job.Spec.Template); err != nil {
+		return err
+	}
+	utils.ApplySecurityProfile(&job.Spec.Template, m.owner)
+	return nil
+}
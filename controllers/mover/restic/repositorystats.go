@@ -0,0 +1,76 @@
+//go:build !disable_restic
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package restic
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+// repositoryStatsPrefix starts the line in the mover logs with the statistics
+// of the repository:
+// VOLSYNC_REPOSITORY_STATS=<stored> <uncompressed> <restore size> <snapshots> <source snapshots>
+const repositoryStatsPrefix = "VOLSYNC_REPOSITORY_STATS="
+
+// repositoryStatsCollector picks the statistics of the repository out of the
+// mover logs
+type repositoryStatsCollector struct {
+	stats *volsyncv1alpha1.ResticRepositoryStats
+}
+
+// filter wraps a log line filter, capturing the repository statistics while
+// passing everything through to the wrapped filter
+func (r *repositoryStatsCollector) filter(next func(string) *string) func(string) *string {
+	return func(line string) *string {
+		if strings.HasPrefix(line, repositoryStatsPrefix) {
+			fields := strings.Fields(strings.TrimPrefix(line, repositoryStatsPrefix))
+			if len(fields) == 5 {
+				values := make([]int64, len(fields))
+				for i, f := range fields {
+					v, err := strconv.ParseInt(f, 10, 64)
+					if err != nil {
+						return next(line)
+					}
+					values[i] = v
+				}
+				stored, uncompressed, restore := values[0], values[1], values[2]
+				r.stats = &volsyncv1alpha1.ResticRepositoryStats{
+					StoredSize:          resource.NewQuantity(stored, resource.BinarySI),
+					UncompressedSize:    resource.NewQuantity(uncompressed, resource.BinarySI),
+					RestoreSize:         resource.NewQuantity(restore, resource.BinarySI),
+					SnapshotCount:       values[3],
+					SourceSnapshotCount: values[4],
+					Time:                ptr.To(metav1.Now()),
+				}
+				if uncompressed > 0 {
+					r.stats.DeduplicationRatio = fmt.Sprintf("%.2f", float64(restore)/float64(uncompressed))
+				}
+			}
+		}
+		return next(line)
+	}
+}
@@ -0,0 +1,74 @@
+//go:build !disable_restic
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package restic
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// repositoryTemplateData holds the fields available to a RESTIC_REPOSITORY
+// template, allowing one repository Secret to be shared by many
+// ReplicationSources and ReplicationDestinations
+type repositoryTemplateData struct {
+	Namespace string
+	PVCName   string
+	CRName    string
+}
+
+// isRepositoryTemplate returns true if the repository is a template that must
+// be rendered for each owner
+func isRepositoryTemplate(repository string) bool {
+	return strings.Contains(repository, "{{")
+}
+
+// renderRepository renders the RESTIC_REPOSITORY template of the repository
+// Secret for the mover
+func (m *Mover) renderRepository(repository string) (string, error) {
+	data := repositoryTemplateData{
+		Namespace: m.owner.GetNamespace(),
+		PVCName:   m.repositoryPVCName(),
+		CRName:    m.owner.GetName(),
+	}
+	tmpl, err := template.New("repository").Option("missingkey=error").Parse(repository)
+	if err != nil {
+		return "", fmt.Errorf("invalid RESTIC_REPOSITORY template: %w", err)
+	}
+	out := &strings.Builder{}
+	if err := tmpl.Execute(out, data); err != nil {
+		return "", fmt.Errorf("invalid RESTIC_REPOSITORY template: %w", err)
+	}
+	rendered := strings.TrimSpace(out.String())
+	if rendered == "" {
+		return "", fmt.Errorf("RESTIC_REPOSITORY template %q renders to an empty repository", repository)
+	}
+	return rendered, nil
+}
+
+// repositoryPVCName is the PVC name available to the RESTIC_REPOSITORY
+// template: the source PVC, or the destination PVC
+func (m *Mover) repositoryPVCName() string {
+	if m.isSource {
+		return m.selectorPVCName()
+	}
+	_, pvcName := m.getDestinationPVCName()
+	return pvcName
+}
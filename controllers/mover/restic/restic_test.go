@@ -22,6 +22,7 @@ package restic
 import (
 	"context"
 	"flag"
+	"fmt"
 	"os"
 	"path"
 	"strings"
@@ -35,6 +36,7 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -99,6 +101,13 @@ var _ = Describe("Restic retain policy", func() {
 			forget := generateForgetOptions(policy)
 			Expect(forget).To(MatchRegexp("^\\s*--keep-within\\s+5m3w1d\\s*$"))
 		})
+		It("groups the snapshots", func() {
+			policy := &volsyncv1alpha1.ResticRetainPolicy{
+				GroupBy: ptr.To("host,tags"),
+			}
+			forget := generateForgetOptions(policy)
+			Expect(forget).To(MatchRegexp("^\\s*--keep-last\\s+1\\s+--group-by\\s+host,tags\\s*$"))
+		})
 	})
 })
 
@@ -165,6 +174,42 @@ var _ = Describe("Restic unlock", func() {
 
 })
 
+var _ = Describe("Restic password change", func() {
+	var m *Mover
+
+	BeforeEach(func() {
+		m = &Mover{
+			logger:       zap.New(zap.UseDevMode(true), zap.WriteTo(GinkgoWriter)),
+			isSource:     true,
+			sourceStatus: &volsyncv1alpha1.ReplicationSourceResticStatus{},
+		}
+	})
+
+	It("is not run if changePassword is not set", func() {
+		Expect(m.shouldChangePassword()).To(BeFalse())
+	})
+	It("is run until the status records the new Secret", func() {
+		m.changePassword = "pw-2"
+		Expect(m.shouldChangePassword()).To(BeTrue())
+		m.sourceStatus.LastPasswordChange = &volsyncv1alpha1.ResticPasswordChangeStatus{SecretName: "pw-1"}
+		Expect(m.shouldChangePassword()).To(BeTrue())
+		m.sourceStatus.LastPasswordChange.SecretName = "pw-2"
+		Expect(m.shouldChangePassword()).To(BeFalse())
+	})
+})
+
+var _ = Describe("Restic filesystem quotas", func() {
+	It("requires a privileged mover", func() {
+		m := &Mover{
+			logger:           zap.New(zap.UseDevMode(true), zap.WriteTo(GinkgoWriter)),
+			filesystemQuotas: true,
+		}
+		result, err := m.Synchronize(context.Background())
+		Expect(err).To(HaveOccurred())
+		Expect(result.Completed).To(BeFalse())
+	})
+})
+
 var _ = Describe("Restic prune policy", func() {
 	var m *Mover
 	var owner *corev1.ConfigMap
@@ -231,6 +276,147 @@ var _ = Describe("Restic prune policy", func() {
 	})
 })
 
+var _ = Describe("Restic provenance", func() {
+	logger := zap.New(zap.UseDevMode(true), zap.WriteTo(GinkgoWriter))
+
+	It("is collected from the mover logs", func() {
+		p := &provenanceCollector{}
+		filter := p.filter(LogLineFilterSuccess)
+		//nolint:lll
+		Expect(filter(`VOLSYNC_PROVENANCE={"source":"ns1/src","snapshotID":"abcd1234","snapshotTime":"2024-05-01T10:11:12.123456789Z","restoreTime":"2024-05-02T00:00:00Z","volsyncVersion":"v0.12.0"}`)).To(BeNil())
+		Expect(filter("VOLSYNC_PROVENANCE_SHA256=0123abcd")).To(BeNil())
+		Expect(filter("restic completed in 5s")).NotTo(BeNil())
+
+		result := p.result(logger)
+		Expect(result).NotTo(BeNil())
+		Expect(result.Source).To(Equal("ns1/src"))
+		Expect(result.SnapshotID).To(Equal("abcd1234"))
+		Expect(result.SnapshotTime.UTC().Format(time.RFC3339)).To(Equal("2024-05-01T10:11:12Z"))
+		Expect(result.RestoreTime.UTC().Format(time.RFC3339)).To(Equal("2024-05-02T00:00:00Z"))
+		Expect(result.VolSyncVersion).To(Equal("v0.12.0"))
+		Expect(result.ManifestChecksum).To(Equal("0123abcd"))
+	})
+
+	It("is nil when nothing was restored", func() {
+		p := &provenanceCollector{}
+		filter := p.filter(LogLineFilterSuccess)
+		filter("No eligible snapshots found")
+		Expect(p.result(logger)).To(BeNil())
+	})
+})
+
+var _ = Describe("Restic bit rot detection", func() {
+	It("collects the suspected corrupt files from the mover logs", func() {
+		b := &bitRotCollector{}
+		filter := b.filter(utils.AllLines)
+		for i := 0; i < maxSuspectedCorruptFiles+5; i++ {
+			Expect(filter(fmt.Sprintf("VOLSYNC_BITROT=dir/file%d", i))).NotTo(BeNil())
+		}
+		filter("ERROR: 25 file(s) changed without their modification time changing, not backing up")
+
+		m := &Mover{
+			logger:       zap.New(zap.UseDevMode(true), zap.WriteTo(GinkgoWriter)),
+			sourceStatus: &volsyncv1alpha1.ReplicationSourceResticStatus{},
+		}
+		m.updateSuspectedCorruption(b)
+		Expect(m.sourceStatus.SuspectedCorruptFileCount).To(Equal(int32(maxSuspectedCorruptFiles + 5)))
+		Expect(m.sourceStatus.SuspectedCorruptFiles).To(HaveLen(maxSuspectedCorruptFiles))
+		Expect(m.sourceStatus.SuspectedCorruptFiles[0]).To(Equal("dir/file0"))
+
+		// A later successful backup clears them
+		m.updateSuspectedCorruption(&bitRotCollector{})
+		Expect(m.sourceStatus.SuspectedCorruptFileCount).To(BeZero())
+		Expect(m.sourceStatus.SuspectedCorruptFiles).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Restic cache usage", func() {
+	It("collects the cache usage from the mover logs", func() {
+		c := &cacheUsageCollector{}
+		filter := c.filter(utils.AllLines)
+		Expect(filter("VOLSYNC_CACHE_USAGE=2097152")).NotTo(BeNil())
+		Expect(c.usage).NotTo(BeNil())
+		Expect(c.usage.Value()).To(Equal(int64(2097152)))
+		Expect(c.usage.String()).To(Equal("2Mi"))
+	})
+	It("ignores malformed values", func() {
+		c := &cacheUsageCollector{}
+		c.filter(utils.AllLines)("VOLSYNC_CACHE_USAGE=lots")
+		Expect(c.usage).To(BeNil())
+	})
+})
+
+var _ = Describe("Restic integrity manifest", func() {
+	It("collects the manifest reference from the mover logs", func() {
+		i := &integrityManifestCollector{}
+		filter := i.filter(utils.AllLines)
+		Expect(filter("VOLSYNC_INTEGRITY_MANIFEST=1a2b3c4d 5e6f7a8b 0123abcd 42")).NotTo(BeNil())
+		Expect(i.manifest).NotTo(BeNil())
+		Expect(i.manifest.SnapshotID).To(Equal("1a2b3c4d"))
+		Expect(i.manifest.ManifestSnapshotID).To(Equal("5e6f7a8b"))
+		Expect(i.manifest.SHA256).To(Equal("0123abcd"))
+		Expect(i.manifest.Files).To(Equal(int64(42)))
+		Expect(i.manifest.Time).NotTo(BeNil())
+	})
+	It("ignores malformed lines", func() {
+		i := &integrityManifestCollector{}
+		i.filter(utils.AllLines)("VOLSYNC_INTEGRITY_MANIFEST=1a2b3c4d")
+		Expect(i.manifest).To(BeNil())
+	})
+})
+
+var _ = Describe("Restic repository statistics", func() {
+	It("collects the statistics from the mover logs", func() {
+		r := &repositoryStatsCollector{}
+		filter := r.filter(utils.AllLines)
+		Expect(filter("VOLSYNC_REPOSITORY_STATS=1000 2000 5000 7 3")).NotTo(BeNil())
+		Expect(r.stats).NotTo(BeNil())
+		Expect(r.stats.StoredSize.Value()).To(Equal(int64(1000)))
+		Expect(r.stats.UncompressedSize.Value()).To(Equal(int64(2000)))
+		Expect(r.stats.RestoreSize.Value()).To(Equal(int64(5000)))
+		Expect(r.stats.DeduplicationRatio).To(Equal("2.50"))
+		Expect(r.stats.SnapshotCount).To(Equal(int64(7)))
+		Expect(r.stats.SourceSnapshotCount).To(Equal(int64(3)))
+		Expect(r.stats.Time).NotTo(BeNil())
+	})
+	It("ignores malformed lines", func() {
+		r := &repositoryStatsCollector{}
+		r.filter(utils.AllLines)("VOLSYNC_REPOSITORY_STATS=1000 2000 x 7 3")
+		Expect(r.stats).To(BeNil())
+	})
+})
+
+var _ = Describe("Restic restore size", func() {
+	It("collects the restore size from the mover logs", func() {
+		r := &restoreSizeCollector{}
+		filter := r.filter(utils.AllLines)
+		Expect(filter("VOLSYNC_RESTORE_SIZE=1a2b3c4d 3000 2000")).NotTo(BeNil())
+		size := r.result()
+		Expect(size).NotTo(BeNil())
+		Expect(size.SnapshotID).To(Equal("1a2b3c4d"))
+		Expect(size.SizeBytes).To(Equal(int64(3000)))
+		Expect(size.CapacityBytes).To(Equal(int64(2000)))
+		Expect(size.Fits).To(BeFalse())
+		Expect(size.Time).NotTo(BeNil())
+
+		filter("VOLSYNC_RESTORE_SIZE=1a2b3c4d 1000 2000")
+		Expect(r.result().Fits).To(BeTrue())
+	})
+	It("ignores malformed lines", func() {
+		r := &restoreSizeCollector{}
+		r.filter(utils.AllLines)("VOLSYNC_RESTORE_SIZE=1a2b3c4d lots 2000")
+		Expect(r.result()).To(BeNil())
+	})
+	It("classifies a restore that does not fit as NoSpace", func() {
+		e := &utils.ErrorCodeCollector{Patterns: errorCodePatterns}
+		e.Filter(utils.AllLines)("ERROR: snapshot 1a2b3c4d needs 3000 bytes, which does not fit on the " +
+			"destination volume (2000 bytes available)")
+		status := &volsyncv1alpha1.MoverStatus{Result: volsyncv1alpha1.MoverResultFailed}
+		e.Apply(status)
+		Expect(status.ErrorCode).To(Equal(volsyncv1alpha1.MoverErrorCodeNoSpace))
+	})
+})
+
 var _ = Describe("Restic properly registers", func() {
 	When("Restic's registration function is called", func() {
 		BeforeEach(func() {
@@ -508,6 +694,36 @@ var _ = Describe("Restic as a source", func() {
 					}
 				}
 			})
+			It("renders a templated repository for the owner", func() {
+				repo.Data = map[string][]byte{
+					"RESTIC_REPOSITORY": []byte("s3:s3.example.com/backups/{{ .Namespace }}/{{ .CRName }}-{{ .PVCName }}"),
+					"RESTIC_PASSWORD":   []byte("pw"),
+				}
+				Expect(k8sClient.Update(ctx, repo)).To(Succeed())
+				s, e := mover.validateRepository(ctx)
+				Expect(e).NotTo(HaveOccurred())
+				expected := "s3:s3.example.com/backups/" + ns.Name + "/rs-s"
+				Expect(string(s.Data["RESTIC_REPOSITORY"])).To(Equal(expected))
+				Expect(mover.repositoryEnvVars(s)).To(ContainElement(
+					corev1.EnvVar{Name: "RESTIC_REPOSITORY", Value: expected}))
+
+				repo.Data["RESTIC_REPOSITORY"] = []byte("s3:s3.example.com/backups/{{ .Unknown }}")
+				Expect(k8sClient.Update(ctx, repo)).To(Succeed())
+				s, e = mover.validateRepository(ctx)
+				Expect(e).To(HaveOccurred())
+				Expect(s).To(BeNil())
+			})
+			It("passes an untemplated repository from the Secret", func() {
+				repo.Data = map[string][]byte{
+					"RESTIC_REPOSITORY": []byte("s3:s3.example.com/backups/rs"),
+					"RESTIC_PASSWORD":   []byte("pw"),
+				}
+				Expect(k8sClient.Update(ctx, repo)).To(Succeed())
+				s, e := mover.validateRepository(ctx)
+				Expect(e).NotTo(HaveOccurred())
+				Expect(mover.repositoryEnvVars(s)).To(ContainElement(
+					utils.EnvFromSecret(repo.Name, "RESTIC_REPOSITORY", false)))
+			})
 		})
 
 		Context("Restic cache is created correctly", func() {
@@ -624,6 +840,50 @@ var _ = Describe("Restic as a source", func() {
 					Expect(*cache.Spec.StorageClassName).To(Equal(cachesc))
 				})
 			})
+
+			When("the cacheType is Ephemeral", func() {
+				theSize := resource.MustParse("3Gi")
+				cachesc := "cachesc"
+				BeforeEach(func() {
+					rs.Spec.Restic.CacheType = ptr.To(volsyncv1alpha1.ResticCacheTypeEphemeral)
+					rs.Spec.Restic.CacheCapacity = &theSize
+					rs.Spec.Restic.CacheStorageClassName = &cachesc
+					rs.Spec.Restic.AccessModes = nil
+					rs.Spec.Restic.CacheAccessModes = nil
+				})
+				It("uses a generic ephemeral volume", func() {
+					vs := mover.cacheVolumeSource(nil, dataPVC)
+					Expect(vs.PersistentVolumeClaim).To(BeNil())
+					Expect(vs.Ephemeral).NotTo(BeNil())
+					spec := vs.Ephemeral.VolumeClaimTemplate.Spec
+					Expect(*spec.Resources.Requests.Storage()).To(Equal(theSize))
+					Expect(*spec.StorageClassName).To(Equal(cachesc))
+					Expect(spec.AccessModes).To(Equal(dataPVC.Spec.AccessModes))
+				})
+				It("removes a cache PVC from an earlier cacheType", func() {
+					cache, err := mover.ensureCache(ctx, dataPVC, false)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(mover.removeCachePVC(ctx)).To(Succeed())
+					err = k8sClient.Get(ctx, client.ObjectKeyFromObject(cache), cache)
+					Expect(kerrors.IsNotFound(err) || !cache.DeletionTimestamp.IsZero()).To(BeTrue())
+				})
+			})
+			When("the cacheType is EmptyDir", func() {
+				BeforeEach(func() {
+					rs.Spec.Restic.CacheType = ptr.To(volsyncv1alpha1.ResticCacheTypeEmptyDir)
+					rs.Spec.Restic.CacheCapacity = nil
+				})
+				It("uses an emptyDir limited to the cache capacity", func() {
+					vs := mover.cacheVolumeSource(nil, dataPVC)
+					Expect(vs.EmptyDir).NotTo(BeNil())
+					Expect(*vs.EmptyDir.SizeLimit).To(Equal(resource.MustParse("1Gi")))
+				})
+				It("can not be combined with detectBitRot", func() {
+					rs.Spec.Restic.DetectBitRot = true
+					err := k8sClient.Update(ctx, rs)
+					Expect(kerrors.IsInvalid(err)).To(BeTrue())
+				})
+			})
 		})
 
 		Context("Source volume is handled properly", func() {
@@ -1467,6 +1727,28 @@ var _ = Describe("Restic as a source", func() {
 				})
 			})
 
+			When("The spec enforces a read-only source", func() {
+				It("Mover job should mount the PVC as read-only", func() {
+					mover.readOnlySource = true
+					j, e := mover.ensureJob(ctx, cache, sPVC, sa, repo, nil)
+					Expect(e).NotTo(HaveOccurred())
+					Expect(j).To(BeNil()) // hasn't completed
+					nsn := types.NamespacedName{Name: jobName, Namespace: ns.Name}
+					job = &batchv1.Job{}
+					Expect(k8sClient.Get(ctx, nsn, job)).To(Succeed())
+
+					foundDataVolume := false
+					for _, vol := range job.Spec.Template.Spec.Volumes {
+						if vol.Name == dataVolumeName {
+							foundDataVolume = true
+							Expect(vol.VolumeSource.PersistentVolumeClaim.ClaimName).To(Equal(sPVC.GetName()))
+							Expect(vol.VolumeSource.PersistentVolumeClaim.ReadOnly).To(Equal(true))
+						}
+					}
+					Expect(foundDataVolume).To(Equal(true))
+				})
+			})
+
 			// nolint:dupl
 			Context("Unlock tests", func() {
 				When("Unlock is used (spec.restic.unlock", func() {
@@ -1587,6 +1869,355 @@ var _ = Describe("Restic as a source", func() {
 				})
 			})
 
+			When("repositorySecretRef is set", func() {
+				BeforeEach(func() {
+					mover.repositorySecretRef = &volsyncv1alpha1.MoverSecretRef{
+						Provider:            volsyncv1alpha1.MoverSecretProviderCSISecretsStore,
+						SecretProviderClass: "restic-repo",
+					}
+				})
+				It("should mount the credentials from the secret store", func() {
+					j, e := mover.ensureJob(ctx, cache, sPVC, sa, nil, nil)
+					Expect(e).NotTo(HaveOccurred())
+					Expect(j).To(BeNil()) // hasn't completed
+					nsn := types.NamespacedName{Name: jobName, Namespace: ns.Name}
+					job = &batchv1.Job{}
+					Expect(k8sClient.Get(ctx, nsn, job)).To(Succeed())
+					podSpec := job.Spec.Template.Spec
+					Expect(podSpec.Containers[0].Env).To(ContainElement(
+						corev1.EnvVar{Name: "CREDENTIALS_DIR", Value: secretStoreMountPath}))
+					for _, env := range podSpec.Containers[0].Env {
+						Expect(env.ValueFrom).To(BeNil())
+					}
+					Expect(podSpec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{
+						Name: secretStoreVolumeName, MountPath: secretStoreMountPath, ReadOnly: true}))
+					var found bool
+					for _, v := range podSpec.Volumes {
+						if v.Name == secretStoreVolumeName {
+							found = true
+							Expect(v.CSI).NotTo(BeNil())
+							Expect(v.CSI.VolumeAttributes).To(HaveKeyWithValue("secretProviderClass", "restic-repo"))
+						}
+					}
+					Expect(found).To(BeTrue())
+				})
+				It("should refuse features that need the credentials in the operator", func() {
+					mover.changePassword = "new-password"
+					Expect(mover.validateRepositorySecretRef()).NotTo(Succeed())
+				})
+			})
+
+			When("ensureRepository is set", func() {
+				var repoJobName types.NamespacedName
+				BeforeEach(func() {
+					mover.ensureRepository = true
+					repoJobName = types.NamespacedName{Name: "volsync-repo-src-" + rs.Name, Namespace: ns.Name}
+				})
+				It("should check the repository in a separate job first", func() {
+					ready, e := mover.ensureRepositoryReady(ctx, sa, repo, nil)
+					Expect(e).NotTo(HaveOccurred())
+					Expect(ready).To(BeFalse())
+					job = &batchv1.Job{}
+					Expect(k8sClient.Get(ctx, repoJobName, job)).To(Succeed())
+					Expect(job.Spec.Template.Spec.Containers[0].Args).To(ConsistOf("ensure-repository"))
+					Expect(job.Spec.Template.Spec.ServiceAccountName).To(Equal(sa.Name))
+					cond := apimeta.FindStatusCondition(*mover.conditions, volsyncv1alpha1.ConditionRepositoryReady)
+					Expect(cond).NotTo(BeNil())
+					Expect(cond.Status).To(Equal(metav1.ConditionUnknown))
+
+					job.Status.Succeeded = 1
+					Expect(k8sClient.Status().Update(ctx, job)).To(Succeed())
+					ready, e = mover.ensureRepositoryReady(ctx, sa, repo, nil)
+					Expect(e).NotTo(HaveOccurred())
+					Expect(ready).To(BeTrue())
+					Expect(apimeta.IsStatusConditionTrue(*mover.conditions,
+						volsyncv1alpha1.ConditionRepositoryReady)).To(BeTrue())
+				})
+				It("should report a repository that can not be accessed", func() {
+					_, e := mover.ensureRepositoryReady(ctx, sa, repo, nil)
+					Expect(e).NotTo(HaveOccurred())
+					job = &batchv1.Job{}
+					Expect(k8sClient.Get(ctx, repoJobName, job)).To(Succeed())
+					job.Status.Failed = repositoryCheckBackoffLimit
+					Expect(k8sClient.Status().Update(ctx, job)).To(Succeed())
+					_, e = mover.ensureRepositoryReady(ctx, sa, repo, nil)
+					Expect(e).To(HaveOccurred())
+					Expect(kerrors.IsNotFound(k8sClient.Get(ctx, repoJobName, job))).To(BeTrue())
+					cond := apimeta.FindStatusCondition(*mover.conditions, volsyncv1alpha1.ConditionRepositoryReady)
+					Expect(cond).NotTo(BeNil())
+					Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+					Expect(cond.Reason).To(Equal(volsyncv1alpha1.RepositoryReadyReasonCheckFailed))
+				})
+			})
+
+			When("connectionTest is set", func() {
+				var testJobName types.NamespacedName
+				BeforeEach(func() {
+					repo.StringData = map[string]string{
+						"RESTIC_REPOSITORY": "s3:http://minio/bucket",
+						"RESTIC_PASSWORD":   "password",
+					}
+					mover.repositoryName = repo.Name
+					mover.connectionTest = "test-1"
+					testJobName = types.NamespacedName{Name: "volsync-conntest-src-" + rs.Name, Namespace: ns.Name}
+				})
+				It("should check the repository in a separate job", func() {
+					result, e := mover.TestConnection(ctx)
+					Expect(e).NotTo(HaveOccurred())
+					Expect(result.Completed).To(BeFalse())
+					job = &batchv1.Job{}
+					Expect(k8sClient.Get(ctx, testJobName, job)).To(Succeed())
+					Expect(job.Spec.Template.Spec.Containers[0].Args).To(ConsistOf("connection-test"))
+					// The sync cleanup must not remove it
+					Expect(job.Labels).NotTo(HaveKey("volsync.backube/cleanup"))
+
+					job.Status.Succeeded = 1
+					Expect(k8sClient.Status().Update(ctx, job)).To(Succeed())
+					result, e = mover.TestConnection(ctx)
+					Expect(e).NotTo(HaveOccurred())
+					Expect(result.Completed).To(BeTrue())
+					Expect(rs.Status.ConnectionTest).NotTo(BeNil())
+					Expect(rs.Status.ConnectionTest.Trigger).To(Equal("test-1"))
+					Expect(rs.Status.ConnectionTest.Succeeded).To(BeTrue())
+					Expect(kerrors.IsNotFound(k8sClient.Get(ctx, testJobName, job))).To(BeTrue())
+
+					// Nothing more to do until the trigger changes
+					result, e = mover.TestConnection(ctx)
+					Expect(e).NotTo(HaveOccurred())
+					Expect(result.Completed).To(BeTrue())
+					Expect(kerrors.IsNotFound(k8sClient.Get(ctx, testJobName, job))).To(BeTrue())
+				})
+				It("should report a repository that can not be accessed", func() {
+					_, e := mover.TestConnection(ctx)
+					Expect(e).NotTo(HaveOccurred())
+					job = &batchv1.Job{}
+					Expect(k8sClient.Get(ctx, testJobName, job)).To(Succeed())
+					job.Status.Failed = 1
+					Expect(k8sClient.Status().Update(ctx, job)).To(Succeed())
+					result, e := mover.TestConnection(ctx)
+					Expect(e).NotTo(HaveOccurred())
+					Expect(result.Completed).To(BeTrue())
+					Expect(rs.Status.ConnectionTest.Succeeded).To(BeFalse())
+					Expect(rs.Status.ConnectionTest.Message).NotTo(BeEmpty())
+				})
+			})
+
+			When("several sourcePVCs are backed up together", func() {
+				var otherPVC *corev1.PersistentVolumeClaim
+				BeforeEach(func() {
+					otherPVC = sPVC.DeepCopy()
+					otherPVC.ObjectMeta = metav1.ObjectMeta{Name: "other", Namespace: ns.Name}
+					otherPVC.Spec.AccessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadOnlyMany}
+					mover.sourcePVCNames = []string{sPVC.Name, otherPVC.Name}
+					mover.sourceDataPVCs = []*corev1.PersistentVolumeClaim{sPVC, otherPVC}
+				})
+				It("should mount each PVC in its own directory", func() {
+					j, e := mover.ensureJob(ctx, cache, sPVC, sa, repo, nil)
+					Expect(e).NotTo(HaveOccurred())
+					Expect(j).To(BeNil()) // hasn't completed
+					nsn := types.NamespacedName{Name: jobName, Namespace: ns.Name}
+					job = &batchv1.Job{}
+					Expect(k8sClient.Get(ctx, nsn, job)).To(Succeed())
+					podSpec := job.Spec.Template.Spec
+					Expect(podSpec.Containers[0].VolumeMounts).To(ContainElements(
+						corev1.VolumeMount{Name: dataVolumeName + "-0", MountPath: mountPath + "/" + sPVC.Name},
+						corev1.VolumeMount{Name: dataVolumeName + "-1", MountPath: mountPath + "/other"}))
+					claims := map[string]*corev1.PersistentVolumeClaimVolumeSource{}
+					for _, v := range podSpec.Volumes {
+						Expect(v.Name).NotTo(Equal(dataVolumeName))
+						if v.PersistentVolumeClaim != nil {
+							claims[v.Name] = v.PersistentVolumeClaim
+						}
+					}
+					Expect(claims).To(HaveKeyWithValue(dataVolumeName+"-0",
+						&corev1.PersistentVolumeClaimVolumeSource{ClaimName: sPVC.Name}))
+					Expect(claims).To(HaveKeyWithValue(dataVolumeName+"-1",
+						&corev1.PersistentVolumeClaimVolumeSource{ClaimName: "other", ReadOnly: true}))
+				})
+			})
+
+			When("the source PVC is a block volume", func() {
+				BeforeEach(func() {
+					// Only the in-memory PVC is used to build the Job
+					sPVC.Spec.VolumeMode = ptr.To(corev1.PersistentVolumeBlock)
+					mover.filesystemQuotas = true
+				})
+				It("should expose the device instead of mounting it", func() {
+					j, e := mover.ensureJob(ctx, cache, sPVC, sa, repo, nil)
+					Expect(e).NotTo(HaveOccurred())
+					Expect(j).To(BeNil()) // hasn't completed
+					nsn := types.NamespacedName{Name: jobName, Namespace: ns.Name}
+					job = &batchv1.Job{}
+					Expect(k8sClient.Get(ctx, nsn, job)).To(Succeed())
+					c := job.Spec.Template.Spec.Containers[0]
+					Expect(c.VolumeDevices).To(ConsistOf(corev1.VolumeDevice{
+						Name: dataVolumeName, DevicePath: devicePath}))
+					for _, vm := range c.VolumeMounts {
+						Expect(vm.Name).NotTo(Equal(dataVolumeName))
+					}
+					Expect(c.Env).To(ContainElement(corev1.EnvVar{Name: "BLOCK_DEVICE", Value: devicePath}))
+					// Quotas are a filesystem feature
+					Expect(c.Env).To(ContainElement(corev1.EnvVar{Name: "FILESYSTEM_QUOTAS", Value: "0"}))
+				})
+			})
+
+			When("filesystemQuotas is set", func() {
+				It("should tell the mover to save the quotas", func() {
+					mover.filesystemQuotas = true
+					j, e := mover.ensureJob(ctx, cache, sPVC, sa, repo, nil)
+					Expect(e).NotTo(HaveOccurred())
+					Expect(j).To(BeNil()) // hasn't completed
+					nsn := types.NamespacedName{Name: jobName, Namespace: ns.Name}
+					job = &batchv1.Job{}
+					Expect(k8sClient.Get(ctx, nsn, job)).To(Succeed())
+					Expect(job.Spec.Template.Spec.Containers[0].Env).To(ContainElement(
+						corev1.EnvVar{Name: "FILESYSTEM_QUOTAS", Value: "1"}))
+				})
+			})
+
+			When("tags and a host are set", func() {
+				It("should pass the rendered values to the mover", func() {
+					mover.tags = []string{"tenant-{{ .Namespace }}", "{{ .Name }}"}
+					mover.host = ptr.To("{{ .Namespace }}")
+					j, e := mover.ensureJob(ctx, cache, sPVC, sa, repo, nil)
+					Expect(e).NotTo(HaveOccurred())
+					Expect(j).To(BeNil()) // hasn't completed
+					nsn := types.NamespacedName{Name: jobName, Namespace: ns.Name}
+					job = &batchv1.Job{}
+					Expect(k8sClient.Get(ctx, nsn, job)).To(Succeed())
+					Expect(job.Spec.Template.Spec.Containers[0].Env).To(ContainElements(
+						corev1.EnvVar{Name: "SNAPSHOT_TAGS", Value: "tenant-" + ns.Name + "," + mover.owner.GetName()},
+						corev1.EnvVar{Name: "SNAPSHOT_HOST", Value: ns.Name}))
+				})
+				It("should fail if a template is invalid", func() {
+					mover.tags = []string{"{{ .Tenant }}"}
+					_, e := mover.ensureJob(ctx, cache, sPVC, sa, repo, nil)
+					Expect(e).To(HaveOccurred())
+				})
+			})
+
+			When("a cacheCleanupPolicy is set", func() {
+				It("should pass the policy to the mover", func() {
+					maxSize := resource.MustParse("1Gi")
+					mover.cacheCleanupPolicy = &volsyncv1alpha1.ResticCacheCleanupPolicy{
+						MaxAgeDays: ptr.To[int32](7),
+						MaxSize:    &maxSize,
+					}
+					j, e := mover.ensureJob(ctx, cache, sPVC, sa, repo, nil)
+					Expect(e).NotTo(HaveOccurred())
+					Expect(j).To(BeNil()) // hasn't completed
+					nsn := types.NamespacedName{Name: jobName, Namespace: ns.Name}
+					job = &batchv1.Job{}
+					Expect(k8sClient.Get(ctx, nsn, job)).To(Succeed())
+					Expect(job.Spec.Template.Spec.Containers[0].Env).To(ContainElements(
+						corev1.EnvVar{Name: "CACHE_MAX_AGE_DAYS", Value: "7"},
+						corev1.EnvVar{Name: "CACHE_MAX_SIZE", Value: "1073741824"}))
+				})
+			})
+
+			When("parallelism is set", func() {
+				It("should pass it to the mover", func() {
+					mover.parallelism = ptr.To[int32](16)
+					j, e := mover.ensureJob(ctx, cache, sPVC, sa, repo, nil)
+					Expect(e).NotTo(HaveOccurred())
+					Expect(j).To(BeNil()) // hasn't completed
+					nsn := types.NamespacedName{Name: jobName, Namespace: ns.Name}
+					job = &batchv1.Job{}
+					Expect(k8sClient.Get(ctx, nsn, job)).To(Succeed())
+					Expect(job.Spec.Template.Spec.Containers[0].Env).To(ContainElement(
+						corev1.EnvVar{Name: "PARALLELISM", Value: "16"}))
+				})
+			})
+
+			When("automaticUnlock is set", func() {
+				It("should pass the stale lock age to the mover", func() {
+					mover.automaticUnlock = &volsyncv1alpha1.ResticAutomaticUnlockSpec{
+						StaleLockAge: &metav1.Duration{Duration: 2 * time.Hour},
+					}
+					j, e := mover.ensureJob(ctx, cache, sPVC, sa, repo, nil)
+					Expect(e).NotTo(HaveOccurred())
+					Expect(j).To(BeNil()) // hasn't completed
+					nsn := types.NamespacedName{Name: jobName, Namespace: ns.Name}
+					job = &batchv1.Job{}
+					Expect(k8sClient.Get(ctx, nsn, job)).To(Succeed())
+					Expect(job.Spec.Template.Spec.Containers[0].Env).To(ContainElement(
+						corev1.EnvVar{Name: "AUTOMATIC_UNLOCK_AGE", Value: "7200"}))
+				})
+				It("should record the locks removed by the mover", func() {
+					autoUnlock := &automaticUnlockCollector{}
+					filter := autoUnlock.filter(LogLineFilterSuccess)
+					filter("unable to create lock in backend: repository is already locked")
+					filter("VOLSYNC_AUTOMATIC_UNLOCK=2 5400")
+					mover.recordAutomaticUnlock(autoUnlock)
+					Expect(mover.sourceStatus.LastAutomaticUnlock).NotTo(BeNil())
+					Expect(mover.sourceStatus.LastAutomaticUnlock.StaleLocks).To(Equal(int32(2)))
+					Expect(mover.sourceStatus.LastAutomaticUnlock.OldestLockAge.Duration).To(
+						Equal(90 * time.Minute))
+				})
+			})
+
+			Context("Password change tests", func() {
+				findEnv := func(job *batchv1.Job, name string) *corev1.EnvVar {
+					for i, e := range job.Spec.Template.Spec.Containers[0].Env {
+						if e.Name == name {
+							return &job.Spec.Template.Spec.Containers[0].Env[i]
+						}
+					}
+					return nil
+				}
+
+				When("changePassword is set in the spec", func() {
+					JustBeforeEach(func() {
+						mover.changePassword = "new-password"
+					})
+					It("should change the password before the backup", func() {
+						j, e := mover.ensureJob(ctx, cache, sPVC, sa, repo, nil)
+						Expect(e).NotTo(HaveOccurred())
+						Expect(j).To(BeNil()) // hasn't completed
+						nsn := types.NamespacedName{Name: jobName, Namespace: ns.Name}
+						job = &batchv1.Job{}
+						Expect(k8sClient.Get(ctx, nsn, job)).To(Succeed())
+						args := job.Spec.Template.Spec.Containers[0].Args
+						Expect(args).To(Equal([]string{"change-password", "backup"}))
+						newPassword := findEnv(job, "NEW_PASSWORD")
+						Expect(newPassword).NotTo(BeNil())
+						Expect(newPassword.ValueFrom.SecretKeyRef.Name).To(Equal("new-password"))
+						Expect(findEnv(job, "RESTIC_PASSWORD").ValueFrom.SecretKeyRef.Name).To(Equal(repo.Name))
+						// Mark completed
+						job.Status.Succeeded = int32(1)
+						Expect(k8sClient.Status().Update(ctx, job)).To(Succeed())
+						j, e = mover.ensureJob(ctx, cache, sPVC, sa, repo, nil)
+						Expect(e).NotTo(HaveOccurred())
+						Expect(j).NotTo(BeNil())
+						Expect(mover.sourceStatus.LastPasswordChange).NotTo(BeNil())
+						Expect(mover.sourceStatus.LastPasswordChange.SecretName).To(Equal("new-password"))
+						Expect(mover.sourceStatus.LastPasswordChange.Time).NotTo(BeNil())
+					})
+				})
+
+				When("the password has already been changed", func() {
+					JustBeforeEach(func() {
+						mover.changePassword = "new-password"
+						mover.sourceStatus.LastPasswordChange = &volsyncv1alpha1.ResticPasswordChangeStatus{
+							SecretName: "new-password",
+						}
+					})
+					It("should back up using the new password", func() {
+						j, e := mover.ensureJob(ctx, cache, sPVC, sa, repo, nil)
+						Expect(e).NotTo(HaveOccurred())
+						Expect(j).To(BeNil()) // hasn't completed
+						nsn := types.NamespacedName{Name: jobName, Namespace: ns.Name}
+						job = &batchv1.Job{}
+						Expect(k8sClient.Get(ctx, nsn, job)).To(Succeed())
+						Expect(job.Spec.Template.Spec.Containers[0].Args).To(ConsistOf("backup"))
+						Expect(findEnv(job, "NEW_PASSWORD")).To(BeNil())
+						password := findEnv(job, "RESTIC_PASSWORD")
+						Expect(password.ValueFrom.SecretKeyRef.Name).To(Equal("new-password"))
+						Expect(password.ValueFrom.SecretKeyRef.Key).To(Equal("NEW_PASSWORD"))
+					})
+				})
+			})
+
 			When("it's time to prune", func() {
 				var lastMonth metav1.Time
 				JustBeforeEach(func() {
@@ -2209,6 +2840,30 @@ var _ = Describe("Restic as a destination", func() {
 				})
 			})
 
+			When("a previous restore recorded its provenance", func() {
+				JustBeforeEach(func() {
+					mover.destinationStatus.Provenance = &volsyncv1alpha1.RestoreProvenance{
+						Source:     "ns1/src",
+						SnapshotID: "abcd1234",
+					}
+				})
+				It("should clear it when nothing is restored", func() {
+					j, e := mover.ensureJob(ctx, cache, dPVC, sa, repo, nil)
+					Expect(e).NotTo(HaveOccurred())
+					Expect(j).To(BeNil()) // hasn't completed
+					nsn := types.NamespacedName{Name: jobName, Namespace: ns.Name}
+					job = &batchv1.Job{}
+					Expect(k8sClient.Get(ctx, nsn, job)).To(Succeed())
+					// Mark completed, the logs have no provenance
+					job.Status.Succeeded = int32(1)
+					Expect(k8sClient.Status().Update(ctx, job)).To(Succeed())
+					j, e = mover.ensureJob(ctx, cache, dPVC, sa, repo, nil)
+					Expect(e).NotTo(HaveOccurred())
+					Expect(j).NotTo(BeNil())
+					Expect(mover.destinationStatus.Provenance).To(BeNil())
+				})
+			})
+
 			Context("Restore options", func() {
 				When("No restore options are specified", func() {
 					It("should set env vars related to restore options with defaults", func() {
@@ -2276,6 +2931,145 @@ var _ = Describe("Restic as a destination", func() {
 						Expect(restoreOptions.Value).To(Equal("--delete"))
 					})
 				})
+				When("verifyChecksum is specified", func() {
+					BeforeEach(func() {
+						rd.Spec.Restic.VerifyChecksum = true
+					})
+					It("should set the VERIFY_CHECKSUM env var", func() {
+						j, e := mover.ensureJob(ctx, cache, dPVC, sa, repo, nil)
+						Expect(e).NotTo(HaveOccurred())
+						Expect(j).To(BeNil()) // hasn't completed
+						nsn := types.NamespacedName{Name: jobName, Namespace: ns.Name}
+						job = &batchv1.Job{}
+						Expect(k8sClient.Get(ctx, nsn, job)).To(Succeed())
+
+						var verifyChecksum *corev1.EnvVar
+						envVars := job.Spec.Template.Spec.Containers[0].Env
+						for i := range envVars {
+							envVar := envVars[i]
+							if envVar.Name == "VERIFY_CHECKSUM" {
+								verifyChecksum = &envVar
+							}
+						}
+						Expect(verifyChecksum).NotTo(BeNil())
+						Expect(verifyChecksum.Value).To(Equal("1"))
+					})
+				})
+				When("atomicRestore is specified", func() {
+					BeforeEach(func() {
+						rd.Spec.Restic.AtomicRestore = true
+					})
+					It("should set the ATOMIC_RESTORE env var", func() {
+						j, e := mover.ensureJob(ctx, cache, dPVC, sa, repo, nil)
+						Expect(e).NotTo(HaveOccurred())
+						Expect(j).To(BeNil()) // hasn't completed
+						nsn := types.NamespacedName{Name: jobName, Namespace: ns.Name}
+						job = &batchv1.Job{}
+						Expect(k8sClient.Get(ctx, nsn, job)).To(Succeed())
+
+						var atomicRestore *corev1.EnvVar
+						envVars := job.Spec.Template.Spec.Containers[0].Env
+						for i := range envVars {
+							envVar := envVars[i]
+							if envVar.Name == "ATOMIC_RESTORE" {
+								atomicRestore = &envVar
+							}
+						}
+						Expect(atomicRestore).NotTo(BeNil())
+						Expect(atomicRestore.Value).To(Equal("1"))
+					})
+				})
+				When("skipSizeValidation is specified", func() {
+					BeforeEach(func() {
+						rd.Spec.Restic.SkipSizeValidation = true
+					})
+					It("should not validate the restore size", func() {
+						j, e := mover.ensureJob(ctx, cache, dPVC, sa, repo, nil)
+						Expect(e).NotTo(HaveOccurred())
+						Expect(j).To(BeNil()) // hasn't completed
+						nsn := types.NamespacedName{Name: jobName, Namespace: ns.Name}
+						job = &batchv1.Job{}
+						Expect(k8sClient.Get(ctx, nsn, job)).To(Succeed())
+
+						var validateSize *corev1.EnvVar
+						envVars := job.Spec.Template.Spec.Containers[0].Env
+						for i := range envVars {
+							envVar := envVars[i]
+							if envVar.Name == "VALIDATE_RESTORE_SIZE" {
+								validateSize = &envVar
+							}
+						}
+						Expect(validateSize).NotTo(BeNil())
+						Expect(validateSize.Value).To(Equal("0"))
+					})
+				})
+				When("the repository has the Velero layout", func() {
+					BeforeEach(func() {
+						rd.Spec.Restic.RepositoryLayout = volsyncv1alpha1.ResticRepositoryLayoutVelero
+					})
+					It("should point the mover at the repository of the namespace", func() {
+						j, e := mover.ensureJob(ctx, cache, dPVC, sa, repo, nil)
+						Expect(e).NotTo(HaveOccurred())
+						Expect(j).To(BeNil()) // hasn't completed
+						nsn := types.NamespacedName{Name: jobName, Namespace: ns.Name}
+						job = &batchv1.Job{}
+						Expect(k8sClient.Get(ctx, nsn, job)).To(Succeed())
+
+						var subpath *corev1.EnvVar
+						envVars := job.Spec.Template.Spec.Containers[0].Env
+						for i := range envVars {
+							envVar := envVars[i]
+							if envVar.Name == "RESTIC_REPOSITORY_SUBPATH" {
+								subpath = &envVar
+							}
+						}
+						Expect(subpath).NotTo(BeNil())
+						Expect(subpath.Value).To(Equal("restic/" + ns.Name))
+					})
+				})
+				When("the destination was created by the volume populator", func() {
+					BeforeEach(func() {
+						rd.Annotations = map[string]string{
+							utils.PopulatorIdentityAnnotation: "volsync-populator/1234-abcd",
+						}
+					})
+					It("should restore using the identity of the populator", func() {
+						j, e := mover.ensureJob(ctx, cache, dPVC, sa, repo, nil)
+						Expect(e).NotTo(HaveOccurred())
+						Expect(j).To(BeNil()) // hasn't completed
+						nsn := types.NamespacedName{Name: jobName, Namespace: ns.Name}
+						job = &batchv1.Job{}
+						Expect(k8sClient.Get(ctx, nsn, job)).To(Succeed())
+
+						Expect(job.Spec.Template.Spec.Hostname).To(Equal("volsync-populator-1234-abcd"))
+						Expect(job.Spec.Template.Spec.Containers[0].Env).To(ContainElement(
+							corev1.EnvVar{Name: "VOLSYNC_IDENTITY", Value: "volsync-populator/1234-abcd"}))
+					})
+				})
+				When("A snapshotID is specified", func() {
+					BeforeEach(func() {
+						rd.Spec.Restic.SnapshotID = ptr.To("4f5c7a1b")
+					})
+					It("should set RESTORE_SNAPSHOT_ID env var", func() {
+						j, e := mover.ensureJob(ctx, cache, dPVC, sa, repo, nil)
+						Expect(e).NotTo(HaveOccurred())
+						Expect(j).To(BeNil()) // hasn't completed
+						nsn := types.NamespacedName{Name: jobName, Namespace: ns.Name}
+						job = &batchv1.Job{}
+						Expect(k8sClient.Get(ctx, nsn, job)).To(Succeed())
+
+						var snapshotID *corev1.EnvVar
+						envVars := job.Spec.Template.Spec.Containers[0].Env
+						for i := range envVars {
+							envVar := envVars[i]
+							if envVar.Name == "RESTORE_SNAPSHOT_ID" {
+								snapshotID = &envVar
+							}
+						}
+						Expect(snapshotID).NotTo(BeNil())
+						Expect(snapshotID.Value).To(Equal("4f5c7a1b"))
+					})
+				})
 			})
 
 			Context("Cluster wide proxy settings", func() {
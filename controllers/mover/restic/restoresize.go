@@ -0,0 +1,70 @@
+//go:build !disable_restic
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package restic
+
+import (
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+// restoreSizePrefix starts the line in the mover logs that compares the size
+// of the snapshot with the space on the destination volume:
+// VOLSYNC_RESTORE_SIZE=<snapshot id> <size bytes> <capacity bytes>
+const restoreSizePrefix = "VOLSYNC_RESTORE_SIZE="
+
+// restoreSizeCollector picks the size of the restore out of the mover logs
+type restoreSizeCollector struct {
+	size *volsyncv1alpha1.RestoreSize
+}
+
+// filter wraps a log line filter, capturing the restore size while passing
+// everything through to the wrapped filter
+func (r *restoreSizeCollector) filter(next func(string) *string) func(string) *string {
+	return func(line string) *string {
+		if strings.HasPrefix(line, restoreSizePrefix) {
+			fields := strings.Fields(strings.TrimPrefix(line, restoreSizePrefix))
+			if len(fields) == 3 {
+				size, sizeErr := strconv.ParseInt(fields[1], 10, 64)
+				capacity, capErr := strconv.ParseInt(fields[2], 10, 64)
+				if sizeErr == nil && capErr == nil {
+					r.size = &volsyncv1alpha1.RestoreSize{
+						SnapshotID:    fields[0],
+						SizeBytes:     size,
+						CapacityBytes: capacity,
+						Fits:          size <= capacity,
+						Time:          ptr.To(metav1.Now()),
+					}
+				}
+			}
+		}
+		return next(line)
+	}
+}
+
+// result returns the restore size reported by the mover, or nil if the mover
+// did not measure it
+func (r *restoreSizeCollector) result() *volsyncv1alpha1.RestoreSize {
+	return r.size
+}
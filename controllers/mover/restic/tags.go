@@ -0,0 +1,93 @@
+//go:build !disable_restic
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package restic
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// snapshotTemplateData holds the fields available to the tags and host
+// templates
+type snapshotTemplateData struct {
+	Namespace             string
+	Name                  string
+	PersistentVolumeClaim string
+}
+
+// renderSnapshotSelectors renders the tags and host of the snapshots for the
+// owner. The tags are returned as the comma-separated list that restic's --tag
+// option takes. Empty strings are returned for unset values.
+func renderSnapshotSelectors(owner client.Object, pvcName string,
+	tags []string, host *string) (string, string, error) {
+	data := snapshotTemplateData{
+		Namespace:             owner.GetNamespace(),
+		Name:                  owner.GetName(),
+		PersistentVolumeClaim: pvcName,
+	}
+
+	rendered := make([]string, 0, len(tags))
+	for _, t := range tags {
+		tag, err := renderSnapshotTemplate(t, data)
+		if err != nil {
+			return "", "", fmt.Errorf("invalid restic tag %q: %w", t, err)
+		}
+		if tag == "" || strings.Contains(tag, ",") {
+			return "", "", fmt.Errorf("restic tag %q must not be empty or contain commas, got %q", t, tag)
+		}
+		rendered = append(rendered, tag)
+	}
+
+	hostName := ""
+	if host != nil {
+		var err error
+		hostName, err = renderSnapshotTemplate(*host, data)
+		if err != nil {
+			return "", "", fmt.Errorf("invalid restic host %q: %w", *host, err)
+		}
+		if hostName == "" {
+			return "", "", fmt.Errorf("restic host %q must not be empty", *host)
+		}
+	}
+	return strings.Join(rendered, ","), hostName, nil
+}
+
+func renderSnapshotTemplate(text string, data snapshotTemplateData) (string, error) {
+	tmpl, err := template.New("snapshot").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	out := &strings.Builder{}
+	if err := tmpl.Execute(out, data); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(out.String()), nil
+}
+
+// selectorPVCName is the PVC name available to the tags and host templates
+func (m *Mover) selectorPVCName() string {
+	if m.mainPVCName == nil {
+		return ""
+	}
+	return *m.mainPVCName
+}
@@ -0,0 +1,105 @@
+//go:build !disable_rsync
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package rsync
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"k8s.io/utils/ptr"
+)
+
+// Host names that are safe to pass to ssh (they can't be mistaken for an
+// option)
+var hostNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_]([a-zA-Z0-9_.-]*[a-zA-Z0-9_])?\.?$`)
+
+// sshAddress is a remote host and port
+type sshAddress struct {
+	// Host name or IP address, without brackets
+	Host string
+	// Port, if one was provided
+	Port *int32
+	// The port was included in the address string
+	PortInAddress bool
+}
+
+// parseAddress splits an address of the form host, IPv6 (with or without
+// brackets), host:port, or [IPv6]:port into its host and port. The port in the
+// address, if any, must agree with port.
+func parseAddress(address string, port *int32) (*sshAddress, error) {
+	addr := &sshAddress{Host: strings.TrimSpace(address), Port: port}
+	if addr.Host == "" {
+		return nil, fmt.Errorf("address must not be empty")
+	}
+
+	var addrPort string
+	switch {
+	case strings.HasPrefix(addr.Host, "["):
+		if strings.HasSuffix(addr.Host, "]") {
+			addr.Host = addr.Host[1 : len(addr.Host)-1]
+			break
+		}
+		h, p, err := net.SplitHostPort(addr.Host)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %q: %w", address, err)
+		}
+		addr.Host, addrPort = h, p
+	case strings.Count(addr.Host, ":") == 1:
+		h, p, err := net.SplitHostPort(addr.Host)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %q: %w", address, err)
+		}
+		addr.Host, addrPort = h, p
+	}
+
+	if err := validateHost(addr.Host); err != nil {
+		return nil, fmt.Errorf("invalid address %q: %w", address, err)
+	}
+
+	if addrPort != "" {
+		p, err := strconv.ParseUint(addrPort, 10, 16)
+		if err != nil || p == 0 {
+			return nil, fmt.Errorf("invalid port %q in address %q", addrPort, address)
+		}
+		if port != nil && int64(p) != int64(*port) {
+			return nil, fmt.Errorf("port %d in address %q does not match port %d", p, address, *port)
+		}
+		addr.Port = ptr.To(int32(p)) //nolint:gosec
+		addr.PortInAddress = true
+	}
+	return addr, nil
+}
+
+func validateHost(host string) error {
+	if strings.Contains(host, ":") {
+		if _, err := netip.ParseAddr(host); err != nil {
+			return fmt.Errorf("not a valid IPv6 address: %w", err)
+		}
+		return nil
+	}
+	if !hostNameRegex.MatchString(host) {
+		return fmt.Errorf("not a valid host name or IP address")
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+//go:build !disable_rsync
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package rsync
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+)
+
+var _ = DescribeTable("Parsing the rsync address",
+	func(address string, port *int32, host string, expectedPort *int32, inAddress bool) {
+		addr, err := parseAddress(address, port)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(addr.Host).To(Equal(host))
+		Expect(addr.Port).To(Equal(expectedPort))
+		Expect(addr.PortInAddress).To(Equal(inAddress))
+	},
+	Entry("host name", "dest.example.com", nil, "dest.example.com", nil, false),
+	Entry("host name and port", "dest.example.com", ptr.To[int32](2222), "dest.example.com", ptr.To[int32](2222), false),
+	Entry("IPv4", "10.0.0.1", nil, "10.0.0.1", nil, false),
+	Entry("IPv4 with port", "10.0.0.1:2222", nil, "10.0.0.1", ptr.To[int32](2222), true),
+	Entry("bare IPv6", "fd00::1", nil, "fd00::1", nil, false),
+	Entry("full IPv6", "fd00:0:0:0:0:0:0:1", nil, "fd00:0:0:0:0:0:0:1", nil, false),
+	Entry("bracketed IPv6", "[fd00::1]", ptr.To[int32](22), "fd00::1", ptr.To[int32](22), false),
+	Entry("bracketed IPv6 with port", "[fd00::1]:2222", nil, "fd00::1", ptr.To[int32](2222), true),
+	Entry("matching ports", "[fd00::1]:2222", ptr.To[int32](2222), "fd00::1", ptr.To[int32](2222), true),
+)
+
+var _ = DescribeTable("Rejecting invalid rsync addresses",
+	func(address string, port *int32) {
+		_, err := parseAddress(address, port)
+		Expect(err).To(HaveOccurred())
+	},
+	Entry("empty", "", nil),
+	Entry("URL", "https://dest.example.com:8888", nil),
+	Entry("option injection", "-oProxyCommand=sh", nil),
+	Entry("whitespace", "dest example.com", nil),
+	Entry("invalid IPv6", "fd00:::1", nil),
+	Entry("unterminated bracket", "[fd00::1", nil),
+	Entry("invalid port", "dest.example.com:ssh", nil),
+	Entry("port out of range", "dest.example.com:70000", nil),
+	Entry("conflicting ports", "[fd00::1]:2222", ptr.To[int32](22)),
+)
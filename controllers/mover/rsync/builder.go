@@ -114,6 +114,8 @@ func (rb *Builder) FromSource(client client.Client, logger logr.Logger,
 		volumehandler.WithRecorder(eventRecorder),
 		volumehandler.WithOwner(source),
 		volumehandler.FromSource(&source.Spec.Rsync.ReplicationSourceVolumeOptions),
+		volumehandler.SyncHooks(source.Spec.Hooks),
+		volumehandler.IntermediateRetention(source.Spec.IntermediateRetention, source.Status),
 	)
 	if err != nil {
 		return nil, err
@@ -124,6 +126,30 @@ func (rb *Builder) FromSource(client client.Client, logger logr.Logger,
 	saHandler := utils.NewSAHandler(client, source, isSource, true, /*Rsync runs privileged only*/
 		source.Spec.Rsync.MoverServiceAccount)
 
+	containerImage, err := utils.MoverImage(rb.getRsyncContainerImage(), source.Spec.Rsync.MoverImage)
+	if err != nil {
+		return nil, err
+	}
+
+	var destination *sshAddress
+	if source.Spec.Rsync.Address != nil {
+		destination, err = parseAddress(*source.Spec.Rsync.Address, source.Spec.Rsync.Port)
+		if err != nil {
+			return nil, err
+		}
+		if destination.PortInAddress {
+			logger.Info("including the port in the address is deprecated, use the port field instead",
+				"address", *source.Spec.Rsync.Address)
+		}
+	}
+	var proxy *sshAddress
+	if source.Spec.Rsync.ProxyJump != nil {
+		proxy, err = parseAddress(source.Spec.Rsync.ProxyJump.Host, source.Spec.Rsync.ProxyJump.Port)
+		if err != nil {
+			return nil, fmt.Errorf("proxyJump: %w", err)
+		}
+	}
+
 	return &Mover{
 		client:             client,
 		logger:             logger.WithValues("method", "Rsync"),
@@ -131,21 +157,34 @@ func (rb *Builder) FromSource(client client.Client, logger logr.Logger,
 		owner:              source,
 		vh:                 vh,
 		saHandler:          saHandler,
-		containerImage:     rb.getRsyncContainerImage(),
+		containerImage:     containerImage,
 		sshKeys:            source.Spec.Rsync.SSHKeys,
 		serviceType:        source.Spec.Rsync.ServiceType,
 		serviceAnnotations: nil,
 		address:            source.Spec.Rsync.Address,
 		port:               source.Spec.Rsync.Port,
+		sparse:             source.Spec.Rsync.Sparse,
+		extraOptions:       source.Spec.Rsync.ExtraOptions,
 		isSource:           isSource,
 		paused:             source.Spec.Paused,
+		readOnlySource:     source.Spec.EnforceReadOnlySource,
 		mainPVCName:        &source.Spec.SourcePVC,
 		sourceStatus:       source.Status.Rsync,
+		destination:        destination,
+		proxyJump:          source.Spec.Rsync.ProxyJump,
+		proxy:              proxy,
+		errorPolicy:        source.Spec.ErrorPolicy,
 		latestMoverStatus:  source.Status.LatestMoverStatus,
 		moverConfig: volsyncv1alpha1.MoverConfig{
-			MoverSecurityContext: nil, // Not supported for rsync ssh
-			MoverPodLabels:       source.Spec.Rsync.MoverPodLabels,
-			MoverResources:       source.Spec.Rsync.MoverResources,
+			MoverSecurityContext:      nil, // Not supported for rsync ssh
+			MoverPodLabels:            source.Spec.Rsync.MoverPodLabels,
+			MoverPodAnnotations:       source.Spec.Rsync.MoverPodAnnotations,
+			MoverResources:            source.Spec.Rsync.MoverResources,
+			MoverPriorityClassName:    source.Spec.Rsync.MoverPriorityClassName,
+			MoverSchedulerName:        source.Spec.Rsync.MoverSchedulerName,
+			MoverEnv:                  source.Spec.Rsync.MoverEnv,
+			MoverServiceAccountTokens: source.Spec.Rsync.MoverServiceAccountTokens,
+			MoverTimeout:              source.Spec.Rsync.MoverTimeout,
 		},
 	}, nil
 }
@@ -192,29 +231,41 @@ func (rb *Builder) FromDestination(client client.Client, logger logr.Logger,
 		svcAnnotations = *destination.Spec.Rsync.ServiceAnnotations
 	}
 
+	containerImage, err := utils.MoverImage(rb.getRsyncContainerImage(), destination.Spec.Rsync.MoverImage)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Mover{
-		client:             client,
-		logger:             logger.WithValues("method", "Rsync"),
-		eventRecorder:      eventRecorder,
-		owner:              destination,
-		vh:                 vh,
-		saHandler:          saHandler,
-		containerImage:     rb.getRsyncContainerImage(),
-		sshKeys:            destination.Spec.Rsync.SSHKeys,
-		serviceType:        destination.Spec.Rsync.ServiceType,
-		serviceAnnotations: svcAnnotations,
-		address:            destination.Spec.Rsync.Address,
-		port:               destination.Spec.Rsync.Port,
-		isSource:           isSource,
-		paused:             destination.Spec.Paused,
-		mainPVCName:        destination.Spec.Rsync.DestinationPVC,
-		cleanupTempPVC:     destination.Spec.Rsync.CleanupTempPVC,
-		destStatus:         destination.Status.Rsync,
-		latestMoverStatus:  destination.Status.LatestMoverStatus,
+		client:                  client,
+		logger:                  logger.WithValues("method", "Rsync"),
+		eventRecorder:           eventRecorder,
+		owner:                   destination,
+		vh:                      vh,
+		saHandler:               saHandler,
+		containerImage:          containerImage,
+		sshKeys:                 destination.Spec.Rsync.SSHKeys,
+		serviceType:             destination.Spec.Rsync.ServiceType,
+		serviceAnnotations:      svcAnnotations,
+		address:                 destination.Spec.Rsync.Address,
+		port:                    destination.Spec.Rsync.Port,
+		isSource:                isSource,
+		paused:                  destination.Spec.Paused,
+		mainPVCName:             destination.Spec.Rsync.DestinationPVC,
+		cleanupTempPVC:          destination.Spec.Rsync.CleanupTempPVC,
+		destStatus:              destination.Status.Rsync,
+		hostKeyRotationInterval: destination.Spec.Rsync.HostKeyRotationInterval,
+		latestMoverStatus:       destination.Status.LatestMoverStatus,
 		moverConfig: volsyncv1alpha1.MoverConfig{
-			MoverSecurityContext: nil, // Not supported for rsync ssh
-			MoverPodLabels:       destination.Spec.Rsync.MoverPodLabels,
-			MoverResources:       destination.Spec.Rsync.MoverResources,
+			MoverSecurityContext:      nil, // Not supported for rsync ssh
+			MoverPodLabels:            destination.Spec.Rsync.MoverPodLabels,
+			MoverPodAnnotations:       destination.Spec.Rsync.MoverPodAnnotations,
+			MoverResources:            destination.Spec.Rsync.MoverResources,
+			MoverPriorityClassName:    destination.Spec.Rsync.MoverPriorityClassName,
+			MoverSchedulerName:        destination.Spec.Rsync.MoverSchedulerName,
+			MoverEnv:                  destination.Spec.Rsync.MoverEnv,
+			MoverServiceAccountTokens: destination.Spec.Rsync.MoverServiceAccountTokens,
+			MoverTimeout:              destination.Spec.Rsync.MoverTimeout,
 		},
 	}, nil
 }
@@ -21,6 +21,9 @@ package rsync
 
 import (
 	"regexp"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
 )
 
 var rsyncRegex = regexp.MustCompile(
@@ -35,3 +38,11 @@ func LogLineFilterSuccess(line string) *string {
 	}
 	return nil
 }
+
+// errorCodePatterns classify the failures of rsync over ssh
+var errorCodePatterns = []utils.ErrorCodePattern{
+	{
+		Code:  volsyncv1alpha1.MoverErrorCodeAuthFailed,
+		Regex: regexp.MustCompile(`(Permission denied \(publickey)|(Host key verification failed)`),
+	},
+}
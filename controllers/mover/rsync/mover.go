@@ -23,6 +23,7 @@ import (
 	"context"
 	"errors"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -66,16 +67,24 @@ type Mover struct {
 	serviceAnnotations map[string]string
 	address            *string
 	port               *int32
+	sparse             bool
+	extraOptions       []string
 	isSource           bool
 	paused             bool
+	readOnlySource     bool
 	mainPVCName        *string
 	latestMoverStatus  *volsyncv1alpha1.MoverStatus
 	moverConfig        volsyncv1alpha1.MoverConfig
 	// Source-only fields
 	sourceStatus *volsyncv1alpha1.ReplicationSourceRsyncStatus
+	destination  *sshAddress
+	proxyJump    *volsyncv1alpha1.RsyncProxyJumpSpec
+	proxy        *sshAddress
+	errorPolicy  *volsyncv1alpha1.ErrorPolicy
 	// Destination-only fields
-	destStatus     *volsyncv1alpha1.ReplicationDestinationRsyncStatus
-	cleanupTempPVC bool
+	destStatus              *volsyncv1alpha1.ReplicationDestinationRsyncStatus
+	cleanupTempPVC          bool
+	hostKeyRotationInterval *metav1.Duration
 }
 
 var _ mover.Mover = &Mover{}
@@ -116,6 +125,10 @@ func (m *Mover) Synchronize(ctx context.Context) (mover.Result, error) {
 		return mover.InProgress(), err
 	}
 
+	if err := m.validateProxyJumpSecret(ctx); err != nil {
+		return mover.InProgress(), err
+	}
+
 	// Prepare ServiceAccount, role, rolebinding
 	sa, err := m.saHandler.Reconcile(ctx, m.logger)
 	if sa == nil || err != nil {
@@ -241,6 +254,9 @@ func (m *Mover) ensureSecrets(ctx context.Context) (*string, error) {
 			m.logger.Error(err, "SSH keys secret does not contain the proper fields")
 			return nil, err
 		}
+		if !m.isSource {
+			m.destStatus.HostKeyFingerprints = sshKeyFingerprints(rsyncSecret.Data["destination.pub"])
+		}
 		return m.sshKeys, nil
 	}
 
@@ -251,6 +267,9 @@ func (m *Mover) ensureSecrets(ctx context.Context) (*string, error) {
 		Owner:        m.owner,
 		NameTemplate: volSyncRsyncPrefix + m.direction(),
 	}
+	if !m.isSource && m.hostKeyRotationInterval != nil {
+		keyInfo.HostKeyRotationInterval = &m.hostKeyRotationInterval.Duration
+	}
 	cont, err := keyInfo.Reconcile(m.logger)
 	if !cont || err != nil {
 		m.updateStatusSSHKeys(nil)
@@ -266,9 +285,32 @@ func (m *Mover) ensureSecrets(ctx context.Context) (*string, error) {
 	// For ReplicationDestination, expose source secret in status but return dest secret name (to be later used
 	// in the replication destination job)
 	m.updateStatusSSHKeys(&keyInfo.SrcSecret.Name)
+	m.destStatus.HostKeyFingerprints = sshKeyFingerprints(keyInfo.SrcSecret.Data["destination.pub"])
+	m.destStatus.LastHostKeyRotation = nil
+	if keyInfo.HostKeyRotationInterval != nil {
+		m.destStatus.LastHostKeyRotation = &metav1.Time{Time: keyInfo.HostKeyActivated()}
+	}
 	return &keyInfo.DestSecret.Name, nil
 }
 
+func (m *Mover) validateProxyJumpSecret(ctx context.Context) error {
+	if m.proxyJump == nil {
+		return nil
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      m.proxyJump.SSHKeys,
+			Namespace: m.owner.GetNamespace(),
+		},
+	}
+	logger := m.logger.WithValues("proxyJumpSecret", client.ObjectKeyFromObject(secret))
+	if err := utils.GetAndValidateSecret(ctx, m.client, logger, secret, "proxy", "proxy-host.pub"); err != nil {
+		logger.Error(err, "proxyJump SSH keys secret does not contain the proper fields")
+		return err
+	}
+	return nil
+}
+
 func (m *Mover) direction() string {
 	dir := "src"
 	if !m.isSource {
@@ -361,6 +403,13 @@ func (m *Mover) ensureJob(ctx context.Context, dataPVC *corev1.PersistentVolumeC
 	}
 	logger := m.logger.WithValues("job", client.ObjectKeyFromObject(job))
 
+	// Stop a Job that has been running for too long and wait before starting
+	// it over
+	if wait, err := utils.EnforceMoverTimeout(ctx, m.client, logger, m.eventRecorder, m.owner, job,
+		m.moverConfig.MoverTimeout, m.latestMoverStatus); wait || err != nil {
+		return nil, err
+	}
+
 	op, err := utils.CreateOrUpdateDeleteOnImmutableErr(ctx, m.client, job, logger, func() error {
 		if err := ctrl.SetControllerReference(m.owner, job, m.client.Scheme()); err != nil {
 			logger.Error(err, utils.ErrUnableToSetControllerRef)
@@ -388,19 +437,37 @@ func (m *Mover) ensureJob(ctx context.Context, dataPVC *corev1.PersistentVolumeC
 		containerCmd := []string{"/bin/bash", "-c", "/mover-rsync/destination.sh"} // cmd for replicationDestination job
 		if m.isSource {
 			// Set dest address/port if necessary
-			if m.address != nil {
-				containerEnv = append(containerEnv, corev1.EnvVar{Name: "DESTINATION_ADDRESS", Value: *m.address})
-				if m.port != nil {
-					connectPort := strconv.Itoa(int(*m.port))
+			if m.destination != nil {
+				containerEnv = append(containerEnv, corev1.EnvVar{Name: "DESTINATION_ADDRESS", Value: m.destination.Host})
+				if m.destination.Port != nil {
+					connectPort := strconv.Itoa(int(*m.destination.Port))
 					containerEnv = append(containerEnv, corev1.EnvVar{Name: "DESTINATION_PORT", Value: connectPort})
 				}
 			}
+			if m.proxy != nil {
+				containerEnv = append(containerEnv,
+					corev1.EnvVar{Name: "PROXY_HOST", Value: m.proxy.Host},
+					corev1.EnvVar{Name: "PROXY_USER", Value: ptr.Deref(m.proxyJump.SSHUser, "root")})
+				if m.proxy.Port != nil {
+					proxyPort := strconv.Itoa(int(*m.proxy.Port))
+					containerEnv = append(containerEnv, corev1.EnvVar{Name: "PROXY_PORT", Value: proxyPort})
+				}
+			}
+			if m.sparse {
+				containerEnv = append(containerEnv, corev1.EnvVar{Name: "SPARSE_FILES", Value: "1"})
+			}
+			if len(m.extraOptions) > 0 {
+				containerEnv = append(containerEnv,
+					corev1.EnvVar{Name: "RSYNC_EXTRA_OPTS", Value: strings.Join(m.extraOptions, " ")})
+			}
+			containerEnv = append(containerEnv, utils.ErrorPolicyEnvVars(m.errorPolicy)...)
 
 			// Set container cmd for the replicationSource job
 			containerCmd = []string{"/bin/bash", "-c", "/mover-rsync/source.sh"}
 
-			// Set read-only for volume in repl source job spec if the PVC only supports read-only
-			readOnlyVolume = utils.PvcIsReadOnly(dataPVC)
+			// Set read-only for volume in repl source job spec if the PVC only supports read-only or
+			// the spec requires the source to be read-only
+			readOnlyVolume = m.readOnlySource || utils.PvcIsReadOnly(dataPVC)
 		}
 
 		// Run mover in debug mode if required
@@ -469,6 +536,19 @@ func (m *Mover) ensureJob(ctx context.Context, dataPVC *corev1.PersistentVolumeC
 				}},
 			},
 		}
+		if m.proxy != nil {
+			job.Spec.Template.Spec.Containers[0].VolumeMounts = append(job.Spec.Template.Spec.Containers[0].VolumeMounts,
+				corev1.VolumeMount{Name: "proxy-keys", MountPath: "/proxy-keys"})
+			job.Spec.Template.Spec.Volumes = append(job.Spec.Template.Spec.Volumes, corev1.Volume{
+				Name: "proxy-keys",
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName:  m.proxyJump.SSHKeys,
+						DefaultMode: ptr.To[int32](0600),
+					},
+				},
+			})
+		}
 		if m.vh.IsCopyMethodDirect() {
 			affinity, err := utils.AffinityFromVolume(ctx, m.client, logger, dataPVC)
 			if err != nil {
@@ -482,22 +562,37 @@ func (m *Mover) ensureJob(ctx context.Context, dataPVC *corev1.PersistentVolumeC
 		// Update the job podLabels and resourceRequirements (if specified)
 		utils.UpdatePodTemplateSpecFromMoverConfig(&job.Spec.Template, m.moverConfig, corev1.ResourceRequirements{})
 
+		// Keep the mover off of nodes it cannot run on
+		if err := utils.SetMoverNodeAffinity(ctx, m.client, logger, &job.Spec.Template); err != nil {
+			return err
+		}
+
 		logger.V(1).Info("Job has PVC", "PVC", dataPVC, "DS", dataPVC.Spec.DataSource)
 		return nil
 	})
+	if err == nil {
+		utils.SetJobRunningCondition(m.eventRecorder, m.owner, job)
+		err = utils.EnsureEvictionProtection(ctx, m.client, logger, job, m.moverConfig.ProtectFromEviction)
+	}
 	// If Job had failed, delete it so it can be recreated
 	if job.Status.Failed >= *job.Spec.BackoffLimit {
 		// Update status with mover logs from failed job
+		partial := &utils.PartialCompletionCollector{}
+		errorCode := &utils.ErrorCodeCollector{Patterns: errorCodePatterns}
 		utils.UpdateMoverStatusForFailedJob(ctx, m.logger, m.latestMoverStatus, job.GetName(), job.GetNamespace(),
-			utils.AllLines)
+			errorCode.Filter(partial.Filter(utils.AllLines)))
+		partial.Apply(m.latestMoverStatus)
+		errorCode.Apply(m.latestMoverStatus)
 
 		logger.Info("deleting job -- backoff limit reached")
+		mover.RecordJobFinished(rsyncMoverName, job, mover.JobResultFailed)
 		m.eventRecorder.Eventf(m.owner, job, corev1.EventTypeWarning,
 			volsyncv1alpha1.EvRTransferFailed, volsyncv1alpha1.EvADeleteMover, "mover Job backoff limit reached")
 		err = m.client.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground))
 		return nil, err
 	}
 	if err != nil {
+		mover.RecordJobError(rsyncMoverName, err)
 		logger.Error(err, "reconcile failed")
 		return nil, err
 	}
@@ -519,10 +614,17 @@ func (m *Mover) ensureJob(ctx context.Context, dataPVC *corev1.PersistentVolumeC
 	}
 
 	logger.Info("job completed")
+	mover.RecordJobFinished(rsyncMoverName, job, mover.JobResultSucceeded)
 
 	// update status with mover logs from successful job
+	transferStats := &utils.RsyncTransferStatsCollector{}
+	partial := &utils.PartialCompletionCollector{}
 	utils.UpdateMoverStatusForSuccessfulJob(ctx, m.logger, m.latestMoverStatus, job.GetName(), job.GetNamespace(),
-		LogLineFilterSuccess)
+		transferStats.Filter(partial.Filter(LogLineFilterSuccess)))
+	partial.Apply(m.latestMoverStatus)
+	if m.isSource {
+		m.sourceStatus.TransferStats = transferStats.Result()
+	}
 
 	// We only continue reconciling if the rsync job has completed
 	return job, nil
@@ -21,9 +21,13 @@ package rsync
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/backube/volsync/controllers/utils"
 	"github.com/go-logr/logr"
@@ -110,6 +114,18 @@ func updateMap(map1, map2 map[string]string) {
 	}
 }
 
+const (
+	// Annotation on the main secret holding the time the current destination
+	// host key was put into use
+	hostKeyActivatedAnnotation = utils.VolsyncLabelPrefix + "/host-key-activated"
+	// Annotation on the main secret holding the time the next destination host
+	// key was published to the source
+	nextHostKeyPublishedAnnotation = utils.VolsyncLabelPrefix + "/next-host-key-published"
+	// The next host key is published to the source half of the rotation
+	// interval (but at most this long) before the destination starts using it
+	maxHostKeyGracePeriod = 24 * time.Hour
+)
+
 type rsyncSSHKeys struct {
 	Context      context.Context
 	Client       client.Client
@@ -118,6 +134,8 @@ type rsyncSSHKeys struct {
 	MainSecret   *corev1.Secret
 	SrcSecret    *corev1.Secret
 	DestSecret   *corev1.Secret
+	// If set, the destination host key is replaced this often
+	HostKeyRotationInterval *time.Duration
 }
 
 func (k *rsyncSSHKeys) Reconcile(l logr.Logger) (bool, error) {
@@ -171,8 +189,11 @@ func (k *rsyncSSHKeys) ensureMainSecret(l logr.Logger) (bool, error) {
 			}
 			return false, err
 		}
-		// Secret is valid, we're done
+		// Secret is valid, we're done unless the host key needs to be rotated
 		logger.V(1).Info("secret is valid")
+		if k.HostKeyRotationInterval != nil {
+			return k.rotateHostKey(logger)
+		}
 		return true, nil
 	}
 
@@ -232,12 +253,97 @@ func (k *rsyncSSHKeys) generateMainSecret(l logr.Logger) error {
 	}
 	k.MainSecret.Data["destination"] = priv
 	k.MainSecret.Data["destination.pub"] = pub
+	k.MainSecret.SetAnnotations(map[string]string{
+		hostKeyActivatedAnnotation: utils.FormatTime(time.Now()),
+	})
 
 	l.V(1).Info("created secret")
 	return nil
 }
 
-func (k *rsyncSSHKeys) ensureSecret(l logr.Logger, secret *corev1.Secret, keys []string) (bool, error) {
+// rotateHostKey replaces the destination host key once the rotation interval
+// has passed. This happens in two steps so that sources accept both the old
+// and new host keys during the changeover:
+//   - A grace period before the rotation, the next key is generated and
+//     published to the source (destination-next.pub)
+//   - After the grace period, the next key becomes the destination's key
+func (k *rsyncSSHKeys) rotateHostKey(l logr.Logger) (bool, error) {
+	now := time.Now()
+	interval := *k.HostKeyRotationInterval
+	gracePeriod := min(interval/2, maxHostKeyGracePeriod)
+
+	annotations := k.MainSecret.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	activated := k.HostKeyActivated()
+	_, pending := k.MainSecret.Data["destination-next"]
+	switch {
+	case !pending && now.Sub(activated) >= interval-gracePeriod:
+		priv, pub, err := generateKeyPair(k.Context, l)
+		if err != nil {
+			l.Error(err, "unable to generate destination ssh keys")
+			return false, err
+		}
+		k.MainSecret.Data["destination-next"] = priv
+		k.MainSecret.Data["destination-next.pub"] = pub
+		annotations[nextHostKeyPublishedAnnotation] = utils.FormatTime(now)
+		l.Info("publishing next destination host key")
+	case pending && now.Sub(annotationTime(annotations, nextHostKeyPublishedAnnotation, activated)) >= gracePeriod:
+		k.MainSecret.Data["destination"] = k.MainSecret.Data["destination-next"]
+		k.MainSecret.Data["destination.pub"] = k.MainSecret.Data["destination-next.pub"]
+		delete(k.MainSecret.Data, "destination-next")
+		delete(k.MainSecret.Data, "destination-next.pub")
+		annotations[hostKeyActivatedAnnotation] = utils.FormatTime(now)
+		delete(annotations, nextHostKeyPublishedAnnotation)
+		l.Info("rotated destination host key")
+	default:
+		return true, nil
+	}
+
+	k.MainSecret.SetAnnotations(annotations)
+	if err := k.Client.Update(k.Context, k.MainSecret); err != nil {
+		l.Error(err, "unable to update secret")
+		return false, err
+	}
+	return true, nil
+}
+
+// HostKeyActivated returns the time the current destination host key was put
+// into use
+func (k *rsyncSSHKeys) HostKeyActivated() time.Time {
+	return annotationTime(k.MainSecret.GetAnnotations(), hostKeyActivatedAnnotation,
+		k.MainSecret.GetCreationTimestamp().Time)
+}
+
+func annotationTime(annotations map[string]string, key string, defaultTime time.Time) time.Time {
+	t, err := time.Parse(time.RFC3339, annotations[key])
+	if err != nil {
+		return defaultTime
+	}
+	return t
+}
+
+// sshKeyFingerprints returns the SHA256 fingerprints (as displayed by
+// ssh-keygen -l) of the public keys, one per line, in pubKeys
+func sshKeyFingerprints(pubKeys []byte) []string {
+	fingerprints := []string{}
+	for _, line := range strings.Split(string(pubKeys), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		key, err := base64.StdEncoding.DecodeString(fields[1])
+		if err != nil {
+			continue
+		}
+		sum := sha256.Sum256(key)
+		fingerprints = append(fingerprints, "SHA256:"+base64.RawStdEncoding.EncodeToString(sum[:]))
+	}
+	return fingerprints
+}
+
+func (k *rsyncSSHKeys) ensureSecret(l logr.Logger, secret *corev1.Secret, data map[string][]byte) (bool, error) {
 	logger := l.WithValues("secret", client.ObjectKeyFromObject(secret))
 
 	op, err := ctrlutil.CreateOrUpdate(k.Context, k.Client, secret, func() error {
@@ -249,8 +355,8 @@ func (k *rsyncSSHKeys) ensureSecret(l logr.Logger, secret *corev1.Secret, keys [
 		if secret.Data == nil {
 			secret.Data = make(map[string][]byte, 3)
 		}
-		for _, key := range keys {
-			secret.Data[key] = k.MainSecret.Data[key]
+		for key, value := range data {
+			secret.Data[key] = value
 		}
 		return nil
 	})
@@ -264,10 +370,24 @@ func (k *rsyncSSHKeys) ensureSecret(l logr.Logger, secret *corev1.Secret, keys [
 
 func (k *rsyncSSHKeys) ensureSrcSecret(l logr.Logger) (bool, error) {
 	logger := l.WithValues("sourceSecret", client.ObjectKeyFromObject(k.SrcSecret))
-	return k.ensureSecret(logger, k.SrcSecret, []string{"source", "source.pub", "destination.pub"})
+	// While a host key rotation is pending, the source accepts both the
+	// current and next destination host keys
+	destPub := k.MainSecret.Data["destination.pub"]
+	if nextPub, ok := k.MainSecret.Data["destination-next.pub"]; ok {
+		destPub = []byte(strings.TrimSpace(string(destPub)) + "\n" + string(nextPub))
+	}
+	return k.ensureSecret(logger, k.SrcSecret, map[string][]byte{
+		"source":          k.MainSecret.Data["source"],
+		"source.pub":      k.MainSecret.Data["source.pub"],
+		"destination.pub": destPub,
+	})
 }
 
 func (k *rsyncSSHKeys) ensureDestSecret(l logr.Logger) (bool, error) {
 	logger := l.WithValues("destSecret", client.ObjectKeyFromObject(k.DestSecret))
-	return k.ensureSecret(logger, k.DestSecret, []string{"destination", "destination.pub", "source.pub"})
+	return k.ensureSecret(logger, k.DestSecret, map[string][]byte{
+		"destination":     k.MainSecret.Data["destination"],
+		"destination.pub": k.MainSecret.Data["destination.pub"],
+		"source.pub":      k.MainSecret.Data["source.pub"],
+	})
 }
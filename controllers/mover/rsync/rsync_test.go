@@ -23,6 +23,7 @@ import (
 	"flag"
 	"os"
 	"strconv"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -1004,7 +1005,7 @@ var _ = Describe("Rsync as a source", func() {
 				var address string
 				BeforeEach(func() {
 					// Set an address in the spec but no port
-					address = "https://testserver.mydomain:8888"
+					address = "testserver.mydomain"
 					rs.Spec.Rsync.Address = &address
 				})
 				It("should have the correct env vars when address is set in spec", func() {
@@ -1027,7 +1028,7 @@ var _ = Describe("Rsync as a source", func() {
 				var port int32
 				BeforeEach(func() {
 					// Set an address in the spec but no port
-					address = "https://testserver.mydomain:8888"
+					address = "testserver.mydomain"
 					rs.Spec.Rsync.Address = &address
 
 					port = 4567
@@ -1049,6 +1050,109 @@ var _ = Describe("Rsync as a source", func() {
 				})
 			})
 
+			When("the address is an IPv6 address that includes the port", func() {
+				BeforeEach(func() {
+					rs.Spec.Rsync.Address = ptr.To("[fd00::1]:2222")
+				})
+				It("should pass the host and port separately", func() {
+					j, e := mover.ensureJob(ctx, sPVC, sa, sshKeysSecret.GetName()) // Using sPVC as dataPVC (i.e. direct)
+					Expect(e).NotTo(HaveOccurred())
+					Expect(j).To(BeNil()) // hasn't completed
+					nsn := types.NamespacedName{Name: jobName, Namespace: ns.Name}
+					job = &batchv1.Job{}
+					Expect(k8sClient.Get(ctx, nsn, job)).To(Succeed())
+
+					env := job.Spec.Template.Spec.Containers[0].Env
+					Expect(len(env)).To(Equal(2))
+					validateEnvVar(env, "DESTINATION_ADDRESS", "fd00::1")
+					validateEnvVar(env, "DESTINATION_PORT", "2222")
+				})
+			})
+
+			When("a jump host is specified in rsync spec", func() {
+				BeforeEach(func() {
+					rs.Spec.Rsync.Address = ptr.To("10.0.0.5")
+					rs.Spec.Rsync.ProxyJump = &volsyncv1alpha1.RsyncProxyJumpSpec{
+						Host:    "bastion.mydomain",
+						Port:    ptr.To[int32](2200),
+						SSHUser: ptr.To("volsync"),
+						SSHKeys: "proxy-keys",
+					}
+				})
+				It("should configure the jump host in the mover", func() {
+					j, e := mover.ensureJob(ctx, sPVC, sa, sshKeysSecret.GetName()) // Using sPVC as dataPVC (i.e. direct)
+					Expect(e).NotTo(HaveOccurred())
+					Expect(j).To(BeNil()) // hasn't completed
+					nsn := types.NamespacedName{Name: jobName, Namespace: ns.Name}
+					job = &batchv1.Job{}
+					Expect(k8sClient.Get(ctx, nsn, job)).To(Succeed())
+
+					env := job.Spec.Template.Spec.Containers[0].Env
+					validateEnvVar(env, "DESTINATION_ADDRESS", "10.0.0.5")
+					validateEnvVar(env, "PROXY_HOST", "bastion.mydomain")
+					validateEnvVar(env, "PROXY_PORT", "2200")
+					validateEnvVar(env, "PROXY_USER", "volsync")
+
+					Expect(job.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(
+						corev1.VolumeMount{Name: "proxy-keys", MountPath: "/proxy-keys"}))
+					var proxyVolume *corev1.Volume
+					for i := range job.Spec.Template.Spec.Volumes {
+						if job.Spec.Template.Spec.Volumes[i].Name == "proxy-keys" {
+							proxyVolume = &job.Spec.Template.Spec.Volumes[i]
+						}
+					}
+					Expect(proxyVolume).NotTo(BeNil())
+					Expect(proxyVolume.Secret.SecretName).To(Equal("proxy-keys"))
+				})
+				It("should require the jump host keys", func() {
+					Expect(mover.validateProxyJumpSecret(ctx)).NotTo(Succeed())
+					Expect(k8sClient.Create(ctx, &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Name: "proxy-keys", Namespace: ns.Name},
+						StringData: map[string]string{
+							"proxy":          "private-key",
+							"proxy-host.pub": "host-key",
+						},
+					})).To(Succeed())
+					Expect(mover.validateProxyJumpSecret(ctx)).To(Succeed())
+				})
+			})
+
+			When("sparse file handling is enabled in rsync spec", func() {
+				BeforeEach(func() {
+					rs.Spec.Rsync.Sparse = true
+				})
+				It("should tell the mover to handle sparse files", func() {
+					j, e := mover.ensureJob(ctx, sPVC, sa, sshKeysSecret.GetName()) // Using sPVC as dataPVC (i.e. direct)
+					Expect(e).NotTo(HaveOccurred())
+					Expect(j).To(BeNil()) // hasn't completed
+					nsn := types.NamespacedName{Name: jobName, Namespace: ns.Name}
+					job = &batchv1.Job{}
+					Expect(k8sClient.Get(ctx, nsn, job)).To(Succeed())
+
+					// Validate job env vars
+					env := job.Spec.Template.Spec.Containers[0].Env
+					validateEnvVar(env, "SPARSE_FILES", "1")
+				})
+			})
+
+			When("extra rsync options are specified", func() {
+				BeforeEach(func() {
+					rs.Spec.Rsync.ExtraOptions = []string{"--inplace", "--bwlimit=10M"}
+				})
+				It("should pass them to the mover", func() {
+					j, e := mover.ensureJob(ctx, sPVC, sa, sshKeysSecret.GetName()) // Using sPVC as dataPVC (i.e. direct)
+					Expect(e).NotTo(HaveOccurred())
+					Expect(j).To(BeNil()) // hasn't completed
+					nsn := types.NamespacedName{Name: jobName, Namespace: ns.Name}
+					job = &batchv1.Job{}
+					Expect(k8sClient.Get(ctx, nsn, job)).To(Succeed())
+
+					// Validate job env vars
+					env := job.Spec.Template.Spec.Containers[0].Env
+					validateEnvVar(env, "RSYNC_EXTRA_OPTS", "--inplace --bwlimit=10M")
+				})
+			})
+
 			When("Doing a sync when the job already exists", func() {
 				JustBeforeEach(func() {
 					mover.containerImage = "my-rsync-mover-image"
@@ -1453,6 +1557,40 @@ var _ = Describe("Rsync as a destination", func() {
 					Expect(secret3.Data).To(HaveKey("source.pub"))
 					Expect(secret3.Data).To(HaveKey("destination.pub"))
 					Expect(ownerMatches(secret3, rd.GetName(), false)).To(BeTrue())
+
+					// Fingerprint of the host key is published
+					Expect(rd.Status.Rsync.HostKeyFingerprints).To(HaveLen(1))
+					Expect(rd.Status.Rsync.HostKeyFingerprints[0]).To(HavePrefix("SHA256:"))
+					Expect(rd.Status.Rsync.LastHostKeyRotation).To(BeNil())
+				})
+			})
+			When("host key rotation is enabled", func() {
+				BeforeEach(func() {
+					rd.Spec.Rsync = &volsyncv1alpha1.ReplicationDestinationRsyncSpec{
+						HostKeyRotationInterval: &metav1.Duration{Duration: 4 * time.Second},
+					}
+				})
+				It("publishes the next host key before rotating", func() {
+					Eventually(func() (*string, error) {
+						return mover.ensureSecrets(ctx)
+					}, maxWait, interval).Should(Not(BeNil()))
+					Expect(rd.Status.Rsync.HostKeyFingerprints).To(HaveLen(1))
+					Expect(rd.Status.Rsync.LastHostKeyRotation).NotTo(BeNil())
+					original := rd.Status.Rsync.HostKeyFingerprints[0]
+
+					// The next key is accepted by the source in addition to the current one
+					Eventually(func() []string {
+						_, _ = mover.ensureSecrets(ctx)
+						return rd.Status.Rsync.HostKeyFingerprints
+					}, maxWait, interval).Should(HaveLen(2))
+					Expect(rd.Status.Rsync.HostKeyFingerprints[0]).To(Equal(original))
+					next := rd.Status.Rsync.HostKeyFingerprints[1]
+
+					// Then the next key becomes the current one
+					Eventually(func() []string {
+						_, _ = mover.ensureSecrets(ctx)
+						return rd.Status.Rsync.HostKeyFingerprints
+					}, maxWait, interval).Should(Equal([]string{next}))
 				})
 			})
 
@@ -114,6 +114,8 @@ func (rb *Builder) FromSource(client client.Client, logger logr.Logger,
 		volumehandler.WithRecorder(eventRecorder),
 		volumehandler.WithOwner(source),
 		volumehandler.FromSource(&source.Spec.RsyncTLS.ReplicationSourceVolumeOptions),
+		volumehandler.SyncHooks(source.Spec.Hooks),
+		volumehandler.IntermediateRetention(source.Spec.IntermediateRetention, source.Status),
 	)
 	if err != nil {
 		return nil, err
@@ -124,6 +126,11 @@ func (rb *Builder) FromSource(client client.Client, logger logr.Logger,
 	saHandler := utils.NewSAHandler(client, source, isSource, privileged,
 		source.Spec.RsyncTLS.MoverServiceAccount)
 
+	containerImage, err := utils.MoverImage(rb.getRsyncTLSContainerImage(), source.Spec.RsyncTLS.MoverImage)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Mover{
 		client:             client,
 		logger:             logger.WithValues("method", "RsyncTLS"),
@@ -131,14 +138,17 @@ func (rb *Builder) FromSource(client client.Client, logger logr.Logger,
 		owner:              source,
 		vh:                 vh,
 		saHandler:          saHandler,
-		containerImage:     rb.getRsyncTLSContainerImage(),
+		containerImage:     containerImage,
 		key:                source.Spec.RsyncTLS.KeySecret,
+		keySecretRef:       source.Spec.RsyncTLS.KeySecretRef,
 		serviceType:        nil,
 		serviceAnnotations: nil,
 		address:            source.Spec.RsyncTLS.Address,
 		port:               source.Spec.RsyncTLS.Port,
+		sparse:             source.Spec.RsyncTLS.Sparse,
 		isSource:           isSource,
 		paused:             source.Spec.Paused,
+		readOnlySource:     source.Spec.EnforceReadOnlySource,
 		mainPVCName:        &source.Spec.SourcePVC,
 		privileged:         privileged,
 		sourceStatus:       source.Status.RsyncTLS,
@@ -189,6 +199,11 @@ func (rb *Builder) FromDestination(client client.Client, logger logr.Logger,
 		svcAnnotations = *destination.Spec.RsyncTLS.ServiceAnnotations
 	}
 
+	containerImage, err := utils.MoverImage(rb.getRsyncTLSContainerImage(), destination.Spec.RsyncTLS.MoverImage)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Mover{
 		client:             client,
 		logger:             logger.WithValues("method", "RsyncTLS"),
@@ -196,10 +211,13 @@ func (rb *Builder) FromDestination(client client.Client, logger logr.Logger,
 		owner:              destination,
 		vh:                 vh,
 		saHandler:          saHandler,
-		containerImage:     rb.getRsyncTLSContainerImage(),
+		containerImage:     containerImage,
 		key:                destination.Spec.RsyncTLS.KeySecret,
+		keySecretRef:       destination.Spec.RsyncTLS.KeySecretRef,
 		serviceType:        destination.Spec.RsyncTLS.ServiceType,
 		serviceAnnotations: svcAnnotations,
+		externalAddress:    destination.Spec.RsyncTLS.ExternalAddress,
+		tcpRoute:           destination.Spec.RsyncTLS.TCPRoute,
 		address:            nil,
 		port:               nil,
 		isSource:           isSource,
@@ -21,6 +21,9 @@ package rsynctls
 
 import (
 	"regexp"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
 )
 
 var rsyncTLSRegex = regexp.MustCompile(
@@ -55,3 +58,11 @@ func LogLineFilterFailure(line string) *string {
 
 	return nil
 }
+
+// errorCodePatterns classify the failures of rsync over stunnel
+var errorCodePatterns = []utils.ErrorCodePattern{
+	{
+		Code:  volsyncv1alpha1.MoverErrorCodeAuthFailed,
+		Regex: regexp.MustCompile(`(?i)(psk identity not found)|(no psk identity)|(ssl routines)`),
+	},
+}
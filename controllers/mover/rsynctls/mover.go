@@ -25,6 +25,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -33,6 +34,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -53,6 +55,9 @@ const (
 	tlsContainerPort = 8000
 
 	volSyncRsyncTLSPrefix = mover.VolSyncPrefix + "rsync-tls-"
+	// Annotation used by external-dns to create DNS records for a Service or
+	// Gateway API route
+	externalDNSHostnameAnnotation = "external-dns.alpha.kubernetes.io/hostname"
 )
 
 // Mover is the reconciliation logic for the Rsync-based data mover.
@@ -65,12 +70,17 @@ type Mover struct {
 	saHandler          utils.SAHandler
 	containerImage     string
 	key                *string
+	keySecretRef       *volsyncv1alpha1.MoverSecretRef
 	serviceType        *corev1.ServiceType
 	serviceAnnotations map[string]string
+	externalAddress    *string
+	tcpRoute           *volsyncv1alpha1.RsyncTLSTCPRouteSpec
 	address            *string
 	port               *int32
+	sparse             bool
 	isSource           bool
 	paused             bool
+	readOnlySource     bool
 	mainPVCName        *string
 	privileged         bool
 	latestMoverStatus  *volsyncv1alpha1.MoverStatus
@@ -172,29 +182,84 @@ func (m *Mover) ensureServiceAndPublishAddress(ctx context.Context) (bool, error
 		return false, err
 	}
 
+	if m.tcpRoute != nil {
+		return m.ensureRouteAndPublishAddress(ctx, service)
+	}
 	return m.publishSvcAddress(service)
 }
 
 func (m *Mover) publishSvcAddress(service *corev1.Service) (bool, error) {
 	address := utils.GetServiceAddress(service)
-	if address == "" {
+	port := service.Spec.Ports[0].Port
+	if external := m.externalAddressFor(service.Annotations); address != "" && external != "" {
+		// The external name points at the Service, so it is only published
+		// once the Service is reachable
+		address = external
+		if service.Spec.Type == corev1.ServiceTypeNodePort {
+			port = service.Spec.Ports[0].NodePort
+		}
+	}
+	return m.publishAddress(service, address, port, "ensure the proper serviceType was specified")
+}
+
+func (m *Mover) ensureRouteAndPublishAddress(ctx context.Context, service *corev1.Service) (bool, error) {
+	route := &unstructured.Unstructured{}
+	route.SetName(service.Name)
+	route.SetNamespace(service.Namespace)
+	routeDesc := rsyncRouteDescription{
+		Context: ctx,
+		Client:  m.client,
+		Route:   route,
+		Owner:   m.owner,
+		Service: service,
+		Spec:    m.tcpRoute,
+	}
+	if err := routeDesc.Reconcile(m.logger); err != nil {
+		return false, err
+	}
+
+	address, port, err := routeDesc.GatewayAddress()
+	if err != nil {
+		m.logger.Error(err, "unable to determine the address of the Gateway")
+		return false, err
+	}
+	if external := m.externalAddressFor(route.GetAnnotations()); address != "" && external != "" {
+		address = external
+	}
+	return m.publishAddress(route, address, port, "ensure the Gateway has been assigned an address")
+}
+
+// externalAddressFor returns the externally resolvable name of the
+// destination: the externalAddress from the spec or else the first hostname
+// that external-dns was asked to create via the annotations of the exposing
+// object
+func (m *Mover) externalAddressFor(annotations map[string]string) string {
+	if m.externalAddress != nil {
+		return *m.externalAddress
+	}
+	hostnames := strings.Split(annotations[externalDNSHostnameAnnotation], ",")
+	return strings.TrimSpace(hostnames[0])
+}
+
+func (m *Mover) publishAddress(obj client.Object, address string, port int32, hint string) (bool, error) {
+	if address == "" || port == 0 {
 		// We don't have an address yet, try again later
-		m.updateStatusAddress(nil)
-		if service.CreationTimestamp.Add(mover.ServiceAddressTimeout).Before(time.Now()) {
-			m.eventRecorder.Eventf(m.owner, service, corev1.EventTypeWarning,
+		m.updateStatusAddress(nil, nil)
+		if obj.GetCreationTimestamp().Add(mover.ServiceAddressTimeout).Before(time.Now()) {
+			m.eventRecorder.Eventf(m.owner, obj, corev1.EventTypeWarning,
 				volsyncv1alpha1.EvRSvcNoAddress, volsyncv1alpha1.EvANone,
-				"waiting for an address to be assigned to %s; ensure the proper serviceType was specified",
-				utils.KindAndName(m.client.Scheme(), service))
+				"waiting for an address to be assigned to %s; %s",
+				utils.KindAndName(m.client.Scheme(), obj), hint)
 		}
 		return false, nil
 	}
-	m.updateStatusAddress(&address)
+	m.updateStatusAddress(&address, &port)
 
-	m.logger.V(1).Info("Service addr published", "address", address)
+	m.logger.V(1).Info("Service addr published", "address", address, "port", port)
 	return true, nil
 }
 
-func (m *Mover) updateStatusAddress(address *string) {
+func (m *Mover) updateStatusAddress(address *string, port *int32) {
 	publishEvent := false
 	if !m.isSource {
 		if m.destStatus.Address == nil ||
@@ -202,6 +267,7 @@ func (m *Mover) updateStatusAddress(address *string) {
 			publishEvent = true
 		}
 		m.destStatus.Address = address
+		m.destStatus.Port = port
 	}
 	if publishEvent && address != nil {
 		m.eventRecorder.Eventf(m.owner, nil, corev1.EventTypeNormal,
@@ -221,6 +287,12 @@ func (m *Mover) updateStatusPSK(pskSecretName *string) {
 // Will ensure the secret exists or create secrets if necessary
 // - Returns the name of the secret that should be used in the replication job
 func (m *Mover) ensureSecrets(ctx context.Context) (*string, error) {
+	// The key is mounted from the secret store, so there is no Secret
+	if m.keySecretRef != nil {
+		m.updateStatusPSK(nil)
+		return ptr.To(""), nil
+	}
+
 	// If user provided key, use that
 	if m.key != nil {
 		keySecret := &corev1.Secret{
@@ -275,6 +347,39 @@ func (m *Mover) ensureSecrets(ctx context.Context) (*string, error) {
 	return &keySecret.Name, nil
 }
 
+// keyValues returns the pre-shared key in the Secret, to be redacted from the
+// mover logs. Nothing is returned if the key is in the secret store.
+func (m *Mover) keyValues(ctx context.Context, rsyncSecretName string) []string {
+	if rsyncSecretName == "" {
+		return nil
+	}
+	keySecret := &corev1.Secret{}
+	if err := m.client.Get(ctx, client.ObjectKey{Name: rsyncSecretName, Namespace: m.owner.GetNamespace()},
+		keySecret); err != nil {
+		return nil
+	}
+	values := utils.SensitiveSecretValues(keySecret)
+	// The key is "<identity>:<key>", and the key may be logged on its own
+	if _, key, found := strings.Cut(string(keySecret.Data["psk.txt"]), ":"); found {
+		values = append(values, strings.TrimSpace(key))
+	}
+	return values
+}
+
+// keysVolumeSource returns the volume that provides the pre-shared key to the
+// mover
+func (m *Mover) keysVolumeSource(rsyncSecretName string) corev1.VolumeSource {
+	if m.keySecretRef != nil {
+		return utils.SecretStoreVolumeSource(m.keySecretRef)
+	}
+	return corev1.VolumeSource{
+		Secret: &corev1.SecretVolumeSource{
+			SecretName:  rsyncSecretName,
+			DefaultMode: ptr.To[int32](0600),
+		},
+	}
+}
+
 func (m *Mover) direction() string {
 	dir := "src"
 	if !m.isSource {
@@ -367,6 +472,13 @@ func (m *Mover) ensureJob(ctx context.Context, dataPVC *corev1.PersistentVolumeC
 	}
 	logger := m.logger.WithValues("job", client.ObjectKeyFromObject(job))
 
+	// Stop a Job that has been running for too long and wait before starting
+	// it over
+	if wait, err := utils.EnforceMoverTimeout(ctx, m.client, logger, m.eventRecorder, m.owner, job,
+		m.moverConfig.MoverTimeout, m.latestMoverStatus); wait || err != nil {
+		return nil, err
+	}
+
 	op, err := utils.CreateOrUpdateDeleteOnImmutableErr(ctx, m.client, job, logger, func() error {
 		if err := ctrl.SetControllerReference(m.owner, job, m.client.Scheme()); err != nil {
 			logger.Error(err, utils.ErrUnableToSetControllerRef)
@@ -401,11 +513,15 @@ func (m *Mover) ensureJob(ctx context.Context, dataPVC *corev1.PersistentVolumeC
 				connectPort := strconv.Itoa(int(*m.port))
 				containerEnv = append(containerEnv, corev1.EnvVar{Name: "DESTINATION_PORT", Value: connectPort})
 			}
+			if m.sparse {
+				containerEnv = append(containerEnv, corev1.EnvVar{Name: "SPARSE_FILES", Value: "1"})
+			}
 			// Set container cmd for the replicationSource job
 			containerCmd = []string{"/bin/bash", "-c", "/mover-rsync-tls/client.sh"}
 
-			// Set read-only for volume in repl source job spec if the PVC only supports read-only
-			readOnlyVolume = utils.PvcIsReadOnly(dataPVC)
+			// Set read-only for volume in repl source job spec if the PVC only supports read-only or
+			// the spec requires the source to be read-only
+			readOnlyVolume = m.readOnlySource || utils.PvcIsReadOnly(dataPVC)
 		}
 		podSpec := &job.Spec.Template.Spec
 		podSpec.Containers = []corev1.Container{{
@@ -443,12 +559,7 @@ func (m *Mover) ensureJob(ctx context.Context, dataPVC *corev1.PersistentVolumeC
 					ReadOnly:  readOnlyVolume,
 				}},
 			},
-			{Name: "keys", VolumeSource: corev1.VolumeSource{
-				Secret: &corev1.SecretVolumeSource{
-					SecretName:  rsyncSecretName,
-					DefaultMode: ptr.To[int32](0600),
-				}},
-			},
+			{Name: "keys", VolumeSource: m.keysVolumeSource(rsyncSecretName)},
 			{Name: "tempdir", VolumeSource: corev1.VolumeSource{
 				EmptyDir: &corev1.EmptyDirVolumeSource{
 					Medium: corev1.StorageMediumMemory,
@@ -468,6 +579,15 @@ func (m *Mover) ensureJob(ctx context.Context, dataPVC *corev1.PersistentVolumeC
 		// Update the job securityContext, podLabels and resourceRequirements from moverConfig (if specified)
 		utils.UpdatePodTemplateSpecFromMoverConfig(&job.Spec.Template, m.moverConfig, corev1.ResourceRequirements{})
 
+		// Keep the mover off of nodes it cannot run on
+		if err := utils.SetMoverNodeAffinity(ctx, m.client, logger, &job.Spec.Template); err != nil {
+			return err
+		}
+		if err := utils.SetMoverApplicationAffinity(ctx, m.client, logger, &job.Spec.Template,
+			m.moverConfig.MoverApplicationAffinity, m.owner.GetNamespace(), m.mainPVCName); err != nil {
+			return err
+		}
+
 		if m.privileged {
 			podSpec.Containers[0].Env = append(podSpec.Containers[0].Env, corev1.EnvVar{
 				Name:  "PRIVILEGED_MOVER",
@@ -487,25 +607,36 @@ func (m *Mover) ensureJob(ctx context.Context, dataPVC *corev1.PersistentVolumeC
 			})
 		}
 
+		// Enforce the security profile last so that it is not overridden
+		utils.ApplySecurityProfile(&job.Spec.Template, m.owner)
+
 		// Run mover in debug mode if required
 		podSpec.Containers[0].Env = utils.AppendDebugMoverEnvVar(m.owner, podSpec.Containers[0].Env)
 
 		logger.V(1).Info("Job has PVC", "PVC", dataPVC, "DS", dataPVC.Spec.DataSource)
 		return nil
 	})
+	if err == nil {
+		utils.SetJobRunningCondition(m.eventRecorder, m.owner, job)
+		err = utils.EnsureEvictionProtection(ctx, m.client, logger, job, m.moverConfig.ProtectFromEviction)
+	}
 	// If Job had failed, delete it so it can be recreated
 	if job.Status.Failed >= *job.Spec.BackoffLimit {
 		// Update status with mover logs from failed job
+		errorCode := &utils.ErrorCodeCollector{Patterns: errorCodePatterns}
 		utils.UpdateMoverStatusForFailedJob(ctx, m.logger, m.latestMoverStatus, job.GetName(), job.GetNamespace(),
-			LogLineFilterFailure)
+			errorCode.Filter(LogLineFilterFailure), m.keyValues(ctx, rsyncSecretName)...)
+		errorCode.Apply(m.latestMoverStatus)
 
 		logger.Info("deleting job -- backoff limit reached")
+		mover.RecordJobFinished(rsyncTLSMoverName, job, mover.JobResultFailed)
 		m.eventRecorder.Eventf(m.owner, job, corev1.EventTypeWarning,
 			volsyncv1alpha1.EvRTransferFailed, volsyncv1alpha1.EvADeleteMover, "mover Job backoff limit reached")
 		err = m.client.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground))
 		return nil, err
 	}
 	if err != nil {
+		mover.RecordJobError(rsyncTLSMoverName, err)
 		logger.Error(err, "reconcile failed")
 		return nil, err
 	}
@@ -527,10 +658,15 @@ func (m *Mover) ensureJob(ctx context.Context, dataPVC *corev1.PersistentVolumeC
 	}
 
 	logger.Info("job completed")
+	mover.RecordJobFinished(rsyncTLSMoverName, job, mover.JobResultSucceeded)
 
 	// update status with mover logs from successful job
+	transferStats := &utils.RsyncTransferStatsCollector{}
 	utils.UpdateMoverStatusForSuccessfulJob(ctx, m.logger, m.latestMoverStatus, job.GetName(), job.GetNamespace(),
-		LogLineFilterSuccess)
+		transferStats.Filter(LogLineFilterSuccess), m.keyValues(ctx, rsyncSecretName)...)
+	if m.isSource {
+		m.sourceStatus.TransferStats = transferStats.Result()
+	}
 
 	// We only continue reconciling if the rsync job has completed
 	return job, nil
@@ -21,11 +21,15 @@ package rsynctls
 
 import (
 	"context"
+	"fmt"
 
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
 	"github.com/backube/volsync/controllers/utils"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -105,3 +109,118 @@ func updateMap(map1, map2 map[string]string) {
 		map1[k] = v
 	}
 }
+
+var (
+	tcpRouteGVK = schema.GroupVersionKind{Group: "gateway.networking.k8s.io", Version: "v1alpha2", Kind: "TCPRoute"}
+	gatewayGVK  = schema.GroupVersionKind{Group: "gateway.networking.k8s.io", Version: "v1", Kind: "Gateway"}
+)
+
+type rsyncRouteDescription struct {
+	Context context.Context
+	Client  client.Client
+	Route   *unstructured.Unstructured
+	Owner   metav1.Object
+	Service *corev1.Service
+	Spec    *volsyncv1alpha1.RsyncTLSTCPRouteSpec
+}
+
+func (d *rsyncRouteDescription) gatewayKey() client.ObjectKey {
+	key := client.ObjectKey{Name: d.Spec.GatewayName, Namespace: d.Owner.GetNamespace()}
+	if d.Spec.GatewayNamespace != nil {
+		key.Namespace = *d.Spec.GatewayNamespace
+	}
+	return key
+}
+
+// Reconcile ensures a TCPRoute that forwards the connections arriving at the
+// Gateway to the Service
+func (d *rsyncRouteDescription) Reconcile(l logr.Logger) error {
+	d.Route.SetGroupVersionKind(tcpRouteGVK)
+	logger := l.WithValues("tcpRoute", client.ObjectKeyFromObject(d.Route))
+
+	op, err := ctrlutil.CreateOrUpdate(d.Context, d.Client, d.Route, func() error {
+		if err := ctrl.SetControllerReference(d.Owner, d.Route, d.Client.Scheme()); err != nil {
+			logger.Error(err, utils.ErrUnableToSetControllerRef)
+			return err
+		}
+		utils.SetOwnedByVolSync(d.Route)
+
+		if len(d.Spec.Annotations) > 0 {
+			annotations := d.Route.GetAnnotations()
+			if annotations == nil {
+				annotations = map[string]string{}
+			}
+			updateMap(annotations, d.Spec.Annotations)
+			d.Route.SetAnnotations(annotations)
+		}
+
+		gateway := d.gatewayKey()
+		parentRef := map[string]interface{}{
+			"group":     gatewayGVK.Group,
+			"kind":      gatewayGVK.Kind,
+			"name":      gateway.Name,
+			"namespace": gateway.Namespace,
+		}
+		if d.Spec.SectionName != nil {
+			parentRef["sectionName"] = *d.Spec.SectionName
+		}
+		backendRef := map[string]interface{}{
+			"name": d.Service.Name,
+			"port": int64(d.Service.Spec.Ports[0].Port),
+		}
+		rule := map[string]interface{}{
+			"backendRefs": []interface{}{backendRef},
+		}
+		return unstructured.SetNestedField(d.Route.Object, map[string]interface{}{
+			"parentRefs": []interface{}{parentRef},
+			"rules":      []interface{}{rule},
+		}, "spec")
+	})
+	if err != nil {
+		logger.Error(err, "TCPRoute reconcile failed")
+		return err
+	}
+
+	logger.V(1).Info("TCPRoute reconciled", "operation", op)
+	return nil
+}
+
+// GatewayAddress returns the address of the Gateway and the port of the
+// listener that the route is attached to. The address is empty until the
+// Gateway has been assigned one.
+func (d *rsyncRouteDescription) GatewayAddress() (string, int32, error) {
+	gateway := &unstructured.Unstructured{}
+	gateway.SetGroupVersionKind(gatewayGVK)
+	if err := d.Client.Get(d.Context, d.gatewayKey(), gateway); err != nil {
+		return "", 0, err
+	}
+
+	listeners, _, _ := unstructured.NestedSlice(gateway.Object, "spec", "listeners")
+	var port int64
+	for _, l := range listeners {
+		listener, ok := l.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(listener, "name")
+		protocol, _, _ := unstructured.NestedString(listener, "protocol")
+		if (d.Spec.SectionName != nil && name == *d.Spec.SectionName) ||
+			(d.Spec.SectionName == nil && protocol == "TCP") {
+			port, _, _ = unstructured.NestedInt64(listener, "port")
+			break
+		}
+	}
+	if port == 0 {
+		return "", 0, fmt.Errorf("gateway %s has no matching TCP listener", d.gatewayKey())
+	}
+
+	addresses, _, _ := unstructured.NestedSlice(gateway.Object, "status", "addresses")
+	for _, a := range addresses {
+		if address, ok := a.(map[string]interface{}); ok {
+			if value, _, _ := unstructured.NestedString(address, "value"); value != "" {
+				return value, int32(port), nil
+			}
+		}
+	}
+	return "", int32(port), nil
+}
@@ -1006,6 +1006,22 @@ var _ = Describe("RsyncTLS as a source", func() {
 					})
 				})
 			})
+
+			When("TLS key secret ref is provided", func() {
+				BeforeEach(func() {
+					rs.Spec.RsyncTLS.KeySecretRef = &volsyncv1alpha1.MoverSecretRef{
+						Provider:            volsyncv1alpha1.MoverSecretProviderCSISecretsStore,
+						SecretProviderClass: "rsync-keys",
+					}
+				})
+				It("Mover should not require or generate a key Secret", func() {
+					keyName, err := mover.ensureSecrets(ctx)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(keyName).NotTo(BeNil())
+					Expect(*keyName).To(BeEmpty())
+					Expect(rs.Status.RsyncTLS.KeySecret).To(BeNil())
+				})
+			})
 		})
 
 		//nolint:dupl
@@ -1163,6 +1179,30 @@ var _ = Describe("RsyncTLS as a source", func() {
 					Expect(job.Spec.Template.Spec.ServiceAccountName).To(Equal(sa.Name))
 				})
 
+				It("should mount the key from the secret store when keySecretRef is set", func() {
+					mover.keySecretRef = &volsyncv1alpha1.MoverSecretRef{
+						Provider:            volsyncv1alpha1.MoverSecretProviderCSISecretsStore,
+						SecretProviderClass: "rsync-keys",
+					}
+					j, e := mover.ensureJob(ctx, sPVC, sa, "")
+					Expect(e).NotTo(HaveOccurred())
+					Expect(j).To(BeNil()) // hasn't completed
+					nsn := types.NamespacedName{Name: jobName, Namespace: ns.Name}
+					job = &batchv1.Job{}
+					Expect(k8sClient.Get(ctx, nsn, job)).To(Succeed())
+					var keys *corev1.Volume
+					for i, v := range job.Spec.Template.Spec.Volumes {
+						if v.Name == "keys" {
+							keys = &job.Spec.Template.Spec.Volumes[i]
+						}
+					}
+					Expect(keys).NotTo(BeNil())
+					Expect(keys.Secret).To(BeNil())
+					Expect(keys.CSI).NotTo(BeNil())
+					Expect(keys.CSI.Driver).To(Equal(utils.SecretsStoreCSIDriver))
+					Expect(keys.CSI.VolumeAttributes).To(HaveKeyWithValue("secretProviderClass", "rsync-keys"))
+				})
+
 				getSPVC := func() *corev1.PersistentVolumeClaim {
 					return sPVC
 				}
@@ -1457,6 +1497,24 @@ var _ = Describe("RsyncTLS as a source", func() {
 				})
 			})
 
+			When("sparse file handling is enabled in rsyncTLS spec", func() {
+				BeforeEach(func() {
+					rs.Spec.RsyncTLS.Sparse = true
+				})
+				It("should tell the mover to handle sparse files", func() {
+					j, e := mover.ensureJob(ctx, sPVC, sa, tlsKeySecret.GetName()) // Using sPVC as dataPVC (i.e. direct)
+					Expect(e).NotTo(HaveOccurred())
+					Expect(j).To(BeNil()) // hasn't completed
+					nsn := types.NamespacedName{Name: jobName, Namespace: ns.Name}
+					job = &batchv1.Job{}
+					Expect(k8sClient.Get(ctx, nsn, job)).To(Succeed())
+
+					// Validate job env vars
+					env := job.Spec.Template.Spec.Containers[0].Env
+					validateEnvVar(env, "SPARSE_FILES", "1")
+				})
+			})
+
 			When("Doing a sync when the job already exists", func() {
 				JustBeforeEach(func() {
 					mover.containerImage = "my-rsync-mover-image"
@@ -1776,6 +1834,31 @@ var _ = Describe("Rsync as a destination", func() {
 					Expect(svc.Annotations).To(Equal(myCustAnnotations))
 				})
 			})
+
+			When("spec has an externalAddress", func() {
+				BeforeEach(func() {
+					rd.Spec.RsyncTLS = &volsyncv1alpha1.ReplicationDestinationRsyncTLSSpec{
+						ExternalAddress: ptr.To("rsync.example.com"),
+					}
+				})
+				It("publishes the external address and the Service port", func() {
+					Expect(*rd.Status.RsyncTLS.Address).To(Equal("rsync.example.com"))
+					Expect(*rd.Status.RsyncTLS.Port).To(Equal(svc.Spec.Ports[0].Port))
+				})
+			})
+
+			When("the Service has an external-dns hostname", func() {
+				BeforeEach(func() {
+					rd.Spec.RsyncTLS = &volsyncv1alpha1.ReplicationDestinationRsyncTLSSpec{
+						ServiceAnnotations: &map[string]string{
+							"external-dns.alpha.kubernetes.io/hostname": "a.example.com,b.example.com",
+						},
+					}
+				})
+				It("publishes the first hostname", func() {
+					Expect(*rd.Status.RsyncTLS.Address).To(Equal("a.example.com"))
+				})
+			})
 		})
 
 		//nolint:dupl
@@ -69,6 +69,60 @@ var _ = Describe("Syncthing connection", func() {
 					Expect(syncthing.SystemConnections.Total.At).To(Equal("test"))
 				})
 
+				When("remote devices are configured", func() {
+					var device2, _ = protocol.DeviceIDFromString(
+						"AIR6LPZ-7K4PTTV-UXQSMUU-CPQ5YWH-OEDFIIQ-JUG777G-2YQXXR5-YD6AWQR",
+					)
+
+					BeforeEach(func() {
+						serverState.Configuration.Devices = []config.DeviceConfiguration{
+							{DeviceID: myID},
+							{DeviceID: device2},
+						}
+						serverState.DeviceCompletion = map[string]DeviceCompletion{
+							device2.GoString(): {Completion: 50, NeedBytes: 100},
+						}
+					})
+
+					It("fetches the completion of the remote devices", func() {
+						syncthing, err := syncthingConnection.Fetch()
+						Expect(err).NotTo(HaveOccurred())
+						Expect(syncthing.DeviceCompletion).To(HaveLen(1))
+						Expect(syncthing.DeviceCompletion).To(HaveKey(device2.GoString()))
+						Expect(syncthing.DeviceCompletion[device2.GoString()].NeedBytes).To(Equal(int64(100)))
+						Expect(syncthing.DeviceCompletion[device2.GoString()].Completion).To(Equal(float64(50)))
+					})
+
+					When("the completion of a device can not be fetched", func() {
+						var device3, _ = protocol.DeviceIDFromString(
+							"MFZWI3D-BONSGYC-YLTMRWG-C43ENR5-QXGZDMM-FZWI3DP-BONSGYY-LTMRWAD",
+						)
+
+						BeforeEach(func() {
+							serverState.Configuration.Devices = append(serverState.Configuration.Devices,
+								config.DeviceConfiguration{DeviceID: device3})
+						})
+						JustBeforeEach(func() {
+							handler := ts.Config.Handler
+							ts.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+								if r.URL.Path == DBCompletionEndpoint && r.URL.Query().Get("device") == device3.GoString() {
+									http.Error(w, "device is offline", http.StatusInternalServerError)
+									return
+								}
+								handler.ServeHTTP(w, r)
+							})
+						})
+
+						It("still fetches the status and the completion of the other devices", func() {
+							syncthing, err := syncthingConnection.Fetch()
+							Expect(err).NotTo(HaveOccurred())
+							Expect(syncthing.SystemStatus.MyID).To(Equal(myID.GoString()))
+							Expect(syncthing.DeviceCompletion).To(HaveLen(1))
+							Expect(syncthing.DeviceCompletion).To(HaveKey(device2.GoString()))
+						})
+					})
+				})
+
 				It("updates the Syncthing Config", func() {
 					syncthing := &Syncthing{
 						Configuration: config.Configuration{
@@ -32,6 +32,7 @@ const (
 	SystemStatusEndpoint      = "/rest/system/status"
 	SystemConnectionsEndpoint = "/rest/system/connections"
 	ConfigEndpoint            = "/rest/config"
+	DBCompletionEndpoint      = "/rest/db/completion"
 )
 
 // Fetch Pulls all of Syncthing's latest information from the API and stores it
@@ -55,10 +56,28 @@ func (s *syncthingAPIConnection) Fetch() (*Syncthing, error) {
 		return nil, err
 	}
 
+	// get the sync completion of each remote device. A device whose completion
+	// is unavailable (e.g., it is offline) is left out rather than failing the
+	// whole fetch.
+	deviceCompletion := map[string]DeviceCompletion{}
+	for _, device := range conf.Devices {
+		deviceID := device.DeviceID.GoString()
+		if deviceID == systemStatus.MyID {
+			continue
+		}
+		completion, err := s.fetchDeviceCompletion(deviceID)
+		if err != nil {
+			s.logger.Error(err, "Failed to get the completion of device", "device", deviceID)
+			continue
+		}
+		deviceCompletion[deviceID] = *completion
+	}
+
 	return &Syncthing{
 		Configuration:     *conf,
 		SystemConnections: *systemConnections,
 		SystemStatus:      *systemStatus,
+		DeviceCompletion:  deviceCompletion,
 	}, nil
 }
 
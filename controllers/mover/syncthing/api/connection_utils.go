@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/go-logr/logr"
@@ -138,6 +139,23 @@ func (api *syncthingAPIConnection) fetchSystemConnections() (*SystemConnections,
 	return responseBody, nil
 }
 
+// fetchDeviceCompletion Fetches the sync completion of the given remote device
+// across all of the folders shared with it.
+func (api *syncthingAPIConnection) fetchDeviceCompletion(deviceID string) (*DeviceCompletion, error) {
+	responseBody := &DeviceCompletion{}
+	api.logger.Info("Fetching Syncthing device completion", "device", deviceID)
+	endpoint := DBCompletionEndpoint + "?device=" + url.QueryEscape(deviceID)
+	data, err := api.jsonRequest(endpoint, "GET", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(data, responseBody); err != nil {
+		return nil, err
+	}
+	return responseBody, nil
+}
+
 // checkResponse Returns an error if one exists in the response, or nil otherwise.
 // This function was extracted from the Syncthing repository
 // due to the overlapping functionality between our API access & the Syncthing CLI.
@@ -66,6 +66,17 @@ type ConnectionStats struct {
 	Type          string `json:"type"`
 }
 
+// DeviceCompletion Describes how far a remote device is from being in sync
+// with the local device, across all folders shared with it.
+type DeviceCompletion struct {
+	Completion  float64 `json:"completion"`
+	GlobalBytes int64   `json:"globalBytes"`
+	NeedBytes   int64   `json:"needBytes"`
+	NeedItems   int     `json:"needItems"`
+	NeedDeletes int     `json:"needDeletes"`
+	RemoteState string  `json:"remoteState"`
+}
+
 // SystemConnections Describes the devices which are connected to the Syncthing
 // device, in addition to statistics about the total traffic to and from this node.
 type SystemConnections struct {
@@ -95,9 +106,12 @@ type SyncthingConnection interface {
 
 // Syncthing Defines a Syncthing API object which contains a subset of the information
 // exposed through Syncthing's API. Namely, this struct exposes the configuration,
-// system status, and connections contained by the given object.
+// system status, and connections contained by the given object, along with the
+// sync completion of each of the remote devices.
 type Syncthing struct {
 	Configuration     config.Configuration
 	SystemConnections SystemConnections
 	SystemStatus      SystemStatus
+	// DeviceCompletion is keyed by the device ID
+	DeviceCompletion map[string]DeviceCompletion
 }
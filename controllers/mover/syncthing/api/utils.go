@@ -66,7 +66,7 @@ func (s *Syncthing) ShareFoldersWithDevices() {
 }
 
 // CreateSyncthingTestServer Returns a test server that mimics the Syncthing API by exposing
-// the endpoints for config, system status, and system connections.
+// the endpoints for config, system status, system connections, and device completion.
 // The server also accepts an API Key, which is used for authenticating between the client and server.
 //
 // The accepted arguments are pointers so that the state can be changed externally and the server
@@ -119,6 +119,11 @@ func CreateSyncthingTestServer(state *Syncthing, serverAPIKey string) *httptest.
 			resBytes, _ := json.Marshal(res)
 			fmt.Fprintln(w, string(resBytes))
 			return
+		case DBCompletionEndpoint:
+			res := state.DeviceCompletion[r.URL.Query().Get("device")]
+			resBytes, _ := json.Marshal(res)
+			fmt.Fprintln(w, string(resBytes))
+			return
 		default:
 			// the endpoint doesn't exist
 			http.Error(w, "the resource path doesn't exist", http.StatusNotFound)
@@ -121,6 +121,11 @@ func (rb *Builder) FromSource(client client.Client, logger logr.Logger,
 
 	syncthingLogger := logger.WithValues("method", "Syncthing")
 
+	containerImage, err := utils.MoverImage(rb.getSyncthingContainerImage(), source.Spec.Syncthing.MoverImage)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Mover{
 		client:              client,
 		logger:              syncthingLogger,
@@ -130,9 +135,10 @@ func (rb *Builder) FromSource(client client.Client, logger logr.Logger,
 		configCapacity:      source.Spec.Syncthing.ConfigCapacity,
 		configStorageClass:  source.Spec.Syncthing.ConfigStorageClassName,
 		configAccessModes:   source.Spec.Syncthing.ConfigAccessModes,
-		containerImage:      rb.getSyncthingContainerImage(),
+		containerImage:      containerImage,
 		peerList:            source.Spec.Syncthing.Peers,
 		paused:              source.Spec.Paused,
+		readOnlySource:      source.Spec.EnforceReadOnlySource,
 		dataPVCName:         &source.Spec.SourcePVC,
 		status:              source.Status.Syncthing,
 		serviceType:         serviceType,
@@ -140,6 +146,7 @@ func (rb *Builder) FromSource(client client.Client, logger logr.Logger,
 		apiConfig:           api.APIConfig{},
 		privileged:          privileged,
 		moverConfig:         source.Spec.Syncthing.MoverConfig,
+		rotateIdentity:      source.Spec.Syncthing.RotateIdentity,
 		// defer setting the VolumeHandler
 	}, nil
 }
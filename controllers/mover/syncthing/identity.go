@@ -0,0 +1,306 @@
+//go:build !disable_syncthing
+
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package syncthing
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/syncthing/syncthing/lib/protocol"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/mover"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+// The device identity (certificate and key) that VolSync manages for
+// Syncthing. The mover image copies it into the config directory at startup.
+const (
+	identityDirEnv       = "SYNCTHING_IDENTITY_DIR"
+	identityDirMountPath = "/identity"
+	identityVolumeName   = "syncthing-identity"
+	identityCertDataKey  = "cert.pem"
+	identityKeyDataKey   = "key.pem"
+	// identityLifetime is how long the device certificates are valid for
+	identityLifetime = 10 * 365 * 24 * time.Hour
+)
+
+const (
+	// rotateIdentityAnnotation records the spec.syncthing.rotateIdentity value
+	// that an identity Secret was generated for
+	rotateIdentityAnnotation = "volsync.backube/rotate-identity"
+	// previousIDAnnotation records the device ID that an identity replaced
+	previousIDAnnotation = "volsync.backube/previous-device-id"
+	// deviceIDAnnotation on the pod template restarts Syncthing when the
+	// device identity changes
+	deviceIDAnnotation = "volsync.backube/device-id"
+)
+
+// deviceIdentity is a Syncthing device certificate
+type deviceIdentity struct {
+	certPEM  []byte
+	keyPEM   []byte
+	id       string
+	notAfter time.Time
+}
+
+// generateDeviceIdentity creates a new self-signed Syncthing device
+// certificate. Like the ones generated by Syncthing, it uses an ECDSA key and
+// the common name "syncthing".
+func generateDeviceIdentity() (*deviceIdentity, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	serialNumber, err := GenerateRandomBytes(20)
+	if err != nil {
+		return nil, err
+	}
+	notBefore := time.Now().Add(-time.Minute)
+	template := &x509.Certificate{
+		SerialNumber: new(big.Int).SetBytes(serialNumber),
+		Subject: pkix.Name{
+			CommonName:         "syncthing",
+			Organization:       []string{OrganizationName},
+			OrganizationalUnit: []string{OrganizationUnit},
+		},
+		NotBefore:             notBefore,
+		NotAfter:              notBefore.Add(identityLifetime),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return &deviceIdentity{
+		certPEM:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPEM:   pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		id:       protocol.NewDeviceID(der).String(),
+		notAfter: template.NotAfter,
+	}, nil
+}
+
+// parseDeviceIdentity reads the device ID and expiry of the certificate in an
+// identity Secret
+func parseDeviceIdentity(secret *corev1.Secret) (*deviceIdentity, error) {
+	block, _ := pem.Decode(secret.Data[identityCertDataKey])
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("secret %s does not contain a PEM-encoded %s", secret.Name, identityCertDataKey)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	return &deviceIdentity{
+		certPEM:  secret.Data[identityCertDataKey],
+		keyPEM:   secret.Data[identityKeyDataKey],
+		id:       protocol.NewDeviceID(block.Bytes).String(),
+		notAfter: cert.NotAfter,
+	}, nil
+}
+
+func (m *Mover) identitySecretName() string {
+	return mover.VolSyncPrefix + m.owner.GetName() + "-identity"
+}
+
+func (m *Mover) configPVCName() string {
+	return mover.VolSyncPrefix + m.owner.GetName() + "-config"
+}
+
+// ensureIdentity ensures the device identity Secret exists when VolSync
+// manages the device certificate, and rotates it when requested via
+// spec.syncthing.rotateIdentity.
+//
+// ReplicationSources whose config volume predates VolSync-managed identities
+// keep the certificate that Syncthing generated in the config volume until
+// the first rotation. For those, nil is returned.
+func (m *Mover) ensureIdentity(ctx context.Context) (*corev1.Secret, error) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      m.identitySecretName(),
+			Namespace: m.owner.GetNamespace(),
+		},
+	}
+	err := m.client.Get(ctx, client.ObjectKeyFromObject(secret), secret)
+	if err != nil && !kerrors.IsNotFound(err) {
+		return nil, err
+	}
+	exists := err == nil
+	rotate := m.rotateIdentity != "" && m.rotateIdentity != secret.Annotations[rotateIdentityAnnotation]
+
+	if !exists && !rotate {
+		configPVC := &corev1.PersistentVolumeClaim{}
+		err := m.client.Get(ctx, client.ObjectKey{Name: m.configPVCName(), Namespace: m.owner.GetNamespace()},
+			configPVC)
+		if err == nil {
+			// The identity lives in the existing config volume
+			return nil, nil
+		} else if !kerrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	if !exists || rotate {
+		previousID := m.status.ID
+		if exists {
+			if current, err := parseDeviceIdentity(secret); err == nil {
+				previousID = current.id
+			}
+		}
+		if err := m.writeIdentity(ctx, secret, exists, previousID); err != nil {
+			return nil, err
+		}
+	}
+
+	identity, err := parseDeviceIdentity(secret)
+	if err != nil {
+		return nil, err
+	}
+	m.status.CertificateExpiry = &metav1.Time{Time: identity.notAfter}
+
+	// Finish a rotation that has not been reported yet
+	if trigger := secret.Annotations[rotateIdentityAnnotation]; trigger != "" && trigger != m.status.LastRotateIdentity {
+		previousID := secret.Annotations[previousIDAnnotation]
+		if err := m.updatePeerReferences(ctx, previousID, identity.id); err != nil {
+			return nil, err
+		}
+		m.status.LastRotateIdentity = trigger
+		m.status.PreviousID = previousID
+		m.status.LastRotationTime = ptr.To(metav1.Now())
+		m.eventRecorder.Eventf(m.owner, secret, corev1.EventTypeNormal,
+			volsyncv1alpha1.EvRIdentityRotated, volsyncv1alpha1.EvANone,
+			"Syncthing device ID changed from %s to %s", previousID, identity.id)
+	}
+	return secret, nil
+}
+
+// addIdentityVolume mounts the device identity Secret into the Syncthing
+// container. The device ID is recorded on the pod template so that Syncthing
+// is restarted when the identity is rotated.
+func (m *Mover) addIdentityVolume(template *corev1.PodTemplateSpec) {
+	if identity, err := parseDeviceIdentity(m.identitySecret); err == nil {
+		if template.Annotations == nil {
+			template.Annotations = map[string]string{}
+		}
+		template.Annotations[deviceIDAnnotation] = identity.id
+	}
+	podSpec := &template.Spec
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name: identityVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName:  m.identitySecret.Name,
+				DefaultMode: ptr.To[int32](0600),
+			},
+		},
+	})
+	container := &podSpec.Containers[0]
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      identityVolumeName,
+		MountPath: identityDirMountPath,
+		ReadOnly:  true,
+	})
+	container.Env = append(container.Env, corev1.EnvVar{Name: identityDirEnv, Value: identityDirMountPath})
+}
+
+// writeIdentity generates a new device identity into the Secret
+func (m *Mover) writeIdentity(ctx context.Context, secret *corev1.Secret, exists bool, previousID string) error {
+	identity, err := generateDeviceIdentity()
+	if err != nil {
+		return err
+	}
+	m.logger.Info("generated Syncthing device identity", "deviceID", identity.id, "previousID", previousID)
+
+	secret.Type = corev1.SecretTypeOpaque
+	secret.Data = map[string][]byte{
+		identityCertDataKey: identity.certPEM,
+		identityKeyDataKey:  identity.keyPEM,
+	}
+	if m.rotateIdentity != "" {
+		if secret.Annotations == nil {
+			secret.Annotations = map[string]string{}
+		}
+		secret.Annotations[rotateIdentityAnnotation] = m.rotateIdentity
+		secret.Annotations[previousIDAnnotation] = previousID
+	}
+	if exists {
+		return m.client.Update(ctx, secret)
+	}
+	if err := ctrl.SetControllerReference(m.owner, secret, m.client.Scheme()); err != nil {
+		m.logger.Error(err, utils.ErrUnableToSetControllerRef)
+		return err
+	}
+	utils.SetOwnedByVolSync(secret)
+	return m.client.Create(ctx, secret)
+}
+
+// updatePeerReferences replaces the previous device ID in the peer lists of
+// the Syncthing ReplicationSources in this cluster, so that they keep
+// connecting to this device after its identity has been rotated
+func (m *Mover) updatePeerReferences(ctx context.Context, previousID string, newID string) error {
+	if previousID == "" || previousID == newID {
+		return nil
+	}
+	rsList := &volsyncv1alpha1.ReplicationSourceList{}
+	if err := m.client.List(ctx, rsList); err != nil {
+		return err
+	}
+	for i := range rsList.Items {
+		rs := &rsList.Items[i]
+		if rs.Spec.Syncthing == nil || rs.UID == m.owner.GetUID() {
+			continue
+		}
+		changed := false
+		for j := range rs.Spec.Syncthing.Peers {
+			if rs.Spec.Syncthing.Peers[j].ID == previousID {
+				rs.Spec.Syncthing.Peers[j].ID = newID
+				changed = true
+			}
+		}
+		if !changed {
+			continue
+		}
+		m.logger.Info("updating peer device ID", "replicationSource", client.ObjectKeyFromObject(rs))
+		if err := m.client.Update(ctx, rs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -113,6 +113,7 @@ type Mover struct {
 	configAccessModes   []corev1.PersistentVolumeAccessMode
 	containerImage      string
 	paused              bool
+	readOnlySource      bool
 	dataPVCName         *string
 	peerList            []volsyncv1alpha1.SyncthingPeer
 	status              *volsyncv1alpha1.ReplicationSourceSyncthingStatus
@@ -121,6 +122,9 @@ type Mover struct {
 	apiConfig           api.APIConfig
 	privileged          bool
 	moverConfig         volsyncv1alpha1.MoverConfig
+	rotateIdentity      string
+	// identitySecret holds the device identity when it is managed by VolSync
+	identitySecret *corev1.Secret
 }
 
 var _ mover.Mover = &Mover{}
@@ -149,6 +153,10 @@ func (m *Mover) Name() string { return syncthingMoverName }
 // with information about our local Syncthing instance, as well
 // as any connections that have been made to the Syncthing instance.
 func (m *Mover) Synchronize(ctx context.Context) (mover.Result, error) {
+	if m.readOnlySource {
+		return mover.InProgress(),
+			fmt.Errorf("enforceReadOnlySource is not supported since Syncthing requires write access to the source volume")
+	}
 	dataService, secretAPIKey, err := m.ensureNecessaryResources(ctx)
 	if err != nil {
 		return mover.InProgress(), err
@@ -171,6 +179,11 @@ func (m *Mover) ensureNecessaryResources(ctx context.Context) (*corev1.Service,
 		return nil, nil, err
 	}
 
+	// The identity must be checked before a new config PVC is created
+	if m.identitySecret, err = m.ensureIdentity(ctx); err != nil {
+		return nil, nil, err
+	}
+
 	configPVC, err := m.ensureConfigPVC(ctx, dataPVC)
 	if configPVC == nil || err != nil {
 		return nil, nil, err
@@ -279,7 +292,7 @@ func (m *Mover) ensureConfigPVC(
 	}
 
 	// Allocate the config volume
-	configName := mover.VolSyncPrefix + m.owner.GetName() + "-config"
+	configName := m.configPVCName()
 	m.logger.Info("allocating config volume", "PVC", configName)
 	return configVh.EnsureNewPVC(ctx, m.logger, configName, false /* this is NOT a temp pvc */)
 }
@@ -529,6 +542,15 @@ func (m *Mover) ensureDeployment(ctx context.Context, dataPVC *corev1.Persistent
 		// Update the deployment securityContext, podLabels and resourceRequirements from moverConfig (if specified)
 		utils.UpdatePodTemplateSpecFromMoverConfig(&deployment.Spec.Template, m.moverConfig, defaultMoverResources)
 
+		// Keep the mover off of nodes it cannot run on
+		if err := utils.SetMoverNodeAffinity(ctx, m.client, logger, &deployment.Spec.Template); err != nil {
+			return err
+		}
+		if err := utils.SetMoverApplicationAffinity(ctx, m.client, logger, &deployment.Spec.Template,
+			m.moverConfig.MoverApplicationAffinity, m.owner.GetNamespace(), m.dataPVCName); err != nil {
+			return err
+		}
+
 		if m.privileged {
 			podSpec.Containers[0].Env = append(podSpec.Containers[0].Env, corev1.EnvVar{
 				Name:  "PRIVILEGED_MOVER",
@@ -548,6 +570,13 @@ func (m *Mover) ensureDeployment(ctx context.Context, dataPVC *corev1.Persistent
 			})
 		}
 
+		if m.identitySecret != nil {
+			m.addIdentityVolume(&deployment.Spec.Template)
+		}
+
+		// Enforce the security profile last so that it is not overridden
+		utils.ApplySecurityProfile(&deployment.Spec.Template, m.owner)
+
 		return nil
 	})
 
@@ -818,13 +847,22 @@ func (m *Mover) getConnectedPeers(syncthing *api.Syncthing) []volsyncv1alpha1.Sy
 		deviceName := device.Name
 
 		// check connection status
-		connectedPeers = append(connectedPeers, volsyncv1alpha1.SyncthingPeerStatus{
-			ID:           deviceID,
-			Address:      tcpAddress,
-			Connected:    connectionInfo.Connected,
-			Name:         deviceName,
-			IntroducedBy: introducedBy.GoString(),
-		})
+		peerStatus := volsyncv1alpha1.SyncthingPeerStatus{
+			ID:            deviceID,
+			Address:       tcpAddress,
+			Connected:     connectionInfo.Connected,
+			Name:          deviceName,
+			IntroducedBy:  introducedBy.GoString(),
+			InBytesTotal:  int64(connectionInfo.InBytesTotal),
+			OutBytesTotal: int64(connectionInfo.OutBytesTotal),
+		}
+
+		// add how far along the peer is in syncing with us
+		if completion, ok := syncthing.DeviceCompletion[deviceID]; ok {
+			peerStatus.NeedBytes = completion.NeedBytes
+			peerStatus.Completion = completionPercent(completion.Completion)
+		}
+		connectedPeers = append(connectedPeers, peerStatus)
 	}
 	return connectedPeers
 }
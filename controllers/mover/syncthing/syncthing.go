@@ -21,6 +21,7 @@ package syncthing
 import (
 	"crypto/rand"
 	"fmt"
+	"math"
 	"regexp"
 
 	"github.com/backube/volsync/api/v1alpha1"
@@ -170,3 +171,11 @@ func asTCPAddress(address string) string {
 
 	return "tcp://" + address //nolint:goconst // goconst thinks tcp:// is used 4x
 }
+
+// completionPercent Converts the completion reported by Syncthing into a whole
+// percentage. The value is rounded down so that a peer is only reported as 100%
+// complete once it is fully in sync.
+func completionPercent(completion float64) *int32 {
+	percent := int32(math.Floor(math.Max(0, math.Min(100, completion))))
+	return &percent
+}
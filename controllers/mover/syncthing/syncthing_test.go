@@ -26,6 +26,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
 	cMover "github.com/backube/volsync/controllers/mover"
@@ -219,6 +220,100 @@ var _ = Describe("When an RS specifies Syncthing", func() {
 			Expect(name).To(Equal("syncthing"))
 		})
 
+		Context("the device identity is managed by VolSync", func() {
+			getIdentity := func() *corev1.Secret {
+				secret := &corev1.Secret{}
+				Expect(k8sClient.Get(ctx, types.NamespacedName{Name: mover.identitySecretName(), Namespace: ns.Name},
+					secret)).To(Succeed())
+				return secret
+			}
+
+			It("generates an identity for a new ReplicationSource", func() {
+				secret, err := mover.ensureIdentity(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(secret).NotTo(BeNil())
+				Expect(getIdentity().Data).To(HaveKey(identityCertDataKey))
+				Expect(mover.status.CertificateExpiry).NotTo(BeNil())
+				Expect(mover.status.LastRotateIdentity).To(BeEmpty())
+
+				// The identity is mounted into the Syncthing container
+				template := &corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "syncthing"}}},
+				}
+				mover.identitySecret = secret
+				mover.addIdentityVolume(template)
+				identity, err := parseDeviceIdentity(secret)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(template.Annotations).To(HaveKeyWithValue(deviceIDAnnotation, identity.id))
+				Expect(template.Spec.Volumes[0].Secret.SecretName).To(Equal(secret.Name))
+				Expect(template.Spec.Containers[0].Env).To(ContainElement(
+					corev1.EnvVar{Name: identityDirEnv, Value: identityDirMountPath}))
+			})
+
+			It("leaves the identity in an existing config volume alone", func() {
+				configPVC := &corev1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{Name: mover.configPVCName(), Namespace: ns.Name},
+					Spec: corev1.PersistentVolumeClaimSpec{
+						AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+						Resources: corev1.VolumeResourceRequirements{
+							Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+						},
+					},
+				}
+				Expect(k8sClient.Create(ctx, configPVC)).To(Succeed())
+
+				secret, err := mover.ensureIdentity(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(secret).To(BeNil())
+			})
+
+			When("the identity is rotated", func() {
+				var peerRS *volsyncv1alpha1.ReplicationSource
+				const oldID = "ZNWFSWE-RWRV2BD-45BLMCV-LTDE2UR-4LJDW6J-R5BPWEB-TXD27XJ-IZF5RA4"
+				BeforeEach(func() {
+					rs.Spec.Syncthing.RotateIdentity = "2026-10"
+				})
+				JustBeforeEach(func() {
+					peerRS = &volsyncv1alpha1.ReplicationSource{
+						ObjectMeta: metav1.ObjectMeta{GenerateName: "syncthing-peer-", Namespace: ns.Name},
+						Spec: volsyncv1alpha1.ReplicationSourceSpec{
+							SourcePVC: srcPVC.Name,
+							Paused:    true,
+							Syncthing: &volsyncv1alpha1.ReplicationSourceSyncthingSpec{
+								Peers: []volsyncv1alpha1.SyncthingPeer{
+									{ID: oldID, Address: "tcp://volsync-rs-data:22000"},
+								},
+							},
+						},
+					}
+					Expect(k8sClient.Create(ctx, peerRS)).To(Succeed())
+					mover.status.ID = oldID
+				})
+
+				It("replaces the device ID and updates peers in the cluster", func() {
+					secret, err := mover.ensureIdentity(ctx)
+					Expect(err).NotTo(HaveOccurred())
+					identity, err := parseDeviceIdentity(secret)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(identity.id).NotTo(Equal(oldID))
+					Expect(mover.status.LastRotateIdentity).To(Equal("2026-10"))
+					Expect(mover.status.PreviousID).To(Equal(oldID))
+					Expect(mover.status.LastRotationTime).NotTo(BeNil())
+
+					Expect(k8sClient.Get(ctx, types.NamespacedName{Name: peerRS.Name, Namespace: ns.Name},
+						peerRS)).To(Succeed())
+					Expect(peerRS.Spec.Syncthing.Peers[0].ID).To(Equal(identity.id))
+
+					// Nothing changes until the trigger changes again
+					secret, err = mover.ensureIdentity(ctx)
+					Expect(err).NotTo(HaveOccurred())
+					again, err := parseDeviceIdentity(secret)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(again.id).To(Equal(identity.id))
+				})
+			})
+		})
+
 		// test that the mover works with ClusterIP and LoadBalancer
 		Context("services are created properly", func() {
 			var svcType corev1.ServiceType
@@ -762,6 +857,16 @@ var _ = Describe("When an RS specifies Syncthing", func() {
 							device3.GoString(): {
 								Connected: true,
 								Address:   device3Config.Addresses[0],
+								TotalStats: api.TotalStats{
+									InBytesTotal:  1024,
+									OutBytesTotal: 4096,
+								},
+							},
+						}
+						syncthingState.DeviceCompletion = map[string]api.DeviceCompletion{
+							device3.GoString(): {
+								Completion: 99.95,
+								NeedBytes:  512,
 							},
 						}
 
@@ -807,6 +912,14 @@ var _ = Describe("When an RS specifies Syncthing", func() {
 						Expect(peer.Connected).To(BeTrue())
 						Expect(peer.IntroducedBy).To(Equal(device3Config.IntroducedBy.GoString()))
 						Expect(peer.Name).To(Equal(device3Config.Name))
+
+						// transfer statistics are reported for the peer
+						Expect(peer.InBytesTotal).To(Equal(int64(1024)))
+						Expect(peer.OutBytesTotal).To(Equal(int64(4096)))
+						Expect(peer.NeedBytes).To(Equal(int64(512)))
+						Expect(peer.Completion).NotTo(BeNil())
+						// not fully synced, so it shouldn't be rounded up to 100
+						Expect(*peer.Completion).To(Equal(int32(99)))
 					})
 				})
 
@@ -1843,6 +1956,24 @@ var _ = Describe("Syncthing utils", func() {
 		})
 
 	})
+	Context("device identities are generated", func() {
+		It("generates a certificate Syncthing can use", func() {
+			identity, err := generateDeviceIdentity()
+			Expect(err).ToNot(HaveOccurred())
+			cert, err := tls.X509KeyPair(identity.certPEM, identity.keyPEM)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(identity.id).To(Equal(protocol.NewDeviceID(cert.Certificate[0]).String()))
+			Expect(identity.notAfter).To(BeTemporally(">", time.Now().AddDate(9, 0, 0)))
+
+			parsed, err := parseDeviceIdentity(&corev1.Secret{Data: map[string][]byte{
+				identityCertDataKey: identity.certPEM,
+				identityKeyDataKey:  identity.keyPEM,
+			}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsed.id).To(Equal(identity.id))
+			Expect(parsed.notAfter).To(BeTemporally("~", identity.notAfter, time.Second))
+		})
+	})
 	Context("TLS Certificates are generated", func() {
 		It("generates them without fault", func() {
 			var apiAddress string = "my.real.api.address"
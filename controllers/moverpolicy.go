@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/mover"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+// ensureMoverAllowed returns mover.ErrMoverNotAllowed if the namespace does not
+// permit the use of the mover, setting the Synchronizing condition to explain
+// why.
+func ensureMoverAllowed(ctx context.Context, c client.Client, logger logr.Logger,
+	conditions *[]metav1.Condition, namespace string, moverName string) error {
+	allowed, err := utils.MoverAllowed(ctx, c, logger, namespace, moverName)
+	if err != nil {
+		return err
+	}
+	if allowed {
+		return nil
+	}
+
+	logger.Info("mover is not allowed in namespace", "mover", moverName,
+		"annotation", volsyncv1alpha1.AllowedMoversNamespaceAnnotation)
+	apimeta.SetStatusCondition(conditions, metav1.Condition{
+		Type:   volsyncv1alpha1.ConditionSynchronizing,
+		Status: metav1.ConditionFalse,
+		Reason: volsyncv1alpha1.SynchronizingReasonMoverNotAllowed,
+		Message: fmt.Sprintf("the %s mover is not allowed by the %s annotation of namespace %s",
+			moverName, volsyncv1alpha1.AllowedMoversNamespaceAnnotation, namespace),
+	})
+	return mover.ErrMoverNotAllowed
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+// applyNamespaceSuspend pauses the mover of a ReplicationSource or
+// ReplicationDestination while its namespace carries the suspend annotation,
+// and reports this in the Paused condition. The spec is only changed in
+// memory, so the object's own setting takes effect again once the annotation
+// is removed.
+func applyNamespaceSuspend(ctx context.Context, c client.Client, logger logr.Logger,
+	conditions *[]metav1.Condition, namespace string, paused *bool) error {
+	suspended, err := utils.NamespaceSuspended(ctx, c, logger, namespace)
+	if err != nil {
+		return err
+	}
+	if !suspended {
+		apimeta.RemoveStatusCondition(conditions, volsyncv1alpha1.ConditionPaused)
+		return nil
+	}
+
+	logger.V(1).Info("namespace is suspended", "annotation", volsyncv1alpha1.SuspendNamespaceAnnotation)
+	*paused = true
+	apimeta.SetStatusCondition(conditions, metav1.Condition{
+		Type:   volsyncv1alpha1.ConditionPaused,
+		Status: metav1.ConditionTrue,
+		Reason: volsyncv1alpha1.PausedReasonNamespaceSuspended,
+		Message: fmt.Sprintf("replication is suspended by the %s annotation of namespace %s",
+			volsyncv1alpha1.SuspendNamespaceAnnotation, namespace),
+	})
+	return nil
+}
+
+// mapFuncNamespaceToObjects returns a map function that enqueues all of the
+// objects of the list's type in a namespace
+func mapFuncNamespaceToObjects(k8sClient client.Client,
+	newList func() client.ObjectList) handler.MapFunc {
+	return func(ctx context.Context, o client.Object) []reconcile.Request {
+		list := newList()
+		if err := k8sClient.List(ctx, list, client.InNamespace(o.GetName())); err != nil {
+			return nil
+		}
+		objs, err := apimeta.ExtractList(list)
+		if err != nil {
+			return nil
+		}
+		reqs := []reconcile.Request{}
+		for _, obj := range objs {
+			if co, ok := obj.(client.Object); ok {
+				reqs = append(reqs, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(co)})
+			}
+		}
+		return reqs
+	}
+}
+
+// namespaceSuspendPredicate passes updates of a Namespace that change its
+// suspend annotation
+func namespaceSuspendPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(_ event.DeleteEvent) bool {
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			key := volsyncv1alpha1.SuspendNamespaceAnnotation
+			return e.ObjectOld.GetAnnotations()[key] != e.ObjectNew.GetAnnotations()[key]
+		},
+		GenericFunc: func(_ event.GenericEvent) bool {
+			return false
+		},
+	}
+}
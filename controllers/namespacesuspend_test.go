@@ -0,0 +1,79 @@
+package controllers
+
+import (
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+var _ = Describe("Namespace suspension", func() {
+	var namespace *corev1.Namespace
+	var conditions []metav1.Condition
+
+	BeforeEach(func() {
+		namespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "volsync-test-",
+			},
+		}
+		conditions = nil
+	})
+	JustBeforeEach(func() {
+		createWithCacheReload(ctx, k8sClient, namespace)
+	})
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, namespace)).To(Succeed())
+	})
+
+	It("leaves objects alone in a namespace that is not suspended", func() {
+		paused := false
+		Expect(applyNamespaceSuspend(ctx, k8sClient, logr.Discard(), &conditions, namespace.Name,
+			&paused)).To(Succeed())
+		Expect(paused).To(BeFalse())
+		Expect(apimeta.FindStatusCondition(conditions, volsyncv1alpha1.ConditionPaused)).To(BeNil())
+	})
+
+	When("the namespace is suspended", func() {
+		BeforeEach(func() {
+			namespace.Annotations = map[string]string{volsyncv1alpha1.SuspendNamespaceAnnotation: "true"}
+		})
+
+		It("pauses the mover and sets the Paused condition", func() {
+			paused := false
+			Expect(applyNamespaceSuspend(ctx, k8sClient, logr.Discard(), &conditions, namespace.Name,
+				&paused)).To(Succeed())
+			Expect(paused).To(BeTrue())
+			cond := apimeta.FindStatusCondition(conditions, volsyncv1alpha1.ConditionPaused)
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(volsyncv1alpha1.PausedReasonNamespaceSuspended))
+
+			// Resuming removes the condition
+			namespace.Annotations = nil
+			Expect(k8sClient.Update(ctx, namespace)).To(Succeed())
+			Eventually(func() []metav1.Condition {
+				paused = false
+				Expect(applyNamespaceSuspend(ctx, k8sClient, logr.Discard(), &conditions, namespace.Name,
+					&paused)).To(Succeed())
+				return conditions
+			}, maxWait, interval).Should(BeEmpty())
+			Expect(paused).To(BeFalse())
+		})
+	})
+
+	It("only reacts to changes of the suspend annotation", func() {
+		p := namespaceSuspendPredicate()
+		oldNs := &corev1.Namespace{}
+		newNs := oldNs.DeepCopy()
+		newNs.Annotations = map[string]string{"other": "x"}
+		Expect(p.Update(event.UpdateEvent{ObjectOld: oldNs, ObjectNew: newNs})).To(BeFalse())
+		newNs.Annotations[volsyncv1alpha1.SuspendNamespaceAnnotation] = "true"
+		Expect(p.Update(event.UpdateEvent{ObjectOld: oldNs, ObjectNew: newNs})).To(BeTrue())
+	})
+})
@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+const (
+	// Objects younger than this are never collected, so that resources
+	// created for a new owner are not mistaken for orphans while the owner
+	// is making its way into the cache
+	orphanMinAge = 10 * time.Minute
+)
+
+var (
+	// OrphanCollectionInterval is the time between sweeps of the
+	// OrphanCollector. A value of 0 disables it.
+	OrphanCollectionInterval time.Duration
+	// OrphanCollectionDryRun causes the OrphanCollector to only report the
+	// orphans that it finds
+	OrphanCollectionDryRun bool
+)
+
+// orphanKinds are the types of objects that VolSync creates for the
+// synchronizations of its owners
+var orphanKinds = []struct {
+	kind    string
+	newList func() client.ObjectList
+}{
+	{"Job", func() client.ObjectList { return &batchv1.JobList{} }},
+	{"PersistentVolumeClaim", func() client.ObjectList { return &corev1.PersistentVolumeClaimList{} }},
+	{"Secret", func() client.ObjectList { return &corev1.SecretList{} }},
+	{"Service", func() client.ObjectList { return &corev1.ServiceList{} }},
+	{"VolumeSnapshot", func() client.ObjectList { return &snapv1.VolumeSnapshotList{} }},
+}
+
+// OrphanCollector is a Runnable that periodically sweeps the cluster for
+// objects that were created by VolSync on behalf of a ReplicationSource,
+// ReplicationDestination, ReplicationPolicy, or RestoreFanout that no longer
+// exists, and deletes them. Such objects are normally removed by the
+// Kubernetes garbage collector, but are left behind when their owner
+// references are lost (e.g., when a namespace is restored from a backup).
+type OrphanCollector struct {
+	Client client.Client
+	// APIReader is used to look up the owners, bypassing the cache
+	APIReader client.Reader
+	Log       logr.Logger
+	Interval  time.Duration
+	DryRun    bool
+}
+
+var _ manager.Runnable = &OrphanCollector{}
+var _ manager.LeaderElectionRunnable = &OrphanCollector{}
+
+// NeedLeaderElection ensures only the active operator deletes objects
+func (g *OrphanCollector) NeedLeaderElection() bool { return true }
+
+// Start sweeps for orphans every Interval until the manager stops
+func (g *OrphanCollector) Start(ctx context.Context) error {
+	logger := g.Log.WithValues("interval", g.Interval, "dryRun", g.DryRun)
+	logger.Info("starting orphan collector")
+
+	ticker := time.NewTicker(g.Interval)
+	defer ticker.Stop()
+	for {
+		if err := g.sweep(ctx, time.Now()); err != nil {
+			logger.Error(err, "unable to sweep for orphaned objects")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// sweep deletes (or, in dry-run mode, reports) the orphaned objects of each
+// kind, updating the orphan metrics
+func (g *OrphanCollector) sweep(ctx context.Context, now time.Time) error {
+	owners, err := g.liveOwners(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, k := range orphanKinds {
+		logger := g.Log.WithValues("kind", k.kind)
+		list := k.newList()
+		if err := g.Client.List(ctx, list,
+			client.MatchingLabels{utils.OwnedByLabelKey: utils.OwnedByLabelValue}); err != nil {
+			logger.Error(err, "unable to list objects")
+			continue
+		}
+		objs, err := apimeta.ExtractList(list)
+		if err != nil {
+			return err
+		}
+
+		found := 0
+		for _, o := range objs {
+			obj, ok := o.(client.Object)
+			if !ok || !isOrphan(obj, owners, now) {
+				continue
+			}
+			found++
+			l := logger.WithValues("object", client.ObjectKeyFromObject(obj))
+			if g.DryRun {
+				l.Info("found orphaned object (dry run)")
+				continue
+			}
+			uid, rv := obj.GetUID(), obj.GetResourceVersion()
+			err := g.Client.Delete(ctx, obj,
+				client.Preconditions{UID: &uid, ResourceVersion: &rv},
+				client.PropagationPolicy(metav1.DeletePropagationBackground))
+			if client.IgnoreNotFound(err) != nil {
+				l.Error(err, "unable to delete orphaned object")
+				continue
+			}
+			l.Info("deleted orphaned object")
+			orphansDeleted.WithLabelValues(k.kind).Inc()
+		}
+		orphansFound.WithLabelValues(k.kind).Set(float64(found))
+	}
+	return nil
+}
+
+// liveOwners returns the UIDs of all objects that may own VolSync resources
+func (g *OrphanCollector) liveOwners(ctx context.Context) (map[types.UID]bool, error) {
+	owners := map[types.UID]bool{}
+	for _, list := range []client.ObjectList{
+		&volsyncv1alpha1.ReplicationSourceList{},
+		&volsyncv1alpha1.ReplicationDestinationList{},
+		&volsyncv1alpha1.ReplicationPolicyList{},
+		&volsyncv1alpha1.RestoreFanoutList{},
+	} {
+		if err := g.APIReader.List(ctx, list); err != nil {
+			return nil, err
+		}
+		objs, err := apimeta.ExtractList(list)
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range objs {
+			if obj, ok := o.(metav1.Object); ok {
+				owners[obj.GetUID()] = true
+			}
+		}
+	}
+	return owners, nil
+}
+
+// isOrphan returns true if obj belongs to VolSync owners, none of which exist.
+// Objects that do not identify their owner, that are shared with other owners,
+// that are marked do-not-delete, or that are being deleted are left alone.
+func isOrphan(obj client.Object, owners map[types.UID]bool, now time.Time) bool {
+	if !obj.GetDeletionTimestamp().IsZero() || utils.HasLabel(obj, utils.DoNotDeleteLabelKey) {
+		return false
+	}
+	if now.Sub(obj.GetCreationTimestamp().Time) < orphanMinAge {
+		return false
+	}
+	uids, volsyncOnly := utils.VolSyncOwnerUIDs(obj)
+	if !volsyncOnly || len(uids) == 0 {
+		return false
+	}
+	for _, uid := range uids {
+		if owners[uid] {
+			return false
+		}
+	}
+	return true
+}
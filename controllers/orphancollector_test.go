@@ -0,0 +1,75 @@
+package controllers
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("Orphan collector", func() {
+	var now time.Time
+	var pvc *corev1.PersistentVolumeClaim
+	var owners map[types.UID]bool
+
+	BeforeEach(func() {
+		now = time.Now()
+		owners = map[types.UID]bool{"live": true}
+		pvc = &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "volsync-src-cache",
+				Namespace:         "ns",
+				CreationTimestamp: metav1.NewTime(now.Add(-time.Hour)),
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: volsyncv1alpha1.GroupVersion.String(),
+					Kind:       "ReplicationSource",
+					Name:       "src",
+					UID:        "gone",
+				}},
+			},
+		}
+		utils.SetOwnedByVolSync(pvc)
+	})
+
+	It("finds objects whose owner no longer exists", func() {
+		Expect(isOrphan(pvc, owners, now)).To(BeTrue())
+	})
+
+	It("keeps objects whose owner exists", func() {
+		pvc.OwnerReferences[0].UID = "live"
+		Expect(isOrphan(pvc, owners, now)).To(BeFalse())
+	})
+
+	It("uses the cleanup labels when there are no owner references", func() {
+		pvc.OwnerReferences = nil
+		Expect(isOrphan(pvc, owners, now)).To(BeFalse())
+		utils.MarkForCleanup(&metav1.ObjectMeta{UID: "gone"}, pvc)
+		Expect(isOrphan(pvc, owners, now)).To(BeTrue())
+		utils.MarkForCleanup(&metav1.ObjectMeta{UID: "live"}, pvc)
+		Expect(isOrphan(pvc, owners, now)).To(BeFalse())
+	})
+
+	It("keeps objects that are also owned by something else", func() {
+		pvc.OwnerReferences = append(pvc.OwnerReferences, metav1.OwnerReference{
+			APIVersion: "v1",
+			Kind:       "PersistentVolumeClaim",
+			Name:       "other",
+			UID:        "other",
+		})
+		Expect(isOrphan(pvc, owners, now)).To(BeFalse())
+	})
+
+	It("keeps new and protected objects", func() {
+		pvc.CreationTimestamp = metav1.NewTime(now.Add(-time.Minute))
+		Expect(isOrphan(pvc, owners, now)).To(BeFalse())
+		pvc.CreationTimestamp = metav1.NewTime(now.Add(-time.Hour))
+		utils.AddLabel(pvc, utils.DoNotDeleteLabelKey, "true")
+		Expect(isOrphan(pvc, owners, now)).To(BeFalse())
+	})
+})
@@ -471,9 +471,16 @@ var _ = Describe("ReplicationDestination [rclone]", func() {
 					})
 
 					When("When a VolumeSnapshotClass is specified", func() {
-						vscName := "MyVolumeSnapshotClass"
+						vscName := "my-volume-snapshot-class"
 						BeforeEach(func() {
 							rd.Spec.Rclone.ReplicationDestinationVolumeOptions.VolumeSnapshotClassName = &vscName
+							// The class must exist for the snapshot to be created
+							vsc := &snapv1.VolumeSnapshotClass{
+								ObjectMeta:     metav1.ObjectMeta{Name: vscName},
+								Driver:         "test.csi.driver",
+								DeletionPolicy: snapv1.VolumeSnapshotContentDelete,
+							}
+							Expect(client.IgnoreAlreadyExists(k8sClient.Create(ctx, vsc))).To(Succeed())
 						})
 
 						It("is used as the VSC for the Snapshot", func() {
@@ -0,0 +1,298 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+// How often the remote cluster is checked, if not specified
+const defaultCredentialSyncRefreshInterval = 5 * time.Minute
+
+//nolint:lll
+//+kubebuilder:rbac:groups=volsync.backube,resources=replicationcredentialsyncs,verbs=get;list;watch
+//+kubebuilder:rbac:groups=volsync.backube,resources=replicationcredentialsyncs/finalizers,verbs=update
+//+kubebuilder:rbac:groups=volsync.backube,resources=replicationcredentialsyncs/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=volsync.backube,resources=replicationsources,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+
+// ReplicationCredentialSyncReconciler reconciles a ReplicationCredentialSync
+// object, copying the keys and address of a ReplicationDestination in another
+// cluster into the namespace of the ReplicationCredentialSync.
+type ReplicationCredentialSyncReconciler struct {
+	client.Client
+	Log           logr.Logger
+	Scheme        *runtime.Scheme
+	EventRecorder record.EventRecorder
+	// RemoteClient connects to the remote cluster. Defaults to
+	// utils.NewRemoteClient.
+	RemoteClient utils.RemoteClientFunc
+}
+
+// remoteDestination is the connection information published by a
+// ReplicationDestination
+type remoteDestination struct {
+	keySecret string
+	address   *string
+	port      *int32
+}
+
+func (r *ReplicationCredentialSyncReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := r.Log.WithValues("replicationcredentialsync", req.NamespacedName)
+	cs := &volsyncv1alpha1.ReplicationCredentialSync{}
+	if err := r.Client.Get(ctx, req.NamespacedName, cs); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if cs.Spec.Paused {
+		return ctrl.Result{}, nil
+	}
+
+	if cs.Status == nil {
+		cs.Status = &volsyncv1alpha1.ReplicationCredentialSyncStatus{}
+	}
+
+	reconcileErr := r.syncCredentials(ctx, logger, cs)
+	if reconcileErr != nil {
+		logger.Error(reconcileErr, "unable to synchronize credentials")
+		r.EventRecorder.Eventf(cs, corev1.EventTypeWarning, volsyncv1alpha1.EvRCredentialsSyncFailed,
+			"unable to synchronize credentials: %s", reconcileErr)
+	}
+
+	if err := r.Client.Status().Update(ctx, cs); err != nil {
+		logger.Error(err, "failed to update status")
+		return ctrl.Result{}, err
+	}
+	// Errors are retried at the refresh interval, the remote cluster will
+	// not generate events that trigger a reconcile
+	return ctrl.Result{RequeueAfter: credentialSyncRefreshInterval(cs)}, nil
+}
+
+// syncCredentials copies the key Secret of the remote ReplicationDestination
+// and updates the ReplicationSource, recording the outcome in the conditions
+func (r *ReplicationCredentialSyncReconciler) syncCredentials(ctx context.Context, logger logr.Logger,
+	cs *volsyncv1alpha1.ReplicationCredentialSync) error {
+	cs.Status.LastCheckTime = ptr.To(metav1.Now())
+
+	newRemoteClient := r.RemoteClient
+	if newRemoteClient == nil {
+		newRemoteClient = utils.NewRemoteClient
+	}
+	remote, err := newRemoteClient(ctx, r.Client, logger, cs.Namespace, cs.Spec.KubeconfigSecretRef)
+	if err != nil {
+		setCredentialSyncCondition(cs, metav1.ConditionFalse, volsyncv1alpha1.CredentialsSyncReasonRemoteUnreachable,
+			err.Error())
+		return err
+	}
+
+	rdRef := cs.Spec.ReplicationDestination
+	rd := &volsyncv1alpha1.ReplicationDestination{}
+	if err := remote.Get(ctx, client.ObjectKey{Namespace: rdRef.Namespace, Name: rdRef.Name}, rd); err != nil {
+		setCredentialSyncCondition(cs, metav1.ConditionFalse, volsyncv1alpha1.CredentialsSyncReasonRemoteUnreachable,
+			err.Error())
+		return err
+	}
+	dest, err := destinationConnectionInfo(rd)
+	if err != nil {
+		setCredentialSyncCondition(cs, metav1.ConditionFalse, volsyncv1alpha1.CredentialsSyncReasonError, err.Error())
+		return err
+	}
+	if dest == nil {
+		setCredentialSyncCondition(cs, metav1.ConditionFalse, volsyncv1alpha1.CredentialsSyncReasonWaitingForDestination,
+			fmt.Sprintf("Waiting for ReplicationDestination %s/%s to publish its keys and address",
+				rdRef.Namespace, rdRef.Name))
+		return nil
+	}
+
+	remoteSecret := &corev1.Secret{}
+	if err := remote.Get(ctx, client.ObjectKey{Namespace: rdRef.Namespace, Name: dest.keySecret}, remoteSecret); err != nil {
+		setCredentialSyncCondition(cs, metav1.ConditionFalse, volsyncv1alpha1.CredentialsSyncReasonRemoteUnreachable,
+			err.Error())
+		return err
+	}
+
+	secretChanged, err := r.ensureKeySecret(ctx, cs, remoteSecret)
+	if err != nil {
+		setCredentialSyncCondition(cs, metav1.ConditionFalse, volsyncv1alpha1.CredentialsSyncReasonError, err.Error())
+		return err
+	}
+	addressChanged := !reflect.DeepEqual(cs.Status.Address, dest.address) || !reflect.DeepEqual(cs.Status.Port, dest.port)
+	cs.Status.KeySecretName = ptr.To(credentialSyncKeySecretName(cs))
+	cs.Status.Address = dest.address
+	cs.Status.Port = dest.port
+
+	if cs.Spec.ReplicationSource != nil {
+		if err := r.updateReplicationSource(ctx, logger, cs, rd); err != nil {
+			setCredentialSyncCondition(cs, metav1.ConditionFalse, volsyncv1alpha1.CredentialsSyncReasonError,
+				err.Error())
+			return err
+		}
+	}
+
+	if secretChanged || addressChanged {
+		cs.Status.LastUpdateTime = ptr.To(metav1.Now())
+		logger.Info("credentials updated", "address", ptr.Deref(dest.address, ""))
+		r.EventRecorder.Eventf(cs, corev1.EventTypeNormal, volsyncv1alpha1.EvRCredentialsUpdated,
+			"updated the keys and address of ReplicationDestination %s/%s", rdRef.Namespace, rdRef.Name)
+	}
+	setCredentialSyncCondition(cs, metav1.ConditionTrue, volsyncv1alpha1.CredentialsSyncReasonSuccess,
+		fmt.Sprintf("Credentials of ReplicationDestination %s/%s are up to date", rdRef.Namespace, rdRef.Name))
+	return nil
+}
+
+// destinationConnectionInfo returns the key Secret and address published in
+// the status of an rsync-tls or rsync ReplicationDestination, or nil if they
+// have not been published yet
+func destinationConnectionInfo(rd *volsyncv1alpha1.ReplicationDestination) (*remoteDestination, error) {
+	var dest *remoteDestination
+	switch {
+	case rd.Spec.RsyncTLS != nil:
+		if rd.Status != nil && rd.Status.RsyncTLS != nil && rd.Status.RsyncTLS.KeySecret != nil {
+			dest = &remoteDestination{
+				keySecret: *rd.Status.RsyncTLS.KeySecret,
+				address:   rd.Status.RsyncTLS.Address,
+				port:      rd.Status.RsyncTLS.Port,
+			}
+		}
+	case rd.Spec.Rsync != nil:
+		if rd.Status != nil && rd.Status.Rsync != nil && rd.Status.Rsync.SSHKeys != nil {
+			dest = &remoteDestination{
+				keySecret: *rd.Status.Rsync.SSHKeys,
+				address:   rd.Status.Rsync.Address,
+				port:      rd.Status.Rsync.Port,
+			}
+		}
+	default:
+		return nil, fmt.Errorf("ReplicationDestination %s/%s does not use the rsyncTLS or rsync mover",
+			rd.Namespace, rd.Name)
+	}
+	if dest == nil || dest.address == nil {
+		return nil, nil
+	}
+	return dest, nil
+}
+
+// ensureKeySecret copies the data of the remote key Secret into the local key
+// Secret. It returns true if the local Secret was created or changed.
+func (r *ReplicationCredentialSyncReconciler) ensureKeySecret(ctx context.Context,
+	cs *volsyncv1alpha1.ReplicationCredentialSync, remoteSecret *corev1.Secret) (bool, error) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: credentialSyncKeySecretName(cs), Namespace: cs.Namespace},
+	}
+	op, err := ctrlutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		if secret.GetUID() != "" && !metav1.IsControlledBy(secret, cs) {
+			return fmt.Errorf("Secret %s already exists and is not managed by ReplicationCredentialSync %s",
+				secret.Name, cs.Name)
+		}
+		if err := ctrl.SetControllerReference(cs, secret, r.Client.Scheme()); err != nil {
+			return err
+		}
+		secret.Data = map[string][]byte{}
+		for k, v := range remoteSecret.Data {
+			secret.Data[k] = v
+		}
+		return nil
+	})
+	return op != ctrlutil.OperationResultNone, err
+}
+
+// updateReplicationSource points the ReplicationSource at the destination,
+// using the copied keys
+func (r *ReplicationCredentialSyncReconciler) updateReplicationSource(ctx context.Context, logger logr.Logger,
+	cs *volsyncv1alpha1.ReplicationCredentialSync, rd *volsyncv1alpha1.ReplicationDestination) error {
+	rs := &volsyncv1alpha1.ReplicationSource{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: cs.Namespace, Name: *cs.Spec.ReplicationSource},
+		rs); err != nil {
+		return err
+	}
+	original := rs.Spec.DeepCopy()
+
+	keySecret := cs.Status.KeySecretName
+	switch {
+	case rd.Spec.RsyncTLS != nil && rs.Spec.RsyncTLS != nil:
+		rs.Spec.RsyncTLS.KeySecret = keySecret
+		rs.Spec.RsyncTLS.Address = cs.Status.Address
+		rs.Spec.RsyncTLS.Port = cs.Status.Port
+	case rd.Spec.Rsync != nil && rs.Spec.Rsync != nil:
+		rs.Spec.Rsync.SSHKeys = keySecret
+		rs.Spec.Rsync.Address = cs.Status.Address
+		rs.Spec.Rsync.Port = cs.Status.Port
+	default:
+		return fmt.Errorf("ReplicationSource %s does not use the same mover as ReplicationDestination %s/%s",
+			rs.Name, rd.Namespace, rd.Name)
+	}
+
+	if reflect.DeepEqual(original, &rs.Spec) {
+		return nil
+	}
+	logger.Info("updating ReplicationSource", "replicationsource", rs.Name)
+	return r.Client.Update(ctx, rs)
+}
+
+func credentialSyncKeySecretName(cs *volsyncv1alpha1.ReplicationCredentialSync) string {
+	if cs.Spec.KeySecretName != nil {
+		return *cs.Spec.KeySecretName
+	}
+	return cs.Name
+}
+
+func credentialSyncRefreshInterval(cs *volsyncv1alpha1.ReplicationCredentialSync) time.Duration {
+	if cs.Spec.RefreshInterval != nil && cs.Spec.RefreshInterval.Duration > 0 {
+		return cs.Spec.RefreshInterval.Duration
+	}
+	return defaultCredentialSyncRefreshInterval
+}
+
+func setCredentialSyncCondition(cs *volsyncv1alpha1.ReplicationCredentialSync, status metav1.ConditionStatus,
+	reason string, message string) {
+	apimeta.SetStatusCondition(&cs.Status.Conditions, metav1.Condition{
+		Type:    volsyncv1alpha1.ConditionCredentialsSynchronized,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	})
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ReplicationCredentialSyncReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// Status updates (lastCheckTime changes on every reconcile) must not
+		// trigger another reconcile
+		For(&volsyncv1alpha1.ReplicationCredentialSync{},
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Owns(&corev1.Secret{}).
+		Complete(r)
+}
@@ -0,0 +1,179 @@
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+var _ = Describe("ReplicationCredentialSync", func() {
+	var namespace *corev1.Namespace
+	var remoteNamespace *corev1.Namespace
+	var rd *volsyncv1alpha1.ReplicationDestination
+	var rs *volsyncv1alpha1.ReplicationSource
+	var cs *volsyncv1alpha1.ReplicationCredentialSync
+	var r *ReplicationCredentialSyncReconciler
+
+	reconcile := func() *volsyncv1alpha1.ReplicationCredentialSync {
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(cs)})
+		Expect(err).NotTo(HaveOccurred())
+		updated := &volsyncv1alpha1.ReplicationCredentialSync{}
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(cs), updated)).To(Succeed())
+		return updated
+	}
+	reason := func(cs *volsyncv1alpha1.ReplicationCredentialSync) string {
+		if cs.Status == nil {
+			return ""
+		}
+		cond := apimeta.FindStatusCondition(cs.Status.Conditions, volsyncv1alpha1.ConditionCredentialsSynchronized)
+		if cond == nil {
+			return ""
+		}
+		return cond.Reason
+	}
+	// publish sets the status that the rsync-tls mover sets on the
+	// destination
+	publish := func(address string) {
+		Eventually(func() error {
+			if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(rd), rd); err != nil {
+				return err
+			}
+			if rd.Status == nil {
+				rd.Status = &volsyncv1alpha1.ReplicationDestinationStatus{}
+			}
+			rd.Status.RsyncTLS = &volsyncv1alpha1.ReplicationDestinationRsyncTLSStatus{
+				KeySecret: ptr.To("remote-psk"),
+				Address:   ptr.To(address),
+				Port:      ptr.To[int32](8000),
+			}
+			return k8sClient.Status().Update(ctx, rd)
+		}, maxWait, interval).Should(Succeed())
+	}
+
+	BeforeEach(func() {
+		namespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{GenerateName: "volsync-test-"},
+		}
+		createWithCacheReload(ctx, k8sClient, namespace)
+		remoteNamespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{GenerateName: "volsync-test-remote-"},
+		}
+		createWithCacheReload(ctx, k8sClient, remoteNamespace)
+
+		// The "remote" cluster is the test cluster itself
+		rd = &volsyncv1alpha1.ReplicationDestination{
+			ObjectMeta: metav1.ObjectMeta{Name: "dest", Namespace: remoteNamespace.Name},
+			Spec: volsyncv1alpha1.ReplicationDestinationSpec{
+				// Keep the mover from running
+				Paused:   true,
+				RsyncTLS: &volsyncv1alpha1.ReplicationDestinationRsyncTLSSpec{},
+			},
+		}
+		createWithCacheReload(ctx, k8sClient, rd)
+		createWithCacheReload(ctx, k8sClient, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "remote-psk", Namespace: remoteNamespace.Name},
+			StringData: map[string]string{"psk.txt": "volsync:0123456789abcdef"},
+		})
+
+		rs = &volsyncv1alpha1.ReplicationSource{
+			ObjectMeta: metav1.ObjectMeta{Name: "source", Namespace: namespace.Name},
+			Spec: volsyncv1alpha1.ReplicationSourceSpec{
+				SourcePVC: "data",
+				Paused:    true,
+				RsyncTLS:  &volsyncv1alpha1.ReplicationSourceRsyncTLSSpec{},
+			},
+		}
+		createWithCacheReload(ctx, k8sClient, rs)
+
+		cs = &volsyncv1alpha1.ReplicationCredentialSync{
+			ObjectMeta: metav1.ObjectMeta{Name: "site-b", Namespace: namespace.Name},
+			Spec: volsyncv1alpha1.ReplicationCredentialSyncSpec{
+				KubeconfigSecretRef: volsyncv1alpha1.KubeconfigSecretRef{Name: "kubeconfig"},
+				ReplicationDestination: volsyncv1alpha1.RemoteObjectReference{
+					Namespace: remoteNamespace.Name,
+					Name:      rd.Name,
+				},
+				ReplicationSource: ptr.To(rs.Name),
+			},
+		}
+		createWithCacheReload(ctx, k8sClient, cs)
+
+		r = &ReplicationCredentialSyncReconciler{
+			Client:        k8sClient,
+			Log:           logr.Discard(),
+			Scheme:        k8sClient.Scheme(),
+			EventRecorder: record.NewFakeRecorder(10),
+			RemoteClient: func(context.Context, client.Client, logr.Logger, string,
+				volsyncv1alpha1.KubeconfigSecretRef) (client.Client, error) {
+				return k8sClient, nil
+			},
+		}
+	})
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, namespace)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, remoteNamespace)).To(Succeed())
+	})
+
+	It("waits for the destination to publish its address", func() {
+		Expect(reason(reconcile())).To(Equal(volsyncv1alpha1.CredentialsSyncReasonWaitingForDestination))
+	})
+
+	It("copies the keys and points the ReplicationSource at the destination", func() {
+		publish("10.0.0.1")
+		updated := reconcile()
+		Expect(reason(updated)).To(Equal(volsyncv1alpha1.CredentialsSyncReasonSuccess))
+		Expect(updated.Status.Address).To(Equal(ptr.To("10.0.0.1")))
+		Expect(updated.Status.KeySecretName).To(Equal(ptr.To(cs.Name)))
+		Expect(updated.Status.LastUpdateTime).NotTo(BeNil())
+
+		secret := &corev1.Secret{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: cs.Name, Namespace: namespace.Name}, secret)).To(Succeed())
+		Expect(secret.Data).To(HaveKeyWithValue("psk.txt", []byte("volsync:0123456789abcdef")))
+		Expect(metav1.IsControlledBy(secret, updated)).To(BeTrue())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(rs), rs)).To(Succeed())
+		Expect(rs.Spec.RsyncTLS.KeySecret).To(Equal(ptr.To(cs.Name)))
+		Expect(rs.Spec.RsyncTLS.Address).To(Equal(ptr.To("10.0.0.1")))
+		Expect(rs.Spec.RsyncTLS.Port).To(Equal(ptr.To[int32](8000)))
+	})
+
+	It("follows changes to the keys and address", func() {
+		publish("10.0.0.1")
+		first := reconcile().Status.LastUpdateTime
+		Expect(first).NotTo(BeNil())
+
+		remoteSecret := &corev1.Secret{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: "remote-psk", Namespace: remoteNamespace.Name},
+			remoteSecret)).To(Succeed())
+		remoteSecret.Data = map[string][]byte{"psk.txt": []byte("volsync:fedcba9876543210")}
+		Expect(k8sClient.Update(ctx, remoteSecret)).To(Succeed())
+		publish("10.0.0.2")
+		updated := reconcile()
+		Expect(updated.Status.Address).To(Equal(ptr.To("10.0.0.2")))
+
+		secret := &corev1.Secret{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: cs.Name, Namespace: namespace.Name}, secret)).To(Succeed())
+		Expect(secret.Data).To(HaveKeyWithValue("psk.txt", []byte("volsync:fedcba9876543210")))
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(rs), rs)).To(Succeed())
+		Expect(rs.Spec.RsyncTLS.Address).To(Equal(ptr.To("10.0.0.2")))
+	})
+
+	It("rejects a ReplicationSource that uses a different mover", func() {
+		publish("10.0.0.1")
+		rs.Spec.RsyncTLS = nil
+		rs.Spec.Rclone = &volsyncv1alpha1.ReplicationSourceRcloneSpec{}
+		Expect(k8sClient.Update(ctx, rs)).To(Succeed())
+		Expect(reason(reconcile())).To(Equal(volsyncv1alpha1.CredentialsSyncReasonError))
+	})
+})
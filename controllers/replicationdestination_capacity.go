@@ -0,0 +1,210 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+const (
+	defaultCapacityForecastHorizon  = 7 * 24 * time.Hour
+	defaultCapacityExpansionPercent = 25
+)
+
+// prepareCapacityForecast makes sure the capacity forecast status exists if
+// (and only if) forecasting is enabled, so that the mover records the usage of
+// the volume
+func prepareCapacityForecast(rd *volsyncv1alpha1.ReplicationDestination) {
+	if rd.Spec.CapacityForecast == nil {
+		rd.Status.CapacityForecast = nil
+		apimeta.RemoveStatusCondition(&rd.Status.Conditions, volsyncv1alpha1.ConditionCapacityForecast)
+		return
+	}
+	if rd.Status.CapacityForecast == nil {
+		rd.Status.CapacityForecast = &volsyncv1alpha1.CapacityForecastStatus{}
+	}
+}
+
+// projectCapacity fits a line through the usage samples, returning the growth
+// of the used space in bytes/day and when the volume will be full (nil if the
+// usage is not growing). Both are nil if there are too few samples.
+func projectCapacity(samples []volsyncv1alpha1.CapacitySample) (*int64, *time.Time) {
+	if len(samples) < 2 {
+		return nil, nil
+	}
+	// Least squares fit of used bytes over time (in seconds since the first
+	// sample)
+	start := samples[0].Time.Time
+	var sumX, sumY, sumXY, sumXX float64
+	for _, s := range samples {
+		x := s.Time.Sub(start).Seconds()
+		y := float64(s.UsedBytes)
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+	n := float64(len(samples))
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		// All samples were taken at the same time
+		return nil, nil
+	}
+	slope := (n*sumXY - sumX*sumY) / denominator // bytes/second
+	growth := int64(math.Round(slope * 24 * 60 * 60))
+	if slope <= 0 {
+		return &growth, nil
+	}
+
+	last := samples[len(samples)-1]
+	remaining := float64(last.CapacityBytes - last.UsedBytes)
+	full := last.Time.Add(time.Duration(math.Max(remaining, 0) / slope * float64(time.Second)))
+	return &growth, &full
+}
+
+// forecastCapacity updates the capacity forecast from the usage samples
+// recorded by the mover and, if the destination volume is projected to be full
+// within the horizon, expands it or raises the CapacityForecast condition.
+func (m *rdMachine) forecastCapacity(ctx context.Context) error {
+	spec := m.rd.Spec.CapacityForecast
+	status := m.rd.Status.CapacityForecast
+	if spec == nil || status == nil {
+		return nil
+	}
+
+	growth, full := projectCapacity(status.Samples)
+	status.GrowthBytesPerDay = growth
+	status.ProjectedFullTime = nil
+	if full != nil {
+		status.ProjectedFullTime = &metav1.Time{Time: *full}
+	}
+
+	switch {
+	case growth == nil:
+		m.setCapacityCondition(metav1.ConditionFalse, volsyncv1alpha1.CapacityForecastReasonInsufficientData,
+			"Waiting for more usage samples from the mover")
+		return nil
+	case full == nil:
+		m.setCapacityCondition(metav1.ConditionFalse, volsyncv1alpha1.CapacityForecastReasonSufficient,
+			"The usage of the destination volume is not growing")
+		return nil
+	}
+
+	horizon := defaultCapacityForecastHorizon
+	if spec.Horizon != nil {
+		horizon = spec.Horizon.Duration
+	}
+	if full.After(time.Now().Add(horizon)) {
+		m.setCapacityCondition(metav1.ConditionFalse, volsyncv1alpha1.CapacityForecastReasonSufficient,
+			fmt.Sprintf("The destination volume is projected to be full at %s",
+				full.UTC().Format(time.RFC3339)))
+		return nil
+	}
+
+	reason := "autoExpand is not enabled"
+	if spec.AutoExpand {
+		var expanded bool
+		var err error
+		expanded, reason, err = m.expandDestinationPVC(ctx, spec, status)
+		if err != nil || expanded {
+			return err
+		}
+	}
+	message := fmt.Sprintf("The destination volume is projected to be full at %s (%s)",
+		full.UTC().Format(time.RFC3339), reason)
+	cond := apimeta.FindStatusCondition(m.rd.Status.Conditions, volsyncv1alpha1.ConditionCapacityForecast)
+	if cond == nil || cond.Status != metav1.ConditionTrue {
+		m.eventRecorder.Eventf(m.rd, nil, corev1.EventTypeWarning, volsyncv1alpha1.EvRCapacityExhaustion,
+			volsyncv1alpha1.EvANone, "%s", message)
+	}
+	m.setCapacityCondition(metav1.ConditionTrue, volsyncv1alpha1.CapacityForecastReasonExhaustion, message)
+	return nil
+}
+
+// expandDestinationPVC grows the destination PVC by the expansion percentage.
+// It returns whether the PVC was expanded and, if not, why.
+func (m *rdMachine) expandDestinationPVC(ctx context.Context, spec *volsyncv1alpha1.CapacityForecastSpec,
+	status *volsyncv1alpha1.CapacityForecastStatus) (bool, string, error) {
+	last := status.Samples[len(status.Samples)-1]
+	if status.LastExpansionTime != nil && !status.LastExpansionTime.Before(&last.Time) {
+		// The usage has not been measured since the last expansion
+		return false, "waiting for the previous expansion to be measured", nil
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := m.client.Get(ctx, client.ObjectKey{Namespace: m.rd.Namespace, Name: status.PVCName}, pvc); err != nil {
+		return false, "", client.IgnoreNotFound(err)
+	}
+	if pvc.Spec.StorageClassName == nil {
+		return false, "the PVC has no StorageClass", nil
+	}
+	sc := &storagev1.StorageClass{}
+	if err := m.client.Get(ctx, client.ObjectKey{Name: *pvc.Spec.StorageClassName}, sc); err != nil {
+		return false, "", client.IgnoreNotFound(err)
+	}
+	if !ptr.Deref(sc.AllowVolumeExpansion, false) {
+		return false, "the StorageClass does not allow volume expansion", nil
+	}
+
+	current := pvc.Spec.Resources.Requests.Storage()
+	percent := int64(ptr.Deref(spec.ExpansionPercent, defaultCapacityExpansionPercent))
+	newSize := resource.NewQuantity(current.Value()+current.Value()*percent/100, resource.BinarySI)
+	if spec.MaxCapacity != nil && newSize.Cmp(*spec.MaxCapacity) > 0 {
+		newSize = spec.MaxCapacity
+	}
+	if newSize.Cmp(*current) <= 0 {
+		return false, "the PVC is at maxCapacity", nil
+	}
+
+	m.logger.Info("expanding destination PVC", "pvc", pvc.Name, "from", current.String(), "to", newSize.String())
+	patch := client.MergeFrom(pvc.DeepCopy())
+	pvc.Spec.Resources.Requests[corev1.ResourceStorage] = *newSize
+	if err := m.client.Patch(ctx, pvc, patch); err != nil {
+		return false, "", err
+	}
+	status.LastExpansionTime = ptr.To(metav1.Now())
+
+	message := fmt.Sprintf("Expanded PVC %s from %s to %s ahead of projected exhaustion",
+		pvc.Name, current.String(), newSize.String())
+	m.eventRecorder.Eventf(m.rd, pvc, corev1.EventTypeNormal, volsyncv1alpha1.EvRPVCExpanded,
+		volsyncv1alpha1.EvAExpandPVC, "%s", message)
+	m.setCapacityCondition(metav1.ConditionFalse, volsyncv1alpha1.CapacityForecastReasonExpanded, message)
+	return true, "", nil
+}
+
+func (m *rdMachine) setCapacityCondition(status metav1.ConditionStatus, reason, message string) {
+	apimeta.SetStatusCondition(&m.rd.Status.Conditions, metav1.Condition{
+		Type:    volsyncv1alpha1.ConditionCapacityForecast,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	})
+}
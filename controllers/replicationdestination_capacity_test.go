@@ -0,0 +1,161 @@
+package controllers
+
+import (
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+const gib = 1024 * 1024 * 1024
+
+// usageSamples returns one sample per day, the last one taken now, with the
+// given used GiB of a 10GiB volume
+func usageSamples(usedGiB ...int64) []volsyncv1alpha1.CapacitySample {
+	now := time.Now()
+	samples := []volsyncv1alpha1.CapacitySample{}
+	for i, used := range usedGiB {
+		samples = append(samples, volsyncv1alpha1.CapacitySample{
+			Time:          metav1.NewTime(now.Add(time.Duration(i-len(usedGiB)+1) * 24 * time.Hour)),
+			UsedBytes:     used * gib,
+			CapacityBytes: 10 * gib,
+		})
+	}
+	return samples
+}
+
+var _ = Describe("Capacity forecasting", func() {
+	Describe("projectCapacity", func() {
+		It("needs at least two samples", func() {
+			growth, full := projectCapacity(usageSamples(5))
+			Expect(growth).To(BeNil())
+			Expect(full).To(BeNil())
+		})
+
+		It("does not project a full date if the usage is not growing", func() {
+			growth, full := projectCapacity(usageSamples(5, 5, 4))
+			Expect(growth).NotTo(BeNil())
+			Expect(*growth).To(BeNumerically("<", 0))
+			Expect(full).To(BeNil())
+		})
+
+		It("projects when the volume will be full", func() {
+			growth, full := projectCapacity(usageSamples(4, 5, 6))
+			Expect(*growth).To(BeNumerically("~", gib, 1024))
+			// 4GiB remaining at 1GiB/day
+			Expect(*full).To(BeTemporally("~", time.Now().Add(4*24*time.Hour), time.Minute))
+		})
+	})
+
+	Describe("forecastCapacity", func() {
+		var namespace *corev1.Namespace
+		var sc *storagev1.StorageClass
+		var pvc *corev1.PersistentVolumeClaim
+		var rd *volsyncv1alpha1.ReplicationDestination
+		var m *rdMachine
+
+		BeforeEach(func() {
+			namespace = &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "volsync-test-",
+				},
+			}
+			createWithCacheReload(ctx, k8sClient, namespace)
+			sc = &storagev1.StorageClass{
+				ObjectMeta:           metav1.ObjectMeta{Name: "expandable-" + namespace.Name},
+				Provisioner:          "test.csi.driver",
+				AllowVolumeExpansion: ptr.To(true),
+			}
+			Expect(k8sClient.Create(ctx, sc)).To(Succeed())
+			pvc = &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: "dest", Namespace: namespace.Name},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+					StorageClassName: &sc.Name,
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+					},
+				},
+			}
+			createWithCacheReload(ctx, k8sClient, pvc)
+			// Only bound PVCs can be expanded
+			pvc.Status.Phase = corev1.ClaimBound
+			Expect(k8sClient.Status().Update(ctx, pvc)).To(Succeed())
+
+			rd = &volsyncv1alpha1.ReplicationDestination{
+				ObjectMeta: metav1.ObjectMeta{Name: "rd", Namespace: namespace.Name},
+				Spec: volsyncv1alpha1.ReplicationDestinationSpec{
+					CapacityForecast: &volsyncv1alpha1.CapacityForecastSpec{},
+				},
+				Status: &volsyncv1alpha1.ReplicationDestinationStatus{
+					CapacityForecast: &volsyncv1alpha1.CapacityForecastStatus{
+						PVCName: pvc.Name,
+						Samples: usageSamples(4, 5, 6),
+					},
+				},
+			}
+			m = &rdMachine{
+				rd:            rd,
+				client:        k8sClient,
+				logger:        logr.Discard(),
+				eventRecorder: events.NewFakeRecorder(10),
+			}
+		})
+		AfterEach(func() {
+			Expect(k8sClient.Delete(ctx, sc)).To(Succeed())
+			Expect(k8sClient.Delete(ctx, namespace)).To(Succeed())
+		})
+
+		It("reports sufficient capacity beyond the horizon", func() {
+			rd.Spec.CapacityForecast.Horizon = &metav1.Duration{Duration: 24 * time.Hour}
+			Expect(m.forecastCapacity(ctx)).To(Succeed())
+			cond := apimeta.FindStatusCondition(rd.Status.Conditions, volsyncv1alpha1.ConditionCapacityForecast)
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Reason).To(Equal(volsyncv1alpha1.CapacityForecastReasonSufficient))
+			Expect(rd.Status.CapacityForecast.ProjectedFullTime).NotTo(BeNil())
+		})
+
+		It("warns of projected exhaustion without autoExpand", func() {
+			Expect(m.forecastCapacity(ctx)).To(Succeed())
+			cond := apimeta.FindStatusCondition(rd.Status.Conditions, volsyncv1alpha1.ConditionCapacityForecast)
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(volsyncv1alpha1.CapacityForecastReasonExhaustion))
+		})
+
+		It("expands the PVC ahead of need", func() {
+			rd.Spec.CapacityForecast.AutoExpand = true
+			rd.Spec.CapacityForecast.ExpansionPercent = ptr.To[int32](50)
+			Expect(m.forecastCapacity(ctx)).To(Succeed())
+			cond := apimeta.FindStatusCondition(rd.Status.Conditions, volsyncv1alpha1.ConditionCapacityForecast)
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Reason).To(Equal(volsyncv1alpha1.CapacityForecastReasonExpanded))
+			Expect(rd.Status.CapacityForecast.LastExpansionTime).NotTo(BeNil())
+
+			Eventually(func() string {
+				p := &corev1.PersistentVolumeClaim{}
+				if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(pvc), p); err != nil {
+					return ""
+				}
+				return p.Spec.Resources.Requests.Storage().String()
+			}, maxWait, interval).Should(Equal("15Gi"))
+
+			// No further expansion until the usage has been measured again
+			Expect(m.forecastCapacity(ctx)).To(Succeed())
+			cond = apimeta.FindStatusCondition(rd.Status.Conditions, volsyncv1alpha1.ConditionCapacityForecast)
+			Expect(cond.Reason).To(Equal(volsyncv1alpha1.CapacityForecastReasonExhaustion))
+		})
+	})
+})
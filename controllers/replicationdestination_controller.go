@@ -33,11 +33,15 @@ import (
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/events"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 
 	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
 	"github.com/backube/volsync/controllers/mover"
@@ -54,11 +58,12 @@ type ReplicationDestinationReconciler struct {
 }
 
 type rdMachine struct {
-	rd      *volsyncv1alpha1.ReplicationDestination
-	client  client.Client
-	logger  logr.Logger
-	metrics volsyncMetrics
-	mover   mover.Mover
+	rd            *volsyncv1alpha1.ReplicationDestination
+	client        client.Client
+	logger        logr.Logger
+	eventRecorder events.EventRecorder
+	metrics       volsyncMetrics
+	mover         mover.Mover
 }
 
 var _ sm.ReplicationMachine = &rdMachine{}
@@ -68,8 +73,10 @@ var _ sm.ReplicationMachine = &rdMachine{}
 //+kubebuilder:rbac:groups=volsync.backube,resources=replicationdestinations/finalizers,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=volsync.backube,resources=replicationdestinations/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete;deletecollection
+//+kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=core,resources=events,verbs=create;update;patch
 //+kubebuilder:rbac:groups=core,resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch;delete;deletecollection
+//+kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get;list;watch
 //+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
@@ -79,6 +86,8 @@ var _ sm.ReplicationMachine = &rdMachine{}
 //+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=security.openshift.io,resources=securitycontextconstraints,resourceNames=volsync-privileged-mover,verbs=use
 //+kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshots,verbs=get;list;watch;create;update;patch;delete;deletecollection
+//+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=tcproutes,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways,verbs=get;list;watch
 
 //nolint:funlen
 func (r *ReplicationDestinationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -88,6 +97,8 @@ func (r *ReplicationDestinationReconciler) Reconcile(ctx context.Context, req ct
 	if err := r.Client.Get(ctx, req.NamespacedName, inst); err != nil {
 		if !kerrors.IsNotFound(err) {
 			logger.Error(err, "Failed to get Destination")
+		} else {
+			sm.ReleaseSync(rdLaunchKey(req.NamespacedName))
 		}
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
@@ -95,28 +106,65 @@ func (r *ReplicationDestinationReconciler) Reconcile(ctx context.Context, req ct
 	if inst.Status == nil {
 		inst.Status = &volsyncv1alpha1.ReplicationDestinationStatus{}
 	}
+	prepareCapacityForecast(inst)
 
 	var result ctrl.Result
 	var err error
 
+	// Apply the stale policy before building the mover so that a suspension
+	// takes effect immediately
+	deleted, staleRequeueAfter, err := r.handleStaleDestination(ctx, logger, inst)
+	if err != nil || deleted {
+		return result, err
+	}
+
+	promoteRequeueAfter, err := r.handlePromotion(ctx, logger, inst)
+	if err != nil {
+		if statusErr := r.Client.Status().Update(ctx, inst); statusErr != nil {
+			logger.Error(statusErr, "unable to update status")
+		}
+		return result, err
+	}
+
+	// A suspended namespace pauses the mover. This is applied after the
+	// promotion so that only the promotion's pause is saved in the spec.
+	err = applyNamespaceSuspend(ctx, r.Client, logger, &inst.Status.Conditions, inst.GetNamespace(),
+		&inst.Spec.Paused)
+	if err != nil {
+		return result, err
+	}
+
 	// Check if any volume snapshots are marked with do-not-delete label and remove ownership if so
 	err = utils.RelinquishOwnedSnapshotsWithDoNotDeleteLabel(ctx, r.Client, logger, inst)
 	if err != nil {
 		return result, err
 	}
 
+	retentionRequeueAfter, err := r.applySnapshotRetention(ctx, logger, inst)
+	if err != nil {
+		return result, err
+	}
+
 	// Check if privileged movers are allowed via namespace annotation
 	privilegedMoverOk, err := utils.PrivilegedMoversOk(ctx, r.Client, logger, inst.GetNamespace())
 	if err != nil {
 		return result, err
 	}
+	// The Hardened security profile never uses privileged movers
+	if utils.SecurityProfileFor(inst) == volsyncv1alpha1.SecurityProfileHardened {
+		privilegedMoverOk = false
+	}
 
 	rdm, err := newRDMachine(inst, r.Client, logger,
 		record.NewEventRecorderAdapter(mover.NewEventRecorderLogger(r.EventRecorder)), privilegedMoverOk)
 
 	// Using only external method
 	if errors.Is(err, mover.ErrNoMoverFound) && inst.Spec.External != nil {
-		return ctrl.Result{}, nil
+		err = ensureMoverAllowed(ctx, r.Client, logger, &inst.Status.Conditions, inst.GetNamespace(),
+			volsyncv1alpha1.ExternalMoverName)
+		if err == nil {
+			return ctrl.Result{}, nil
+		}
 	}
 	// Both internal and external methods defined
 	if rdm != nil && inst.Spec.External != nil {
@@ -130,18 +178,64 @@ func (r *ReplicationDestinationReconciler) Reconcile(ctx context.Context, req ct
 	}
 	// No method found
 	if rdm == nil && inst.Spec.External == nil {
-		err = mover.ErrNoMoverFound
+		message := err.Error()
+		if errors.Is(err, mover.ErrNoMoverFound) {
+			message += fmt.Sprintf(" - enabled movers: %v", mover.GetEnabledMoverList())
+		}
 		apimeta.SetStatusCondition(&inst.Status.Conditions, metav1.Condition{
 			Type:    volsyncv1alpha1.ConditionSynchronizing,
 			Status:  metav1.ConditionFalse,
 			Reason:  volsyncv1alpha1.SynchronizingReasonError,
-			Message: err.Error() + fmt.Sprintf(" - enabled movers: %v", mover.GetEnabledMoverList()),
+			Message: message,
 		})
 	}
 
-	// All good, so run the state machine
+	// The namespace may restrict which movers can be used
 	if err == nil {
+		err = ensureMoverAllowed(ctx, r.Client, logger, &inst.Status.Conditions, inst.GetNamespace(),
+			rdm.mover.Name())
+	}
+
+	// The security profile may not be satisfiable by the mover
+	if err == nil {
+		err = ensureSecurityProfile(logger, inst, &inst.Status.Conditions, &inst.Status.Security,
+			rdm.mover.Name(), privilegedMoverOk)
+	}
+
+	// All good, so run the state machine
+	moverReady := err == nil
+	if moverReady {
 		result, err = sm.Run(ctx, rdm, logger)
+		updateSyncBlockedCondition(&inst.Status.Conditions, err)
+	}
+
+	// Connection tests are run independently of the synchronizations, so
+	// they can be used while the synchronizations are failing
+	if moverReady {
+		var testErr error
+		result, testErr = runConnectionTest(ctx, rdm.mover, result)
+		if err == nil { // Don't mask previous error
+			err = testErr
+		}
+	}
+
+	// The latest image is validated independently of the synchronizations
+	var validationRequeueAfter time.Duration
+	if !inst.Spec.Paused {
+		var validationErr error
+		validationRequeueAfter, validationErr = r.validateStandby(ctx, logger, inst)
+		if err == nil { // Don't mask previous error
+			err = validationErr
+		}
+	}
+
+	// Make sure we come back to check for staleness, promotion, expired
+	// snapshots, and the next validation
+	for _, after := range []time.Duration{staleRequeueAfter, promoteRequeueAfter, retentionRequeueAfter,
+		validationRequeueAfter} {
+		if after > 0 && (result.RequeueAfter == 0 || after < result.RequeueAfter) {
+			result.RequeueAfter = after
+		}
 	}
 
 	// Update instance status
@@ -153,7 +247,7 @@ func (r *ReplicationDestinationReconciler) Reconcile(ctx context.Context, req ct
 }
 
 func (r *ReplicationDestinationReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&volsyncv1alpha1.ReplicationDestination{}).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: 100,
@@ -165,8 +259,16 @@ func (r *ReplicationDestinationReconciler) SetupWithManager(mgr ctrl.Manager) er
 		Owns(&corev1.ServiceAccount{}).
 		Owns(&rbacv1.Role{}).
 		Owns(&rbacv1.RoleBinding{}).
-		Owns(&snapv1.VolumeSnapshot{}).
-		Complete(r)
+		Owns(&snapv1.VolumeSnapshot{})
+	// The annotations of the namespaces can only be watched when VolSync
+	// watches all namespaces
+	if !utils.NamespaceScoped() {
+		b = b.Watches(&corev1.Namespace{},
+			handler.EnqueueRequestsFromMapFunc(mapFuncNamespaceToObjects(mgr.GetClient(),
+				func() client.ObjectList { return &volsyncv1alpha1.ReplicationDestinationList{} })),
+			builder.WithPredicates(namespaceSuspendPredicate()))
+	}
+	return b.Complete(r)
 }
 
 func newRDMachine(rd *volsyncv1alpha1.ReplicationDestination, c client.Client,
@@ -184,14 +286,27 @@ func newRDMachine(rd *volsyncv1alpha1.ReplicationDestination, c client.Client,
 	})
 
 	return &rdMachine{
-		rd:      rd,
-		client:  c,
-		logger:  l,
-		metrics: metrics,
-		mover:   dataMover,
+		rd:            rd,
+		client:        c,
+		logger:        l,
+		eventRecorder: er,
+		metrics:       metrics,
+		mover:         dataMover,
 	}, nil
 }
 
+func rdLaunchKey(nn types.NamespacedName) string {
+	return "ReplicationDestination/" + nn.String()
+}
+
+func (m *rdMachine) Namespace() string {
+	return m.rd.GetNamespace()
+}
+
+func (m *rdMachine) LaunchKey() string {
+	return rdLaunchKey(client.ObjectKeyFromObject(m.rd))
+}
+
 func (m *rdMachine) Cronspec() string {
 	if m.rd.Spec.Trigger != nil && m.rd.Spec.Trigger.Schedule != nil {
 		return *m.rd.Spec.Trigger.Schedule
@@ -199,6 +314,13 @@ func (m *rdMachine) Cronspec() string {
 	return ""
 }
 
+func (m *rdMachine) TimeZone() string {
+	if m.rd.Spec.Trigger != nil && m.rd.Spec.Trigger.TimeZone != nil {
+		return *m.rd.Spec.Trigger.TimeZone
+	}
+	return ""
+}
+
 func (m *rdMachine) ManualTag() string {
 	if m.rd.Spec.Trigger != nil {
 		return m.rd.Spec.Trigger.Manual
@@ -266,23 +388,132 @@ func (m *rdMachine) ObserveSyncDuration(duration time.Duration) {
 	m.metrics.SyncDurations.Observe(duration.Seconds())
 }
 
+func (m *rdMachine) LatestMoverStatus() *volsyncv1alpha1.MoverStatus {
+	return m.rd.Status.LatestMoverStatus
+}
+
+func (m *rdMachine) SyncHistory() *[]volsyncv1alpha1.SyncHistoryEntry {
+	return &m.rd.Status.History
+}
+
+func (m *rdMachine) RetryPolicy() *volsyncv1alpha1.RetryPolicy {
+	return m.rd.Spec.RetryPolicy
+}
+
+func (m *rdMachine) RetryStatus() *volsyncv1alpha1.RetryStatus {
+	return m.rd.Status.Retries
+}
+
+func (m *rdMachine) SetRetryStatus(rs *volsyncv1alpha1.RetryStatus) {
+	m.rd.Status.Retries = rs
+}
+
+// Destinations do not report the amount of data received
+func (m *rdMachine) BytesTransferred() *int64 {
+	return nil
+}
+
+func (m *rdMachine) NotifySyncResult(ctx context.Context, event volsyncv1alpha1.NotificationEventType,
+	message string) {
+	if m.rd.Spec.Notifications == nil {
+		return
+	}
+	n := utils.SyncNotification{
+		Kind:      "ReplicationDestination",
+		Namespace: m.rd.Namespace,
+		Name:      m.rd.Name,
+		Event:     event,
+		Time:      time.Now().UTC(),
+		Message:   message,
+	}
+	if event == volsyncv1alpha1.NotificationEventSucceeded && m.rd.Status.LastSyncDuration != nil {
+		n.Duration = m.rd.Status.LastSyncDuration.Duration.String()
+	}
+	utils.SendSyncNotification(ctx, m.logger, m.client, m.rd.Spec.Notifications, n)
+}
+
 func (m *rdMachine) Synchronize(ctx context.Context) (mover.Result, error) {
+	utils.SetCleanupCompleteCondition(m.eventRecorder, m.rd, false)
+	if m.rd.Spec.WorkloadCoordination != nil {
+		return m.synchronizeWithWorkloadCoordination(ctx)
+	}
+	return m.synchronize(ctx)
+}
+
+func (m *rdMachine) synchronize(ctx context.Context) (mover.Result, error) {
 	result, err := m.mover.Synchronize(ctx)
 
 	if result.Completed && result.Image != nil {
-		// Mark previous latestImage for cleanup if it was a snapshot
-		err = utils.MarkOldSnapshotForCleanup(ctx, m.client, m.logger, m.rd,
-			m.rd.Status.LatestImage, result.Image)
-		if err != nil {
+		if err := m.recordLatestImage(ctx, result.Image); err != nil {
 			return mover.InProgress(), err
 		}
+	}
 
-		m.rd.Status.LatestImage = result.Image
+	if result.Completed && err == nil {
+		// A failure to forecast (or expand) should not fail the sync
+		if ferr := m.forecastCapacity(ctx); ferr != nil {
+			m.logger.Error(ferr, "unable to forecast the capacity of the destination volume")
+		}
 	}
 
 	return result, err
 }
 
+// recordLatestImage makes image the latestImage. The previous images are
+// retained up to spec.keepLatestImages; the others are marked for cleanup if
+// they are snapshots.
+func (m *rdMachine) recordLatestImage(ctx context.Context, image *corev1.TypedLocalObjectReference) error {
+	keep := 1
+	if m.rd.Spec.KeepLatestImages != nil {
+		keep = int(*m.rd.Spec.KeepLatestImages)
+	}
+
+	previous := m.rd.Status.LatestImages
+	if len(previous) == 0 && m.rd.Status.LatestImage != nil {
+		previous = []volsyncv1alpha1.RetainedImage{{
+			Image:    *m.rd.Status.LatestImage,
+			SyncTime: m.rd.Status.LastSyncTime,
+		}}
+	}
+
+	var retained []volsyncv1alpha1.RetainedImage
+	if keep > 1 && utils.IsSnapshot(image) {
+		retained = append(retained, volsyncv1alpha1.RetainedImage{
+			Image:    *image,
+			SyncTime: ptr.To(metav1.Now()),
+		})
+	}
+	for i := range previous {
+		old := &previous[i].Image
+		if len(retained) > 0 && len(retained) < keep && old.Name != image.Name && utils.IsSnapshot(old) {
+			retained = append(retained, previous[i])
+			continue
+		}
+		// Mark the image for cleanup if it was a snapshot
+		if err := utils.MarkOldSnapshotForCleanup(ctx, m.client, m.logger, m.rd, old, image); err != nil {
+			return err
+		}
+	}
+
+	m.rd.Status.LatestImages = retained
+	m.rd.Status.LatestImage = image
+	return nil
+}
+
 func (m *rdMachine) Cleanup(ctx context.Context) (mover.Result, error) {
-	return m.mover.Cleanup(ctx)
+	result, err := m.mover.Cleanup(ctx)
+	if result.Completed && err == nil {
+		utils.SetCleanupCompleteCondition(m.eventRecorder, m.rd, true)
+	}
+	return result, err
+}
+
+func (m *rdMachine) DeferSync(_ context.Context) (time.Duration, string, error) {
+	return 0, "", nil
+}
+
+func (m *rdMachine) VerifyCleanup(ctx context.Context) (time.Duration, error) {
+	warnings, recheck, err := utils.VerifyCleanup(ctx, m.client, m.logger, m.rd, m.rd.Status.CleanupWarnings)
+	m.rd.Status.CleanupWarnings = warnings
+	return recheck, err
 }
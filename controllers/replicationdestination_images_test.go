@@ -0,0 +1,127 @@
+package controllers
+
+import (
+	"github.com/go-logr/logr"
+	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("ReplicationDestination latest image history", func() {
+	var namespace *corev1.Namespace
+	var rd *volsyncv1alpha1.ReplicationDestination
+	var m *rdMachine
+
+	// newSnapshot creates a VolumeSnapshot owned by the destination and
+	// returns a reference to it
+	newSnapshot := func(name string) *corev1.TypedLocalObjectReference {
+		snap := &snapv1.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace.Name},
+			Spec: snapv1.VolumeSnapshotSpec{
+				Source: snapv1.VolumeSnapshotSource{PersistentVolumeClaimName: ptr.To("dest")},
+			},
+		}
+		Expect(ctrl.SetControllerReference(rd, snap, k8sClient.Scheme())).To(Succeed())
+		createWithCacheReload(ctx, k8sClient, snap)
+		return &corev1.TypedLocalObjectReference{
+			APIGroup: &snapv1.SchemeGroupVersion.Group,
+			Kind:     "VolumeSnapshot",
+			Name:     name,
+		}
+	}
+	isMarkedForCleanup := func(name string) bool {
+		snap := &snapv1.VolumeSnapshot{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace.Name}, snap)).To(Succeed())
+		return utils.HasLabel(snap, utils.VolsyncLabelPrefix+"/cleanup")
+	}
+	retainedNames := func() []string {
+		names := []string{}
+		for _, i := range rd.Status.LatestImages {
+			names = append(names, i.Image.Name)
+		}
+		return names
+	}
+
+	BeforeEach(func() {
+		namespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "volsync-test-",
+			},
+		}
+		createWithCacheReload(ctx, k8sClient, namespace)
+		rd = &volsyncv1alpha1.ReplicationDestination{
+			ObjectMeta: metav1.ObjectMeta{Name: "rd", Namespace: namespace.Name},
+			Spec: volsyncv1alpha1.ReplicationDestinationSpec{
+				// Keep the controller of the test suite from acting on it
+				Paused: true,
+			},
+		}
+		createWithCacheReload(ctx, k8sClient, rd)
+		rd.Status = &volsyncv1alpha1.ReplicationDestinationStatus{}
+		m = &rdMachine{
+			rd:            rd,
+			client:        k8sClient,
+			logger:        logr.Discard(),
+			eventRecorder: events.NewFakeRecorder(10),
+		}
+	})
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, namespace)).To(Succeed())
+	})
+
+	It("only keeps the latest image by default", func() {
+		first := newSnapshot("first")
+		second := newSnapshot("second")
+		Expect(m.recordLatestImage(ctx, first)).To(Succeed())
+		Expect(m.recordLatestImage(ctx, second)).To(Succeed())
+		Expect(rd.Status.LatestImage.Name).To(Equal("second"))
+		Expect(rd.Status.LatestImages).To(BeEmpty())
+		Expect(isMarkedForCleanup("first")).To(BeTrue())
+		Expect(isMarkedForCleanup("second")).To(BeFalse())
+	})
+
+	It("retains up to keepLatestImages snapshots", func() {
+		rd.Spec.KeepLatestImages = ptr.To[int32](2)
+		for _, name := range []string{"first", "second", "third"} {
+			Expect(m.recordLatestImage(ctx, newSnapshot(name))).To(Succeed())
+		}
+		Expect(rd.Status.LatestImage.Name).To(Equal("third"))
+		Expect(retainedNames()).To(Equal([]string{"third", "second"}))
+		Expect(rd.Status.LatestImages[0].SyncTime).NotTo(BeNil())
+		Expect(isMarkedForCleanup("first")).To(BeTrue())
+		Expect(isMarkedForCleanup("second")).To(BeFalse())
+		Expect(isMarkedForCleanup("third")).To(BeFalse())
+	})
+
+	It("starts the history from an existing latestImage", func() {
+		rd.Spec.KeepLatestImages = ptr.To[int32](3)
+		rd.Status.LatestImage = newSnapshot("existing")
+		Expect(m.recordLatestImage(ctx, newSnapshot("next"))).To(Succeed())
+		Expect(retainedNames()).To(Equal([]string{"next", "existing"}))
+		Expect(isMarkedForCleanup("existing")).To(BeFalse())
+	})
+
+	It("releases the history when keepLatestImages is lowered", func() {
+		rd.Spec.KeepLatestImages = ptr.To[int32](3)
+		for _, name := range []string{"first", "second", "third"} {
+			Expect(m.recordLatestImage(ctx, newSnapshot(name))).To(Succeed())
+		}
+		Expect(retainedNames()).To(Equal([]string{"third", "second", "first"}))
+
+		rd.Spec.KeepLatestImages = nil
+		Expect(m.recordLatestImage(ctx, newSnapshot("fourth"))).To(Succeed())
+		Expect(rd.Status.LatestImages).To(BeEmpty())
+		for _, name := range []string{"first", "second", "third"} {
+			Expect(isMarkedForCleanup(name)).To(BeTrue())
+		}
+	})
+})
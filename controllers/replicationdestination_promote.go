@@ -0,0 +1,261 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+// How often to check for a latest image to promote
+const promoteRetryInterval = time.Minute
+
+// handlePromotion carries out spec.promote: it pauses the destination and
+// turns the latest image into a PVC that does not belong to the destination.
+// Promotion happens once; afterwards the destination is left alone. It returns
+// the amount of time after which the promotion should be retried.
+func (r *ReplicationDestinationReconciler) handlePromotion(ctx context.Context, logger logr.Logger,
+	inst *volsyncv1alpha1.ReplicationDestination) (time.Duration, error) {
+	if inst.Spec.Promote == nil {
+		apimeta.RemoveStatusCondition(&inst.Status.Conditions, volsyncv1alpha1.ConditionPromoted)
+		return 0, nil
+	}
+	if inst.Status.Promotion != nil {
+		return 0, nil
+	}
+
+	image := inst.Status.LatestImage
+	if image == nil {
+		apimeta.SetStatusCondition(&inst.Status.Conditions, metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionPromoted,
+			Status:  metav1.ConditionFalse,
+			Reason:  volsyncv1alpha1.PromotedReasonWaitingForImage,
+			Message: "Waiting for a synchronization to complete before promoting",
+		})
+		return promoteRetryInterval, nil
+	}
+
+	// Pause first so that the image is not replaced while it is promoted
+	if !inst.Spec.Paused {
+		logger.Info("pausing destination for promotion")
+		status := inst.Status
+		inst.Spec.Paused = true
+		if err := r.Client.Update(ctx, inst); err != nil {
+			return 0, err
+		}
+		inst.Status = status
+	}
+
+	var pvcName string
+	var err error
+	if utils.IsSnapshot(image) {
+		pvcName, err = r.promoteSnapshot(ctx, inst, image.Name)
+	} else {
+		pvcName, err = r.promotePVC(ctx, inst, image.Name)
+	}
+	if err != nil {
+		logger.Error(err, "unable to promote latest image", "image", image.Name)
+		apimeta.SetStatusCondition(&inst.Status.Conditions, metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionPromoted,
+			Status:  metav1.ConditionFalse,
+			Reason:  volsyncv1alpha1.PromotedReasonError,
+			Message: err.Error(),
+		})
+		r.EventRecorder.Eventf(inst, corev1.EventTypeWarning, volsyncv1alpha1.EvRPromotionFailed,
+			"unable to promote %s: %s", image.Name, err)
+		return 0, err
+	}
+
+	inst.Status.Promotion = &volsyncv1alpha1.PromotionStatus{
+		PVCName:       pvcName,
+		Image:         image.DeepCopy(),
+		PromotionTime: metav1.Now(),
+	}
+	apimeta.SetStatusCondition(&inst.Status.Conditions, metav1.Condition{
+		Type:    volsyncv1alpha1.ConditionPromoted,
+		Status:  metav1.ConditionTrue,
+		Reason:  volsyncv1alpha1.PromotedReasonPromoted,
+		Message: fmt.Sprintf("Promoted %s to PVC %s", image.Name, pvcName),
+	})
+	r.EventRecorder.Eventf(inst, corev1.EventTypeNormal, volsyncv1alpha1.EvRPromoted,
+		"promoted %s to PVC %s", image.Name, pvcName)
+	logger.Info("promoted latest image", "image", image.Name, "pvc", pvcName)
+	return 0, nil
+}
+
+// promoteSnapshot creates the promoted PVC from the VolumeSnapshot and
+// protects the snapshot from being cleaned up
+func (r *ReplicationDestinationReconciler) promoteSnapshot(ctx context.Context,
+	inst *volsyncv1alpha1.ReplicationDestination, snapName string) (string, error) {
+	snap := &snapv1.VolumeSnapshot{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: snapName, Namespace: inst.Namespace}, snap); err != nil {
+		return "", err
+	}
+	if utils.MarkDoNotDelete(snap) {
+		if err := r.Client.Update(ctx, snap); err != nil {
+			return "", err
+		}
+	}
+
+	pvcName := inst.Name
+	if inst.Spec.Promote.PVCName != nil {
+		pvcName = *inst.Spec.Promote.PVCName
+	}
+	pvc := &corev1.PersistentVolumeClaim{}
+	err := r.Client.Get(ctx, client.ObjectKey{Name: pvcName, Namespace: inst.Namespace}, pvc)
+	if err == nil {
+		// Left over from an earlier attempt whose status was not saved
+		if pvc.Annotations[utils.PromotedFromAnnotation] == inst.Name {
+			return pvcName, nil
+		}
+		return "", fmt.Errorf("PVC %s already exists", pvcName)
+	}
+	if !kerrors.IsNotFound(err) {
+		return "", err
+	}
+
+	spec, err := pvcSpecFromSnapshot(inst, snap, inst.Spec.Promote.StorageClassName)
+	if err != nil {
+		return "", err
+	}
+	pvc = &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        pvcName,
+			Namespace:   inst.Namespace,
+			Annotations: promotedAnnotations(inst),
+		},
+		Spec: spec,
+	}
+	return pvcName, r.Client.Create(ctx, pvc)
+}
+
+// pvcSpecFromSnapshot returns the spec of a PVC restoring the VolumeSnapshot
+// with the volume options of the destination. storageClassName, if set,
+// overrides the StorageClass of the volume options.
+func pvcSpecFromSnapshot(inst *volsyncv1alpha1.ReplicationDestination, snap *snapv1.VolumeSnapshot,
+	storageClassName *string) (corev1.PersistentVolumeClaimSpec, error) {
+	opts := destinationVolumeOptions(inst)
+	capacity := snap.Status.RestoreSize
+	if capacity == nil || capacity.IsZero() {
+		capacity = opts.Capacity
+	}
+	if capacity == nil {
+		return corev1.PersistentVolumeClaimSpec{},
+			fmt.Errorf("the size of VolumeSnapshot %s is not known and no capacity is set", snap.Name)
+	}
+	accessModes := opts.AccessModes
+	if len(accessModes) == 0 {
+		accessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}
+	}
+	if storageClassName == nil {
+		storageClassName = opts.StorageClassName
+	}
+
+	return corev1.PersistentVolumeClaimSpec{
+		AccessModes:      accessModes,
+		StorageClassName: storageClassName,
+		VolumeMode:       destinationVolumeMode(inst),
+		Resources: corev1.VolumeResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceStorage: *capacity},
+		},
+		DataSource: &corev1.TypedLocalObjectReference{
+			APIGroup: &snapv1.SchemeGroupVersion.Group,
+			Kind:     "VolumeSnapshot",
+			Name:     snap.Name,
+		},
+	}, nil
+}
+
+// promotePVC releases the destination PVC (copyMethod: Direct) so that it
+// outlives the ReplicationDestination
+func (r *ReplicationDestinationReconciler) promotePVC(ctx context.Context,
+	inst *volsyncv1alpha1.ReplicationDestination, pvcName string) (string, error) {
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: pvcName, Namespace: inst.Namespace}, pvc); err != nil {
+		return "", err
+	}
+	utils.UnMarkForCleanupAndRemoveOwnership(pvc, inst)
+	utils.RemoveLabel(pvc, utils.AdoptedByLabelKey)
+	if pvc.Annotations == nil {
+		pvc.Annotations = map[string]string{}
+	}
+	for k, v := range promotedAnnotations(inst) {
+		pvc.Annotations[k] = v
+	}
+	return pvcName, r.Client.Update(ctx, pvc)
+}
+
+func promotedAnnotations(inst *volsyncv1alpha1.ReplicationDestination) map[string]string {
+	annotations := map[string]string{}
+	for k, v := range inst.Spec.Promote.Annotations {
+		annotations[k] = v
+	}
+	annotations[utils.PromotedFromAnnotation] = inst.Name
+	return annotations
+}
+
+// destinationVolumeOptions returns the volume options of the mover in use
+func destinationVolumeOptions(
+	inst *volsyncv1alpha1.ReplicationDestination) volsyncv1alpha1.ReplicationDestinationVolumeOptions {
+	switch {
+	case inst.Spec.Rsync != nil:
+		return inst.Spec.Rsync.ReplicationDestinationVolumeOptions
+	case inst.Spec.RsyncTLS != nil:
+		return inst.Spec.RsyncTLS.ReplicationDestinationVolumeOptions
+	case inst.Spec.Block != nil:
+		return inst.Spec.Block.ReplicationDestinationVolumeOptions
+	case inst.Spec.NFS != nil:
+		return inst.Spec.NFS.ReplicationDestinationVolumeOptions
+	case inst.Spec.Mock != nil:
+		return inst.Spec.Mock.ReplicationDestinationVolumeOptions
+	case inst.Spec.Rclone != nil:
+		return inst.Spec.Rclone.ReplicationDestinationVolumeOptions
+	case inst.Spec.Restic != nil:
+		return inst.Spec.Restic.ReplicationDestinationVolumeOptions
+	}
+	return volsyncv1alpha1.ReplicationDestinationVolumeOptions{}
+}
+
+// destinationVolumeMode returns the volumeMode of the destination volume, or
+// nil for the default (Filesystem)
+func destinationVolumeMode(inst *volsyncv1alpha1.ReplicationDestination) *corev1.PersistentVolumeMode {
+	switch {
+	case inst.Spec.Rsync != nil:
+		return inst.Spec.Rsync.VolumeMode
+	case inst.Spec.RsyncTLS != nil:
+		return inst.Spec.RsyncTLS.VolumeMode
+	case inst.Spec.Block != nil:
+		return ptr.To(corev1.PersistentVolumeBlock)
+	case inst.Spec.Restic != nil:
+		return inst.Spec.Restic.VolumeMode
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package controllers
+
+import (
+	"github.com/go-logr/logr"
+	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("ReplicationDestination promotion", func() {
+	var namespace *corev1.Namespace
+	var rd *volsyncv1alpha1.ReplicationDestination
+	var r *ReplicationDestinationReconciler
+
+	BeforeEach(func() {
+		namespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "volsync-test-",
+			},
+		}
+		createWithCacheReload(ctx, k8sClient, namespace)
+		rd = &volsyncv1alpha1.ReplicationDestination{
+			ObjectMeta: metav1.ObjectMeta{Name: "rd", Namespace: namespace.Name},
+			Spec: volsyncv1alpha1.ReplicationDestinationSpec{
+				// Keep the controller of the test suite from acting on it
+				Paused: true,
+				Promote: &volsyncv1alpha1.PromoteSpec{
+					PVCName:     ptr.To("promoted"),
+					Annotations: map[string]string{"reverse-to": "site-a"},
+				},
+			},
+		}
+		createWithCacheReload(ctx, k8sClient, rd)
+		rd.Status = &volsyncv1alpha1.ReplicationDestinationStatus{}
+		r = &ReplicationDestinationReconciler{
+			Client:        k8sClient,
+			Log:           logr.Discard(),
+			Scheme:        k8sClient.Scheme(),
+			EventRecorder: record.NewFakeRecorder(10),
+		}
+	})
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, namespace)).To(Succeed())
+	})
+
+	It("waits for a latest image", func() {
+		after, err := r.handlePromotion(ctx, logr.Discard(), rd)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(after).To(Equal(promoteRetryInterval))
+		cond := apimeta.FindStatusCondition(rd.Status.Conditions, volsyncv1alpha1.ConditionPromoted)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(volsyncv1alpha1.PromotedReasonWaitingForImage))
+		Expect(rd.Status.Promotion).To(BeNil())
+	})
+
+	It("creates a standalone PVC from the latest snapshot", func() {
+		snap := &snapv1.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Name: "latest", Namespace: namespace.Name},
+			Spec: snapv1.VolumeSnapshotSpec{
+				Source: snapv1.VolumeSnapshotSource{PersistentVolumeClaimName: ptr.To("dest")},
+			},
+		}
+		Expect(ctrl.SetControllerReference(rd, snap, k8sClient.Scheme())).To(Succeed())
+		createWithCacheReload(ctx, k8sClient, snap)
+		snap.Status = &snapv1.VolumeSnapshotStatus{RestoreSize: ptr.To(resource.MustParse("2Gi"))}
+		Expect(k8sClient.Status().Update(ctx, snap)).To(Succeed())
+		rd.Status.LatestImage = &corev1.TypedLocalObjectReference{
+			APIGroup: &snapv1.SchemeGroupVersion.Group,
+			Kind:     "VolumeSnapshot",
+			Name:     snap.Name,
+		}
+
+		after, err := r.handlePromotion(ctx, logr.Discard(), rd)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(after).To(BeZero())
+		Expect(rd.Status.Promotion).NotTo(BeNil())
+		Expect(rd.Status.Promotion.PVCName).To(Equal("promoted"))
+		cond := apimeta.FindStatusCondition(rd.Status.Conditions, volsyncv1alpha1.ConditionPromoted)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+
+		pvc := &corev1.PersistentVolumeClaim{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: "promoted", Namespace: namespace.Name}, pvc)).To(Succeed())
+		Expect(pvc.OwnerReferences).To(BeEmpty())
+		Expect(pvc.Annotations).To(HaveKeyWithValue(utils.PromotedFromAnnotation, rd.Name))
+		Expect(pvc.Annotations).To(HaveKeyWithValue("reverse-to", "site-a"))
+		Expect(pvc.Spec.DataSource.Name).To(Equal(snap.Name))
+		Expect(pvc.Spec.Resources.Requests.Storage().String()).To(Equal("2Gi"))
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(snap), snap)).To(Succeed())
+		Expect(utils.IsMarkedDoNotDelete(snap)).To(BeTrue())
+	})
+
+	It("releases the destination PVC with copyMethod Direct", func() {
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "dest", Namespace: namespace.Name},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+				},
+			},
+		}
+		Expect(ctrl.SetControllerReference(rd, pvc, k8sClient.Scheme())).To(Succeed())
+		utils.SetOwnedByVolSync(pvc)
+		createWithCacheReload(ctx, k8sClient, pvc)
+		rd.Status.LatestImage = &corev1.TypedLocalObjectReference{
+			Kind: "PersistentVolumeClaim",
+			Name: pvc.Name,
+		}
+
+		_, err := r.handlePromotion(ctx, logr.Discard(), rd)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rd.Status.Promotion.PVCName).To(Equal(pvc.Name))
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(pvc), pvc)).To(Succeed())
+		Expect(pvc.OwnerReferences).To(BeEmpty())
+		Expect(utils.IsOwnedByVolsync(pvc)).To(BeFalse())
+		Expect(pvc.Annotations).To(HaveKeyWithValue(utils.PromotedFromAnnotation, rd.Name))
+	})
+})
@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package controllers
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+// applySnapshotRetention deletes the snapshots of the destination's images
+// that are too old or too many according to the snapshotRetention policy and
+// removes them from the status. This is done whether or not the destination is
+// paused. It returns the amount of time until the next snapshot expires.
+func (r *ReplicationDestinationReconciler) applySnapshotRetention(ctx context.Context, logger logr.Logger,
+	inst *volsyncv1alpha1.ReplicationDestination) (time.Duration, error) {
+	policy := destinationVolumeOptions(inst).SnapshotRetention
+	if policy == nil || (policy.MaxAge == nil && policy.MaxCount == nil) {
+		return 0, nil
+	}
+
+	snaps, err := r.imageSnapshots(ctx, inst)
+	if err != nil {
+		return 0, err
+	}
+	// Newest first, so that maxCount keeps the most recent ones
+	sort.SliceStable(snaps, func(i, j int) bool {
+		return snaps[j].CreationTimestamp.Before(&snaps[i].CreationTimestamp)
+	})
+
+	now := time.Now()
+	var requeueAfter time.Duration
+	expired := &snapv1.VolumeSnapshotList{}
+	kept := 0
+	for _, snap := range snaps {
+		age := now.Sub(snap.CreationTimestamp.Time)
+		tooOld := policy.MaxAge != nil && age >= policy.MaxAge.Duration
+		tooMany := policy.MaxCount != nil && kept >= int(*policy.MaxCount)
+		if tooOld || tooMany {
+			expired.Items = append(expired.Items, snap)
+			continue
+		}
+		kept++
+		if policy.MaxAge != nil {
+			if after := policy.MaxAge.Duration - age; requeueAfter == 0 || after < requeueAfter {
+				requeueAfter = after
+			}
+		}
+	}
+	if len(expired.Items) == 0 {
+		return requeueAfter, nil
+	}
+
+	if err := utils.CleanupSnapshotsWithLabelCheck(ctx, r.Client, logger, inst, expired); err != nil {
+		return 0, err
+	}
+	for _, snap := range expired.Items {
+		logger.Info("deleted expired snapshot", "snapshot", snap.Name)
+		r.EventRecorder.Eventf(inst, corev1.EventTypeNormal, volsyncv1alpha1.EvRSnapExpired,
+			"deleted VolumeSnapshot %s according to the snapshot retention policy", snap.Name)
+		removeImageFromStatus(inst, snap.Name)
+	}
+	return requeueAfter, nil
+}
+
+// imageSnapshots returns the VolumeSnapshots of latestImage and latestImages
+// that belong to the destination and are not protected by the do-not-delete
+// label
+func (r *ReplicationDestinationReconciler) imageSnapshots(ctx context.Context,
+	inst *volsyncv1alpha1.ReplicationDestination) ([]snapv1.VolumeSnapshot, error) {
+	images := []*corev1.TypedLocalObjectReference{inst.Status.LatestImage}
+	for i := range inst.Status.LatestImages {
+		images = append(images, &inst.Status.LatestImages[i].Image)
+	}
+
+	seen := map[string]bool{}
+	snaps := []snapv1.VolumeSnapshot{}
+	for _, image := range images {
+		if !utils.IsSnapshot(image) || seen[image.Name] {
+			continue
+		}
+		seen[image.Name] = true
+		snap := &snapv1.VolumeSnapshot{}
+		err := r.Client.Get(ctx, client.ObjectKey{Name: image.Name, Namespace: inst.Namespace}, snap)
+		if kerrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !metav1.IsControlledBy(snap, inst) || utils.IsMarkedDoNotDelete(snap) ||
+			!snap.DeletionTimestamp.IsZero() {
+			continue
+		}
+		snaps = append(snaps, *snap)
+	}
+	return snaps, nil
+}
+
+// removeImageFromStatus removes the image from latestImage and latestImages
+func removeImageFromStatus(inst *volsyncv1alpha1.ReplicationDestination, name string) {
+	if inst.Status.LatestImage != nil && inst.Status.LatestImage.Name == name {
+		inst.Status.LatestImage = nil
+	}
+	retained := []volsyncv1alpha1.RetainedImage{}
+	for _, image := range inst.Status.LatestImages {
+		if image.Image.Name != name {
+			retained = append(retained, image)
+		}
+	}
+	if len(retained) == 0 {
+		retained = nil
+	}
+	inst.Status.LatestImages = retained
+}
@@ -0,0 +1,135 @@
+package controllers
+
+import (
+	"time"
+
+	"github.com/go-logr/logr"
+	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("ReplicationDestination snapshot retention", func() {
+	var namespace *corev1.Namespace
+	var rd *volsyncv1alpha1.ReplicationDestination
+	var r *ReplicationDestinationReconciler
+
+	// newImage creates a VolumeSnapshot owned by the destination and returns
+	// a reference to it
+	newImage := func(name string) corev1.TypedLocalObjectReference {
+		snap := &snapv1.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace.Name},
+			Spec: snapv1.VolumeSnapshotSpec{
+				Source: snapv1.VolumeSnapshotSource{PersistentVolumeClaimName: ptr.To("dest")},
+			},
+		}
+		Expect(ctrl.SetControllerReference(rd, snap, k8sClient.Scheme())).To(Succeed())
+		createWithCacheReload(ctx, k8sClient, snap)
+		return corev1.TypedLocalObjectReference{
+			APIGroup: &snapv1.SchemeGroupVersion.Group,
+			Kind:     "VolumeSnapshot",
+			Name:     name,
+		}
+	}
+	exists := func(name string) bool {
+		snap := &snapv1.VolumeSnapshot{}
+		err := k8sClient.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace.Name}, snap)
+		if kerrors.IsNotFound(err) {
+			return false
+		}
+		Expect(err).NotTo(HaveOccurred())
+		return snap.DeletionTimestamp.IsZero()
+	}
+
+	BeforeEach(func() {
+		namespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "volsync-test-",
+			},
+		}
+		createWithCacheReload(ctx, k8sClient, namespace)
+		rd = &volsyncv1alpha1.ReplicationDestination{
+			ObjectMeta: metav1.ObjectMeta{Name: "rd", Namespace: namespace.Name},
+			Spec: volsyncv1alpha1.ReplicationDestinationSpec{
+				// Keep the controller of the test suite from acting on it
+				Paused: true,
+				Mock: &volsyncv1alpha1.ReplicationDestinationMockSpec{
+					ReplicationDestinationVolumeOptions: volsyncv1alpha1.ReplicationDestinationVolumeOptions{
+						SnapshotRetention: &volsyncv1alpha1.SnapshotRetentionPolicy{},
+					},
+				},
+			},
+		}
+		createWithCacheReload(ctx, k8sClient, rd)
+		rd.Status = &volsyncv1alpha1.ReplicationDestinationStatus{}
+		r = &ReplicationDestinationReconciler{
+			Client:        k8sClient,
+			Log:           logr.Discard(),
+			Scheme:        k8sClient.Scheme(),
+			EventRecorder: record.NewFakeRecorder(10),
+		}
+	})
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, namespace)).To(Succeed())
+	})
+
+	It("keeps the most recent maxCount snapshots", func() {
+		rd.Spec.Mock.SnapshotRetention.MaxCount = ptr.To[int32](1)
+		older := newImage("older")
+		// Creation timestamps have a resolution of one second
+		time.Sleep(1100 * time.Millisecond)
+		newer := newImage("newer")
+		rd.Status.LatestImage = &newer
+		rd.Status.LatestImages = []volsyncv1alpha1.RetainedImage{{Image: newer}, {Image: older}}
+
+		after, err := r.applySnapshotRetention(ctx, logr.Discard(), rd)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(after).To(BeZero())
+		Expect(exists("newer")).To(BeTrue())
+		Expect(exists("older")).To(BeFalse())
+		Expect(rd.Status.LatestImage.Name).To(Equal("newer"))
+		Expect(rd.Status.LatestImages).To(HaveLen(1))
+	})
+
+	It("deletes the latest image of a paused destination once it expires", func() {
+		image := newImage("latest")
+		rd.Status.LatestImage = &image
+
+		rd.Spec.Mock.SnapshotRetention.MaxAge = &metav1.Duration{Duration: time.Hour}
+		after, err := r.applySnapshotRetention(ctx, logr.Discard(), rd)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(after).To(BeNumerically("~", time.Hour, time.Minute))
+		Expect(exists("latest")).To(BeTrue())
+
+		rd.Spec.Mock.SnapshotRetention.MaxAge = &metav1.Duration{Duration: time.Nanosecond}
+		_, err = r.applySnapshotRetention(ctx, logr.Discard(), rd)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exists("latest")).To(BeFalse())
+		Expect(rd.Status.LatestImage).To(BeNil())
+	})
+
+	It("does not delete snapshots marked do-not-delete", func() {
+		image := newImage("protected")
+		snap := &snapv1.VolumeSnapshot{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: image.Name, Namespace: namespace.Name}, snap)).To(Succeed())
+		utils.MarkDoNotDelete(snap)
+		Expect(k8sClient.Update(ctx, snap)).To(Succeed())
+		rd.Status.LatestImage = &image
+
+		rd.Spec.Mock.SnapshotRetention.MaxAge = &metav1.Duration{Duration: time.Nanosecond}
+		_, err := r.applySnapshotRetention(ctx, logr.Discard(), rd)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exists("protected")).To(BeTrue())
+		Expect(rd.Status.LatestImage).NotTo(BeNil())
+	})
+})
@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+// staleDeletionGracePeriod is how long a destination with stalePolicy: Delete
+// is reported as pending deletion before it is actually deleted. This gives
+// time to notice the warning and intervene.
+var staleDeletionGracePeriod = time.Hour
+
+// handleStaleDestination checks whether the destination has gone without a
+// completed synchronization for longer than spec.staleAfter and applies the
+// spec.stalePolicy if so. It returns whether the destination was deleted and
+// the amount of time after which staleness should be checked again.
+//
+//nolint:funlen
+func (r *ReplicationDestinationReconciler) handleStaleDestination(ctx context.Context, logger logr.Logger,
+	inst *volsyncv1alpha1.ReplicationDestination) (bool, time.Duration, error) {
+	if inst.Spec.StaleAfter == nil {
+		apimeta.RemoveStatusCondition(&inst.Status.Conditions, volsyncv1alpha1.ConditionStale)
+		return false, 0, nil
+	}
+
+	now := time.Now()
+	staleCond := apimeta.FindStatusCondition(inst.Status.Conditions, volsyncv1alpha1.ConditionStale)
+
+	// We paused the destination and it has since been unpaused, so the user
+	// has resumed it. Staleness is measured from this point forward.
+	if staleCond != nil && staleCond.Reason == volsyncv1alpha1.StaleReasonSuspended && !inst.Spec.Paused {
+		apimeta.RemoveStatusCondition(&inst.Status.Conditions, volsyncv1alpha1.ConditionStale)
+		apimeta.SetStatusCondition(&inst.Status.Conditions, metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionStale,
+			Status:  metav1.ConditionFalse,
+			Reason:  volsyncv1alpha1.StaleReasonResumed,
+			Message: "Destination was resumed after being suspended",
+		})
+		staleCond = apimeta.FindStatusCondition(inst.Status.Conditions, volsyncv1alpha1.ConditionStale)
+	}
+
+	lastActivity := staleReferenceTime(inst, staleCond)
+	staleAt := lastActivity.Add(inst.Spec.StaleAfter.Duration)
+	if now.Before(staleAt) {
+		if staleCond == nil || staleCond.Reason != volsyncv1alpha1.StaleReasonResumed ||
+			!lastActivity.Equal(staleCond.LastTransitionTime.Time) {
+			apimeta.SetStatusCondition(&inst.Status.Conditions, metav1.Condition{
+				Type:    volsyncv1alpha1.ConditionStale,
+				Status:  metav1.ConditionFalse,
+				Reason:  volsyncv1alpha1.StaleReasonRecent,
+				Message: "Synchronization completed within staleAfter",
+			})
+		}
+		return false, staleAt.Sub(now), nil
+	}
+
+	message := fmt.Sprintf("No synchronization has completed since %s", utils.FormatTime(lastActivity))
+	switch inst.Spec.StalePolicy {
+	case volsyncv1alpha1.StalePolicySuspend:
+		if !inst.Spec.Paused {
+			logger.Info("suspending stale destination")
+			status := inst.Status
+			inst.Spec.Paused = true
+			if err := r.Client.Update(ctx, inst); err != nil {
+				return false, 0, err
+			}
+			inst.Status = status
+			r.EventRecorder.Eventf(inst, corev1.EventTypeWarning, volsyncv1alpha1.EvRStaleSuspended,
+				"%s, suspending destination", message)
+		}
+		apimeta.SetStatusCondition(&inst.Status.Conditions, metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionStale,
+			Status:  metav1.ConditionTrue,
+			Reason:  volsyncv1alpha1.StaleReasonSuspended,
+			Message: message + ". Set spec.paused to false to resume.",
+		})
+	case volsyncv1alpha1.StalePolicyDelete:
+		if utils.HasLabel(inst, utils.DoNotDeleteLabelKey) {
+			apimeta.SetStatusCondition(&inst.Status.Conditions, metav1.Condition{
+				Type:    volsyncv1alpha1.ConditionStale,
+				Status:  metav1.ConditionTrue,
+				Reason:  volsyncv1alpha1.StaleReasonStale,
+				Message: message + ". Not deleting due to the " + utils.DoNotDeleteLabelKey + " label.",
+			})
+			return false, 0, nil
+		}
+
+		// Always warn (via the condition and an event) for a full grace period
+		// before deleting
+		if staleCond == nil || staleCond.Reason != volsyncv1alpha1.StaleReasonPendingDeletion {
+			deleteAt := now.Add(staleDeletionGracePeriod)
+			apimeta.RemoveStatusCondition(&inst.Status.Conditions, volsyncv1alpha1.ConditionStale)
+			apimeta.SetStatusCondition(&inst.Status.Conditions, metav1.Condition{
+				Type:    volsyncv1alpha1.ConditionStale,
+				Status:  metav1.ConditionTrue,
+				Reason:  volsyncv1alpha1.StaleReasonPendingDeletion,
+				Message: fmt.Sprintf("%s. Destination will be deleted after %s.", message, utils.FormatTime(deleteAt)),
+			})
+			r.EventRecorder.Eventf(inst, corev1.EventTypeWarning, volsyncv1alpha1.EvRStaleDeleting,
+				"%s, destination will be deleted after %s", message, utils.FormatTime(deleteAt))
+			return false, staleDeletionGracePeriod, nil
+		}
+		deleteAt := staleCond.LastTransitionTime.Add(staleDeletionGracePeriod)
+		if now.Before(deleteAt) {
+			return false, deleteAt.Sub(now), nil
+		}
+
+		logger.Info("deleting stale destination")
+		r.EventRecorder.Eventf(inst, corev1.EventTypeWarning, volsyncv1alpha1.EvRStaleDeleting,
+			"%s, deleting destination", message)
+		err := r.Client.Delete(ctx, inst, client.PropagationPolicy(metav1.DeletePropagationBackground))
+		return err == nil, 0, client.IgnoreNotFound(err)
+	default:
+		if staleCond == nil || staleCond.Status != metav1.ConditionTrue {
+			r.EventRecorder.Eventf(inst, corev1.EventTypeWarning, volsyncv1alpha1.EvRStale, "%s", message)
+		}
+		apimeta.SetStatusCondition(&inst.Status.Conditions, metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionStale,
+			Status:  metav1.ConditionTrue,
+			Reason:  volsyncv1alpha1.StaleReasonStale,
+			Message: message,
+		})
+	}
+	return false, 0, nil
+}
+
+// staleReferenceTime returns the time from which staleness is measured: the
+// most recent of the destination's creation, its last completed
+// synchronization, and when it was resumed after having been suspended.
+func staleReferenceTime(inst *volsyncv1alpha1.ReplicationDestination, staleCond *metav1.Condition) time.Time {
+	ref := inst.GetCreationTimestamp().Time
+	if inst.Status.LastSyncTime != nil && inst.Status.LastSyncTime.After(ref) {
+		ref = inst.Status.LastSyncTime.Time
+	}
+	if staleCond != nil && staleCond.Reason == volsyncv1alpha1.StaleReasonResumed &&
+		staleCond.LastTransitionTime.After(ref) {
+		ref = staleCond.LastTransitionTime.Time
+	}
+	return ref
+}
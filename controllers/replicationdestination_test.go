@@ -8,6 +8,7 @@ import (
 	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
@@ -16,6 +17,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
@@ -619,4 +622,184 @@ var _ = Describe("ReplicationDestination", func() {
 			})
 		})
 	})
+
+	Context("when workloadCoordination is specified", func() {
+		var pvc *corev1.PersistentVolumeClaim
+		var deployment *appsv1.Deployment
+		var pod *corev1.Pod
+		BeforeEach(func() {
+			pvc = &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "app-data",
+					Namespace: rd.Namespace,
+				},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: corev1.ResourceList{
+							"storage": resource.MustParse("1Gi"),
+						},
+					},
+				},
+			}
+			createWithCacheReload(ctx, k8sClient, pvc)
+
+			// Simulate a Deployment w/ a pod using the PVC (there are no
+			// workload controllers in the test environment)
+			labels := map[string]string{"app": "myapp"}
+			podSpec := corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "app", Image: "app"}},
+				Volumes: []corev1.Volume{{
+					Name: "data",
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: pvc.Name},
+					},
+				}},
+			}
+			deployment = &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "myapp",
+					Namespace: rd.Namespace,
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: ptr.To[int32](2),
+					Selector: &metav1.LabelSelector{MatchLabels: labels},
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: labels},
+						Spec:       podSpec,
+					},
+				},
+			}
+			createWithCacheReload(ctx, k8sClient, deployment)
+			replicaSet := &appsv1.ReplicaSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "myapp-1234",
+					Namespace: rd.Namespace,
+				},
+				Spec: appsv1.ReplicaSetSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: labels},
+					Template: deployment.Spec.Template,
+				},
+			}
+			Expect(ctrl.SetControllerReference(deployment, replicaSet, k8sClient.Scheme())).To(Succeed())
+			createWithCacheReload(ctx, k8sClient, replicaSet)
+			pod = &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "myapp-1234-abcd",
+					Namespace: rd.Namespace,
+					Labels:    labels,
+				},
+				Spec: podSpec,
+			}
+			Expect(ctrl.SetControllerReference(replicaSet, pod, k8sClient.Scheme())).To(Succeed())
+			createWithCacheReload(ctx, k8sClient, pod)
+
+			rd.Spec.WorkloadCoordination = &volsyncv1alpha1.WorkloadCoordinationSpec{}
+			rd.Spec.Rsync = &volsyncv1alpha1.ReplicationDestinationRsyncSpec{
+				ReplicationDestinationVolumeOptions: volsyncv1alpha1.ReplicationDestinationVolumeOptions{
+					DestinationPVC: &pvc.Name,
+					CopyMethod:     volsyncv1alpha1.CopyMethodDirect,
+				},
+			}
+		})
+
+		It("stops the workloads during the restore", func() {
+			// The Deployment is scaled down and the restore waits for its pods
+			Eventually(func() int32 {
+				Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+				return *deployment.Spec.Replicas
+			}, maxWait, interval).Should(Equal(int32(0)))
+			Eventually(func() string {
+				_ = k8sClient.Get(ctx, client.ObjectKeyFromObject(rd), rd)
+				if rd.Status == nil || rd.Status.WorkloadCoordination == nil {
+					return ""
+				}
+				return rd.Status.WorkloadCoordination.Step
+			}, maxWait, interval).Should(Equal(volsyncv1alpha1.WorkloadStepWaitingForDetach))
+			Expect(rd.Status.WorkloadCoordination.ScaledWorkloads).To(ConsistOf(volsyncv1alpha1.ScaledWorkload{
+				Kind:     "Deployment",
+				Name:     deployment.Name,
+				Replicas: 2,
+			}))
+			job := &batchv1.Job{}
+			jobKey := types.NamespacedName{Name: rsyncDstPrefix + rd.Name, Namespace: rd.Namespace}
+			Consistently(func() error {
+				return k8sClient.Get(ctx, jobKey, job)
+			}, duration, interval).ShouldNot(Succeed())
+
+			// Once the pod is gone, the restore runs
+			Expect(k8sClient.Delete(ctx, pod, client.GracePeriodSeconds(0))).To(Succeed())
+			Eventually(func() error {
+				return k8sClient.Get(ctx, jobKey, job)
+			}, maxWait, interval).Should(Succeed())
+			job.Status.Succeeded = 1
+			Expect(k8sClient.Status().Update(ctx, job)).To(Succeed())
+
+			// Then the Deployment is scaled back up
+			Eventually(func() int32 {
+				Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+				return *deployment.Spec.Replicas
+			}, maxWait, interval).Should(Equal(int32(2)))
+			Eventually(func() string {
+				_ = k8sClient.Get(ctx, client.ObjectKeyFromObject(rd), rd)
+				return rd.Status.WorkloadCoordination.Step
+			}, maxWait, interval).Should(Equal(volsyncv1alpha1.WorkloadStepCompleted))
+			var steps []string
+			for _, entry := range rd.Status.WorkloadCoordination.Timeline {
+				steps = append(steps, entry.Step)
+			}
+			Expect(steps).To(Equal([]string{
+				volsyncv1alpha1.WorkloadStepScalingDown,
+				volsyncv1alpha1.WorkloadStepWaitingForDetach,
+				volsyncv1alpha1.WorkloadStepRestoring,
+				volsyncv1alpha1.WorkloadStepScalingUp,
+				volsyncv1alpha1.WorkloadStepCompleted,
+			}))
+		})
+	})
+
+	Context("when staleAfter is specified", func() {
+		BeforeEach(func() {
+			capacity := resource.MustParse("2Gi")
+			rd.Spec.Rsync = &volsyncv1alpha1.ReplicationDestinationRsyncSpec{
+				ReplicationDestinationVolumeOptions: volsyncv1alpha1.ReplicationDestinationVolumeOptions{
+					Capacity:    &capacity,
+					AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				},
+			}
+			rd.Spec.StaleAfter = &metav1.Duration{Duration: 2 * time.Second}
+		})
+
+		It("reports the destination as stale when no sync completes", func() {
+			Eventually(func() *metav1.Condition {
+				_ = k8sClient.Get(ctx, client.ObjectKeyFromObject(rd), rd)
+				if rd.Status == nil {
+					return nil
+				}
+				return apimeta.FindStatusCondition(rd.Status.Conditions, volsyncv1alpha1.ConditionStale)
+			}, maxWait, interval).Should(And(Not(BeNil()),
+				HaveField("Status", metav1.ConditionTrue),
+				HaveField("Reason", volsyncv1alpha1.StaleReasonStale)))
+			Expect(rd.Spec.Paused).To(BeFalse())
+		})
+
+		Context("with a stalePolicy of Suspend", func() {
+			BeforeEach(func() {
+				rd.Spec.StalePolicy = volsyncv1alpha1.StalePolicySuspend
+			})
+			It("pauses the destination", func() {
+				Eventually(func() bool {
+					_ = k8sClient.Get(ctx, client.ObjectKeyFromObject(rd), rd)
+					return rd.Spec.Paused
+				}, maxWait, interval).Should(BeTrue())
+				Eventually(func() *metav1.Condition {
+					_ = k8sClient.Get(ctx, client.ObjectKeyFromObject(rd), rd)
+					if rd.Status == nil {
+						return nil
+					}
+					return apimeta.FindStatusCondition(rd.Status.Conditions, volsyncv1alpha1.ConditionStale)
+				}, maxWait, interval).Should(HaveField("Reason", volsyncv1alpha1.StaleReasonSuspended))
+			})
+		})
+	})
 })
@@ -0,0 +1,377 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/mover"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+const (
+	defaultStandbyValidationInterval = 24 * time.Hour
+	defaultStandbyValidationTimeout  = time.Hour
+	// How often to check whether a validation can be started
+	standbyValidationRetryInterval = time.Minute
+	// Annotation on the validation Job recording the image being validated
+	// as <kind>/<name>
+	standbyValidationImageAnnotation = "volsync.backube/validation-image"
+	standbyValidationDataPath        = "/data"
+	standbyValidationDevicePath      = "/dev/block"
+)
+
+// validateStandby carries out spec.standbyValidation: once the interval has
+// passed since the previous validation, a copy of the latest image is mounted
+// read-only in a Job that runs the validation command, and the outcome is recorded in
+// status. It returns the amount of time after which it should be called again.
+func (r *ReplicationDestinationReconciler) validateStandby(ctx context.Context, logger logr.Logger,
+	inst *volsyncv1alpha1.ReplicationDestination) (time.Duration, error) {
+	if inst.Spec.StandbyValidation == nil {
+		inst.Status.StandbyValidation = nil
+		apimeta.RemoveStatusCondition(&inst.Status.Conditions, volsyncv1alpha1.ConditionStandbyValidated)
+		return 0, r.removeStandbyValidation(ctx, inst)
+	}
+	logger = logger.WithValues("validation", standbyValidationName(inst))
+
+	job := &batchv1.Job{}
+	err := r.Client.Get(ctx, client.ObjectKey{Name: standbyValidationName(inst), Namespace: inst.Namespace}, job)
+	if err == nil {
+		return r.finishStandbyValidation(ctx, logger, inst, job)
+	}
+	if !kerrors.IsNotFound(err) {
+		return 0, err
+	}
+
+	image := inst.Status.LatestImage
+	if image == nil {
+		apimeta.SetStatusCondition(&inst.Status.Conditions, metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionStandbyValidated,
+			Status:  metav1.ConditionFalse,
+			Reason:  volsyncv1alpha1.StandbyValidatedReasonWaiting,
+			Message: "Waiting for a synchronization to complete before validating",
+		})
+		return standbyValidationRetryInterval, nil
+	}
+
+	interval := defaultStandbyValidationInterval
+	if inst.Spec.StandbyValidation.Interval != nil {
+		interval = inst.Spec.StandbyValidation.Interval.Duration
+	}
+	if last := inst.Status.StandbyValidation; last != nil {
+		if next := last.Time.Add(interval); time.Now().Before(next) {
+			return time.Until(next), nil
+		}
+	}
+	if !utils.IsSnapshot(image) && inst.Status.LastSyncStartTime != nil {
+		// Don't clone the destination PVC while it is being written to
+		return standbyValidationRetryInterval, nil
+	}
+
+	started, err := r.startStandbyValidation(ctx, logger, inst, image)
+	if err != nil {
+		apimeta.SetStatusCondition(&inst.Status.Conditions, metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionStandbyValidated,
+			Status:  metav1.ConditionFalse,
+			Reason:  volsyncv1alpha1.StandbyValidatedReasonError,
+			Message: err.Error(),
+		})
+		return 0, err
+	}
+	if !started {
+		return standbyValidationRetryInterval, nil
+	}
+	// The Job is watched, so there is no need to requeue while it runs
+	return 0, nil
+}
+
+// startStandbyValidation creates the validation Job for the image. The Job
+// never uses the image itself: a VolumeSnapshot is restored, and the
+// destination PVC is cloned, into a temporary PVC. It returns false if the Job
+// could not be created yet.
+func (r *ReplicationDestinationReconciler) startStandbyValidation(ctx context.Context, logger logr.Logger,
+	inst *volsyncv1alpha1.ReplicationDestination, image *corev1.TypedLocalObjectReference) (bool, error) {
+	spec := inst.Spec.StandbyValidation
+	containerImage, err := utils.MoverImage("", &spec.Image)
+	if err != nil {
+		return false, err
+	}
+
+	pvc, err := r.ensureStandbyValidationPVC(ctx, inst, image)
+	if err != nil || pvc == nil {
+		return false, err
+	}
+
+	timeout := defaultStandbyValidationTimeout
+	if spec.Timeout != nil {
+		timeout = spec.Timeout.Duration
+	}
+	container := corev1.Container{
+		Name:    "validate",
+		Image:   containerImage,
+		Command: spec.Command,
+		Args:    spec.Args,
+	}
+	if utils.PvcIsBlockMode(pvc) {
+		container.VolumeDevices = []corev1.VolumeDevice{{Name: "data", DevicePath: standbyValidationDevicePath}}
+	} else {
+		container.VolumeMounts = []corev1.VolumeMount{
+			{Name: "data", MountPath: standbyValidationDataPath, ReadOnly: true},
+		}
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      standbyValidationName(inst),
+			Namespace: inst.Namespace,
+			Annotations: map[string]string{
+				standbyValidationImageAnnotation: image.Kind + "/" + image.Name,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:          ptr.To[int32](0),
+			ActiveDeadlineSeconds: ptr.To(int64(timeout.Seconds())),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers:      []corev1.Container{container},
+					RestartPolicy:   corev1.RestartPolicyNever,
+					SecurityContext: utils.MoverSecurityContextFor(inst),
+					Volumes: []corev1.Volume{{
+						Name: "data",
+						VolumeSource: corev1.VolumeSource{
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+								ClaimName: pvc.Name,
+								ReadOnly:  true,
+							},
+						},
+					}},
+				},
+			},
+		},
+	}
+	if spec.ServiceAccountName != nil {
+		job.Spec.Template.Spec.ServiceAccountName = *spec.ServiceAccountName
+	}
+	utils.ApplySecurityProfile(&job.Spec.Template, inst)
+	if err := ctrl.SetControllerReference(inst, job, r.Client.Scheme()); err != nil {
+		logger.Error(err, utils.ErrUnableToSetControllerRef)
+		return false, err
+	}
+	utils.SetOwnedByVolSync(job)
+	if err := r.Client.Create(ctx, job); err != nil {
+		return false, err
+	}
+	logger.Info("started validation of latest image", "image", image.Name)
+	return true, nil
+}
+
+// ensureStandbyValidationPVC returns the temporary PVC restoring the
+// VolumeSnapshot, or cloning the PVC, for the validation, creating it if
+// needed. It returns nil if a PVC left over from a previous validation is
+// still being removed.
+func (r *ReplicationDestinationReconciler) ensureStandbyValidationPVC(ctx context.Context,
+	inst *volsyncv1alpha1.ReplicationDestination,
+	image *corev1.TypedLocalObjectReference) (*corev1.PersistentVolumeClaim, error) {
+	pvc := &corev1.PersistentVolumeClaim{}
+	err := r.Client.Get(ctx, client.ObjectKey{Name: standbyValidationName(inst), Namespace: inst.Namespace}, pvc)
+	if err == nil {
+		if pvc.DeletionTimestamp.IsZero() && pvc.Spec.DataSource != nil &&
+			pvc.Spec.DataSource.Kind == image.Kind && pvc.Spec.DataSource.Name == image.Name {
+			return pvc, nil
+		}
+		return nil, client.IgnoreNotFound(r.Client.Delete(ctx, pvc))
+	}
+	if !kerrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	var spec corev1.PersistentVolumeClaimSpec
+	if utils.IsSnapshot(image) {
+		snap := &snapv1.VolumeSnapshot{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Name: image.Name, Namespace: inst.Namespace}, snap); err != nil {
+			return nil, err
+		}
+		spec, err = pvcSpecFromSnapshot(inst, snap, nil)
+	} else {
+		src := &corev1.PersistentVolumeClaim{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Name: image.Name, Namespace: inst.Namespace}, src); err != nil {
+			return nil, err
+		}
+		spec, err = pvcSpecForClone(src)
+	}
+	if err != nil {
+		return nil, err
+	}
+	pvc = &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      standbyValidationName(inst),
+			Namespace: inst.Namespace,
+		},
+		Spec: spec,
+	}
+	if err := ctrl.SetControllerReference(inst, pvc, r.Client.Scheme()); err != nil {
+		return nil, err
+	}
+	utils.SetOwnedByVolSync(pvc)
+	return pvc, r.Client.Create(ctx, pvc)
+}
+
+// pvcSpecForClone returns the spec of a PVC that clones src
+func pvcSpecForClone(src *corev1.PersistentVolumeClaim) (corev1.PersistentVolumeClaimSpec, error) {
+	capacity := src.Spec.Resources.Requests.Storage()
+	if statusCapacity, ok := src.Status.Capacity[corev1.ResourceStorage]; ok && statusCapacity.Cmp(*capacity) > 0 {
+		capacity = &statusCapacity
+	}
+	if capacity.IsZero() {
+		return corev1.PersistentVolumeClaimSpec{}, fmt.Errorf("the size of PVC %s is not known", src.Name)
+	}
+	return corev1.PersistentVolumeClaimSpec{
+		AccessModes:      src.Spec.AccessModes,
+		StorageClassName: src.Spec.StorageClassName,
+		VolumeMode:       src.Spec.VolumeMode,
+		Resources: corev1.VolumeResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceStorage: *capacity},
+		},
+		DataSource: &corev1.TypedLocalObjectReference{
+			Kind: "PersistentVolumeClaim",
+			Name: src.Name,
+		},
+	}, nil
+}
+
+// finishStandbyValidation records the outcome of the validation Job once it
+// has finished, and removes it
+func (r *ReplicationDestinationReconciler) finishStandbyValidation(ctx context.Context, logger logr.Logger,
+	inst *volsyncv1alpha1.ReplicationDestination, job *batchv1.Job) (time.Duration, error) {
+	if !job.DeletionTimestamp.IsZero() {
+		// A previous validation is still being removed
+		return standbyValidationRetryInterval, nil
+	}
+
+	failed := job.Spec.BackoffLimit != nil && job.Status.Failed > *job.Spec.BackoffLimit
+	for _, c := range job.Status.Conditions {
+		if c.Type == batchv1.JobFailed && c.Status == corev1.ConditionTrue {
+			failed = true
+		}
+	}
+	if job.Status.Succeeded == 0 && !failed {
+		return 0, nil
+	}
+
+	output := &volsyncv1alpha1.MoverStatus{}
+	result := volsyncv1alpha1.RestoreVerificationPassed
+	if failed {
+		result = volsyncv1alpha1.RestoreVerificationFailed
+		utils.UpdateMoverStatusForFailedJob(ctx, logger, output, job.Name, job.Namespace, nil)
+	} else {
+		utils.UpdateMoverStatusForSuccessfulJob(ctx, logger, output, job.Name, job.Namespace, nil)
+	}
+
+	image := standbyValidationImage(job)
+	inst.Status.StandbyValidation = &volsyncv1alpha1.StandbyValidationStatus{
+		Result:  result,
+		Image:   image,
+		Time:    metav1.Now(),
+		Message: output.Logs,
+	}
+	imageName := ""
+	if image != nil {
+		imageName = image.Name
+	}
+	if failed {
+		apimeta.SetStatusCondition(&inst.Status.Conditions, metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionStandbyValidated,
+			Status:  metav1.ConditionFalse,
+			Reason:  volsyncv1alpha1.StandbyValidatedReasonFailed,
+			Message: fmt.Sprintf("Validation of %s failed", imageName),
+		})
+		r.EventRecorder.Eventf(inst, corev1.EventTypeWarning, volsyncv1alpha1.EvRStandbyValidationFailed,
+			"validation of %s failed", imageName)
+	} else {
+		apimeta.SetStatusCondition(&inst.Status.Conditions, metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionStandbyValidated,
+			Status:  metav1.ConditionTrue,
+			Reason:  volsyncv1alpha1.StandbyValidatedReasonPassed,
+			Message: fmt.Sprintf("Validation of %s passed", imageName),
+		})
+		r.EventRecorder.Eventf(inst, corev1.EventTypeNormal, volsyncv1alpha1.EvRStandbyValidationPassed,
+			"validation of %s passed", imageName)
+	}
+	logger.Info("validation of latest image finished", "image", imageName, "result", result)
+
+	interval := defaultStandbyValidationInterval
+	if inst.Spec.StandbyValidation.Interval != nil {
+		interval = inst.Spec.StandbyValidation.Interval.Duration
+	}
+	return interval, r.removeStandbyValidation(ctx, inst)
+}
+
+// removeStandbyValidation deletes the validation Job and temporary PVC, if
+// they exist
+func (r *ReplicationDestinationReconciler) removeStandbyValidation(ctx context.Context,
+	inst *volsyncv1alpha1.ReplicationDestination) error {
+	key := client.ObjectKey{Name: standbyValidationName(inst), Namespace: inst.Namespace}
+	for _, obj := range []client.Object{&batchv1.Job{}, &corev1.PersistentVolumeClaim{}} {
+		if err := r.Client.Get(ctx, key, obj); err != nil {
+			if kerrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if !obj.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+		err := r.Client.Delete(ctx, obj, client.PropagationPolicy(metav1.DeletePropagationBackground))
+		if client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// standbyValidationImage returns the image that the validation Job validates
+func standbyValidationImage(job *batchv1.Job) *corev1.TypedLocalObjectReference {
+	kind, name, ok := strings.Cut(job.Annotations[standbyValidationImageAnnotation], "/")
+	if !ok {
+		return nil
+	}
+	image := &corev1.TypedLocalObjectReference{Kind: kind, Name: name}
+	if kind == "VolumeSnapshot" {
+		image.APIGroup = &snapv1.SchemeGroupVersion.Group
+	}
+	return image
+}
+
+func standbyValidationName(inst *volsyncv1alpha1.ReplicationDestination) string {
+	return mover.VolSyncPrefix + "validate-" + inst.Name
+}
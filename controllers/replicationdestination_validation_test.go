@@ -0,0 +1,235 @@
+package controllers
+
+import (
+	"errors"
+
+	"github.com/go-logr/logr"
+	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("ReplicationDestination standby validation", func() {
+	var namespace *corev1.Namespace
+	var rd *volsyncv1alpha1.ReplicationDestination
+	var r *ReplicationDestinationReconciler
+
+	BeforeEach(func() {
+		namespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "volsync-test-",
+			},
+		}
+		createWithCacheReload(ctx, k8sClient, namespace)
+		utils.AllowedMoverImages = "quay.io/example/*"
+		rd = &volsyncv1alpha1.ReplicationDestination{
+			ObjectMeta: metav1.ObjectMeta{Name: "rd", Namespace: namespace.Name},
+			Spec: volsyncv1alpha1.ReplicationDestinationSpec{
+				// Keep the controller of the test suite from acting on it
+				Paused: true,
+				StandbyValidation: &volsyncv1alpha1.StandbyValidationSpec{
+					JobHook: volsyncv1alpha1.JobHook{
+						Image:   "quay.io/example/fsck",
+						Command: []string{"/bin/check", "/data"},
+					},
+					Interval: &metav1.Duration{Duration: defaultStandbyValidationInterval},
+				},
+			},
+		}
+		createWithCacheReload(ctx, k8sClient, rd)
+		rd.Status = &volsyncv1alpha1.ReplicationDestinationStatus{}
+		r = &ReplicationDestinationReconciler{
+			Client:        k8sClient,
+			Log:           logr.Discard(),
+			Scheme:        k8sClient.Scheme(),
+			EventRecorder: record.NewFakeRecorder(10),
+		}
+	})
+	AfterEach(func() {
+		utils.AllowedMoverImages = ""
+		Expect(k8sClient.Delete(ctx, namespace)).To(Succeed())
+	})
+
+	getJob := func() *batchv1.Job {
+		job := &batchv1.Job{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: standbyValidationName(rd), Namespace: namespace.Name},
+			job)).To(Succeed())
+		return job
+	}
+
+	It("waits for a latest image", func() {
+		after, err := r.validateStandby(ctx, logr.Discard(), rd)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(after).To(Equal(standbyValidationRetryInterval))
+		cond := apimeta.FindStatusCondition(rd.Status.Conditions, volsyncv1alpha1.ConditionStandbyValidated)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(volsyncv1alpha1.StandbyValidatedReasonWaiting))
+	})
+
+	It("validates the latest snapshot in a temporary PVC", func() {
+		snap := &snapv1.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Name: "latest", Namespace: namespace.Name},
+			Spec: snapv1.VolumeSnapshotSpec{
+				Source: snapv1.VolumeSnapshotSource{PersistentVolumeClaimName: ptr.To("dest")},
+			},
+		}
+		Expect(ctrl.SetControllerReference(rd, snap, k8sClient.Scheme())).To(Succeed())
+		createWithCacheReload(ctx, k8sClient, snap)
+		snap.Status = &snapv1.VolumeSnapshotStatus{RestoreSize: ptr.To(resource.MustParse("2Gi"))}
+		Expect(k8sClient.Status().Update(ctx, snap)).To(Succeed())
+		rd.Status.LatestImage = &corev1.TypedLocalObjectReference{
+			APIGroup: &snapv1.SchemeGroupVersion.Group,
+			Kind:     "VolumeSnapshot",
+			Name:     snap.Name,
+		}
+
+		_, err := r.validateStandby(ctx, logr.Discard(), rd)
+		Expect(err).NotTo(HaveOccurred())
+
+		pvc := &corev1.PersistentVolumeClaim{}
+		Eventually(func() error {
+			return k8sClient.Get(ctx, client.ObjectKey{Name: standbyValidationName(rd), Namespace: namespace.Name},
+				pvc)
+		}, maxWait, interval).Should(Succeed())
+		Expect(pvc.Spec.DataSource.Name).To(Equal(snap.Name))
+		Expect(pvc.Spec.Resources.Requests.Storage().String()).To(Equal("2Gi"))
+
+		// The PVC only shows up in the cache after a while
+		var job *batchv1.Job
+		Eventually(func() error {
+			if _, err := r.validateStandby(ctx, logr.Discard(), rd); err != nil {
+				return err
+			}
+			job = &batchv1.Job{}
+			return k8sClient.Get(ctx, client.ObjectKey{Name: standbyValidationName(rd), Namespace: namespace.Name},
+				job)
+		}, maxWait, interval).Should(Succeed())
+		podSpec := job.Spec.Template.Spec
+		Expect(podSpec.Containers[0].Command).To(Equal([]string{"/bin/check", "/data"}))
+		Expect(podSpec.Containers[0].VolumeMounts[0].MountPath).To(Equal(standbyValidationDataPath))
+		Expect(podSpec.Containers[0].VolumeMounts[0].ReadOnly).To(BeTrue())
+		Expect(podSpec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal(pvc.Name))
+		Expect(podSpec.Volumes[0].PersistentVolumeClaim.ReadOnly).To(BeTrue())
+
+		// Fail the Job
+		job.Status.Failed = 1
+		job.Status.StartTime = ptr.To(metav1.Now())
+		job.Status.Conditions = []batchv1.JobCondition{
+			{Type: batchv1.JobFailureTarget, Status: corev1.ConditionTrue},
+			{Type: batchv1.JobFailed, Status: corev1.ConditionTrue},
+		}
+		Expect(k8sClient.Status().Update(ctx, job)).To(Succeed())
+		Eventually(func() bool {
+			return getJob().Status.Failed == 1
+		}, maxWait, interval).Should(BeTrue())
+
+		after, err := r.validateStandby(ctx, logr.Discard(), rd)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(after).To(Equal(defaultStandbyValidationInterval))
+		Expect(rd.Status.StandbyValidation).NotTo(BeNil())
+		Expect(rd.Status.StandbyValidation.Result).To(Equal(volsyncv1alpha1.RestoreVerificationFailed))
+		Expect(rd.Status.StandbyValidation.Image.Name).To(Equal(snap.Name))
+		Expect(*rd.Status.StandbyValidation.Image.APIGroup).To(Equal(snapv1.SchemeGroupVersion.Group))
+		cond := apimeta.FindStatusCondition(rd.Status.Conditions, volsyncv1alpha1.ConditionStandbyValidated)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(volsyncv1alpha1.StandbyValidatedReasonFailed))
+
+		// The Job has been removed and the next validation waits for the
+		// interval
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, client.ObjectKeyFromObject(job), &batchv1.Job{})
+			return kerrors.IsNotFound(err)
+		}, maxWait, interval).Should(BeTrue())
+		after, err = r.validateStandby(ctx, logr.Discard(), rd)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(after).To(BeNumerically(">", defaultStandbyValidationInterval/2))
+	})
+
+	It("does not use an image that is not in the allowed mover images", func() {
+		utils.AllowedMoverImages = "quay.io/backube/volsync:*"
+		rd.Status.LatestImage = &corev1.TypedLocalObjectReference{
+			Kind: "PersistentVolumeClaim",
+			Name: "dest",
+		}
+		_, err := r.validateStandby(ctx, logr.Discard(), rd)
+		Expect(errors.Is(err, utils.ErrMoverImageNotAllowed)).To(BeTrue())
+		cond := apimeta.FindStatusCondition(rd.Status.Conditions, volsyncv1alpha1.ConditionStandbyValidated)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Reason).To(Equal(volsyncv1alpha1.StandbyValidatedReasonError))
+		err = k8sClient.Get(ctx, client.ObjectKey{Name: standbyValidationName(rd), Namespace: namespace.Name},
+			&batchv1.Job{})
+		Expect(kerrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("validates a clone of the destination PVC with copyMethod Direct", func() {
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "dest", Namespace: namespace.Name},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+				},
+			},
+		}
+		createWithCacheReload(ctx, k8sClient, pvc)
+		rd.Status.LatestImage = &corev1.TypedLocalObjectReference{
+			Kind: "PersistentVolumeClaim",
+			Name: pvc.Name,
+		}
+
+		// Not while a synchronization is writing to it
+		rd.Status.LastSyncStartTime = ptr.To(metav1.Now())
+		after, err := r.validateStandby(ctx, logr.Discard(), rd)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(after).To(Equal(standbyValidationRetryInterval))
+
+		rd.Status.LastSyncStartTime = nil
+		_, err = r.validateStandby(ctx, logr.Discard(), rd)
+		Expect(err).NotTo(HaveOccurred())
+		clone := &corev1.PersistentVolumeClaim{}
+		Eventually(func() error {
+			return k8sClient.Get(ctx, client.ObjectKey{Name: standbyValidationName(rd), Namespace: namespace.Name},
+				clone)
+		}, maxWait, interval).Should(Succeed())
+		Expect(clone.Spec.DataSource.Kind).To(Equal("PersistentVolumeClaim"))
+		Expect(clone.Spec.DataSource.Name).To(Equal(pvc.Name))
+		Expect(clone.Spec.Resources.Requests.Storage().String()).To(Equal("1Gi"))
+
+		// The PVC only shows up in the cache after a while
+		var job *batchv1.Job
+		Eventually(func() error {
+			if _, err := r.validateStandby(ctx, logr.Discard(), rd); err != nil {
+				return err
+			}
+			job = &batchv1.Job{}
+			return k8sClient.Get(ctx, client.ObjectKey{Name: standbyValidationName(rd), Namespace: namespace.Name},
+				job)
+		}, maxWait, interval).Should(Succeed())
+		Expect(job.Spec.Template.Spec.Containers[0].Image).To(Equal("quay.io/example/fsck"))
+		Expect(job.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal(clone.Name))
+		Expect(job.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim.ReadOnly).To(BeTrue())
+
+		// Removing standbyValidation removes the Job
+		rd.Spec.StandbyValidation = nil
+		_, err = r.validateStandby(ctx, logr.Discard(), rd)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rd.Status.StandbyValidation).To(BeNil())
+		Expect(apimeta.FindStatusCondition(rd.Status.Conditions,
+			volsyncv1alpha1.ConditionStandbyValidated)).To(BeNil())
+	})
+})
@@ -0,0 +1,370 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/mover"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+// maxWorkloadTimelineEntries limits the size of
+// status.workloadCoordination.timeline
+const maxWorkloadTimelineEntries = 20
+
+//nolint:lll
+//+kubebuilder:rbac:groups=apps,resources=deployments;statefulsets,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=apps,resources=replicasets,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=pods/eviction,verbs=create
+//+kubebuilder:rbac:groups=storage.k8s.io,resources=volumeattachments,verbs=get;list;watch
+
+// synchronizeWithWorkloadCoordination performs the synchronization while the
+// workloads using the destination PVC are stopped (see
+// spec.workloadCoordination). The progress is tracked in
+// status.workloadCoordination so that it survives restarts of the operator.
+//
+//nolint:funlen
+func (m *rdMachine) synchronizeWithWorkloadCoordination(ctx context.Context) (mover.Result, error) {
+	pvcName := destinationPVCName(m.rd)
+	if pvcName == "" {
+		// VolSync provisions the destination PVC, so no workloads can be using it
+		return m.synchronize(ctx)
+	}
+
+	st := m.rd.Status.WorkloadCoordination
+	if st == nil || st.Step == "" || st.Step == volsyncv1alpha1.WorkloadStepCompleted ||
+		st.Step == volsyncv1alpha1.WorkloadStepBudgetExceeded {
+		st = &volsyncv1alpha1.WorkloadCoordinationStatus{
+			DowntimeStart: ptr.To(metav1.Now()),
+		}
+		m.rd.Status.WorkloadCoordination = st
+		m.setWorkloadStep(volsyncv1alpha1.WorkloadStepScalingDown, "Stopping the workloads using PVC "+pvcName)
+	}
+
+	var result mover.Result
+	var err error
+	switch st.Step {
+	case volsyncv1alpha1.WorkloadStepScalingDown, volsyncv1alpha1.WorkloadStepWaitingForDetach:
+		// Scaling down is repeated while waiting in case new pods show up
+		inUse, err := m.stopWorkloads(ctx, pvcName)
+		if err != nil {
+			return mover.InProgress(), err
+		}
+		if st.Step == volsyncv1alpha1.WorkloadStepScalingDown {
+			m.setWorkloadStep(volsyncv1alpha1.WorkloadStepWaitingForDetach, "")
+		}
+		if !inUse {
+			attached, err := m.volumeAttached(ctx, pvcName)
+			if err != nil {
+				return mover.InProgress(), err
+			}
+			inUse = attached
+		}
+		if inUse {
+			if m.downtimeBudgetExceeded() {
+				return mover.InProgress(), m.abortWorkloadCoordination(ctx)
+			}
+			return mover.RetryAfter(5 * time.Second), nil
+		}
+		m.setWorkloadStep(volsyncv1alpha1.WorkloadStepRestoring, "")
+		fallthrough
+	case volsyncv1alpha1.WorkloadStepRestoring:
+		result, err = m.synchronize(ctx)
+		if err != nil || !result.Completed {
+			if m.downtimeBudgetExceeded() {
+				if _, cleanupErr := m.mover.Cleanup(ctx); cleanupErr != nil {
+					return mover.InProgress(), cleanupErr
+				}
+				return mover.InProgress(), m.abortWorkloadCoordination(ctx)
+			}
+			return result, err
+		}
+		m.setWorkloadStep(volsyncv1alpha1.WorkloadStepScalingUp, "")
+		fallthrough
+	case volsyncv1alpha1.WorkloadStepScalingUp:
+		if err := m.restartWorkloads(ctx); err != nil {
+			return mover.InProgress(), err
+		}
+		if !result.Completed {
+			// Resuming after an error scaling up. The mover is not cleaned up
+			// until the sync completes, so this returns the result of the
+			// completed restore.
+			result, err = m.synchronize(ctx)
+			if err != nil || !result.Completed {
+				return result, err
+			}
+		}
+		m.setWorkloadStep(volsyncv1alpha1.WorkloadStepCompleted,
+			fmt.Sprintf("Downtime: %s", time.Since(st.DowntimeStart.Time).Round(time.Second)))
+		return result, nil
+	}
+
+	return mover.InProgress(), fmt.Errorf("unknown workload coordination step: %s", st.Step)
+}
+
+// stopWorkloads scales down the Deployments and StatefulSets with pods that
+// use the PVC, and evicts the other pods if permitted. It returns whether any
+// pods are still using the PVC.
+func (m *rdMachine) stopWorkloads(ctx context.Context, pvcName string) (bool, error) {
+	pods := &corev1.PodList{}
+	if err := m.client.List(ctx, pods, client.InNamespace(m.rd.GetNamespace())); err != nil {
+		return false, err
+	}
+
+	inUse := false
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if !podUsesPVC(pod, pvcName) || utils.IsOwnedByVolsync(pod) ||
+			pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		inUse = true
+
+		kind, name, err := m.workloadForPod(ctx, pod)
+		if err != nil {
+			return true, err
+		}
+		if kind != "" {
+			if err := m.scaleDownWorkload(ctx, kind, name); err != nil {
+				return true, err
+			}
+			continue
+		}
+
+		if m.rd.Spec.WorkloadCoordination.EvictPods && pod.GetDeletionTimestamp().IsZero() {
+			m.logger.Info("evicting pod using the destination PVC", "pod", pod.GetName())
+			err := m.client.SubResource("eviction").Create(ctx, pod, &policyv1.Eviction{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      pod.GetName(),
+					Namespace: pod.GetNamespace(),
+				},
+			})
+			if err != nil && !kerrors.IsNotFound(err) && !kerrors.IsTooManyRequests(err) {
+				// TooManyRequests means a PodDisruptionBudget is blocking
+				// the eviction, so it is retried later
+				return true, err
+			}
+		}
+	}
+	return inUse, nil
+}
+
+// workloadForPod returns the Deployment or StatefulSet that the pod belongs
+// to, if any
+func (m *rdMachine) workloadForPod(ctx context.Context, pod *corev1.Pod) (string, string, error) {
+	ref := metav1.GetControllerOf(pod)
+	if ref == nil {
+		return "", "", nil
+	}
+	switch ref.Kind {
+	case "StatefulSet":
+		return ref.Kind, ref.Name, nil
+	case "ReplicaSet":
+		rs := &appsv1.ReplicaSet{}
+		err := m.client.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: pod.GetNamespace()}, rs)
+		if err != nil {
+			return "", "", client.IgnoreNotFound(err)
+		}
+		if rsRef := metav1.GetControllerOf(rs); rsRef != nil && rsRef.Kind == "Deployment" {
+			return rsRef.Kind, rsRef.Name, nil
+		}
+	}
+	return "", "", nil
+}
+
+func (m *rdMachine) scaleDownWorkload(ctx context.Context, kind, name string) error {
+	st := m.rd.Status.WorkloadCoordination
+	for _, w := range st.ScaledWorkloads {
+		if w.Kind == kind && w.Name == name {
+			// Already scaled down
+			return nil
+		}
+	}
+
+	replicas, err := m.scaleWorkload(ctx, kind, name, 0)
+	if err != nil {
+		return err
+	}
+	st.ScaledWorkloads = append(st.ScaledWorkloads, volsyncv1alpha1.ScaledWorkload{
+		Kind:     kind,
+		Name:     name,
+		Replicas: replicas,
+	})
+	m.eventRecorder.Eventf(m.rd, nil, corev1.EventTypeNormal, volsyncv1alpha1.EvRWorkloadsScaledDown,
+		volsyncv1alpha1.EvAScaleWorkloads, "scaled down %s %s from %d replicas", kind, name, replicas)
+	return nil
+}
+
+// restartWorkloads scales the workloads back up to their original number of
+// replicas
+func (m *rdMachine) restartWorkloads(ctx context.Context) error {
+	st := m.rd.Status.WorkloadCoordination
+	for _, w := range st.ScaledWorkloads {
+		if _, err := m.scaleWorkload(ctx, w.Kind, w.Name, w.Replicas); err != nil {
+			if kerrors.IsNotFound(err) {
+				// The workload has been deleted in the meantime
+				continue
+			}
+			return err
+		}
+		m.eventRecorder.Eventf(m.rd, nil, corev1.EventTypeNormal, volsyncv1alpha1.EvRWorkloadsScaledUp,
+			volsyncv1alpha1.EvAScaleWorkloads, "scaled up %s %s to %d replicas", w.Kind, w.Name, w.Replicas)
+	}
+	st.ScaledWorkloads = nil
+	return nil
+}
+
+// scaleWorkload sets the replicas of a Deployment or StatefulSet, returning
+// the previous number of replicas
+func (m *rdMachine) scaleWorkload(ctx context.Context, kind, name string, replicas int32) (int32, error) {
+	var obj client.Object
+	var specReplicas **int32
+	switch kind {
+	case "Deployment":
+		deployment := &appsv1.Deployment{}
+		obj, specReplicas = deployment, &deployment.Spec.Replicas
+	case "StatefulSet":
+		statefulSet := &appsv1.StatefulSet{}
+		obj, specReplicas = statefulSet, &statefulSet.Spec.Replicas
+	default:
+		return 0, fmt.Errorf("unable to scale %s %s", kind, name)
+	}
+	if err := m.client.Get(ctx, client.ObjectKey{Name: name, Namespace: m.rd.GetNamespace()}, obj); err != nil {
+		return 0, err
+	}
+
+	// Replicas defaults to 1
+	previous := ptr.Deref(*specReplicas, 1)
+	if previous == replicas {
+		return previous, nil
+	}
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	*specReplicas = ptr.To(replicas)
+	m.logger.Info("scaling workload", "kind", kind, "name", name, "replicas", replicas)
+	return previous, m.client.Patch(ctx, obj, patch)
+}
+
+// volumeAttached returns whether the volume bound to the PVC is still
+// attached to a node
+func (m *rdMachine) volumeAttached(ctx context.Context, pvcName string) (bool, error) {
+	pvc := &corev1.PersistentVolumeClaim{}
+	err := m.client.Get(ctx, client.ObjectKey{Name: pvcName, Namespace: m.rd.GetNamespace()}, pvc)
+	if err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	if pvc.Spec.VolumeName == "" {
+		return false, nil
+	}
+
+	attachments := &storagev1.VolumeAttachmentList{}
+	if err := m.client.List(ctx, attachments); err != nil {
+		return false, err
+	}
+	for _, va := range attachments.Items {
+		if va.Spec.Source.PersistentVolumeName != nil &&
+			*va.Spec.Source.PersistentVolumeName == pvc.Spec.VolumeName && va.Status.Attached {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *rdMachine) downtimeBudgetExceeded() bool {
+	budget := m.rd.Spec.WorkloadCoordination.MaxDowntime
+	st := m.rd.Status.WorkloadCoordination
+	return budget != nil && st.DowntimeStart != nil && time.Since(st.DowntimeStart.Time) > budget.Duration
+}
+
+// abortWorkloadCoordination restarts the workloads after the downtime budget
+// has been exceeded. The returned error causes the restore to be retried.
+func (m *rdMachine) abortWorkloadCoordination(ctx context.Context) error {
+	if err := m.restartWorkloads(ctx); err != nil {
+		return err
+	}
+	message := fmt.Sprintf("restore did not complete within the downtime budget of %s",
+		m.rd.Spec.WorkloadCoordination.MaxDowntime.Duration)
+	m.setWorkloadStep(volsyncv1alpha1.WorkloadStepBudgetExceeded, message)
+	m.eventRecorder.Eventf(m.rd, nil, corev1.EventTypeWarning, volsyncv1alpha1.EvRDowntimeBudgetExceeded,
+		volsyncv1alpha1.EvAScaleWorkloads, "%s, workloads have been scaled back up", message)
+	return fmt.Errorf("%s", message)
+}
+
+func (m *rdMachine) setWorkloadStep(step, message string) {
+	st := m.rd.Status.WorkloadCoordination
+	if step == volsyncv1alpha1.WorkloadStepScalingDown {
+		// A new restore starts a new timeline
+		st.Timeline = nil
+	}
+	st.Step = step
+	st.Timeline = append(st.Timeline, volsyncv1alpha1.WorkloadTimelineEntry{
+		Time:    metav1.Now(),
+		Step:    step,
+		Message: message,
+	})
+	if len(st.Timeline) > maxWorkloadTimelineEntries {
+		st.Timeline = st.Timeline[len(st.Timeline)-maxWorkloadTimelineEntries:]
+	}
+}
+
+func podUsesPVC(pod *corev1.Pod, pvcName string) bool {
+	for _, vol := range pod.Spec.Volumes {
+		if vol.PersistentVolumeClaim != nil && vol.PersistentVolumeClaim.ClaimName == pvcName {
+			return true
+		}
+	}
+	return false
+}
+
+// destinationPVCName returns the name of the user-supplied destination PVC of
+// the ReplicationDestination, or "" if VolSync provisions it
+func destinationPVCName(rd *volsyncv1alpha1.ReplicationDestination) string {
+	var opts *volsyncv1alpha1.ReplicationDestinationVolumeOptions
+	switch {
+	case rd.Spec.Rsync != nil:
+		opts = &rd.Spec.Rsync.ReplicationDestinationVolumeOptions
+	case rd.Spec.RsyncTLS != nil:
+		opts = &rd.Spec.RsyncTLS.ReplicationDestinationVolumeOptions
+	case rd.Spec.Block != nil:
+		opts = &rd.Spec.Block.ReplicationDestinationVolumeOptions
+	case rd.Spec.NFS != nil:
+		opts = &rd.Spec.NFS.ReplicationDestinationVolumeOptions
+	case rd.Spec.Mock != nil:
+		opts = &rd.Spec.Mock.ReplicationDestinationVolumeOptions
+	case rd.Spec.Rclone != nil:
+		opts = &rd.Spec.Rclone.ReplicationDestinationVolumeOptions
+	case rd.Spec.Restic != nil:
+		opts = &rd.Spec.Restic.ReplicationDestinationVolumeOptions
+	}
+	if opts == nil || opts.DestinationPVC == nil {
+		return ""
+	}
+	return *opts.DestinationPVC
+}
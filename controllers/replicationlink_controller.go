@@ -0,0 +1,291 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+// How often the status of the two ends is retrieved, if not specified
+const defaultLinkRefreshInterval = 5 * time.Minute
+
+//nolint:lll
+//+kubebuilder:rbac:groups=volsync.backube,resources=replicationlinks,verbs=get;list;watch
+//+kubebuilder:rbac:groups=volsync.backube,resources=replicationlinks/finalizers,verbs=update
+//+kubebuilder:rbac:groups=volsync.backube,resources=replicationlinks/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=volsync.backube,resources=replicationsources,verbs=get;list;watch
+//+kubebuilder:rbac:groups=volsync.backube,resources=replicationdestinations,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+
+// ReplicationLinkReconciler reconciles a ReplicationLink object, combining the
+// status of a ReplicationSource and a ReplicationDestination that may be in
+// different clusters.
+type ReplicationLinkReconciler struct {
+	client.Client
+	Log           logr.Logger
+	Scheme        *runtime.Scheme
+	EventRecorder record.EventRecorder
+	// RemoteClient connects to the remote clusters. Defaults to
+	// utils.NewRemoteClient.
+	RemoteClient utils.RemoteClientFunc
+}
+
+func (r *ReplicationLinkReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := r.Log.WithValues("replicationlink", req.NamespacedName)
+	link := &volsyncv1alpha1.ReplicationLink{}
+	if err := r.Client.Get(ctx, req.NamespacedName, link); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if link.Spec.Paused {
+		return ctrl.Result{}, nil
+	}
+
+	if link.Status == nil {
+		link.Status = &volsyncv1alpha1.ReplicationLinkStatus{}
+	}
+	now := time.Now()
+	link.Status.LastCheckTime = &metav1.Time{Time: now}
+
+	var source, destination *volsyncv1alpha1.ReplicationLinkEndpointStatus
+	rs := &volsyncv1alpha1.ReplicationSource{}
+	if err := r.getEndpoint(ctx, logger, link, link.Spec.Source, rs); err != nil {
+		source = unreachableEndpoint(err)
+	} else {
+		source = sourceEndpointStatus(rs)
+	}
+	rd := &volsyncv1alpha1.ReplicationDestination{}
+	if err := r.getEndpoint(ctx, logger, link, link.Spec.Destination, rd); err != nil {
+		destination = unreachableEndpoint(err)
+	} else {
+		destination = destinationEndpointStatus(rd)
+	}
+	link.Status.Source = source
+	link.Status.Destination = destination
+	link.Status.Lag = linkLag(source, destination, now)
+	previous := apimeta.FindStatusCondition(link.Status.Conditions, volsyncv1alpha1.ConditionLinkHealthy).DeepCopy()
+	setLinkHealthCondition(link)
+	r.emitHealthEvent(link, previous)
+
+	if err := r.Client.Status().Update(ctx, link); err != nil {
+		logger.Error(err, "failed to update status")
+		return ctrl.Result{}, err
+	}
+	// The remote clusters will not generate events that trigger a reconcile
+	return ctrl.Result{RequeueAfter: linkRefreshInterval(link)}, nil
+}
+
+// getEndpoint retrieves the object at one end of the link, from this cluster
+// or from the cluster in its kubeconfig
+func (r *ReplicationLinkReconciler) getEndpoint(ctx context.Context, logger logr.Logger,
+	link *volsyncv1alpha1.ReplicationLink, endpoint volsyncv1alpha1.ReplicationLinkEndpoint,
+	obj client.Object) error {
+	if !localEndpointAllowed(link, endpoint) {
+		return fmt.Errorf("an object in this cluster must be in the namespace of the ReplicationLink (%s)",
+			link.Namespace)
+	}
+	namespace := endpoint.Namespace
+	if namespace == "" {
+		namespace = link.Namespace
+	}
+
+	c := r.Client
+	if endpoint.KubeconfigSecretRef != nil {
+		newRemoteClient := r.RemoteClient
+		if newRemoteClient == nil {
+			newRemoteClient = utils.NewRemoteClient
+		}
+		remote, err := newRemoteClient(ctx, r.Client, logger, link.Namespace, *endpoint.KubeconfigSecretRef)
+		if err != nil {
+			return err
+		}
+		c = remote
+	}
+	return c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: endpoint.Name}, obj)
+}
+
+// localEndpointAllowed returns false if the end of the link is in this cluster
+// but in another namespace. The controller can read every namespace, so
+// allowing it would expose the status of other tenants' objects.
+func localEndpointAllowed(link *volsyncv1alpha1.ReplicationLink,
+	endpoint volsyncv1alpha1.ReplicationLinkEndpoint) bool {
+	return endpoint.KubeconfigSecretRef != nil || endpoint.Namespace == "" || endpoint.Namespace == link.Namespace
+}
+
+func unreachableEndpoint(err error) *volsyncv1alpha1.ReplicationLinkEndpointStatus {
+	return &volsyncv1alpha1.ReplicationLinkEndpointStatus{
+		Reachable: false,
+		Message:   err.Error(),
+	}
+}
+
+func sourceEndpointStatus(rs *volsyncv1alpha1.ReplicationSource) *volsyncv1alpha1.ReplicationLinkEndpointStatus {
+	if rs.Status == nil {
+		return &volsyncv1alpha1.ReplicationLinkEndpointStatus{Reachable: true}
+	}
+	return endpointStatus(rs.Status.LastSyncTime, rs.Status.LastSyncDuration, rs.Status.NextSyncTime,
+		rs.Status.Conditions, rs.Status.LatestMoverStatus)
+}
+
+func destinationEndpointStatus(
+	rd *volsyncv1alpha1.ReplicationDestination) *volsyncv1alpha1.ReplicationLinkEndpointStatus {
+	if rd.Status == nil {
+		return &volsyncv1alpha1.ReplicationLinkEndpointStatus{Reachable: true}
+	}
+	return endpointStatus(rd.Status.LastSyncTime, rd.Status.LastSyncDuration, rd.Status.NextSyncTime,
+		rd.Status.Conditions, rd.Status.LatestMoverStatus)
+}
+
+func endpointStatus(lastSyncTime *metav1.Time, lastSyncDuration *metav1.Duration, nextSyncTime *metav1.Time,
+	conditions []metav1.Condition,
+	moverStatus *volsyncv1alpha1.MoverStatus) *volsyncv1alpha1.ReplicationLinkEndpointStatus {
+	status := &volsyncv1alpha1.ReplicationLinkEndpointStatus{
+		Reachable:        true,
+		LastSyncTime:     lastSyncTime.DeepCopy(),
+		LastSyncDuration: lastSyncDuration.DeepCopy(),
+		NextSyncTime:     nextSyncTime.DeepCopy(),
+	}
+	if cond := apimeta.FindStatusCondition(conditions, volsyncv1alpha1.ConditionSynchronizing); cond != nil {
+		status.Synchronizing = cond.Reason
+		status.Message = cond.Message
+	}
+	if moverStatus != nil {
+		status.LatestMoverResult = moverStatus.Result
+	}
+	return status
+}
+
+// linkLag is the time since the most recent synchronization that completed on
+// both ends, or nil if one of them has not completed a synchronization
+func linkLag(source *volsyncv1alpha1.ReplicationLinkEndpointStatus,
+	destination *volsyncv1alpha1.ReplicationLinkEndpointStatus, now time.Time) *metav1.Duration {
+	if source.LastSyncTime == nil || destination.LastSyncTime == nil {
+		return nil
+	}
+	oldest := source.LastSyncTime.Time
+	if destination.LastSyncTime.Before(&metav1.Time{Time: oldest}) {
+		oldest = destination.LastSyncTime.Time
+	}
+	lag := now.Sub(oldest)
+	if lag < 0 {
+		lag = 0
+	}
+	return &metav1.Duration{Duration: lag.Round(time.Second)}
+}
+
+// endpointFailing returns true if the most recent synchronization attempt of
+// an end of the link failed
+func endpointFailing(status *volsyncv1alpha1.ReplicationLinkEndpointStatus) bool {
+	return status.LatestMoverResult == volsyncv1alpha1.MoverResultFailed ||
+		status.Synchronizing == volsyncv1alpha1.SynchronizingReasonError
+}
+
+func setLinkHealthCondition(link *volsyncv1alpha1.ReplicationLink) {
+	status := link.Status
+	cond := metav1.Condition{
+		Type:   volsyncv1alpha1.ConditionLinkHealthy,
+		Status: metav1.ConditionFalse,
+	}
+	switch {
+	case !localEndpointAllowed(link, link.Spec.Source):
+		cond.Reason = volsyncv1alpha1.LinkHealthyReasonInvalidEndpoint
+		cond.Message = fmt.Sprintf("ReplicationSource %s/%s: %s", link.Spec.Source.Namespace,
+			link.Spec.Source.Name, status.Source.Message)
+	case !localEndpointAllowed(link, link.Spec.Destination):
+		cond.Reason = volsyncv1alpha1.LinkHealthyReasonInvalidEndpoint
+		cond.Message = fmt.Sprintf("ReplicationDestination %s/%s: %s", link.Spec.Destination.Namespace,
+			link.Spec.Destination.Name, status.Destination.Message)
+	case !status.Source.Reachable:
+		cond.Reason = volsyncv1alpha1.LinkHealthyReasonUnreachable
+		cond.Message = fmt.Sprintf("Unable to get ReplicationSource %s/%s: %s", link.Spec.Source.Namespace,
+			link.Spec.Source.Name, status.Source.Message)
+	case !status.Destination.Reachable:
+		cond.Reason = volsyncv1alpha1.LinkHealthyReasonUnreachable
+		cond.Message = fmt.Sprintf("Unable to get ReplicationDestination %s/%s: %s",
+			link.Spec.Destination.Namespace, link.Spec.Destination.Name, status.Destination.Message)
+	case endpointFailing(status.Source):
+		cond.Reason = volsyncv1alpha1.LinkHealthyReasonSourceFailing
+		cond.Message = fmt.Sprintf("Synchronization of ReplicationSource %s/%s is failing",
+			link.Spec.Source.Namespace, link.Spec.Source.Name)
+	case endpointFailing(status.Destination):
+		cond.Reason = volsyncv1alpha1.LinkHealthyReasonDestinationFailing
+		cond.Message = fmt.Sprintf("Synchronization of ReplicationDestination %s/%s is failing",
+			link.Spec.Destination.Namespace, link.Spec.Destination.Name)
+	case link.Spec.MaxLag != nil && status.Lag != nil && status.Lag.Duration > link.Spec.MaxLag.Duration:
+		cond.Reason = volsyncv1alpha1.LinkHealthyReasonLagExceeded
+		cond.Message = fmt.Sprintf("Replication lag of %s exceeds maxLag of %s", status.Lag.Duration,
+			link.Spec.MaxLag.Duration)
+	default:
+		cond.Status = metav1.ConditionTrue
+		cond.Reason = volsyncv1alpha1.LinkHealthyReasonHealthy
+		cond.Message = "Both ends are synchronizing"
+		if status.Lag == nil {
+			cond.Message = "No synchronization has completed on both ends yet"
+		}
+	}
+	apimeta.SetStatusCondition(&status.Conditions, cond)
+}
+
+// emitHealthEvent records an Event when the health of the link changes
+func (r *ReplicationLinkReconciler) emitHealthEvent(link *volsyncv1alpha1.ReplicationLink,
+	previous *metav1.Condition) {
+	cond := apimeta.FindStatusCondition(link.Status.Conditions, volsyncv1alpha1.ConditionLinkHealthy)
+	if previous != nil && previous.Status == cond.Status && previous.Reason == cond.Reason {
+		return
+	}
+	if cond.Status == metav1.ConditionTrue {
+		// Don't report the initial, healthy, state
+		if previous != nil {
+			r.EventRecorder.Event(link, corev1.EventTypeNormal, volsyncv1alpha1.EvRLinkHealthy, cond.Message)
+		}
+		return
+	}
+	r.EventRecorder.Event(link, corev1.EventTypeWarning, volsyncv1alpha1.EvRLinkUnhealthy, cond.Message)
+}
+
+func linkRefreshInterval(link *volsyncv1alpha1.ReplicationLink) time.Duration {
+	if link.Spec.RefreshInterval != nil && link.Spec.RefreshInterval.Duration > 0 {
+		return link.Spec.RefreshInterval.Duration
+	}
+	return defaultLinkRefreshInterval
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ReplicationLinkReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// Status updates (lastCheckTime changes on every reconcile) must not
+		// trigger another reconcile
+		For(&volsyncv1alpha1.ReplicationLink{},
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
@@ -0,0 +1,216 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+var _ = Describe("ReplicationLink", func() {
+	var namespace *corev1.Namespace
+	var remoteNamespace *corev1.Namespace
+	var rs *volsyncv1alpha1.ReplicationSource
+	var rd *volsyncv1alpha1.ReplicationDestination
+	var link *volsyncv1alpha1.ReplicationLink
+	var r *ReplicationLinkReconciler
+
+	reconcile := func() *volsyncv1alpha1.ReplicationLink {
+		result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(link)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(defaultLinkRefreshInterval))
+		updated := &volsyncv1alpha1.ReplicationLink{}
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(link), updated)).To(Succeed())
+		return updated
+	}
+	healthy := func(link *volsyncv1alpha1.ReplicationLink) *metav1.Condition {
+		Expect(link.Status).NotTo(BeNil())
+		cond := apimeta.FindStatusCondition(link.Status.Conditions, volsyncv1alpha1.ConditionLinkHealthy)
+		Expect(cond).NotTo(BeNil())
+		return cond
+	}
+	setSourceStatus := func(status *volsyncv1alpha1.ReplicationSourceStatus) {
+		Eventually(func() error {
+			if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(rs), rs); err != nil {
+				return err
+			}
+			rs.Status = status
+			return k8sClient.Status().Update(ctx, rs)
+		}, maxWait, interval).Should(Succeed())
+	}
+	setDestinationStatus := func(status *volsyncv1alpha1.ReplicationDestinationStatus) {
+		Eventually(func() error {
+			if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(rd), rd); err != nil {
+				return err
+			}
+			rd.Status = status
+			return k8sClient.Status().Update(ctx, rd)
+		}, maxWait, interval).Should(Succeed())
+	}
+
+	BeforeEach(func() {
+		namespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{GenerateName: "volsync-test-"},
+		}
+		createWithCacheReload(ctx, k8sClient, namespace)
+		remoteNamespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{GenerateName: "volsync-test-remote-"},
+		}
+		createWithCacheReload(ctx, k8sClient, remoteNamespace)
+
+		rs = &volsyncv1alpha1.ReplicationSource{
+			ObjectMeta: metav1.ObjectMeta{Name: "source", Namespace: namespace.Name},
+			Spec: volsyncv1alpha1.ReplicationSourceSpec{
+				SourcePVC: "data",
+				// Keep the mover from running
+				Paused:   true,
+				RsyncTLS: &volsyncv1alpha1.ReplicationSourceRsyncTLSSpec{},
+			},
+		}
+		createWithCacheReload(ctx, k8sClient, rs)
+		// The "remote" cluster is the test cluster itself
+		rd = &volsyncv1alpha1.ReplicationDestination{
+			ObjectMeta: metav1.ObjectMeta{Name: "dest", Namespace: remoteNamespace.Name},
+			Spec: volsyncv1alpha1.ReplicationDestinationSpec{
+				Paused:   true,
+				RsyncTLS: &volsyncv1alpha1.ReplicationDestinationRsyncTLSSpec{},
+			},
+		}
+		createWithCacheReload(ctx, k8sClient, rd)
+
+		link = &volsyncv1alpha1.ReplicationLink{
+			ObjectMeta: metav1.ObjectMeta{Name: "link", Namespace: namespace.Name},
+			Spec: volsyncv1alpha1.ReplicationLinkSpec{
+				Source: volsyncv1alpha1.ReplicationLinkEndpoint{
+					RemoteObjectReference: volsyncv1alpha1.RemoteObjectReference{
+						Namespace: namespace.Name,
+						Name:      rs.Name,
+					},
+				},
+				Destination: volsyncv1alpha1.ReplicationLinkEndpoint{
+					KubeconfigSecretRef: &volsyncv1alpha1.KubeconfigSecretRef{Name: "kubeconfig"},
+					RemoteObjectReference: volsyncv1alpha1.RemoteObjectReference{
+						Namespace: remoteNamespace.Name,
+						Name:      rd.Name,
+					},
+				},
+				MaxLag: &metav1.Duration{Duration: time.Hour},
+			},
+		}
+		createWithCacheReload(ctx, k8sClient, link)
+
+		r = &ReplicationLinkReconciler{
+			Client:        k8sClient,
+			Log:           logr.Discard(),
+			Scheme:        k8sClient.Scheme(),
+			EventRecorder: record.NewFakeRecorder(10),
+			RemoteClient: func(context.Context, client.Client, logr.Logger, string,
+				volsyncv1alpha1.KubeconfigSecretRef) (client.Client, error) {
+				return k8sClient, nil
+			},
+		}
+	})
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, namespace)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, remoteNamespace)).To(Succeed())
+	})
+
+	It("is healthy before the first synchronization", func() {
+		updated := reconcile()
+		cond := healthy(updated)
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(cond.Reason).To(Equal(volsyncv1alpha1.LinkHealthyReasonHealthy))
+		Expect(updated.Status.Source.Reachable).To(BeTrue())
+		Expect(updated.Status.Destination.Reachable).To(BeTrue())
+		Expect(updated.Status.Lag).To(BeNil())
+		Expect(updated.Status.LastCheckTime).NotTo(BeNil())
+	})
+
+	It("reports the lag from the oldest synchronization", func() {
+		setSourceStatus(&volsyncv1alpha1.ReplicationSourceStatus{
+			LastSyncTime: &metav1.Time{Time: time.Now().Add(-10 * time.Minute)},
+		})
+		setDestinationStatus(&volsyncv1alpha1.ReplicationDestinationStatus{
+			LastSyncTime: &metav1.Time{Time: time.Now().Add(-30 * time.Minute)},
+		})
+		updated := reconcile()
+		Expect(healthy(updated).Status).To(Equal(metav1.ConditionTrue))
+		Expect(updated.Status.Lag).NotTo(BeNil())
+		Expect(updated.Status.Lag.Duration).To(BeNumerically("~", 30*time.Minute, time.Minute))
+
+		setDestinationStatus(&volsyncv1alpha1.ReplicationDestinationStatus{
+			LastSyncTime: &metav1.Time{Time: time.Now().Add(-2 * time.Hour)},
+		})
+		cond := healthy(reconcile())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(volsyncv1alpha1.LinkHealthyReasonLagExceeded))
+	})
+
+	It("reports a failing end", func() {
+		setSourceStatus(&volsyncv1alpha1.ReplicationSourceStatus{
+			LatestMoverStatus: &volsyncv1alpha1.MoverStatus{Result: volsyncv1alpha1.MoverResultFailed},
+		})
+		updated := reconcile()
+		cond := healthy(updated)
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(volsyncv1alpha1.LinkHealthyReasonSourceFailing))
+		Expect(updated.Status.Source.LatestMoverResult).To(Equal(volsyncv1alpha1.MoverResultFailed))
+
+		setSourceStatus(&volsyncv1alpha1.ReplicationSourceStatus{})
+		setDestinationStatus(&volsyncv1alpha1.ReplicationDestinationStatus{
+			Conditions: []metav1.Condition{{
+				Type:    volsyncv1alpha1.ConditionSynchronizing,
+				Status:  metav1.ConditionFalse,
+				Reason:  volsyncv1alpha1.SynchronizingReasonError,
+				Message: "mover failed",
+			}},
+		})
+		updated = reconcile()
+		Expect(healthy(updated).Reason).To(Equal(volsyncv1alpha1.LinkHealthyReasonDestinationFailing))
+		Expect(updated.Status.Destination.Synchronizing).To(Equal(volsyncv1alpha1.SynchronizingReasonError))
+	})
+
+	It("reports an unreachable cluster", func() {
+		r.RemoteClient = func(context.Context, client.Client, logr.Logger, string,
+			volsyncv1alpha1.KubeconfigSecretRef) (client.Client, error) {
+			return nil, errors.New("connection refused")
+		}
+		updated := reconcile()
+		cond := healthy(updated)
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(volsyncv1alpha1.LinkHealthyReasonUnreachable))
+		Expect(updated.Status.Source.Reachable).To(BeTrue())
+		Expect(updated.Status.Destination.Reachable).To(BeFalse())
+		Expect(updated.Status.Destination.Message).To(ContainSubstring("connection refused"))
+		Expect(r.EventRecorder.(*record.FakeRecorder).Events).To(Receive(
+			ContainSubstring(volsyncv1alpha1.EvRLinkUnhealthy)))
+	})
+
+	It("does not read an end in another namespace of this cluster", func() {
+		link.Spec.Destination.KubeconfigSecretRef = nil
+		Expect(k8sClient.Update(ctx, link)).To(Succeed())
+		setDestinationStatus(&volsyncv1alpha1.ReplicationDestinationStatus{
+			LastSyncTime: &metav1.Time{Time: time.Now()},
+		})
+
+		updated := reconcile()
+		cond := healthy(updated)
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(volsyncv1alpha1.LinkHealthyReasonInvalidEndpoint))
+		Expect(cond.Message).To(ContainSubstring(namespace.Name))
+		Expect(updated.Status.Source.Reachable).To(BeTrue())
+		Expect(updated.Status.Destination.Reachable).To(BeFalse())
+		Expect(updated.Status.Destination.LastSyncTime).To(BeNil())
+	})
+})
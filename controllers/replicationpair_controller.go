@@ -0,0 +1,337 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+//nolint:lll
+//+kubebuilder:rbac:groups=volsync.backube,resources=replicationpairs,verbs=get;list;watch
+//+kubebuilder:rbac:groups=volsync.backube,resources=replicationpairs/finalizers,verbs=update
+//+kubebuilder:rbac:groups=volsync.backube,resources=replicationpairs/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=volsync.backube,resources=replicationsources,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=volsync.backube,resources=replicationdestinations,verbs=get;list;watch;create;update;patch;delete
+
+// ReplicationPairReconciler reconciles a ReplicationPair object, maintaining a
+// ReplicationSource or ReplicationDestination for the pair's current role.
+type ReplicationPairReconciler struct {
+	client.Client
+	Log           logr.Logger
+	Scheme        *runtime.Scheme
+	EventRecorder record.EventRecorder
+}
+
+func (r *ReplicationPairReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := r.Log.WithValues("replicationpair", req.NamespacedName)
+	pair := &volsyncv1alpha1.ReplicationPair{}
+	if err := r.Client.Get(ctx, req.NamespacedName, pair); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if pair.Status == nil {
+		pair.Status = &volsyncv1alpha1.ReplicationPairStatus{}
+	}
+
+	reconcileErr := r.reconcilePair(ctx, logger, pair)
+	if reconcileErr != nil {
+		apimeta.SetStatusCondition(&pair.Status.Conditions, metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionPairReconciled,
+			Status:  metav1.ConditionFalse,
+			Reason:  volsyncv1alpha1.PairReconciledReasonError,
+			Message: reconcileErr.Error(),
+		})
+	}
+
+	if err := r.Client.Status().Update(ctx, pair); err != nil {
+		logger.Error(err, "failed to update status")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, reconcileErr
+}
+
+// reconcilePair replaces the ReplicationSource or ReplicationDestination of
+// the previous role, if any, and then creates or updates the one for the
+// current role. Progress is driven by the events of the owned objects.
+func (r *ReplicationPairReconciler) reconcilePair(ctx context.Context, logger logr.Logger,
+	pair *volsyncv1alpha1.ReplicationPair) error {
+	rs := &volsyncv1alpha1.ReplicationSource{}
+	rsExists, err := r.getOwnedByPair(ctx, pair, rs)
+	if err != nil {
+		return err
+	}
+	rd := &volsyncv1alpha1.ReplicationDestination{}
+	rdExists, err := r.getOwnedByPair(ctx, pair, rd)
+	if err != nil {
+		return err
+	}
+
+	// The object of the previous role must be gone before its replacement is
+	// created, so that two movers never use the PVC at the same time
+	var previous client.Object
+	switch {
+	case pair.Spec.Role == volsyncv1alpha1.ReplicationPairRoleSource && rdExists:
+		previous = rd
+		if rd.Spec.RsyncTLS == nil && rd.Status != nil && rd.Status.LastSyncStartTime != nil {
+			setPairWaiting(pair, "Waiting for the ReplicationDestination to finish synchronizing")
+			return nil
+		}
+	case pair.Spec.Role == volsyncv1alpha1.ReplicationPairRoleDestination && rsExists:
+		previous = rs
+		if rs.Status != nil && rs.Status.LastSyncStartTime != nil {
+			setPairWaiting(pair, "Waiting for the ReplicationSource to finish synchronizing")
+			return nil
+		}
+	}
+	if previous != nil {
+		if previous.GetDeletionTimestamp().IsZero() {
+			logger.Info("changing role", "role", pair.Spec.Role)
+			if err := r.Client.Delete(ctx, previous,
+				client.PropagationPolicy(metav1.DeletePropagationForeground)); client.IgnoreNotFound(err) != nil {
+				return err
+			}
+			pair.Status.LastRoleChangeTime = ptr.To(metav1.Now())
+			r.EventRecorder.Eventf(pair, corev1.EventTypeNormal, volsyncv1alpha1.EvRRoleChanged,
+				"changing role to %s", pair.Spec.Role)
+		}
+		setPairWaiting(pair, "Waiting for the resources of the previous role to be removed")
+		return nil
+	}
+
+	if pair.Spec.Role == volsyncv1alpha1.ReplicationPairRoleSource {
+		err = r.ensurePairSource(ctx, logger, pair, rs)
+	} else {
+		err = r.ensurePairDestination(ctx, logger, pair, rd)
+	}
+	if err != nil {
+		return err
+	}
+
+	pair.Status.Role = pair.Spec.Role
+	apimeta.SetStatusCondition(&pair.Status.Conditions, metav1.Condition{
+		Type:    volsyncv1alpha1.ConditionPairReconciled,
+		Status:  metav1.ConditionTrue,
+		Reason:  volsyncv1alpha1.PairReconciledReasonSuccess,
+		Message: fmt.Sprintf("Replicating in the %s role", pair.Spec.Role),
+	})
+	return nil
+}
+
+func setPairWaiting(pair *volsyncv1alpha1.ReplicationPair, message string) {
+	apimeta.SetStatusCondition(&pair.Status.Conditions, metav1.Condition{
+		Type:    volsyncv1alpha1.ConditionPairReconciled,
+		Status:  metav1.ConditionFalse,
+		Reason:  volsyncv1alpha1.PairReconciledReasonWaitingForSync,
+		Message: message,
+	})
+}
+
+// getOwnedByPair looks up the ReplicationSource or ReplicationDestination with
+// the same name as the pair. It returns false if it does not exist and an
+// error if it exists but does not belong to the pair.
+func (r *ReplicationPairReconciler) getOwnedByPair(ctx context.Context,
+	pair *volsyncv1alpha1.ReplicationPair, obj client.Object) (bool, error) {
+	err := r.Client.Get(ctx, client.ObjectKeyFromObject(pair), obj)
+	if kerrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if !metav1.IsControlledBy(obj, pair) {
+		return false, fmt.Errorf("%T %s already exists and is not managed by ReplicationPair %s",
+			obj, obj.GetName(), pair.GetName())
+	}
+	return true, nil
+}
+
+func (r *ReplicationPairReconciler) ensurePairSource(ctx context.Context, logger logr.Logger,
+	pair *volsyncv1alpha1.ReplicationPair, rs *volsyncv1alpha1.ReplicationSource) error {
+	rs.ObjectMeta = metav1.ObjectMeta{Name: pair.GetName(), Namespace: pair.GetNamespace()}
+	op, err := ctrlutil.CreateOrUpdate(ctx, r.Client, rs, func() error {
+		if err := r.claimForPair(pair, rs); err != nil {
+			return err
+		}
+		rs.Spec = pairSourceSpec(pair)
+		return nil
+	})
+	if err != nil {
+		logger.Error(err, "unable to ensure ReplicationSource")
+		return err
+	}
+	if op != ctrlutil.OperationResultNone {
+		logger.Info("ReplicationSource reconciled", "operation", op)
+	}
+
+	pair.Status.RsyncTLS = nil
+	pair.Status.LastSyncTime = nil
+	if rs.Status != nil {
+		pair.Status.LastSyncTime = rs.Status.LastSyncTime
+	}
+	return nil
+}
+
+func (r *ReplicationPairReconciler) ensurePairDestination(ctx context.Context, logger logr.Logger,
+	pair *volsyncv1alpha1.ReplicationPair, rd *volsyncv1alpha1.ReplicationDestination) error {
+	rd.ObjectMeta = metav1.ObjectMeta{Name: pair.GetName(), Namespace: pair.GetNamespace()}
+	op, err := ctrlutil.CreateOrUpdate(ctx, r.Client, rd, func() error {
+		if err := r.claimForPair(pair, rd); err != nil {
+			return err
+		}
+		rd.Spec = pairDestinationSpec(pair)
+		return nil
+	})
+	if err != nil {
+		logger.Error(err, "unable to ensure ReplicationDestination")
+		return err
+	}
+	if op != ctrlutil.OperationResultNone {
+		logger.Info("ReplicationDestination reconciled", "operation", op)
+	}
+
+	pair.Status.RsyncTLS = nil
+	pair.Status.LastSyncTime = nil
+	if rd.Status != nil {
+		pair.Status.LastSyncTime = rd.Status.LastSyncTime
+		if rd.Status.RsyncTLS != nil {
+			pair.Status.RsyncTLS = &volsyncv1alpha1.ReplicationPairRsyncTLSStatus{
+				Address: rd.Status.RsyncTLS.Address,
+				Port:    rd.Status.RsyncTLS.Port,
+			}
+		}
+	}
+	return nil
+}
+
+// claimForPair marks obj as belonging to the pair. Objects that were not
+// created by the pair are not taken over.
+func (r *ReplicationPairReconciler) claimForPair(pair *volsyncv1alpha1.ReplicationPair, obj client.Object) error {
+	if obj.GetUID() != "" && !metav1.IsControlledBy(obj, pair) {
+		return fmt.Errorf("%T %s already exists and is not managed by ReplicationPair %s",
+			obj, obj.GetName(), pair.GetName())
+	}
+	if err := ctrl.SetControllerReference(pair, obj, r.Client.Scheme()); err != nil {
+		return err
+	}
+	utils.SetOwnedByVolSync(obj)
+	utils.AddLabel(obj, volsyncv1alpha1.ReplicationPairLabel, pair.GetName())
+	return nil
+}
+
+// pairSourceSpec is the spec of the ReplicationSource for the Source role
+func pairSourceSpec(pair *volsyncv1alpha1.ReplicationPair) volsyncv1alpha1.ReplicationSourceSpec {
+	spec := volsyncv1alpha1.ReplicationSourceSpec{
+		SourcePVC: pair.Spec.PVCName,
+		Trigger:   pair.Spec.Trigger.DeepCopy(),
+		Paused:    pair.Spec.Paused,
+	}
+	switch {
+	case pair.Spec.RsyncTLS != nil:
+		tls := pair.Spec.RsyncTLS
+		spec.RsyncTLS = &volsyncv1alpha1.ReplicationSourceRsyncTLSSpec{
+			ReplicationSourceVolumeOptions: pairSourceVolumeOptions(tls.ReplicationPairVolumeOptions),
+			KeySecret:                      &tls.KeySecret,
+			Address:                        tls.Address,
+			Port:                           tls.Port,
+		}
+	case pair.Spec.Restic != nil:
+		restic := pair.Spec.Restic
+		spec.Restic = &volsyncv1alpha1.ReplicationSourceResticSpec{
+			ReplicationSourceVolumeOptions: pairSourceVolumeOptions(restic.ReplicationPairVolumeOptions),
+			Repository:                     restic.Repository,
+			Retain:                         restic.Retain,
+			PruneIntervalDays:              restic.PruneIntervalDays,
+		}
+	}
+	return *spec.DeepCopy()
+}
+
+// pairDestinationSpec is the spec of the ReplicationDestination for the
+// Destination role. The pair's PVC is used as the destination volume.
+func pairDestinationSpec(pair *volsyncv1alpha1.ReplicationPair) volsyncv1alpha1.ReplicationDestinationSpec {
+	spec := volsyncv1alpha1.ReplicationDestinationSpec{
+		Paused: pair.Spec.Paused,
+	}
+	switch {
+	case pair.Spec.RsyncTLS != nil:
+		tls := pair.Spec.RsyncTLS
+		spec.RsyncTLS = &volsyncv1alpha1.ReplicationDestinationRsyncTLSSpec{
+			ReplicationDestinationVolumeOptions: pairDestinationVolumeOptions(pair, tls.ReplicationPairVolumeOptions),
+			KeySecret:                           &tls.KeySecret,
+			ServiceType:                         tls.ServiceType,
+		}
+	case pair.Spec.Restic != nil:
+		restic := pair.Spec.Restic
+		if pair.Spec.Trigger != nil {
+			spec.Trigger = &volsyncv1alpha1.ReplicationDestinationTriggerSpec{
+				Schedule: pair.Spec.Trigger.Schedule,
+				TimeZone: pair.Spec.Trigger.TimeZone,
+				Manual:   pair.Spec.Trigger.Manual,
+			}
+		}
+		spec.Restic = &volsyncv1alpha1.ReplicationDestinationResticSpec{
+			ReplicationDestinationVolumeOptions: pairDestinationVolumeOptions(pair, restic.ReplicationPairVolumeOptions),
+			Repository:                          restic.Repository,
+		}
+	}
+	return *spec.DeepCopy()
+}
+
+func pairSourceVolumeOptions(
+	opts volsyncv1alpha1.ReplicationPairVolumeOptions) volsyncv1alpha1.ReplicationSourceVolumeOptions {
+	return volsyncv1alpha1.ReplicationSourceVolumeOptions{
+		CopyMethod:              opts.CopyMethod,
+		StorageClassName:        opts.StorageClassName,
+		VolumeSnapshotClassName: opts.VolumeSnapshotClassName,
+	}
+}
+
+func pairDestinationVolumeOptions(pair *volsyncv1alpha1.ReplicationPair,
+	opts volsyncv1alpha1.ReplicationPairVolumeOptions) volsyncv1alpha1.ReplicationDestinationVolumeOptions {
+	return volsyncv1alpha1.ReplicationDestinationVolumeOptions{
+		CopyMethod:              opts.CopyMethod,
+		StorageClassName:        opts.StorageClassName,
+		VolumeSnapshotClassName: opts.VolumeSnapshotClassName,
+		DestinationPVC:          &pair.Spec.PVCName,
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ReplicationPairReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&volsyncv1alpha1.ReplicationPair{}).
+		Owns(&volsyncv1alpha1.ReplicationSource{}).
+		Owns(&volsyncv1alpha1.ReplicationDestination{}).
+		Complete(r)
+}
@@ -0,0 +1,177 @@
+package controllers
+
+import (
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("ReplicationPair", func() {
+	var namespace *corev1.Namespace
+	var pair *volsyncv1alpha1.ReplicationPair
+
+	pairKey := func() client.ObjectKey { return client.ObjectKeyFromObject(pair) }
+	reconciledReason := func() string {
+		p := &volsyncv1alpha1.ReplicationPair{}
+		Expect(k8sClient.Get(ctx, pairKey(), p)).To(Succeed())
+		if p.Status == nil {
+			return ""
+		}
+		cond := apimeta.FindStatusCondition(p.Status.Conditions, volsyncv1alpha1.ConditionPairReconciled)
+		if cond == nil {
+			return ""
+		}
+		return cond.Reason
+	}
+
+	BeforeEach(func() {
+		namespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "volsync-test-",
+			},
+		}
+		createWithCacheReload(ctx, k8sClient, namespace)
+		Expect(namespace.Name).NotTo(BeEmpty())
+
+		pair = &volsyncv1alpha1.ReplicationPair{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pair",
+				Namespace: namespace.Name,
+			},
+			Spec: volsyncv1alpha1.ReplicationPairSpec{
+				Role:    volsyncv1alpha1.ReplicationPairRoleSource,
+				PVCName: "data",
+				Trigger: &volsyncv1alpha1.ReplicationSourceTriggerSpec{
+					Schedule: ptr.To("*/15 * * * *"),
+				},
+				RsyncTLS: &volsyncv1alpha1.ReplicationPairRsyncTLSSpec{
+					ReplicationPairVolumeOptions: volsyncv1alpha1.ReplicationPairVolumeOptions{
+						CopyMethod: volsyncv1alpha1.CopyMethodSnapshot,
+					},
+					KeySecret: "psk",
+					Address:   ptr.To("site-b.example.com"),
+				},
+				// Keep the movers from running
+				Paused: true,
+			},
+		}
+	})
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, namespace)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		createWithCacheReload(ctx, k8sClient, pair)
+	})
+
+	It("creates a ReplicationSource in the Source role", func() {
+		rs := &volsyncv1alpha1.ReplicationSource{}
+		Eventually(func() error {
+			return k8sClient.Get(ctx, pairKey(), rs)
+		}, maxWait, interval).Should(Succeed())
+		Expect(metav1.IsControlledBy(rs, pair)).To(BeTrue())
+		Expect(rs.Labels).To(HaveKeyWithValue(volsyncv1alpha1.ReplicationPairLabel, pair.Name))
+		Expect(rs.Spec.SourcePVC).To(Equal("data"))
+		Expect(rs.Spec.Paused).To(BeTrue())
+		Expect(rs.Spec.Trigger.Schedule).To(Equal(ptr.To("*/15 * * * *")))
+		Expect(rs.Spec.RsyncTLS).NotTo(BeNil())
+		Expect(rs.Spec.RsyncTLS.KeySecret).To(Equal(ptr.To("psk")))
+		Expect(rs.Spec.RsyncTLS.Address).To(Equal(ptr.To("site-b.example.com")))
+		Expect(rs.Spec.RsyncTLS.CopyMethod).To(Equal(volsyncv1alpha1.CopyMethodSnapshot))
+
+		Eventually(reconciledReason, maxWait, interval).Should(Equal(volsyncv1alpha1.PairReconciledReasonSuccess))
+		Expect(k8sClient.Get(ctx, pairKey(), pair)).To(Succeed())
+		Expect(pair.Status.Role).To(Equal(volsyncv1alpha1.ReplicationPairRoleSource))
+	})
+
+	It("swaps the ReplicationSource for a ReplicationDestination when the role changes", func() {
+		rs := &volsyncv1alpha1.ReplicationSource{}
+		Eventually(func() error {
+			return k8sClient.Get(ctx, pairKey(), rs)
+		}, maxWait, interval).Should(Succeed())
+
+		Expect(k8sClient.Get(ctx, pairKey(), pair)).To(Succeed())
+		pair.Spec.Role = volsyncv1alpha1.ReplicationPairRoleDestination
+		Expect(k8sClient.Update(ctx, pair)).To(Succeed())
+
+		// There is no garbage collector in the test environment to finish the
+		// foreground deletion
+		Eventually(func() bool {
+			if err := k8sClient.Get(ctx, pairKey(), rs); err != nil {
+				return kerrors.IsNotFound(err)
+			}
+			if rs.DeletionTimestamp.IsZero() {
+				return false
+			}
+			rs.Finalizers = nil
+			return k8sClient.Update(ctx, rs) == nil
+		}, maxWait, interval).Should(BeTrue())
+
+		rd := &volsyncv1alpha1.ReplicationDestination{}
+		Eventually(func() error {
+			return k8sClient.Get(ctx, pairKey(), rd)
+		}, maxWait, interval).Should(Succeed())
+		Expect(metav1.IsControlledBy(rd, pair)).To(BeTrue())
+		Expect(rd.Spec.RsyncTLS).NotTo(BeNil())
+		Expect(rd.Spec.RsyncTLS.KeySecret).To(Equal(ptr.To("psk")))
+		Expect(rd.Spec.RsyncTLS.DestinationPVC).To(Equal(ptr.To("data")))
+		Expect(rd.Spec.RsyncTLS.CopyMethod).To(Equal(volsyncv1alpha1.CopyMethodSnapshot))
+
+		Eventually(reconciledReason, maxWait, interval).Should(Equal(volsyncv1alpha1.PairReconciledReasonSuccess))
+		Expect(k8sClient.Get(ctx, pairKey(), pair)).To(Succeed())
+		Expect(pair.Status.Role).To(Equal(volsyncv1alpha1.ReplicationPairRoleDestination))
+		Expect(pair.Status.LastRoleChangeTime).NotTo(BeNil())
+	})
+
+	When("a ReplicationSource of the same name already exists", func() {
+		BeforeEach(func() {
+			rs := &volsyncv1alpha1.ReplicationSource{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      pair.Name,
+					Namespace: namespace.Name,
+				},
+				Spec: volsyncv1alpha1.ReplicationSourceSpec{
+					SourcePVC: "other",
+					Paused:    true,
+				},
+			}
+			createWithCacheReload(ctx, k8sClient, rs)
+		})
+		It("is not taken over", func() {
+			Eventually(reconciledReason, maxWait, interval).Should(Equal(volsyncv1alpha1.PairReconciledReasonError))
+			rs := &volsyncv1alpha1.ReplicationSource{}
+			Expect(k8sClient.Get(ctx, pairKey(), rs)).To(Succeed())
+			Expect(rs.Spec.SourcePVC).To(Equal("other"))
+		})
+	})
+})
+
+var _ = Describe("ReplicationPair specs", func() {
+	It("carries the restic details over to either role", func() {
+		pair := &volsyncv1alpha1.ReplicationPair{
+			Spec: volsyncv1alpha1.ReplicationPairSpec{
+				PVCName: "data",
+				Trigger: &volsyncv1alpha1.ReplicationSourceTriggerSpec{Manual: "now"},
+				Restic: &volsyncv1alpha1.ReplicationPairResticSpec{
+					Repository:        "repo",
+					PruneIntervalDays: ptr.To[int32](7),
+				},
+			},
+		}
+		src := pairSourceSpec(pair)
+		Expect(src.Restic.Repository).To(Equal("repo"))
+		Expect(src.Restic.PruneIntervalDays).To(Equal(ptr.To[int32](7)))
+		Expect(src.Trigger.Manual).To(Equal("now"))
+
+		dst := pairDestinationSpec(pair)
+		Expect(dst.Restic.Repository).To(Equal("repo"))
+		Expect(dst.Restic.DestinationPVC).To(Equal(ptr.To("data")))
+		Expect(dst.Trigger.Manual).To(Equal("now"))
+	})
+})
@@ -0,0 +1,451 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"text/template"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+//nolint:lll
+//+kubebuilder:rbac:groups=volsync.backube,resources=replicationpolicies,verbs=get;list;watch
+//+kubebuilder:rbac:groups=volsync.backube,resources=replicationpolicies/finalizers,verbs=update
+//+kubebuilder:rbac:groups=volsync.backube,resources=replicationpolicies/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=volsync.backube,resources=replicationsources,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=core,resources=persistentvolumeclaims,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+
+// ReplicationPolicyReconciler reconciles a ReplicationPolicy object, creating
+// a ReplicationSource for each PVC selected by the policy.
+type ReplicationPolicyReconciler struct {
+	client.Client
+	Log           logr.Logger
+	Scheme        *runtime.Scheme
+	EventRecorder record.EventRecorder
+}
+
+// Fields that are available when rendering the repository template
+type repositoryTemplateData struct {
+	PolicyName string
+	Namespace  string
+	PVCName    string
+}
+
+func (r *ReplicationPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := r.Log.WithValues("replicationpolicy", req.Name)
+	policy := &volsyncv1alpha1.ReplicationPolicy{}
+	if err := r.Client.Get(ctx, req.NamespacedName, policy); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if policy.Status == nil {
+		policy.Status = &volsyncv1alpha1.ReplicationPolicyStatus{}
+	}
+
+	if policy.Spec.Paused {
+		logger.V(1).Info("policy is paused")
+		return ctrl.Result{}, nil
+	}
+
+	reconcileErr := r.reconcilePolicy(ctx, logger, policy)
+	if reconcileErr != nil {
+		reason := volsyncv1alpha1.PolicyReconciledReasonError
+		if errorIsTemplateErr(reconcileErr) {
+			reason = volsyncv1alpha1.PolicyReconciledReasonTemplate
+		}
+		apimeta.SetStatusCondition(&policy.Status.Conditions, metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionPolicyReconciled,
+			Status:  metav1.ConditionFalse,
+			Reason:  reason,
+			Message: reconcileErr.Error(),
+		})
+	} else {
+		apimeta.SetStatusCondition(&policy.Status.Conditions, metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionPolicyReconciled,
+			Status:  metav1.ConditionTrue,
+			Reason:  volsyncv1alpha1.PolicyReconciledReasonSuccess,
+			Message: "All selected PVCs have a ReplicationSource",
+		})
+	}
+	policy.Status.LastReconcileTime = &metav1.Time{Time: metav1.Now().Time}
+
+	if err := r.Client.Status().Update(ctx, policy); err != nil {
+		logger.Error(err, "failed to update status")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, reconcileErr
+}
+
+func (r *ReplicationPolicyReconciler) reconcilePolicy(ctx context.Context, logger logr.Logger,
+	policy *volsyncv1alpha1.ReplicationPolicy) error {
+	pvcs, err := r.selectedPVCs(ctx, policy)
+	if err != nil {
+		logger.Error(err, "unable to determine selected PVCs")
+		return err
+	}
+	policy.Status.MatchedPVCs = int32(len(pvcs)) //nolint:gosec
+
+	// Load the repository template, if any, before touching the sources
+	var repoTemplate *corev1.Secret
+	if policy.Spec.RepositoryTemplate != nil {
+		repoTemplate = &corev1.Secret{}
+		err := r.Client.Get(ctx, types.NamespacedName{
+			Name:      policy.Spec.RepositoryTemplate.Name,
+			Namespace: policy.Spec.RepositoryTemplate.Namespace,
+		}, repoTemplate)
+		if err != nil {
+			logger.Error(err, "unable to get repository template")
+			return err
+		}
+	}
+
+	// The sources of all selected PVCs are kept, even if updating them fails
+	desired := map[types.NamespacedName]bool{}
+	for i := range pvcs {
+		desired[policySourceKey(policy, &pvcs[i])] = true
+	}
+	var lastErr error
+	for i := range pvcs {
+		if _, err := r.ensureReplicationSource(ctx, logger, policy, &pvcs[i], repoTemplate); err != nil {
+			// Keep processing the remaining PVCs
+			lastErr = err
+		}
+	}
+
+	// Remove sources for PVCs that are no longer selected
+	rsList := &volsyncv1alpha1.ReplicationSourceList{}
+	err = r.Client.List(ctx, rsList,
+		client.MatchingLabels{volsyncv1alpha1.ReplicationPolicyLabel: policy.GetName()})
+	if err != nil {
+		return err
+	}
+	managed := int32(0)
+	failing := []volsyncv1alpha1.ReplicationPolicyFailingSource{}
+	for i := range rsList.Items {
+		rs := &rsList.Items[i]
+		if desired[client.ObjectKeyFromObject(rs)] {
+			managed++
+			if msg := replicationSourceError(rs); msg != "" {
+				failing = append(failing, volsyncv1alpha1.ReplicationPolicyFailingSource{
+					Name:      rs.GetName(),
+					Namespace: rs.GetNamespace(),
+					Message:   msg,
+				})
+			}
+			continue
+		}
+		if !isOwnedByPolicy(rs, policy) {
+			continue
+		}
+		logger.Info("deleting ReplicationSource for PVC no longer selected by policy",
+			"replicationsource", client.ObjectKeyFromObject(rs))
+		if err := r.Client.Delete(ctx, rs); client.IgnoreNotFound(err) != nil {
+			lastErr = err
+		}
+	}
+	policy.Status.ReplicationSources = managed
+	updatePolicySourceHealth(policy, failing)
+
+	return lastErr
+}
+
+// Aggregates the health of the managed ReplicationSources into the policy's
+// status
+func updatePolicySourceHealth(policy *volsyncv1alpha1.ReplicationPolicy,
+	failing []volsyncv1alpha1.ReplicationPolicyFailingSource) {
+	policy.Status.FailingSources = int32(len(failing)) //nolint:gosec
+	policy.Status.HealthySources = policy.Status.ReplicationSources - policy.Status.FailingSources
+	if len(failing) > volsyncv1alpha1.MaxPolicyFailingSources {
+		failing = failing[:volsyncv1alpha1.MaxPolicyFailingSources]
+	}
+	policy.Status.Failing = failing
+
+	if len(failing) == 0 {
+		apimeta.SetStatusCondition(&policy.Status.Conditions, metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionPolicySourcesHealthy,
+			Status:  metav1.ConditionTrue,
+			Reason:  volsyncv1alpha1.PolicySourcesHealthyReasonHealthy,
+			Message: "No managed ReplicationSources are reporting errors",
+		})
+		return
+	}
+	apimeta.SetStatusCondition(&policy.Status.Conditions, metav1.Condition{
+		Type:   volsyncv1alpha1.ConditionPolicySourcesHealthy,
+		Status: metav1.ConditionFalse,
+		Reason: volsyncv1alpha1.PolicySourcesHealthyReasonFailing,
+		Message: fmt.Sprintf("%d of %d managed ReplicationSources are reporting errors",
+			policy.Status.FailingSources, policy.Status.ReplicationSources),
+	})
+}
+
+// Returns a description of the error reported by the ReplicationSource, or ""
+// if it is healthy
+func replicationSourceError(rs *volsyncv1alpha1.ReplicationSource) string {
+	if rs.Status == nil {
+		return ""
+	}
+	cond := apimeta.FindStatusCondition(rs.Status.Conditions, volsyncv1alpha1.ConditionSynchronizing)
+	if cond != nil && (cond.Reason == volsyncv1alpha1.SynchronizingReasonError ||
+		cond.Reason == volsyncv1alpha1.SynchronizingReasonMoverNotAllowed) {
+		return cond.Message
+	}
+	if rs.Status.LatestMoverStatus != nil &&
+		rs.Status.LatestMoverStatus.Result == volsyncv1alpha1.MoverResultFailed {
+		return "the most recent mover Job failed"
+	}
+	return ""
+}
+
+// Returns the (non-terminating) PVCs that are selected by the policy
+func (r *ReplicationPolicyReconciler) selectedPVCs(ctx context.Context,
+	policy *volsyncv1alpha1.ReplicationPolicy) ([]corev1.PersistentVolumeClaim, error) {
+	pvcSelector, err := metav1.LabelSelectorAsSelector(&policy.Spec.PVCSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	var nsAllowed map[string]bool
+	if policy.Spec.NamespaceSelector != nil {
+		nsSelector, err := metav1.LabelSelectorAsSelector(policy.Spec.NamespaceSelector)
+		if err != nil {
+			return nil, err
+		}
+		nsList := &corev1.NamespaceList{}
+		if err := r.Client.List(ctx, nsList, client.MatchingLabelsSelector{Selector: nsSelector}); err != nil {
+			return nil, err
+		}
+		nsAllowed = map[string]bool{}
+		for _, ns := range nsList.Items {
+			nsAllowed[ns.GetName()] = true
+		}
+	}
+
+	pvcList := &corev1.PersistentVolumeClaimList{}
+	if err := r.Client.List(ctx, pvcList, client.MatchingLabelsSelector{Selector: pvcSelector}); err != nil {
+		return nil, err
+	}
+
+	selected := []corev1.PersistentVolumeClaim{}
+	for _, pvc := range pvcList.Items {
+		if !pvc.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+		if nsAllowed != nil && !nsAllowed[pvc.GetNamespace()] {
+			continue
+		}
+		selected = append(selected, pvc)
+	}
+	return selected, nil
+}
+
+func (r *ReplicationPolicyReconciler) ensureReplicationSource(ctx context.Context, logger logr.Logger,
+	policy *volsyncv1alpha1.ReplicationPolicy, pvc *corev1.PersistentVolumeClaim,
+	repoTemplate *corev1.Secret) (*volsyncv1alpha1.ReplicationSource, error) {
+	key := policySourceKey(policy, pvc)
+	rs := &volsyncv1alpha1.ReplicationSource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+		},
+	}
+	logger = logger.WithValues("replicationsource", client.ObjectKeyFromObject(rs))
+
+	op, err := ctrlutil.CreateOrUpdate(ctx, r.Client, rs, func() error {
+		if !rs.CreationTimestamp.IsZero() && !isOwnedByPolicy(rs, policy) {
+			return fmt.Errorf("ReplicationSource %s/%s already exists and is not managed by ReplicationPolicy %s",
+				rs.GetNamespace(), rs.GetName(), policy.GetName())
+		}
+		if err := ctrl.SetControllerReference(policy, rs, r.Client.Scheme()); err != nil {
+			return err
+		}
+		utils.SetOwnedByVolSync(rs)
+		utils.AddAllLabels(rs, policy.Spec.SourceTemplate.Labels)
+		utils.AddLabel(rs, volsyncv1alpha1.ReplicationPolicyLabel, policy.GetName())
+		annotations := rs.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		for k, v := range policy.Spec.SourceTemplate.Annotations {
+			annotations[k] = v
+		}
+		rs.SetAnnotations(annotations)
+
+		rs.Spec = *policy.Spec.SourceTemplate.Spec.DeepCopy()
+		rs.Spec.SourcePVC = pvc.GetName()
+		if repoTemplate != nil && rs.Spec.Restic != nil {
+			rs.Spec.Restic.Repository = rs.GetName() + "-repo"
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Error(err, "unable to ensure ReplicationSource")
+		return nil, err
+	}
+	if op != ctrlutil.OperationResultNone {
+		logger.Info("ReplicationSource reconciled", "operation", op)
+	}
+
+	if repoTemplate != nil && rs.Spec.Restic != nil {
+		if err := r.ensureRepositorySecret(ctx, logger, policy, rs, pvc, repoTemplate); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+// policySourceKey is the name of the ReplicationSource that the policy
+// creates for the PVC
+func policySourceKey(policy *volsyncv1alpha1.ReplicationPolicy,
+	pvc *corev1.PersistentVolumeClaim) types.NamespacedName {
+	return types.NamespacedName{Name: policy.GetName() + "-" + pvc.GetName(), Namespace: pvc.GetNamespace()}
+}
+
+// Renders the repository template into a Secret (owned by the
+// ReplicationSource) in the namespace of the PVC
+func (r *ReplicationPolicyReconciler) ensureRepositorySecret(ctx context.Context, logger logr.Logger,
+	policy *volsyncv1alpha1.ReplicationPolicy, rs *volsyncv1alpha1.ReplicationSource,
+	pvc *corev1.PersistentVolumeClaim, repoTemplate *corev1.Secret) error {
+	data := repositoryTemplateData{
+		PolicyName: policy.GetName(),
+		Namespace:  pvc.GetNamespace(),
+		PVCName:    pvc.GetName(),
+	}
+	rendered := map[string][]byte{}
+	for k, v := range repoTemplate.Data {
+		out, err := renderRepositoryTemplate(k, string(v), data)
+		if err != nil {
+			return err
+		}
+		rendered[k] = out
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      rs.Spec.Restic.Repository,
+			Namespace: rs.GetNamespace(),
+		},
+	}
+	_, err := ctrlutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		if err := ctrl.SetControllerReference(rs, secret, r.Client.Scheme()); err != nil {
+			return err
+		}
+		utils.SetOwnedByVolSync(secret)
+		utils.AddLabel(secret, volsyncv1alpha1.ReplicationPolicyLabel, policy.GetName())
+		secret.Data = rendered
+		return nil
+	})
+	if err != nil {
+		logger.Error(err, "unable to ensure repository secret")
+	}
+	return err
+}
+
+type templateError struct {
+	err error
+}
+
+func (e *templateError) Error() string {
+	return e.err.Error()
+}
+
+func (e *templateError) Unwrap() error {
+	return e.err
+}
+
+func errorIsTemplateErr(err error) bool {
+	var tErr *templateError
+	return errors.As(err, &tErr)
+}
+
+func renderRepositoryTemplate(key string, value string, data repositoryTemplateData) ([]byte, error) {
+	tmpl, err := template.New(key).Option("missingkey=error").Parse(value)
+	if err != nil {
+		return nil, &templateError{err: fmt.Errorf("invalid repository template for key %s: %w", key, err)}
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, &templateError{err: fmt.Errorf("unable to render repository template for key %s: %w", key, err)}
+	}
+	return buf.Bytes(), nil
+}
+
+func isOwnedByPolicy(rs *volsyncv1alpha1.ReplicationSource, policy *volsyncv1alpha1.ReplicationPolicy) bool {
+	for _, ref := range rs.GetOwnerReferences() {
+		if ref.UID == policy.GetUID() {
+			return true
+		}
+	}
+	return false
+}
+
+// Any change to PVCs or namespaces could change the selection of any policy
+func mapFuncToAllReplicationPolicies(ctx context.Context, k8sClient client.Client) []reconcile.Request {
+	logger := ctrl.Log.WithName("mapFuncToAllReplicationPolicies")
+
+	policyList := &volsyncv1alpha1.ReplicationPolicyList{}
+	if err := k8sClient.List(ctx, policyList); err != nil {
+		logger.Error(err, "Error looking up replicationpolicies")
+		return []reconcile.Request{}
+	}
+
+	requests := []reconcile.Request{}
+	for _, policy := range policyList.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: policy.GetName()},
+		})
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ReplicationPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	mapToPolicies := handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, _ client.Object) []reconcile.Request {
+		return mapFuncToAllReplicationPolicies(ctx, mgr.GetClient())
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&volsyncv1alpha1.ReplicationPolicy{}).
+		Owns(&volsyncv1alpha1.ReplicationSource{}).
+		Watches(&corev1.PersistentVolumeClaim{}, mapToPolicies,
+			builder.WithPredicates(predicate.LabelChangedPredicate{})).
+		Watches(&corev1.Namespace{}, mapToPolicies,
+			builder.WithPredicates(predicate.LabelChangedPredicate{})).
+		Complete(r)
+}
@@ -0,0 +1,270 @@
+package controllers
+
+import (
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("ReplicationPolicy", func() {
+	var namespace *corev1.Namespace
+	var policy *volsyncv1alpha1.ReplicationPolicy
+	var repoTemplate *corev1.Secret
+	var selectedPVC *corev1.PersistentVolumeClaim
+	var otherPVC *corev1.PersistentVolumeClaim
+
+	newPVC := func(name string, lbls map[string]string) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace.Name,
+				Labels:    lbls,
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceStorage: resource.MustParse("1Gi"),
+					},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		namespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "volsync-test-",
+				Labels:       map[string]string{"policy-test": "true"},
+			},
+		}
+		createWithCacheReload(ctx, k8sClient, namespace)
+		Expect(namespace.Name).NotTo(BeEmpty())
+
+		selectedPVC = newPVC("selected", map[string]string{"backup": "true"})
+		otherPVC = newPVC("other", nil)
+		createWithCacheReload(ctx, k8sClient, selectedPVC)
+		createWithCacheReload(ctx, k8sClient, otherPVC)
+
+		repoTemplate = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "repo-template",
+				Namespace: namespace.Name,
+			},
+			StringData: map[string]string{
+				"RESTIC_REPOSITORY": "s3:http://minio/bucket/{{ .Namespace }}/{{ .PVCName }}",
+				"RESTIC_PASSWORD":   "secret",
+			},
+		}
+		createWithCacheReload(ctx, k8sClient, repoTemplate)
+
+		policy = &volsyncv1alpha1.ReplicationPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				// Cluster scoped, so use the namespace name to keep it unique
+				Name: "policy-" + namespace.Name,
+			},
+			Spec: volsyncv1alpha1.ReplicationPolicySpec{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"kubernetes.io/metadata.name": namespace.Name},
+				},
+				PVCSelector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"backup": "true"},
+				},
+				SourceTemplate: volsyncv1alpha1.ReplicationPolicySourceTemplate{
+					Spec: volsyncv1alpha1.ReplicationSourceSpec{
+						Trigger: &volsyncv1alpha1.ReplicationSourceTriggerSpec{
+							Schedule: ptr.To("0 * * * *"),
+						},
+						Restic: &volsyncv1alpha1.ReplicationSourceResticSpec{
+							ReplicationSourceVolumeOptions: volsyncv1alpha1.ReplicationSourceVolumeOptions{
+								CopyMethod: volsyncv1alpha1.CopyMethodSnapshot,
+							},
+						},
+					},
+				},
+				RepositoryTemplate: &volsyncv1alpha1.ReplicationPolicyRepositoryTemplate{
+					Name:      repoTemplate.Name,
+					Namespace: repoTemplate.Namespace,
+				},
+			},
+		}
+	})
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, policy)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, namespace)).To(Succeed())
+	})
+	JustBeforeEach(func() {
+		createWithCacheReload(ctx, k8sClient, policy)
+	})
+
+	It("creates a ReplicationSource only for the selected PVC", func() {
+		rs := &volsyncv1alpha1.ReplicationSource{}
+		rsKey := client.ObjectKey{Name: policy.Name + "-" + selectedPVC.Name, Namespace: namespace.Name}
+		Eventually(func() error {
+			return k8sClient.Get(ctx, rsKey, rs)
+		}, maxWait, interval).Should(Succeed())
+		Expect(rs.Spec.SourcePVC).To(Equal(selectedPVC.Name))
+		Expect(rs.Labels).To(HaveKeyWithValue(volsyncv1alpha1.ReplicationPolicyLabel, policy.Name))
+		Expect(rs.Spec.Restic).NotTo(BeNil())
+		Expect(rs.Spec.Restic.Repository).To(Equal(rs.Name + "-repo"))
+
+		otherRS := &volsyncv1alpha1.ReplicationSource{}
+		err := k8sClient.Get(ctx, client.ObjectKey{Name: policy.Name + "-" + otherPVC.Name,
+			Namespace: namespace.Name}, otherRS)
+		Expect(kerrors.IsNotFound(err)).To(BeTrue())
+
+		// Repository secret is rendered from the template
+		repo := &corev1.Secret{}
+		Eventually(func() error {
+			return k8sClient.Get(ctx, client.ObjectKey{Name: rs.Spec.Restic.Repository,
+				Namespace: namespace.Name}, repo)
+		}, maxWait, interval).Should(Succeed())
+		Expect(string(repo.Data["RESTIC_REPOSITORY"])).To(Equal(
+			"s3:http://minio/bucket/" + namespace.Name + "/" + selectedPVC.Name))
+		Expect(string(repo.Data["RESTIC_PASSWORD"])).To(Equal("secret"))
+
+		Eventually(func() int32 {
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(policy), policy)).To(Succeed())
+			if policy.Status == nil {
+				return 0
+			}
+			return policy.Status.ReplicationSources
+		}, maxWait, interval).Should(Equal(int32(1)))
+		Expect(policy.Status.MatchedPVCs).To(Equal(int32(1)))
+		cond := apimeta.FindStatusCondition(policy.Status.Conditions, volsyncv1alpha1.ConditionPolicyReconciled)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+
+		By("removing the label from the PVC, the ReplicationSource should be removed")
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(selectedPVC), selectedPVC)).To(Succeed())
+		selectedPVC.Labels = nil
+		Expect(k8sClient.Update(ctx, selectedPVC)).To(Succeed())
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, rsKey, rs)
+			return kerrors.IsNotFound(err)
+		}, maxWait, interval).Should(BeTrue())
+	})
+
+	It("aggregates the health of the managed ReplicationSources", func() {
+		rs := &volsyncv1alpha1.ReplicationSource{}
+		rsKey := client.ObjectKey{Name: policy.Name + "-" + selectedPVC.Name, Namespace: namespace.Name}
+		Eventually(func() error {
+			return k8sClient.Get(ctx, rsKey, rs)
+		}, maxWait, interval).Should(Succeed())
+
+		Eventually(func() *metav1.Condition {
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(policy), policy)).To(Succeed())
+			if policy.Status == nil {
+				return nil
+			}
+			return apimeta.FindStatusCondition(policy.Status.Conditions, volsyncv1alpha1.ConditionPolicySourcesHealthy)
+		}, maxWait, interval).ShouldNot(BeNil())
+		cond := apimeta.FindStatusCondition(policy.Status.Conditions, volsyncv1alpha1.ConditionPolicySourcesHealthy)
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(policy.Status.HealthySources).To(Equal(int32(1)))
+		Expect(policy.Status.FailingSources).To(Equal(int32(0)))
+
+		By("marking the latest mover job of the ReplicationSource as failed")
+		Eventually(func() error {
+			if err := k8sClient.Get(ctx, rsKey, rs); err != nil {
+				return err
+			}
+			if rs.Status == nil {
+				rs.Status = &volsyncv1alpha1.ReplicationSourceStatus{}
+			}
+			rs.Status.LatestMoverStatus = &volsyncv1alpha1.MoverStatus{
+				Result: volsyncv1alpha1.MoverResultFailed,
+			}
+			return k8sClient.Status().Update(ctx, rs)
+		}, maxWait, interval).Should(Succeed())
+
+		Eventually(func() int32 {
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(policy), policy)).To(Succeed())
+			return policy.Status.FailingSources
+		}, maxWait, interval).Should(Equal(int32(1)))
+		Expect(policy.Status.HealthySources).To(Equal(int32(0)))
+		Expect(policy.Status.Failing).To(HaveLen(1))
+		Expect(policy.Status.Failing[0].Name).To(Equal(rs.Name))
+		Expect(policy.Status.Failing[0].Namespace).To(Equal(namespace.Name))
+		cond = apimeta.FindStatusCondition(policy.Status.Conditions, volsyncv1alpha1.ConditionPolicySourcesHealthy)
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(volsyncv1alpha1.PolicySourcesHealthyReasonFailing))
+	})
+
+	It("keeps the ReplicationSource of a selected PVC when updating it fails", func() {
+		rs := &volsyncv1alpha1.ReplicationSource{}
+		rsKey := client.ObjectKey{Name: policy.Name + "-" + selectedPVC.Name, Namespace: namespace.Name}
+		Eventually(func() error {
+			return k8sClient.Get(ctx, rsKey, rs)
+		}, maxWait, interval).Should(Succeed())
+		uid := rs.GetUID()
+
+		By("breaking the repository template, ensuring the ReplicationSource fails")
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(repoTemplate), repoTemplate)).To(Succeed())
+		repoTemplate.Data["RESTIC_REPOSITORY"] = []byte("s3:http://minio/bucket/{{ .Missing }}")
+		Expect(k8sClient.Update(ctx, repoTemplate)).To(Succeed())
+		// Trigger a reconcile of the policy
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(selectedPVC), selectedPVC)).To(Succeed())
+		selectedPVC.Labels["touched"] = "true"
+		Expect(k8sClient.Update(ctx, selectedPVC)).To(Succeed())
+
+		Eventually(func() metav1.ConditionStatus {
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(policy), policy)).To(Succeed())
+			if policy.Status == nil {
+				return ""
+			}
+			cond := apimeta.FindStatusCondition(policy.Status.Conditions, volsyncv1alpha1.ConditionPolicyReconciled)
+			if cond == nil {
+				return ""
+			}
+			return cond.Status
+		}, maxWait, interval).Should(Equal(metav1.ConditionFalse))
+		Consistently(func() error {
+			if err := k8sClient.Get(ctx, rsKey, rs); err != nil {
+				return err
+			}
+			Expect(rs.GetUID()).To(Equal(uid))
+			Expect(rs.DeletionTimestamp).To(BeNil())
+			return nil
+		}, duration, interval).Should(Succeed())
+		Expect(policy.Status.ReplicationSources).To(Equal(int32(1)))
+	})
+
+	When("a ReplicationSource with the same name already exists", func() {
+		var existing *volsyncv1alpha1.ReplicationSource
+		BeforeEach(func() {
+			existing = &volsyncv1alpha1.ReplicationSource{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      policy.Name + "-" + selectedPVC.Name,
+					Namespace: namespace.Name,
+				},
+				Spec: volsyncv1alpha1.ReplicationSourceSpec{
+					SourcePVC: "something-else",
+					External:  &volsyncv1alpha1.ReplicationSourceExternalSpec{},
+				},
+			}
+			createWithCacheReload(ctx, k8sClient, existing)
+		})
+		It("does not take over the existing ReplicationSource", func() {
+			Eventually(func() *metav1.Condition {
+				Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(policy), policy)).To(Succeed())
+				if policy.Status == nil {
+					return nil
+				}
+				return apimeta.FindStatusCondition(policy.Status.Conditions, volsyncv1alpha1.ConditionPolicyReconciled)
+			}, maxWait, interval).ShouldNot(BeNil())
+			cond := apimeta.FindStatusCondition(policy.Status.Conditions, volsyncv1alpha1.ConditionPolicyReconciled)
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(existing), existing)).To(Succeed())
+			Expect(existing.Spec.SourcePVC).To(Equal("something-else"))
+		})
+	})
+})
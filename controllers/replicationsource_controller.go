@@ -21,6 +21,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -36,6 +37,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/events"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -46,6 +48,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	vserrors "github.com/backube/volsync/controllers/errors"
 	"github.com/backube/volsync/controllers/mover"
 	sm "github.com/backube/volsync/controllers/statemachine"
 	"github.com/backube/volsync/controllers/utils"
@@ -53,6 +56,10 @@ import (
 
 const (
 	ReplicationSourceToSourcePVCIndex string = "replicationsource.spec.sourcePVC"
+
+	// Defaults for spec.ioGate
+	defaultIOGateMaxDelay      = time.Hour
+	defaultIOGateCheckInterval = time.Minute
 )
 
 // ReplicationSourceReconciler reconciles a ReplicationSource object
@@ -64,11 +71,14 @@ type ReplicationSourceReconciler struct {
 }
 
 type rsMachine struct {
-	rs      *volsyncv1alpha1.ReplicationSource
-	client  client.Client
-	logger  logr.Logger
-	metrics volsyncMetrics
-	mover   mover.Mover
+	rs            *volsyncv1alpha1.ReplicationSource
+	client        client.Client
+	logger        logr.Logger
+	eventRecorder events.EventRecorder
+	metrics       volsyncMetrics
+	mover         mover.Mover
+	// windows restrict when synchronizations may start (spec.schedulePolicy)
+	windows *syncWindows
 }
 
 var _ sm.ReplicationMachine = &rsMachine{}
@@ -78,11 +88,14 @@ var _ sm.ReplicationMachine = &rsMachine{}
 //+kubebuilder:rbac:groups=volsync.backube,resources=replicationsources,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=volsync.backube,resources=replicationsources/finalizers,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=volsync.backube,resources=replicationsources/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=volsync.backube,resources=replicationschedulepolicies,verbs=get;list;watch
 //+kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete;deletecollection
+//+kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=core,resources=events,verbs=create;update;patch
 //+kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete;deletecollection
 //+kubebuilder:rbac:groups=core,resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch;delete;deletecollection
 //+kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=resourcequotas,verbs=get;list;watch
 //+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
@@ -92,6 +105,8 @@ var _ sm.ReplicationMachine = &rsMachine{}
 //+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=security.openshift.io,resources=securitycontextconstraints,resourceNames=volsync-privileged-mover,verbs=use
 //+kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshots,verbs=get;list;watch;create;update;patch;delete;deletecollection
+//+kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshotclasses,verbs=get;list;watch
+//+kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get;list;watch
 
 //nolint:funlen
 func (r *ReplicationSourceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -100,6 +115,7 @@ func (r *ReplicationSourceReconciler) Reconcile(ctx context.Context, req ctrl.Re
 	if err := r.Client.Get(ctx, req.NamespacedName, inst); err != nil {
 		if kerrors.IsNotFound(err) {
 			logger.Error(err, "Failed to get Source")
+			sm.ReleaseSync(rsLaunchKey(req.NamespacedName))
 		}
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
@@ -111,18 +127,33 @@ func (r *ReplicationSourceReconciler) Reconcile(ctx context.Context, req ctrl.Re
 	var result ctrl.Result
 	var err error
 
+	// A suspended namespace pauses the mover
+	err = applyNamespaceSuspend(ctx, r.Client, logger, &inst.Status.Conditions, inst.GetNamespace(),
+		&inst.Spec.Paused)
+	if err != nil {
+		return result, err
+	}
+
 	// Check if privileged movers are allowed via namespace annotation
 	privilegedMoverOk, err := utils.PrivilegedMoversOk(ctx, r.Client, logger, inst.GetNamespace())
 	if err != nil {
 		return result, err
 	}
+	// The Hardened security profile never uses privileged movers
+	if utils.SecurityProfileFor(inst) == volsyncv1alpha1.SecurityProfileHardened {
+		privilegedMoverOk = false
+	}
 
 	rsm, err := newRSMachine(inst, r.Client, logger,
 		record.NewEventRecorderAdapter(mover.NewEventRecorderLogger(r.EventRecorder)), privilegedMoverOk)
 
 	// Using only external method
 	if errors.Is(err, mover.ErrNoMoverFound) && inst.Spec.External != nil {
-		return ctrl.Result{}, nil
+		err = ensureMoverAllowed(ctx, r.Client, logger, &inst.Status.Conditions, inst.GetNamespace(),
+			volsyncv1alpha1.ExternalMoverName)
+		if err == nil {
+			return ctrl.Result{}, nil
+		}
 	}
 	// Both internal and external methods defined
 	if rsm != nil && inst.Spec.External != nil {
@@ -136,18 +167,60 @@ func (r *ReplicationSourceReconciler) Reconcile(ctx context.Context, req ctrl.Re
 	}
 	// No method found
 	if rsm == nil && inst.Spec.External == nil {
-		err = mover.ErrNoMoverFound
+		message := err.Error()
+		if errors.Is(err, mover.ErrNoMoverFound) {
+			message += fmt.Sprintf(" - enabled movers: %v", mover.GetEnabledMoverList())
+		}
 		apimeta.SetStatusCondition(&inst.Status.Conditions, metav1.Condition{
 			Type:    volsyncv1alpha1.ConditionSynchronizing,
 			Status:  metav1.ConditionFalse,
 			Reason:  volsyncv1alpha1.SynchronizingReasonError,
-			Message: err.Error() + fmt.Sprintf(" - enabled movers: %v", mover.GetEnabledMoverList()),
+			Message: message,
 		})
 	}
 
-	// All good, so run the state machine
+	// The namespace may restrict which movers can be used
+	if err == nil {
+		err = ensureMoverAllowed(ctx, r.Client, logger, &inst.Status.Conditions, inst.GetNamespace(),
+			rsm.mover.Name())
+	}
+
+	// The security profile may not be satisfiable by the mover
 	if err == nil {
+		err = ensureSecurityProfile(logger, inst, &inst.Status.Conditions, &inst.Status.Security,
+			rsm.mover.Name(), privilegedMoverOk)
+	}
+
+	// Synchronizations may be restricted to the windows of a schedule policy
+	if err == nil {
+		rsm.windows, err = getSyncWindows(ctx, r.Client, logger, inst)
+	}
+
+	// All good, so run the state machine
+	moverReady := err == nil
+	if moverReady {
 		result, err = sm.Run(ctx, rsm, logger)
+		updateSyncBlockedCondition(&inst.Status.Conditions, err)
+	}
+
+	// Connection tests are run independently of the synchronizations, so
+	// they can be used while the synchronizations are failing
+	if moverReady {
+		var testErr error
+		result, testErr = runConnectionTest(ctx, rsm.mover, result)
+		if err == nil { // Don't mask previous error
+			err = testErr
+		}
+	}
+
+	// Retained intermediate snapshots expire independently of the schedule
+	if err == nil {
+		var expiry time.Duration
+		expiry, err = utils.PruneIntermediateSnapshots(ctx, r.Client, logger, inst,
+			inst.Spec.IntermediateRetention, time.Now())
+		if expiry > 0 && (result.RequeueAfter == 0 || expiry < result.RequeueAfter) {
+			result.RequeueAfter = expiry
+		}
 	}
 
 	// Update instance status
@@ -159,7 +232,7 @@ func (r *ReplicationSourceReconciler) Reconcile(ctx context.Context, req ctrl.Re
 }
 
 func (r *ReplicationSourceReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&volsyncv1alpha1.ReplicationSource{}).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: 100,
@@ -176,7 +249,17 @@ func (r *ReplicationSourceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, o client.Object) []reconcile.Request {
 				return mapFuncCopyTriggerPVCToReplicationSource(ctx, mgr.GetClient(), o)
 			}), builder.WithPredicates(copyTriggerPVCPredicate())).
-		Complete(r)
+		Watches(&volsyncv1alpha1.ReplicationSchedulePolicy{},
+			handler.EnqueueRequestsFromMapFunc(mapFuncSchedulePolicyToReplicationSources(mgr.GetClient())))
+	// The annotations of the namespaces can only be watched when VolSync
+	// watches all namespaces
+	if !utils.NamespaceScoped() {
+		b = b.Watches(&corev1.Namespace{},
+			handler.EnqueueRequestsFromMapFunc(mapFuncNamespaceToObjects(mgr.GetClient(),
+				func() client.ObjectList { return &volsyncv1alpha1.ReplicationSourceList{} })),
+			builder.WithPredicates(namespaceSuspendPredicate()))
+	}
+	return b.Complete(r)
 }
 
 func mapFuncCopyTriggerPVCToReplicationSource(ctx context.Context, k8sClient client.Client,
@@ -254,6 +337,7 @@ func IndexFieldsForReplicationSource(ctx context.Context, fieldIndexer client.Fi
 			if sourcePVC != "" {
 				res = append(res, sourcePVC)
 			}
+			res = append(res, replicationSource.Spec.SourcePVCs...)
 			return res
 		})
 }
@@ -273,14 +357,27 @@ func newRSMachine(rs *volsyncv1alpha1.ReplicationSource, c client.Client,
 	})
 
 	return &rsMachine{
-		rs:      rs,
-		client:  c,
-		logger:  l,
-		metrics: metrics,
-		mover:   dataMover,
+		rs:            rs,
+		client:        c,
+		logger:        l,
+		eventRecorder: er,
+		metrics:       metrics,
+		mover:         dataMover,
 	}, nil
 }
 
+func rsLaunchKey(nn types.NamespacedName) string {
+	return "ReplicationSource/" + nn.String()
+}
+
+func (m *rsMachine) Namespace() string {
+	return m.rs.GetNamespace()
+}
+
+func (m *rsMachine) LaunchKey() string {
+	return rsLaunchKey(client.ObjectKeyFromObject(m.rs))
+}
+
 func (m *rsMachine) Cronspec() string {
 	if m.rs.Spec.Trigger != nil && m.rs.Spec.Trigger.Schedule != nil {
 		return *m.rs.Spec.Trigger.Schedule
@@ -288,6 +385,13 @@ func (m *rsMachine) Cronspec() string {
 	return ""
 }
 
+func (m *rsMachine) TimeZone() string {
+	if m.rs.Spec.Trigger != nil && m.rs.Spec.Trigger.TimeZone != nil {
+		return *m.rs.Spec.Trigger.TimeZone
+	}
+	return ""
+}
+
 func (m *rsMachine) ManualTag() string {
 	if m.rs.Spec.Trigger != nil {
 		return m.rs.Spec.Trigger.Manual
@@ -308,6 +412,15 @@ func (m *rsMachine) NextSyncTime() *metav1.Time {
 }
 
 func (m *rsMachine) SetNextSyncTime(next *metav1.Time) {
+	// A scheduled sync can only start once the schedule policy permits it
+	if next != nil && m.windows != nil {
+		allowed, err := m.windows.nextAllowed(next.Time)
+		if err != nil {
+			m.logger.Error(err, "unable to find the next sync window", "policy", m.windows.name)
+		} else {
+			next = &metav1.Time{Time: allowed}
+		}
+	}
 	m.rs.Status.NextSyncTime = next
 }
 
@@ -355,10 +468,202 @@ func (m *rsMachine) ObserveSyncDuration(duration time.Duration) {
 	m.metrics.SyncDurations.Observe(duration.Seconds())
 }
 
+func (m *rsMachine) LatestMoverStatus() *volsyncv1alpha1.MoverStatus {
+	return m.rs.Status.LatestMoverStatus
+}
+
+func (m *rsMachine) SyncHistory() *[]volsyncv1alpha1.SyncHistoryEntry {
+	return &m.rs.Status.History
+}
+
+func (m *rsMachine) RetryPolicy() *volsyncv1alpha1.RetryPolicy {
+	return m.rs.Spec.RetryPolicy
+}
+
+func (m *rsMachine) RetryStatus() *volsyncv1alpha1.RetryStatus {
+	return m.rs.Status.Retries
+}
+
+func (m *rsMachine) SetRetryStatus(rs *volsyncv1alpha1.RetryStatus) {
+	m.rs.Status.Retries = rs
+}
+
+func (m *rsMachine) BytesTransferred() *int64 {
+	var stats *volsyncv1alpha1.RsyncTransferStats
+	switch {
+	case m.rs.Status.Rsync != nil:
+		stats = m.rs.Status.Rsync.TransferStats
+	case m.rs.Status.RsyncTLS != nil:
+		stats = m.rs.Status.RsyncTLS.TransferStats
+	}
+	if stats == nil {
+		return nil
+	}
+	return ptr.To(stats.PhysicalBytes)
+}
+
+func (m *rsMachine) NotifySyncResult(ctx context.Context, event volsyncv1alpha1.NotificationEventType,
+	message string) {
+	if m.rs.Spec.Notifications == nil {
+		return
+	}
+	n := utils.SyncNotification{
+		Kind:      "ReplicationSource",
+		Namespace: m.rs.Namespace,
+		Name:      m.rs.Name,
+		Event:     event,
+		Time:      time.Now().UTC(),
+		Message:   message,
+	}
+	if event == volsyncv1alpha1.NotificationEventSucceeded && m.rs.Status.LastSyncDuration != nil {
+		n.Duration = m.rs.Status.LastSyncDuration.Duration.String()
+	}
+	utils.SendSyncNotification(ctx, m.logger, m.client, m.rs.Spec.Notifications, n)
+}
+
+// DeferSync puts off the start of a synchronization until the schedule policy
+// permits it, and while the IO activity on the source volume is above the
+// threshold of spec.ioGate, for up to maxDelay
+func (m *rsMachine) DeferSync(ctx context.Context) (time.Duration, string, error) {
+	if retry, message, err := m.deferToSyncWindow(); retry > 0 || err != nil {
+		return retry, message, err
+	}
+
+	gate := m.rs.Spec.IOGate
+	if gate == nil {
+		m.rs.Status.IOGate = nil
+		return 0, "", nil
+	}
+	if m.rs.Status.IOGate == nil {
+		m.rs.Status.IOGate = &volsyncv1alpha1.ReplicationSourceIOGateStatus{}
+	}
+	st := m.rs.Status.IOGate
+	now := metav1.Now()
+	if st.DeferredSince == nil {
+		st.DeferredSince = &now
+	}
+
+	maxDelay := defaultIOGateMaxDelay
+	if gate.MaxDelay != nil {
+		maxDelay = gate.MaxDelay.Duration
+	}
+	interval := defaultIOGateCheckInterval
+	if gate.CheckInterval != nil && gate.CheckInterval.Duration > 0 {
+		interval = gate.CheckInterval.Duration
+	}
+	if now.Sub(st.DeferredSince.Time) >= maxDelay {
+		m.logger.Info("starting synchronization after the maximum ioGate delay", "maxDelay", maxDelay)
+		st.DeferredSince = nil
+		return 0, "", nil
+	}
+
+	pvcNames := m.rs.Spec.SourcePVCs
+	if m.rs.Spec.SourcePVC != "" {
+		pvcNames = []string{m.rs.Spec.SourcePVC}
+	}
+	activity := 0.0
+	for _, name := range pvcNames {
+		v, err := utils.QueryIOActivity(ctx, m.client, m.rs.GetNamespace(), name, &gate.Prometheus)
+		if err != nil {
+			// Not knowing the activity isn't fatal, the sync starts once
+			// maxDelay has elapsed
+			m.logger.Error(err, "unable to query source volume IO activity")
+			st.LastValue = ""
+			st.LastCheckTime = &now
+			return interval, "Unable to query the IO activity of the source volume: " + err.Error(), nil
+		}
+		activity = max(activity, v)
+	}
+	st.LastValue = strconv.FormatFloat(activity, 'g', -1, 64)
+	st.LastCheckTime = &now
+
+	threshold := gate.Threshold.AsApproximateFloat64()
+	if activity < threshold {
+		st.DeferredSince = nil
+		return 0, "", nil
+	}
+	return interval, fmt.Sprintf("Waiting for the IO activity of the source volume (%s) to drop below %s",
+		st.LastValue, gate.Threshold.String()), nil
+}
+
+// deferToSyncWindow puts off the start of a synchronization that is due
+// outside of the windows of the schedule policy, reporting the time at which
+// it will start as the next sync time
+func (m *rsMachine) deferToSyncWindow() (time.Duration, string, error) {
+	if m.windows == nil {
+		return 0, "", nil
+	}
+	now := time.Now()
+	allowed, err := m.windows.nextAllowed(now)
+	if err != nil {
+		return 0, "", err
+	}
+	if !allowed.After(now) {
+		if m.Cronspec() == "" {
+			m.rs.Status.NextSyncTime = nil
+		}
+		return 0, "", nil
+	}
+	m.rs.Status.NextSyncTime = &metav1.Time{Time: allowed}
+	return allowed.Sub(now), fmt.Sprintf("Synchronization is not permitted by ReplicationSchedulePolicy %s until %s",
+		m.windows.name, allowed.Format(time.RFC3339)), nil
+}
+
 func (m *rsMachine) Synchronize(ctx context.Context) (mover.Result, error) {
-	return m.mover.Synchronize(ctx)
+	utils.SetCleanupCompleteCondition(m.eventRecorder, m.rs, false)
+	result, err := m.mover.Synchronize(ctx)
+	if result.Completed {
+		// The movers mount the source read-only when it's enforced (or refuse
+		// to run), so a completed sync has been done w/ a read-only source
+		m.rs.Status.ReadOnlySourceEnforced = m.rs.Spec.EnforceReadOnlySource
+	}
+	if m.rs.Status.Restic != nil {
+		m.metrics.SuspectedCorruptFiles.Set(float64(m.rs.Status.Restic.SuspectedCorruptFileCount))
+		if stats := m.rs.Status.Restic.RepositoryStats; stats != nil {
+			if stats.StoredSize != nil {
+				m.metrics.ResticRepositorySize.Set(float64(stats.StoredSize.Value()))
+			}
+			if ratio, err := strconv.ParseFloat(stats.DeduplicationRatio, 64); err == nil {
+				m.metrics.ResticDeduplicationRatio.Set(ratio)
+			}
+			m.metrics.ResticSourceSnapshots.Set(float64(stats.SourceSnapshotCount))
+		}
+	}
+	return result, err
 }
 
 func (m *rsMachine) Cleanup(ctx context.Context) (mover.Result, error) {
-	return m.mover.Cleanup(ctx)
+	// Take the snapshots out of the cleanup before the mover removes its
+	// temporary objects
+	err := utils.RetainIntermediateSnapshots(ctx, m.client, m.logger, m.rs, m.rs.Spec.IntermediateRetention)
+	if err != nil {
+		return mover.InProgress(), err
+	}
+	result, err := m.mover.Cleanup(ctx)
+	if result.Completed && err == nil {
+		utils.SetCleanupCompleteCondition(m.eventRecorder, m.rs, true)
+	}
+	return result, err
+}
+
+func (m *rsMachine) VerifyCleanup(ctx context.Context) (time.Duration, error) {
+	warnings, recheck, err := utils.VerifyCleanup(ctx, m.client, m.logger, m.rs, m.rs.Status.CleanupWarnings)
+	m.rs.Status.CleanupWarnings = warnings
+	return recheck, err
+}
+
+// updateSyncBlockedCondition sets the SyncBlocked condition if err is from a
+// pre-flight check that failed, and removes it otherwise
+func updateSyncBlockedCondition(conditions *[]metav1.Condition, err error) {
+	var blocked *vserrors.SyncBlockedError
+	if !errors.As(err, &blocked) {
+		apimeta.RemoveStatusCondition(conditions, volsyncv1alpha1.ConditionSyncBlocked)
+		return
+	}
+	apimeta.SetStatusCondition(conditions, metav1.Condition{
+		Type:    volsyncv1alpha1.ConditionSyncBlocked,
+		Status:  metav1.ConditionTrue,
+		Reason:  blocked.Reason,
+		Message: blocked.Message,
+	})
 }
@@ -13,6 +13,7 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -104,6 +105,38 @@ var _ = Describe("ReplicationSource", func() {
 		})
 	})
 
+	Context("when the Hardened security profile is used with the rsync mover", func() {
+		BeforeEach(func() {
+			rs.Spec.SecurityProfile = volsyncv1alpha1.SecurityProfileHardened
+			rs.Spec.Rsync = &volsyncv1alpha1.ReplicationSourceRsyncSpec{
+				ReplicationSourceVolumeOptions: volsyncv1alpha1.ReplicationSourceVolumeOptions{
+					CopyMethod: volsyncv1alpha1.CopyMethodDirect,
+				},
+			}
+		})
+		It("reports that the profile cannot be applied", func() {
+			var cond *metav1.Condition
+			Eventually(func() *metav1.Condition {
+				Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(rs), rs)).To(Succeed())
+				if rs.Status == nil {
+					return nil
+				}
+				cond = apimeta.FindStatusCondition(rs.Status.Conditions, volsyncv1alpha1.ConditionSynchronizing)
+				return cond
+			}, duration, interval).ShouldNot(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Reason).To(Equal(volsyncv1alpha1.SynchronizingReasonSecurityProfile))
+			Expect(cond.Message).To(ContainSubstring("rsyncTLS"))
+			Expect(rs.Status.Security).NotTo(BeNil())
+			Expect(rs.Status.Security.Profile).To(Equal(volsyncv1alpha1.SecurityProfileHardened))
+
+			// No mover job is started
+			jobs := &batchv1.JobList{}
+			Expect(k8sClient.List(ctx, jobs, client.InNamespace(rs.Namespace))).To(Succeed())
+			Expect(jobs.Items).To(BeEmpty())
+		})
+	})
+
 	directCopyMethodTypes := []volsyncv1alpha1.CopyMethodType{
 		volsyncv1alpha1.CopyMethodNone,
 		volsyncv1alpha1.CopyMethodDirect,
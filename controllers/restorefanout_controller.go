@@ -0,0 +1,473 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+// Manual trigger used for the ReplicationDestinations created by a
+// RestoreFanout
+const restoreFanoutTrigger = "restore-fanout"
+
+//nolint:lll
+//+kubebuilder:rbac:groups=volsync.backube,resources=restorefanouts,verbs=get;list;watch
+//+kubebuilder:rbac:groups=volsync.backube,resources=restorefanouts/finalizers,verbs=update
+//+kubebuilder:rbac:groups=volsync.backube,resources=restorefanouts/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=volsync.backube,resources=replicationdestinations,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=core,resources=persistentvolumeclaims,verbs=get;list;watch;create
+//+kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
+
+// RestoreFanoutReconciler reconciles a RestoreFanout object, restoring a
+// snapshot into each of its targets via a ReplicationDestination.
+type RestoreFanoutReconciler struct {
+	client.Client
+	Log           logr.Logger
+	Scheme        *runtime.Scheme
+	EventRecorder record.EventRecorder
+}
+
+func (r *RestoreFanoutReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := r.Log.WithValues("restorefanout", req.Name)
+	fanout := &volsyncv1alpha1.RestoreFanout{}
+	if err := r.Client.Get(ctx, req.NamespacedName, fanout); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if fanout.Status == nil {
+		fanout.Status = &volsyncv1alpha1.RestoreFanoutStatus{}
+	}
+
+	reconcileErr := r.reconcileFanout(ctx, logger, fanout)
+	updateFanoutCompletion(fanout, reconcileErr)
+
+	if err := r.Client.Status().Update(ctx, fanout); err != nil {
+		logger.Error(err, "failed to update status")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, reconcileErr
+}
+
+func (r *RestoreFanoutReconciler) reconcileFanout(ctx context.Context, logger logr.Logger,
+	fanout *volsyncv1alpha1.RestoreFanout) error {
+	targets, err := r.fanoutTargets(ctx, fanout)
+	if err != nil {
+		logger.Error(err, "unable to determine targets")
+		return err
+	}
+
+	previous := map[types.NamespacedName]volsyncv1alpha1.RestoreFanoutTargetStatus{}
+	for _, ts := range fanout.Status.Targets {
+		previous[types.NamespacedName{Namespace: ts.Namespace, Name: ts.PVCName}] = ts
+	}
+	statuses := make([]volsyncv1alpha1.RestoreFanoutTargetStatus, 0, len(targets))
+	for _, t := range targets {
+		ts, ok := previous[t]
+		if !ok {
+			ts = volsyncv1alpha1.RestoreFanoutTargetStatus{
+				Namespace: t.Namespace,
+				PVCName:   t.Name,
+				Phase:     volsyncv1alpha1.RestoreFanoutTargetPending,
+			}
+		}
+		statuses = append(statuses, ts)
+	}
+
+	// Check on the restores that are in progress and clean up after the
+	// ones that have finished
+	var lastErr error
+	active := int32(0)
+	for i := range statuses {
+		ts := &statuses[i]
+		if ts.Phase == volsyncv1alpha1.RestoreFanoutTargetPending {
+			continue
+		}
+		if err := r.updateTargetStatus(ctx, logger, fanout, ts); err != nil {
+			lastErr = err
+		}
+		if ts.Phase == volsyncv1alpha1.RestoreFanoutTargetRestoring {
+			active++
+		}
+	}
+
+	// Start as many pending restores as the parallelism allows
+	parallelism := ptr.Deref(fanout.Spec.Parallelism, volsyncv1alpha1.DefaultFanoutParallelism)
+	for i := range statuses {
+		if active >= parallelism {
+			break
+		}
+		ts := &statuses[i]
+		if ts.Phase != volsyncv1alpha1.RestoreFanoutTargetPending {
+			continue
+		}
+		if err := r.startRestore(ctx, logger, fanout, ts); err != nil {
+			lastErr = err
+			continue
+		}
+		active++
+	}
+
+	fanout.Status.Targets = statuses
+	return lastErr
+}
+
+// Returns the PVCs to restore into, in the order they should be restored
+func (r *RestoreFanoutReconciler) fanoutTargets(ctx context.Context,
+	fanout *volsyncv1alpha1.RestoreFanout) ([]types.NamespacedName, error) {
+	seen := map[types.NamespacedName]bool{}
+	targets := []types.NamespacedName{}
+	add := func(namespace string, pvcName string) {
+		if pvcName == "" {
+			pvcName = fanout.GetName()
+		}
+		t := types.NamespacedName{Namespace: namespace, Name: pvcName}
+		if !seen[t] {
+			seen[t] = true
+			targets = append(targets, t)
+		}
+	}
+
+	for _, t := range fanout.Spec.Targets {
+		add(t.Namespace, t.PVCName)
+	}
+
+	if fanout.Spec.NamespaceSelector != nil {
+		nsSelector, err := metav1.LabelSelectorAsSelector(fanout.Spec.NamespaceSelector)
+		if err != nil {
+			return nil, err
+		}
+		nsList := &corev1.NamespaceList{}
+		if err := r.Client.List(ctx, nsList, client.MatchingLabelsSelector{Selector: nsSelector}); err != nil {
+			return nil, err
+		}
+		for _, ns := range nsList.Items {
+			if !ns.GetDeletionTimestamp().IsZero() {
+				continue
+			}
+			add(ns.GetName(), "")
+		}
+	}
+	return targets, nil
+}
+
+func fanoutDestinationName(fanout *volsyncv1alpha1.RestoreFanout, pvcName string) string {
+	return fanout.GetName() + "-" + pvcName
+}
+
+// Updates the status of a target that has been started, deleting the
+// ReplicationDestination once the restore has finished
+func (r *RestoreFanoutReconciler) updateTargetStatus(ctx context.Context, logger logr.Logger,
+	fanout *volsyncv1alpha1.RestoreFanout, ts *volsyncv1alpha1.RestoreFanoutTargetStatus) error {
+	rd := &volsyncv1alpha1.ReplicationDestination{}
+	err := r.Client.Get(ctx, types.NamespacedName{
+		Name:      fanoutDestinationName(fanout, ts.PVCName),
+		Namespace: ts.Namespace,
+	}, rd)
+	if kerrors.IsNotFound(err) {
+		if ts.Phase == volsyncv1alpha1.RestoreFanoutTargetRestoring {
+			// The ReplicationDestination was removed before the restore
+			// finished, so it needs to be started again
+			ts.Phase = volsyncv1alpha1.RestoreFanoutTargetPending
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !isOwnedByFanout(rd, fanout) {
+		return fmt.Errorf("ReplicationDestination %s/%s is not managed by RestoreFanout %s",
+			rd.GetNamespace(), rd.GetName(), fanout.GetName())
+	}
+
+	if ts.Phase == volsyncv1alpha1.RestoreFanoutTargetRestoring && rd.Status != nil {
+		switch {
+		case rd.Status.LastManualSync == restoreFanoutTrigger:
+			ts.Phase = volsyncv1alpha1.RestoreFanoutTargetCompleted
+			ts.Message = ""
+			ts.CompletionTime = ptr.To(metav1.Now())
+			logger.Info("restore completed", "namespace", ts.Namespace, "pvc", ts.PVCName)
+			r.EventRecorder.Eventf(fanout, corev1.EventTypeNormal, volsyncv1alpha1.EvRFanoutRestoreCompleted,
+				"restored into PersistentVolumeClaim %s/%s", ts.Namespace, ts.PVCName)
+		case rd.Status.LatestMoverStatus != nil &&
+			rd.Status.LatestMoverStatus.Result == volsyncv1alpha1.MoverResultFailed:
+			ts.Phase = volsyncv1alpha1.RestoreFanoutTargetFailed
+			ts.Message = "the restore mover Job failed"
+			ts.CompletionTime = ptr.To(metav1.Now())
+			logger.Info("restore failed", "namespace", ts.Namespace, "pvc", ts.PVCName)
+			r.EventRecorder.Eventf(fanout, corev1.EventTypeWarning, volsyncv1alpha1.EvRFanoutRestoreFailed,
+				"restore into PersistentVolumeClaim %s/%s failed", ts.Namespace, ts.PVCName)
+		}
+	}
+
+	if ts.Phase == volsyncv1alpha1.RestoreFanoutTargetRestoring {
+		return nil
+	}
+	// The repository Secret is owned by the ReplicationDestination and will
+	// be garbage collected with it
+	return client.IgnoreNotFound(r.Client.Delete(ctx, rd))
+}
+
+// Ensures the target PVC exists and creates the ReplicationDestination that
+// restores into it
+func (r *RestoreFanoutReconciler) startRestore(ctx context.Context, logger logr.Logger,
+	fanout *volsyncv1alpha1.RestoreFanout, ts *volsyncv1alpha1.RestoreFanoutTargetStatus) error {
+	logger = logger.WithValues("namespace", ts.Namespace, "pvc", ts.PVCName)
+
+	if err := r.ensureTargetPVC(ctx, fanout, ts); err != nil {
+		logger.Error(err, "unable to ensure target PVC")
+		ts.Message = err.Error()
+		return err
+	}
+
+	repoName := fanoutDestinationName(fanout, ts.PVCName) + "-repo"
+	rd := &volsyncv1alpha1.ReplicationDestination{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fanoutDestinationName(fanout, ts.PVCName),
+			Namespace: ts.Namespace,
+		},
+	}
+	_, err := ctrlutil.CreateOrUpdate(ctx, r.Client, rd, func() error {
+		if !rd.CreationTimestamp.IsZero() && !isOwnedByFanout(rd, fanout) {
+			return fmt.Errorf("ReplicationDestination %s/%s already exists and is not managed by RestoreFanout %s",
+				rd.GetNamespace(), rd.GetName(), fanout.GetName())
+		}
+		if err := ctrl.SetControllerReference(fanout, rd, r.Client.Scheme()); err != nil {
+			return err
+		}
+		utils.SetOwnedByVolSync(rd)
+		utils.AddLabel(rd, volsyncv1alpha1.RestoreFanoutLabel, fanout.GetName())
+		restic := fanout.Spec.Restic.DeepCopy()
+		restic.Repository = repoName
+		restic.RepositorySecretRef = nil
+		restic.CopyMethod = volsyncv1alpha1.CopyMethodDirect
+		restic.DestinationPVC = ptr.To(ts.PVCName)
+		rd.Spec = volsyncv1alpha1.ReplicationDestinationSpec{
+			Trigger: &volsyncv1alpha1.ReplicationDestinationTriggerSpec{
+				Manual: restoreFanoutTrigger,
+			},
+			Restic: restic,
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Error(err, "unable to ensure ReplicationDestination")
+		ts.Message = err.Error()
+		return err
+	}
+
+	if err := r.ensureFanoutRepositorySecret(ctx, fanout, rd, repoName); err != nil {
+		logger.Error(err, "unable to ensure repository secret")
+		ts.Message = err.Error()
+		return err
+	}
+
+	logger.Info("restore started")
+	r.EventRecorder.Eventf(fanout, corev1.EventTypeNormal, volsyncv1alpha1.EvRFanoutRestoreStarted,
+		"restoring into PersistentVolumeClaim %s/%s", ts.Namespace, ts.PVCName)
+	ts.Phase = volsyncv1alpha1.RestoreFanoutTargetRestoring
+	ts.Message = ""
+	return nil
+}
+
+// Creates the target PVC if it doesn't already exist. The PVC is not owned by
+// the RestoreFanout so that the restored data is kept when it is deleted.
+func (r *RestoreFanoutReconciler) ensureTargetPVC(ctx context.Context,
+	fanout *volsyncv1alpha1.RestoreFanout, ts *volsyncv1alpha1.RestoreFanoutTargetStatus) error {
+	pvc := &corev1.PersistentVolumeClaim{}
+	err := r.Client.Get(ctx, types.NamespacedName{Name: ts.PVCName, Namespace: ts.Namespace}, pvc)
+	if err == nil || !kerrors.IsNotFound(err) {
+		return err
+	}
+
+	restic := fanout.Spec.Restic
+	if restic.Capacity == nil || len(restic.AccessModes) == 0 {
+		return fmt.Errorf("PersistentVolumeClaim %s/%s does not exist and restic.capacity and "+
+			"restic.accessModes are needed to create it", ts.Namespace, ts.PVCName)
+	}
+	pvc = &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ts.PVCName,
+			Namespace: ts.Namespace,
+			Labels: map[string]string{
+				volsyncv1alpha1.RestoreFanoutLabel: fanout.GetName(),
+			},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes:      restic.AccessModes,
+			StorageClassName: restic.StorageClassName,
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: *restic.Capacity,
+				},
+			},
+		},
+	}
+	return client.IgnoreAlreadyExists(r.Client.Create(ctx, pvc))
+}
+
+// Copies the repository Secret into the namespace of the target
+func (r *RestoreFanoutReconciler) ensureFanoutRepositorySecret(ctx context.Context,
+	fanout *volsyncv1alpha1.RestoreFanout, rd *volsyncv1alpha1.ReplicationDestination, name string) error {
+	source := &corev1.Secret{}
+	if err := r.Client.Get(ctx, types.NamespacedName{
+		Name:      fanout.Spec.Repository.Name,
+		Namespace: fanout.Spec.Repository.Namespace,
+	}, source); err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: rd.GetNamespace(),
+		},
+	}
+	_, err := ctrlutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		if err := ctrl.SetControllerReference(rd, secret, r.Client.Scheme()); err != nil {
+			return err
+		}
+		utils.SetOwnedByVolSync(secret)
+		utils.AddLabel(secret, volsyncv1alpha1.RestoreFanoutLabel, fanout.GetName())
+		secret.Data = source.Data
+		return nil
+	})
+	return err
+}
+
+// Aggregates the progress of the targets into the fanout's status
+func updateFanoutCompletion(fanout *volsyncv1alpha1.RestoreFanout, reconcileErr error) {
+	status := fanout.Status
+	status.Total = int32(len(status.Targets)) //nolint:gosec
+	status.Restoring, status.Completed, status.Failed = 0, 0, 0
+	for _, ts := range status.Targets {
+		switch ts.Phase {
+		case volsyncv1alpha1.RestoreFanoutTargetRestoring:
+			status.Restoring++
+		case volsyncv1alpha1.RestoreFanoutTargetCompleted:
+			status.Completed++
+		case volsyncv1alpha1.RestoreFanoutTargetFailed:
+			status.Failed++
+		}
+	}
+
+	finished := status.Completed + status.Failed
+	switch {
+	case reconcileErr != nil:
+		apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionFanoutCompleted,
+			Status:  metav1.ConditionFalse,
+			Reason:  volsyncv1alpha1.FanoutCompletedReasonError,
+			Message: reconcileErr.Error(),
+		})
+	case finished < status.Total:
+		status.CompletionTime = nil
+		apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionFanoutCompleted,
+			Status:  metav1.ConditionFalse,
+			Reason:  volsyncv1alpha1.FanoutCompletedReasonRestoring,
+			Message: fmt.Sprintf("%d of %d targets have finished", finished, status.Total),
+		})
+	case status.Failed > 0:
+		if status.CompletionTime == nil {
+			status.CompletionTime = ptr.To(metav1.Now())
+		}
+		apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionFanoutCompleted,
+			Status:  metav1.ConditionTrue,
+			Reason:  volsyncv1alpha1.FanoutCompletedReasonFailed,
+			Message: fmt.Sprintf("%d of %d targets failed to restore", status.Failed, status.Total),
+		})
+	default:
+		if status.CompletionTime == nil {
+			status.CompletionTime = ptr.To(metav1.Now())
+		}
+		apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionFanoutCompleted,
+			Status:  metav1.ConditionTrue,
+			Reason:  volsyncv1alpha1.FanoutCompletedReasonSucceeded,
+			Message: "All targets have been restored",
+		})
+	}
+}
+
+func isOwnedByFanout(rd *volsyncv1alpha1.ReplicationDestination, fanout *volsyncv1alpha1.RestoreFanout) bool {
+	for _, ref := range rd.GetOwnerReferences() {
+		if ref.UID == fanout.GetUID() {
+			return true
+		}
+	}
+	return false
+}
+
+// Any change to namespaces could change the targets of any fanout
+func mapFuncToAllRestoreFanouts(ctx context.Context, k8sClient client.Client) []reconcile.Request {
+	logger := ctrl.Log.WithName("mapFuncToAllRestoreFanouts")
+
+	fanoutList := &volsyncv1alpha1.RestoreFanoutList{}
+	if err := k8sClient.List(ctx, fanoutList); err != nil {
+		logger.Error(err, "Error looking up restorefanouts")
+		return []reconcile.Request{}
+	}
+
+	requests := []reconcile.Request{}
+	for _, fanout := range fanoutList.Items {
+		if fanout.Spec.NamespaceSelector == nil {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: fanout.GetName()},
+		})
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *RestoreFanoutReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	mapToFanouts := handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, _ client.Object) []reconcile.Request {
+		return mapFuncToAllRestoreFanouts(ctx, mgr.GetClient())
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&volsyncv1alpha1.RestoreFanout{}).
+		Owns(&volsyncv1alpha1.ReplicationDestination{}).
+		Watches(&corev1.Namespace{}, mapToFanouts,
+			builder.WithPredicates(predicate.LabelChangedPredicate{})).
+		Complete(r)
+}
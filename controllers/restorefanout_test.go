@@ -0,0 +1,174 @@
+package controllers
+
+import (
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("RestoreFanout", func() {
+	var namespace *corev1.Namespace
+	var fanout *volsyncv1alpha1.RestoreFanout
+	var repo *corev1.Secret
+	targetPVCs := []string{"target-a", "target-b", "target-c"}
+
+	rdKey := func(pvcName string) client.ObjectKey {
+		return client.ObjectKey{Name: fanout.Name + "-" + pvcName, Namespace: namespace.Name}
+	}
+	countRDs := func() int {
+		rdList := &volsyncv1alpha1.ReplicationDestinationList{}
+		Expect(k8sClient.List(ctx, rdList, client.InNamespace(namespace.Name),
+			client.MatchingLabels{volsyncv1alpha1.RestoreFanoutLabel: fanout.Name})).To(Succeed())
+		return len(rdList.Items)
+	}
+
+	BeforeEach(func() {
+		namespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "volsync-test-",
+			},
+		}
+		createWithCacheReload(ctx, k8sClient, namespace)
+		Expect(namespace.Name).NotTo(BeEmpty())
+
+		repo = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "repo",
+				Namespace: namespace.Name,
+			},
+			StringData: map[string]string{
+				"RESTIC_REPOSITORY": "s3:http://minio/bucket/dev",
+				"RESTIC_PASSWORD":   "secret",
+			},
+		}
+		createWithCacheReload(ctx, k8sClient, repo)
+
+		targets := []volsyncv1alpha1.RestoreFanoutTarget{}
+		for _, pvcName := range targetPVCs {
+			targets = append(targets, volsyncv1alpha1.RestoreFanoutTarget{
+				Namespace: namespace.Name,
+				PVCName:   pvcName,
+			})
+		}
+		capacity := resource.MustParse("1Gi")
+		fanout = &volsyncv1alpha1.RestoreFanout{
+			ObjectMeta: metav1.ObjectMeta{
+				// Cluster scoped, so use the namespace name to keep it unique
+				Name: "fanout-" + namespace.Name,
+			},
+			Spec: volsyncv1alpha1.RestoreFanoutSpec{
+				Repository: volsyncv1alpha1.RestoreFanoutRepository{
+					Name:      repo.Name,
+					Namespace: repo.Namespace,
+				},
+				Restic: volsyncv1alpha1.ReplicationDestinationResticSpec{
+					ReplicationDestinationVolumeOptions: volsyncv1alpha1.ReplicationDestinationVolumeOptions{
+						Capacity:    &capacity,
+						AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+					},
+					SnapshotID: ptr.To("0123abcd"),
+				},
+				Targets:     targets,
+				Parallelism: ptr.To[int32](2),
+			},
+		}
+	})
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, fanout)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, namespace)).To(Succeed())
+	})
+	JustBeforeEach(func() {
+		createWithCacheReload(ctx, k8sClient, fanout)
+	})
+
+	It("restores into the targets with bounded parallelism", func() {
+		rd := &volsyncv1alpha1.ReplicationDestination{}
+		Eventually(func() error {
+			return k8sClient.Get(ctx, rdKey(targetPVCs[0]), rd)
+		}, maxWait, interval).Should(Succeed())
+		Expect(rd.Spec.Trigger).NotTo(BeNil())
+		Expect(rd.Spec.Trigger.Manual).NotTo(BeEmpty())
+		Expect(rd.Spec.Restic).NotTo(BeNil())
+		Expect(rd.Spec.Restic.CopyMethod).To(Equal(volsyncv1alpha1.CopyMethodDirect))
+		Expect(*rd.Spec.Restic.DestinationPVC).To(Equal(targetPVCs[0]))
+		Expect(*rd.Spec.Restic.SnapshotID).To(Equal("0123abcd"))
+
+		// The target PVC and a copy of the repository are created
+		pvc := &corev1.PersistentVolumeClaim{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: targetPVCs[0], Namespace: namespace.Name},
+			pvc)).To(Succeed())
+		repoCopy := &corev1.Secret{}
+		Eventually(func() error {
+			return k8sClient.Get(ctx, client.ObjectKey{Name: rd.Spec.Restic.Repository,
+				Namespace: namespace.Name}, repoCopy)
+		}, maxWait, interval).Should(Succeed())
+		Expect(string(repoCopy.Data["RESTIC_PASSWORD"])).To(Equal("secret"))
+
+		Eventually(func() int32 {
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(fanout), fanout)).To(Succeed())
+			if fanout.Status == nil {
+				return 0
+			}
+			return fanout.Status.Restoring
+		}, maxWait, interval).Should(Equal(int32(2)))
+		Expect(fanout.Status.Total).To(Equal(int32(3)))
+		Consistently(countRDs, duration, interval).Should(Equal(2))
+
+		By("completing the first restore, the third is started")
+		Eventually(func() error {
+			if err := k8sClient.Get(ctx, rdKey(targetPVCs[0]), rd); err != nil {
+				return err
+			}
+			if rd.Status == nil {
+				rd.Status = &volsyncv1alpha1.ReplicationDestinationStatus{}
+			}
+			rd.Status.LastManualSync = rd.Spec.Trigger.Manual
+			return k8sClient.Status().Update(ctx, rd)
+		}, maxWait, interval).Should(Succeed())
+		Eventually(func() error {
+			return k8sClient.Get(ctx, rdKey(targetPVCs[2]), &volsyncv1alpha1.ReplicationDestination{})
+		}, maxWait, interval).Should(Succeed())
+		Eventually(func() int32 {
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(fanout), fanout)).To(Succeed())
+			return fanout.Status.Completed
+		}, maxWait, interval).Should(Equal(int32(1)))
+		Expect(fanout.Status.Targets[0].Phase).To(Equal(volsyncv1alpha1.RestoreFanoutTargetCompleted))
+
+		By("failing the remaining restores, the fanout is finished")
+		for _, pvcName := range targetPVCs[1:] {
+			Eventually(func() error {
+				if err := k8sClient.Get(ctx, rdKey(pvcName), rd); err != nil {
+					return err
+				}
+				if rd.Status == nil {
+					rd.Status = &volsyncv1alpha1.ReplicationDestinationStatus{}
+				}
+				rd.Status.LatestMoverStatus = &volsyncv1alpha1.MoverStatus{
+					Result: volsyncv1alpha1.MoverResultFailed,
+				}
+				return k8sClient.Status().Update(ctx, rd)
+			}, maxWait, interval).Should(Succeed())
+		}
+		Eventually(func() int32 {
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(fanout), fanout)).To(Succeed())
+			return fanout.Status.Failed
+		}, maxWait, interval).Should(Equal(int32(2)))
+		cond := apimeta.FindStatusCondition(fanout.Status.Conditions, volsyncv1alpha1.ConditionFanoutCompleted)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(cond.Reason).To(Equal(volsyncv1alpha1.FanoutCompletedReasonFailed))
+		Expect(fanout.Status.CompletionTime).NotTo(BeNil())
+
+		// Finished restores don't leave a ReplicationDestination behind
+		Eventually(countRDs, maxWait, interval).Should(Equal(0))
+		err := k8sClient.Get(ctx, client.ObjectKey{Name: targetPVCs[0], Namespace: namespace.Name}, pvc)
+		Expect(kerrors.IsNotFound(err)).To(BeFalse())
+	})
+})
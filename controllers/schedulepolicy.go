@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	cron "github.com/robfig/cron/v3"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	sm "github.com/backube/volsync/controllers/statemachine"
+)
+
+// The number of windows that are stepped over when searching for the next
+// time at which a synchronization may start
+const syncWindowSearchLimit = 1000
+
+var errNoSyncWindow = errors.New("the schedule policy never permits synchronization")
+
+// syncWindow is a parsed volsyncv1alpha1.ScheduleWindow
+type syncWindow struct {
+	schedule cron.Schedule
+	duration time.Duration
+}
+
+// closesAt returns the time at which the window that t is within closes, and
+// false if t is not within the window
+func (w syncWindow) closesAt(t time.Time) (time.Time, bool) {
+	if w.duration <= 0 {
+		return time.Time{}, false
+	}
+	var end time.Time
+	// Each start in (t - duration, t] opens a window that t is within
+	for s := w.schedule.Next(t.Add(-w.duration)); !s.IsZero() && !s.After(t); s = w.schedule.Next(s) {
+		if e := s.Add(w.duration); e.After(end) {
+			end = e
+		}
+	}
+	return end, !end.IsZero()
+}
+
+// syncWindows are the parsed windows of a ReplicationSchedulePolicy
+type syncWindows struct {
+	name      string
+	allowed   []syncWindow
+	blackouts []syncWindow
+	freezes   []volsyncv1alpha1.ScheduleFreeze
+}
+
+func parseSyncWindows(policy *volsyncv1alpha1.ReplicationSchedulePolicy) (*syncWindows, error) {
+	timeZone := ""
+	if policy.Spec.TimeZone != nil {
+		timeZone = *policy.Spec.TimeZone
+	}
+	parse := func(windows []volsyncv1alpha1.ScheduleWindow) ([]syncWindow, error) {
+		parsed := make([]syncWindow, 0, len(windows))
+		for _, w := range windows {
+			schedule, err := sm.ParseSchedule(w.Start, timeZone)
+			if err != nil {
+				return nil, fmt.Errorf("invalid window start %q: %w", w.Start, err)
+			}
+			parsed = append(parsed, syncWindow{schedule: schedule, duration: w.Duration.Duration})
+		}
+		return parsed, nil
+	}
+
+	sw := &syncWindows{name: policy.Name, freezes: policy.Spec.Freezes}
+	var err error
+	if sw.allowed, err = parse(policy.Spec.AllowedWindows); err != nil {
+		return nil, err
+	}
+	if sw.blackouts, err = parse(policy.Spec.Blackouts); err != nil {
+		return nil, err
+	}
+	return sw, nil
+}
+
+// nextAllowed returns the earliest time, not before t, at which a
+// synchronization may start
+func (sw *syncWindows) nextAllowed(t time.Time) (time.Time, error) {
+	for range syncWindowSearchLimit {
+		next := t
+		for _, f := range sw.freezes {
+			if !t.Before(f.Start.Time) && t.Before(f.End.Time) && f.End.After(next) {
+				next = f.End.Time
+			}
+		}
+		for _, b := range sw.blackouts {
+			if end, within := b.closesAt(t); within && end.After(next) {
+				next = end
+			}
+		}
+		if next.Equal(t) && len(sw.allowed) > 0 {
+			next = time.Time{}
+			for _, a := range sw.allowed {
+				if _, within := a.closesAt(t); within {
+					next = t
+					break
+				}
+				if s := a.schedule.Next(t); !s.IsZero() && (next.IsZero() || s.Before(next)) {
+					next = s
+				}
+			}
+			if next.IsZero() {
+				return time.Time{}, errNoSyncWindow
+			}
+		}
+		if next.Equal(t) {
+			return t, nil
+		}
+		t = next
+	}
+	return time.Time{}, errNoSyncWindow
+}
+
+// getSyncWindows returns the windows of the ReplicationSchedulePolicy
+// referenced by the ReplicationSource, or nil if there is none. If the policy
+// can not be used, the Synchronizing condition is set to an error.
+func getSyncWindows(ctx context.Context, c client.Client, logger logr.Logger,
+	rs *volsyncv1alpha1.ReplicationSource) (*syncWindows, error) {
+	if rs.Spec.SchedulePolicy == nil {
+		return nil, nil
+	}
+	policy := &volsyncv1alpha1.ReplicationSchedulePolicy{}
+	err := c.Get(ctx, client.ObjectKey{Name: *rs.Spec.SchedulePolicy, Namespace: rs.Namespace}, policy)
+	var windows *syncWindows
+	if err == nil {
+		windows, err = parseSyncWindows(policy)
+	}
+	if err != nil {
+		logger.Error(err, "unable to use schedule policy", "policy", *rs.Spec.SchedulePolicy)
+		apimeta.SetStatusCondition(&rs.Status.Conditions, metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionSynchronizing,
+			Status:  metav1.ConditionFalse,
+			Reason:  volsyncv1alpha1.SynchronizingReasonError,
+			Message: fmt.Sprintf("unable to use ReplicationSchedulePolicy %s: %s", *rs.Spec.SchedulePolicy, err),
+		})
+		return nil, err
+	}
+	return windows, nil
+}
+
+// mapFuncSchedulePolicyToReplicationSources returns the ReplicationSources
+// that reference a ReplicationSchedulePolicy
+func mapFuncSchedulePolicyToReplicationSources(c client.Client) func(context.Context,
+	client.Object) []reconcile.Request {
+	return func(ctx context.Context, o client.Object) []reconcile.Request {
+		rsList := &volsyncv1alpha1.ReplicationSourceList{}
+		if err := c.List(ctx, rsList, client.InNamespace(o.GetNamespace())); err != nil {
+			return nil
+		}
+		reqs := []reconcile.Request{}
+		for _, rs := range rsList.Items {
+			if rs.Spec.SchedulePolicy != nil && *rs.Spec.SchedulePolicy == o.GetName() {
+				reqs = append(reqs, reconcile.Request{
+					NamespacedName: types.NamespacedName{Name: rs.Name, Namespace: rs.Namespace},
+				})
+			}
+		}
+		return reqs
+	}
+}
@@ -0,0 +1,80 @@
+package controllers
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+var _ = Describe("Schedule policy windows", func() {
+	var policy *volsyncv1alpha1.ReplicationSchedulePolicy
+
+	at := func(s string) time.Time {
+		t, err := time.Parse(time.RFC3339, s)
+		Expect(err).NotTo(HaveOccurred())
+		return t
+	}
+	nextAllowed := func(s string) time.Time {
+		sw, err := parseSyncWindows(policy)
+		Expect(err).NotTo(HaveOccurred())
+		next, err := sw.nextAllowed(at(s))
+		Expect(err).NotTo(HaveOccurred())
+		return next
+	}
+
+	BeforeEach(func() {
+		policy = &volsyncv1alpha1.ReplicationSchedulePolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "policy"},
+			Spec: volsyncv1alpha1.ReplicationSchedulePolicySpec{
+				TimeZone: ptr.To("UTC"),
+			},
+		}
+	})
+
+	It("permits any time without windows", func() {
+		Expect(nextAllowed("2026-01-10T12:00:00Z")).To(Equal(at("2026-01-10T12:00:00Z")))
+	})
+
+	It("waits for an allowed window to open", func() {
+		policy.Spec.AllowedWindows = []volsyncv1alpha1.ScheduleWindow{
+			{Start: "0 22 * * *", Duration: metav1.Duration{Duration: 4 * time.Hour}},
+		}
+		Expect(nextAllowed("2026-01-10T12:00:00Z")).To(Equal(at("2026-01-10T22:00:00Z")))
+		Expect(nextAllowed("2026-01-11T01:59:00Z")).To(Equal(at("2026-01-11T01:59:00Z")))
+		Expect(nextAllowed("2026-01-11T02:00:00Z")).To(Equal(at("2026-01-11T22:00:00Z")))
+	})
+
+	It("skips blackouts and freezes", func() {
+		policy.Spec.Blackouts = []volsyncv1alpha1.ScheduleWindow{
+			{Start: "0 0 28 * *", Duration: metav1.Duration{Duration: 120 * time.Hour}},
+		}
+		policy.Spec.Freezes = []volsyncv1alpha1.ScheduleFreeze{{
+			Start: metav1.NewTime(at("2026-03-10T00:00:00Z")),
+			End:   metav1.NewTime(at("2026-03-11T00:00:00Z")),
+		}}
+		Expect(nextAllowed("2026-01-27T23:00:00Z")).To(Equal(at("2026-01-27T23:00:00Z")))
+		Expect(nextAllowed("2026-01-30T12:00:00Z")).To(Equal(at("2026-02-02T00:00:00Z")))
+		Expect(nextAllowed("2026-03-10T12:00:00Z")).To(Equal(at("2026-03-11T00:00:00Z")))
+	})
+
+	It("finds an allowed window after a blackout", func() {
+		policy.Spec.AllowedWindows = []volsyncv1alpha1.ScheduleWindow{
+			{Start: "0 22 * * *", Duration: metav1.Duration{Duration: time.Hour}},
+		}
+		policy.Spec.Blackouts = []volsyncv1alpha1.ScheduleWindow{
+			{Start: "0 0 28 * *", Duration: metav1.Duration{Duration: 120 * time.Hour}},
+		}
+		Expect(nextAllowed("2026-01-27T23:30:00Z")).To(Equal(at("2026-02-02T22:00:00Z")))
+	})
+
+	It("rejects invalid windows", func() {
+		policy.Spec.Blackouts = []volsyncv1alpha1.ScheduleWindow{{Start: "not a cronspec"}}
+		_, err := parseSyncWindows(policy)
+		Expect(err).To(HaveOccurred())
+	})
+})
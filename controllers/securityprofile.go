@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package controllers
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+// The rsync (ssh) mover runs sshd as root, so it cannot be hardened
+const rsyncSSHMoverName = "rsync"
+
+// ensureSecurityProfile reports the hardening measures applied to the mover in
+// the status. If the owner uses the Hardened profile and the mover cannot
+// satisfy it, an error is returned and the Synchronizing condition explains
+// how to fix it.
+func ensureSecurityProfile(logger logr.Logger, owner metav1.Object, conditions *[]metav1.Condition,
+	status **volsyncv1alpha1.SecurityStatus, moverName string, privileged bool) error {
+	profile := utils.SecurityProfileFor(owner)
+	podSC := utils.MoverSecurityContextFor(owner)
+	runsAsRoot := privileged || moverName == rsyncSSHMoverName
+	if podSC == nil {
+		podSC = utils.DefaultMoverSecurityContextFor(runsAsRoot)
+	}
+	*status = utils.SecurityReport(profile, podSC, runsAsRoot)
+
+	if profile != volsyncv1alpha1.SecurityProfileHardened {
+		return nil
+	}
+
+	var err error
+	if moverName == rsyncSSHMoverName {
+		err = fmt.Errorf("the Hardened security profile cannot be used with the rsync mover since it runs as " +
+			"root, use rsyncTLS instead")
+	} else {
+		err = utils.ValidateSecurityProfile(podSC)
+	}
+	if err != nil {
+		logger.Info("security profile cannot be applied", "reason", err.Error())
+		apimeta.SetStatusCondition(conditions, metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionSynchronizing,
+			Status:  metav1.ConditionFalse,
+			Reason:  volsyncv1alpha1.SynchronizingReasonSecurityProfile,
+			Message: err.Error(),
+		})
+	}
+	return err
+}
@@ -0,0 +1,238 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+const (
+	// Prefix of the VolumeSnapshots created to rebind retained contents
+	reboundSnapshotPrefix = "volsync-retained-"
+	// Annotation on a rebound VolumeSnapshot recording the name of the
+	// VolumeSnapshot the content was originally created for
+	reboundFromAnnotation = "volsync.backube/rebound-from"
+)
+
+//nolint:lll
+//+kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshotcontents,verbs=get;list;watch;update;patch;delete
+//+kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshots,verbs=get;list;watch;create
+
+// SnapshotContentReconciler handles the VolumeSnapshotContents with a Retain
+// deletion policy that were created from VolSync VolumeSnapshots, applying
+// the snapshotContentPolicy once the VolumeSnapshot has been deleted.
+type SnapshotContentReconciler struct {
+	client.Client
+	Log           logr.Logger
+	EventRecorder record.EventRecorder
+}
+
+func (r *SnapshotContentReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := r.Log.WithValues("volumesnapshotcontent", req.Name)
+	defer r.updateMetrics(ctx, logger)
+
+	content := &snapv1.VolumeSnapshotContent{}
+	if err := r.Client.Get(ctx, req.NamespacedName, content); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !content.GetDeletionTimestamp().IsZero() ||
+		content.Spec.DeletionPolicy != snapv1.VolumeSnapshotContentRetain {
+		return ctrl.Result{}, nil
+	}
+
+	orphaned, err := r.isOrphaned(ctx, content)
+	if err != nil || !orphaned {
+		return ctrl.Result{}, err
+	}
+
+	policy := volsyncv1alpha1.SnapshotContentPolicyType(content.GetLabels()[volsyncv1alpha1.SnapshotContentPolicyLabel])
+	switch policy {
+	case volsyncv1alpha1.SnapshotContentPolicyDelete:
+		return ctrl.Result{}, r.deleteContent(ctx, logger, content)
+	case volsyncv1alpha1.SnapshotContentPolicyRebind:
+		return ctrl.Result{}, r.rebindContent(ctx, logger, content)
+	}
+	return ctrl.Result{}, nil
+}
+
+// A content is orphaned if the VolumeSnapshot it was created for no longer
+// exists
+func (r *SnapshotContentReconciler) isOrphaned(ctx context.Context,
+	content *snapv1.VolumeSnapshotContent) (bool, error) {
+	ref := content.Spec.VolumeSnapshotRef
+	snap := &snapv1.VolumeSnapshot{}
+	err := r.Client.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, snap)
+	if kerrors.IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return ref.UID != "" && ref.UID != snap.GetUID(), nil
+}
+
+// Switches the content to the Delete policy so that the storage snapshot is
+// removed along with it
+func (r *SnapshotContentReconciler) deleteContent(ctx context.Context, logger logr.Logger,
+	content *snapv1.VolumeSnapshotContent) error {
+	logger.Info("deleting retained VolumeSnapshotContent")
+	content.Spec.DeletionPolicy = snapv1.VolumeSnapshotContentDelete
+	if err := r.Client.Update(ctx, content); err != nil {
+		return err
+	}
+	if err := r.Client.Delete(ctx, content); client.IgnoreNotFound(err) != nil {
+		return err
+	}
+	r.EventRecorder.Eventf(content, corev1.EventTypeNormal, volsyncv1alpha1.EvRSnapContentDeleted,
+		"deleted retained content of VolumeSnapshot %s/%s",
+		content.Spec.VolumeSnapshotRef.Namespace, content.Spec.VolumeSnapshotRef.Name)
+	return nil
+}
+
+// Binds the content to a new VolumeSnapshot in the namespace of the original
+// one. The new VolumeSnapshot is marked do-not-delete so that VolSync leaves
+// it alone.
+func (r *SnapshotContentReconciler) rebindContent(ctx context.Context, logger logr.Logger,
+	content *snapv1.VolumeSnapshotContent) error {
+	origRef := content.Spec.VolumeSnapshotRef
+	snap := &snapv1.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      reboundSnapshotPrefix + content.GetName(),
+			Namespace: origRef.Namespace,
+			Labels: map[string]string{
+				utils.DoNotDeleteLabelKey: "true",
+			},
+			Annotations: map[string]string{
+				reboundFromAnnotation: origRef.Name,
+			},
+		},
+		Spec: snapv1.VolumeSnapshotSpec{
+			Source: snapv1.VolumeSnapshotSource{
+				VolumeSnapshotContentName: ptr.To(content.GetName()),
+			},
+			VolumeSnapshotClassName: content.Spec.VolumeSnapshotClassName,
+		},
+	}
+	logger = logger.WithValues("snapshot", client.ObjectKeyFromObject(snap))
+
+	// Point the content at the new snapshot and stop managing it so that it
+	// isn't processed again if the new snapshot is later deleted
+	content.Spec.VolumeSnapshotRef = corev1.ObjectReference{
+		Name:      snap.GetName(),
+		Namespace: snap.GetNamespace(),
+	}
+	utils.AddLabel(content, volsyncv1alpha1.SnapshotContentPolicyLabel,
+		string(volsyncv1alpha1.SnapshotContentPolicyIgnore))
+	if err := r.Client.Update(ctx, content); err != nil {
+		return err
+	}
+	if err := r.Client.Create(ctx, snap); client.IgnoreAlreadyExists(err) != nil {
+		logger.Error(err, "unable to create VolumeSnapshot for retained content")
+		return err
+	}
+	logger.Info("rebound retained VolumeSnapshotContent")
+	r.EventRecorder.Eventf(content, corev1.EventTypeNormal, volsyncv1alpha1.EvRSnapContentRebound,
+		"bound retained content of VolumeSnapshot %s/%s to VolumeSnapshot %s",
+		origRef.Namespace, origRef.Name, snap.GetName())
+	return nil
+}
+
+// Recomputes the number of retained contents in each namespace
+func (r *SnapshotContentReconciler) updateMetrics(ctx context.Context, logger logr.Logger) {
+	contentList := &snapv1.VolumeSnapshotContentList{}
+	if err := r.Client.List(ctx, contentList, client.HasLabels{volsyncv1alpha1.SnapshotContentPolicyLabel}); err != nil {
+		logger.Error(err, "unable to list VolumeSnapshotContents")
+		return
+	}
+	retainedSnapshotContents.Reset()
+	for i := range contentList.Items {
+		content := &contentList.Items[i]
+		if content.Spec.DeletionPolicy != snapv1.VolumeSnapshotContentRetain {
+			continue
+		}
+		state := "bound"
+		if orphaned, err := r.isOrphaned(ctx, content); err != nil {
+			continue
+		} else if orphaned {
+			state = "orphaned"
+		}
+		retainedSnapshotContents.WithLabelValues(content.Spec.VolumeSnapshotRef.Namespace, state).Inc()
+	}
+}
+
+// Deleting a VolumeSnapshot may leave its content orphaned
+func mapSnapshotToContent(_ context.Context, o client.Object) []reconcile.Request {
+	snap, ok := o.(*snapv1.VolumeSnapshot)
+	if !ok || snap.Status == nil || snap.Status.BoundVolumeSnapshotContentName == nil {
+		return []reconcile.Request{}
+	}
+	return []reconcile.Request{{
+		NamespacedName: types.NamespacedName{Name: *snap.Status.BoundVolumeSnapshotContentName},
+	}}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *SnapshotContentReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	isMarked := predicate.NewPredicateFuncs(func(o client.Object) bool {
+		return utils.HasLabel(o, volsyncv1alpha1.SnapshotContentPolicyLabel)
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("volumesnapshotcontent").
+		For(&snapv1.VolumeSnapshotContent{}, builder.WithPredicates(isMarked)).
+		Watches(&snapv1.VolumeSnapshot{}, handler.EnqueueRequestsFromMapFunc(mapSnapshotToContent),
+			builder.WithPredicates(snapshotDeletedPredicate())).
+		Complete(r)
+}
+
+// Only VolumeSnapshot deletions can orphan a content
+func snapshotDeletedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(_ event.DeleteEvent) bool {
+			return true
+		},
+		UpdateFunc: func(_ event.UpdateEvent) bool {
+			return false
+		},
+		GenericFunc: func(_ event.GenericEvent) bool {
+			return false
+		},
+	}
+}
@@ -0,0 +1,101 @@
+package controllers
+
+import (
+	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("Retained VolumeSnapshotContents", func() {
+	var namespace *corev1.Namespace
+	var content *snapv1.VolumeSnapshotContent
+	var policy volsyncv1alpha1.SnapshotContentPolicyType
+
+	BeforeEach(func() {
+		namespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "volsync-test-",
+			},
+		}
+		createWithCacheReload(ctx, k8sClient, namespace)
+		Expect(namespace.Name).NotTo(BeEmpty())
+		policy = volsyncv1alpha1.SnapshotContentPolicyIgnore
+	})
+	AfterEach(func() {
+		Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, content))).To(Succeed())
+		Expect(k8sClient.Delete(ctx, namespace)).To(Succeed())
+	})
+	JustBeforeEach(func() {
+		// The content of a VolSync snapshot that has since been deleted
+		content = &snapv1.VolumeSnapshotContent{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "snapcontent-" + namespace.Name,
+				Labels: map[string]string{
+					volsyncv1alpha1.SnapshotContentPolicyLabel: string(policy),
+				},
+			},
+			Spec: snapv1.VolumeSnapshotContentSpec{
+				DeletionPolicy: snapv1.VolumeSnapshotContentRetain,
+				Driver:         "test.csi.driver",
+				Source: snapv1.VolumeSnapshotContentSource{
+					SnapshotHandle: ptr.To("handle-1"),
+				},
+				VolumeSnapshotRef: corev1.ObjectReference{
+					Name:      "volsync-rs-src",
+					Namespace: namespace.Name,
+					UID:       "0000-1111",
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, content)).To(Succeed())
+	})
+
+	When("the policy is Ignore", func() {
+		It("leaves the content in place", func() {
+			Consistently(func() error {
+				return k8sClient.Get(ctx, client.ObjectKeyFromObject(content), content)
+			}, "2s", interval).Should(Succeed())
+			Expect(content.Spec.DeletionPolicy).To(Equal(snapv1.VolumeSnapshotContentRetain))
+		})
+	})
+
+	When("the policy is Delete", func() {
+		BeforeEach(func() {
+			policy = volsyncv1alpha1.SnapshotContentPolicyDelete
+		})
+		It("deletes the orphaned content", func() {
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, client.ObjectKeyFromObject(content), content)
+				return kerrors.IsNotFound(err)
+			}, maxWait, interval).Should(BeTrue())
+		})
+	})
+
+	When("the policy is Rebind", func() {
+		BeforeEach(func() {
+			policy = volsyncv1alpha1.SnapshotContentPolicyRebind
+		})
+		It("binds the orphaned content to a new VolumeSnapshot", func() {
+			snap := &snapv1.VolumeSnapshot{}
+			Eventually(func() error {
+				return k8sClient.Get(ctx, client.ObjectKey{Name: "volsync-retained-" + content.Name,
+					Namespace: namespace.Name}, snap)
+			}, maxWait, interval).Should(Succeed())
+			Expect(*snap.Spec.Source.VolumeSnapshotContentName).To(Equal(content.Name))
+			Expect(utils.IsMarkedDoNotDelete(snap)).To(BeTrue())
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(content), content)).To(Succeed())
+			Expect(content.Spec.VolumeSnapshotRef.Name).To(Equal(snap.Name))
+			Expect(content.Labels).To(HaveKeyWithValue(volsyncv1alpha1.SnapshotContentPolicyLabel,
+				string(volsyncv1alpha1.SnapshotContentPolicyIgnore)))
+		})
+	})
+})
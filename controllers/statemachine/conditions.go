@@ -18,6 +18,8 @@ along with this program.  If not, see <https://www.gnu.org/licenses/>.
 package statemachine
 
 import (
+	"fmt"
+
 	"github.com/go-logr/logr"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -55,6 +57,26 @@ func setConditionScheduled(r ReplicationMachine, _ logr.Logger) {
 		})
 }
 
+func setConditionQueued(r ReplicationMachine, _ logr.Logger) {
+	apimeta.SetStatusCondition(r.Conditions(),
+		metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionSynchronizing,
+			Status:  metav1.ConditionFalse,
+			Reason:  volsyncv1alpha1.SynchronizingReasonQueued,
+			Message: "Waiting for other synchronizations to complete",
+		})
+}
+
+func setConditionDeferred(r ReplicationMachine, _ logr.Logger, message string) {
+	apimeta.SetStatusCondition(r.Conditions(),
+		metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionSynchronizing,
+			Status:  metav1.ConditionFalse,
+			Reason:  volsyncv1alpha1.SynchronizingReasonDeferred,
+			Message: message,
+		})
+}
+
 func setConditionCleanup(r ReplicationMachine, _ logr.Logger) {
 	apimeta.SetStatusCondition(r.Conditions(),
 		metav1.Condition{
@@ -74,3 +96,20 @@ func setConditionError(r ReplicationMachine, _ logr.Logger, err error) {
 			Message: err.Error(),
 		})
 }
+
+// setConditionTimedOut reports whether the mover Jobs are being stopped for
+// exceeding moverTimeout
+func setConditionTimedOut(r ReplicationMachine, _ logr.Logger) {
+	ms := r.LatestMoverStatus()
+	if ms == nil || ms.Timeouts == 0 {
+		apimeta.RemoveStatusCondition(r.Conditions(), volsyncv1alpha1.ConditionSyncTimedOut)
+		return
+	}
+	apimeta.SetStatusCondition(r.Conditions(),
+		metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionSyncTimedOut,
+			Status:  metav1.ConditionTrue,
+			Reason:  volsyncv1alpha1.SyncTimedOutReasonMoverTimeout,
+			Message: fmt.Sprintf("%d consecutive mover Job(s) exceeded moverTimeout", ms.Timeouts),
+		})
+}
@@ -21,14 +21,18 @@ import (
 	"context"
 	"time"
 
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
 	"github.com/backube/volsync/controllers/mover"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // fakeMachine is a mock ReplicationMachine used for testing
 type fakeMachine struct {
+	NS                  string
+	Key                 string
 	TT                  triggerType
 	CS                  string
+	TZ                  string
 	MT                  string
 	LMT                 string
 	NST                 *metav1.Time
@@ -43,6 +47,16 @@ type fakeMachine struct {
 	SyncErr             error
 	CleanupResult       mover.Result
 	CleanupError        error
+	MoverStatus         *volsyncv1alpha1.MoverStatus
+	SyncMoverStatus     *volsyncv1alpha1.MoverStatus
+	Notifications       []volsyncv1alpha1.NotificationEventType
+	History             []volsyncv1alpha1.SyncHistoryEntry
+	Bytes               *int64
+	Retry               *volsyncv1alpha1.RetryPolicy
+	Retries             *volsyncv1alpha1.RetryStatus
+	VerifyCleanupCalls  int
+	CleanupRecheck      time.Duration
+	DeferFor            time.Duration
 }
 
 var _ ReplicationMachine = &fakeMachine{}
@@ -55,7 +69,10 @@ func newFakeMachine() *fakeMachine {
 	}
 }
 
+func (f *fakeMachine) Namespace() string                      { return f.NS }
+func (f *fakeMachine) LaunchKey() string                      { return f.Key }
 func (f *fakeMachine) Cronspec() string                       { return f.CS }
+func (f *fakeMachine) TimeZone() string                       { return f.TZ }
 func (f *fakeMachine) ManualTag() string                      { return f.MT }
 func (f *fakeMachine) LastManualTag() string                  { return f.LMT }
 func (f *fakeMachine) SetLastManualTag(t string)              { f.LMT = t }
@@ -71,9 +88,32 @@ func (f *fakeMachine) Conditions() *[]metav1.Condition        { return &f.Cond }
 func (f *fakeMachine) SetOutOfSync(oos bool)                  { f.OOSync = oos }
 func (f *fakeMachine) IncMissedIntervals()                    { f.MissedIntervals++ }
 func (f *fakeMachine) ObserveSyncDuration(t time.Duration)    { f.DurationObservation = t }
+func (f *fakeMachine) LatestMoverStatus() *volsyncv1alpha1.MoverStatus {
+	return f.MoverStatus
+}
+func (f *fakeMachine) SyncHistory() *[]volsyncv1alpha1.SyncHistoryEntry {
+	return &f.History
+}
+func (f *fakeMachine) RetryPolicy() *volsyncv1alpha1.RetryPolicy      { return f.Retry }
+func (f *fakeMachine) RetryStatus() *volsyncv1alpha1.RetryStatus      { return f.Retries }
+func (f *fakeMachine) SetRetryStatus(rs *volsyncv1alpha1.RetryStatus) { f.Retries = rs }
+func (f *fakeMachine) BytesTransferred() *int64                       { return f.Bytes }
+func (f *fakeMachine) NotifySyncResult(_ context.Context, e volsyncv1alpha1.NotificationEventType, _ string) {
+	f.Notifications = append(f.Notifications, e)
+}
+func (f *fakeMachine) DeferSync(_ context.Context) (time.Duration, string, error) {
+	return f.DeferFor, "deferred for testing", nil
+}
 func (f *fakeMachine) Synchronize(_ context.Context) (mover.Result, error) {
+	if f.SyncMoverStatus != nil {
+		f.MoverStatus = f.SyncMoverStatus.DeepCopy()
+	}
 	return f.SyncResult, f.SyncErr
 }
 func (f *fakeMachine) Cleanup(_ context.Context) (mover.Result, error) {
 	return f.CleanupResult, f.CleanupError
 }
+func (f *fakeMachine) VerifyCleanup(_ context.Context) (time.Duration, error) {
+	f.VerifyCleanupCalls++
+	return f.CleanupRecheck, nil
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package statemachine
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+// DefaultSyncHistoryLimit is the default number of synchronization attempts
+// retained in .status.history
+const DefaultSyncHistoryLimit = 10
+
+// SyncHistoryLimit is the maximum number of synchronization attempts retained
+// in .status.history of each ReplicationSource and ReplicationDestination. A
+// value <= 0 disables the history.
+var SyncHistoryLimit = DefaultSyncHistoryLimit
+
+// recordSyncHistory adds an entry for a finished synchronization attempt to the
+// front of the history, dropping the oldest entries beyond SyncHistoryLimit.
+// Times from the mover Job are preferred when they are available.
+func recordSyncHistory(r ReplicationMachine, result volsyncv1alpha1.MoverResult, message string) {
+	history := r.SyncHistory()
+	if SyncHistoryLimit <= 0 {
+		*history = nil
+		return
+	}
+
+	now := metav1.Now()
+	entry := volsyncv1alpha1.SyncHistoryEntry{
+		StartTime:      r.LastSyncStartTime().DeepCopy(),
+		CompletionTime: &now,
+		Result:         result,
+		Message:        message,
+	}
+	if ms := r.LatestMoverStatus(); ms != nil && ms.Result == result {
+		entry.MoverJob = ms.JobName
+		if ms.StartTime != nil {
+			entry.StartTime = ms.StartTime.DeepCopy()
+		}
+		if ms.CompletionTime != nil {
+			entry.CompletionTime = ms.CompletionTime.DeepCopy()
+		}
+	}
+	if entry.StartTime != nil {
+		entry.Duration = &metav1.Duration{Duration: entry.CompletionTime.Sub(entry.StartTime.Time)}
+	}
+	if result != volsyncv1alpha1.MoverResultFailed {
+		entry.BytesTransferred = r.BytesTransferred()
+	}
+
+	*history = append([]volsyncv1alpha1.SyncHistoryEntry{entry}, *history...)
+	if len(*history) > SyncHistoryLimit {
+		*history = (*history)[:SyncHistoryLimit]
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package statemachine
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/mover"
+)
+
+var _ = Describe("Sync history", func() {
+	var m *fakeMachine
+	ctx := context.TODO()
+	logger := logr.Discard()
+
+	BeforeEach(func() {
+		m = newFakeMachine()
+		m.LSST = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	})
+	AfterEach(func() {
+		SyncHistoryLimit = DefaultSyncHistoryLimit
+	})
+
+	It("records successful syncs", func() {
+		m.Bytes = ptr.To[int64](1024)
+		_, err := Run(ctx, m, logger)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(m.History).To(HaveLen(1))
+		Expect(m.History[0].Result).To(Equal(volsyncv1alpha1.MoverResultSuccessful))
+		Expect(m.History[0].Duration.Duration).To(BeNumerically(">=", time.Minute))
+		Expect(*m.History[0].BytesTransferred).To(Equal(int64(1024)))
+	})
+
+	It("records partially completed syncs", func() {
+		m.Bytes = ptr.To[int64](512)
+		m.SyncMoverStatus = &volsyncv1alpha1.MoverStatus{
+			Result:          volsyncv1alpha1.MoverResultPartiallyCompleted,
+			FailedPathCount: 2,
+			FailedPaths:     []string{"a", "b"},
+		}
+		_, err := Run(ctx, m, logger)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(m.History).To(HaveLen(1))
+		Expect(m.History[0].Result).To(Equal(volsyncv1alpha1.MoverResultPartiallyCompleted))
+		Expect(m.History[0].Message).To(ContainSubstring("2 path(s)"))
+		Expect(*m.History[0].BytesTransferred).To(Equal(int64(512)))
+	})
+
+	It("records failed mover Jobs once", func() {
+		start := metav1.NewTime(time.Now().Add(-time.Hour))
+		end := metav1.NewTime(start.Add(10 * time.Minute))
+		m.SyncResult = mover.InProgress()
+		m.SyncMoverStatus = &volsyncv1alpha1.MoverStatus{
+			Result:         volsyncv1alpha1.MoverResultFailed,
+			JobName:        "mover-job",
+			StartTime:      &start,
+			CompletionTime: &end,
+		}
+		for range 2 {
+			_, err := Run(ctx, m, logger)
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(m.History).To(HaveLen(1))
+		Expect(m.History[0].Result).To(Equal(volsyncv1alpha1.MoverResultFailed))
+		Expect(m.History[0].MoverJob).To(Equal("mover-job"))
+		Expect(m.History[0].Duration.Duration).To(Equal(10 * time.Minute))
+		Expect(m.History[0].BytesTransferred).To(BeNil())
+	})
+
+	It("keeps only the newest entries", func() {
+		SyncHistoryLimit = 2
+		for _, msg := range []string{"one", "two", "three"} {
+			recordSyncHistory(m, volsyncv1alpha1.MoverResultSuccessful, msg)
+		}
+		Expect(m.History).To(HaveLen(2))
+		Expect(m.History[0].Message).To(Equal("three"))
+		Expect(m.History[1].Message).To(Equal("two"))
+	})
+
+	It("clears the history when disabled", func() {
+		recordSyncHistory(m, volsyncv1alpha1.MoverResultSuccessful, "one")
+		SyncHistoryLimit = 0
+		recordSyncHistory(m, volsyncv1alpha1.MoverResultSuccessful, "two")
+		Expect(m.History).To(BeEmpty())
+	})
+})
@@ -21,6 +21,7 @@ import (
 	"context"
 	"time"
 
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
 	"github.com/backube/volsync/controllers/mover"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -29,7 +30,16 @@ import (
 // ReplicationDestination types that allow us to generically implement the
 // synchronization state machine.
 type ReplicationMachine interface {
+	// Namespace of the replication object
+	Namespace() string
+	// LaunchKey uniquely identifies the replication object when admitting
+	// the start of synchronizations
+	LaunchKey() string
+
 	Cronspec() string
+	// TimeZone is the IANA time zone the Cronspec is interpreted in, or empty
+	// for the operator's local time zone
+	TimeZone() string
 	ManualTag() string
 	LastManualTag() string
 	SetLastManualTag(string)
@@ -52,6 +62,31 @@ type ReplicationMachine interface {
 	IncMissedIntervals()
 	ObserveSyncDuration(time.Duration)
 
+	// LatestMoverStatus is the result of the most recent mover Job, if any
+	LatestMoverStatus() *volsyncv1alpha1.MoverStatus
+	// SyncHistory is the list of recent synchronization attempts, newest first
+	SyncHistory() *[]volsyncv1alpha1.SyncHistoryEntry
+	// RetryPolicy controls how failed mover Jobs are retried, or nil to retry
+	// them immediately
+	RetryPolicy() *volsyncv1alpha1.RetryPolicy
+	// RetryStatus records the failed mover Jobs of the current synchronization
+	RetryStatus() *volsyncv1alpha1.RetryStatus
+	SetRetryStatus(*volsyncv1alpha1.RetryStatus)
+	// BytesTransferred is the amount of data sent by the most recent
+	// synchronization, or nil if the mover doesn't report it
+	BytesTransferred() *int64
+	// NotifySyncResult sends a notification of a synchronization result, if
+	// notifications are configured
+	NotifySyncResult(ctx context.Context, event volsyncv1alpha1.NotificationEventType, message string)
+
+	// DeferSync is called before starting a synchronization that is due. It
+	// returns how long to put the start off for (zero to start it now) and a
+	// message describing why.
+	DeferSync(ctx context.Context) (time.Duration, string, error)
 	Synchronize(ctx context.Context) (mover.Result, error)
 	Cleanup(ctx context.Context) (mover.Result, error)
+	// VerifyCleanup checks for (and retries deleting) temporary objects that
+	// were left over after Cleanup. It returns the time after which the
+	// leftovers should be checked again, or zero if there are none.
+	VerifyCleanup(ctx context.Context) (time.Duration, error)
 }
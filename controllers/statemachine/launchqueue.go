@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package statemachine
+
+import (
+	"sync"
+	"time"
+)
+
+// MaxConcurrentSyncs is the maximum number of synchronizations (across all
+// ReplicationSources and ReplicationDestinations) that may be running at the
+// same time. A value <= 0 means there is no limit.
+var MaxConcurrentSyncs = 0
+
+const (
+	// How long to wait before checking again whether a queued sync can start
+	launchRetryInterval = 10 * time.Second
+	// Waiters that have not checked in for this long are assumed to be gone
+	// (e.g., the object was deleted) and are dropped from the queue
+	staleWaiterTimeout = 6 * launchRetryInterval
+)
+
+type launchWaiter struct {
+	key       string
+	firstSeen time.Time
+	lastSeen  time.Time
+}
+
+// launchQueue admits the start of new synchronizations. When the number of
+// running syncs is limited, free slots are handed out round-robin across
+// namespaces so that a namespace with many objects can't starve the others.
+type launchQueue struct {
+	mu sync.Mutex
+	// key -> namespace for all syncs currently holding a slot
+	active map[string]string
+	// namespace -> waiters, in arrival order
+	waiting map[string][]*launchWaiter
+	// Namespaces with waiters, in the order they will be served
+	nsOrder []string
+	now     func() time.Time
+}
+
+func newLaunchQueue() *launchQueue {
+	return &launchQueue{
+		active:  map[string]string{},
+		waiting: map[string][]*launchWaiter{},
+		now:     time.Now,
+	}
+}
+
+var syncLaunchQueue = newLaunchQueue()
+
+// ReleaseSync frees the slot (or queue position) held by the object with the
+// given key. It should be called when an object is deleted.
+func ReleaseSync(key string) {
+	syncLaunchQueue.release(key)
+}
+
+// tryAcquire returns true if the sync for "key" may start now. If not, the
+// caller is queued and should check back later.
+func (q *launchQueue) tryAcquire(namespace string, key string, maxConcurrent int) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := q.now()
+	if _, ok := q.active[key]; ok {
+		return true
+	}
+	if maxConcurrent <= 0 {
+		q.active[key] = namespace
+		observeLaunchWait(namespace, 0)
+		return true
+	}
+
+	waiter := q.enqueue(namespace, key, now)
+	q.pruneStale(now)
+
+	if len(q.active) >= maxConcurrent {
+		return false
+	}
+	// Only the oldest waiter of the namespace whose turn it is may start
+	if len(q.nsOrder) == 0 || q.nsOrder[0] != namespace || q.waiting[namespace][0] != waiter {
+		return false
+	}
+
+	q.dequeueHead(namespace)
+	q.active[key] = namespace
+	observeLaunchWait(namespace, now.Sub(waiter.firstSeen))
+	return true
+}
+
+// markActive records that "key" is already synchronizing (e.g., the sync was
+// started prior to an operator restart) so that it is counted against the
+// limit.
+func (q *launchQueue) markActive(namespace string, key string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if _, ok := q.active[key]; !ok {
+		q.removeWaiter(key)
+		q.active[key] = namespace
+	}
+}
+
+func (q *launchQueue) release(key string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.active, key)
+	q.removeWaiter(key)
+}
+
+// Adds the key to the namespace's queue (if not already present) and returns
+// its waiter entry
+func (q *launchQueue) enqueue(namespace string, key string, now time.Time) *launchWaiter {
+	for _, w := range q.waiting[namespace] {
+		if w.key == key {
+			w.lastSeen = now
+			return w
+		}
+	}
+	w := &launchWaiter{key: key, firstSeen: now, lastSeen: now}
+	if len(q.waiting[namespace]) == 0 {
+		q.nsOrder = append(q.nsOrder, namespace)
+	}
+	q.waiting[namespace] = append(q.waiting[namespace], w)
+	setLaunchWaiting(namespace, len(q.waiting[namespace]))
+	return w
+}
+
+// Removes the head waiter of the namespace and moves the namespace to the back
+// of the round-robin order
+func (q *launchQueue) dequeueHead(namespace string) {
+	q.waiting[namespace] = q.waiting[namespace][1:]
+	q.nsOrder = q.nsOrder[1:]
+	if len(q.waiting[namespace]) > 0 {
+		q.nsOrder = append(q.nsOrder, namespace)
+	} else {
+		delete(q.waiting, namespace)
+	}
+	setLaunchWaiting(namespace, len(q.waiting[namespace]))
+}
+
+func (q *launchQueue) removeWaiter(key string) {
+	for ns, waiters := range q.waiting {
+		for i, w := range waiters {
+			if w.key == key {
+				q.removeWaiterAt(ns, i)
+				return
+			}
+		}
+	}
+}
+
+func (q *launchQueue) removeWaiterAt(namespace string, i int) {
+	waiters := q.waiting[namespace]
+	q.waiting[namespace] = append(waiters[:i:i], waiters[i+1:]...)
+	if len(q.waiting[namespace]) == 0 {
+		delete(q.waiting, namespace)
+		for j, ns := range q.nsOrder {
+			if ns == namespace {
+				q.nsOrder = append(q.nsOrder[:j:j], q.nsOrder[j+1:]...)
+				break
+			}
+		}
+	}
+	setLaunchWaiting(namespace, len(q.waiting[namespace]))
+}
+
+func (q *launchQueue) pruneStale(now time.Time) {
+	for ns, waiters := range q.waiting {
+		for i := len(waiters) - 1; i >= 0; i-- {
+			if now.Sub(waiters[i].lastSeen) > staleWaiterTimeout {
+				q.removeWaiterAt(ns, i)
+				waiters = q.waiting[ns]
+			}
+		}
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package statemachine
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+var _ = Describe("Sync launch queue", func() {
+	var q *launchQueue
+	var now time.Time
+
+	BeforeEach(func() {
+		q = newLaunchQueue()
+		now = time.Now()
+		q.now = func() time.Time { return now }
+	})
+
+	It("admits everything when there is no limit", func() {
+		for _, k := range []string{"a", "b", "c"} {
+			Expect(q.tryAcquire("ns1", k, 0)).To(BeTrue())
+		}
+	})
+
+	It("limits the number of concurrent syncs", func() {
+		Expect(q.tryAcquire("ns1", "a", 2)).To(BeTrue())
+		Expect(q.tryAcquire("ns1", "b", 2)).To(BeTrue())
+		Expect(q.tryAcquire("ns1", "c", 2)).To(BeFalse())
+		// Already holding a slot
+		Expect(q.tryAcquire("ns1", "a", 2)).To(BeTrue())
+
+		q.release("a")
+		Expect(q.tryAcquire("ns1", "c", 2)).To(BeTrue())
+	})
+
+	It("alternates between namespaces", func() {
+		Expect(q.tryAcquire("busy", "busy-0", 1)).To(BeTrue())
+		// Many waiters from "busy", arriving before the one from "quiet"
+		for _, k := range []string{"busy-1", "busy-2", "busy-3"} {
+			Expect(q.tryAcquire("busy", k, 1)).To(BeFalse())
+		}
+		Expect(q.tryAcquire("quiet", "quiet-1", 1)).To(BeFalse())
+
+		q.release("busy-0")
+		// busy's turn, but only its oldest waiter may start
+		Expect(q.tryAcquire("busy", "busy-2", 1)).To(BeFalse())
+		Expect(q.tryAcquire("quiet", "quiet-1", 1)).To(BeFalse())
+		Expect(q.tryAcquire("busy", "busy-1", 1)).To(BeTrue())
+
+		q.release("busy-1")
+		// Now it's quiet's turn, even though busy-2 checks in first
+		Expect(q.tryAcquire("busy", "busy-2", 1)).To(BeFalse())
+		Expect(q.tryAcquire("quiet", "quiet-1", 1)).To(BeTrue())
+
+		q.release("quiet-1")
+		Expect(q.tryAcquire("busy", "busy-2", 1)).To(BeTrue())
+	})
+
+	It("drops waiters that stop checking in", func() {
+		Expect(q.tryAcquire("ns1", "a", 1)).To(BeTrue())
+		Expect(q.tryAcquire("ns1", "gone", 1)).To(BeFalse())
+
+		now = now.Add(staleWaiterTimeout / 2)
+		Expect(q.tryAcquire("ns2", "b", 1)).To(BeFalse())
+		q.release("a")
+
+		// "gone" is at the front of the queue but hasn't checked in
+		now = now.Add(staleWaiterTimeout)
+		Expect(q.tryAcquire("ns2", "b", 1)).To(BeTrue())
+	})
+
+	It("counts syncs that were already running", func() {
+		q.markActive("ns1", "running")
+		Expect(q.tryAcquire("ns1", "a", 1)).To(BeFalse())
+	})
+
+	When("the machine has to wait for a slot", func() {
+		BeforeEach(func() {
+			MaxConcurrentSyncs = 1
+			syncLaunchQueue = newLaunchQueue()
+		})
+		AfterEach(func() {
+			MaxConcurrentSyncs = 0
+			syncLaunchQueue = newLaunchQueue()
+		})
+		It("stays in the initial state until admitted", func() {
+			m1 := newFakeMachine()
+			m1.NS, m1.Key = "ns1", "m1"
+			m2 := newFakeMachine()
+			m2.NS, m2.Key = "ns2", "m2"
+
+			_, err := Run(ctx, m1, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(currentState(m1)).To(Equal(synchronizingState))
+
+			result, err := Run(ctx, m2, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(launchRetryInterval))
+			Expect(currentState(m2)).To(Equal(initialState))
+			Expect(apimeta.FindStatusCondition(m2.Cond,
+				volsyncv1alpha1.ConditionSynchronizing).Reason).To(Equal(volsyncv1alpha1.SynchronizingReasonQueued))
+
+			// m1 completes its sync, releasing the slot
+			_, err = Run(ctx, m1, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(currentState(m1)).To(Equal(cleaningUpState))
+
+			_, err = Run(ctx, m2, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(currentState(m2)).To(Equal(synchronizingState))
+		})
+	})
+})
@@ -19,12 +19,16 @@ package statemachine
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/go-logr/logr"
 	cron "github.com/robfig/cron/v3"
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
 )
 
 // replicationState is the different states that replication object can be in
@@ -91,9 +95,13 @@ func getTrigger(r ReplicationMachine) triggerType {
 	}
 }
 
-// ctrl.Result is always empty, but leave it as a return param to be consistent with other funcs
-// nolint:unparam
-func doInitialState(_ context.Context, r ReplicationMachine, l logr.Logger) (ctrl.Result, error) {
+func doInitialState(ctx context.Context, r ReplicationMachine, l logr.Logger) (ctrl.Result, error) {
+	if retry, err := deferSync(ctx, r, l); retry > 0 || err != nil {
+		return ctrl.Result{RequeueAfter: retry}, err
+	}
+	if !admitSync(r, l) {
+		return ctrl.Result{RequeueAfter: launchRetryInterval}, nil
+	}
 	err := transitionToSynchronizing(r, l)
 	// We don't need to explicitly re-queue because the transition will
 	// cause a .status update
@@ -101,17 +109,41 @@ func doInitialState(_ context.Context, r ReplicationMachine, l logr.Logger) (ctr
 }
 
 func doSynchronizingState(ctx context.Context, r ReplicationMachine, l logr.Logger) (ctrl.Result, error) {
+	syncLaunchQueue.markActive(r.Namespace(), r.LaunchKey())
+	if wait := holdRetry(r, l); wait > 0 {
+		return ctrl.Result{RequeueAfter: wait}, nil
+	}
+	prevMoverStatus := r.LatestMoverStatus().DeepCopy()
 	result, err := r.Synchronize(ctx)
 	if err != nil {
+		// Errors are mostly transient (conflicts, objects that aren't ready
+		// yet) and are retried, so only failed mover Jobs are notified
 		return ctrl.Result{}, err
 	}
+	setConditionTimedOut(r, l)
+	if moverJobFailed(prevMoverStatus, r.LatestMoverStatus()) {
+		recordSyncHistory(r, volsyncv1alpha1.MoverResultFailed, "mover Job failed")
+		r.NotifySyncResult(ctx, volsyncv1alpha1.NotificationEventFailed, "mover Job failed")
+		recordFailedAttempt(r, l)
+		if wait := holdRetry(r, l); wait > 0 {
+			return ctrl.Result{RequeueAfter: wait}, nil
+		}
+	}
 	if result.Completed {
+		r.SetRetryStatus(nil)
+		if ms := r.LatestMoverStatus(); ms != nil && ms.Result == volsyncv1alpha1.MoverResultPartiallyCompleted {
+			recordSyncHistory(r, volsyncv1alpha1.MoverResultPartiallyCompleted,
+				fmt.Sprintf("synchronization completed, %d path(s) could not be copied", ms.FailedPathCount))
+		} else {
+			recordSyncHistory(r, volsyncv1alpha1.MoverResultSuccessful, "synchronization completed")
+		}
 		// Just finished a sync, so we're in-sync
 		r.SetOutOfSync(false)
 		err = transitionToCleaningUp(r, l)
 		if err != nil {
 			return ctrl.Result{}, err
 		}
+		r.NotifySyncResult(ctx, volsyncv1alpha1.NotificationEventSucceeded, "synchronization completed")
 	} else {
 		setConditionSyncing(r, l)
 	}
@@ -133,6 +165,12 @@ func doCleanupState(ctx context.Context, r ReplicationMachine, l logr.Logger) (c
 	// next reconcile is triggered, but we tell the user that we are "idle".
 	if result.Completed {
 		if shouldSync(r, l) { // Time to start syncing again
+			if retry, err := deferSync(ctx, r, l); retry > 0 || err != nil {
+				return ctrl.Result{RequeueAfter: retry}, err
+			}
+			if !admitSync(r, l) {
+				return ctrl.Result{RequeueAfter: launchRetryInterval}, nil
+			}
 			err := transitionToSynchronizing(r, l)
 			if err != nil {
 				return ctrl.Result{}, err
@@ -144,10 +182,18 @@ func doCleanupState(ctx context.Context, r ReplicationMachine, l logr.Logger) (c
 				setConditionManual(r, l)
 			}
 
+			// Make sure nothing was left behind by the previous cleanup
+			recheck, err := r.VerifyCleanup(ctx)
+			if err != nil {
+				l.Error(err, "unable to verify cleanup")
+			}
+
 			timeToNext := timeToNextSync(r)
 			switch {
-			case timeToNext == nil:
+			case timeToNext == nil && recheck == 0:
 				return ctrl.Result{}, nil
+			case timeToNext == nil || (recheck > 0 && recheck < *timeToNext):
+				return ctrl.Result{RequeueAfter: recheck}, nil
 			default:
 				return ctrl.Result{RequeueAfter: *timeToNext}, nil
 			}
@@ -158,6 +204,15 @@ func doCleanupState(ctx context.Context, r ReplicationMachine, l logr.Logger) (c
 	return result.ReconcileResult(), nil
 }
 
+// moverJobFailed returns true if the mover recorded a new failed Job result
+// during the last call to Synchronize
+func moverJobFailed(prev *volsyncv1alpha1.MoverStatus, cur *volsyncv1alpha1.MoverStatus) bool {
+	if cur == nil || cur.Result != volsyncv1alpha1.MoverResultFailed {
+		return false
+	}
+	return prev == nil || !equality.Semantic.DeepEqual(prev, cur)
+}
+
 // Determine which state we're in by looking at the CR
 func currentState(r ReplicationMachine) replicationState {
 	// If we've never completed a sync and we're not trying to sync, we must be
@@ -178,6 +233,7 @@ func transitionToSynchronizing(r ReplicationMachine, l logr.Logger) error {
 	l.V(1).Info("transitioning to synchronization state")
 	now := metav1.Now()
 	r.SetLastSyncStartTime(&now)
+	r.SetRetryStatus(nil)
 	setConditionSyncing(r, l)
 	return nil
 }
@@ -213,6 +269,9 @@ func transitionToCleaningUp(r ReplicationMachine, l logr.Logger) error {
 	// Update manual trigger tag in .status to match the one in .spec
 	r.SetLastManualTag(r.ManualTag())
 
+	// Give up our slot so that another sync can start
+	syncLaunchQueue.release(r.LaunchKey())
+
 	// Since we're done syncing, clear LSST. In addition to being useful for
 	// duration calculation, it serves as the indicator of which state we're in
 	r.SetLastSyncStartTime(nil)
@@ -221,6 +280,30 @@ func transitionToCleaningUp(r ReplicationMachine, l logr.Logger) error {
 	return nil
 }
 
+// deferSync checks whether the machine wants to put off the start of a
+// synchronization that is due. It returns how long to wait before checking
+// again, or zero if the synchronization can start.
+func deferSync(ctx context.Context, r ReplicationMachine, l logr.Logger) (time.Duration, error) {
+	retry, message, err := r.DeferSync(ctx)
+	if err != nil || retry <= 0 {
+		return 0, err
+	}
+	l.V(1).Info("synchronization deferred", "reason", message, "retry", retry)
+	setConditionDeferred(r, l, message)
+	return retry, nil
+}
+
+// Returns true if the synchronization can start now. Otherwise, the machine is
+// queued (fairly, across namespaces) until a slot becomes available.
+func admitSync(r ReplicationMachine, l logr.Logger) bool {
+	if syncLaunchQueue.tryAcquire(r.Namespace(), r.LaunchKey(), MaxConcurrentSyncs) {
+		return true
+	}
+	l.V(1).Info("waiting for a synchronization slot", "maxConcurrentSyncs", MaxConcurrentSyncs)
+	setConditionQueued(r, l)
+	return false
+}
+
 // Given that we've finished cleanup, should we start syncing again?
 func shouldSync(r ReplicationMachine, l logr.Logger) bool {
 	switch getTrigger(r) {
@@ -251,7 +334,16 @@ func timeToNextSync(r ReplicationMachine) *time.Duration {
 	return &next
 }
 
-func getSchedule(cronspec string) (cron.Schedule, error) {
+// ParseSchedule parses a cronspec, interpreting it in the IANA time zone if
+// one is given
+func ParseSchedule(cronspec string, timeZone string) (cron.Schedule, error) {
+	if timeZone != "" {
+		// Make sure the zone exists, the parser's error doesn't say what's wrong
+		if _, err := time.LoadLocation(timeZone); err != nil {
+			return nil, fmt.Errorf("invalid time zone %q: %w", timeZone, err)
+		}
+		cronspec = "CRON_TZ=" + timeZone + " " + cronspec
+	}
 	parser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
 	return parser.Parse(cronspec)
 }
@@ -268,7 +360,7 @@ func pastScheduleDeadline(schedule cron.Schedule, lastCompleted time.Time, now t
 // Returns true if we're schedule-based and have missed our deadline
 func missedDeadline(r ReplicationMachine) (bool, error) {
 	if getTrigger(r) == scheduleTrigger && !r.LastSyncTime().IsZero() {
-		schedule, err := getSchedule(r.Cronspec())
+		schedule, err := ParseSchedule(r.Cronspec(), r.TimeZone())
 		if err != nil {
 			return false, err
 		}
@@ -284,9 +376,9 @@ func updateNextSyncStartTime(r ReplicationMachine, l logr.Logger) error {
 
 	switch getTrigger(r) {
 	case scheduleTrigger:
-		schedule, err := getSchedule(r.Cronspec())
+		schedule, err := ParseSchedule(r.Cronspec(), r.TimeZone())
 		if err != nil {
-			l.Error(err, "error parsing schedule", "cronspec", r.Cronspec())
+			l.Error(err, "error parsing schedule", "cronspec", r.Cronspec(), "timeZone", r.TimeZone())
 			return err
 		}
 		next := schedule.Next(lastSync.Time)
@@ -28,6 +28,7 @@ import (
 	. "github.com/onsi/gomega"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
@@ -172,6 +173,228 @@ var _ = When("in cleanup", func() {
 			Expect(apimeta.FindStatusCondition(m.Cond,
 				volsyncv1alpha1.ConditionSynchronizing).Reason).To(Equal(volsyncv1alpha1.SynchronizingReasonSched))
 		})
+		It("verifies the cleanup while idle and rechecks leftovers before the next sync", func() {
+			m.CleanupResult = mover.Complete()
+			result, err := Run(ctx, m, logger)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(m.VerifyCleanupCalls).To(Equal(1))
+			// Nothing left over, so wait for the schedule
+			Expect(result.RequeueAfter).To(BeNumerically(">", time.Hour))
+
+			m.CleanupRecheck = time.Minute
+			result, err = Run(ctx, m, logger)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(m.VerifyCleanupCalls).To(Equal(2))
+			Expect(result.RequeueAfter).To(Equal(time.Minute))
+		})
+	})
+	When("the start of the next sync is deferred", func() {
+		BeforeEach(func() {
+			m.TT = manualTrigger
+			m.MT = "1"
+		})
+		It("waits before starting it", func() {
+			m.MT = "2"
+			m.DeferFor = time.Minute
+			result, err := Run(ctx, m, logger)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(currentState(m)).To(Equal(cleaningUpState))
+			Expect(result.RequeueAfter).To(Equal(time.Minute))
+			Expect(apimeta.FindStatusCondition(m.Cond,
+				volsyncv1alpha1.ConditionSynchronizing).Reason).To(Equal(volsyncv1alpha1.SynchronizingReasonDeferred))
+
+			m.DeferFor = 0
+			_, err = Run(ctx, m, logger)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(currentState(m)).To(Equal(synchronizingState))
+		})
+	})
+	When("the trigger is manual and objects were left over", func() {
+		BeforeEach(func() {
+			m.TT = manualTrigger
+			m.MT = "1"
+			m.LMT = "1"
+			m.CleanupRecheck = 5 * time.Minute
+		})
+		It("requeues to recheck the leftovers", func() {
+			m.CleanupResult = mover.Complete()
+			result, err := Run(ctx, m, logger)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(currentState(m)).To(Equal(cleaningUpState))
+			Expect(result.RequeueAfter).To(Equal(5 * time.Minute))
+		})
+	})
+})
+
+var _ = Describe("Sync result notifications", func() {
+	var m *fakeMachine
+	BeforeEach(func() {
+		m = newFakeMachine()
+		Expect(transitionToSynchronizing(m, logger)).To(Succeed())
+	})
+	It("notifies of a successful sync", func() {
+		_, err := Run(ctx, m, logger)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(m.Notifications).To(Equal([]volsyncv1alpha1.NotificationEventType{
+			volsyncv1alpha1.NotificationEventSucceeded}))
+	})
+	It("does not notify of a sync error, which is retried", func() {
+		m.SyncErr = fmt.Errorf("error")
+		_, err := Run(ctx, m, logger)
+		Expect(err).To(HaveOccurred())
+		Expect(m.Notifications).To(BeEmpty())
+	})
+	It("notifies when a mover Job fails", func() {
+		m.SyncResult = mover.InProgress()
+		m.SyncMoverStatus = &volsyncv1alpha1.MoverStatus{Result: volsyncv1alpha1.MoverResultFailed, Logs: "failed"}
+		_, err := Run(ctx, m, logger)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(m.Notifications).To(Equal([]volsyncv1alpha1.NotificationEventType{
+			volsyncv1alpha1.NotificationEventFailed}))
+
+		// The same failed Job is not notified again
+		_, err = Run(ctx, m, logger)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(m.Notifications).To(HaveLen(1))
+	})
+	It("does not re-notify a failed mover Job that has timestamps", func() {
+		m.SyncResult = mover.InProgress()
+		start := metav1.Now()
+		m.SyncMoverStatus = &volsyncv1alpha1.MoverStatus{
+			Result:         volsyncv1alpha1.MoverResultFailed,
+			StartTime:      &start,
+			CompletionTime: &start,
+		}
+		_, err := Run(ctx, m, logger)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = Run(ctx, m, logger)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(m.Notifications).To(HaveLen(1))
+	})
+})
+
+var _ = Describe("Mover timeouts", func() {
+	var m *fakeMachine
+	BeforeEach(func() {
+		m = newFakeMachine()
+		Expect(transitionToSynchronizing(m, logger)).To(Succeed())
+	})
+	It("sets SyncTimedOut while mover Jobs are timing out", func() {
+		m.SyncResult = mover.InProgress()
+		now := metav1.Now()
+		m.SyncMoverStatus = &volsyncv1alpha1.MoverStatus{
+			Result:          volsyncv1alpha1.MoverResultFailed,
+			Timeouts:        2,
+			LastTimeoutTime: &now,
+		}
+		_, err := Run(ctx, m, logger)
+		Expect(err).ToNot(HaveOccurred())
+		cond := apimeta.FindStatusCondition(m.Cond, volsyncv1alpha1.ConditionSyncTimedOut)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(cond.Reason).To(Equal(volsyncv1alpha1.SyncTimedOutReasonMoverTimeout))
+		Expect(m.Notifications).To(Equal([]volsyncv1alpha1.NotificationEventType{
+			volsyncv1alpha1.NotificationEventFailed}))
+
+		// A successful Job clears the condition
+		m.SyncResult = mover.Complete()
+		m.SyncMoverStatus = &volsyncv1alpha1.MoverStatus{Result: volsyncv1alpha1.MoverResultSuccessful}
+		_, err = Run(ctx, m, logger)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(apimeta.FindStatusCondition(m.Cond, volsyncv1alpha1.ConditionSyncTimedOut)).To(BeNil())
+	})
+})
+
+var _ = Describe("Retry policy", func() {
+	var m *fakeMachine
+	BeforeEach(func() {
+		m = newFakeMachine()
+		Expect(transitionToSynchronizing(m, logger)).To(Succeed())
+		m.SyncResult = mover.InProgress()
+	})
+	failJob := func(name string) {
+		m.SyncMoverStatus = &volsyncv1alpha1.MoverStatus{Result: volsyncv1alpha1.MoverResultFailed, JobName: name}
+	}
+	It("retries immediately without a retry policy", func() {
+		failJob("job-1")
+		result, err := Run(ctx, m, logger)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(mover.InProgress().ReconcileResult()))
+		Expect(m.Retries).To(BeNil())
+	})
+	It("backs off between failed mover Jobs", func() {
+		m.Retry = &volsyncv1alpha1.RetryPolicy{Backoff: &metav1.Duration{Duration: 10 * time.Minute}}
+		failJob("job-1")
+		result, err := Run(ctx, m, logger)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically("~", 10*time.Minute, time.Minute))
+		Expect(m.Retries).NotTo(BeNil())
+		Expect(m.Retries.Attempts).To(Equal(int32(1)))
+		Expect(m.Retries.Exhausted).To(BeFalse())
+		cond := apimeta.FindStatusCondition(m.Cond, volsyncv1alpha1.ConditionSynchronizing)
+		Expect(cond.Reason).To(Equal(volsyncv1alpha1.SynchronizingReasonRetryBackoff))
+
+		// The mover is not run while backing off
+		failJob("job-2")
+		_, err = Run(ctx, m, logger)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(m.MoverStatus.JobName).To(Equal("job-1"))
+
+		// The backoff doubles with the next failure
+		m.Retries.NextRetryTime = &metav1.Time{Time: time.Now().Add(-time.Second)}
+		result, err = Run(ctx, m, logger)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(m.Retries.Attempts).To(Equal(int32(2)))
+		Expect(result.RequeueAfter).To(BeNumerically("~", 20*time.Minute, time.Minute))
+
+		// A successful sync resets the retries
+		m.Retries.NextRetryTime = &metav1.Time{Time: time.Now().Add(-time.Second)}
+		m.SyncResult = mover.Complete()
+		m.SyncMoverStatus = &volsyncv1alpha1.MoverStatus{Result: volsyncv1alpha1.MoverResultSuccessful}
+		_, err = Run(ctx, m, logger)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(m.Retries).To(BeNil())
+	})
+	It("waits for the next scheduled sync once retries are exhausted", func() {
+		m.CS = "0 0 * * *"
+		m.Retry = &volsyncv1alpha1.RetryPolicy{MaxRetries: ptr.To[int32](0)}
+		failJob("job-1")
+		result, err := Run(ctx, m, logger)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(m.Retries.Exhausted).To(BeTrue())
+		schedule, err := ParseSchedule(m.CS, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(m.Retries.NextRetryTime.Time).To(BeTemporally("~", schedule.Next(time.Now()), time.Second))
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+		cond := apimeta.FindStatusCondition(m.Cond, volsyncv1alpha1.ConditionSynchronizing)
+		Expect(cond.Reason).To(Equal(volsyncv1alpha1.SynchronizingReasonRetriesExhausted))
+
+		// A new retry window starts at the scheduled time
+		m.Retries.NextRetryTime = &metav1.Time{Time: time.Now().Add(-time.Second)}
+		failJob("job-2")
+		_, err = Run(ctx, m, logger)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(m.MoverStatus.JobName).To(Equal("job-2"))
+		Expect(m.Retries.Attempts).To(Equal(int32(1)))
+	})
+	It("stops retrying after the retry deadline", func() {
+		m.Retry = &volsyncv1alpha1.RetryPolicy{RetryDeadline: &metav1.Duration{Duration: time.Hour}}
+		m.Retries = &volsyncv1alpha1.RetryStatus{
+			Attempts:         3,
+			FirstFailureTime: &metav1.Time{Time: time.Now().Add(-2 * time.Hour)},
+		}
+		failJob("job-4")
+		result, err := Run(ctx, m, logger)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(m.Retries.Attempts).To(Equal(int32(4)))
+		Expect(m.Retries.Exhausted).To(BeTrue())
+		Expect(result.RequeueAfter).To(BeNumerically("~", maxRetryBackoff, time.Minute))
+	})
+	It("caps the backoff", func() {
+		policy := &volsyncv1alpha1.RetryPolicy{}
+		Expect(retryBackoff(policy, 1)).To(Equal(defaultRetryBackoff))
+		Expect(retryBackoff(policy, 3)).To(Equal(4 * defaultRetryBackoff))
+		Expect(retryBackoff(policy, 30)).To(Equal(maxRetryBackoff))
 	})
 })
 
@@ -316,6 +539,32 @@ var _ = Context("Issue 290: Synchronizing condition error doesn't clear", func()
 	})
 })
 
+var _ = Describe("Schedule time zones", func() {
+	var m *fakeMachine
+	BeforeEach(func() {
+		m = newFakeMachine()
+		m.TT = scheduleTrigger
+		m.CS = "0 1 * * *"
+		m.TZ = "America/New_York"
+		// 2024-03-09 12:00 EST, the day before the start of DST
+		m.LST = &metav1.Time{Time: time.Date(2024, 3, 9, 17, 0, 0, 0, time.UTC)}
+	})
+	It("computes the next sync in the requested time zone", func() {
+		Expect(updateNextSyncStartTime(m, logger)).To(Succeed())
+		Expect(m.NST.Time.UTC()).To(Equal(time.Date(2024, 3, 10, 6, 0, 0, 0, time.UTC)))
+	})
+	It("follows daylight saving time changes", func() {
+		m.CS = "0 12 * * *"
+		Expect(updateNextSyncStartTime(m, logger)).To(Succeed())
+		// Noon EDT is 16:00 UTC
+		Expect(m.NST.Time.UTC()).To(Equal(time.Date(2024, 3, 10, 16, 0, 0, 0, time.UTC)))
+	})
+	It("rejects unknown time zones", func() {
+		m.TZ = "Not/AZone"
+		Expect(updateNextSyncStartTime(m, logger)).NotTo(Succeed())
+	})
+})
+
 var _ = DescribeTable("Crontab parsing and validation",
 	func(cronspec string, isValid bool) {
 		// cronspecValidation is the regex used to validate crontab entries it
@@ -327,7 +576,7 @@ var _ = DescribeTable("Crontab parsing and validation",
 		// https://regex101.com/r/AXEJLy/2
 		// nolint:lll
 		var cronspecValidation = regexp.MustCompile(`^(@(annually|yearly|monthly|weekly|daily|hourly))|((((\d+,)*\d+|(\d+(\/|-)\d+)|\*(\/\d+)?)\s?){5})$`)
-		_, err := getSchedule(cronspec)
+		_, err := ParseSchedule(cronspec, "")
 		if isValid { // needs to pass regex validation and be parsable by cron library
 			Expect(cronspecValidation.MatchString(cronspec)).To(BeTrue())
 			Expect(err).NotTo(HaveOccurred())
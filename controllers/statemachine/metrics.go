@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package statemachine
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	launchWaitSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:      "sync_launch_wait_seconds",
+			Namespace: "volsync",
+			Help:      "Time a synchronization waited to be admitted before starting",
+			Buckets:   []float64{0, 10, 30, 60, 300, 900, 1800, 3600, 7200},
+		},
+		[]string{"obj_namespace"},
+	)
+	launchWaiting = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "sync_launch_waiting",
+			Namespace: "volsync",
+			Help:      "The number of synchronizations waiting to be admitted",
+		},
+		[]string{"obj_namespace"},
+	)
+)
+
+func observeLaunchWait(namespace string, wait time.Duration) {
+	launchWaitSeconds.WithLabelValues(namespace).Observe(wait.Seconds())
+}
+
+func setLaunchWaiting(namespace string, count int) {
+	if count == 0 {
+		launchWaiting.DeleteLabelValues(namespace)
+		return
+	}
+	launchWaiting.WithLabelValues(namespace).Set(float64(count))
+}
+
+func init() {
+	metrics.Registry.MustRegister(launchWaitSeconds, launchWaiting)
+}
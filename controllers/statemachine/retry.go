@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package statemachine
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+const (
+	// defaultRetryBackoff is the delay before the first retry of a failed
+	// mover Job when spec.retryPolicy.backoff is omitted
+	defaultRetryBackoff = time.Minute
+	// maxRetryBackoff caps the delay between retries, and is how long an
+	// unscheduled synchronization waits after running out of retries
+	maxRetryBackoff = time.Hour
+)
+
+// holdRetry returns how long to wait before the mover Job of the current
+// synchronization may be retried, or zero if it can be started now. Once the
+// retries of an exhausted window may resume, the retry status is reset.
+func holdRetry(r ReplicationMachine, l logr.Logger) time.Duration {
+	rs := r.RetryStatus()
+	if rs == nil || rs.NextRetryTime == nil {
+		return 0
+	}
+	wait := time.Until(rs.NextRetryTime.Time)
+	if wait <= 0 {
+		if rs.Exhausted {
+			l.V(1).Info("starting a new retry window")
+			r.SetRetryStatus(nil)
+		}
+		return 0
+	}
+	setConditionRetry(r, l)
+	return wait
+}
+
+// recordFailedAttempt updates the retry status after a failed mover Job and
+// determines when it may be retried according to the retry policy
+func recordFailedAttempt(r ReplicationMachine, l logr.Logger) {
+	policy := r.RetryPolicy()
+	if policy == nil {
+		r.SetRetryStatus(nil)
+		return
+	}
+
+	now := metav1.Now()
+	rs := r.RetryStatus().DeepCopy()
+	if rs == nil {
+		rs = &volsyncv1alpha1.RetryStatus{FirstFailureTime: &now}
+	}
+	rs.Attempts++
+	rs.LastFailureTime = &now
+
+	switch {
+	case policy.MaxRetries != nil && rs.Attempts > *policy.MaxRetries:
+		rs.Exhausted = true
+	case policy.RetryDeadline != nil && rs.FirstFailureTime != nil &&
+		now.Sub(rs.FirstFailureTime.Time) >= policy.RetryDeadline.Duration:
+		rs.Exhausted = true
+	}
+
+	var next time.Time
+	if rs.Exhausted {
+		next = now.Add(maxRetryBackoff)
+		if getTrigger(r) == scheduleTrigger {
+			if schedule, err := ParseSchedule(r.Cronspec(), r.TimeZone()); err == nil {
+				next = schedule.Next(now.Time)
+			}
+		}
+	} else {
+		next = now.Add(retryBackoff(policy, rs.Attempts))
+	}
+	rs.NextRetryTime = &metav1.Time{Time: next}
+	l.V(1).Info("mover Job failed", "attempts", rs.Attempts, "exhausted", rs.Exhausted,
+		"nextRetryTime", rs.NextRetryTime)
+	r.SetRetryStatus(rs)
+}
+
+// retryBackoff is the delay after the given number of consecutive failures.
+// It starts at the policy's backoff and doubles with each failure.
+func retryBackoff(policy *volsyncv1alpha1.RetryPolicy, attempts int32) time.Duration {
+	backoff := defaultRetryBackoff
+	if policy.Backoff != nil && policy.Backoff.Duration > 0 {
+		backoff = policy.Backoff.Duration
+	}
+	for i := int32(1); i < attempts && backoff < maxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxRetryBackoff {
+		backoff = maxRetryBackoff
+	}
+	return backoff
+}
+
+func setConditionRetry(r ReplicationMachine, _ logr.Logger) {
+	rs := r.RetryStatus()
+	cond := metav1.Condition{
+		Type:   volsyncv1alpha1.ConditionSynchronizing,
+		Status: metav1.ConditionFalse,
+		Reason: volsyncv1alpha1.SynchronizingReasonRetryBackoff,
+		Message: fmt.Sprintf("Retrying failed mover Job (%d failed attempt(s)) at %s",
+			rs.Attempts, rs.NextRetryTime.UTC().Format(time.RFC3339)),
+	}
+	if rs.Exhausted {
+		cond.Reason = volsyncv1alpha1.SynchronizingReasonRetriesExhausted
+		cond.Message = fmt.Sprintf("Retries exhausted after %d failed attempt(s); retrying at %s",
+			rs.Attempts, rs.NextRetryTime.UTC().Format(time.RFC3339))
+	}
+	apimeta.SetStatusCondition(r.Conditions(), cond)
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package controllers
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	sm "github.com/backube/volsync/controllers/statemachine"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+const (
+	// Annotation recording the version of the status schema that an object
+	// has been backfilled to
+	statusSchemaVersionAnnotation = utils.VolsyncLabelPrefix + "/status-schema-version"
+	// statusSchemaVersion must be incremented whenever a new backfill rule is
+	// added so that existing objects are revisited
+	statusSchemaVersion = 1
+
+	// Condition type used by old versions of VolSync in place of Synchronizing
+	legacyConditionReconciled = "Reconciled"
+)
+
+// StatusBackfillEnabled controls whether the StatusBackfill runs at startup
+var StatusBackfillEnabled = true
+
+// Reasons used by old versions of VolSync and their current equivalents on
+// the Synchronizing condition
+var legacyReasons = map[string]string{
+	"ReconcileComplete": volsyncv1alpha1.SynchronizingReasonSched,
+	"ReconcileError":    volsyncv1alpha1.SynchronizingReasonError,
+	"SyncFailed":        volsyncv1alpha1.SynchronizingReasonError,
+}
+
+// StatusBackfill is a Runnable that brings the status of the existing
+// ReplicationSources and ReplicationDestinations up to date after an upgrade.
+// Each object is rewritten once (at the current storage version), populating
+// status fields that older versions of VolSync did not set and removing
+// obsolete conditions.
+type StatusBackfill struct {
+	Client client.Client
+	Log    logr.Logger
+}
+
+var _ manager.Runnable = &StatusBackfill{}
+var _ manager.LeaderElectionRunnable = &StatusBackfill{}
+
+// NeedLeaderElection ensures only the active operator rewrites objects
+func (b *StatusBackfill) NeedLeaderElection() bool { return true }
+
+// Start walks the existing objects, reporting completion via the
+// volsync_status_backfill_completed metric. It does not block the manager.
+func (b *StatusBackfill) Start(ctx context.Context) error {
+	logger := b.Log.WithValues("statusSchemaVersion", statusSchemaVersion)
+	logger.Info("starting status backfill")
+
+	updated, failed := 0, 0
+	rsList := &volsyncv1alpha1.ReplicationSourceList{}
+	if err := b.Client.List(ctx, rsList); err != nil {
+		logger.Error(err, "unable to list ReplicationSources")
+		return nil
+	}
+	for i := range rsList.Items {
+		rs := &rsList.Items[i]
+		changed, err := b.backfill(ctx, rs, func() bool {
+			if rs.Status == nil {
+				rs.Status = &volsyncv1alpha1.ReplicationSourceStatus{}
+			}
+			return backfillStatus(&rs.Status.History, &rs.Status.Conditions,
+				rs.Status.LastSyncStartTime, rs.Status.LastSyncTime, rs.Status.LastSyncDuration)
+		})
+		updated, failed = tally(logger, rs, changed, err, updated, failed)
+	}
+
+	rdList := &volsyncv1alpha1.ReplicationDestinationList{}
+	if err := b.Client.List(ctx, rdList); err != nil {
+		logger.Error(err, "unable to list ReplicationDestinations")
+		return nil
+	}
+	for i := range rdList.Items {
+		rd := &rdList.Items[i]
+		changed, err := b.backfill(ctx, rd, func() bool {
+			if rd.Status == nil {
+				rd.Status = &volsyncv1alpha1.ReplicationDestinationStatus{}
+			}
+			return backfillStatus(&rd.Status.History, &rd.Status.Conditions,
+				rd.Status.LastSyncStartTime, rd.Status.LastSyncTime, rd.Status.LastSyncDuration)
+		})
+		updated, failed = tally(logger, rd, changed, err, updated, failed)
+	}
+
+	statusBackfillObjects.WithLabelValues("updated").Set(float64(updated))
+	statusBackfillObjects.WithLabelValues("failed").Set(float64(failed))
+	if failed == 0 {
+		statusBackfillCompleted.Set(1)
+	}
+	logger.Info("status backfill finished", "updated", updated, "failed", failed)
+	return nil
+}
+
+func tally(logger logr.Logger, obj client.Object, changed bool, err error, updated, failed int) (int, int) {
+	if err != nil {
+		logger.Error(err, "unable to backfill status", "object", client.ObjectKeyFromObject(obj))
+		return updated, failed + 1
+	}
+	if changed {
+		updated++
+	}
+	return updated, failed
+}
+
+// backfill applies fillStatus to the status of obj and records the status
+// schema version on obj, retrying on conflicts. Objects that are already at
+// the current version are left alone. Returns true if obj was written.
+func (b *StatusBackfill) backfill(ctx context.Context, obj client.Object, fillStatus func() bool) (bool, error) {
+	written := false
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := b.Client.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		if obj.GetAnnotations()[statusSchemaVersionAnnotation] == strconv.Itoa(statusSchemaVersion) {
+			return nil
+		}
+		if fillStatus() {
+			if err := b.Client.Status().Update(ctx, obj); err != nil {
+				return err
+			}
+		}
+		// Rewriting the object also stores it at the current storage version
+		annotations := obj.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[statusSchemaVersionAnnotation] = strconv.Itoa(statusSchemaVersion)
+		obj.SetAnnotations(annotations)
+		if err := b.Client.Update(ctx, obj); err != nil {
+			return err
+		}
+		written = true
+		return nil
+	})
+	return written, err
+}
+
+// backfillStatus populates the parts of a ReplicationSource or
+// ReplicationDestination status that older versions of VolSync did not
+// maintain. Returns true if the status was modified.
+func backfillStatus(history *[]volsyncv1alpha1.SyncHistoryEntry, conditions *[]metav1.Condition,
+	lastSyncStartTime, lastSyncTime *metav1.Time, lastSyncDuration *metav1.Duration) bool {
+	modified := false
+
+	// The history was introduced after lastSyncTime, so seed it with the most
+	// recent successful synchronization
+	if len(*history) == 0 && lastSyncTime != nil && sm.SyncHistoryLimit > 0 {
+		entry := volsyncv1alpha1.SyncHistoryEntry{
+			CompletionTime: lastSyncTime.DeepCopy(),
+			Duration:       lastSyncDuration.DeepCopy(),
+			Result:         volsyncv1alpha1.MoverResultSuccessful,
+			Message:        "recorded from lastSyncTime by the status backfill",
+		}
+		if lastSyncStartTime != nil && !lastSyncStartTime.After(lastSyncTime.Time) {
+			entry.StartTime = lastSyncStartTime.DeepCopy()
+		}
+		*history = []volsyncv1alpha1.SyncHistoryEntry{entry}
+		modified = true
+	}
+
+	// Synchronizing replaced the Reconciled condition
+	if legacy := apimeta.FindStatusCondition(*conditions, legacyConditionReconciled); legacy != nil {
+		if apimeta.FindStatusCondition(*conditions, volsyncv1alpha1.ConditionSynchronizing) == nil {
+			if reason, ok := legacyReasons[legacy.Reason]; ok {
+				status := metav1.ConditionTrue
+				if reason == volsyncv1alpha1.SynchronizingReasonError {
+					status = metav1.ConditionFalse
+				}
+				apimeta.SetStatusCondition(conditions, metav1.Condition{
+					Type:               volsyncv1alpha1.ConditionSynchronizing,
+					Status:             status,
+					Reason:             reason,
+					Message:            legacy.Message,
+					LastTransitionTime: legacy.LastTransitionTime,
+				})
+			}
+		}
+		apimeta.RemoveStatusCondition(conditions, legacyConditionReconciled)
+		modified = true
+	}
+
+	// Normalize any legacy reasons remaining on the Synchronizing condition
+	if cond := apimeta.FindStatusCondition(*conditions, volsyncv1alpha1.ConditionSynchronizing); cond != nil {
+		if reason, ok := legacyReasons[cond.Reason]; ok {
+			cond.Reason = reason
+			modified = true
+		}
+	}
+
+	return modified
+}
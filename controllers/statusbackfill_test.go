@@ -0,0 +1,75 @@
+package controllers
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+var _ = Describe("Status backfill", func() {
+	var history []volsyncv1alpha1.SyncHistoryEntry
+	var conditions []metav1.Condition
+	var start, end *metav1.Time
+	var duration *metav1.Duration
+
+	BeforeEach(func() {
+		history = nil
+		conditions = nil
+		end = &metav1.Time{Time: time.Now().Truncate(time.Second)}
+		start = &metav1.Time{Time: end.Add(-time.Minute)}
+		duration = &metav1.Duration{Duration: time.Minute}
+	})
+
+	It("seeds the history from the last sync", func() {
+		Expect(backfillStatus(&history, &conditions, start, end, duration)).To(BeTrue())
+		Expect(history).To(HaveLen(1))
+		Expect(history[0].Result).To(Equal(volsyncv1alpha1.MoverResultSuccessful))
+		Expect(history[0].StartTime).To(Equal(start))
+		Expect(history[0].CompletionTime).To(Equal(end))
+		Expect(history[0].Duration).To(Equal(duration))
+	})
+
+	It("leaves an existing history alone", func() {
+		history = []volsyncv1alpha1.SyncHistoryEntry{{Result: volsyncv1alpha1.MoverResultFailed}}
+		Expect(backfillStatus(&history, &conditions, start, end, duration)).To(BeFalse())
+		Expect(history).To(HaveLen(1))
+		Expect(history[0].Result).To(Equal(volsyncv1alpha1.MoverResultFailed))
+	})
+
+	It("does nothing if the object has never synchronized", func() {
+		Expect(backfillStatus(&history, &conditions, nil, nil, nil)).To(BeFalse())
+		Expect(history).To(BeEmpty())
+	})
+
+	It("replaces the legacy Reconciled condition", func() {
+		conditions = []metav1.Condition{{
+			Type:    legacyConditionReconciled,
+			Status:  metav1.ConditionFalse,
+			Reason:  "ReconcileError",
+			Message: "boom",
+		}}
+		Expect(backfillStatus(&history, &conditions, nil, nil, nil)).To(BeTrue())
+		Expect(apimeta.FindStatusCondition(conditions, legacyConditionReconciled)).To(BeNil())
+		cond := apimeta.FindStatusCondition(conditions, volsyncv1alpha1.ConditionSynchronizing)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(volsyncv1alpha1.SynchronizingReasonError))
+		Expect(cond.Message).To(Equal("boom"))
+	})
+
+	It("normalizes legacy reasons on the Synchronizing condition", func() {
+		conditions = []metav1.Condition{{
+			Type:   volsyncv1alpha1.ConditionSynchronizing,
+			Status: metav1.ConditionTrue,
+			Reason: "ReconcileComplete",
+		}}
+		Expect(backfillStatus(&history, &conditions, nil, nil, nil)).To(BeTrue())
+		Expect(conditions[0].Reason).To(Equal(volsyncv1alpha1.SynchronizingReasonSched))
+		Expect(backfillStatus(&history, &conditions, nil, nil, nil)).To(BeFalse())
+	})
+})
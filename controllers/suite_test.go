@@ -139,6 +139,37 @@ var _ = BeforeSuite(func() {
 	}).SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 
+	err = (&ReplicationPolicyReconciler{
+		Client:        k8sManager.GetClient(),
+		Log:           ctrl.Log.WithName("controllers").WithName("ReplicationPolicy"),
+		Scheme:        k8sManager.GetScheme(),
+		EventRecorder: &record.FakeRecorder{},
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&ReplicationPairReconciler{
+		Client:        k8sManager.GetClient(),
+		Log:           ctrl.Log.WithName("controllers").WithName("ReplicationPair"),
+		Scheme:        k8sManager.GetScheme(),
+		EventRecorder: &record.FakeRecorder{},
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&RestoreFanoutReconciler{
+		Client:        k8sManager.GetClient(),
+		Log:           ctrl.Log.WithName("controllers").WithName("RestoreFanout"),
+		Scheme:        k8sManager.GetScheme(),
+		EventRecorder: &record.FakeRecorder{},
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&SnapshotContentReconciler{
+		Client:        k8sManager.GetClient(),
+		Log:           ctrl.Log.WithName("controllers").WithName("VolumeSnapshotContent"),
+		EventRecorder: &record.FakeRecorder{},
+	}).SetupWithManager(k8sManager)
+	Expect(err).ToNot(HaveOccurred())
+
 	// Index fields that are required for the VolumePopulator controller
 	err = IndexFieldsForVolumePopulator(ctx, k8sManager.GetFieldIndexer())
 	Expect(err).ToNot(HaveOccurred())
@@ -25,6 +25,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
 )
 
 const nodeHostnameLabelKey = "kubernetes.io/hostname" // Standard kube node hostname label
@@ -91,6 +93,75 @@ func AffinityFromVolume(ctx context.Context, c client.Client, logger logr.Logger
 	return &affinity, nil
 }
 
+// SetMoverApplicationAffinity adds pod affinity or anti-affinity between the
+// mover and the application pods that are using the PVC named pvcName. Pods
+// without labels can't be selected and are skipped.
+func SetMoverApplicationAffinity(ctx context.Context, c client.Client, logger logr.Logger,
+	podTemplateSpec *corev1.PodTemplateSpec, mode *volsyncv1alpha1.ApplicationAffinityMode,
+	namespace string, pvcName *string) error {
+	if mode == nil || pvcName == nil {
+		return nil
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      *pvcName,
+			Namespace: namespace,
+		},
+	}
+	podsUsing, err := podsUsingPVC(ctx, c, logger, pvc)
+	if err != nil {
+		return err
+	}
+
+	var terms []corev1.PodAffinityTerm
+	for i := range podsUsing {
+		pod := &podsUsing[i]
+		if IsOwnedByVolsync(pod) || len(pod.Labels) == 0 ||
+			(pod.Status.Phase != corev1.PodRunning && pod.Status.Phase != corev1.PodPending) {
+			continue
+		}
+		terms = append(terms, corev1.PodAffinityTerm{
+			LabelSelector: &metav1.LabelSelector{MatchLabels: pod.Labels},
+			TopologyKey:   nodeHostnameLabelKey,
+		})
+		if *mode == volsyncv1alpha1.ApplicationAffinityModeAffinity {
+			// The pods sharing a RWO volume are all on the same node
+			break
+		}
+	}
+	if len(terms) == 0 {
+		return nil
+	}
+
+	// The affinity may be shared with the moverAffinity of the owner
+	affinity := podTemplateSpec.Spec.Affinity.DeepCopy()
+	if affinity == nil {
+		affinity = &corev1.Affinity{}
+	}
+	switch *mode {
+	case volsyncv1alpha1.ApplicationAffinityModeAffinity:
+		if affinity.PodAffinity == nil {
+			affinity.PodAffinity = &corev1.PodAffinity{}
+		}
+		affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(
+			affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution, terms...)
+	case volsyncv1alpha1.ApplicationAffinityModeAntiAffinity:
+		if affinity.PodAntiAffinity == nil {
+			affinity.PodAntiAffinity = &corev1.PodAntiAffinity{}
+		}
+		for _, term := range terms {
+			affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(
+				affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution,
+				corev1.WeightedPodAffinityTerm{Weight: 100, PodAffinityTerm: term})
+		}
+	default:
+		return fmt.Errorf("unknown mover application affinity: %s", *mode)
+	}
+	podTemplateSpec.Spec.Affinity = affinity
+	return nil
+}
+
 func getNodeSelectorForNode(ctx context.Context, c client.Client, logger logr.Logger,
 	nodeName string) (map[string]string, error) {
 	node := &corev1.Node{
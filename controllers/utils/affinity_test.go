@@ -18,6 +18,7 @@ along with this program.  If not, see <https://www.gnu.org/licenses/>.
 package utils_test
 
 import (
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
 	"github.com/backube/volsync/controllers/utils"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -25,6 +26,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 )
 
@@ -286,4 +288,50 @@ var _ = Describe("Volume affinity", func() {
 			})
 		})
 	})
+
+	Context("When setting the affinity to the application pods", func() {
+		var podTemplate *corev1.PodTemplateSpec
+
+		BeforeEach(func() {
+			podTemplate = &corev1.PodTemplateSpec{}
+			for _, pod := range []*corev1.Pod{runningPod, pendingPod} {
+				pod.Labels = map[string]string{"app": pod.Name}
+				Expect(k8sClient.Update(ctx, pod)).To(Succeed())
+			}
+		})
+
+		It("does nothing unless requested", func() {
+			Expect(utils.SetMoverApplicationAffinity(ctx, k8sClient, logger, podTemplate,
+				nil, ns.Name, &rwoBoth.Name)).To(Succeed())
+			Expect(podTemplate.Spec.Affinity).To(BeNil())
+		})
+
+		It("does nothing when the PVC is not in use", func() {
+			Expect(utils.SetMoverApplicationAffinity(ctx, k8sClient, logger, podTemplate,
+				ptr.To(volsyncv1alpha1.ApplicationAffinityModeAffinity), ns.Name, &vsOnly.Name)).To(Succeed())
+			Expect(podTemplate.Spec.Affinity).To(BeNil())
+		})
+
+		It("requires the node of an application pod", func() {
+			Expect(utils.SetMoverApplicationAffinity(ctx, k8sClient, logger, podTemplate,
+				ptr.To(volsyncv1alpha1.ApplicationAffinityModeAffinity), ns.Name, &rwoBoth.Name)).To(Succeed())
+			terms := podTemplate.Spec.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+			Expect(terms).To(HaveLen(1))
+			Expect(terms[0].TopologyKey).To(Equal("kubernetes.io/hostname"))
+			Expect(terms[0].LabelSelector.MatchLabels).To(HaveKey("app"))
+		})
+
+		It("prefers other nodes than those of the application pods", func() {
+			moverAffinity := &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{}}
+			podTemplate.Spec.Affinity = moverAffinity
+			Expect(utils.SetMoverApplicationAffinity(ctx, k8sClient, logger, podTemplate,
+				ptr.To(volsyncv1alpha1.ApplicationAffinityModeAntiAffinity), ns.Name, &rwoBoth.Name)).To(Succeed())
+			Expect(podTemplate.Spec.Affinity.NodeAffinity).NotTo(BeNil())
+			terms := podTemplate.Spec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution
+			Expect(terms).To(HaveLen(2))
+			Expect(terms[0].Weight).To(Equal(int32(100)))
+			// The moverAffinity of the owner is left alone
+			Expect(moverAffinity.PodAntiAffinity).To(BeNil())
+		})
+	})
 })
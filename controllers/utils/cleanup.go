@@ -19,7 +19,9 @@ package utils
 
 import (
 	"context"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
@@ -27,7 +29,11 @@ import (
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
 )
 
 // MarkForCleanup marks the provided "obj" to be deleted at the end of the
@@ -316,6 +322,176 @@ func MarkOldSnapshotForCleanup(ctx context.Context, c client.Client, logger logr
 	return nil
 }
 
+// KeepCopyPointSnapshot preserves the (temporary) VolumeSnapshot "snapName" that
+// was used as the copy point of a synchronization so that it is not removed
+// during cleanup. At most "retain" of these snapshots are kept for the owner,
+// older ones are deleted (unless they have been marked do-not-delete).
+func KeepCopyPointSnapshot(ctx context.Context, c client.Client, logger logr.Logger,
+	owner client.Object, snapName string, retain int) error {
+	snap := &snapv1.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      snapName,
+			Namespace: owner.GetNamespace(),
+		},
+	}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(snap), snap); err != nil {
+		logger.Error(err, "unable to get copy point snapshot", "name", snapName)
+		return err
+	}
+
+	updated := UnmarkForCleanup(snap)
+	updated = AddLabel(snap, CopyPointSnapshotLabelKey, string(owner.GetUID())) || updated
+	if updated {
+		if err := c.Update(ctx, snap); err != nil {
+			logger.Error(err, "unable to update copy point snapshot", "name", snapName)
+			return err
+		}
+	}
+
+	snapList := &snapv1.VolumeSnapshotList{}
+	err := c.List(ctx, snapList,
+		client.MatchingLabels{CopyPointSnapshotLabelKey: string(owner.GetUID())},
+		client.InNamespace(owner.GetNamespace()))
+	if err != nil {
+		return err
+	}
+
+	// Newest first
+	snaps := snapList.Items
+	sort.Slice(snaps, func(i, j int) bool {
+		ti := snaps[i].GetCreationTimestamp()
+		tj := snaps[j].GetCreationTimestamp()
+		if ti.Equal(&tj) {
+			return snaps[i].GetName() > snaps[j].GetName()
+		}
+		return tj.Before(&ti)
+	})
+
+	// The snapshot we just preserved always counts as one of those retained
+	kept := 1
+	for i := range snaps {
+		s := &snaps[i]
+		if s.GetName() == snapName || !s.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+		if kept < retain {
+			kept++
+			continue
+		}
+		if IsMarkedDoNotDelete(s) {
+			// Not deleting, but no longer counted against the retention
+			RemoveLabel(s, CopyPointSnapshotLabelKey)
+			if err := c.Update(ctx, s); err != nil {
+				return err
+			}
+			continue
+		}
+		logger.Info("deleting expired copy point snapshot", "name", s.GetName())
+		err := c.Delete(ctx, s, client.Preconditions{ResourceVersion: &s.ResourceVersion})
+		if client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RetainIntermediateSnapshots keeps the VolumeSnapshots that have been marked
+// for cleanup by "owner" instead of letting CleanupObjects delete them. The
+// snapshots remain owned by "owner" and are pruned by
+// PruneIntermediateSnapshots. Nothing is retained if "retention" is nil.
+func RetainIntermediateSnapshots(ctx context.Context, c client.Client, logger logr.Logger,
+	owner client.Object, retention *volsyncv1alpha1.ReplicationSourceIntermediateRetentionSpec) error {
+	if retention == nil {
+		return nil
+	}
+	snapList := &snapv1.VolumeSnapshotList{}
+	err := c.List(ctx, snapList,
+		client.MatchingLabels{cleanupLabelKey: string(owner.GetUID())},
+		client.InNamespace(owner.GetNamespace()))
+	if err != nil {
+		return err
+	}
+	for i := range snapList.Items {
+		snap := &snapList.Items[i]
+		if !snap.GetDeletionTimestamp().IsZero() || IsMarkedDoNotDelete(snap) {
+			// Left to the regular cleanup
+			continue
+		}
+		UnmarkForCleanup(snap)
+		AddLabel(snap, IntermediateSnapshotLabelKey, string(owner.GetUID()))
+		logger.Info("retaining intermediate snapshot", "name", snap.GetName())
+		if err := c.Update(ctx, snap); err != nil {
+			logger.Error(err, "unable to update intermediate snapshot", "name", snap.GetName())
+			return err
+		}
+	}
+	return nil
+}
+
+// PruneIntermediateSnapshots deletes the snapshots retained by
+// RetainIntermediateSnapshots that are beyond the retention count or older
+// than the retention duration. If "retention" is nil, all of them are deleted.
+// It returns the time until the next of the remaining snapshots expires, or 0
+// if none of them expire.
+func PruneIntermediateSnapshots(ctx context.Context, c client.Client, logger logr.Logger,
+	owner client.Object, retention *volsyncv1alpha1.ReplicationSourceIntermediateRetentionSpec,
+	now time.Time) (time.Duration, error) {
+	snapList := &snapv1.VolumeSnapshotList{}
+	err := c.List(ctx, snapList,
+		client.MatchingLabels{IntermediateSnapshotLabelKey: string(owner.GetUID())},
+		client.InNamespace(owner.GetNamespace()))
+	if err != nil {
+		return 0, err
+	}
+
+	// Newest first
+	snaps := snapList.Items
+	sort.Slice(snaps, func(i, j int) bool {
+		ti := snaps[i].GetCreationTimestamp()
+		tj := snaps[j].GetCreationTimestamp()
+		if ti.Equal(&tj) {
+			return snaps[i].GetName() > snaps[j].GetName()
+		}
+		return tj.Before(&ti)
+	})
+
+	var nextExpiry time.Duration
+	kept := 0
+	for i := range snaps {
+		s := &snaps[i]
+		if !s.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+		var remaining time.Duration
+		keep := retention != nil && (retention.Count == nil || kept < int(*retention.Count))
+		if keep && retention.Duration != nil {
+			remaining = s.GetCreationTimestamp().Add(retention.Duration.Duration).Sub(now)
+			keep = remaining > 0
+		}
+		if keep {
+			kept++
+			if remaining > 0 && (nextExpiry == 0 || remaining < nextExpiry) {
+				nextExpiry = remaining
+			}
+			continue
+		}
+		if IsMarkedDoNotDelete(s) {
+			// Not deleting, but no longer counted against the retention
+			RemoveLabel(s, IntermediateSnapshotLabelKey)
+			if err := c.Update(ctx, s); err != nil {
+				return 0, err
+			}
+			continue
+		}
+		logger.Info("deleting expired intermediate snapshot", "name", s.GetName())
+		err := c.Delete(ctx, s, client.Preconditions{ResourceVersion: &s.ResourceVersion})
+		if client.IgnoreNotFound(err) != nil {
+			return 0, err
+		}
+	}
+	return nextExpiry, nil
+}
+
 func IsSnapshot(image *corev1.TypedLocalObjectReference) bool {
 	if image == nil {
 		return false
@@ -325,3 +501,24 @@ func IsSnapshot(image *corev1.TypedLocalObjectReference) bool {
 	}
 	return true
 }
+
+// VolSyncOwnerUIDs returns the UIDs of the VolSync objects that obj was
+// created for, as recorded by its owner references and the cleanup and
+// retention labels. The second return value is false if obj is also owned by
+// something other than VolSync.
+func VolSyncOwnerUIDs(obj metav1.Object) ([]types.UID, bool) {
+	uids := []types.UID{}
+	for _, ref := range obj.GetOwnerReferences() {
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil || gv.Group != volsyncv1alpha1.GroupVersion.Group {
+			return nil, false
+		}
+		uids = append(uids, ref.UID)
+	}
+	for _, key := range []string{cleanupLabelKey, CopyPointSnapshotLabelKey, IntermediateSnapshotLabelKey} {
+		if uid, ok := obj.GetLabels()[key]; ok && uid != "" {
+			uids = append(uids, types.UID(uid))
+		}
+	}
+	return uids, true
+}
@@ -18,6 +18,8 @@ along with this program.  If not, see <https://www.gnu.org/licenses/>.
 package utils_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -298,6 +300,73 @@ var _ = Describe("Cleanup", func() {
 		})
 	})
 
+	Describe("Verify cleanup", func() {
+		BeforeEach(func() {
+			// Mark snap A1 for cleanup
+			utils.MarkForCleanup(rdA, snapA1)
+			Expect(k8sClient.Update(ctx, snapA1)).To(Succeed())
+		})
+
+		It("Should retry deleting leftover objects and report them", func() {
+			warnings, recheck, err := utils.VerifyCleanup(ctx, k8sClient, logger, rdA, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(HaveLen(1))
+			Expect(warnings[0].Kind).To(Equal("VolumeSnapshot"))
+			Expect(warnings[0].Name).To(Equal(snapA1.GetName()))
+			Expect(warnings[0].Attempts).To(Equal(int32(1)))
+			Expect(warnings[0].LastAttempt).NotTo(BeNil())
+			Expect(warnings[0].Reason).To(ContainSubstring("still present after cleanup"))
+			Expect(recheck).To(Equal(time.Minute))
+
+			// The snapshot has been deleted, so nothing is left over
+			err = k8sClient.Get(ctx, client.ObjectKeyFromObject(snapA1), snapA1)
+			Expect(kerrors.IsNotFound(err)).To(BeTrue())
+			warnings, recheck, err = utils.VerifyCleanup(ctx, k8sClient, logger, rdA, warnings)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+			Expect(recheck).To(BeZero())
+		})
+
+		It("Should back off between deletion attempts", func() {
+			previous := []volsyncv1alpha1.CleanupWarning{{
+				Kind:        "VolumeSnapshot",
+				Name:        snapA1.GetName(),
+				Attempts:    3,
+				LastAttempt: &metav1.Time{Time: time.Now()},
+			}}
+			warnings, recheck, err := utils.VerifyCleanup(ctx, k8sClient, logger, rdA, previous)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(HaveLen(1))
+			Expect(warnings[0].Attempts).To(Equal(int32(3)))
+			Expect(recheck).To(BeNumerically("~", 4*time.Minute, time.Second))
+
+			// Not deleted yet
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(snapA1), snapA1)).To(Succeed())
+			Expect(snapA1.DeletionTimestamp.IsZero()).To(BeTrue())
+		})
+
+		Context("When a leftover object's deletion is pending", func() {
+			BeforeEach(func() {
+				snapA1.Finalizers = []string{"test.volsync.backube/blocker"}
+				Expect(k8sClient.Update(ctx, snapA1)).To(Succeed())
+				Expect(k8sClient.Delete(ctx, snapA1)).To(Succeed())
+			})
+			AfterEach(func() {
+				Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(snapA1), snapA1)).To(Succeed())
+				snapA1.Finalizers = nil
+				Expect(k8sClient.Update(ctx, snapA1)).To(Succeed())
+			})
+
+			It("Should give the deletion time to complete before reporting it", func() {
+				warnings, recheck, err := utils.VerifyCleanup(ctx, k8sClient, logger, rdA, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(BeEmpty())
+				Expect(recheck).To(BeNumerically(">", 0))
+				Expect(recheck).To(BeNumerically("<=", time.Minute))
+			})
+		})
+	})
+
 	Describe("Delete with preconditions", func() {
 		// Want to test preconditions here - when cleaning up snapshots we use a precondition with the
 		// resourceVersion to ensure the snapshot has not been modified prior to us attempting to delete it.
@@ -389,6 +458,102 @@ var _ = Describe("Cleanup", func() {
 			})
 		})
 	})
+
+	Describe("Keep copy point snapshots", func() {
+		BeforeEach(func() {
+			utils.MarkForCleanup(rdA, snapA1)
+			Expect(k8sClient.Update(ctx, snapA1)).To(Succeed())
+			utils.MarkForCleanup(rdA, snapA2)
+			Expect(k8sClient.Update(ctx, snapA2)).To(Succeed())
+		})
+
+		It("Should preserve the copy point snapshot and prune older ones", func() {
+			Expect(utils.KeepCopyPointSnapshot(ctx, k8sClient, logger, rdA, snapA1.GetName(), 1)).To(Succeed())
+
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(snapA1), snapA1)).To(Succeed())
+			Expect(snapA1.GetLabels()).NotTo(HaveKey("volsync.backube/cleanup"))
+			Expect(snapA1.GetLabels()).To(HaveKeyWithValue(utils.CopyPointSnapshotLabelKey, string(rdA.GetUID())))
+
+			// Keeping snapA2 w/ retain=1 should remove snapA1
+			Expect(utils.KeepCopyPointSnapshot(ctx, k8sClient, logger, rdA, snapA2.GetName(), 1)).To(Succeed())
+			err := k8sClient.Get(ctx, client.ObjectKeyFromObject(snapA1), snapA1)
+			Expect(kerrors.IsNotFound(err)).To(BeTrue())
+
+			// Cleanup should not remove snapA2
+			Expect(utils.CleanupObjects(ctx, k8sClient, logger, rdA,
+				[]client.Object{&snapv1.VolumeSnapshot{}})).To(Succeed())
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(snapA2), snapA2)).To(Succeed())
+			Expect(snapA2.GetLabels()).To(HaveKeyWithValue(utils.CopyPointSnapshotLabelKey, string(rdA.GetUID())))
+		})
+	})
+	Describe("Intermediate snapshots", func() {
+		BeforeEach(func() {
+			utils.MarkForCleanup(rdA, snapA1)
+			Expect(k8sClient.Update(ctx, snapA1)).To(Succeed())
+			utils.MarkForCleanup(rdA, snapA2)
+			Expect(k8sClient.Update(ctx, snapA2)).To(Succeed())
+		})
+
+		It("Should retain the snapshots and prune them by count", func() {
+			retention := &volsyncv1alpha1.ReplicationSourceIntermediateRetentionSpec{Count: ptr.To[int32](1)}
+			Expect(utils.RetainIntermediateSnapshots(ctx, k8sClient, logger, rdA, retention)).To(Succeed())
+			for _, snap := range []*snapv1.VolumeSnapshot{snapA1, snapA2} {
+				Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(snap), snap)).To(Succeed())
+				Expect(snap.GetLabels()).NotTo(HaveKey("volsync.backube/cleanup"))
+				Expect(snap.GetLabels()).To(HaveKeyWithValue(utils.IntermediateSnapshotLabelKey, string(rdA.GetUID())))
+			}
+
+			// Cleanup should not remove them
+			Expect(utils.CleanupObjects(ctx, k8sClient, logger, rdA,
+				[]client.Object{&snapv1.VolumeSnapshot{}})).To(Succeed())
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(snapA1), snapA1)).To(Succeed())
+
+			// snapA2 sorts as the newer one of the two
+			expiry, err := utils.PruneIntermediateSnapshots(ctx, k8sClient, logger, rdA, retention, time.Now())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(expiry).To(BeZero())
+			err = k8sClient.Get(ctx, client.ObjectKeyFromObject(snapA1), snapA1)
+			Expect(kerrors.IsNotFound(err)).To(BeTrue())
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(snapA2), snapA2)).To(Succeed())
+		})
+
+		It("Should prune the snapshots by age", func() {
+			retention := &volsyncv1alpha1.ReplicationSourceIntermediateRetentionSpec{
+				Duration: &metav1.Duration{Duration: time.Hour},
+			}
+			Expect(utils.RetainIntermediateSnapshots(ctx, k8sClient, logger, rdA, retention)).To(Succeed())
+
+			expiry, err := utils.PruneIntermediateSnapshots(ctx, k8sClient, logger, rdA, retention, time.Now())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(expiry).To(BeNumerically(">", 0))
+			Expect(expiry).To(BeNumerically("<=", time.Hour))
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(snapA1), snapA1)).To(Succeed())
+
+			_, err = utils.PruneIntermediateSnapshots(ctx, k8sClient, logger, rdA, retention,
+				time.Now().Add(2*time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+			snapList := &snapv1.VolumeSnapshotList{}
+			Expect(k8sClient.List(ctx, snapList, client.InNamespace(testNamespace.GetName()))).To(Succeed())
+			Expect(snapList.Items).To(HaveLen(1)) // only snapB1 should be left
+		})
+
+		It("Should remove all of them once the retention is removed", func() {
+			retention := &volsyncv1alpha1.ReplicationSourceIntermediateRetentionSpec{Count: ptr.To[int32](5)}
+			Expect(utils.RetainIntermediateSnapshots(ctx, k8sClient, logger, rdA, retention)).To(Succeed())
+
+			// Protected snapshots are released rather than deleted
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(snapA1), snapA1)).To(Succeed())
+			utils.MarkDoNotDelete(snapA1)
+			Expect(k8sClient.Update(ctx, snapA1)).To(Succeed())
+
+			_, err := utils.PruneIntermediateSnapshots(ctx, k8sClient, logger, rdA, nil, time.Now())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(snapA1), snapA1)).To(Succeed())
+			Expect(snapA1.GetLabels()).NotTo(HaveKey(utils.IntermediateSnapshotLabelKey))
+			err = k8sClient.Get(ctx, client.ObjectKeyFromObject(snapA2), snapA2)
+			Expect(kerrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
 })
 
 // This assumes there was only 1 owner ref at the start
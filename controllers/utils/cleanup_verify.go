@@ -0,0 +1,185 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+const (
+	// Objects that are being deleted are only reported once the deletion has
+	// been pending for this long
+	cleanupVerifyGracePeriod = time.Minute
+	// Backoff between attempts to delete objects that were left over
+	cleanupRetryInitialDelay = time.Minute
+	cleanupRetryMaxDelay     = time.Hour
+)
+
+type leftoverObject struct {
+	kind string
+	obj  client.Object
+}
+
+// VerifyCleanup looks for objects that are still marked for cleanup by owner
+// once a synchronization has been cleaned up. Leftover objects that are not
+// being deleted are deleted again, with an exponential backoff, and all
+// leftover objects are returned as warnings. The returned duration is the time
+// until the leftovers should be checked again, or zero if there are none.
+func VerifyCleanup(ctx context.Context, c client.Client, logger logr.Logger, owner client.Object,
+	previous []volsyncv1alpha1.CleanupWarning) ([]volsyncv1alpha1.CleanupWarning, time.Duration, error) {
+	leftovers, err := listMarkedForCleanup(ctx, c, owner)
+	if err != nil {
+		return previous, 0, err
+	}
+
+	prev := map[string]volsyncv1alpha1.CleanupWarning{}
+	for _, w := range previous {
+		prev[w.Kind+"/"+w.Name] = w
+	}
+
+	now := time.Now()
+	warnings := []volsyncv1alpha1.CleanupWarning{}
+	var recheckAfter time.Duration
+	for _, l := range leftovers {
+		w, found := prev[l.kind+"/"+l.obj.GetName()]
+		if !found {
+			w = volsyncv1alpha1.CleanupWarning{Kind: l.kind, Name: l.obj.GetName()}
+		}
+
+		var next time.Duration
+		if deletedAt := l.obj.GetDeletionTimestamp(); !deletedAt.IsZero() {
+			if deletedAt.Add(cleanupVerifyGracePeriod).After(now) {
+				// Give the deletion a chance to complete
+				recheckAfter = minNonZero(recheckAfter, deletedAt.Add(cleanupVerifyGracePeriod).Sub(now))
+				continue
+			}
+			// Deleting again won't help, but keep an eye on it
+			w.Reason = deletionBlockedReason(l.obj)
+			next = cleanupRetryMaxDelay
+		} else {
+			nextAttempt := now
+			if w.LastAttempt != nil {
+				nextAttempt = w.LastAttempt.Add(cleanupRetryDelay(w.Attempts))
+			}
+			if !nextAttempt.After(now) {
+				logger.Info("retrying deletion of object left over from cleanup",
+					"kind", l.kind, "name", l.obj.GetName(), "attempt", w.Attempts+1)
+				err := c.Delete(ctx, l.obj, client.PropagationPolicy(metav1.DeletePropagationBackground))
+				if client.IgnoreNotFound(err) != nil {
+					logger.Error(err, "unable to delete object left over from cleanup",
+						"kind", l.kind, "name", l.obj.GetName())
+				}
+				w.Attempts++
+				w.LastAttempt = &metav1.Time{Time: now}
+				nextAttempt = now.Add(cleanupRetryDelay(w.Attempts))
+			}
+			w.Reason = fmt.Sprintf("still present after cleanup; deletion retried %d time(s)", w.Attempts)
+			next = nextAttempt.Sub(now)
+		}
+		recheckAfter = minNonZero(recheckAfter, next)
+		warnings = append(warnings, w)
+	}
+
+	if len(warnings) > 0 {
+		logger.Info("objects were left over after cleanup", "count", len(warnings))
+	}
+	return warnings, recheckAfter, nil
+}
+
+// Returns the objects that are still marked for cleanup by owner. Snapshots
+// that are intentionally being kept (i.e., in use by others) are skipped.
+func listMarkedForCleanup(ctx context.Context, c client.Client, owner client.Object) ([]leftoverObject, error) {
+	listOptions := []client.ListOption{
+		client.MatchingLabels{cleanupLabelKey: string(owner.GetUID())},
+		client.InNamespace(owner.GetNamespace()),
+	}
+	leftovers := []leftoverObject{}
+
+	pvcList := &corev1.PersistentVolumeClaimList{}
+	if err := c.List(ctx, pvcList, listOptions...); err != nil {
+		return nil, err
+	}
+	for i := range pvcList.Items {
+		leftovers = append(leftovers, leftoverObject{kind: "PersistentVolumeClaim", obj: &pvcList.Items[i]})
+	}
+
+	snapList := &snapv1.VolumeSnapshotList{}
+	if err := c.List(ctx, snapList, listOptions...); err != nil {
+		return nil, err
+	}
+	for i := range snapList.Items {
+		snap := &snapList.Items[i]
+		if IsMarkedDoNotDelete(snap) || snapInUseByOther(snap, owner) {
+			continue
+		}
+		leftovers = append(leftovers, leftoverObject{kind: "VolumeSnapshot", obj: snap})
+	}
+
+	jobList := &batchv1.JobList{}
+	if err := c.List(ctx, jobList, listOptions...); err != nil {
+		return nil, err
+	}
+	for i := range jobList.Items {
+		leftovers = append(leftovers, leftoverObject{kind: "Job", obj: &jobList.Items[i]})
+	}
+
+	return leftovers, nil
+}
+
+func deletionBlockedReason(obj client.Object) string {
+	reason := "deletion pending"
+	if finalizers := obj.GetFinalizers(); len(finalizers) > 0 {
+		reason = "deletion blocked by finalizers: " + strings.Join(finalizers, ", ")
+	}
+	if snap, ok := obj.(*snapv1.VolumeSnapshot); ok && snap.Status != nil &&
+		snap.Status.Error != nil && snap.Status.Error.Message != nil {
+		reason += "; snapshot error: " + *snap.Status.Error.Message
+	}
+	return reason
+}
+
+// Exponential backoff between deletion attempts
+func cleanupRetryDelay(attempts int32) time.Duration {
+	delay := cleanupRetryInitialDelay
+	for i := int32(1); i < attempts && delay < cleanupRetryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > cleanupRetryMaxDelay {
+		delay = cleanupRetryMaxDelay
+	}
+	return delay
+}
+
+func minNonZero(a, b time.Duration) time.Duration {
+	if a == 0 || (b > 0 && b < a) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+// ConnectionTestLinePrefix starts the line in the mover logs that reports the
+// time taken to access the repository:
+// VOLSYNC_CONNECTION_TEST=<milliseconds>
+const ConnectionTestLinePrefix = "VOLSYNC_CONNECTION_TEST="
+
+// ConnectionTestCollector picks the latency of a connection test out of the
+// mover logs
+type ConnectionTestCollector struct {
+	latency *metav1.Duration
+}
+
+// Filter wraps a log line filter, capturing the latency while passing
+// everything through to the wrapped filter
+func (c *ConnectionTestCollector) Filter(next func(string) *string) func(string) *string {
+	return func(line string) *string {
+		if strings.HasPrefix(line, ConnectionTestLinePrefix) {
+			ms, err := strconv.ParseInt(strings.TrimSpace(strings.TrimPrefix(line, ConnectionTestLinePrefix)), 10, 64)
+			if err == nil && ms >= 0 {
+				c.latency = &metav1.Duration{Duration: time.Duration(ms) * time.Millisecond}
+			}
+		}
+		return next(line)
+	}
+}
+
+// Latency returns the latency reported by the mover, or nil if none was
+// reported
+func (c *ConnectionTestCollector) Latency() *metav1.Duration {
+	return c.latency
+}
+
+// connectionTestStatusFor returns the connection test status field of a
+// ReplicationSource or ReplicationDestination, or nil if it has no status yet
+func connectionTestStatusFor(owner client.Object) **volsyncv1alpha1.ConnectionTestStatus {
+	switch o := owner.(type) {
+	case *volsyncv1alpha1.ReplicationSource:
+		if o.Status != nil {
+			return &o.Status.ConnectionTest
+		}
+	case *volsyncv1alpha1.ReplicationDestination:
+		if o.Status != nil {
+			return &o.Status.ConnectionTest
+		}
+	}
+	return nil
+}
+
+// ConnectionTestPending returns true if the connectionTest value from the
+// mover spec has not been tested yet
+func ConnectionTestPending(owner client.Object, trigger string) bool {
+	if trigger == "" {
+		return false
+	}
+	status := connectionTestStatusFor(owner)
+	return status != nil && (*status == nil || (*status).Trigger != trigger)
+}
+
+// RecordConnectionTest records the result of the finished connection test Job
+// in the status of the owner and emits an event for it. The logs of the Job
+// are passed through logLineFilter, with the redactValues masked.
+func RecordConnectionTest(ctx context.Context, logger logr.Logger, recorder events.EventRecorder,
+	owner client.Object, job *batchv1.Job, trigger string, logLineFilter func(string) *string,
+	redactValues ...string) {
+	status := connectionTestStatusFor(owner)
+	if status == nil {
+		return
+	}
+	failed := job.Status.Succeeded == 0
+	collector := &ConnectionTestCollector{}
+	moverStatus := &volsyncv1alpha1.MoverStatus{}
+	updateMoverStatusForJob(ctx, logger, moverStatus, job.GetName(), job.GetNamespace(), failed,
+		collector.Filter(logLineFilter), redactValues)
+
+	result := &volsyncv1alpha1.ConnectionTestStatus{
+		Trigger:   trigger,
+		Succeeded: !failed,
+		Latency:   collector.Latency(),
+		Time:      ptr.To(metav1.Now()),
+		Message:   strings.TrimSpace(moverStatus.Logs),
+	}
+	eventType, reason := corev1.EventTypeNormal, volsyncv1alpha1.EvRConnectionTestSucceeded
+	if failed {
+		eventType, reason = corev1.EventTypeWarning, volsyncv1alpha1.EvRConnectionTestFailed
+		if result.Message == "" {
+			result.Message = "unable to access the repository"
+		}
+	} else if result.Message == "" {
+		result.Message = "the repository is accessible"
+	}
+	*status = result
+	logger.Info("connection test completed", "succeeded", result.Succeeded, "latency", result.Latency)
+	if recorder != nil {
+		recorder.Eventf(owner, job, eventType, reason, volsyncv1alpha1.EvANone,
+			"connection test %s: %s", trigger, TruncateString(result.Message, 512))
+	}
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("Connection tests", func() {
+	It("are pending until the trigger has been tested", func() {
+		rs := &volsyncv1alpha1.ReplicationSource{Status: &volsyncv1alpha1.ReplicationSourceStatus{}}
+		Expect(utils.ConnectionTestPending(rs, "")).To(BeFalse())
+		Expect(utils.ConnectionTestPending(rs, "t1")).To(BeTrue())
+		rs.Status.ConnectionTest = &volsyncv1alpha1.ConnectionTestStatus{Trigger: "t1"}
+		Expect(utils.ConnectionTestPending(rs, "t1")).To(BeFalse())
+		Expect(utils.ConnectionTestPending(rs, "t2")).To(BeTrue())
+	})
+
+	It("are not run without a status to record them in", func() {
+		rd := &volsyncv1alpha1.ReplicationDestination{}
+		Expect(utils.ConnectionTestPending(rd, "t1")).To(BeFalse())
+	})
+
+	It("record the latency reported by the mover", func() {
+		c := &utils.ConnectionTestCollector{}
+		filter := c.Filter(utils.AllLines)
+		Expect(*filter("VOLSYNC_CONNECTION_TEST=250")).To(Equal("VOLSYNC_CONNECTION_TEST=250"))
+		Expect(c.Latency()).NotTo(BeNil())
+		Expect(c.Latency().Duration).To(Equal(250 * time.Millisecond))
+	})
+
+	It("ignore malformed latencies", func() {
+		c := &utils.ConnectionTestCollector{}
+		filter := c.Filter(utils.AllLines)
+		filter("VOLSYNC_CONNECTION_TEST=fast")
+		filter("VOLSYNC_CONNECTION_TEST=-5")
+		Expect(c.Latency()).To(BeNil())
+	})
+})
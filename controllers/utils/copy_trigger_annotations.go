@@ -47,7 +47,7 @@ func GetLatestCopyTriggerWaitingSinceValue(pvc *corev1.PersistentVolumeClaim) st
 // Returns true if the pvc was updated
 func SetLatestCopyTriggerWaitingSinceValueNow(pvc *corev1.PersistentVolumeClaim) bool {
 	return setAnnotation(pvc,
-		volsyncv1alpha1.LatestCopyTriggerWaitingSinceAnnotation, time.Now().UTC().Format(time.RFC3339))
+		volsyncv1alpha1.LatestCopyTriggerWaitingSinceAnnotation, FormatTime(time.Now()))
 }
 
 // Returns true if the pvc was updated
@@ -17,6 +17,8 @@ import (
 type CustomCAObject interface {
 	// path should be the relative path to filename (key contents will get projected here)
 	GetVolumeSource(path string) corev1.VolumeSource
+	// GetCAData returns the contents of the CA bundle
+	GetCAData() []byte
 }
 
 type CustomCAObjectSecret struct {
@@ -54,6 +56,14 @@ func (c *CustomCAObjectConfigMap) GetVolumeSource(path string) corev1.VolumeSour
 	}
 }
 
+func (c *CustomCAObjectSecret) GetCAData() []byte {
+	return c.secret.Data[c.key]
+}
+
+func (c *CustomCAObjectConfigMap) GetCAData() []byte {
+	return []byte(c.configMap.Data[c.key])
+}
+
 func ValidateCustomCA(ctx context.Context, cl client.Client, l logr.Logger,
 	namespace string, customCA volsyncv1alpha1.CustomCASpec) (CustomCAObject, error) {
 	if customCA.Key == "" {
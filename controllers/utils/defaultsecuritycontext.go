@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// DefaultMoverSecurityContext is the pod securityContext that is applied to
+// the unprivileged movers of ReplicationSources and ReplicationDestinations
+// that do not set moverSecurityContext. It is either JSON (or YAML) or the
+// path of a file containing it.
+var DefaultMoverSecurityContext string
+
+// defaultMoverSecurityContext is the parsed DefaultMoverSecurityContext
+var defaultMoverSecurityContext *corev1.PodSecurityContext
+
+// LoadDefaultMoverSecurityContext parses DefaultMoverSecurityContext. It must
+// be called once at startup.
+func LoadDefaultMoverSecurityContext() error {
+	value := strings.TrimSpace(DefaultMoverSecurityContext)
+	if value == "" {
+		defaultMoverSecurityContext = nil
+		return nil
+	}
+	if !strings.HasPrefix(value, "{") {
+		data, err := os.ReadFile(value)
+		if err != nil {
+			return fmt.Errorf("unable to read default mover security context: %w", err)
+		}
+		value = string(data)
+	}
+	podSC := &corev1.PodSecurityContext{}
+	if err := yaml.UnmarshalStrict([]byte(value), podSC); err != nil {
+		return fmt.Errorf("invalid default mover security context: %w", err)
+	}
+	defaultMoverSecurityContext = podSC
+	return nil
+}
+
+// DefaultMoverSecurityContextFor returns a copy of the default mover
+// securityContext, or nil if there is none. Movers that run as root do not
+// get the default since it may require a non-root user.
+func DefaultMoverSecurityContextFor(runsAsRoot bool) *corev1.PodSecurityContext {
+	if runsAsRoot {
+		return nil
+	}
+	return defaultMoverSecurityContext.DeepCopy()
+}
+
+// podRunsAsRoot returns true if a container of the pod has been set up to
+// run as root (e.g., a privileged mover)
+func podRunsAsRoot(podSpec *corev1.PodSpec) bool {
+	for _, c := range podSpec.Containers {
+		if c.SecurityContext != nil && c.SecurityContext.RunAsUser != nil && *c.SecurityContext.RunAsUser == 0 {
+			return true
+		}
+	}
+	return false
+}
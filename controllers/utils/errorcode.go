@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	"regexp"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+// ErrorCodePattern maps the mover log lines matching Regex to an error code
+type ErrorCodePattern struct {
+	Code  volsyncv1alpha1.MoverErrorCode
+	Regex *regexp.Regexp
+}
+
+// commonErrorCodePatterns recognize failures that look the same for all
+// movers. They are checked after the mover-specific patterns.
+var commonErrorCodePatterns = []ErrorCodePattern{
+	{
+		Code:  volsyncv1alpha1.MoverErrorCodeNoSpace,
+		Regex: regexp.MustCompile(`(?i)(no space left on device|disk quota exceeded|ENOSPC)`),
+	},
+	{
+		Code:  volsyncv1alpha1.MoverErrorCodePermissionDenied,
+		Regex: regexp.MustCompile(`(?i)(permission denied|operation not permitted)`),
+	},
+	{
+		Code: volsyncv1alpha1.MoverErrorCodeNetworkTimeout,
+		Regex: regexp.MustCompile(
+			`(?i)(i/o timeout|connection timed out|deadline exceeded|handshake timeout|timeout in data)`),
+	},
+	{
+		Code: volsyncv1alpha1.MoverErrorCodeConnectionFailed,
+		Regex: regexp.MustCompile(
+			`(?i)(connection refused|no route to host|network is unreachable|no such host|connection reset)`),
+	},
+}
+
+// ErrorCodeCollector classifies the reason a mover failed from its logs. The
+// Patterns of the mover are checked in order, followed by the patterns that
+// are common to all movers. The first pattern in that order that matches any
+// line wins.
+type ErrorCodeCollector struct {
+	Patterns []ErrorCodePattern
+	code     volsyncv1alpha1.MoverErrorCode
+	priority int
+}
+
+// Filter wraps a log line filter, matching each line against the error code
+// patterns while passing everything through to the wrapped filter
+func (e *ErrorCodeCollector) Filter(next func(string) *string) func(string) *string {
+	return func(line string) *string {
+		if e.code == "" {
+			e.priority = len(e.Patterns) + len(commonErrorCodePatterns)
+		}
+		for i := 0; i < e.priority; i++ {
+			if e.patternAt(i).Regex.MatchString(line) {
+				e.code = e.patternAt(i).Code
+				e.priority = i
+				break
+			}
+		}
+		return next(line)
+	}
+}
+
+func (e *ErrorCodeCollector) patternAt(i int) ErrorCodePattern {
+	if i < len(e.Patterns) {
+		return e.Patterns[i]
+	}
+	return commonErrorCodePatterns[i-len(e.Patterns)]
+}
+
+// Apply records the error code in the mover status. Failures that did not
+// match any pattern are Unknown.
+func (e *ErrorCodeCollector) Apply(moverStatus *volsyncv1alpha1.MoverStatus) {
+	if moverStatus.Result != volsyncv1alpha1.MoverResultFailed {
+		moverStatus.ErrorCode = ""
+		return
+	}
+	moverStatus.ErrorCode = e.code
+	if e.code == "" {
+		moverStatus.ErrorCode = volsyncv1alpha1.MoverErrorCodeUnknown
+	}
+}
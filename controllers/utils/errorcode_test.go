@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils_test
+
+import (
+	"regexp"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("ErrorCodeCollector", func() {
+	var e *utils.ErrorCodeCollector
+	var filter func(string) *string
+	var ms *volsyncv1alpha1.MoverStatus
+
+	BeforeEach(func() {
+		e = &utils.ErrorCodeCollector{
+			Patterns: []utils.ErrorCodePattern{{
+				Code:  volsyncv1alpha1.MoverErrorCodeRepoLocked,
+				Regex: regexp.MustCompile(`repository is already locked`),
+			}},
+		}
+		filter = e.Filter(utils.AllLines)
+		ms = &volsyncv1alpha1.MoverStatus{
+			Result:    volsyncv1alpha1.MoverResultFailed,
+			ErrorCode: volsyncv1alpha1.MoverErrorCodeNoSpace,
+		}
+	})
+
+	It("passes the lines through", func() {
+		line := "Fatal: unable to save snapshot"
+		Expect(filter(line)).To(Equal(&line))
+	})
+
+	It("classifies failures common to all movers", func() {
+		filter("rsync: write failed on \"/data/file\": No space left on device (28)")
+		e.Apply(ms)
+		Expect(ms.ErrorCode).To(Equal(volsyncv1alpha1.MoverErrorCodeNoSpace))
+	})
+
+	It("prefers the patterns of the mover", func() {
+		filter("dial tcp 10.0.0.1:443: i/o timeout")
+		filter("Fatal: unable to create lock in backend: repository is already locked by PID 1")
+		e.Apply(ms)
+		Expect(ms.ErrorCode).To(Equal(volsyncv1alpha1.MoverErrorCodeRepoLocked))
+	})
+
+	It("keeps the highest priority match", func() {
+		filter("Fatal: unable to create lock in backend: repository is already locked by PID 1")
+		filter("dial tcp 10.0.0.1:443: i/o timeout")
+		e.Apply(ms)
+		Expect(ms.ErrorCode).To(Equal(volsyncv1alpha1.MoverErrorCodeRepoLocked))
+	})
+
+	It("reports unrecognized failures as Unknown", func() {
+		filter("something unexpected happened")
+		e.Apply(ms)
+		Expect(ms.ErrorCode).To(Equal(volsyncv1alpha1.MoverErrorCodeUnknown))
+	})
+
+	It("clears the error code of a successful mover", func() {
+		ms.Result = volsyncv1alpha1.MoverResultSuccessful
+		filter("dial tcp 10.0.0.1:443: i/o timeout")
+		e.Apply(ms)
+		Expect(ms.ErrorCode).To(BeEmpty())
+	})
+})
@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+const (
+	// FailedPathLinePrefix starts the lines in the mover logs that list the
+	// paths that could not be copied: VOLSYNC_FAILED_PATH=<path>
+	FailedPathLinePrefix = "VOLSYNC_FAILED_PATH="
+	// ErrorSummaryLinePrefix starts the line in the mover logs that summarizes
+	// the errors: VOLSYNC_ERRORS=<failed> <total> <Tolerated|Exceeded>
+	ErrorSummaryLinePrefix = "VOLSYNC_ERRORS="
+
+	errorSummaryTolerated = "Tolerated"
+)
+
+// ErrorPolicyEnvVars returns the environment variables that pass the error
+// policy to the mover
+func ErrorPolicyEnvVars(policy *volsyncv1alpha1.ErrorPolicy) []corev1.EnvVar {
+	mode := volsyncv1alpha1.ErrorPolicyFailFast
+	maxErrors := ""
+	maxErrorPercent := ""
+	if policy != nil {
+		if policy.Mode != "" {
+			mode = policy.Mode
+		}
+		if policy.MaxErrors != nil {
+			maxErrors = strconv.Itoa(int(*policy.MaxErrors))
+		}
+		if policy.MaxErrorPercent != nil {
+			maxErrorPercent = strconv.Itoa(int(*policy.MaxErrorPercent))
+		}
+	}
+	return []corev1.EnvVar{
+		{Name: "ERROR_POLICY", Value: string(mode)},
+		{Name: "MAX_ERRORS", Value: maxErrors},
+		{Name: "MAX_ERROR_PERCENT", Value: maxErrorPercent},
+	}
+}
+
+// PartialCompletionCollector picks the paths that could not be copied out of
+// the mover logs
+type PartialCompletionCollector struct {
+	failedPaths []string
+	failedCount int32
+	tolerated   bool
+}
+
+// Filter wraps a log line filter, capturing the failed paths while passing
+// everything through to the wrapped filter
+func (p *PartialCompletionCollector) Filter(next func(string) *string) func(string) *string {
+	return func(line string) *string {
+		switch {
+		case strings.HasPrefix(line, FailedPathLinePrefix):
+			if len(p.failedPaths) < volsyncv1alpha1.MaxFailedPaths {
+				p.failedPaths = append(p.failedPaths, strings.TrimPrefix(line, FailedPathLinePrefix))
+			}
+		case strings.HasPrefix(line, ErrorSummaryLinePrefix):
+			fields := strings.Fields(strings.TrimPrefix(line, ErrorSummaryLinePrefix))
+			if len(fields) == 3 {
+				if count, err := strconv.ParseInt(fields[0], 10, 32); err == nil {
+					p.failedCount = int32(count)
+				}
+				p.tolerated = fields[2] == errorSummaryTolerated
+			}
+		}
+		return next(line)
+	}
+}
+
+// Apply records the failed paths in the mover status. A successful result
+// becomes PartiallyCompleted if the mover tolerated failed paths.
+func (p *PartialCompletionCollector) Apply(moverStatus *volsyncv1alpha1.MoverStatus) {
+	failedCount := p.failedCount
+	if failedCount < int32(len(p.failedPaths)) {
+		failedCount = int32(len(p.failedPaths))
+	}
+	moverStatus.FailedPathCount = failedCount
+	moverStatus.FailedPaths = p.failedPaths
+	if p.tolerated && failedCount > 0 && moverStatus.Result == volsyncv1alpha1.MoverResultSuccessful {
+		moverStatus.Result = volsyncv1alpha1.MoverResultPartiallyCompleted
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("Error policy", func() {
+	Describe("ErrorPolicyEnvVars", func() {
+		It("defaults to failing fast", func() {
+			Expect(utils.ErrorPolicyEnvVars(nil)).To(ConsistOf(
+				corev1.EnvVar{Name: "ERROR_POLICY", Value: "FailFast"},
+				corev1.EnvVar{Name: "MAX_ERRORS", Value: ""},
+				corev1.EnvVar{Name: "MAX_ERROR_PERCENT", Value: ""},
+			))
+		})
+
+		It("passes the error budget", func() {
+			policy := &volsyncv1alpha1.ErrorPolicy{
+				Mode:            volsyncv1alpha1.ErrorPolicyTolerate,
+				MaxErrors:       ptr.To[int32](10),
+				MaxErrorPercent: ptr.To[int32](5),
+			}
+			Expect(utils.ErrorPolicyEnvVars(policy)).To(ConsistOf(
+				corev1.EnvVar{Name: "ERROR_POLICY", Value: "Tolerate"},
+				corev1.EnvVar{Name: "MAX_ERRORS", Value: "10"},
+				corev1.EnvVar{Name: "MAX_ERROR_PERCENT", Value: "5"},
+			))
+		})
+	})
+
+	Describe("PartialCompletionCollector", func() {
+		var p *utils.PartialCompletionCollector
+		var filter func(string) *string
+		var ms *volsyncv1alpha1.MoverStatus
+
+		BeforeEach(func() {
+			p = &utils.PartialCompletionCollector{}
+			filter = p.Filter(utils.AllLines)
+			ms = &volsyncv1alpha1.MoverStatus{
+				Result:      volsyncv1alpha1.MoverResultSuccessful,
+				FailedPaths: []string{"stale"},
+			}
+		})
+
+		It("clears the failed paths when there were no errors", func() {
+			filter("Synchronization completed successfully.")
+			p.Apply(ms)
+			Expect(ms.Result).To(Equal(volsyncv1alpha1.MoverResultSuccessful))
+			Expect(ms.FailedPaths).To(BeEmpty())
+			Expect(ms.FailedPathCount).To(BeZero())
+		})
+
+		It("marks tolerated errors as partially completed", func() {
+			filter("VOLSYNC_FAILED_PATH=dir/a")
+			filter("VOLSYNC_FAILED_PATH=dir/b")
+			filter("VOLSYNC_ERRORS=2 100 Tolerated")
+			p.Apply(ms)
+			Expect(ms.Result).To(Equal(volsyncv1alpha1.MoverResultPartiallyCompleted))
+			Expect(ms.FailedPaths).To(Equal([]string{"dir/a", "dir/b"}))
+			Expect(ms.FailedPathCount).To(Equal(int32(2)))
+		})
+
+		It("lists the failed paths of a failed job", func() {
+			ms.Result = volsyncv1alpha1.MoverResultFailed
+			filter("VOLSYNC_FAILED_PATH=dir/a")
+			filter("VOLSYNC_ERRORS=1 1 Exceeded")
+			p.Apply(ms)
+			Expect(ms.Result).To(Equal(volsyncv1alpha1.MoverResultFailed))
+			Expect(ms.FailedPaths).To(Equal([]string{"dir/a"}))
+		})
+
+		It("limits the number of listed paths", func() {
+			for i := range volsyncv1alpha1.MaxFailedPaths + 10 {
+				filter(fmt.Sprintf("VOLSYNC_FAILED_PATH=file-%d", i))
+			}
+			filter(fmt.Sprintf("VOLSYNC_ERRORS=%d 1000 Tolerated", volsyncv1alpha1.MaxFailedPaths+25))
+			p.Apply(ms)
+			Expect(ms.FailedPaths).To(HaveLen(volsyncv1alpha1.MaxFailedPaths))
+			Expect(ms.FailedPathCount).To(Equal(int32(volsyncv1alpha1.MaxFailedPaths + 25)))
+		})
+	})
+})
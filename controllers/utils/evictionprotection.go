@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// SafeToEvictAnnotation tells the cluster autoscaler whether a pod may be
+	// evicted when scaling down its node
+	SafeToEvictAnnotation = "cluster-autoscaler.kubernetes.io/safe-to-evict"
+	// jobNameLabel is set by Kubernetes on the pods of a Job
+	jobNameLabel = "batch.kubernetes.io/job-name"
+)
+
+// setEvictionProtection annotates the mover pods as not safe to evict for the
+// cluster autoscaler
+func setEvictionProtection(podTemplateSpec *corev1.PodTemplateSpec, protect *bool) {
+	if protect == nil || !*protect {
+		return
+	}
+	if podTemplateSpec.Annotations == nil {
+		podTemplateSpec.Annotations = map[string]string{}
+	}
+	podTemplateSpec.Annotations[SafeToEvictAnnotation] = "false"
+}
+
+// EnsureEvictionProtection creates a PodDisruptionBudget that keeps the pods
+// of the mover Job from being evicted when protect is set, and removes it
+// otherwise. The PodDisruptionBudget is owned by the Job, so it is removed
+// along with it.
+func EnsureEvictionProtection(ctx context.Context, c client.Client, logger logr.Logger,
+	job *batchv1.Job, protect *bool) error {
+	pdb := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      job.Name,
+			Namespace: job.Namespace,
+		},
+	}
+	if protect == nil || !*protect {
+		if err := c.Get(ctx, client.ObjectKeyFromObject(pdb), pdb); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		if !metav1.IsControlledBy(pdb, job) {
+			return nil
+		}
+		logger.Info("removing PodDisruptionBudget of mover Job")
+		return client.IgnoreNotFound(c.Delete(ctx, pdb))
+	}
+
+	_, err := ctrlutil.CreateOrUpdate(ctx, c, pdb, func() error {
+		if err := ctrl.SetControllerReference(job, pdb, c.Scheme()); err != nil {
+			logger.Error(err, ErrUnableToSetControllerRef)
+			return err
+		}
+		SetOwnedByVolSync(pdb)
+		maxUnavailable := intstr.FromInt32(0)
+		pdb.Spec.MaxUnavailable = &maxUnavailable
+		pdb.Spec.MinAvailable = nil
+		pdb.Spec.Selector = &metav1.LabelSelector{
+			MatchLabels: map[string]string{jobNameLabel: job.Name},
+		}
+		return nil
+	})
+	return err
+}
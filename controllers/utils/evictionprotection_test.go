@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("Eviction protection", func() {
+	logger := zap.New(zap.UseDevMode(true), zap.WriteTo(GinkgoWriter))
+
+	It("annotates the mover pods for the cluster autoscaler", func() {
+		template := &corev1.PodTemplateSpec{}
+		utils.UpdatePodTemplateSpecFromMoverConfig(template, volsyncv1alpha1.MoverConfig{},
+			corev1.ResourceRequirements{})
+		Expect(template.Annotations).NotTo(HaveKey(utils.SafeToEvictAnnotation))
+
+		utils.UpdatePodTemplateSpecFromMoverConfig(template,
+			volsyncv1alpha1.MoverConfig{ProtectFromEviction: ptr.To(true)}, corev1.ResourceRequirements{})
+		Expect(template.Annotations).To(HaveKeyWithValue(utils.SafeToEvictAnnotation, "false"))
+	})
+
+	Describe("EnsureEvictionProtection", func() {
+		var ns *corev1.Namespace
+		var job *batchv1.Job
+
+		BeforeEach(func() {
+			ns = &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{GenerateName: "ns-eviction-"},
+			}
+			Expect(k8sClient.Create(ctx, ns)).To(Succeed())
+			job = &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{Name: "mover", Namespace: ns.Name},
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							RestartPolicy: corev1.RestartPolicyNever,
+							Containers:    []corev1.Container{{Name: "c", Image: "mover"}},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, job)).To(Succeed())
+		})
+		AfterEach(func() {
+			Expect(k8sClient.Delete(ctx, ns)).To(Succeed())
+		})
+
+		It("creates a PodDisruptionBudget for the Job and removes it again", func() {
+			Expect(utils.EnsureEvictionProtection(ctx, k8sClient, logger, job, ptr.To(true))).To(Succeed())
+			pdb := &policyv1.PodDisruptionBudget{}
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(job), pdb)).To(Succeed())
+			Expect(pdb.Spec.MaxUnavailable.IntValue()).To(BeZero())
+			Expect(pdb.Spec.Selector.MatchLabels).To(HaveKeyWithValue("batch.kubernetes.io/job-name", job.Name))
+			Expect(metav1.IsControlledBy(pdb, job)).To(BeTrue())
+
+			Expect(utils.EnsureEvictionProtection(ctx, k8sClient, logger, job, nil)).To(Succeed())
+			err := k8sClient.Get(ctx, client.ObjectKeyFromObject(job), pdb)
+			Expect(kerrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("does not create a PodDisruptionBudget by default", func() {
+			Expect(utils.EnsureEvictionProtection(ctx, k8sClient, logger, job, ptr.To(false))).To(Succeed())
+			err := k8sClient.Get(ctx, client.ObjectKeyFromObject(job), &policyv1.PodDisruptionBudget{})
+			Expect(kerrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
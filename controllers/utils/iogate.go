@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+const (
+	// Key in the ioGate authSecret that holds the bearer token
+	IOGateTokenKey = "token"
+
+	ioGateQueryTimeout = 30 * time.Second
+)
+
+// ioGateQueryData holds the fields available to the ioGate query template
+type ioGateQueryData struct {
+	Namespace             string
+	PersistentVolumeClaim string
+}
+
+// prometheusResponse is the part of the response of the Prometheus
+// /api/v1/query endpoint that is used
+type prometheusResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+// QueryIOActivity evaluates the Prometheus query of spec for the PVC pvcName.
+// It returns the largest value of the result, or 0 if the result is empty.
+func QueryIOActivity(ctx context.Context, c client.Client, namespace string, pvcName string,
+	spec *volsyncv1alpha1.IOGatePrometheusSpec) (float64, error) {
+	query, err := renderIOGateQuery(spec.Query, ioGateQueryData{
+		Namespace:             namespace,
+		PersistentVolumeClaim: pvcName,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	token := ""
+	if spec.AuthSecret != nil {
+		secret := &corev1.Secret{}
+		err := c.Get(ctx, types.NamespacedName{Name: *spec.AuthSecret, Namespace: namespace}, secret)
+		if err != nil {
+			return 0, fmt.Errorf("unable to get ioGate authSecret: %w", err)
+		}
+		token = string(secret.Data[IOGateTokenKey])
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ioGateQueryTimeout)
+	defer cancel()
+	endpoint := strings.TrimSuffix(spec.URL, "/") + "/api/v1/query?" + url.Values{"query": {query}}.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return 0, err
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	pr := prometheusResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(&pr); err != nil {
+		return 0, fmt.Errorf("unable to parse Prometheus response (HTTP status %s): %w", resp.Status, err)
+	}
+	if pr.Status != "success" {
+		return 0, fmt.Errorf("prometheus query failed: %s", pr.Error)
+	}
+	return maxPrometheusValue(pr.Data.ResultType, pr.Data.Result)
+}
+
+func renderIOGateQuery(text string, data ioGateQueryData) (string, error) {
+	tmpl, err := template.New("query").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("invalid ioGate query: %w", err)
+	}
+	out := &strings.Builder{}
+	if err := tmpl.Execute(out, data); err != nil {
+		return "", fmt.Errorf("invalid ioGate query: %w", err)
+	}
+	return out.String(), nil
+}
+
+// maxPrometheusValue returns the largest value of a vector or scalar query
+// result
+func maxPrometheusValue(resultType string, result json.RawMessage) (float64, error) {
+	var samples [][]interface{}
+	switch resultType {
+	case "scalar":
+		var sample []interface{}
+		if err := json.Unmarshal(result, &sample); err != nil {
+			return 0, err
+		}
+		samples = append(samples, sample)
+	case "vector":
+		var series []struct {
+			Value []interface{} `json:"value"`
+		}
+		if err := json.Unmarshal(result, &series); err != nil {
+			return 0, err
+		}
+		for _, s := range series {
+			samples = append(samples, s.Value)
+		}
+	default:
+		return 0, fmt.Errorf("unsupported Prometheus result type %q, the query must return a vector or scalar",
+			resultType)
+	}
+
+	// IO activity is never negative, so 0 doubles as the value of an empty
+	// result. NaN samples are ignored.
+	maxValue := 0.0
+	for _, sample := range samples {
+		// Each sample is [<timestamp>, "<value>"]
+		if len(sample) != 2 {
+			return 0, errors.New("malformed Prometheus sample")
+		}
+		str, ok := sample[1].(string)
+		if !ok {
+			return 0, errors.New("malformed Prometheus sample")
+		}
+		v, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return 0, err
+		}
+		if v > maxValue {
+			maxValue = v
+		}
+	}
+	return maxValue, nil
+}
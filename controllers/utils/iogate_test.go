@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("IO gate queries", func() {
+	var server *httptest.Server
+	var response string
+	var lastQuery string
+	var lastAuth string
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Path).To(Equal("/api/v1/query"))
+			lastQuery = r.URL.Query().Get("query")
+			lastAuth = r.Header.Get("Authorization")
+			_, _ = w.Write([]byte(response))
+		}))
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	spec := func() *volsyncv1alpha1.IOGatePrometheusSpec {
+		return &volsyncv1alpha1.IOGatePrometheusSpec{
+			URL:   server.URL + "/",
+			Query: `rate(io_bytes{namespace="{{ .Namespace }}",pvc="{{ .PersistentVolumeClaim }}"}[5m])`,
+		}
+	}
+
+	It("returns the largest value of a vector", func() {
+		response = `{"status":"success","data":{"resultType":"vector","result":[` +
+			`{"metric":{"device":"a"},"value":[1700000000,"1024"]},` +
+			`{"metric":{"device":"b"},"value":[1700000000,"4096.5"]}]}}`
+		v, err := utils.QueryIOActivity(ctx, k8sClient, "ns", "data", spec())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(v).To(Equal(4096.5))
+		Expect(lastQuery).To(Equal(`rate(io_bytes{namespace="ns",pvc="data"}[5m])`))
+		Expect(lastAuth).To(BeEmpty())
+	})
+
+	It("treats an empty result as idle", func() {
+		response = `{"status":"success","data":{"resultType":"vector","result":[]}}`
+		v, err := utils.QueryIOActivity(ctx, k8sClient, "ns", "data", spec())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(v).To(BeZero())
+	})
+
+	It("accepts scalar results", func() {
+		response = `{"status":"success","data":{"resultType":"scalar","result":[1700000000,"12"]}}`
+		v, err := utils.QueryIOActivity(ctx, k8sClient, "ns", "data", spec())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(v).To(Equal(12.0))
+	})
+
+	It("returns the errors of the query", func() {
+		response = `{"status":"error","errorType":"bad_data","error":"parse error"}`
+		_, err := utils.QueryIOActivity(ctx, k8sClient, "ns", "data", spec())
+		Expect(err).To(MatchError(ContainSubstring("parse error")))
+
+		response = `{"status":"success","data":{"resultType":"matrix","result":[]}}`
+		_, err = utils.QueryIOActivity(ctx, k8sClient, "ns", "data", spec())
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("rejects unknown template fields", func() {
+		s := spec()
+		s.Query = `io_bytes{pvc="{{ .PVC }}"}`
+		_, err := utils.QueryIOActivity(ctx, k8sClient, "ns", "data", s)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("fails if the authSecret is missing", func() {
+		s := spec()
+		s.AuthSecret = ptr.To("does-not-exist")
+		_, err := utils.QueryIOActivity(ctx, k8sClient, "ns", "data", s)
+		Expect(err).To(HaveOccurred())
+	})
+})
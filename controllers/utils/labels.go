@@ -23,6 +23,23 @@ const (
 	DoNotDeleteLabelKey = VolsyncLabelPrefix + "/do-not-delete"
 	OwnedByLabelKey     = "app.kubernetes.io/created-by"
 	OwnedByLabelValue   = "volsync"
+	// Marks a VolumeSnapshot that is being retained as the copy point of a
+	// sync. The value is the UID of the owning object.
+	CopyPointSnapshotLabelKey = VolsyncLabelPrefix + "/copy-point-of"
+	// Marks a VolumeSnapshot of the source volume that is being retained after
+	// its sync according to spec.intermediateRetention. The value is the UID
+	// of the owning object.
+	IntermediateSnapshotLabelKey = VolsyncLabelPrefix + "/intermediate-of"
+	// Marks a user-provided PVC that has been adopted by a
+	// ReplicationDestination. The value is the name of the adopting object.
+	AdoptedByLabelKey = VolsyncLabelPrefix + "/adopted-by"
+	// Annotation on a ReplicationDestination created by the volume populator
+	// holding the identity (volsync-populator/<pvc uid>) that its mover uses
+	// when accessing the repository
+	PopulatorIdentityAnnotation = VolsyncLabelPrefix + "/populator-identity"
+	// Annotation on a PVC promoted from a ReplicationDestination (see
+	// spec.promote). The value is the name of the ReplicationDestination.
+	PromotedFromAnnotation = VolsyncLabelPrefix + "/promoted-from"
 
 	SnapInUseByVolumePopulatorLabelPrefix = VolsyncLabelPrefix + "/volpop-pvc-"
 )
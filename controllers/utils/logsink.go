@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/viper"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+const (
+	// MoverLogSinkTypeWebhook POSTs a JSON document describing the mover run
+	// (including the full log) to the sink URL
+	MoverLogSinkTypeWebhook = "webhook"
+	// MoverLogSinkTypeObject PUTs the full log as a plain text object under the
+	// sink URL, e.g. to a bucket of an S3-compatible object store
+	MoverLogSinkTypeObject = "object"
+
+	// Env var - token sent as a bearer token in the Authorization header of
+	// requests to the mover log sink
+	MoverLogSinkTokenEnvVar = "MOVER_LOG_SINK_TOKEN" // #nosec G101 - name of the env var, not a credential
+
+	moverLogSinkTimeout = 30 * time.Second
+)
+
+// MoverLogSinkURL is the endpoint where the full logs of mover pods are
+// shipped. Log shipping is disabled if this is empty.
+var MoverLogSinkURL string
+
+// MoverLogSinkType is the kind of endpoint given by MoverLogSinkURL
+var MoverLogSinkType = MoverLogSinkTypeWebhook
+
+// MoverLogRecord is the document sent to a webhook mover log sink
+type MoverLogRecord struct {
+	Namespace string                      `json:"namespace"`
+	Job       string                      `json:"job"`
+	Pod       string                      `json:"pod"`
+	Result    volsyncv1alpha1.MoverResult `json:"result"`
+	Time      time.Time                   `json:"time"`
+	Logs      string                      `json:"logs"`
+}
+
+// ValidateMoverLogSink checks the mover log sink configuration
+func ValidateMoverLogSink() error {
+	if MoverLogSinkURL == "" {
+		return nil
+	}
+	u, err := url.Parse(MoverLogSinkURL)
+	if err != nil {
+		return fmt.Errorf("invalid mover log sink URL: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("mover log sink URL must be http or https, got: %s", u.Scheme)
+	}
+	if MoverLogSinkType != MoverLogSinkTypeWebhook && MoverLogSinkType != MoverLogSinkTypeObject {
+		return fmt.Errorf("unknown mover log sink type: %s", MoverLogSinkType)
+	}
+	return nil
+}
+
+func isMoverLogSinkEnabled() bool {
+	return MoverLogSinkURL != ""
+}
+
+// ShipMoverLogs ships the full logs of a mover pod to the configured sink.
+// Failures are logged but otherwise ignored so they don't block the mover from
+// proceeding.
+func ShipMoverLogs(ctx context.Context, logger logr.Logger, record MoverLogRecord) {
+	l := logger.WithValues("sinkType", MoverLogSinkType)
+
+	req, err := newMoverLogSinkRequest(ctx, record)
+	if err != nil {
+		l.Error(err, "Unable to create mover log sink request")
+		return
+	}
+	if token := viper.GetString(MoverLogSinkTokenEnvVar); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	client := &http.Client{Timeout: moverLogSinkTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		l.Error(err, "Unable to ship mover logs")
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		l.Error(fmt.Errorf("unexpected HTTP status: %s", resp.Status), "Unable to ship mover logs")
+		return
+	}
+	l.V(1).Info("Shipped mover logs", "pod", record.Pod)
+}
+
+func newMoverLogSinkRequest(ctx context.Context, record MoverLogRecord) (*http.Request, error) {
+	if MoverLogSinkType == MoverLogSinkTypeObject {
+		// <url>/<namespace>/<job>/<pod>.log
+		objectURL := strings.TrimSuffix(MoverLogSinkURL, "/") + "/" + url.PathEscape(record.Namespace) +
+			"/" + url.PathEscape(record.Job) + "/" + url.PathEscape(record.Pod) + ".log"
+		req, err := http.NewRequestWithContext(ctx, http.MethodPut, objectURL, strings.NewReader(record.Logs))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+		return req, nil
+	}
+
+	body, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, MoverLogSinkURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return req, nil
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("Mover log sink", func() {
+	logger := zap.New(zap.UseDevMode(true), zap.WriteTo(GinkgoWriter))
+
+	var server *httptest.Server
+	var requests []*http.Request
+	var bodies []string
+	record := utils.MoverLogRecord{
+		Namespace: "my-ns",
+		Job:       "volsync-src-myrs",
+		Pod:       "volsync-src-myrs-abcde",
+		Result:    volsyncv1alpha1.MoverResultSuccessful,
+		Time:      time.Now().UTC(),
+		Logs:      "line 1\nline 2\n",
+	}
+
+	BeforeEach(func() {
+		requests = nil
+		bodies = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			requests = append(requests, r)
+			bodies = append(bodies, string(body))
+		}))
+	})
+	AfterEach(func() {
+		server.Close()
+		utils.MoverLogSinkURL = ""
+		utils.MoverLogSinkType = utils.MoverLogSinkTypeWebhook
+	})
+
+	It("validates the configuration", func() {
+		Expect(utils.ValidateMoverLogSink()).To(Succeed()) // disabled
+
+		utils.MoverLogSinkURL = "ftp://example.com/logs"
+		Expect(utils.ValidateMoverLogSink()).NotTo(Succeed())
+
+		utils.MoverLogSinkURL = "https://example.com/logs"
+		utils.MoverLogSinkType = "carrier-pigeon"
+		Expect(utils.ValidateMoverLogSink()).NotTo(Succeed())
+
+		utils.MoverLogSinkType = utils.MoverLogSinkTypeObject
+		Expect(utils.ValidateMoverLogSink()).To(Succeed())
+	})
+
+	It("POSTs the record to a webhook", func() {
+		utils.MoverLogSinkURL = server.URL + "/hook"
+		utils.ShipMoverLogs(ctx, logger, record)
+
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Method).To(Equal(http.MethodPost))
+		Expect(requests[0].URL.Path).To(Equal("/hook"))
+		received := utils.MoverLogRecord{}
+		Expect(json.Unmarshal([]byte(bodies[0]), &received)).To(Succeed())
+		Expect(received.Job).To(Equal(record.Job))
+		Expect(received.Logs).To(Equal(record.Logs))
+	})
+
+	It("PUTs the log as an object", func() {
+		utils.MoverLogSinkURL = server.URL + "/bucket/"
+		utils.MoverLogSinkType = utils.MoverLogSinkTypeObject
+		utils.ShipMoverLogs(ctx, logger, record)
+
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Method).To(Equal(http.MethodPut))
+		Expect(requests[0].URL.Path).To(Equal("/bucket/my-ns/volsync-src-myrs/volsync-src-myrs-abcde.log"))
+		Expect(bodies[0]).To(Equal(record.Logs))
+	})
+})
@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MoverArchitectures is a comma-separated list of the node architectures
+// (e.g., "amd64,arm64") that the mover images support. If empty, mover pods
+// may be scheduled on nodes of any architecture.
+var MoverArchitectures string
+
+// SetMoverNodeAffinity requires the mover pod to be scheduled on a node with
+// an operating system and architecture that the mover images support. The
+// node affinity is only added if the cluster has nodes that the movers cannot
+// run on. Movers run on linux nodes unless the pod has been prepared for
+// Windows by SetMoverOS.
+func SetMoverNodeAffinity(ctx context.Context, c client.Client, logger logr.Logger,
+	podTemplateSpec *corev1.PodTemplateSpec) error {
+	archs := moverArchitectures()
+	moverOS := string(corev1.Linux)
+	if podTemplateSpec.Spec.OS != nil {
+		moverOS = string(podTemplateSpec.Spec.OS.Name)
+	}
+
+	nodes := &corev1.NodeList{}
+	if err := c.List(ctx, nodes); err != nil {
+		logger.Error(err, "unable to list nodes")
+		return err
+	}
+
+	mixedOS, mixedArch := false, false
+	for _, node := range nodes.Items {
+		if os, ok := node.Labels[corev1.LabelOSStable]; ok && os != moverOS {
+			mixedOS = true
+		}
+		if arch, ok := node.Labels[corev1.LabelArchStable]; ok && len(archs) > 0 && !slices.Contains(archs, arch) {
+			mixedArch = true
+		}
+	}
+
+	var requirements []corev1.NodeSelectorRequirement
+	if mixedOS {
+		requirements = append(requirements, corev1.NodeSelectorRequirement{
+			Key:      corev1.LabelOSStable,
+			Operator: corev1.NodeSelectorOpIn,
+			Values:   []string{moverOS},
+		})
+	}
+	if mixedArch {
+		requirements = append(requirements, corev1.NodeSelectorRequirement{
+			Key:      corev1.LabelArchStable,
+			Operator: corev1.NodeSelectorOpIn,
+			Values:   archs,
+		})
+	}
+	if len(requirements) == 0 {
+		return nil
+	}
+
+	// The affinity may be shared with the moverConfig of the owner, so modify a copy
+	affinity := podTemplateSpec.Spec.Affinity.DeepCopy()
+	if affinity == nil {
+		affinity = &corev1.Affinity{}
+	}
+	if affinity.NodeAffinity == nil {
+		affinity.NodeAffinity = &corev1.NodeAffinity{}
+	}
+	required := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if required == nil || len(required.NodeSelectorTerms) == 0 {
+		required = &corev1.NodeSelector{
+			NodeSelectorTerms: []corev1.NodeSelectorTerm{{}},
+		}
+	}
+	// Terms are ORed, so every term needs the requirements
+	for i := range required.NodeSelectorTerms {
+		term := &required.NodeSelectorTerms[i]
+		for _, req := range requirements {
+			if !slices.ContainsFunc(term.MatchExpressions, func(existing corev1.NodeSelectorRequirement) bool {
+				return existing.Key == req.Key && existing.Operator == req.Operator &&
+					slices.Equal(existing.Values, req.Values)
+			}) {
+				term.MatchExpressions = append(term.MatchExpressions, req)
+			}
+		}
+	}
+	affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = required
+	podTemplateSpec.Spec.Affinity = affinity
+
+	return nil
+}
+
+func moverArchitectures() []string {
+	var archs []string
+	for _, arch := range strings.Split(MoverArchitectures, ",") {
+		if arch = strings.TrimSpace(arch); arch != "" {
+			archs = append(archs, arch)
+		}
+	}
+	return archs
+}
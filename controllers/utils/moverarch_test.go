@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("Mover node affinity", func() {
+	logger := zap.New(zap.UseDevMode(true), zap.WriteTo(GinkgoWriter))
+	var nodes []*corev1.Node
+	var podTemplateSpec *corev1.PodTemplateSpec
+
+	makeNode := func(os, arch string) {
+		node := &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "moverarch-",
+				Labels: map[string]string{
+					corev1.LabelOSStable:   os,
+					corev1.LabelArchStable: arch,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, node)).To(Succeed())
+		nodes = append(nodes, node)
+	}
+
+	BeforeEach(func() {
+		nodes = nil
+		podTemplateSpec = &corev1.PodTemplateSpec{}
+		makeNode("linux", "amd64")
+	})
+	AfterEach(func() {
+		utils.MoverArchitectures = ""
+		for _, node := range nodes {
+			Expect(k8sClient.Delete(ctx, node)).To(Succeed())
+		}
+	})
+
+	It("does not add an affinity when all nodes can run the movers", func() {
+		makeNode("linux", "arm64")
+		Expect(utils.SetMoverNodeAffinity(ctx, k8sClient, logger, podTemplateSpec)).To(Succeed())
+		Expect(podTemplateSpec.Spec.Affinity).To(BeNil())
+	})
+
+	When("the cluster has non-linux nodes", func() {
+		BeforeEach(func() {
+			makeNode("windows", "amd64")
+		})
+
+		It("requires a linux node", func() {
+			Expect(utils.SetMoverNodeAffinity(ctx, k8sClient, logger, podTemplateSpec)).To(Succeed())
+			Expect(podTemplateSpec.Spec.Affinity).NotTo(BeNil())
+			terms := podTemplateSpec.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.
+				NodeSelectorTerms
+			Expect(terms).To(HaveLen(1))
+			Expect(terms[0].MatchExpressions).To(ConsistOf(corev1.NodeSelectorRequirement{
+				Key:      corev1.LabelOSStable,
+				Operator: corev1.NodeSelectorOpIn,
+				Values:   []string{"linux"},
+			}))
+
+			// Running again does not add the requirement twice
+			Expect(utils.SetMoverNodeAffinity(ctx, k8sClient, logger, podTemplateSpec)).To(Succeed())
+			Expect(podTemplateSpec.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.
+				NodeSelectorTerms[0].MatchExpressions).To(HaveLen(1))
+		})
+
+		It("requires a windows node for Windows movers", func() {
+			utils.SetMoverOS(podTemplateSpec, volsyncv1alpha1.MoverOSWindows)
+			Expect(utils.SetMoverNodeAffinity(ctx, k8sClient, logger, podTemplateSpec)).To(Succeed())
+			terms := podTemplateSpec.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.
+				NodeSelectorTerms
+			Expect(terms).To(HaveLen(1))
+			Expect(terms[0].MatchExpressions).To(ConsistOf(corev1.NodeSelectorRequirement{
+				Key:      corev1.LabelOSStable,
+				Operator: corev1.NodeSelectorOpIn,
+				Values:   []string{"windows"},
+			}))
+		})
+	})
+
+	When("the cluster has nodes with unsupported architectures", func() {
+		var moverAffinity *corev1.Affinity
+
+		BeforeEach(func() {
+			utils.MoverArchitectures = "amd64, arm64"
+			makeNode("linux", "s390x")
+			moverAffinity = &corev1.Affinity{
+				NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{
+							{MatchExpressions: []corev1.NodeSelectorRequirement{{
+								Key:      "zone",
+								Operator: corev1.NodeSelectorOpIn,
+								Values:   []string{"a"},
+							}}},
+							{MatchExpressions: []corev1.NodeSelectorRequirement{{
+								Key:      "zone",
+								Operator: corev1.NodeSelectorOpIn,
+								Values:   []string{"b"},
+							}}},
+						},
+					},
+				},
+			}
+			podTemplateSpec.Spec.Affinity = moverAffinity
+		})
+
+		It("requires a supported architecture in every term", func() {
+			Expect(utils.SetMoverNodeAffinity(ctx, k8sClient, logger, podTemplateSpec)).To(Succeed())
+			terms := podTemplateSpec.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.
+				NodeSelectorTerms
+			Expect(terms).To(HaveLen(2))
+			for _, term := range terms {
+				Expect(term.MatchExpressions).To(HaveLen(2))
+				Expect(term.MatchExpressions[1]).To(Equal(corev1.NodeSelectorRequirement{
+					Key:      corev1.LabelArchStable,
+					Operator: corev1.NodeSelectorOpIn,
+					Values:   []string{"amd64", "arm64"},
+				}))
+			}
+			// The moverAffinity of the owner is not modified
+			Expect(moverAffinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.
+				NodeSelectorTerms[0].MatchExpressions).To(HaveLen(1))
+		})
+	})
+})
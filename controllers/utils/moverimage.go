@@ -0,0 +1,53 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// AllowedMoverImages is a comma-separated list of the container images that
+// may be used to override the mover image of individual objects. Entries ending
+// with "*" match all images with that prefix.
+var AllowedMoverImages string
+
+var ErrMoverImageNotAllowed = errors.New("mover image is not permitted by --allowed-mover-images")
+
+// MoverImage returns the container image that should be used for a mover:
+// the override if one is given and permitted, otherwise the default.
+func MoverImage(defaultImage string, override *string) (string, error) {
+	if override == nil || *override == "" {
+		return defaultImage, nil
+	}
+	for _, allowed := range strings.Split(AllowedMoverImages, ",") {
+		allowed = strings.TrimSpace(allowed)
+		if allowed == "" {
+			continue
+		}
+		if prefix, isPrefix := strings.CutSuffix(allowed, "*"); isPrefix {
+			if strings.HasPrefix(*override, prefix) {
+				return *override, nil
+			}
+		} else if *override == allowed {
+			return *override, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s", ErrMoverImageNotAllowed, *override)
+}
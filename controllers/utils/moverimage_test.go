@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("Mover image overrides", func() {
+	const defaultImage = "quay.io/backube/volsync:latest"
+
+	AfterEach(func() {
+		utils.AllowedMoverImages = ""
+	})
+
+	It("uses the default image when there's no override", func() {
+		image, err := utils.MoverImage(defaultImage, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(image).To(Equal(defaultImage))
+		image, err = utils.MoverImage(defaultImage, ptr.To(""))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(image).To(Equal(defaultImage))
+	})
+
+	It("rejects overrides when no images are allowed", func() {
+		_, err := utils.MoverImage(defaultImage, ptr.To("quay.io/backube/volsync:0.12.0"))
+		Expect(errors.Is(err, utils.ErrMoverImageNotAllowed)).To(BeTrue())
+	})
+
+	It("allows overrides that are in the allow-list", func() {
+		utils.AllowedMoverImages = "registry.example.com/volsync:canary, quay.io/backube/volsync:*"
+		image, err := utils.MoverImage(defaultImage, ptr.To("quay.io/backube/volsync:0.12.0"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(image).To(Equal("quay.io/backube/volsync:0.12.0"))
+		image, err = utils.MoverImage(defaultImage, ptr.To("registry.example.com/volsync:canary"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(image).To(Equal("registry.example.com/volsync:canary"))
+		_, err = utils.MoverImage(defaultImage, ptr.To("registry.example.com/volsync:other"))
+		Expect(errors.Is(err, utils.ErrMoverImageNotAllowed)).To(BeTrue())
+	})
+})
@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+// IsWindowsMover returns true if the mover should run on Windows nodes
+func IsWindowsMover(moverOS volsyncv1alpha1.MoverOS) bool {
+	return moverOS == volsyncv1alpha1.MoverOSWindows
+}
+
+// SetMoverOS prepares the mover pod to run on nodes with the given operating
+// system. For Windows, spec.os is set and the pod is stripped of the settings
+// that the API server rejects for Windows pods (Linux security settings and
+// memory-backed emptyDirs). It must be called after all other changes to the
+// security settings of the pod (i.e., after ApplySecurityProfile) and before
+// SetMoverNodeAffinity.
+func SetMoverOS(podTemplateSpec *corev1.PodTemplateSpec, moverOS volsyncv1alpha1.MoverOS) {
+	if !IsWindowsMover(moverOS) {
+		return
+	}
+	podSpec := &podTemplateSpec.Spec
+	podSpec.OS = &corev1.PodOS{Name: corev1.Windows}
+
+	// The pod securityContext may be shared with the moverConfig of the owner
+	if podSpec.SecurityContext != nil {
+		podSC := podSpec.SecurityContext.DeepCopy()
+		podSC.SELinuxOptions = nil
+		podSC.RunAsUser = nil
+		podSC.RunAsGroup = nil
+		podSC.SupplementalGroups = nil
+		podSC.SupplementalGroupsPolicy = nil
+		podSC.FSGroup = nil
+		podSC.FSGroupChangePolicy = nil
+		podSC.Sysctls = nil
+		podSC.SeccompProfile = nil
+		podSC.AppArmorProfile = nil
+		podSpec.SecurityContext = podSC
+	}
+
+	windowsContainer := func(c *corev1.Container) {
+		if c.SecurityContext == nil {
+			return
+		}
+		sc := c.SecurityContext.DeepCopy()
+		sc.SELinuxOptions = nil
+		sc.RunAsUser = nil
+		sc.RunAsGroup = nil
+		sc.Capabilities = nil
+		sc.Privileged = nil
+		sc.AllowPrivilegeEscalation = nil
+		sc.ReadOnlyRootFilesystem = nil
+		sc.ProcMount = nil
+		sc.SeccompProfile = nil
+		sc.AppArmorProfile = nil
+		c.SecurityContext = sc
+	}
+	for i := range podSpec.InitContainers {
+		windowsContainer(&podSpec.InitContainers[i])
+	}
+	for i := range podSpec.Containers {
+		windowsContainer(&podSpec.Containers[i])
+	}
+
+	// Windows does not support memory-backed volumes
+	for i := range podSpec.Volumes {
+		if ed := podSpec.Volumes[i].EmptyDir; ed != nil && ed.Medium == corev1.StorageMediumMemory {
+			ed.Medium = corev1.StorageMediumDefault
+		}
+	}
+}
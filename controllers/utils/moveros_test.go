@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("Mover OS", func() {
+	var podTemplateSpec *corev1.PodTemplateSpec
+	var moverSC *corev1.PodSecurityContext
+
+	BeforeEach(func() {
+		// Shared with the moverConfig of the owner
+		moverSC = &corev1.PodSecurityContext{
+			RunAsUser:      ptr.To[int64](1000),
+			FSGroup:        ptr.To[int64](1000),
+			RunAsNonRoot:   ptr.To(true),
+			SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+		}
+		podTemplateSpec = &corev1.PodTemplateSpec{
+			Spec: corev1.PodSpec{
+				SecurityContext: moverSC,
+				Containers: []corev1.Container{{
+					Name: "mover",
+					SecurityContext: &corev1.SecurityContext{
+						AllowPrivilegeEscalation: ptr.To(false),
+						Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+						Privileged:               ptr.To(false),
+						ReadOnlyRootFilesystem:   ptr.To(true),
+					},
+				}},
+				Volumes: []corev1.Volume{{
+					Name: "tempdir",
+					VolumeSource: corev1.VolumeSource{
+						EmptyDir: &corev1.EmptyDirVolumeSource{Medium: corev1.StorageMediumMemory},
+					},
+				}},
+			},
+		}
+	})
+
+	It("leaves linux movers alone", func() {
+		orig := podTemplateSpec.DeepCopy()
+		utils.SetMoverOS(podTemplateSpec, "")
+		utils.SetMoverOS(podTemplateSpec, volsyncv1alpha1.MoverOSLinux)
+		Expect(podTemplateSpec).To(Equal(orig))
+	})
+
+	It("removes the linux-only settings from Windows movers", func() {
+		utils.SetMoverOS(podTemplateSpec, volsyncv1alpha1.MoverOSWindows)
+		spec := podTemplateSpec.Spec
+		Expect(spec.OS).To(Equal(&corev1.PodOS{Name: corev1.Windows}))
+		Expect(spec.SecurityContext).To(Equal(&corev1.PodSecurityContext{RunAsNonRoot: ptr.To(true)}))
+		Expect(spec.Containers[0].SecurityContext).To(Equal(&corev1.SecurityContext{}))
+		Expect(spec.Volumes[0].EmptyDir.Medium).To(Equal(corev1.StorageMediumDefault))
+		// The moverConfig of the owner is not modified
+		Expect(moverSC.RunAsUser).NotTo(BeNil())
+	})
+})
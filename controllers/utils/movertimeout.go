@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+const (
+	// Delay before recreating a mover Job that exceeded moverTimeout. It
+	// doubles with each consecutive timeout, up to moverTimeoutMaxBackoff.
+	moverTimeoutBackoff    = time.Minute
+	moverTimeoutMaxBackoff = time.Hour
+)
+
+// EnforceMoverTimeout deletes the mover Job if it has been running for longer
+// than timeout, and holds off recreating it until the delay that follows a
+// timeout has passed. Movers call it before creating or updating their Job; it
+// returns true if the Job must not be (re)created yet.
+func EnforceMoverTimeout(ctx context.Context, c client.Client, logger logr.Logger,
+	recorder events.EventRecorder, owner client.Object, job *batchv1.Job,
+	timeout *metav1.Duration, moverStatus *volsyncv1alpha1.MoverStatus) (bool, error) {
+	if timeout == nil || moverStatus == nil {
+		return false, nil
+	}
+
+	existing := &batchv1.Job{}
+	err := c.Get(ctx, client.ObjectKeyFromObject(job), existing)
+	if kerrors.IsNotFound(err) {
+		return MoverTimeoutBackoff(moverStatus, time.Now()) > 0, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	// Only a Job with running pods can be stuck (a paused Job has none)
+	if existing.Status.StartTime == nil || existing.Status.Active == 0 || !existing.DeletionTimestamp.IsZero() {
+		return false, nil
+	}
+	runtime := time.Since(existing.Status.StartTime.Time)
+	if runtime <= timeout.Duration {
+		return false, nil
+	}
+
+	logger.Info("deleting job -- moverTimeout exceeded", "runtime", runtime.Round(time.Second),
+		"moverTimeout", timeout.Duration)
+	if err := c.Delete(ctx, existing,
+		client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+		return false, err
+	}
+	now := metav1.Now()
+	moverStatus.Result = volsyncv1alpha1.MoverResultFailed
+	moverStatus.ErrorCode = volsyncv1alpha1.MoverErrorCodeMoverTimeout
+	moverStatus.JobName = existing.Name
+	moverStatus.Logs = fmt.Sprintf("mover Job exceeded moverTimeout of %s", timeout.Duration)
+	moverStatus.Timeouts++
+	moverStatus.LastTimeoutTime = &now
+	recorder.Eventf(owner, existing, corev1.EventTypeWarning, volsyncv1alpha1.EvRSyncTimedOut,
+		volsyncv1alpha1.EvADeleteMover, "mover Job ran for longer than moverTimeout (%s), retrying in %s",
+		timeout.Duration, MoverTimeoutBackoff(moverStatus, now.Time))
+	return true, nil
+}
+
+// MoverTimeoutBackoff returns how much longer to wait before recreating a
+// mover Job after the latest timeout
+func MoverTimeoutBackoff(moverStatus *volsyncv1alpha1.MoverStatus, now time.Time) time.Duration {
+	if moverStatus.Timeouts == 0 || moverStatus.LastTimeoutTime == nil {
+		return 0
+	}
+	backoff := moverTimeoutMaxBackoff
+	// Beyond this, the shift would exceed the maximum (or overflow)
+	if moverStatus.Timeouts <= 7 {
+		backoff = min(moverTimeoutBackoff<<(moverStatus.Timeouts-1), moverTimeoutMaxBackoff)
+	}
+	return max(0, moverStatus.LastTimeoutTime.Add(backoff).Sub(now))
+}
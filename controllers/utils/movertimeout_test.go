@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("Mover timeout", func() {
+	logger := zap.New(zap.UseDevMode(true), zap.WriteTo(GinkgoWriter))
+
+	Describe("MoverTimeoutBackoff", func() {
+		now := time.Now()
+		It("does not wait without a timeout", func() {
+			Expect(utils.MoverTimeoutBackoff(&volsyncv1alpha1.MoverStatus{}, now)).To(BeZero())
+		})
+		It("doubles the delay with each consecutive timeout", func() {
+			last := metav1.NewTime(now)
+			ms := &volsyncv1alpha1.MoverStatus{Timeouts: 1, LastTimeoutTime: &last}
+			Expect(utils.MoverTimeoutBackoff(ms, now)).To(Equal(time.Minute))
+			ms.Timeouts = 3
+			Expect(utils.MoverTimeoutBackoff(ms, now)).To(Equal(4 * time.Minute))
+			ms.Timeouts = 40
+			Expect(utils.MoverTimeoutBackoff(ms, now)).To(Equal(time.Hour))
+			Expect(utils.MoverTimeoutBackoff(ms, now.Add(2*time.Hour))).To(BeZero())
+		})
+	})
+
+	Describe("EnforceMoverTimeout", func() {
+		var ns *corev1.Namespace
+		var rd *volsyncv1alpha1.ReplicationDestination
+		var job *batchv1.Job
+		var moverStatus *volsyncv1alpha1.MoverStatus
+		timeout := &metav1.Duration{Duration: time.Hour}
+
+		BeforeEach(func() {
+			ns = &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{GenerateName: "ns-movertimeout-"},
+			}
+			Expect(k8sClient.Create(ctx, ns)).To(Succeed())
+			rd = &volsyncv1alpha1.ReplicationDestination{
+				ObjectMeta: metav1.ObjectMeta{Name: "rd", Namespace: ns.Name},
+				Spec: volsyncv1alpha1.ReplicationDestinationSpec{
+					External: &volsyncv1alpha1.ReplicationDestinationExternalSpec{},
+				},
+			}
+			Expect(k8sClient.Create(ctx, rd)).To(Succeed())
+			moverStatus = &volsyncv1alpha1.MoverStatus{}
+
+			job = &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{Name: "mover", Namespace: ns.Name},
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							RestartPolicy: corev1.RestartPolicyNever,
+							Containers:    []corev1.Container{{Name: "c", Image: "mover"}},
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, job)).To(Succeed())
+		})
+		AfterEach(func() {
+			Expect(k8sClient.Delete(ctx, ns)).To(Succeed())
+		})
+
+		setRunningSince := func(start time.Time) {
+			startTime := metav1.NewTime(start)
+			job.Status.StartTime = &startTime
+			job.Status.Active = 1
+			Expect(k8sClient.Status().Update(ctx, job)).To(Succeed())
+		}
+		enforce := func(timeout *metav1.Duration) bool {
+			wait, err := utils.EnforceMoverTimeout(ctx, k8sClient, logger, events.NewFakeRecorder(10), rd,
+				&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: job.Name, Namespace: job.Namespace}},
+				timeout, moverStatus)
+			Expect(err).NotTo(HaveOccurred())
+			return wait
+		}
+
+		It("does nothing without a timeout", func() {
+			setRunningSince(time.Now().Add(-2 * time.Hour))
+			Expect(enforce(nil)).To(BeFalse())
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(job), job)).To(Succeed())
+		})
+
+		It("leaves a Job alone that is within the timeout", func() {
+			setRunningSince(time.Now().Add(-time.Minute))
+			Expect(enforce(timeout)).To(BeFalse())
+			Expect(moverStatus.Timeouts).To(BeZero())
+		})
+
+		It("deletes a Job that exceeded the timeout and waits before recreating it", func() {
+			setRunningSince(time.Now().Add(-2 * time.Hour))
+			Expect(enforce(timeout)).To(BeTrue())
+			Expect(moverStatus.Timeouts).To(Equal(int32(1)))
+			Expect(moverStatus.Result).To(Equal(volsyncv1alpha1.MoverResultFailed))
+			Expect(moverStatus.LastTimeoutTime).NotTo(BeNil())
+			Eventually(func() bool {
+				return kerrors.IsNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(job), job))
+			}, "10s", "250ms").Should(BeTrue())
+
+			// Backing off
+			Expect(enforce(timeout)).To(BeTrue())
+
+			// Once the delay has passed, the Job can be created again
+			past := metav1.NewTime(time.Now().Add(-2 * time.Minute))
+			moverStatus.LastTimeoutTime = &past
+			Expect(enforce(timeout)).To(BeFalse())
+		})
+	})
+})
@@ -53,3 +53,49 @@ func PrivilegedMoversOk(ctx context.Context, cl client.Client, logger logr.Logge
 
 	return false, nil
 }
+
+// MoverAllowed checks the allowed-movers annotation of the namespace to
+// determine whether the named mover may be used in it. All movers are allowed
+// if the annotation is not present.
+func MoverAllowed(ctx context.Context, cl client.Client, logger logr.Logger,
+	namespace string, moverName string) (bool, error) {
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: namespace,
+		},
+	}
+	err := cl.Get(ctx, client.ObjectKeyFromObject(ns), ns)
+	if err != nil {
+		logger.Error(err, "Error getting namespace", "namespace", namespace)
+		return false, err
+	}
+
+	allowedMovers, ok := ns.GetAnnotations()[volsyncv1alpha1.AllowedMoversNamespaceAnnotation]
+	if !ok {
+		return true, nil
+	}
+	for _, allowed := range strings.Split(allowedMovers, ",") {
+		if strings.EqualFold(strings.TrimSpace(allowed), moverName) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// NamespaceSuspended checks the suspend annotation of the namespace to
+// determine whether replication in it has been suspended
+func NamespaceSuspended(ctx context.Context, cl client.Client, logger logr.Logger,
+	namespace string) (bool, error) {
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: namespace,
+		},
+	}
+	err := cl.Get(ctx, client.ObjectKeyFromObject(ns), ns)
+	if err != nil {
+		logger.Error(err, "Error getting namespace", "namespace", namespace)
+		return false, err
+	}
+
+	return strings.EqualFold(ns.GetAnnotations()[volsyncv1alpha1.SuspendNamespaceAnnotation], "true"), nil
+}
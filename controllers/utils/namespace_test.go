@@ -106,3 +106,55 @@ var _ = Describe("Namespace mover privilege tests", func() {
 		})
 	})
 })
+
+var _ = Describe("Namespace allowed movers tests", func() {
+	logger := zap.New(zap.UseDevMode(true), zap.WriteTo(GinkgoWriter))
+	var ns *corev1.Namespace
+
+	BeforeEach(func() {
+		ns = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "namespc-",
+			},
+		}
+		Expect(k8sClient.Create(ctx, ns)).To(Succeed())
+		Expect(ns.Name).NotTo(BeEmpty())
+	})
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, ns)).To(Succeed())
+	})
+
+	When("The namespace has no allowed-movers annotation", func() {
+		It("Should allow all movers", func() {
+			for _, moverName := range []string{"restic", "syncthing", volsyncv1alpha1.ExternalMoverName} {
+				allowed, err := utils.MoverAllowed(ctx, k8sClient, logger, ns.GetName(), moverName)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(allowed).To(BeTrue())
+			}
+		})
+	})
+
+	When("The namespace restricts the allowed movers", func() {
+		BeforeEach(func() {
+			ns.Annotations = map[string]string{
+				volsyncv1alpha1.AllowedMoversNamespaceAnnotation: "restic, Rsync-TLS",
+			}
+			Expect(k8sClient.Update(ctx, ns)).To(Succeed())
+		})
+
+		It("Should only allow the listed movers", func() {
+			allowed, err := utils.MoverAllowed(ctx, k8sClient, logger, ns.GetName(), "restic")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(allowed).To(BeTrue())
+			allowed, err = utils.MoverAllowed(ctx, k8sClient, logger, ns.GetName(), "rsync-tls")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(allowed).To(BeTrue())
+			allowed, err = utils.MoverAllowed(ctx, k8sClient, logger, ns.GetName(), "syncthing")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(allowed).To(BeFalse())
+			allowed, err = utils.MoverAllowed(ctx, k8sClient, logger, ns.GetName(), volsyncv1alpha1.ExternalMoverName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(allowed).To(BeFalse())
+		})
+	})
+})
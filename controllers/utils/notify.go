@@ -0,0 +1,239 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+const (
+	// Key in the notification authSecret that holds the bearer token
+	NotificationTokenKey = "token"
+
+	notificationTimeout = 30 * time.Second
+	// Number of attempts made to deliver a notification
+	notificationAttempts = 4
+	// Failure notifications for the same object are sent at most this often
+	notificationFailureInterval = time.Minute
+	// A failure notification with the same message as the previous one for the
+	// object is suppressed for this long
+	notificationRepeatInterval = time.Hour
+)
+
+// AllowedNotificationHosts is a comma-separated list of the hosts that
+// notifications may be sent to. Entries starting with "*." match all
+// subdomains. No notifications are sent if it is empty.
+var AllowedNotificationHosts string
+
+var ErrNotificationHostNotAllowed = errors.New("notification host is not permitted by --allowed-notification-hosts")
+
+// Delay before the first retry of a notification. Subsequent retries back off
+// exponentially.
+var notificationRetryDelay = 5 * time.Second
+
+// SyncNotification is the JSON document sent for a synchronization result
+type SyncNotification struct {
+	Kind      string                                `json:"kind"`
+	Namespace string                                `json:"namespace"`
+	Name      string                                `json:"name"`
+	Event     volsyncv1alpha1.NotificationEventType `json:"event"`
+	Time      time.Time                             `json:"time"`
+	// Duration of the synchronization, for successful results
+	Duration string `json:"duration,omitempty"`
+	Message  string `json:"message"`
+}
+
+type slackPayload struct {
+	Text string `json:"text"`
+}
+
+type sentNotification struct {
+	time    time.Time
+	message string
+}
+
+// Most recent failure notification sent for each object, for rate limiting
+var (
+	lastFailureNotificationsMutex sync.Mutex
+	lastFailureNotifications      = map[string]sentNotification{}
+)
+
+// SendSyncNotification sends a notification of a synchronization result as
+// configured by spec. The request is delivered (and retried) in the
+// background, so errors are only logged.
+func SendSyncNotification(ctx context.Context, logger logr.Logger, c client.Client,
+	spec *volsyncv1alpha1.NotificationSpec, n SyncNotification) {
+	if spec == nil {
+		return
+	}
+	if len(spec.Events) > 0 && !slices.Contains(spec.Events, n.Event) {
+		return
+	}
+	l := logger.WithValues("notificationURL", spec.URL, "event", n.Event)
+
+	// The operator sends the request, so it must not be able to reach
+	// arbitrary (e.g., cluster internal) endpoints on behalf of namespace users
+	if err := checkNotificationURL(spec.URL); err != nil {
+		l.Error(err, "Notification not sent")
+		return
+	}
+
+	if n.Event == volsyncv1alpha1.NotificationEventFailed && !allowFailureNotification(n) {
+		l.V(1).Info("Failure notification suppressed by rate limit")
+		return
+	}
+
+	token := ""
+	if spec.AuthSecret != nil {
+		secret := &corev1.Secret{}
+		err := c.Get(ctx, types.NamespacedName{Name: *spec.AuthSecret, Namespace: n.Namespace}, secret)
+		if err != nil {
+			l.Error(err, "Unable to get notification authSecret")
+			return
+		}
+		token = string(secret.Data[NotificationTokenKey])
+	}
+
+	body, err := notificationBody(spec.Format, n)
+	if err != nil {
+		l.Error(err, "Unable to create notification")
+		return
+	}
+
+	go deliverNotification(l, spec.URL, token, body)
+}
+
+// checkNotificationURL returns an error if notifications may not be sent to
+// the URL
+func checkNotificationURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("unsupported notification URL scheme: %q", u.Scheme)
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, allowed := range strings.Split(AllowedNotificationHosts, ",") {
+		allowed = strings.ToLower(strings.TrimSpace(allowed))
+		if allowed == "" {
+			continue
+		}
+		if suffix, isWildcard := strings.CutPrefix(allowed, "*"); isWildcard {
+			if strings.HasSuffix(host, suffix) {
+				return nil
+			}
+		} else if host == allowed {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s", ErrNotificationHostNotAllowed, host)
+}
+
+// allowFailureNotification applies the rate limit to failure notifications and
+// records the notification if it is allowed
+func allowFailureNotification(n SyncNotification) bool {
+	key := n.Kind + "/" + n.Namespace + "/" + n.Name
+
+	lastFailureNotificationsMutex.Lock()
+	defer lastFailureNotificationsMutex.Unlock()
+
+	if last, ok := lastFailureNotifications[key]; ok {
+		since := n.Time.Sub(last.time)
+		if since < notificationFailureInterval ||
+			(last.message == n.Message && since < notificationRepeatInterval) {
+			return false
+		}
+	}
+	lastFailureNotifications[key] = sentNotification{time: n.Time, message: n.Message}
+	return true
+}
+
+func notificationBody(format volsyncv1alpha1.NotificationFormat, n SyncNotification) ([]byte, error) {
+	if format == volsyncv1alpha1.NotificationFormatSlack {
+		text := fmt.Sprintf("%s %s/%s: synchronization %s", n.Kind, n.Namespace, n.Name, n.Event)
+		if n.Duration != "" {
+			text += " in " + n.Duration
+		}
+		if n.Message != "" {
+			text += ": " + n.Message
+		}
+		return json.Marshal(slackPayload{Text: text})
+	}
+	return json.Marshal(n)
+}
+
+func deliverNotification(logger logr.Logger, url string, token string, body []byte) {
+	client := &http.Client{
+		Timeout: notificationTimeout,
+		// Redirects must not lead to hosts that are not allowed
+		CheckRedirect: func(req *http.Request, _ []*http.Request) error {
+			return checkNotificationURL(req.URL.String())
+		},
+	}
+	delay := notificationRetryDelay
+	var err error
+	for attempt := 1; attempt <= notificationAttempts; attempt++ {
+		if err = postNotification(client, url, token, body); err == nil {
+			logger.V(1).Info("Sent notification")
+			return
+		}
+		if attempt < notificationAttempts {
+			time.Sleep(delay)
+			delay *= 2
+		}
+	}
+	logger.Error(err, "Unable to send notification", "attempts", notificationAttempts)
+}
+
+func postNotification(client *http.Client, url string, token string, body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected HTTP status: %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("Sync notifications", func() {
+	logger := zap.New(zap.UseDevMode(true), zap.WriteTo(GinkgoWriter))
+
+	type received struct {
+		auth string
+		body string
+	}
+	var server *httptest.Server
+	var requests chan received
+	var spec *volsyncv1alpha1.NotificationSpec
+	var notification utils.SyncNotification
+
+	BeforeEach(func() {
+		requests = make(chan received, 10)
+		server = httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			requests <- received{auth: r.Header.Get("Authorization"), body: string(body)}
+		}))
+		spec = &volsyncv1alpha1.NotificationSpec{URL: server.URL}
+		utils.AllowedNotificationHosts = "example.com, 127.0.0.1"
+		notification = utils.SyncNotification{
+			Kind:      "ReplicationSource",
+			Namespace: "default",
+			// Unique name so rate limiting doesn't carry over between tests
+			Name:    "rs-" + time.Now().Format("150405.000000000"),
+			Event:   volsyncv1alpha1.NotificationEventSucceeded,
+			Time:    time.Now(),
+			Message: "Synchronization completed",
+		}
+	})
+	AfterEach(func() {
+		server.Close()
+		utils.AllowedNotificationHosts = ""
+	})
+
+	It("POSTs a JSON document describing the result", func() {
+		notification.Duration = "1m0s"
+		utils.SendSyncNotification(ctx, logger, k8sClient, spec, notification)
+
+		var r received
+		Eventually(requests).Should(Receive(&r))
+		sent := utils.SyncNotification{}
+		Expect(json.Unmarshal([]byte(r.body), &sent)).To(Succeed())
+		Expect(sent.Name).To(Equal(notification.Name))
+		Expect(sent.Event).To(Equal(volsyncv1alpha1.NotificationEventSucceeded))
+		Expect(sent.Duration).To(Equal("1m0s"))
+		Expect(r.auth).To(BeEmpty())
+	})
+
+	It("sends a Slack-compatible payload", func() {
+		spec.Format = volsyncv1alpha1.NotificationFormatSlack
+		utils.SendSyncNotification(ctx, logger, k8sClient, spec, notification)
+
+		var r received
+		Eventually(requests).Should(Receive(&r))
+		payload := map[string]string{}
+		Expect(json.Unmarshal([]byte(r.body), &payload)).To(Succeed())
+		Expect(payload["text"]).To(ContainSubstring(notification.Name))
+		Expect(payload["text"]).To(ContainSubstring("Succeeded"))
+	})
+
+	It("only sends notifications to the allowed hosts", func() {
+		utils.AllowedNotificationHosts = "*.example.com"
+		utils.SendSyncNotification(ctx, logger, k8sClient, spec, notification)
+		Consistently(requests, "1s").ShouldNot(Receive())
+	})
+
+	It("only sends the selected events", func() {
+		spec.Events = []volsyncv1alpha1.NotificationEventType{volsyncv1alpha1.NotificationEventFailed}
+		utils.SendSyncNotification(ctx, logger, k8sClient, spec, notification)
+		Consistently(requests, "1s").ShouldNot(Receive())
+	})
+
+	It("sends the token from the authSecret", func() {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "notify-",
+				Namespace:    "default",
+			},
+			Data: map[string][]byte{utils.NotificationTokenKey: []byte("s3cr3t")},
+		}
+		Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, ctx, secret)
+
+		spec.AuthSecret = ptr.To(secret.GetName())
+		utils.SendSyncNotification(ctx, logger, k8sClient, spec, notification)
+
+		var r received
+		Eventually(requests).Should(Receive(&r))
+		Expect(r.auth).To(Equal("Bearer s3cr3t"))
+	})
+
+	It("rate limits repeated failures", func() {
+		notification.Event = volsyncv1alpha1.NotificationEventFailed
+		notification.Message = "mover Job failed"
+		utils.SendSyncNotification(ctx, logger, k8sClient, spec, notification)
+		Eventually(requests).Should(Receive())
+
+		// The same failure again, shortly after, is suppressed
+		notification.Time = notification.Time.Add(5 * time.Minute)
+		utils.SendSyncNotification(ctx, logger, k8sClient, spec, notification)
+		Consistently(requests, "1s").ShouldNot(Receive())
+
+		// A different failure is sent
+		notification.Message = "unable to find Secret"
+		utils.SendSyncNotification(ctx, logger, k8sClient, spec, notification)
+		Eventually(requests).Should(Receive())
+	})
+})
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+// ConditionsFor returns the status conditions of a ReplicationSource or
+// ReplicationDestination, or nil if it has no status yet
+func ConditionsFor(owner client.Object) *[]metav1.Condition {
+	switch o := owner.(type) {
+	case *volsyncv1alpha1.ReplicationSource:
+		if o.Status != nil {
+			return &o.Status.Conditions
+		}
+	case *volsyncv1alpha1.ReplicationDestination:
+		if o.Status != nil {
+			return &o.Status.Conditions
+		}
+	}
+	return nil
+}
+
+// PhaseEvent is the event that is recorded when a phase condition changes
+type PhaseEvent struct {
+	Type   string
+	Reason string
+}
+
+// SetPhaseCondition sets one of the conditions that report the step of the
+// synchronization on the owner. If the condition changed and an event is
+// given, the event is recorded with the condition's message.
+func SetPhaseCondition(recorder events.EventRecorder, owner client.Object, related runtime.Object,
+	cond metav1.Condition, event *PhaseEvent) {
+	conditions := ConditionsFor(owner)
+	if conditions == nil {
+		return
+	}
+	if apimeta.SetStatusCondition(conditions, cond) && event != nil && recorder != nil {
+		recorder.Eventf(owner, related, event.Type, event.Reason, volsyncv1alpha1.EvANone, "%s", cond.Message)
+	}
+}
+
+// SetJobRunningCondition updates the JobRunning condition of the owner from
+// the status of its mover Job
+func SetJobRunningCondition(recorder events.EventRecorder, owner client.Object, job *batchv1.Job) {
+	cond := metav1.Condition{
+		Type:    volsyncv1alpha1.ConditionJobRunning,
+		Status:  metav1.ConditionFalse,
+		Reason:  volsyncv1alpha1.PhaseReasonJobPending,
+		Message: fmt.Sprintf("waiting for the pod of mover Job %s to start", job.Name),
+	}
+	var event *PhaseEvent
+	switch {
+	case job.Spec.BackoffLimit != nil && job.Status.Failed >= *job.Spec.BackoffLimit:
+		cond.Reason = volsyncv1alpha1.PhaseReasonJobFailed
+		cond.Message = fmt.Sprintf("mover Job %s failed", job.Name)
+		event = &PhaseEvent{Type: corev1.EventTypeWarning, Reason: volsyncv1alpha1.EvRMoverJobFailed}
+	case job.Status.Succeeded > 0:
+		cond.Reason = volsyncv1alpha1.PhaseReasonJobSucceeded
+		cond.Message = fmt.Sprintf("mover Job %s completed", job.Name)
+		event = &PhaseEvent{Type: corev1.EventTypeNormal, Reason: volsyncv1alpha1.EvRMoverJobSucceeded}
+	case job.Status.Active > 0:
+		cond.Status = metav1.ConditionTrue
+		cond.Reason = volsyncv1alpha1.PhaseReasonJobRunning
+		cond.Message = fmt.Sprintf("mover Job %s is running", job.Name)
+		event = &PhaseEvent{Type: corev1.EventTypeNormal, Reason: volsyncv1alpha1.EvRMoverJobStarted}
+	}
+	SetPhaseCondition(recorder, owner, job, cond, event)
+}
+
+// SetCleanupCompleteCondition updates the CleanupComplete condition of the
+// owner. The temporary objects exist from the start of a synchronization until
+// the mover has cleaned up after it.
+func SetCleanupCompleteCondition(recorder events.EventRecorder, owner client.Object, complete bool) {
+	if !complete {
+		SetPhaseCondition(recorder, owner, nil, metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionCleanupComplete,
+			Status:  metav1.ConditionFalse,
+			Reason:  volsyncv1alpha1.PhaseReasonSyncInProgress,
+			Message: "temporary objects are in use by the synchronization",
+		}, nil)
+		return
+	}
+	SetPhaseCondition(recorder, owner, nil, metav1.Condition{
+		Type:    volsyncv1alpha1.ConditionCleanupComplete,
+		Status:  metav1.ConditionTrue,
+		Reason:  volsyncv1alpha1.PhaseReasonCleanedUp,
+		Message: "temporary objects of the last synchronization have been removed",
+	}, &PhaseEvent{Type: corev1.EventTypeNormal, Reason: volsyncv1alpha1.EvRCleanupComplete})
+}
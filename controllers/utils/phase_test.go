@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("Synchronization phase conditions", func() {
+	var rs *volsyncv1alpha1.ReplicationSource
+	var recorder *events.FakeRecorder
+	var job *batchv1.Job
+
+	jobRunning := func() *metav1.Condition {
+		return apimeta.FindStatusCondition(rs.Status.Conditions, volsyncv1alpha1.ConditionJobRunning)
+	}
+
+	BeforeEach(func() {
+		rs = &volsyncv1alpha1.ReplicationSource{
+			ObjectMeta: metav1.ObjectMeta{Name: "rs", Namespace: "ns"},
+			Status:     &volsyncv1alpha1.ReplicationSourceStatus{},
+		}
+		recorder = events.NewFakeRecorder(10)
+		job = &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: "volsync-job", Namespace: "ns"},
+			Spec:       batchv1.JobSpec{BackoffLimit: ptr.To[int32](2)},
+		}
+	})
+
+	It("follows the mover Job and records an event for each change", func() {
+		utils.SetJobRunningCondition(recorder, rs, job)
+		Expect(jobRunning().Status).To(Equal(metav1.ConditionFalse))
+		Expect(jobRunning().Reason).To(Equal(volsyncv1alpha1.PhaseReasonJobPending))
+		Expect(recorder.Events).To(BeEmpty())
+
+		job.Status.Active = 1
+		utils.SetJobRunningCondition(recorder, rs, job)
+		utils.SetJobRunningCondition(recorder, rs, job)
+		Expect(jobRunning().Status).To(Equal(metav1.ConditionTrue))
+		Expect(recorder.Events).To(HaveLen(1))
+		Expect(<-recorder.Events).To(ContainSubstring(volsyncv1alpha1.EvRMoverJobStarted))
+
+		job.Status.Active = 0
+		job.Status.Failed = 2
+		utils.SetJobRunningCondition(recorder, rs, job)
+		Expect(jobRunning().Reason).To(Equal(volsyncv1alpha1.PhaseReasonJobFailed))
+		Expect(<-recorder.Events).To(ContainSubstring("Warning " + volsyncv1alpha1.EvRMoverJobFailed))
+	})
+
+	It("reports whether the temporary objects have been cleaned up", func() {
+		utils.SetCleanupCompleteCondition(recorder, rs, false)
+		Expect(apimeta.IsStatusConditionFalse(rs.Status.Conditions,
+			volsyncv1alpha1.ConditionCleanupComplete)).To(BeTrue())
+		utils.SetCleanupCompleteCondition(recorder, rs, true)
+		Expect(apimeta.IsStatusConditionTrue(rs.Status.Conditions,
+			volsyncv1alpha1.ConditionCleanupComplete)).To(BeTrue())
+		Expect(<-recorder.Events).To(ContainSubstring(volsyncv1alpha1.EvRCleanupComplete))
+	})
+
+	It("ignores objects without a status", func() {
+		rs.Status = nil
+		utils.SetCleanupCompleteCondition(recorder, rs, true)
+		Expect(recorder.Events).To(BeEmpty())
+	})
+})
@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+//+kubebuilder:rbac:groups=core,resources=pods/exec,verbs=create
+
+// ExecInPod runs a command in a container of a running pod and returns its
+// (truncated) combined output. If container is empty, the pod's first
+// container is used.
+func ExecInPod(ctx context.Context, pod *corev1.Pod, container string, command []string) (string, error) {
+	if clientset == nil || restConfig == nil {
+		return "", errors.New("pod exec client has not been initialized")
+	}
+	if container == "" && len(pod.Spec.Containers) > 0 {
+		container = pod.Spec.Containers[0].Name
+	}
+
+	req := clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.GetNamespace()).
+		Name(pod.GetName()).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(restConfig, "POST", req.URL())
+	if err != nil {
+		return "", err
+	}
+
+	var output bytes.Buffer
+	err = executor.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdout: &output,
+		Stderr: &output,
+	})
+	out := TruncateString(output.String(), GetMoverLogMaxBytes())
+	if err != nil {
+		return out, fmt.Errorf("command failed in pod %s/%s: %w", pod.GetNamespace(), pod.GetName(), err)
+	}
+	return out, nil
+}
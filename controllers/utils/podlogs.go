@@ -19,9 +19,11 @@ package utils
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/spf13/viper"
@@ -50,6 +52,7 @@ const (
 //+kubebuilder:rbac:groups=core,resources=pods/log,verbs=get;list;watch
 
 var clientset *kubernetes.Clientset
+var restConfig *rest.Config
 
 func InitPodLogsClient(cfg *rest.Config) (*kubernetes.Clientset, error) {
 	var err error
@@ -76,6 +79,12 @@ func InitPodLogsClient(cfg *rest.Config) (*kubernetes.Clientset, error) {
 		return nil, err
 	}
 
+	// Token used to authenticate with the mover log sink (if configured)
+	err = viper.BindEnv(MoverLogSinkTokenEnvVar)
+	if err != nil {
+		return nil, err
+	}
+
 	// Allow env var to override MOVER_LOG_DEBUG
 	// Set to "true" to log all lines (up to MOVER_LOG_MAX_BYTES) of mover logs
 	// to status.latestMoverStatus.  This effectively bypasses mover filters.
@@ -89,6 +98,7 @@ func InitPodLogsClient(cfg *rest.Config) (*kubernetes.Clientset, error) {
 	if err != nil {
 		return nil, err
 	}
+	restConfig = cfg
 
 	return clientset, nil
 }
@@ -105,8 +115,11 @@ func IsMoverLogDebug() bool {
 	return viper.GetBool(MoverLogDebugEnvVar)
 }
 
+// Gets the logs of the pod, redacted and filtered by lineFilter. If a mover log
+// sink is configured, the unfiltered logs are also returned so they can be
+// shipped.
 func getPodLogs(ctx context.Context, logger logr.Logger, podName, podNamespace string,
-	lineFilter func(line string) *string) (string, error) {
+	lineFilter func(line string) *string, redact func(string) string) (string, *bytes.Buffer, error) {
 	l := logger.WithValues("podName", podName, "podNamespace", podNamespace)
 
 	podLogOptions := &corev1.PodLogOptions{
@@ -123,15 +136,27 @@ func getPodLogs(ctx context.Context, logger logr.Logger, podName, podNamespace s
 	stream, err := request.Stream(ctx)
 	if err != nil {
 		l.Error(err, "Error streaming logs from pod")
-		return "", err
+		return "", nil, err
 	}
 	defer stream.Close()
 
-	return FilterLogs(stream, lineFilter)
+	var reader io.Reader = stream
+	var fullLogs *bytes.Buffer
+	if isMoverLogSinkEnabled() {
+		fullLogs = &bytes.Buffer{}
+		reader = io.TeeReader(stream, fullLogs)
+	}
+
+	filteredLogs, err := filterLogs(reader, lineFilter, redact)
+	return filteredLogs, fullLogs, err
 }
 
-// Appies lineFilter to each line
+// Appies lineFilter to each line, after masking the credentials in URLs
 func FilterLogs(reader io.Reader, lineFilter func(line string) *string) (string, error) {
+	return filterLogs(reader, lineFilter, RedactURLs)
+}
+
+func filterLogs(reader io.Reader, lineFilter func(line string) *string, redact func(string) string) (string, error) {
 	if IsMoverLogDebug() {
 		// If in debug mode, log everything
 		lineFilter = AllLines
@@ -140,8 +165,9 @@ func FilterLogs(reader io.Reader, lineFilter func(line string) *string) (string,
 	lineScanner := bufio.NewScanner(reader)
 	var allLines strings.Builder
 	for lineScanner.Scan() {
-		// Run lineFilter() func to see if the line should be appended
-		lineAfterFilter := lineFilter(lineScanner.Text())
+		// Run lineFilter() func to see if the line should be appended. Secrets
+		// are masked first, so they are not recorded even in debug mode.
+		lineAfterFilter := lineFilter(redact(lineScanner.Text()))
 
 		if lineAfterFilter != nil {
 			if allLines.Len() > 0 {
@@ -159,22 +185,31 @@ func FilterLogs(reader io.Reader, lineFilter func(line string) *string) (string,
 // Updates mover status to failed and puts the errMessage as the logs
 func UpdateMoverStatusFailed(moverStatus *volsyncv1alpha1.MoverStatus, errMessage string) {
 	moverStatus.Result = volsyncv1alpha1.MoverResultFailed
+	moverStatus.ErrorCode = ""
 	moverStatus.Logs = errMessage
 }
 
+// UpdateMoverStatusForFailedJob records the logs of the failed Job in the mover
+// status. The redactValues (see SensitiveSecretValues) and the credentials in
+// URLs are masked in the logs.
 func UpdateMoverStatusForFailedJob(ctx context.Context, logger logr.Logger,
-	moverStatus *volsyncv1alpha1.MoverStatus, jobName, jobNamespace string, logLineFilter func(string) *string) {
-	updateMoverStatusForJob(ctx, logger, moverStatus, jobName, jobNamespace, true, logLineFilter)
+	moverStatus *volsyncv1alpha1.MoverStatus, jobName, jobNamespace string, logLineFilter func(string) *string,
+	redactValues ...string) {
+	updateMoverStatusForJob(ctx, logger, moverStatus, jobName, jobNamespace, true, logLineFilter, redactValues)
 }
 
+// UpdateMoverStatusForSuccessfulJob records the logs of the successful Job in
+// the mover status. The redactValues (see SensitiveSecretValues) and the
+// credentials in URLs are masked in the logs.
 func UpdateMoverStatusForSuccessfulJob(ctx context.Context, logger logr.Logger,
-	moverStatus *volsyncv1alpha1.MoverStatus, jobName, jobNamespace string, logLineFilter func(string) *string) {
-	updateMoverStatusForJob(ctx, logger, moverStatus, jobName, jobNamespace, false, logLineFilter)
+	moverStatus *volsyncv1alpha1.MoverStatus, jobName, jobNamespace string, logLineFilter func(string) *string,
+	redactValues ...string) {
+	updateMoverStatusForJob(ctx, logger, moverStatus, jobName, jobNamespace, false, logLineFilter, redactValues)
 }
 
 // Does not throw error to avoid breaking movers from proceeding if logs can't be gathered
 func updateMoverStatusForJob(ctx context.Context, logger logr.Logger, moverStatus *volsyncv1alpha1.MoverStatus,
-	jobName, jobNamespace string, jobFailed bool, logLineFilter func(string) *string) {
+	jobName, jobNamespace string, jobFailed bool, logLineFilter func(string) *string, redactValues []string) {
 	l := logger.WithValues("jobName", jobName)
 
 	if logLineFilter == nil {
@@ -183,10 +218,24 @@ func updateMoverStatusForJob(ctx context.Context, logger logr.Logger, moverStatu
 	}
 
 	moverStatus.Logs = "" // clear out logs in case we can't get new ones
+	moverStatus.ErrorCode = ""
+	moverStatus.JobName = jobName
 
 	moverStatus.Result = volsyncv1alpha1.MoverResultSuccessful
 	if jobFailed {
 		moverStatus.Result = volsyncv1alpha1.MoverResultFailed
+	} else {
+		// A successful Job ends a series of moverTimeouts
+		moverStatus.Timeouts = 0
+		moverStatus.LastTimeoutTime = nil
+	}
+
+	if clientset != nil {
+		job, err := clientset.BatchV1().Jobs(jobNamespace).Get(ctx, jobName, metav1.GetOptions{})
+		if err != nil {
+			l.Error(err, "Unable to get job to record mover start and completion times")
+		}
+		SetMoverStatusTimes(moverStatus, job)
 	}
 
 	pod, err := GetNewestPodForJob(ctx, logger, jobName, jobNamespace, jobFailed)
@@ -201,14 +250,75 @@ func updateMoverStatusForJob(ctx context.Context, logger logr.Logger, moverStatu
 	}
 
 	l.Info("Getting logs for pod", "podName", pod.GetName(), "pod", pod)
-	filteredLogs, err := getPodLogs(ctx, l, pod.GetName(), jobNamespace, logLineFilter)
+	redact := NewRedactor(redactValues)
+	filteredLogs, fullLogs, err := getPodLogs(ctx, l, pod.GetName(), jobNamespace, logLineFilter, redact)
 	if err != nil {
 		l.Error(err, "Error getting logs from pod")
 	}
 
+	if fullLogs != nil {
+		ShipMoverLogs(ctx, l, MoverLogRecord{
+			Namespace: jobNamespace,
+			Job:       jobName,
+			Pod:       pod.GetName(),
+			Result:    moverStatus.Result,
+			Time:      time.Now().UTC(),
+			Logs:      redact(fullLogs.String()),
+		})
+	}
+
 	moverStatus.Logs = truncateMoverLog(filteredLogs)
 }
 
+// Number of lines at the end of the log of a running mover that are searched
+// for progress information
+const moverProgressTailLines int64 = 20
+
+// UpdateMoverProgressForRunningJob updates the progress in the mover status
+// from the most recent line of the running mover pod's log that is recognized
+// by progressFilter. Does not throw error to avoid breaking movers from
+// proceeding if logs can't be gathered.
+func UpdateMoverProgressForRunningJob(ctx context.Context, logger logr.Logger,
+	moverStatus *volsyncv1alpha1.MoverStatus, jobName, jobNamespace string,
+	progressFilter func(line string) *volsyncv1alpha1.MoverProgress) {
+	if clientset == nil {
+		return
+	}
+	l := logger.WithValues("jobName", jobName)
+
+	runningPods, _, _, err := GetPodsForJob(ctx, l, jobName, jobNamespace)
+	if err != nil {
+		return
+	}
+	pod := getNewestPod(runningPods)
+	if pod == nil {
+		return
+	}
+
+	tailLines := moverProgressTailLines
+	request := clientset.CoreV1().Pods(jobNamespace).GetLogs(pod.GetName(), &corev1.PodLogOptions{
+		TailLines: &tailLines,
+	})
+	stream, err := request.Stream(ctx)
+	if err != nil {
+		l.Error(err, "Error streaming logs from pod to get mover progress")
+		return
+	}
+	defer stream.Close()
+
+	var progress *volsyncv1alpha1.MoverProgress
+	lineScanner := bufio.NewScanner(stream)
+	for lineScanner.Scan() {
+		if p := progressFilter(lineScanner.Text()); p != nil {
+			progress = p
+		}
+	}
+	if progress != nil {
+		progress.LastUpdateTime = &metav1.Time{Time: time.Now()}
+		moverStatus.Progress = progress
+	}
+}
+
 func truncateMoverLog(moverLog string) string {
 	maxBytes := GetMoverLogMaxBytes()
 
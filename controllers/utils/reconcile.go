@@ -19,7 +19,7 @@ package utils
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"strings"
 
 	"github.com/go-logr/logr"
@@ -29,6 +29,10 @@ import (
 	ctrlutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
+// ErrDeletedOnImmutable is returned by CreateOrUpdateDeleteOnImmutableErr when
+// the object was deleted so that it can be recreated
+var ErrDeletedOnImmutable = errors.New("unable to update object. Deleting object so it can be recreated")
+
 // reconcileFunc is a function that partially reconciles an object. It returns a
 // bool indicating whether reconciling should continue and an error.
 type ReconcileFunc func(logr.Logger) (bool, error)
@@ -61,7 +65,7 @@ func CreateOrUpdateDeleteOnImmutableErr(ctx context.Context, k8sClient client.Cl
 			return op, delErr
 		}
 
-		return op, fmt.Errorf("unable to update object. Deleting object so it can be recreated")
+		return op, ErrDeletedOnImmutable
 	}
 
 	return op, err
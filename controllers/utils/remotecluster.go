@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+// ErrUnsafeKubeconfig is returned for kubeconfigs that could run commands or
+// read files in the controller's pod
+var ErrUnsafeKubeconfig = errors.New("kubeconfig must only use inline server, CA, and token or client certificate data")
+
+// RemoteClientFunc returns a client for the cluster described by the
+// kubeconfig Secret referenced by ref in namespace
+type RemoteClientFunc func(ctx context.Context, c client.Client, logger logr.Logger, namespace string,
+	ref volsyncv1alpha1.KubeconfigSecretRef) (client.Client, error)
+
+// NewRemoteClient is the RemoteClientFunc that builds a client from the
+// kubeconfig in the Secret. The client is not cached, so every call reads
+// directly from the remote API server.
+func NewRemoteClient(ctx context.Context, c client.Client, logger logr.Logger, namespace string,
+	ref volsyncv1alpha1.KubeconfigSecretRef) (client.Client, error) {
+	key := ref.Key
+	if key == "" {
+		key = volsyncv1alpha1.DefaultKubeconfigSecretKey
+	}
+	secret := &corev1.Secret{}
+	secret.Name = ref.Name
+	secret.Namespace = namespace
+	if err := GetAndValidateSecret(ctx, c, logger, secret, key); err != nil {
+		return nil, err
+	}
+
+	config, err := RemoteRESTConfig(secret.Data[key])
+	if err != nil {
+		return nil, fmt.Errorf("unable to load kubeconfig from Secret %s: %w", ref.Name, err)
+	}
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	if err := volsyncv1alpha1.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	return client.New(config, client.Options{Scheme: scheme})
+}
+
+// RemoteRESTConfig returns the client configuration for a kubeconfig that was
+// provided by a user. Since the client runs inside the controller, kubeconfigs
+// with exec or auth-provider plugins, or that refer to files (e.g., the
+// controller's own ServiceAccount token), are rejected.
+func RemoteRESTConfig(kubeconfig []byte) (*rest.Config, error) {
+	config, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateKubeconfig(config); err != nil {
+		return nil, err
+	}
+	return clientcmd.NewDefaultClientConfig(*config, &clientcmd.ConfigOverrides{}).ClientConfig()
+}
+
+func validateKubeconfig(config *clientcmdapi.Config) error {
+	for name, cluster := range config.Clusters {
+		switch {
+		case cluster.Server == "":
+			return fmt.Errorf("%w: cluster %q has no server", ErrUnsafeKubeconfig, name)
+		case cluster.CertificateAuthority != "":
+			return fmt.Errorf("%w: cluster %q uses certificate-authority", ErrUnsafeKubeconfig, name)
+		}
+	}
+	for name, authInfo := range config.AuthInfos {
+		switch {
+		case authInfo.Exec != nil:
+			return fmt.Errorf("%w: user %q uses exec", ErrUnsafeKubeconfig, name)
+		case authInfo.AuthProvider != nil:
+			return fmt.Errorf("%w: user %q uses auth-provider", ErrUnsafeKubeconfig, name)
+		case authInfo.TokenFile != "":
+			return fmt.Errorf("%w: user %q uses tokenFile", ErrUnsafeKubeconfig, name)
+		case authInfo.ClientCertificate != "" || authInfo.ClientKey != "":
+			return fmt.Errorf("%w: user %q uses client-certificate or client-key", ErrUnsafeKubeconfig, name)
+		case authInfo.Username != "" || authInfo.Password != "":
+			return fmt.Errorf("%w: user %q uses basic authentication", ErrUnsafeKubeconfig, name)
+		case authInfo.Impersonate != "" || len(authInfo.ImpersonateGroups) > 0 ||
+			authInfo.ImpersonateUID != "" || len(authInfo.ImpersonateUserExtra) > 0:
+			return fmt.Errorf("%w: user %q uses impersonation", ErrUnsafeKubeconfig, name)
+		case authInfo.Token == "" && (len(authInfo.ClientCertificateData) == 0 || len(authInfo.ClientKeyData) == 0):
+			return fmt.Errorf("%w: user %q has no token or client certificate", ErrUnsafeKubeconfig, name)
+		}
+	}
+	return nil
+}
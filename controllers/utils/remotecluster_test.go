@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils_test
+
+import (
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("Remote cluster kubeconfigs", func() {
+	kubeconfig := func(cluster, user string) []byte {
+		return []byte(fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: remote
+  cluster:
+%s
+users:
+- name: remote
+  user:
+%s
+contexts:
+- name: remote
+  context:
+    cluster: remote
+    user: remote
+current-context: remote
+`, cluster, user))
+	}
+	const (
+		inlineCluster = "    server: https://remote.example.com:6443\n" +
+			"    certificate-authority-data: Y2EtZGF0YQ=="
+		inlineToken = "    token: mytoken"
+	)
+
+	It("accepts an inline token", func() {
+		config, err := utils.RemoteRESTConfig(kubeconfig(inlineCluster, inlineToken))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Host).To(Equal("https://remote.example.com:6443"))
+		Expect(config.BearerToken).To(Equal("mytoken"))
+		Expect(config.CAData).To(Equal([]byte("ca-data")))
+	})
+
+	It("accepts an inline client certificate", func() {
+		config, err := utils.RemoteRESTConfig(kubeconfig(inlineCluster,
+			"    client-certificate-data: Y2VydA==\n    client-key-data: a2V5"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.CertData).To(Equal([]byte("cert")))
+		Expect(config.KeyData).To(Equal([]byte("key")))
+	})
+
+	DescribeTable("rejects kubeconfigs that could run commands or read files",
+		func(cluster, user string) {
+			_, err := utils.RemoteRESTConfig(kubeconfig(cluster, user))
+			Expect(err).To(HaveOccurred())
+			Expect(errors.Is(err, utils.ErrUnsafeKubeconfig)).To(BeTrue())
+		},
+		Entry("exec", inlineCluster,
+			"    exec:\n      apiVersion: client.authentication.k8s.io/v1\n      command: /bin/sh\n"+
+				"      interactiveMode: Never"),
+		Entry("auth-provider", inlineCluster,
+			"    auth-provider:\n      name: oidc\n      config:\n        cmd-path: /bin/sh"),
+		Entry("tokenFile", inlineCluster,
+			"    tokenFile: /var/run/secrets/kubernetes.io/serviceaccount/token"),
+		Entry("client-certificate and client-key files", inlineCluster,
+			"    client-certificate: /etc/tls/tls.crt\n    client-key: /etc/tls/tls.key"),
+		Entry("basic authentication", inlineCluster,
+			"    username: admin\n    password: secret"),
+		Entry("a certificate-authority file",
+			"    server: https://remote.example.com:6443\n"+
+				"    certificate-authority: /var/run/secrets/kubernetes.io/serviceaccount/ca.crt",
+			inlineToken),
+		Entry("no server", "    certificate-authority-data: Y2EtZGF0YQ==", inlineToken),
+		Entry("no credentials", inlineCluster, "    as: system:admin"),
+	)
+})
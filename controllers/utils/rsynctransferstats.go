@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+var (
+	rsyncTransferredSizeRegex = regexp.MustCompile(`^\s*Total transferred file size:\s+([0-9.,]+)([KMGTP]?)`)
+	rsyncBytesSentRegex       = regexp.MustCompile(`^\s*Total bytes sent:\s+([0-9.,]+)([KMGTP]?)`)
+	rsyncSparseSupportRegex   = regexp.MustCompile(`^\s*Destination sparse file support:\s+(\S+)`)
+)
+
+// RsyncTransferStatsCollector picks the rsync transfer statistics out of the
+// mover logs of the rsync based movers. Statistics from multiple rsync
+// invocations (retries or additional passes) are summed.
+type RsyncTransferStatsCollector struct {
+	stats volsyncv1alpha1.RsyncTransferStats
+	found bool
+}
+
+// Filter wraps a log line filter, capturing the statistics while passing
+// everything through to the wrapped filter
+func (t *RsyncTransferStatsCollector) Filter(next func(string) *string) func(string) *string {
+	return func(line string) *string {
+		if m := rsyncTransferredSizeRegex.FindStringSubmatch(line); m != nil {
+			t.stats.LogicalBytes += parseRsyncSize(m[1], m[2])
+			t.found = true
+		} else if m := rsyncBytesSentRegex.FindStringSubmatch(line); m != nil {
+			t.stats.PhysicalBytes += parseRsyncSize(m[1], m[2])
+			t.found = true
+		} else if m := rsyncSparseSupportRegex.FindStringSubmatch(line); m != nil && m[1] != "unknown" {
+			supported := m[1] == "supported"
+			t.stats.DestinationSparseSupported = &supported
+			t.found = true
+		}
+		return next(line)
+	}
+}
+
+// Result returns the transfer statistics, or nil if none were found in the
+// logs
+func (t *RsyncTransferStatsCollector) Result() *volsyncv1alpha1.RsyncTransferStats {
+	if !t.found {
+		return nil
+	}
+	stats := t.stats
+	return &stats
+}
+
+// parseRsyncSize converts a size as printed by rsync (either with thousands
+// separators or with a decimal unit suffix when using -h) into bytes
+func parseRsyncSize(number string, suffix string) int64 {
+	value, err := strconv.ParseFloat(strings.ReplaceAll(number, ",", ""), 64)
+	if err != nil {
+		return 0
+	}
+	exponent := 0
+	if suffix != "" {
+		exponent = strings.Index("KMGTP", suffix) + 1
+	}
+	return int64(math.Round(value * math.Pow(1000, float64(exponent))))
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("Rsync transfer stats", func() {
+	var collector *utils.RsyncTransferStatsCollector
+	var filter func(string) *string
+
+	BeforeEach(func() {
+		collector = &utils.RsyncTransferStatsCollector{}
+		filter = collector.Filter(utils.AllLines)
+	})
+
+	It("are collected from the mover logs", func() {
+		logs := []string{
+			"Destination sparse file support: unsupported",
+			"Total file size: 38.44M bytes",
+			"Total transferred file size: 38.44M bytes",
+			"Literal data: 38.44M bytes",
+			"Total bytes sent: 37.57M",
+			"Total bytes received: 529",
+			"sent 37.57M bytes  received 529 bytes  25.05M bytes/sec",
+		}
+		for _, line := range logs {
+			filter(line)
+		}
+
+		stats := collector.Result()
+		Expect(stats).NotTo(BeNil())
+		Expect(stats.LogicalBytes).To(Equal(int64(38440000)))
+		Expect(stats.PhysicalBytes).To(Equal(int64(37570000)))
+		Expect(stats.DestinationSparseSupported).To(HaveValue(BeFalse()))
+	})
+
+	It("parses sizes with thousands separators", func() {
+		filter("Total transferred file size: 1,234,567 bytes")
+		filter("Total bytes sent: 529")
+
+		stats := collector.Result()
+		Expect(stats).NotTo(BeNil())
+		Expect(stats.LogicalBytes).To(Equal(int64(1234567)))
+		Expect(stats.PhysicalBytes).To(Equal(int64(529)))
+		Expect(stats.DestinationSparseSupported).To(BeNil())
+	})
+
+	It("sums the stats of multiple rsync invocations", func() {
+		filter("Total transferred file size: 1.5G bytes")
+		filter("Total transferred file size: 500M bytes")
+
+		Expect(collector.Result().LogicalBytes).To(Equal(int64(2000000000)))
+	})
+
+	It("passes the log lines through to the wrapped filter", func() {
+		line := "Total bytes sent: 529"
+		Expect(filter(line)).To(HaveValue(Equal(line)))
+	})
+
+	It("reports nothing when there are no stats", func() {
+		filter("Rsync completed in 1s")
+		Expect(collector.Result()).To(BeNil())
+	})
+})
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+// SecretsStoreCSIDriver is the name of the Secrets Store CSI driver
+const SecretsStoreCSIDriver = "secrets-store.csi.k8s.io"
+
+// SecretStoreVolumeSource returns the (read-only) volume that provides the
+// credentials referenced by the MoverSecretRef
+func SecretStoreVolumeSource(ref *volsyncv1alpha1.MoverSecretRef) corev1.VolumeSource {
+	return corev1.VolumeSource{
+		CSI: &corev1.CSIVolumeSource{
+			Driver:   SecretsStoreCSIDriver,
+			ReadOnly: ptr.To(true),
+			VolumeAttributes: map[string]string{
+				"secretProviderClass": ref.SecretProviderClass,
+			},
+		},
+	}
+}
+
+// AddSecretStoreVolume adds the volume with the credentials referenced by the
+// MoverSecretRef to the pod and mounts it into the container at mountPath
+func AddSecretStoreVolume(podSpec *corev1.PodSpec, container *corev1.Container,
+	ref *volsyncv1alpha1.MoverSecretRef, volumeName string, mountPath string) {
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name:         volumeName,
+		VolumeSource: SecretStoreVolumeSource(ref),
+	})
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      volumeName,
+		MountPath: mountPath,
+		ReadOnly:  true,
+	})
+}
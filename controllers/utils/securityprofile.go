@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+// SecurityProfileFor returns the security profile of a ReplicationSource or
+// ReplicationDestination
+func SecurityProfileFor(owner metav1.Object) volsyncv1alpha1.SecurityProfileType {
+	var profile volsyncv1alpha1.SecurityProfileType
+	switch o := owner.(type) {
+	case *volsyncv1alpha1.ReplicationSource:
+		profile = o.Spec.SecurityProfile
+	case *volsyncv1alpha1.ReplicationDestination:
+		profile = o.Spec.SecurityProfile
+	}
+	if profile == "" {
+		profile = volsyncv1alpha1.SecurityProfileDefault
+	}
+	return profile
+}
+
+// MoverSecurityContextFor returns the moverSecurityContext of the mover that
+// is configured in a ReplicationSource or ReplicationDestination
+func MoverSecurityContextFor(owner metav1.Object) *corev1.PodSecurityContext {
+	switch o := owner.(type) {
+	case *volsyncv1alpha1.ReplicationSource:
+		switch {
+		case o.Spec.RsyncTLS != nil:
+			return o.Spec.RsyncTLS.MoverSecurityContext
+		case o.Spec.Rclone != nil:
+			return o.Spec.Rclone.MoverSecurityContext
+		case o.Spec.Restic != nil:
+			return o.Spec.Restic.MoverSecurityContext
+		case o.Spec.Block != nil:
+			return o.Spec.Block.MoverSecurityContext
+		case o.Spec.NFS != nil:
+			return o.Spec.NFS.MoverSecurityContext
+		case o.Spec.Mock != nil:
+			return o.Spec.Mock.MoverSecurityContext
+		case o.Spec.Syncthing != nil:
+			return o.Spec.Syncthing.MoverSecurityContext
+		}
+	case *volsyncv1alpha1.ReplicationDestination:
+		switch {
+		case o.Spec.RsyncTLS != nil:
+			return o.Spec.RsyncTLS.MoverSecurityContext
+		case o.Spec.Rclone != nil:
+			return o.Spec.Rclone.MoverSecurityContext
+		case o.Spec.Restic != nil:
+			return o.Spec.Restic.MoverSecurityContext
+		case o.Spec.Block != nil:
+			return o.Spec.Block.MoverSecurityContext
+		case o.Spec.NFS != nil:
+			return o.Spec.NFS.MoverSecurityContext
+		case o.Spec.Mock != nil:
+			return o.Spec.Mock.MoverSecurityContext
+		}
+	}
+	return nil
+}
+
+// ValidateSecurityProfile returns an error describing how to fix the
+// moverSecurityContext if it prevents the Hardened profile from being applied
+func ValidateSecurityProfile(podSC *corev1.PodSecurityContext) error {
+	if podSC == nil || podSC.RunAsUser == nil || *podSC.RunAsUser == 0 {
+		return fmt.Errorf("the Hardened security profile requires moverSecurityContext.runAsUser to be set to a " +
+			"non-root user that can access the data on the volume (also set fsGroup if the storage supports it)")
+	}
+	if podSC.RunAsNonRoot != nil && !*podSC.RunAsNonRoot {
+		return fmt.Errorf("the Hardened security profile cannot be used with moverSecurityContext.runAsNonRoot=false")
+	}
+	if podSC.SeccompProfile != nil && podSC.SeccompProfile.Type == corev1.SeccompProfileTypeUnconfined {
+		return fmt.Errorf("the Hardened security profile cannot be used with an Unconfined " +
+			"moverSecurityContext.seccompProfile")
+	}
+	return nil
+}
+
+// SecurityReport describes the hardening measures that are applied to a
+// mover. runsAsRoot is true if the mover runs as root with added capabilities
+// (e.g., privileged movers).
+func SecurityReport(profile volsyncv1alpha1.SecurityProfileType, podSC *corev1.PodSecurityContext,
+	runsAsRoot bool) *volsyncv1alpha1.SecurityStatus {
+	hardened := profile == volsyncv1alpha1.SecurityProfileHardened
+	nonRoot := hardened || (!runsAsRoot && podSC != nil &&
+		((podSC.RunAsNonRoot != nil && *podSC.RunAsNonRoot) || (podSC.RunAsUser != nil && *podSC.RunAsUser != 0)))
+	seccomp := hardened || (podSC != nil && podSC.SeccompProfile != nil &&
+		podSC.SeccompProfile.Type == corev1.SeccompProfileTypeRuntimeDefault)
+
+	return &volsyncv1alpha1.SecurityStatus{
+		Profile: profile,
+		Measures: []volsyncv1alpha1.SecurityMeasure{
+			{Name: volsyncv1alpha1.SecurityMeasureNonRoot, Active: nonRoot},
+			// All movers use a read-only root filesystem
+			{Name: volsyncv1alpha1.SecurityMeasureReadOnlyRootFilesystem, Active: true},
+			{Name: volsyncv1alpha1.SecurityMeasureSeccompRuntimeDefault, Active: seccomp},
+			{Name: volsyncv1alpha1.SecurityMeasureCapabilitiesDropped, Active: !runsAsRoot},
+			{Name: volsyncv1alpha1.SecurityMeasureUnprivileged, Active: !runsAsRoot},
+		},
+	}
+}
+
+// ApplySecurityProfile updates the mover pod template with the default mover
+// securityContext if the owner does not set one, and hardens it if the owner
+// uses the Hardened security profile. It must be called after all other
+// changes to the security settings of the pod.
+func ApplySecurityProfile(podTemplateSpec *corev1.PodTemplateSpec, owner metav1.Object) {
+	if podTemplateSpec.Spec.SecurityContext == nil {
+		podTemplateSpec.Spec.SecurityContext = DefaultMoverSecurityContextFor(podRunsAsRoot(&podTemplateSpec.Spec))
+	}
+
+	if SecurityProfileFor(owner) != volsyncv1alpha1.SecurityProfileHardened {
+		return
+	}
+
+	// The pod securityContext may be shared with the moverConfig of the owner
+	podSC := podTemplateSpec.Spec.SecurityContext.DeepCopy()
+	if podSC == nil {
+		podSC = &corev1.PodSecurityContext{}
+	}
+	podSC.RunAsNonRoot = ptr.To(true)
+	if podSC.SeccompProfile == nil {
+		podSC.SeccompProfile = &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault}
+	}
+	podTemplateSpec.Spec.SecurityContext = podSC
+
+	harden := func(c *corev1.Container) {
+		if c.SecurityContext == nil {
+			c.SecurityContext = &corev1.SecurityContext{}
+		}
+		c.SecurityContext.AllowPrivilegeEscalation = ptr.To(false)
+		c.SecurityContext.Capabilities = &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}}
+		c.SecurityContext.Privileged = ptr.To(false)
+		c.SecurityContext.ReadOnlyRootFilesystem = ptr.To(true)
+		c.SecurityContext.RunAsNonRoot = nil
+		c.SecurityContext.RunAsUser = nil
+	}
+	for i := range podTemplateSpec.Spec.InitContainers {
+		harden(&podTemplateSpec.Spec.InitContainers[i])
+	}
+	for i := range podTemplateSpec.Spec.Containers {
+		harden(&podTemplateSpec.Spec.Containers[i])
+	}
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("Security profiles", func() {
+	var rs *volsyncv1alpha1.ReplicationSource
+	var podSC *corev1.PodSecurityContext
+
+	BeforeEach(func() {
+		podSC = &corev1.PodSecurityContext{
+			RunAsUser: ptr.To[int64](1000),
+			FSGroup:   ptr.To[int64](1000),
+		}
+		rs = &volsyncv1alpha1.ReplicationSource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "rs",
+				Namespace: "ns",
+			},
+			Spec: volsyncv1alpha1.ReplicationSourceSpec{
+				Restic: &volsyncv1alpha1.ReplicationSourceResticSpec{
+					MoverConfig: volsyncv1alpha1.MoverConfig{
+						MoverSecurityContext: podSC,
+					},
+				},
+			},
+		}
+	})
+
+	It("defaults to the Default profile", func() {
+		Expect(utils.SecurityProfileFor(rs)).To(Equal(volsyncv1alpha1.SecurityProfileDefault))
+		Expect(utils.MoverSecurityContextFor(rs)).To(Equal(podSC))
+	})
+
+	DescribeTable("validating the moverSecurityContext for the Hardened profile",
+		func(sc *corev1.PodSecurityContext, valid bool) {
+			err := utils.ValidateSecurityProfile(sc)
+			if valid {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+		Entry("missing", nil, false),
+		Entry("without runAsUser", &corev1.PodSecurityContext{FSGroup: ptr.To[int64](1000)}, false),
+		Entry("running as root", &corev1.PodSecurityContext{RunAsUser: ptr.To[int64](0)}, false),
+		Entry("with runAsNonRoot=false", &corev1.PodSecurityContext{RunAsUser: ptr.To[int64](1000),
+			RunAsNonRoot: ptr.To(false)}, false),
+		Entry("with an Unconfined seccomp profile", &corev1.PodSecurityContext{RunAsUser: ptr.To[int64](1000),
+			SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeUnconfined}}, false),
+		Entry("with a non-root user", &corev1.PodSecurityContext{RunAsUser: ptr.To[int64](1000)}, true),
+	)
+
+	It("reports the measures that are active", func() {
+		report := utils.SecurityReport(volsyncv1alpha1.SecurityProfileDefault, podSC, false)
+		Expect(report.Profile).To(Equal(volsyncv1alpha1.SecurityProfileDefault))
+		Expect(report.Measures).To(ContainElements(
+			volsyncv1alpha1.SecurityMeasure{Name: volsyncv1alpha1.SecurityMeasureNonRoot, Active: true},
+			volsyncv1alpha1.SecurityMeasure{Name: volsyncv1alpha1.SecurityMeasureSeccompRuntimeDefault, Active: false},
+			volsyncv1alpha1.SecurityMeasure{Name: volsyncv1alpha1.SecurityMeasureUnprivileged, Active: true},
+		))
+
+		report = utils.SecurityReport(volsyncv1alpha1.SecurityProfileDefault, podSC, true)
+		Expect(report.Measures).To(ContainElements(
+			volsyncv1alpha1.SecurityMeasure{Name: volsyncv1alpha1.SecurityMeasureNonRoot, Active: false},
+			volsyncv1alpha1.SecurityMeasure{Name: volsyncv1alpha1.SecurityMeasureCapabilitiesDropped, Active: false},
+		))
+
+		report = utils.SecurityReport(volsyncv1alpha1.SecurityProfileHardened, podSC, false)
+		for _, m := range report.Measures {
+			Expect(m.Active).To(BeTrue(), m.Name)
+		}
+	})
+
+	Describe("applying the profile to a pod", func() {
+		var template *corev1.PodTemplateSpec
+
+		BeforeEach(func() {
+			template = &corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					SecurityContext: podSC,
+					Containers: []corev1.Container{{
+						Name: "mover",
+						SecurityContext: &corev1.SecurityContext{
+							Capabilities: &corev1.Capabilities{
+								Add:  []corev1.Capability{"DAC_OVERRIDE"},
+								Drop: []corev1.Capability{"ALL"},
+							},
+							RunAsUser: ptr.To[int64](0),
+						},
+					}},
+				},
+			}
+		})
+
+		It("leaves the pod alone with the Default profile", func() {
+			orig := template.DeepCopy()
+			utils.ApplySecurityProfile(template, rs)
+			Expect(template).To(Equal(orig))
+		})
+
+		It("hardens the pod with the Hardened profile", func() {
+			rs.Spec.SecurityProfile = volsyncv1alpha1.SecurityProfileHardened
+			utils.ApplySecurityProfile(template, rs)
+
+			sc := template.Spec.SecurityContext
+			Expect(sc.RunAsNonRoot).To(HaveValue(BeTrue()))
+			Expect(sc.RunAsUser).To(HaveValue(Equal(int64(1000))))
+			Expect(sc.SeccompProfile.Type).To(Equal(corev1.SeccompProfileTypeRuntimeDefault))
+			// The moverSecurityContext of the owner is not modified
+			Expect(podSC.RunAsNonRoot).To(BeNil())
+
+			csc := template.Spec.Containers[0].SecurityContext
+			Expect(csc.Capabilities.Add).To(BeEmpty())
+			Expect(csc.Capabilities.Drop).To(ConsistOf(corev1.Capability("ALL")))
+			Expect(csc.AllowPrivilegeEscalation).To(HaveValue(BeFalse()))
+			Expect(csc.Privileged).To(HaveValue(BeFalse()))
+			Expect(csc.ReadOnlyRootFilesystem).To(HaveValue(BeTrue()))
+			Expect(csc.RunAsUser).To(BeNil())
+		})
+	})
+
+	Describe("the default mover securityContext", func() {
+		AfterEach(func() {
+			utils.DefaultMoverSecurityContext = ""
+			Expect(utils.LoadDefaultMoverSecurityContext()).To(Succeed())
+		})
+
+		It("is not set by default", func() {
+			Expect(utils.LoadDefaultMoverSecurityContext()).To(Succeed())
+			Expect(utils.DefaultMoverSecurityContextFor(false)).To(BeNil())
+		})
+
+		It("rejects invalid values", func() {
+			utils.DefaultMoverSecurityContext = `{"runAsUser": "nobody"}`
+			Expect(utils.LoadDefaultMoverSecurityContext()).NotTo(Succeed())
+			utils.DefaultMoverSecurityContext = `{"runAsUsr": 1000}`
+			Expect(utils.LoadDefaultMoverSecurityContext()).NotTo(Succeed())
+			utils.DefaultMoverSecurityContext = "/nonexistent/securitycontext.yaml"
+			Expect(utils.LoadDefaultMoverSecurityContext()).NotTo(Succeed())
+		})
+
+		It("is applied to unprivileged movers without a moverSecurityContext", func() {
+			utils.DefaultMoverSecurityContext = `{"runAsUser": 2000, "fsGroup": 2000}`
+			Expect(utils.LoadDefaultMoverSecurityContext()).To(Succeed())
+			Expect(utils.DefaultMoverSecurityContextFor(true)).To(BeNil())
+
+			template := &corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "mover"}},
+				},
+			}
+			rs.Spec.Restic.MoverSecurityContext = nil
+			utils.ApplySecurityProfile(template, rs)
+			Expect(template.Spec.SecurityContext.RunAsUser).To(HaveValue(Equal(int64(2000))))
+			Expect(template.Spec.SecurityContext.FSGroup).To(HaveValue(Equal(int64(2000))))
+
+			// A moverSecurityContext takes precedence
+			template.Spec.SecurityContext = podSC
+			utils.ApplySecurityProfile(template, rs)
+			Expect(template.Spec.SecurityContext).To(Equal(podSC))
+
+			// Privileged movers run as root and do not get the default
+			template.Spec.SecurityContext = nil
+			template.Spec.Containers[0].SecurityContext = &corev1.SecurityContext{
+				RunAsUser: ptr.To[int64](0),
+			}
+			utils.ApplySecurityProfile(template, rs)
+			Expect(template.Spec.SecurityContext).To(BeNil())
+		})
+
+		It("may be read from a file", func() {
+			file := filepath.Join(GinkgoT().TempDir(), "securitycontext.yaml")
+			Expect(os.WriteFile(file, []byte("runAsUser: 3000\nrunAsNonRoot: true\n"), 0600)).To(Succeed())
+			utils.DefaultMoverSecurityContext = file
+			Expect(utils.LoadDefaultMoverSecurityContext()).To(Succeed())
+			sc := utils.DefaultMoverSecurityContextFor(false)
+			Expect(sc.RunAsUser).To(HaveValue(Equal(int64(3000))))
+			Expect(sc.RunAsNonRoot).To(HaveValue(BeTrue()))
+		})
+	})
+})
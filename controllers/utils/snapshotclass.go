@@ -0,0 +1,39 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import "strings"
+
+// DefaultVolumeSnapshotClasses is a comma-separated list of
+// <storageClass>=<volumeSnapshotClass> pairs that select the
+// VolumeSnapshotClass used to snapshot PVCs of each StorageClass when
+// volumeSnapshotClassName is not set
+var DefaultVolumeSnapshotClasses string
+
+// DefaultVolumeSnapshotClassFor returns the VolumeSnapshotClass configured
+// for the StorageClass, or nil if there is none
+func DefaultVolumeSnapshotClassFor(storageClassName string) *string {
+	for _, pair := range strings.Split(DefaultVolumeSnapshotClasses, ",") {
+		sc, vsc, _ := strings.Cut(pair, "=")
+		sc, vsc = strings.TrimSpace(sc), strings.TrimSpace(vsc)
+		if sc != "" && vsc != "" && sc == storageClassName {
+			return &vsc
+		}
+	}
+	return nil
+}
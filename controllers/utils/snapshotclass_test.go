@@ -0,0 +1,49 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("Default VolumeSnapshotClasses", func() {
+	AfterEach(func() {
+		utils.DefaultVolumeSnapshotClasses = ""
+	})
+
+	It("has no default when none are configured", func() {
+		Expect(utils.DefaultVolumeSnapshotClassFor("fast")).To(BeNil())
+	})
+
+	It("returns the class configured for the StorageClass", func() {
+		utils.DefaultVolumeSnapshotClasses = "fast=fast-snap, slow = slow-snap"
+		Expect(utils.DefaultVolumeSnapshotClassFor("fast")).To(HaveValue(Equal("fast-snap")))
+		Expect(utils.DefaultVolumeSnapshotClassFor("slow")).To(HaveValue(Equal("slow-snap")))
+		Expect(utils.DefaultVolumeSnapshotClassFor("other")).To(BeNil())
+	})
+
+	It("ignores malformed entries", func() {
+		utils.DefaultVolumeSnapshotClasses = "fast,slow=,=snap"
+		Expect(utils.DefaultVolumeSnapshotClassFor("fast")).To(BeNil())
+		Expect(utils.DefaultVolumeSnapshotClassFor("slow")).To(BeNil())
+		Expect(utils.DefaultVolumeSnapshotClassFor("")).To(BeNil())
+	})
+})
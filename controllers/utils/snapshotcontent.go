@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+// MarkSnapshotContent labels the VolumeSnapshotContent bound to snap, if its
+// deletion policy is Retain, so that it can be attributed to VolSync (and
+// handled according to policy) once snap has been deleted.
+func MarkSnapshotContent(ctx context.Context, c client.Client, logger logr.Logger,
+	owner client.Object, policy volsyncv1alpha1.SnapshotContentPolicyType, snap *snapv1.VolumeSnapshot) error {
+	if snap.Status == nil || snap.Status.BoundVolumeSnapshotContentName == nil {
+		return nil
+	}
+	if policy == "" {
+		policy = volsyncv1alpha1.SnapshotContentPolicyIgnore
+	}
+
+	content := &snapv1.VolumeSnapshotContent{}
+	if err := c.Get(ctx, types.NamespacedName{Name: *snap.Status.BoundVolumeSnapshotContentName},
+		content); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if content.Spec.DeletionPolicy != snapv1.VolumeSnapshotContentRetain {
+		return nil
+	}
+
+	ownerRef := KindAndName(c.Scheme(), owner)
+	updated := AddLabel(content, volsyncv1alpha1.SnapshotContentPolicyLabel, string(policy))
+	annotations := content.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	if annotations[volsyncv1alpha1.SnapshotContentOwnerAnnotation] != ownerRef {
+		annotations[volsyncv1alpha1.SnapshotContentOwnerAnnotation] = ownerRef
+		content.SetAnnotations(annotations)
+		updated = true
+	}
+	if !updated {
+		return nil
+	}
+	logger.V(1).Info("marking retained VolumeSnapshotContent", "content", content.GetName())
+	return c.Update(ctx, content)
+}
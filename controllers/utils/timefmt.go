@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+// FormatTime returns the time as an RFC3339 string in UTC. This is the format
+// used for all times that VolSync writes into annotations, events, and status
+// messages.
+func FormatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// SetMoverStatusTimes records the start & completion times of the mover Job in
+// the MoverStatus along with the duration between them. Fields are cleared if
+// the Job does not (yet) have the corresponding time.
+func SetMoverStatusTimes(moverStatus *volsyncv1alpha1.MoverStatus, job *batchv1.Job) {
+	moverStatus.StartTime = nil
+	moverStatus.CompletionTime = nil
+	moverStatus.Duration = nil
+	if job == nil {
+		return
+	}
+
+	if job.Status.StartTime != nil {
+		moverStatus.StartTime = &metav1.Time{Time: job.Status.StartTime.UTC()}
+	}
+
+	completion := job.Status.CompletionTime
+	if completion == nil {
+		// Failed jobs have no completionTime, use the time the Job was marked
+		// as failed instead
+		for _, c := range job.Status.Conditions {
+			if c.Type == batchv1.JobFailed && c.Status == corev1.ConditionTrue {
+				completion = &c.LastTransitionTime
+				break
+			}
+		}
+	}
+	if completion != nil && !completion.IsZero() {
+		moverStatus.CompletionTime = &metav1.Time{Time: completion.UTC()}
+	}
+
+	if moverStatus.StartTime != nil && moverStatus.CompletionTime != nil {
+		moverStatus.Duration = &metav1.Duration{
+			Duration: moverStatus.CompletionTime.Sub(moverStatus.StartTime.Time),
+		}
+	}
+}
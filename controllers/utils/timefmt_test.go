@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("Time formatting", func() {
+	It("Formats times as RFC3339 in UTC", func() {
+		loc := time.FixedZone("UTC-5", -5*60*60)
+		t := time.Date(2024, 3, 1, 20, 30, 0, 0, loc)
+		Expect(utils.FormatTime(t)).To(Equal("2024-03-02T01:30:00Z"))
+	})
+})
+
+var _ = Describe("Mover status times", func() {
+	var moverStatus *volsyncv1alpha1.MoverStatus
+	var job *batchv1.Job
+	start := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	BeforeEach(func() {
+		moverStatus = &volsyncv1alpha1.MoverStatus{}
+		job = &batchv1.Job{
+			Status: batchv1.JobStatus{
+				StartTime: &metav1.Time{Time: start},
+			},
+		}
+	})
+
+	It("Records start time only while the job is running", func() {
+		utils.SetMoverStatusTimes(moverStatus, job)
+		Expect(moverStatus.StartTime.Time).To(Equal(start))
+		Expect(moverStatus.CompletionTime).To(BeNil())
+		Expect(moverStatus.Duration).To(BeNil())
+	})
+
+	It("Records completion time and duration for successful jobs", func() {
+		job.Status.CompletionTime = &metav1.Time{Time: start.Add(90 * time.Second)}
+		utils.SetMoverStatusTimes(moverStatus, job)
+		Expect(moverStatus.CompletionTime.Time).To(Equal(start.Add(90 * time.Second)))
+		Expect(moverStatus.Duration.Duration).To(Equal(90 * time.Second))
+	})
+
+	It("Uses the failed condition time for failed jobs", func() {
+		job.Status.Conditions = []batchv1.JobCondition{{
+			Type:               batchv1.JobFailed,
+			Status:             corev1.ConditionTrue,
+			LastTransitionTime: metav1.Time{Time: start.Add(time.Minute)},
+		}}
+		utils.SetMoverStatusTimes(moverStatus, job)
+		Expect(moverStatus.CompletionTime.Time).To(Equal(start.Add(time.Minute)))
+		Expect(moverStatus.Duration.Duration).To(Equal(time.Minute))
+	})
+
+	It("Clears the times when the job is missing", func() {
+		moverStatus.StartTime = &metav1.Time{Time: start}
+		utils.SetMoverStatusTimes(moverStatus, nil)
+		Expect(moverStatus.StartTime).To(BeNil())
+	})
+})
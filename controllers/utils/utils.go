@@ -44,6 +44,13 @@ const (
 	ErrUnableToSetControllerRef = "unable to set controller reference"
 )
 
+const (
+	// MoverTokensMountPath is where the service account tokens of
+	// MoverServiceAccountTokens are mounted in the data mover containers
+	MoverTokensMountPath  = "/var/run/secrets/volsync/tokens"
+	moverTokensVolumeName = "volsync-sa-tokens"
+)
+
 // Check if error is due to the CRD not being present (API kind/group not available)
 // This has changed recently in controller-runtime v0.15.0, see:
 // https://github.com/kubernetes-sigs/controller-runtime/pull/2116
@@ -222,7 +229,7 @@ func AppendDebugMoverEnvVar(replicationSourceOrDestObj metav1.Object, envVars []
 	return envVars
 }
 
-// Updates to set the securityContext, podLabels on mover pod in the spec and resourceRequirements on the mover
+// Updates to set the securityContext, podLabels, podAnnotations on mover pod in the spec and resourceRequirements on the mover
 // containers based on what is set in the MoverConfig
 func UpdatePodTemplateSpecFromMoverConfig(podTemplateSpec *corev1.PodTemplateSpec,
 	moverConfig volsyncv1alpha1.MoverConfig, defaultMoverResources corev1.ResourceRequirements) {
@@ -238,6 +245,14 @@ func UpdatePodTemplateSpecFromMoverConfig(podTemplateSpec *corev1.PodTemplateSpe
 		podTemplateSpec.Spec.Affinity = moverConfig.MoverAffinity
 	}
 
+	// Priority and scheduler (cluster defaults if not set)
+	if moverConfig.MoverPriorityClassName != nil {
+		podTemplateSpec.Spec.PriorityClassName = *moverConfig.MoverPriorityClassName
+	}
+	if moverConfig.MoverSchedulerName != nil {
+		podTemplateSpec.Spec.SchedulerName = *moverConfig.MoverSchedulerName
+	}
+
 	// Adjust the job/deploy containers resourceRequirements based on resourceRequirements from the moverConfig
 	moverResources := defaultMoverResources
 	if moverConfig.MoverResources != nil {
@@ -247,6 +262,9 @@ func UpdatePodTemplateSpecFromMoverConfig(podTemplateSpec *corev1.PodTemplateSpe
 		podTemplateSpec.Spec.Containers[i].Resources = moverResources
 	}
 
+	addMoverEnv(podTemplateSpec, moverConfig.MoverEnv)
+	addMoverServiceAccountTokens(podTemplateSpec, moverConfig.MoverServiceAccountTokens)
+
 	// Set custom labels on the job pod if specified in the moverConfig
 	if podTemplateSpec.Labels == nil {
 		podTemplateSpec.Labels = map[string]string{}
@@ -254,4 +272,71 @@ func UpdatePodTemplateSpecFromMoverConfig(podTemplateSpec *corev1.PodTemplateSpe
 	for label, value := range moverConfig.MoverPodLabels {
 		podTemplateSpec.Labels[label] = value
 	}
+
+	// Set custom annotations on the job pod if specified in the moverConfig
+	if len(moverConfig.MoverPodAnnotations) > 0 && podTemplateSpec.Annotations == nil {
+		podTemplateSpec.Annotations = map[string]string{}
+	}
+	for annotation, value := range moverConfig.MoverPodAnnotations {
+		podTemplateSpec.Annotations[annotation] = value
+	}
+
+	setEvictionProtection(podTemplateSpec, moverConfig.ProtectFromEviction)
+}
+
+// addMoverEnv puts the user's environment variables in front of those of each
+// container. Variables that the container already has are skipped, and since
+// the last definition of a variable wins, those that VolSync adds later take
+// precedence as well.
+func addMoverEnv(podTemplateSpec *corev1.PodTemplateSpec, moverEnv []corev1.EnvVar) {
+	if len(moverEnv) == 0 {
+		return
+	}
+	for i := range podTemplateSpec.Spec.Containers {
+		container := &podTemplateSpec.Spec.Containers[i]
+		existing := map[string]bool{}
+		for _, e := range container.Env {
+			existing[e.Name] = true
+		}
+		env := []corev1.EnvVar{}
+		for _, e := range moverEnv {
+			if !existing[e.Name] {
+				env = append(env, *e.DeepCopy())
+			}
+		}
+		container.Env = append(env, container.Env...)
+	}
+}
+
+// addMoverServiceAccountTokens projects the requested service account tokens
+// into a volume that is mounted in each container
+func addMoverServiceAccountTokens(podTemplateSpec *corev1.PodTemplateSpec,
+	tokens []volsyncv1alpha1.MoverServiceAccountToken) {
+	if len(tokens) == 0 {
+		return
+	}
+	sources := []corev1.VolumeProjection{}
+	for _, t := range tokens {
+		sources = append(sources, corev1.VolumeProjection{
+			ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+				Audience:          t.Audience,
+				ExpirationSeconds: t.ExpirationSeconds,
+				Path:              t.Name,
+			},
+		})
+	}
+	podTemplateSpec.Spec.Volumes = append(podTemplateSpec.Spec.Volumes, corev1.Volume{
+		Name: moverTokensVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{Sources: sources},
+		},
+	})
+	for i := range podTemplateSpec.Spec.Containers {
+		container := &podTemplateSpec.Spec.Containers[i]
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      moverTokensVolumeName,
+			MountPath: MoverTokensMountPath,
+			ReadOnly:  true,
+		})
+	}
 }
@@ -470,6 +470,16 @@ var _ = Describe("utils tests", func() {
 				}
 			})
 
+			When("pod annotations are also set", func() {
+				It("Should add the annotations to the podTemplateSpec", func() {
+					moverConfig.MoverPodAnnotations = map[string]string{
+						"sidecar.istio.io/inject": "false",
+					}
+					utils.UpdatePodTemplateSpecFromMoverConfig(podTemplateSpec, moverConfig, corev1.ResourceRequirements{})
+					Expect(podTemplateSpec.Annotations).To(HaveKeyWithValue("sidecar.istio.io/inject", "false"))
+				})
+			})
+
 			It("Should update the podTemplateSpec", func() {
 				utils.UpdatePodTemplateSpecFromMoverConfig(podTemplateSpec, moverConfig, corev1.ResourceRequirements{})
 
@@ -582,5 +592,86 @@ var _ = Describe("utils tests", func() {
 			})
 		})
 
+		When("moverConfig has a priorityClassName and schedulerName set", func() {
+			It("Should set them in the podTemplateSpec", func() {
+				moverConfig := volsyncv1alpha1.MoverConfig{
+					MoverPriorityClassName: ptr.To("low-priority"),
+					MoverSchedulerName:     ptr.To("batch-scheduler"),
+				}
+				utils.UpdatePodTemplateSpecFromMoverConfig(podTemplateSpec, moverConfig, corev1.ResourceRequirements{})
+				Expect(podTemplateSpec.Spec.PriorityClassName).To(Equal("low-priority"))
+				Expect(podTemplateSpec.Spec.SchedulerName).To(Equal("batch-scheduler"))
+			})
+
+			It("Should leave the cluster defaults otherwise", func() {
+				utils.UpdatePodTemplateSpecFromMoverConfig(podTemplateSpec, volsyncv1alpha1.MoverConfig{},
+					corev1.ResourceRequirements{})
+				Expect(podTemplateSpec.Spec.PriorityClassName).To(BeEmpty())
+				Expect(podTemplateSpec.Spec.SchedulerName).To(BeEmpty())
+			})
+		})
+
+		When("moverConfig has moverEnv set", func() {
+			BeforeEach(func() {
+				podTemplateSpec.Spec.Containers[0].Env = []corev1.EnvVar{
+					{Name: "DATA_DIR", Value: "/data"},
+				}
+			})
+
+			It("Should add the variables without overriding those of VolSync", func() {
+				moverConfig := volsyncv1alpha1.MoverConfig{
+					MoverEnv: []corev1.EnvVar{
+						{Name: "HTTPS_PROXY", Value: "http://proxy.example.com:3128"},
+						{Name: "DATA_DIR", Value: "/elsewhere"},
+						{Name: "VAULT_ROLE", ValueFrom: &corev1.EnvVarSource{
+							SecretKeyRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "vault"},
+								Key:                  "role",
+							},
+						}},
+					},
+				}
+				utils.UpdatePodTemplateSpecFromMoverConfig(podTemplateSpec, moverConfig, corev1.ResourceRequirements{})
+				env := podTemplateSpec.Spec.Containers[0].Env
+				Expect(env).To(HaveLen(3))
+				Expect(env[0]).To(Equal(moverConfig.MoverEnv[0]))
+				Expect(env[1]).To(Equal(moverConfig.MoverEnv[2]))
+				Expect(env[2]).To(Equal(corev1.EnvVar{Name: "DATA_DIR", Value: "/data"}))
+			})
+		})
+
+		When("moverConfig has moverServiceAccountTokens set", func() {
+			It("Should project the tokens into the containers", func() {
+				moverConfig := volsyncv1alpha1.MoverConfig{
+					MoverServiceAccountTokens: []volsyncv1alpha1.MoverServiceAccountToken{
+						{Name: "vault", Audience: "vault.example.com", ExpirationSeconds: ptr.To[int64](900)},
+						{Name: "proxy", Audience: "proxy.example.com"},
+					},
+				}
+				utils.UpdatePodTemplateSpecFromMoverConfig(podTemplateSpec, moverConfig, corev1.ResourceRequirements{})
+				Expect(podTemplateSpec.Spec.Volumes).To(HaveLen(1))
+				projected := podTemplateSpec.Spec.Volumes[0].Projected
+				Expect(projected).NotTo(BeNil())
+				Expect(projected.Sources).To(HaveLen(2))
+				Expect(projected.Sources[0].ServiceAccountToken.Path).To(Equal("vault"))
+				Expect(projected.Sources[0].ServiceAccountToken.Audience).To(Equal("vault.example.com"))
+				Expect(*projected.Sources[0].ServiceAccountToken.ExpirationSeconds).To(Equal(int64(900)))
+				Expect(projected.Sources[1].ServiceAccountToken.ExpirationSeconds).To(BeNil())
+
+				mounts := podTemplateSpec.Spec.Containers[0].VolumeMounts
+				Expect(mounts).To(HaveLen(1))
+				Expect(mounts[0].Name).To(Equal(podTemplateSpec.Spec.Volumes[0].Name))
+				Expect(mounts[0].MountPath).To(Equal(utils.MoverTokensMountPath))
+				Expect(mounts[0].ReadOnly).To(BeTrue())
+			})
+
+			It("Should not add a volume otherwise", func() {
+				utils.UpdatePodTemplateSpecFromMoverConfig(podTemplateSpec, volsyncv1alpha1.MoverConfig{},
+					corev1.ResourceRequirements{})
+				Expect(podTemplateSpec.Spec.Volumes).To(BeEmpty())
+				Expect(podTemplateSpec.Spec.Containers[0].VolumeMounts).To(BeEmpty())
+			})
+		})
+
 	})
 })
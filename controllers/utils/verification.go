@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+// VerificationLinePrefix starts the line in the mover logs that reports the
+// result of verifying the restored data: VOLSYNC_VERIFY=<result>[ <message>]
+const VerificationLinePrefix = "VOLSYNC_VERIFY="
+
+// VerificationCollector picks the result of the restore verification out of
+// the mover logs
+type VerificationCollector struct {
+	result  string
+	message string
+}
+
+// Filter wraps a log line filter, capturing the verification result while
+// passing everything through to the wrapped filter
+func (v *VerificationCollector) Filter(next func(string) *string) func(string) *string {
+	return func(line string) *string {
+		if strings.HasPrefix(line, VerificationLinePrefix) {
+			result, message, _ := strings.Cut(strings.TrimPrefix(line, VerificationLinePrefix), " ")
+			v.result = strings.TrimSpace(result)
+			v.message = strings.TrimSpace(message)
+		}
+		return next(line)
+	}
+}
+
+// Result returns the verification reported by the mover, or nil if the mover
+// did not verify the restored data
+func (v *VerificationCollector) Result() *volsyncv1alpha1.RestoreVerification {
+	result := volsyncv1alpha1.RestoreVerificationResult(v.result)
+	if result != volsyncv1alpha1.RestoreVerificationPassed && result != volsyncv1alpha1.RestoreVerificationFailed {
+		return nil
+	}
+	return &volsyncv1alpha1.RestoreVerification{
+		Result:  result,
+		Time:    ptr.To(metav1.Now()),
+		Message: v.message,
+	}
+}
+
+// Failed returns true if the mover reported that the restored data does not
+// match the backup
+func (v *VerificationCollector) Failed() bool {
+	return volsyncv1alpha1.RestoreVerificationResult(v.result) == volsyncv1alpha1.RestoreVerificationFailed
+}
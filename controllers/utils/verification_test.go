@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("Restore verification", func() {
+	var v *utils.VerificationCollector
+	var filter func(string) *string
+
+	BeforeEach(func() {
+		v = &utils.VerificationCollector{}
+		filter = v.Filter(utils.AllLines)
+	})
+
+	It("reports nothing if the mover did not verify", func() {
+		filter("restoring <Snapshot 0123abcd> to .")
+		Expect(v.Result()).To(BeNil())
+		Expect(v.Failed()).To(BeFalse())
+	})
+
+	It("passes the lines through to the wrapped filter", func() {
+		line := "VOLSYNC_VERIFY=Passed"
+		Expect(filter(line)).To(HaveValue(Equal(line)))
+	})
+
+	It("reports a successful verification", func() {
+		filter("VOLSYNC_VERIFY=Passed")
+		r := v.Result()
+		Expect(r).NotTo(BeNil())
+		Expect(r.Result).To(Equal(volsyncv1alpha1.RestoreVerificationPassed))
+		Expect(r.Message).To(BeEmpty())
+		Expect(r.Time).NotTo(BeNil())
+		Expect(v.Failed()).To(BeFalse())
+	})
+
+	It("reports a failed verification with its message", func() {
+		filter("VOLSYNC_VERIFY=Failed restored files do not match snapshot 0123abcd")
+		r := v.Result()
+		Expect(r).NotTo(BeNil())
+		Expect(r.Result).To(Equal(volsyncv1alpha1.RestoreVerificationFailed))
+		Expect(r.Message).To(Equal("restored files do not match snapshot 0123abcd"))
+		Expect(v.Failed()).To(BeTrue())
+	})
+
+	It("ignores unknown results", func() {
+		filter("VOLSYNC_VERIFY=Maybe")
+		Expect(v.Result()).To(BeNil())
+	})
+})
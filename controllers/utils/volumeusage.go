@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+// VolumeUsageLinePrefix starts the line in the mover logs that reports the
+// usage of the data volume: VOLSYNC_VOLUME_USAGE=<used bytes> <capacity bytes>
+const VolumeUsageLinePrefix = "VOLSYNC_VOLUME_USAGE="
+
+// VolumeUsageCollector picks the usage of the data volume out of the mover
+// logs
+type VolumeUsageCollector struct {
+	sample *volsyncv1alpha1.CapacitySample
+}
+
+// Filter wraps a log line filter, capturing the volume usage while passing
+// everything through to the wrapped filter
+func (v *VolumeUsageCollector) Filter(next func(string) *string) func(string) *string {
+	return func(line string) *string {
+		if strings.HasPrefix(line, VolumeUsageLinePrefix) {
+			fields := strings.Fields(strings.TrimPrefix(line, VolumeUsageLinePrefix))
+			if len(fields) == 2 {
+				used, usedErr := strconv.ParseInt(fields[0], 10, 64)
+				capacity, capErr := strconv.ParseInt(fields[1], 10, 64)
+				if usedErr == nil && capErr == nil && capacity > 0 {
+					v.sample = &volsyncv1alpha1.CapacitySample{
+						Time:          metav1.Now(),
+						UsedBytes:     used,
+						CapacityBytes: capacity,
+					}
+				}
+			}
+		}
+		return next(line)
+	}
+}
+
+// Record adds the volume usage reported by the mover to the samples in the
+// capacity forecast status. The samples are restarted if the volume changed.
+// Nothing is recorded if the status is nil (forecasting is not enabled).
+func (v *VolumeUsageCollector) Record(status *volsyncv1alpha1.CapacityForecastStatus, pvcName string) {
+	if status == nil || v.sample == nil {
+		return
+	}
+	if status.PVCName != pvcName {
+		status.PVCName = pvcName
+		status.Samples = nil
+		status.LastExpansionTime = nil
+	}
+	status.Samples = append(status.Samples, *v.sample)
+	if len(status.Samples) > volsyncv1alpha1.MaxCapacitySamples {
+		status.Samples = status.Samples[len(status.Samples)-volsyncv1alpha1.MaxCapacitySamples:]
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("Volume usage", func() {
+	var v *utils.VolumeUsageCollector
+	var filter func(string) *string
+	var status *volsyncv1alpha1.CapacityForecastStatus
+
+	BeforeEach(func() {
+		v = &utils.VolumeUsageCollector{}
+		filter = v.Filter(utils.AllLines)
+		status = &volsyncv1alpha1.CapacityForecastStatus{}
+	})
+
+	It("records the usage reported by the mover", func() {
+		filter("VOLSYNC_VOLUME_USAGE=1024 4096")
+		v.Record(status, "dest")
+		Expect(status.PVCName).To(Equal("dest"))
+		Expect(status.Samples).To(HaveLen(1))
+		Expect(status.Samples[0].UsedBytes).To(Equal(int64(1024)))
+		Expect(status.Samples[0].CapacityBytes).To(Equal(int64(4096)))
+	})
+
+	It("ignores malformed lines", func() {
+		filter("VOLSYNC_VOLUME_USAGE=1024")
+		filter("VOLSYNC_VOLUME_USAGE=a b")
+		v.Record(status, "dest")
+		Expect(status.Samples).To(BeEmpty())
+	})
+
+	It("does nothing when forecasting is not enabled", func() {
+		filter("VOLSYNC_VOLUME_USAGE=1024 4096")
+		Expect(func() { v.Record(nil, "dest") }).NotTo(Panic())
+	})
+
+	It("keeps only the newest samples", func() {
+		for i := range volsyncv1alpha1.MaxCapacitySamples + 3 {
+			c := &utils.VolumeUsageCollector{}
+			c.Filter(utils.AllLines)(fmt.Sprintf("VOLSYNC_VOLUME_USAGE=%d 4096", i))
+			c.Record(status, "dest")
+		}
+		Expect(status.Samples).To(HaveLen(volsyncv1alpha1.MaxCapacitySamples))
+		Expect(status.Samples[volsyncv1alpha1.MaxCapacitySamples-1].UsedBytes).
+			To(Equal(int64(volsyncv1alpha1.MaxCapacitySamples + 2)))
+	})
+
+	It("restarts the samples when the PVC changes", func() {
+		filter("VOLSYNC_VOLUME_USAGE=1024 4096")
+		v.Record(status, "old")
+		v.Record(status, "new")
+		Expect(status.PVCName).To(Equal("new"))
+		Expect(status.Samples).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	"slices"
+	"strings"
+)
+
+// WatchNamespaces is a comma-separated list of the namespaces that VolSync
+// watches. If empty, VolSync watches all namespaces.
+var WatchNamespaces string
+
+// WatchedNamespaces returns the namespaces listed in WatchNamespaces
+func WatchedNamespaces() []string {
+	namespaces := []string{}
+	for _, ns := range strings.Split(WatchNamespaces, ",") {
+		if ns = strings.TrimSpace(ns); ns != "" {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	return namespaces
+}
+
+// NamespaceScoped returns true if VolSync is restricted to a set of
+// namespaces. In this mode, it does not watch cluster-scoped objects.
+func NamespaceScoped() bool {
+	return len(WatchedNamespaces()) > 0
+}
+
+// NamespaceWatched returns true if VolSync watches the namespace
+func NamespaceWatched(namespace string) bool {
+	watched := WatchedNamespaces()
+	return len(watched) == 0 || slices.Contains(watched, namespace)
+}
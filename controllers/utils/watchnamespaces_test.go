@@ -0,0 +1,45 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("Watched namespaces", func() {
+	AfterEach(func() {
+		utils.WatchNamespaces = ""
+	})
+
+	It("watches all namespaces by default", func() {
+		Expect(utils.NamespaceScoped()).To(BeFalse())
+		Expect(utils.WatchedNamespaces()).To(BeEmpty())
+		Expect(utils.NamespaceWatched("any")).To(BeTrue())
+	})
+
+	It("only watches the listed namespaces", func() {
+		utils.WatchNamespaces = "team-a, team-b,"
+		Expect(utils.NamespaceScoped()).To(BeTrue())
+		Expect(utils.WatchedNamespaces()).To(Equal([]string{"team-a", "team-b"}))
+		Expect(utils.NamespaceWatched("team-b")).To(BeTrue())
+		Expect(utils.NamespaceWatched("other")).To(BeFalse())
+	})
+})
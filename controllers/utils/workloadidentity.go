@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// WorkloadIdentityProvider is the cloud whose workload identity a
+// ServiceAccount is set up for
+type WorkloadIdentityProvider string
+
+const (
+	WorkloadIdentityNone  WorkloadIdentityProvider = ""
+	WorkloadIdentityAWS   WorkloadIdentityProvider = "AWS"
+	WorkloadIdentityGCP   WorkloadIdentityProvider = "GCP"
+	WorkloadIdentityAzure WorkloadIdentityProvider = "Azure"
+
+	// ServiceAccount annotations that bind it to a cloud identity
+	AWSRoleARNAnnotation        = "eks.amazonaws.com/role-arn"
+	GCPServiceAccountAnnotation = "iam.gke.io/gcp-service-account"
+	AzureClientIDAnnotation     = "azure.workload.identity/client-id"
+	// Pods must carry this label for Azure to inject the workload identity
+	AzureWorkloadIdentityUseLabel = "azure.workload.identity/use"
+)
+
+// WorkloadIdentityFor returns the cloud whose workload identity (IRSA, GKE
+// Workload Identity, or Azure Workload Identity) the ServiceAccount is
+// annotated for, if any
+func WorkloadIdentityFor(sa *corev1.ServiceAccount) WorkloadIdentityProvider {
+	if sa == nil {
+		return WorkloadIdentityNone
+	}
+	annotations := sa.GetAnnotations()
+	switch {
+	case annotations[AWSRoleARNAnnotation] != "":
+		return WorkloadIdentityAWS
+	case annotations[GCPServiceAccountAnnotation] != "":
+		return WorkloadIdentityGCP
+	case annotations[AzureClientIDAnnotation] != "":
+		return WorkloadIdentityAzure
+	}
+	return WorkloadIdentityNone
+}
+
+// ApplyWorkloadIdentity prepares the mover's pod template for the workload
+// identity of its ServiceAccount. The credentials themselves are injected by
+// the cloud provider's admission webhook or metadata server.
+func ApplyWorkloadIdentity(podTemplateSpec *corev1.PodTemplateSpec, sa *corev1.ServiceAccount) {
+	if WorkloadIdentityFor(sa) == WorkloadIdentityAzure {
+		AddLabel(podTemplateSpec, AzureWorkloadIdentityUseLabel, "true")
+	}
+}
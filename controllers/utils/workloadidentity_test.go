@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("Workload identity", func() {
+	var sa *corev1.ServiceAccount
+	var template *corev1.PodTemplateSpec
+
+	BeforeEach(func() {
+		sa = &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "mover"}}
+		template = &corev1.PodTemplateSpec{}
+	})
+
+	It("is not used by a plain ServiceAccount", func() {
+		Expect(utils.WorkloadIdentityFor(sa)).To(Equal(utils.WorkloadIdentityNone))
+		Expect(utils.WorkloadIdentityFor(nil)).To(Equal(utils.WorkloadIdentityNone))
+		utils.ApplyWorkloadIdentity(template, sa)
+		Expect(template.Labels).To(BeEmpty())
+	})
+
+	It("detects the cloud from the ServiceAccount annotations", func() {
+		sa.Annotations = map[string]string{utils.AWSRoleARNAnnotation: "arn:aws:iam::123456789012:role/volsync"}
+		Expect(utils.WorkloadIdentityFor(sa)).To(Equal(utils.WorkloadIdentityAWS))
+		sa.Annotations = map[string]string{utils.GCPServiceAccountAnnotation: "volsync@project.iam.gserviceaccount.com"}
+		Expect(utils.WorkloadIdentityFor(sa)).To(Equal(utils.WorkloadIdentityGCP))
+		utils.ApplyWorkloadIdentity(template, sa)
+		Expect(template.Labels).To(BeEmpty())
+	})
+
+	It("labels the mover Pods for Azure", func() {
+		sa.Annotations = map[string]string{utils.AzureClientIDAnnotation: "00000000-0000-0000-0000-000000000000"}
+		Expect(utils.WorkloadIdentityFor(sa)).To(Equal(utils.WorkloadIdentityAzure))
+		utils.ApplyWorkloadIdentity(template, sa)
+		Expect(template.Labels).To(HaveKeyWithValue(utils.AzureWorkloadIdentityUseLabel, "true"))
+	})
+})
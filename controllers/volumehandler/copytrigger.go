@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package volumehandler
+
+import (
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	volsyncerrors "github.com/backube/volsync/controllers/errors"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+// copyTriggerSource returns the owning ReplicationSource if it coordinates the
+// copy of the source volume via spec.copyTrigger
+func (vh *VolumeHandler) copyTriggerSource() *volsyncv1alpha1.ReplicationSource {
+	rs, ok := vh.owner.(*volsyncv1alpha1.ReplicationSource)
+	if !ok || rs.Spec.CopyTrigger == nil {
+		return nil
+	}
+	return rs
+}
+
+// waitForCopyTriggerSpec is the spec.copyTrigger equivalent of
+// waitForCopyTriggerBeforeCloneOrSnap. The progress is recorded in the
+// ReplicationSource's status, which is persisted by the caller.
+func (vh *VolumeHandler) waitForCopyTriggerSpec(log logr.Logger, rs *volsyncv1alpha1.ReplicationSource,
+	srcPVC *corev1.PersistentVolumeClaim) (bool, error) {
+	if rs.Status.CopyTrigger == nil {
+		rs.Status.CopyTrigger = &volsyncv1alpha1.ReplicationSourceCopyTriggerStatus{}
+	}
+	status := rs.Status.CopyTrigger
+
+	trigger := rs.Spec.CopyTrigger.Trigger
+	if trigger == "" || trigger == status.LatestTrigger {
+		// Need to block until the trigger is updated
+		if status.WaitingSince == nil {
+			status.WaitingSince = &metav1.Time{Time: time.Now()}
+			vh.eventRecorder.Eventf(vh.owner, srcPVC, corev1.EventTypeNormal,
+				volsyncv1alpha1.EvRSrcPVCWaitingForCopyTrigger, volsyncv1alpha1.EvACreateSrcCopyUsingCopyTrigger,
+				"Waiting on spec.copyTrigger before creating snapshot or clone of src PVC %s",
+				utils.KindAndName(vh.client.Scheme(), srcPVC))
+		}
+
+		if status.WaitingSince.Add(volsyncv1alpha1.CopyTriggerWaitTimeout).Before(time.Now()) {
+			apimeta.SetStatusCondition(&rs.Status.Conditions, metav1.Condition{
+				Type:   volsyncv1alpha1.ConditionCopyTriggered,
+				Status: metav1.ConditionFalse,
+				Reason: volsyncv1alpha1.CopyTriggeredReasonTimeout,
+				Message: "Still waiting for spec.copyTrigger.trigger to be updated after " +
+					volsyncv1alpha1.CopyTriggerWaitTimeout.String(),
+			})
+			vh.eventRecorder.Eventf(vh.owner, srcPVC, corev1.EventTypeWarning,
+				volsyncv1alpha1.EvRSrcPVCTimeoutWaitingForCopyTrigger, volsyncv1alpha1.EvACreateSrcCopyUsingCopyTrigger,
+				"waiting on spec.copyTrigger before creating snapshot or clone of src PVC %s",
+				utils.KindAndName(vh.client.Scheme(), srcPVC))
+			return true, &volsyncerrors.CopyTriggerTimeoutError{
+				SourcePVC: srcPVC.GetName(),
+			}
+		}
+
+		apimeta.SetStatusCondition(&rs.Status.Conditions, metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionCopyTriggered,
+			Status:  metav1.ConditionFalse,
+			Reason:  volsyncv1alpha1.CopyTriggeredReasonWaiting,
+			Message: "Waiting for spec.copyTrigger.trigger to be updated",
+		})
+		log.Info("Waiting for spec.copyTrigger to be updated before creating a snapshot or clone")
+		return true, nil
+	}
+
+	// Trigger has been updated, we can proceed with the copy
+	status.WaitingSince = nil
+	if !apimeta.IsStatusConditionPresentAndEqual(rs.Status.Conditions,
+		volsyncv1alpha1.ConditionCopyTriggered, metav1.ConditionTrue) {
+		vh.eventRecorder.Eventf(vh.owner, srcPVC, corev1.EventTypeNormal,
+			volsyncv1alpha1.EvRSrcPVCCopyTriggerReceived, volsyncv1alpha1.EvACreateSrcCopyUsingCopyTrigger,
+			"Received updated spec.copyTrigger, proceeding to create snapshot or clone of src PVC %s",
+			utils.KindAndName(vh.client.Scheme(), srcPVC))
+	}
+	apimeta.SetStatusCondition(&rs.Status.Conditions, metav1.Condition{
+		Type:    volsyncv1alpha1.ConditionCopyTriggered,
+		Status:  metav1.ConditionTrue,
+		Reason:  volsyncv1alpha1.CopyTriggeredReasonInProgress,
+		Message: "Creating snapshot or clone for trigger " + trigger,
+	})
+	return false, nil
+}
+
+// updateCopyTriggerSpecAfterCloneOrSnap records that the copy for the current
+// spec.copyTrigger.trigger has been completed
+func (vh *VolumeHandler) updateCopyTriggerSpecAfterCloneOrSnap(rs *volsyncv1alpha1.ReplicationSource,
+	srcPVC *corev1.PersistentVolumeClaim) {
+	if rs.Status.CopyTrigger == nil {
+		rs.Status.CopyTrigger = &volsyncv1alpha1.ReplicationSourceCopyTriggerStatus{}
+	}
+	trigger := rs.Spec.CopyTrigger.Trigger
+	if rs.Status.CopyTrigger.LatestTrigger == trigger {
+		return
+	}
+
+	rs.Status.CopyTrigger.LatestTrigger = trigger
+	rs.Status.CopyTrigger.WaitingSince = nil
+	apimeta.SetStatusCondition(&rs.Status.Conditions, metav1.Condition{
+		Type:    volsyncv1alpha1.ConditionCopyTriggered,
+		Status:  metav1.ConditionTrue,
+		Reason:  volsyncv1alpha1.CopyTriggeredReasonCopyComplete,
+		Message: "Snapshot or clone completed for trigger " + trigger,
+	})
+	vh.eventRecorder.Eventf(vh.owner, srcPVC, corev1.EventTypeNormal,
+		volsyncv1alpha1.EvRSrcPVCCopyUsingCopyTriggerCompleted, volsyncv1alpha1.EvACreateSrcCopyUsingCopyTrigger,
+		"snapshot or clone complete for src PVC %s using spec.copyTrigger",
+		utils.KindAndName(vh.client.Scheme(), srcPVC))
+}
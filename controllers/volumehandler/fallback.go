@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package volumehandler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/component-helpers/storage/volume"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/mover"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+// errCloneNotProvisioned is returned when a clone stays Pending although it
+// should have been provisioned
+var errCloneNotProvisioned = errors.New("the clone was not provisioned")
+
+// checkCloneProvisioned returns an error wrapping errCloneNotProvisioned if
+// the Pending clone should have been provisioned by now. Clones in a
+// WaitForFirstConsumer StorageClass are only provisioned once a node has been
+// selected for them, so they are given time until then.
+func (vh *VolumeHandler) checkCloneProvisioned(ctx context.Context, clone *corev1.PersistentVolumeClaim) error {
+	if clone.Annotations[volume.AnnSelectedNode] == "" {
+		if clone.Spec.StorageClassName == nil || *clone.Spec.StorageClassName == "" {
+			return nil
+		}
+		sc, err := vh.getStorageClass(ctx, *clone.Spec.StorageClassName)
+		if sc == nil || err != nil {
+			return err
+		}
+		if sc.VolumeBindingMode != nil && *sc.VolumeBindingMode == storagev1.VolumeBindingWaitForFirstConsumer {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s has not been bound within %s", errCloneNotProvisioned,
+		utils.KindAndName(vh.client.Scheme(), clone), mover.PVCBindTimeout)
+}
+
+// sourceStatus returns the status of the owning ReplicationSource, if any
+func (vh *VolumeHandler) sourceStatus() *volsyncv1alpha1.ReplicationSourceStatus {
+	if rs, ok := vh.owner.(*volsyncv1alpha1.ReplicationSource); ok {
+		return rs.Status
+	}
+	return nil
+}
+
+// fellBackToSnapshot returns true if copyMethodFallback has replaced Clone
+// with Snapshot since the owner was last changed
+func (vh *VolumeHandler) fellBackToSnapshot() bool {
+	status := vh.sourceStatus()
+	return vh.copyMethodFallback && status != nil && status.CopyMethodFallback != nil &&
+		status.CopyMethodFallback.ObservedGeneration == vh.owner.GetGeneration()
+}
+
+// shouldFallBack returns true if the copy should be made with a snapshot
+// because the clone failed with err
+func (vh *VolumeHandler) shouldFallBack(err error) bool {
+	return vh.copyMethodFallback && errors.Is(err, errCloneNotProvisioned)
+}
+
+// fallBackToSnapshot records that the copies of src are made with a snapshot
+// until the owner is changed
+func (vh *VolumeHandler) fallBackToSnapshot(src *corev1.PersistentVolumeClaim, cause error) {
+	if status := vh.sourceStatus(); status != nil {
+		status.CopyMethodFallback = &volsyncv1alpha1.CopyMethodFallbackStatus{
+			Message:            cause.Error(),
+			ObservedGeneration: vh.owner.GetGeneration(),
+			Time:               ptr.To(metav1.Now()),
+		}
+	}
+	vh.eventRecorder.Eventf(vh.owner, src, corev1.EventTypeWarning,
+		volsyncv1alpha1.EvRCopyMethodFallback, volsyncv1alpha1.EvACreateSnap,
+		"unable to clone %s, copying it with a snapshot instead: %s",
+		utils.KindAndName(vh.client.Scheme(), src), cause.Error())
+}
+
+// ensurePVCViaSnapshotInsteadOfClone restores a snapshot of src into the PVC
+// that would have been the clone, once the clone that could not be
+// provisioned has been removed
+func (vh *VolumeHandler) ensurePVCViaSnapshotInsteadOfClone(ctx context.Context, log logr.Logger,
+	src *corev1.PersistentVolumeClaim, name string, isTemporary bool) (*corev1.PersistentVolumeClaim, error) {
+	clone := &corev1.PersistentVolumeClaim{}
+	err := vh.client.Get(ctx, client.ObjectKey{Name: name, Namespace: vh.owner.GetNamespace()}, clone)
+	if client.IgnoreNotFound(err) != nil {
+		return nil, err
+	}
+	if err == nil && clone.Spec.DataSource != nil && clone.Spec.DataSource.Kind == "PersistentVolumeClaim" {
+		if clone.DeletionTimestamp.IsZero() {
+			log.Info("removing the clone that could not be provisioned", "clone", client.ObjectKeyFromObject(clone))
+			if err := vh.client.Delete(ctx, clone); client.IgnoreNotFound(err) != nil {
+				return nil, err
+			}
+		}
+		// Wait for the clone to be gone before reusing its name
+		return nil, nil
+	}
+	return vh.ensurePVCViaSnapshot(ctx, log, src, name, isTemporary)
+}
+
+// recordCopyMethod reports the method that was used to copy the source volume
+// in the status of the owning ReplicationSource
+func (vh *VolumeHandler) recordCopyMethod() {
+	status := vh.sourceStatus()
+	if status == nil {
+		return
+	}
+	if vh.fellBackToSnapshot() {
+		status.LastCopyMethod = volsyncv1alpha1.CopyMethodSnapshot
+		return
+	}
+	status.CopyMethodFallback = nil
+	status.LastCopyMethod = vh.copyMethod
+}
@@ -0,0 +1,278 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package volumehandler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/mover"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+const (
+	// Annotation placed on a clone or snapshot once the postSync hook has
+	// been run for it
+	postSyncHookAnnotation = "volsync.backube/post-sync-hook"
+	postSyncHookCompleted  = "Completed"
+	// How long a hook may run if no timeout is specified
+	defaultHookTimeout = 5 * time.Minute
+
+	preSyncHookName  = "presync"
+	postSyncHookName = "postsync"
+)
+
+//+kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+
+// runPreSyncHook runs the preSync hook, if one is configured. It returns true
+// if the hook has not yet finished and the caller should try again later.
+func (vh *VolumeHandler) runPreSyncHook(ctx context.Context, log logr.Logger) (bool, error) {
+	if vh.hooks == nil || vh.hooks.PreSync == nil {
+		return false, nil
+	}
+	return vh.runHook(ctx, log, preSyncHookName, vh.hooks.PreSync)
+}
+
+// runPostSyncHook runs the postSync hook, if one is configured, once for the
+// provided clone or snapshot. It returns true if the hook has not yet finished
+// and the caller should try again later.
+func (vh *VolumeHandler) runPostSyncHook(ctx context.Context, log logr.Logger,
+	copyObj client.Object) (bool, error) {
+	if vh.hooks == nil || vh.hooks.PostSync == nil {
+		return false, nil
+	}
+	if copyObj.GetAnnotations()[postSyncHookAnnotation] == postSyncHookCompleted {
+		return false, nil
+	}
+
+	wait, err := vh.runHook(ctx, log, postSyncHookName, vh.hooks.PostSync)
+	if wait || err != nil {
+		return wait, err
+	}
+
+	annotations := copyObj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[postSyncHookAnnotation] = postSyncHookCompleted
+	copyObj.SetAnnotations(annotations)
+	return false, vh.client.Update(ctx, copyObj)
+}
+
+func (vh *VolumeHandler) runHook(ctx context.Context, log logr.Logger, name string,
+	hook *volsyncv1alpha1.SyncHook) (bool, error) {
+	logger := log.WithValues("hook", name)
+
+	timeout := defaultHookTimeout
+	if hook.Timeout != nil {
+		timeout = hook.Timeout.Duration
+	}
+
+	// Hooks run commands in the application's pods with the operator's
+	// permissions, so the namespace must opt in to them the same way it does
+	// for privileged movers
+	hooksOk, err := utils.PrivilegedMoversOk(ctx, vh.client, logger, vh.owner.GetNamespace())
+	if err != nil {
+		return false, err
+	}
+
+	switch {
+	case !hooksOk:
+		err = fmt.Errorf("sync hooks require the namespace annotation %s: \"true\"",
+			volsyncv1alpha1.PrivilegedMoversNamespaceAnnotation)
+	case hook.Exec != nil && hook.Job != nil:
+		err = errors.New("only one of exec or job may be specified")
+	case hook.Exec != nil:
+		err = vh.runExecHook(ctx, logger, hook.Exec, timeout)
+	case hook.Job != nil:
+		var wait bool
+		wait, err = vh.runJobHook(ctx, logger, name, hook.Job, timeout)
+		if wait && err == nil {
+			return true, nil
+		}
+	default:
+		err = errors.New("one of exec or job must be specified")
+	}
+
+	if err != nil {
+		logger.Error(err, "hook failed")
+		vh.eventRecorder.Eventf(vh.owner, nil, corev1.EventTypeWarning,
+			volsyncv1alpha1.EvRSyncHookFailed, volsyncv1alpha1.EvARunSyncHook,
+			"%s hook failed: %s", name, err)
+		return false, fmt.Errorf("%s hook failed: %w", name, err)
+	}
+
+	logger.Info("hook completed")
+	vh.eventRecorder.Eventf(vh.owner, nil, corev1.EventTypeNormal,
+		volsyncv1alpha1.EvRSyncHookSucceeded, volsyncv1alpha1.EvARunSyncHook,
+		"%s hook completed", name)
+	return false, nil
+}
+
+// runExecHook runs the hook's command in each of the running pods matched by
+// the selector
+func (vh *VolumeHandler) runExecHook(ctx context.Context, logger logr.Logger,
+	hook *volsyncv1alpha1.ExecHook, timeout time.Duration) error {
+	selector, err := metav1.LabelSelectorAsSelector(&hook.Selector)
+	if err != nil {
+		return err
+	}
+	if selector.Empty() {
+		return errors.New("exec hook selector must not be empty")
+	}
+
+	podList := &corev1.PodList{}
+	if err := vh.client.List(ctx, podList, client.InNamespace(vh.owner.GetNamespace()),
+		client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return err
+	}
+
+	pods := []corev1.Pod{}
+	for _, pod := range podList.Items {
+		if pod.Status.Phase == corev1.PodRunning && pod.DeletionTimestamp.IsZero() {
+			pods = append(pods, pod)
+		}
+	}
+	if len(pods) == 0 {
+		return fmt.Errorf("no running pods match selector %q", selector.String())
+	}
+
+	execCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for i := range pods {
+		output, err := utils.ExecInPod(execCtx, &pods[i], hook.Container, hook.Command)
+		logger.V(1).Info("exec hook output", "pod", pods[i].GetName(), "output", output)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runJobHook ensures the hook Job has been created and returns true while it
+// is still running. The Job is removed once it has finished. Like a mover, the
+// Job runs unprivileged and its image must be permitted by
+// --allowed-mover-images.
+func (vh *VolumeHandler) runJobHook(ctx context.Context, logger logr.Logger, name string,
+	hook *volsyncv1alpha1.JobHook, timeout time.Duration) (bool, error) {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      mover.VolSyncPrefix + name + "-" + vh.owner.GetName(),
+			Namespace: vh.owner.GetNamespace(),
+		},
+	}
+
+	err := vh.client.Get(ctx, client.ObjectKeyFromObject(job), job)
+	if kerrors.IsNotFound(err) {
+		image, err := utils.MoverImage("", &hook.Image)
+		if err != nil {
+			return false, err
+		}
+		backoffLimit := int32(0)
+		deadline := int64(timeout.Seconds())
+		job.Spec = batchv1.JobSpec{
+			BackoffLimit:          &backoffLimit,
+			ActiveDeadlineSeconds: &deadline,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:    "hook",
+						Image:   image,
+						Command: hook.Command,
+						Args:    hook.Args,
+						SecurityContext: &corev1.SecurityContext{
+							AllowPrivilegeEscalation: ptr.To(false),
+							Capabilities: &corev1.Capabilities{
+								Drop: []corev1.Capability{"ALL"},
+							},
+							Privileged:             ptr.To(false),
+							ReadOnlyRootFilesystem: ptr.To(true),
+						},
+						VolumeMounts: []corev1.VolumeMount{
+							{Name: "tempdir", MountPath: "/tmp"},
+						},
+					}},
+					RestartPolicy:   corev1.RestartPolicyNever,
+					SecurityContext: utils.MoverSecurityContextFor(vh.owner),
+					Volumes: []corev1.Volume{{
+						Name: "tempdir",
+						VolumeSource: corev1.VolumeSource{
+							EmptyDir: &corev1.EmptyDirVolumeSource{
+								Medium: corev1.StorageMediumMemory,
+							}},
+					}},
+				},
+			},
+		}
+		if hook.ServiceAccountName != nil {
+			job.Spec.Template.Spec.ServiceAccountName = *hook.ServiceAccountName
+		}
+		utils.ApplySecurityProfile(&job.Spec.Template, vh.owner)
+		if err := ctrl.SetControllerReference(vh.owner, job, vh.client.Scheme()); err != nil {
+			logger.Error(err, utils.ErrUnableToSetControllerRef)
+			return false, err
+		}
+		utils.SetOwnedByVolSync(job)
+		utils.MarkForCleanup(vh.owner, job)
+		if err := vh.client.Create(ctx, job); err != nil {
+			return false, err
+		}
+		logger.Info("created hook job", "job", client.ObjectKeyFromObject(job))
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if !job.DeletionTimestamp.IsZero() {
+		// A previous run of the hook is still being removed
+		return true, nil
+	}
+
+	failed := job.Spec.BackoffLimit != nil && job.Status.Failed > *job.Spec.BackoffLimit
+	for _, c := range job.Status.Conditions {
+		if c.Type == batchv1.JobFailed && c.Status == corev1.ConditionTrue {
+			failed = true
+		}
+	}
+	if job.Status.Succeeded == 0 && !failed {
+		return true, nil
+	}
+
+	// The Job has finished, remove it so the hook runs again next time
+	if err := vh.client.Delete(ctx, job,
+		client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+		return false, err
+	}
+	if failed {
+		return false, fmt.Errorf("job %s failed", job.GetName())
+	}
+	return false, nil
+}
@@ -80,6 +80,7 @@ func FromSource(s *volsyncv1alpha1.ReplicationSourceVolumeOptions) VHOption {
 		vh.storageClassName = s.StorageClassName
 		vh.accessModes = s.AccessModes
 		vh.volumeSnapshotClassName = s.VolumeSnapshotClassName
+		vh.copyMethodFallback = s.CopyMethodFallback
 	}
 }
 
@@ -92,6 +93,8 @@ func FromDestination(d *volsyncv1alpha1.ReplicationDestinationVolumeOptions) VHO
 		vh.storageClassName = d.StorageClassName
 		vh.accessModes = d.AccessModes
 		vh.volumeSnapshotClassName = d.VolumeSnapshotClassName
+		vh.adoptPVC = d.AdoptDestinationPVC
+		vh.snapshotMetadata = d.SnapshotMetadata
 	}
 }
 
@@ -131,8 +134,40 @@ func VolumeSnapshotClassName(vsc *string) VHOption {
 	}
 }
 
+// SnapshotName overrides the name of the VolumeSnapshot created by
+// EnsurePVCFromSrc when using CopyMethodSnapshot. By default, the snapshot has
+// the same name as the PVC that is provisioned from it.
+func SnapshotName(name string) VHOption {
+	return func(vh *VolumeHandler) {
+		vh.snapshotName = name
+	}
+}
+
+// IntermediateRetention gives the VolumeSnapshots created by EnsurePVCFromSrc a
+// name that is unique to the synchronization when "retention" is set, so that
+// snapshots retained from earlier synchronizations are never reused. The
+// synchronization is identified by status.lastSyncStartTime at the time the
+// snapshot is created.
+func IntermediateRetention(retention *volsyncv1alpha1.ReplicationSourceIntermediateRetentionSpec,
+	status *volsyncv1alpha1.ReplicationSourceStatus) VHOption {
+	return func(vh *VolumeHandler) {
+		vh.retainedSyncStatus = nil
+		if retention != nil {
+			vh.retainedSyncStatus = status
+		}
+	}
+}
+
 func WithRecorder(r events.EventRecorder) VHOption {
 	return func(vh *VolumeHandler) {
 		vh.eventRecorder = r
 	}
 }
+
+// SyncHooks configures the hooks that are run around the creation of the
+// clone or snapshot of the source volume.
+func SyncHooks(h *volsyncv1alpha1.ReplicationSourceHooksSpec) VHOption {
+	return func(vh *VolumeHandler) {
+		vh.hooks = h
+	}
+}
@@ -0,0 +1,260 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package volumehandler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/component-helpers/storage/volume"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	volsyncerrors "github.com/backube/volsync/controllers/errors"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+// preflightClone checks that a clone of src can be provisioned before it is
+// created, so that the synchronization is reported as blocked rather than
+// waiting on a clone that stays Pending. It returns a SyncBlockedError if the
+// storage does not support the clone or a ResourceQuota has no room for it.
+func (vh *VolumeHandler) preflightClone(ctx context.Context, log logr.Logger,
+	src *corev1.PersistentVolumeClaim) error {
+	storageClassName := vh.cloneStorageClassName(src)
+	if err := vh.checkCloneSupported(ctx, src, storageClassName); err != nil {
+		log.Info("volume cloning is not supported", "reason", err.Error())
+		return err
+	}
+	if err := vh.checkQuota(ctx, storageClassName, vh.cloneCapacity(src)); err != nil {
+		log.Info("no room for the clone", "reason", err.Error())
+		return err
+	}
+	return nil
+}
+
+// checkCloneSupported verifies that the source and the clone are provisioned
+// by the same CSI driver. Storage classes that can not be found are assumed
+// to support cloning.
+func (vh *VolumeHandler) checkCloneSupported(ctx context.Context, src *corev1.PersistentVolumeClaim,
+	storageClassName *string) error {
+	if src.Spec.StorageClassName == nil || *src.Spec.StorageClassName == "" {
+		return nil
+	}
+	srcSC, err := vh.getStorageClass(ctx, *src.Spec.StorageClassName)
+	if srcSC == nil || err != nil {
+		return err
+	}
+
+	if strings.HasPrefix(srcSC.Provisioner, "kubernetes.io/") && src.Annotations[volume.AnnMigratedTo] == "" {
+		return &volsyncerrors.SyncBlockedError{
+			Reason: volsyncv1alpha1.SyncBlockedReasonCloneUnsupported,
+			Message: fmt.Sprintf("PVC %s uses the in-tree volume plugin %s, which does not support cloning; "+
+				"use copyMethod Snapshot or Direct instead", src.Name, srcSC.Provisioner),
+		}
+	}
+
+	if storageClassName == nil || *storageClassName == srcSC.Name {
+		return nil
+	}
+	cloneSC, err := vh.getStorageClass(ctx, *storageClassName)
+	if cloneSC == nil || err != nil {
+		return err
+	}
+	if cloneSC.Provisioner != srcSC.Provisioner {
+		return &volsyncerrors.SyncBlockedError{
+			Reason: volsyncv1alpha1.SyncBlockedReasonCloneUnsupported,
+			Message: fmt.Sprintf("a clone must be provisioned by the same driver as its source, but "+
+				"StorageClass %s uses %s and StorageClass %s uses %s",
+				srcSC.Name, srcSC.Provisioner, cloneSC.Name, cloneSC.Provisioner),
+		}
+	}
+	return nil
+}
+
+// cloneViaSnapshot returns true if the copy of src must be made by restoring
+// a VolumeSnapshot rather than by cloning. CSI only clones a volume into the
+// same StorageClass, so a copy into another StorageClass of the same driver
+// is made with a snapshot and restore instead. A copy into a StorageClass of
+// another driver is reported as blocked.
+func (vh *VolumeHandler) cloneViaSnapshot(ctx context.Context, log logr.Logger,
+	src *corev1.PersistentVolumeClaim) (bool, error) {
+	storageClassName := vh.cloneStorageClassName(src)
+	if storageClassName == nil || src.Spec.StorageClassName == nil ||
+		*storageClassName == *src.Spec.StorageClassName {
+		return false, nil
+	}
+	if err := vh.checkCloneSupported(ctx, src, storageClassName); err != nil {
+		log.Info("unable to copy the volume into the StorageClass", "reason", err.Error())
+		return false, err
+	}
+	srcSC, err := vh.getStorageClass(ctx, *src.Spec.StorageClassName)
+	if srcSC == nil || err != nil {
+		return false, err
+	}
+	cloneSC, err := vh.getStorageClass(ctx, *storageClassName)
+	if cloneSC == nil || err != nil {
+		return false, err
+	}
+	log.V(1).Info("copying the volume into another StorageClass with a snapshot",
+		"from", srcSC.Name, "to", cloneSC.Name)
+	return true, nil
+}
+
+// getStorageClass returns the named StorageClass, or nil if it does not exist
+func (vh *VolumeHandler) getStorageClass(ctx context.Context, name string) (*storagev1.StorageClass, error) {
+	sc := &storagev1.StorageClass{}
+	err := vh.client.Get(ctx, client.ObjectKey{Name: name}, sc)
+	if kerrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return sc, nil
+}
+
+// checkQuota verifies that the ResourceQuotas of the namespace have room for
+// a new PVC of the given storage class and size
+func (vh *VolumeHandler) checkQuota(ctx context.Context, storageClassName *string,
+	capacity resource.Quantity) error {
+	quotas := &corev1.ResourceQuotaList{}
+	if err := vh.client.List(ctx, quotas, client.InNamespace(vh.owner.GetNamespace())); err != nil {
+		return err
+	}
+
+	storageKeys := []corev1.ResourceName{corev1.ResourceRequestsStorage}
+	countKeys := []corev1.ResourceName{corev1.ResourcePersistentVolumeClaims}
+	if storageClassName != nil && *storageClassName != "" {
+		prefix := *storageClassName + ".storageclass.storage.k8s.io/"
+		storageKeys = append(storageKeys, corev1.ResourceName(prefix+string(corev1.ResourceRequestsStorage)))
+		countKeys = append(countKeys, corev1.ResourceName(prefix+string(corev1.ResourcePersistentVolumeClaims)))
+	}
+
+	one := *resource.NewQuantity(1, resource.DecimalSI)
+	for _, quota := range quotas.Items {
+		for _, key := range storageKeys {
+			if exceedsQuota(quota, key, capacity) {
+				return quotaExceeded(quota, key, capacity)
+			}
+		}
+		for _, key := range countKeys {
+			if exceedsQuota(quota, key, one) {
+				return quotaExceeded(quota, key, one)
+			}
+		}
+	}
+	return nil
+}
+
+// exceedsQuota returns true if adding amount to the usage of the resource
+// would go over the hard limit of the quota
+func exceedsQuota(quota corev1.ResourceQuota, key corev1.ResourceName, amount resource.Quantity) bool {
+	hard, ok := quota.Status.Hard[key]
+	if !ok {
+		return false
+	}
+	used := quota.Status.Used[key]
+	used.Add(amount)
+	return used.Cmp(hard) > 0
+}
+
+func quotaExceeded(quota corev1.ResourceQuota, key corev1.ResourceName, amount resource.Quantity) error {
+	hard := quota.Status.Hard[key]
+	used := quota.Status.Used[key]
+	return &volsyncerrors.SyncBlockedError{
+		Reason: volsyncv1alpha1.SyncBlockedReasonQuotaExceeded,
+		Message: fmt.Sprintf("ResourceQuota %s does not have room for the copy of the source: "+
+			"%s would use %s in addition to %s of %s", quota.Name, key, amount.String(),
+			used.String(), hard.String()),
+	}
+}
+
+// snapshotClassFor returns the VolumeSnapshotClass to use for a snapshot of
+// src: volumeSnapshotClassName if it is set, otherwise the default configured
+// for the StorageClass of src, if any. A nil class selects the cluster's
+// default. It returns a SyncBlockedError if the class does not exist or
+// belongs to a different CSI driver than src.
+func (vh *VolumeHandler) snapshotClassFor(ctx context.Context,
+	src *corev1.PersistentVolumeClaim) (*string, error) {
+	className := vh.volumeSnapshotClassName
+	if className == nil && src.Spec.StorageClassName != nil {
+		className = utils.DefaultVolumeSnapshotClassFor(*src.Spec.StorageClassName)
+	}
+	if className == nil || *className == "" {
+		return className, nil
+	}
+
+	vsc := &snapv1.VolumeSnapshotClass{}
+	err := vh.client.Get(ctx, client.ObjectKey{Name: *className}, vsc)
+	if kerrors.IsNotFound(err) {
+		return nil, &volsyncerrors.SyncBlockedError{
+			Reason:  volsyncv1alpha1.SyncBlockedReasonSnapshotClassInvalid,
+			Message: fmt.Sprintf("VolumeSnapshotClass %s does not exist", *className),
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	driver, err := vh.csiDriverOf(ctx, src)
+	if err != nil {
+		return nil, err
+	}
+	if driver != "" && driver != vsc.Driver {
+		return nil, &volsyncerrors.SyncBlockedError{
+			Reason: volsyncv1alpha1.SyncBlockedReasonSnapshotClassInvalid,
+			Message: fmt.Sprintf("VolumeSnapshotClass %s is for the CSI driver %s, but PVC %s is provisioned by %s",
+				vsc.Name, vsc.Driver, src.Name, driver),
+		}
+	}
+	return className, nil
+}
+
+// csiDriverOf returns the CSI driver of the PVC's volume or, if it is not
+// bound, of its StorageClass. It returns "" if the driver can not be
+// determined.
+func (vh *VolumeHandler) csiDriverOf(ctx context.Context, pvc *corev1.PersistentVolumeClaim) (string, error) {
+	if pvc.Spec.VolumeName != "" {
+		pv := &corev1.PersistentVolume{}
+		err := vh.client.Get(ctx, client.ObjectKey{Name: pvc.Spec.VolumeName}, pv)
+		if client.IgnoreNotFound(err) != nil {
+			return "", err
+		}
+		if err == nil {
+			if pv.Spec.CSI != nil {
+				return pv.Spec.CSI.Driver, nil
+			}
+			return "", nil
+		}
+	}
+	if pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName == "" {
+		return "", nil
+	}
+	sc, err := vh.getStorageClass(ctx, *pvc.Spec.StorageClassName)
+	if sc == nil || err != nil {
+		return "", err
+	}
+	return sc.Provisioner, nil
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package volumehandler
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+// Fields that are available when rendering the snapshotMetadata templates
+type snapshotMetadataData struct {
+	Name            string
+	Namespace       string
+	SyncTime        string
+	SyncTimestamp   string
+	SourceName      string
+	SourceNamespace string
+}
+
+func newSnapshotMetadataData(owner client.Object, syncTime time.Time) snapshotMetadataData {
+	data := snapshotMetadataData{
+		Name:          owner.GetName(),
+		Namespace:     owner.GetNamespace(),
+		SyncTime:      syncTime.UTC().Format(time.RFC3339),
+		SyncTimestamp: syncTime.UTC().Format(timeYYYYMMDDHHMMSS),
+	}
+	// The source is known if the mover reported the provenance of the data
+	if rd, ok := owner.(*volsyncv1alpha1.ReplicationDestination); ok &&
+		rd.Status != nil && rd.Status.Provenance != nil {
+		if ns, name, found := strings.Cut(rd.Status.Provenance.Source, "/"); found {
+			data.SourceNamespace = ns
+			data.SourceName = name
+		}
+	}
+	return data
+}
+
+// applySnapshotMetadata adds the rendered labels and annotations of the
+// snapshotMetadata to the object
+func (vh *VolumeHandler) applySnapshotMetadata(obj client.Object, syncTime time.Time) error {
+	if vh.snapshotMetadata == nil {
+		return nil
+	}
+	data := newSnapshotMetadataData(vh.owner, syncTime)
+
+	labels, err := renderSnapshotMetadata(vh.snapshotMetadata.Labels, data)
+	if err != nil {
+		return err
+	}
+	for key, value := range labels {
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return fmt.Errorf("snapshot label %s has invalid value %q: %s", key, value, strings.Join(errs, "; "))
+		}
+	}
+	annotations, err := renderSnapshotMetadata(vh.snapshotMetadata.Annotations, data)
+	if err != nil {
+		return err
+	}
+
+	if len(labels) > 0 {
+		objLabels := obj.GetLabels()
+		if objLabels == nil {
+			objLabels = map[string]string{}
+		}
+		for key, value := range labels {
+			objLabels[key] = value
+		}
+		obj.SetLabels(objLabels)
+	}
+	if len(annotations) > 0 {
+		objAnnotations := obj.GetAnnotations()
+		if objAnnotations == nil {
+			objAnnotations = map[string]string{}
+		}
+		for key, value := range annotations {
+			objAnnotations[key] = value
+		}
+		obj.SetAnnotations(objAnnotations)
+	}
+	return nil
+}
+
+func renderSnapshotMetadata(templates map[string]string, data snapshotMetadataData) (map[string]string, error) {
+	rendered := make(map[string]string, len(templates))
+	for key, value := range templates {
+		tmpl, err := template.New(key).Option("missingkey=error").Parse(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid snapshotMetadata template for %s: %w", key, err)
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("unable to render snapshotMetadata template for %s: %w", key, err)
+		}
+		rendered[key] = buf.String()
+	}
+	return rendered, nil
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package volumehandler
+
+import (
+	"time"
+
+	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+var _ = Describe("Snapshot metadata", func() {
+	var vh *VolumeHandler
+	var rd *volsyncv1alpha1.ReplicationDestination
+	var snap *snapv1.VolumeSnapshot
+	syncTime := time.Date(2024, 5, 2, 1, 2, 3, 0, time.UTC)
+
+	BeforeEach(func() {
+		rd = &volsyncv1alpha1.ReplicationDestination{
+			ObjectMeta: metav1.ObjectMeta{Name: "myapp", Namespace: "dest"},
+			Status: &volsyncv1alpha1.ReplicationDestinationStatus{
+				Provenance: &volsyncv1alpha1.RestoreProvenance{Source: "prod/myapp-src"},
+			},
+		}
+		vh = &VolumeHandler{owner: rd}
+		snap = &snapv1.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"existing": "label"}},
+		}
+	})
+
+	It("does nothing without snapshotMetadata", func() {
+		Expect(vh.applySnapshotMetadata(snap, syncTime)).To(Succeed())
+		Expect(snap.Labels).To(Equal(map[string]string{"existing": "label"}))
+		Expect(snap.Annotations).To(BeNil())
+	})
+
+	It("renders the labels and annotations", func() {
+		vh.snapshotMetadata = &volsyncv1alpha1.SnapshotMetadataSpec{
+			Labels: map[string]string{
+				"app":       "x",
+				"synced-at": "{{ .SyncTimestamp }}",
+				"source":    "{{ .SourceNamespace }}.{{ .SourceName }}",
+			},
+			Annotations: map[string]string{
+				"example.com/synced": "{{ .SyncTime }} by {{ .Namespace }}/{{ .Name }}",
+			},
+		}
+		Expect(vh.applySnapshotMetadata(snap, syncTime)).To(Succeed())
+		Expect(snap.Labels).To(Equal(map[string]string{
+			"existing":  "label",
+			"app":       "x",
+			"synced-at": "20240502010203",
+			"source":    "prod.myapp-src",
+		}))
+		Expect(snap.Annotations).To(Equal(map[string]string{
+			"example.com/synced": "2024-05-02T01:02:03Z by dest/myapp",
+		}))
+	})
+
+	It("rejects invalid templates and label values", func() {
+		vh.snapshotMetadata = &volsyncv1alpha1.SnapshotMetadataSpec{
+			Labels: map[string]string{"bad": "{{ .Unknown }}"},
+		}
+		Expect(vh.applySnapshotMetadata(snap, syncTime)).NotTo(Succeed())
+
+		vh.snapshotMetadata.Labels = map[string]string{"bad": "{{ .SyncTime }}"}
+		Expect(vh.applySnapshotMetadata(snap, syncTime)).NotTo(Succeed())
+		Expect(snap.Labels).NotTo(HaveKey("bad"))
+	})
+})
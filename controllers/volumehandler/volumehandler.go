@@ -54,11 +54,17 @@ type VolumeHandler struct {
 	eventRecorder           events.EventRecorder
 	owner                   client.Object
 	copyMethod              volsyncv1alpha1.CopyMethodType
+	copyMethodFallback      bool
 	capacity                *resource.Quantity
 	storageClassName        *string
 	accessModes             []corev1.PersistentVolumeAccessMode
 	volumeMode              *corev1.PersistentVolumeMode
 	volumeSnapshotClassName *string
+	snapshotName            string
+	hooks                   *volsyncv1alpha1.ReplicationSourceHooksSpec
+	adoptPVC                *volsyncv1alpha1.AdoptDestinationPVCSpec
+	snapshotMetadata        *volsyncv1alpha1.SnapshotMetadataSpec
+	retainedSyncStatus      *volsyncv1alpha1.ReplicationSourceStatus
 }
 
 // EnsurePVCFromSrc ensures the presence of a PVC that is based on the provided
@@ -66,6 +72,59 @@ type VolumeHandler struct {
 // be the same PVC as src. Note: it's possible to return nil, nil. In this case,
 // the operation should be retried.
 func (vh *VolumeHandler) EnsurePVCFromSrc(ctx context.Context, log logr.Logger,
+	src *corev1.PersistentVolumeClaim, name string, isTemporary bool) (*corev1.PersistentVolumeClaim, error) {
+	pvc, err := vh.ensurePVCFromSrc(ctx, log, src, name, isTemporary)
+	if err == nil {
+		vh.setSourcePVCReadyCondition(src, pvc)
+		if pvc != nil {
+			vh.recordCopyMethod()
+		}
+	}
+	return pvc, err
+}
+
+// setSourcePVCReadyCondition reports whether the PVC that the mover reads
+// from is ready
+func (vh *VolumeHandler) setSourcePVCReadyCondition(src *corev1.PersistentVolumeClaim,
+	pvc *corev1.PersistentVolumeClaim) {
+	if pvc == nil {
+		utils.SetPhaseCondition(vh.eventRecorder, vh.owner, src, metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionSourcePVCReady,
+			Status:  metav1.ConditionFalse,
+			Reason:  volsyncv1alpha1.PhaseReasonWaiting,
+			Message: fmt.Sprintf("waiting for the %s copy of %s", vh.copyMethod, src.Name),
+		}, nil)
+		return
+	}
+	utils.SetPhaseCondition(vh.eventRecorder, vh.owner, pvc, metav1.Condition{
+		Type:    volsyncv1alpha1.ConditionSourcePVCReady,
+		Status:  metav1.ConditionTrue,
+		Reason:  volsyncv1alpha1.PhaseReasonReady,
+		Message: fmt.Sprintf("%s is ready to be read by the mover", utils.KindAndName(vh.client.Scheme(), pvc)),
+	}, &utils.PhaseEvent{Type: corev1.EventTypeNormal, Reason: volsyncv1alpha1.EvRSrcPVCReady})
+}
+
+// setSnapshotReadyCondition reports whether the VolumeSnapshot of the
+// synchronization is ready to use
+func (vh *VolumeHandler) setSnapshotReadyCondition(snap *snapv1.VolumeSnapshot, ready bool) {
+	if !ready {
+		utils.SetPhaseCondition(vh.eventRecorder, vh.owner, snap, metav1.Condition{
+			Type:    volsyncv1alpha1.ConditionSnapshotReady,
+			Status:  metav1.ConditionFalse,
+			Reason:  volsyncv1alpha1.PhaseReasonWaiting,
+			Message: fmt.Sprintf("waiting for %s to be ready", utils.KindAndName(vh.client.Scheme(), snap)),
+		}, nil)
+		return
+	}
+	utils.SetPhaseCondition(vh.eventRecorder, vh.owner, snap, metav1.Condition{
+		Type:    volsyncv1alpha1.ConditionSnapshotReady,
+		Status:  metav1.ConditionTrue,
+		Reason:  volsyncv1alpha1.PhaseReasonReady,
+		Message: fmt.Sprintf("%s is ready to use", utils.KindAndName(vh.client.Scheme(), snap)),
+	}, &utils.PhaseEvent{Type: corev1.EventTypeNormal, Reason: volsyncv1alpha1.EvRSnapReady})
+}
+
+func (vh *VolumeHandler) ensurePVCFromSrc(ctx context.Context, log logr.Logger,
 	src *corev1.PersistentVolumeClaim, name string, isTemporary bool) (*corev1.PersistentVolumeClaim, error) {
 	// make sure the volumeMode is set properly from the source PVC
 	vh.volumeMode = &defaultVolumeMode
@@ -78,18 +137,53 @@ func (vh *VolumeHandler) EnsurePVCFromSrc(ctx context.Context, log logr.Logger,
 	case volsyncv1alpha1.CopyMethodDirect:
 		return src, nil
 	case volsyncv1alpha1.CopyMethodClone:
-		return vh.ensureClone(ctx, log, src, name, isTemporary)
-	case volsyncv1alpha1.CopyMethodSnapshot:
-		snap, err := vh.ensureSnapshot(ctx, log, src, name, isTemporary)
-		if snap == nil || err != nil {
-			return nil, err
+		if vh.fellBackToSnapshot() {
+			return vh.ensurePVCViaSnapshotInsteadOfClone(ctx, log, src, name, isTemporary)
+		}
+		pvc, err := vh.ensureCloneOf(ctx, log, src, name, isTemporary)
+		if err != nil && vh.shouldFallBack(err) {
+			vh.fallBackToSnapshot(src, err)
+			return vh.ensurePVCViaSnapshotInsteadOfClone(ctx, log, src, name, isTemporary)
 		}
-		return vh.pvcFromSnapshot(ctx, log, snap, src, name, isTemporary)
+		return pvc, err
+	case volsyncv1alpha1.CopyMethodSnapshot:
+		return vh.ensurePVCViaSnapshot(ctx, log, src, name, isTemporary)
 	default:
 		return nil, fmt.Errorf("unsupported copyMethod: %v -- must be Direct, None, Clone, or Snapshot", vh.copyMethod)
 	}
 }
 
+// ensureCloneOf ensures the presence of a clone of src, which is made with a
+// snapshot if it goes into another StorageClass
+func (vh *VolumeHandler) ensureCloneOf(ctx context.Context, log logr.Logger,
+	src *corev1.PersistentVolumeClaim, name string, isTemporary bool) (*corev1.PersistentVolumeClaim, error) {
+	viaSnapshot, err := vh.cloneViaSnapshot(ctx, log, src)
+	if err != nil {
+		return nil, err
+	}
+	if viaSnapshot {
+		return vh.ensurePVCViaSnapshot(ctx, log, src, name, isTemporary)
+	}
+	return vh.ensureClone(ctx, log, src, name, isTemporary)
+}
+
+// ensurePVCViaSnapshot takes a VolumeSnapshot of src and restores it into a
+// new PVC
+func (vh *VolumeHandler) ensurePVCViaSnapshot(ctx context.Context, log logr.Logger,
+	src *corev1.PersistentVolumeClaim, name string, isTemporary bool) (*corev1.PersistentVolumeClaim, error) {
+	snapName := name
+	if vh.snapshotName != "" {
+		snapName = vh.snapshotName
+	} else if vh.retainedSyncStatus != nil && vh.retainedSyncStatus.LastSyncStartTime != nil {
+		snapName = name + "-" + vh.retainedSyncStatus.LastSyncStartTime.UTC().Format(timeYYYYMMDDHHMMSS)
+	}
+	snap, err := vh.ensureSnapshot(ctx, log, src, snapName, isTemporary)
+	if snap == nil || err != nil {
+		return nil, err
+	}
+	return vh.pvcFromSnapshot(ctx, log, snap, src, name, isTemporary)
+}
+
 // EnsureImage ensures the presence of a representation of the provided src
 // PVC. It is generated based on the VolumeHandler's configuration and could be
 // of type PersistentVolumeClaim or VolumeSnapshot. It may even be the same PVC
@@ -134,8 +228,89 @@ func (vh *VolumeHandler) EnsureImage(ctx context.Context, log logr.Logger,
 	}
 }
 
+// UseProvidedPVC returns the user-supplied PVC. If adoption has been
+// requested, the PVC is first brought under VolSync's management; if adoption
+// has since been disabled, any previous adoption is released.
 func (vh *VolumeHandler) UseProvidedPVC(ctx context.Context, pvcName string) (*corev1.PersistentVolumeClaim, error) {
-	return vh.getPVCByName(ctx, pvcName)
+	pvc, err := vh.getPVCByName(ctx, pvcName)
+	if err != nil {
+		return pvc, err
+	}
+	if vh.adoptPVC == nil {
+		return pvc, vh.releaseAdoptedPVC(ctx, pvc)
+	}
+	return pvc, vh.adoptProvidedPVC(ctx, pvc)
+}
+
+func (vh *VolumeHandler) adoptProvidedPVC(ctx context.Context, pvc *corev1.PersistentVolumeClaim) error {
+	orig := pvc.DeepCopy()
+
+	modified := utils.AddLabel(pvc, utils.AdoptedByLabelKey, vh.owner.GetName())
+	if vh.adoptPVC.DeleteWithDestination {
+		if !metav1.IsControlledBy(pvc, vh.owner) {
+			if err := ctrl.SetControllerReference(vh.owner, pvc, vh.client.Scheme()); err != nil {
+				return fmt.Errorf("unable to adopt %s: %w",
+					utils.KindAndName(vh.client.Scheme(), pvc), err)
+			}
+			modified = true
+		}
+	} else {
+		modified = vh.removeOwnerRef(pvc) || modified
+	}
+
+	if vh.adoptPVC.Resize && vh.capacity != nil {
+		current := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+		if vh.capacity.Cmp(current) > 0 {
+			if pvc.Spec.Resources.Requests == nil {
+				pvc.Spec.Resources.Requests = corev1.ResourceList{}
+			}
+			pvc.Spec.Resources.Requests[corev1.ResourceStorage] = *vh.capacity
+			modified = true
+		}
+	}
+
+	if !modified {
+		return nil
+	}
+	if err := vh.client.Patch(ctx, pvc, client.MergeFrom(orig)); err != nil {
+		return err
+	}
+	if orig.Labels[utils.AdoptedByLabelKey] != vh.owner.GetName() {
+		vh.eventRecorder.Eventf(vh.owner, pvc, corev1.EventTypeNormal,
+			volsyncv1alpha1.EvRPVCAdopted, volsyncv1alpha1.EvAAdoptPVC,
+			"adopted %s as the destination volume",
+			utils.KindAndName(vh.client.Scheme(), pvc))
+	}
+	return nil
+}
+
+// releaseAdoptedPVC undoes a previous adoption of the PVC by this owner so that
+// it is once again left entirely to the user.
+func (vh *VolumeHandler) releaseAdoptedPVC(ctx context.Context, pvc *corev1.PersistentVolumeClaim) error {
+	if !utils.HasLabelWithValue(pvc, utils.AdoptedByLabelKey, vh.owner.GetName()) {
+		return nil
+	}
+	orig := pvc.DeepCopy()
+	utils.RemoveLabel(pvc, utils.AdoptedByLabelKey)
+	vh.removeOwnerRef(pvc)
+	return vh.client.Patch(ctx, pvc, client.MergeFrom(orig))
+}
+
+// removeOwnerRef drops any owner reference to the VolumeHandler's owner from
+// obj, returning true if one was removed
+func (vh *VolumeHandler) removeOwnerRef(obj client.Object) bool {
+	refs := obj.GetOwnerReferences()
+	kept := make([]metav1.OwnerReference, 0, len(refs))
+	for _, ref := range refs {
+		if ref.UID != vh.owner.GetUID() {
+			kept = append(kept, ref)
+		}
+	}
+	if len(kept) == len(refs) {
+		return false
+	}
+	obj.SetOwnerReferences(kept)
+	return true
 }
 
 func (vh *VolumeHandler) getPVCByName(ctx context.Context, pvcName string) (*corev1.PersistentVolumeClaim, error) {
@@ -230,6 +405,10 @@ func (vh *VolumeHandler) GetAccessModes() []corev1.PersistentVolumeAccessMode {
 	return vh.accessModes
 }
 
+func (vh *VolumeHandler) GetStorageClassName() *string {
+	return vh.storageClassName
+}
+
 // nolint: funlen
 func (vh *VolumeHandler) ensureImageSnapshot(ctx context.Context, log logr.Logger,
 	src *corev1.PersistentVolumeClaim) (*snapv1.VolumeSnapshot, error) {
@@ -238,7 +417,7 @@ func (vh *VolumeHandler) ensureImageSnapshot(ctx context.Context, log logr.Logge
 		src.Annotations = make(map[string]string)
 	}
 	if _, ok := src.Annotations[snapshotAnnotation]; !ok {
-		ts := time.Now().Format(timeYYYYMMDDHHMMSS)
+		ts := time.Now().UTC().Format(timeYYYYMMDDHHMMSS)
 		src.Annotations[snapshotAnnotation] = src.Name + "-" + ts
 		if err := vh.client.Update(ctx, src); err != nil {
 			log.Error(err, "unable to annotate PVC")
@@ -268,11 +447,19 @@ func (vh *VolumeHandler) ensureImageSnapshot(ctx context.Context, log logr.Logge
 			utils.SetOwnedByVolSync(snap)
 		}
 		if snap.CreationTimestamp.IsZero() {
+			className, err := vh.snapshotClassFor(ctx, src)
+			if err != nil {
+				return err
+			}
 			snap.Spec = snapv1.VolumeSnapshotSpec{
 				Source: snapv1.VolumeSnapshotSource{
 					PersistentVolumeClaimName: &src.Name,
 				},
-				VolumeSnapshotClassName: vh.volumeSnapshotClassName,
+				VolumeSnapshotClassName: className,
+			}
+			if err := vh.applySnapshotMetadata(snap, time.Now()); err != nil {
+				logger.Error(err, "unable to set snapshot metadata")
+				return err
 			}
 		}
 		return nil
@@ -299,8 +486,11 @@ func (vh *VolumeHandler) ensureImageSnapshot(ctx context.Context, log logr.Logge
 				"waiting for %s to bind; check VolumeSnapshotClass name and ensure CSI driver supports volume snapshots",
 				utils.KindAndName(vh.client.Scheme(), snap))
 		}
+		vh.setSnapshotReadyCondition(snap, false)
 		return nil, nil
 	}
+	vh.markSnapshotContent(ctx, logger, snap)
+	vh.setSnapshotReadyCondition(snap, true)
 
 	return snap, nil
 }
@@ -345,6 +535,17 @@ func (vh *VolumeHandler) ensureClone(ctx context.Context, log logr.Logger,
 		if wait || err != nil {
 			return nil, err
 		}
+
+		// Make sure the clone can be provisioned before quiescing the source
+		if err := vh.preflightClone(ctx, log, src); err != nil {
+			return nil, err
+		}
+
+		// Run the preSync hook (if any) before taking the copy
+		wait, err = vh.runPreSyncHook(ctx, log)
+		if wait || err != nil {
+			return nil, err
+		}
 	}
 
 	op, err := ctrlutil.CreateOrUpdate(ctx, vh.client, clone, func() error {
@@ -357,26 +558,10 @@ func (vh *VolumeHandler) ensureClone(ctx context.Context, log logr.Logger,
 			utils.MarkForCleanup(vh.owner, clone)
 		}
 		if clone.CreationTimestamp.IsZero() {
-			if vh.capacity != nil {
-				clone.Spec.Resources.Requests = corev1.ResourceList{
-					corev1.ResourceStorage: *vh.capacity,
-				}
-			} else if src.Status.Capacity != nil && src.Status.Capacity.Storage() != nil {
-				// check the src PVC capacity if set
-				clone.Spec.Resources.Requests = corev1.ResourceList{
-					corev1.ResourceStorage: *src.Status.Capacity.Storage(),
-				}
-			} else {
-				// Fallback to the pvc requested size
-				clone.Spec.Resources.Requests = corev1.ResourceList{
-					corev1.ResourceStorage: *src.Spec.Resources.Requests.Storage(),
-				}
-			}
-			if vh.storageClassName != nil {
-				clone.Spec.StorageClassName = vh.storageClassName
-			} else {
-				clone.Spec.StorageClassName = src.Spec.StorageClassName
+			clone.Spec.Resources.Requests = corev1.ResourceList{
+				corev1.ResourceStorage: vh.cloneCapacity(src),
 			}
+			clone.Spec.StorageClassName = vh.cloneStorageClassName(src)
 			if vh.accessModes != nil {
 				clone.Spec.AccessModes = vh.accessModes
 			} else {
@@ -413,6 +598,11 @@ func (vh *VolumeHandler) ensureClone(ctx context.Context, log logr.Logger,
 			volsyncv1alpha1.EvRPVCNotBound, "",
 			"waiting for %s to bind; check StorageClass name and ensure CSI driver supports volume cloning",
 			utils.KindAndName(vh.client.Scheme(), clone))
+		if vh.copyMethodFallback {
+			if err := vh.checkCloneProvisioned(ctx, clone); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	if clone.Status.Phase == corev1.ClaimBound {
@@ -421,11 +611,37 @@ func (vh *VolumeHandler) ensureClone(ctx context.Context, log logr.Logger,
 		if err != nil {
 			return clone, err
 		}
+
+		wait, err := vh.runPostSyncHook(ctx, log, clone)
+		if wait || err != nil {
+			return nil, err
+		}
 	}
 
 	return clone, err
 }
 
+// cloneCapacity returns the size of a clone of src
+func (vh *VolumeHandler) cloneCapacity(src *corev1.PersistentVolumeClaim) resource.Quantity {
+	if vh.capacity != nil {
+		return *vh.capacity
+	}
+	if src.Status.Capacity != nil && src.Status.Capacity.Storage() != nil {
+		// check the src PVC capacity if set
+		return *src.Status.Capacity.Storage()
+	}
+	// Fallback to the pvc requested size
+	return *src.Spec.Resources.Requests.Storage()
+}
+
+// cloneStorageClassName returns the StorageClass of a clone of src
+func (vh *VolumeHandler) cloneStorageClassName(src *corev1.PersistentVolumeClaim) *string {
+	if vh.storageClassName != nil {
+		return vh.storageClassName
+	}
+	return src.Spec.StorageClassName
+}
+
 // nolint: funlen
 func (vh *VolumeHandler) ensureSnapshot(ctx context.Context, log logr.Logger,
 	src *corev1.PersistentVolumeClaim, name string, isTemporary bool) (*snapv1.VolumeSnapshot, error) {
@@ -449,6 +665,12 @@ func (vh *VolumeHandler) ensureSnapshot(ctx context.Context, log logr.Logger,
 		if wait || err != nil {
 			return nil, err
 		}
+
+		// Run the preSync hook (if any) before taking the copy
+		wait, err = vh.runPreSyncHook(ctx, log)
+		if wait || err != nil {
+			return nil, err
+		}
 	}
 
 	op, err := ctrlutil.CreateOrUpdate(ctx, vh.client, snap, func() error {
@@ -461,8 +683,12 @@ func (vh *VolumeHandler) ensureSnapshot(ctx context.Context, log logr.Logger,
 			utils.MarkForCleanup(vh.owner, snap)
 		}
 		if snap.CreationTimestamp.IsZero() {
+			className, err := vh.snapshotClassFor(ctx, src)
+			if err != nil {
+				return err
+			}
 			snap.Spec.Source.PersistentVolumeClaimName = &src.Name
-			snap.Spec.VolumeSnapshotClassName = vh.volumeSnapshotClassName
+			snap.Spec.VolumeSnapshotClassName = className
 		}
 		return nil
 	})
@@ -488,15 +714,19 @@ func (vh *VolumeHandler) ensureSnapshot(ctx context.Context, log logr.Logger,
 				"waiting for %s to bind; check VolumeSnapshotClass name and ensure CSI driver supports volume snapshots",
 				utils.KindAndName(vh.client.Scheme(), snap))
 		}
+		vh.setSnapshotReadyCondition(snap, false)
 		return nil, nil
 	}
 	if snap.Status.ReadyToUse != nil && !*snap.Status.ReadyToUse {
 		// readyToUse is set to false for this volume snapshot
 		logger.V(1).Info("waiting for snapshot to be ready")
+		vh.setSnapshotReadyCondition(snap, false)
 		return nil, nil
 	}
 	// status.readyToUse either is not set by the driver at this point (even though
 	// status.BoundVolumeSnapshotContentName is set), or readyToUse=true
+	vh.markSnapshotContent(ctx, logger, snap)
+	vh.setSnapshotReadyCondition(snap, true)
 
 	// Snapshot is ready - update copy trigger if necessary
 	err = vh.updateCopyTriggerAfterCloneOrSnap(ctx, src)
@@ -504,6 +734,11 @@ func (vh *VolumeHandler) ensureSnapshot(ctx context.Context, log logr.Logger,
 		return snap, err
 	}
 
+	wait, err := vh.runPostSyncHook(ctx, log, snap)
+	if wait || err != nil {
+		return nil, err
+	}
+
 	logger.V(1).Info("temporary snapshot reconciled", "operation", op)
 	return snap, nil
 }
@@ -515,6 +750,10 @@ func (vh *VolumeHandler) ensureSnapshot(ctx context.Context, log logr.Logger,
 //nolint:funlen
 func (vh *VolumeHandler) waitForCopyTriggerBeforeCloneOrSnap(ctx context.Context, log logr.Logger,
 	srcPVC *corev1.PersistentVolumeClaim) (bool, error) {
+	if rs := vh.copyTriggerSource(); rs != nil {
+		// spec.copyTrigger takes precedence over the PVC annotations
+		return vh.waitForCopyTriggerSpec(log, rs, srcPVC)
+	}
 	if !utils.PVCUsesCopyTrigger(srcPVC) {
 		// No need to wait
 		return false, nil
@@ -585,6 +824,10 @@ func (vh *VolumeHandler) waitForCopyTriggerBeforeCloneOrSnap(ctx context.Context
 
 func (vh *VolumeHandler) updateCopyTriggerAfterCloneOrSnap(ctx context.Context,
 	srcPVC *corev1.PersistentVolumeClaim) error {
+	if rs := vh.copyTriggerSource(); rs != nil {
+		vh.updateCopyTriggerSpecAfterCloneOrSnap(rs, srcPVC)
+		return nil
+	}
 	if !utils.PVCUsesCopyTrigger(srcPVC) {
 		// Nothing to update
 		return nil
@@ -687,6 +930,28 @@ func (vh *VolumeHandler) pvcFromSnapshot(ctx context.Context, log logr.Logger,
 	return pvc, nil
 }
 
+// Returns the snapshotContentPolicy of the owning ReplicationSource or
+// ReplicationDestination
+func (vh *VolumeHandler) snapshotContentPolicy() volsyncv1alpha1.SnapshotContentPolicyType {
+	switch owner := vh.owner.(type) {
+	case *volsyncv1alpha1.ReplicationSource:
+		return owner.Spec.SnapshotContentPolicy
+	case *volsyncv1alpha1.ReplicationDestination:
+		return owner.Spec.SnapshotContentPolicy
+	}
+	return ""
+}
+
+// Marks a retained VolumeSnapshotContent as belonging to VolSync. Failures
+// are logged but don't hold up the synchronization.
+func (vh *VolumeHandler) markSnapshotContent(ctx context.Context, logger logr.Logger,
+	snap *snapv1.VolumeSnapshot) {
+	err := utils.MarkSnapshotContent(ctx, vh.client, logger, vh.owner, vh.snapshotContentPolicy(), snap)
+	if err != nil {
+		logger.Error(err, "unable to mark VolumeSnapshotContent")
+	}
+}
+
 func (vh *VolumeHandler) IsCopyMethodDirect() bool {
 	return vh.copyMethod == volsyncv1alpha1.CopyMethodDirect ||
 		vh.copyMethod == volsyncv1alpha1.CopyMethodNone
@@ -19,18 +19,25 @@ package volumehandler
 
 import (
 	"context"
+	"errors"
 
 	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	volsyncerrors "github.com/backube/volsync/controllers/errors"
 	"github.com/backube/volsync/controllers/utils"
 	//sc "github.com/backube/volsync/controllers"
 )
@@ -232,6 +239,98 @@ var _ = Describe("Volumehandler", func() {
 			})
 		}
 
+		When("a destinationPVC is provided", func() {
+			var pvc *corev1.PersistentVolumeClaim
+			var vh *VolumeHandler
+			BeforeEach(func() {
+				pvc = &corev1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "userpvc",
+						Namespace: ns.Name,
+					},
+					Spec: corev1.PersistentVolumeClaimSpec{
+						AccessModes: []corev1.PersistentVolumeAccessMode{
+							corev1.ReadWriteOnce,
+						},
+						Resources: corev1.VolumeResourceRequirements{
+							Requests: corev1.ResourceList{
+								"storage": resource.MustParse("2Gi"),
+							},
+						},
+					},
+				}
+				Expect(k8sClient.Create(ctx, pvc)).To(Succeed())
+				rd.Spec.Rsync.DestinationPVC = &pvc.Name
+				capacity := resource.MustParse("5Gi")
+				rd.Spec.Rsync.Capacity = &capacity
+			})
+			JustBeforeEach(func() {
+				var err error
+				vh, err = NewVolumeHandler(
+					WithClient(k8sClient),
+					WithOwner(rd),
+					FromDestination(&rd.Spec.Rsync.ReplicationDestinationVolumeOptions),
+				)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("is left untouched when adoption is not requested", func() {
+				usedPVC, err := vh.UseProvidedPVC(ctx, pvc.Name)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(usedPVC.Labels).NotTo(HaveKey(utils.AdoptedByLabelKey))
+				Expect(usedPVC.OwnerReferences).To(BeEmpty())
+				Expect(usedPVC.Spec.Resources.Requests.Storage().String()).To(Equal("2Gi"))
+			})
+
+			When("adoption is requested", func() {
+				BeforeEach(func() {
+					rd.Spec.Rsync.AdoptDestinationPVC = &volsyncv1alpha1.AdoptDestinationPVCSpec{}
+				})
+
+				It("labels the PVC without taking ownership or resizing it", func() {
+					usedPVC, err := vh.UseProvidedPVC(ctx, pvc.Name)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(usedPVC.Labels).To(HaveKeyWithValue(utils.AdoptedByLabelKey, rd.Name))
+					Expect(usedPVC.OwnerReferences).To(BeEmpty())
+					Expect(usedPVC.Spec.Resources.Requests.Storage().String()).To(Equal("2Gi"))
+				})
+
+				When("deleteWithDestination and resize are set", func() {
+					BeforeEach(func() {
+						rd.Spec.Rsync.AdoptDestinationPVC.DeleteWithDestination = true
+						rd.Spec.Rsync.AdoptDestinationPVC.Resize = true
+					})
+
+					It("takes ownership of and expands the PVC", func() {
+						_, err := vh.UseProvidedPVC(ctx, pvc.Name)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(pvc), pvc)).To(Succeed())
+						Expect(pvc.Labels).To(HaveKeyWithValue(utils.AdoptedByLabelKey, rd.Name))
+						Expect(metav1.IsControlledBy(pvc, rd)).To(BeTrue())
+						Expect(pvc.Spec.Resources.Requests.Storage().String()).To(Equal("5Gi"))
+					})
+
+					It("releases the PVC once adoption is disabled", func() {
+						_, err := vh.UseProvidedPVC(ctx, pvc.Name)
+						Expect(err).NotTo(HaveOccurred())
+
+						rd.Spec.Rsync.AdoptDestinationPVC = nil
+						vh, err = NewVolumeHandler(
+							WithClient(k8sClient),
+							WithOwner(rd),
+							FromDestination(&rd.Spec.Rsync.ReplicationDestinationVolumeOptions),
+						)
+						Expect(err).NotTo(HaveOccurred())
+						usedPVC, err := vh.UseProvidedPVC(ctx, pvc.Name)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(usedPVC.Labels).NotTo(HaveKey(utils.AdoptedByLabelKey))
+						Expect(usedPVC.OwnerReferences).To(BeEmpty())
+					})
+				})
+			})
+		})
+
 		When("CopyMethod is Snapshot", func() {
 			BeforeEach(func() {
 				rd.Spec.Rsync.CopyMethod = volsyncv1alpha1.CopyMethodSnapshot
@@ -416,6 +515,199 @@ var _ = Describe("Volumehandler", func() {
 					})
 				})
 			})
+			When("spec.copyTrigger is specified", func() {
+				var vh *VolumeHandler
+				BeforeEach(func() {
+					rs.Spec.CopyTrigger = &volsyncv1alpha1.ReplicationSourceCopyTriggerSpec{}
+				})
+				JustBeforeEach(func() {
+					var err error
+					vh, err = NewVolumeHandler(
+						WithClient(k8sClient),
+						WithOwner(rs),
+						FromSource(&rs.Spec.Rsync.ReplicationSourceVolumeOptions),
+					)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("waits for the trigger before creating the clone and reports progress in the status", func() {
+					newPVC, err := vh.EnsurePVCFromSrc(ctx, logger, src, "newpvc", true)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(newPVC).To(BeNil())
+					Expect(rs.Status.CopyTrigger).NotTo(BeNil())
+					Expect(rs.Status.CopyTrigger.WaitingSince).NotTo(BeNil())
+					cond := apimeta.FindStatusCondition(rs.Status.Conditions, volsyncv1alpha1.ConditionCopyTriggered)
+					Expect(cond).NotTo(BeNil())
+					Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+					Expect(cond.Reason).To(Equal(volsyncv1alpha1.CopyTriggeredReasonWaiting))
+					clone := &corev1.PersistentVolumeClaim{}
+					err = k8sClient.Get(ctx, types.NamespacedName{Name: "newpvc", Namespace: ns.Name}, clone)
+					Expect(kerrors.IsNotFound(err)).To(BeTrue())
+
+					// The PVC annotations are not used
+					Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(src), src)).To(Succeed())
+					Expect(src.GetAnnotations()).NotTo(HaveKey(volsyncv1alpha1.LatestCopyStatusAnnotation))
+
+					rs.Spec.CopyTrigger.Trigger = "trigger-1"
+					newPVC, err = vh.EnsurePVCFromSrc(ctx, logger, src, "newpvc", true)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(newPVC).NotTo(BeNil())
+					Expect(rs.Status.CopyTrigger.WaitingSince).To(BeNil())
+					cond = apimeta.FindStatusCondition(rs.Status.Conditions, volsyncv1alpha1.ConditionCopyTriggered)
+					Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+					Expect(cond.Reason).To(Equal(volsyncv1alpha1.CopyTriggeredReasonInProgress))
+					Expect(rs.Status.CopyTrigger.LatestTrigger).To(BeEmpty())
+
+					// Copy is complete once the clone is bound
+					newPVC.Status.Phase = corev1.ClaimBound
+					Expect(k8sClient.Status().Update(ctx, newPVC)).To(Succeed())
+					newPVC, err = vh.EnsurePVCFromSrc(ctx, logger, src, "newpvc", true)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(newPVC).NotTo(BeNil())
+					Expect(rs.Status.CopyTrigger.LatestTrigger).To(Equal("trigger-1"))
+					cond = apimeta.FindStatusCondition(rs.Status.Conditions, volsyncv1alpha1.ConditionCopyTriggered)
+					Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+					Expect(cond.Reason).To(Equal(volsyncv1alpha1.CopyTriggeredReasonCopyComplete))
+				})
+			})
+			When("sync hooks are specified", func() {
+				var vh *VolumeHandler
+				BeforeEach(func() {
+					rs.Spec.Hooks = &volsyncv1alpha1.ReplicationSourceHooksSpec{
+						PreSync: &volsyncv1alpha1.SyncHook{
+							Job: &volsyncv1alpha1.JobHook{
+								Image:   "quay.io/myhooks/quiesce:latest",
+								Command: []string{"/quiesce.sh"},
+							},
+						},
+						PostSync: &volsyncv1alpha1.SyncHook{
+							Exec: &volsyncv1alpha1.ExecHook{
+								Selector: metav1.LabelSelector{
+									MatchLabels: map[string]string{"app": "mydb"},
+								},
+								Command: []string{"/resume.sh"},
+							},
+						},
+					}
+					utils.AllowedMoverImages = "quay.io/myhooks/*"
+					ns.Annotations = map[string]string{
+						volsyncv1alpha1.PrivilegedMoversNamespaceAnnotation: "true",
+					}
+					Expect(k8sClient.Update(ctx, ns)).To(Succeed())
+				})
+				AfterEach(func() {
+					utils.AllowedMoverImages = ""
+				})
+				JustBeforeEach(func() {
+					var err error
+					vh, err = NewVolumeHandler(
+						WithClient(k8sClient),
+						WithOwner(rs),
+						FromSource(&rs.Spec.Rsync.ReplicationSourceVolumeOptions),
+						SyncHooks(rs.Spec.Hooks),
+					)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("waits for the preSync hook before creating the clone", func() {
+					newPVC, err := vh.EnsurePVCFromSrc(ctx, logger, src, "newpvc", true)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(newPVC).To(BeNil())
+
+					// The hook job should be created, but not the clone
+					job := &batchv1.Job{}
+					Expect(k8sClient.Get(ctx, types.NamespacedName{
+						Name: "volsync-presync-" + rs.GetName(), Namespace: ns.Name}, job)).To(Succeed())
+					Expect(job.Spec.Template.Spec.Containers[0].Image).To(Equal("quay.io/myhooks/quiesce:latest"))
+					Expect(job.Spec.Template.Spec.Containers[0].Command).To(Equal([]string{"/quiesce.sh"}))
+					Expect(*job.Spec.ActiveDeadlineSeconds).To(Equal(int64(300)))
+					Expect(*job.Spec.Template.Spec.Containers[0].SecurityContext.Privileged).To(BeFalse())
+					Expect(job.Spec.Template.Spec.Containers[0].SecurityContext.Capabilities.Drop).
+						To(ConsistOf(corev1.Capability("ALL")))
+					clone := &corev1.PersistentVolumeClaim{}
+					err = k8sClient.Get(ctx, types.NamespacedName{Name: "newpvc", Namespace: ns.Name}, clone)
+					Expect(kerrors.IsNotFound(err)).To(BeTrue())
+
+					// Still waiting while the job runs
+					newPVC, err = vh.EnsurePVCFromSrc(ctx, logger, src, "newpvc", true)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(newPVC).To(BeNil())
+
+					job.Status.Succeeded = 1
+					Expect(k8sClient.Status().Update(ctx, job)).To(Succeed())
+
+					// Clone is created once the hook has completed
+					newPVC, err = vh.EnsurePVCFromSrc(ctx, logger, src, "newpvc", true)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(newPVC).ToNot(BeNil())
+					Expect(newPVC.Spec.DataSource.Name).To(Equal(src.GetName()))
+
+					// Once bound, the postSync hook runs. There are no matching
+					// pods, so it fails.
+					newPVC.Status.Phase = corev1.ClaimBound
+					Expect(k8sClient.Status().Update(ctx, newPVC)).To(Succeed())
+					newPVC, err = vh.EnsurePVCFromSrc(ctx, logger, src, "newpvc", true)
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("postsync hook failed"))
+					Expect(newPVC).To(BeNil())
+				})
+
+				When("the namespace does not allow privileged movers", func() {
+					BeforeEach(func() {
+						ns.Annotations = nil
+						Expect(k8sClient.Update(ctx, ns)).To(Succeed())
+					})
+					It("does not run the hooks", func() {
+						newPVC, err := vh.EnsurePVCFromSrc(ctx, logger, src, "newpvc", true)
+						Expect(err).To(HaveOccurred())
+						Expect(err.Error()).To(ContainSubstring(volsyncv1alpha1.PrivilegedMoversNamespaceAnnotation))
+						Expect(newPVC).To(BeNil())
+
+						job := &batchv1.Job{}
+						err = k8sClient.Get(ctx, types.NamespacedName{
+							Name: "volsync-presync-" + rs.GetName(), Namespace: ns.Name}, job)
+						Expect(kerrors.IsNotFound(err)).To(BeTrue())
+					})
+				})
+
+				When("the hook image is not in the allowed mover images", func() {
+					BeforeEach(func() {
+						utils.AllowedMoverImages = "quay.io/backube/volsync:*"
+					})
+					It("does not create the hook job", func() {
+						newPVC, err := vh.EnsurePVCFromSrc(ctx, logger, src, "newpvc", true)
+						Expect(err).To(HaveOccurred())
+						Expect(errors.Is(err, utils.ErrMoverImageNotAllowed)).To(BeTrue())
+						Expect(newPVC).To(BeNil())
+
+						job := &batchv1.Job{}
+						err = k8sClient.Get(ctx, types.NamespacedName{
+							Name: "volsync-presync-" + rs.GetName(), Namespace: ns.Name}, job)
+						Expect(kerrors.IsNotFound(err)).To(BeTrue())
+					})
+				})
+
+				When("the preSync hook job fails", func() {
+					It("returns an error and does not create the clone", func() {
+						newPVC, err := vh.EnsurePVCFromSrc(ctx, logger, src, "newpvc", true)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(newPVC).To(BeNil())
+
+						job := &batchv1.Job{}
+						Expect(k8sClient.Get(ctx, types.NamespacedName{
+							Name: "volsync-presync-" + rs.GetName(), Namespace: ns.Name}, job)).To(Succeed())
+						job.Status.Failed = 1
+						Expect(k8sClient.Status().Update(ctx, job)).To(Succeed())
+
+						newPVC, err = vh.EnsurePVCFromSrc(ctx, logger, src, "newpvc", true)
+						Expect(err).To(HaveOccurred())
+						Expect(newPVC).To(BeNil())
+						clone := &corev1.PersistentVolumeClaim{}
+						err = k8sClient.Get(ctx, types.NamespacedName{Name: "newpvc", Namespace: ns.Name}, clone)
+						Expect(kerrors.IsNotFound(err)).To(BeTrue())
+					})
+				})
+			})
 			When("options are overridden", func() {
 				newSC := "thenewsc"
 				newCap := resource.MustParse("9Gi")
@@ -445,6 +737,161 @@ var _ = Describe("Volumehandler", func() {
 
 				})
 			})
+			When("the clone is in another StorageClass of the same driver", func() {
+				var srcClass, cloneClass *storagev1.StorageClass
+				BeforeEach(func() {
+					srcClass = &storagev1.StorageClass{
+						ObjectMeta:  metav1.ObjectMeta{Name: "src-" + ns.Name},
+						Provisioner: "test.csi.driver",
+					}
+					cloneClass = &storagev1.StorageClass{
+						ObjectMeta:  metav1.ObjectMeta{Name: "clone-" + ns.Name},
+						Provisioner: "test.csi.driver",
+					}
+					Expect(k8sClient.Create(ctx, srcClass)).To(Succeed())
+					Expect(k8sClient.Create(ctx, cloneClass)).To(Succeed())
+					src.Spec.StorageClassName = &srcClass.Name
+					rs.Spec.Rsync.StorageClassName = &cloneClass.Name
+				})
+				AfterEach(func() {
+					Expect(k8sClient.Delete(ctx, srcClass)).To(Succeed())
+					Expect(k8sClient.Delete(ctx, cloneClass)).To(Succeed())
+				})
+				It("copies the volume with a snapshot instead of a clone", func() {
+					vh, err := NewVolumeHandler(
+						WithClient(k8sClient),
+						WithOwner(rs),
+						FromSource(&rs.Spec.Rsync.ReplicationSourceVolumeOptions),
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					// The PVC is not created until the snapshot is bound
+					newPVC, err := vh.EnsurePVCFromSrc(ctx, logger, src, "newpvc", true)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(newPVC).To(BeNil())
+
+					snaps := &snapv1.VolumeSnapshotList{}
+					Expect(k8sClient.List(ctx, snaps, client.InNamespace(ns.Name))).To(Succeed())
+					Expect(snaps.Items).To(HaveLen(1))
+					Expect(*snaps.Items[0].Spec.Source.PersistentVolumeClaimName).To(Equal(src.Name))
+
+					pvc := &corev1.PersistentVolumeClaim{}
+					err = k8sClient.Get(ctx, client.ObjectKey{Name: "newpvc", Namespace: ns.Name}, pvc)
+					Expect(kerrors.IsNotFound(err)).To(BeTrue())
+				})
+			})
+			When("copyMethodFallback is set", func() {
+				var vh *VolumeHandler
+				BeforeEach(func() {
+					rs.Spec.Rsync.CopyMethodFallback = true
+				})
+				JustBeforeEach(func() {
+					var err error
+					vh, err = NewVolumeHandler(
+						WithClient(k8sClient),
+						WithOwner(rs),
+						FromSource(&rs.Spec.Rsync.ReplicationSourceVolumeOptions),
+					)
+					Expect(err).NotTo(HaveOccurred())
+				})
+				It("copies the volume with a snapshot after falling back", func() {
+					rs.Status = &volsyncv1alpha1.ReplicationSourceStatus{
+						CopyMethodFallback: &volsyncv1alpha1.CopyMethodFallbackStatus{
+							Message:            "the clone was not provisioned",
+							ObservedGeneration: rs.Generation,
+						},
+					}
+					newPVC, err := vh.EnsurePVCFromSrc(ctx, logger, src, "newpvc", true)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(newPVC).To(BeNil())
+
+					snaps := &snapv1.VolumeSnapshotList{}
+					Expect(k8sClient.List(ctx, snaps, client.InNamespace(ns.Name))).To(Succeed())
+					Expect(snaps.Items).To(HaveLen(1))
+					Expect(*snaps.Items[0].Spec.Source.PersistentVolumeClaimName).To(Equal(src.Name))
+					Expect(rs.Status.CopyMethodFallback).NotTo(BeNil())
+				})
+				It("tries cloning again once the ReplicationSource has changed", func() {
+					rs.Status = &volsyncv1alpha1.ReplicationSourceStatus{
+						LastCopyMethod: volsyncv1alpha1.CopyMethodSnapshot,
+						CopyMethodFallback: &volsyncv1alpha1.CopyMethodFallbackStatus{
+							Message:            "the clone was not provisioned",
+							ObservedGeneration: rs.Generation - 1,
+						},
+					}
+					newPVC, err := vh.EnsurePVCFromSrc(ctx, logger, src, "newpvc", true)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(newPVC).ToNot(BeNil())
+					Expect(newPVC.Spec.DataSource.Kind).To(Equal("PersistentVolumeClaim"))
+					Expect(rs.Status.LastCopyMethod).To(Equal(volsyncv1alpha1.CopyMethodClone))
+					Expect(rs.Status.CopyMethodFallback).To(BeNil())
+				})
+				It("gives clones of a WaitForFirstConsumer StorageClass time until a node is selected", func() {
+					wffc := storagev1.VolumeBindingWaitForFirstConsumer
+					class := &storagev1.StorageClass{
+						ObjectMeta:        metav1.ObjectMeta{Name: "wffc-" + ns.Name},
+						Provisioner:       "test.csi.driver",
+						VolumeBindingMode: &wffc,
+					}
+					Expect(k8sClient.Create(ctx, class)).To(Succeed())
+					defer func() {
+						Expect(k8sClient.Delete(ctx, class)).To(Succeed())
+					}()
+					clone := &corev1.PersistentVolumeClaim{
+						ObjectMeta: metav1.ObjectMeta{Name: "clone", Namespace: ns.Name},
+						Spec:       corev1.PersistentVolumeClaimSpec{StorageClassName: &class.Name},
+					}
+					Expect(vh.checkCloneProvisioned(ctx, clone)).To(Succeed())
+
+					clone.Annotations = map[string]string{"volume.kubernetes.io/selected-node": "node1"}
+					err := vh.checkCloneProvisioned(ctx, clone)
+					Expect(errors.Is(err, errCloneNotProvisioned)).To(BeTrue())
+					Expect(vh.shouldFallBack(err)).To(BeTrue())
+				})
+			})
+			When("a ResourceQuota has no room for the clone", func() {
+				JustBeforeEach(func() {
+					quota := &corev1.ResourceQuota{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "storage",
+							Namespace: ns.Name,
+						},
+						Spec: corev1.ResourceQuotaSpec{
+							Hard: corev1.ResourceList{
+								corev1.ResourceRequestsStorage: resource.MustParse("5Gi"),
+							},
+						},
+					}
+					Expect(k8sClient.Create(ctx, quota)).To(Succeed())
+					// There is no quota controller in the test env to fill in
+					// the usage
+					quota.Status = corev1.ResourceQuotaStatus{
+						Hard: quota.Spec.Hard,
+						Used: corev1.ResourceList{
+							corev1.ResourceRequestsStorage: pvcRequestedSize,
+						},
+					}
+					Expect(k8sClient.Status().Update(ctx, quota)).To(Succeed())
+				})
+				It("reports the sync as blocked and does not create the clone", func() {
+					vh, err := NewVolumeHandler(
+						WithClient(k8sClient),
+						WithOwner(rs),
+						FromSource(&rs.Spec.Rsync.ReplicationSourceVolumeOptions),
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					newPVC, err := vh.EnsurePVCFromSrc(ctx, logger, src, "newpvc", true)
+					Expect(newPVC).To(BeNil())
+					var blocked *volsyncerrors.SyncBlockedError
+					Expect(errors.As(err, &blocked)).To(BeTrue())
+					Expect(blocked.Reason).To(Equal(volsyncv1alpha1.SyncBlockedReasonQuotaExceeded))
+
+					pvc := &corev1.PersistentVolumeClaim{}
+					err = k8sClient.Get(ctx, client.ObjectKey{Name: "newpvc", Namespace: ns.Name}, pvc)
+					Expect(kerrors.IsNotFound(err)).To(BeTrue())
+				})
+			})
 		})
 		When("CopyMethod is Snapshot", func() {
 			BeforeEach(func() {
@@ -589,6 +1036,12 @@ var _ = Describe("Volumehandler", func() {
 					rs.Spec.Rsync.Capacity = &newCap
 					rs.Spec.Rsync.AccessModes = newAccessModes
 					rs.Spec.Rsync.VolumeSnapshotClassName = &newVSC
+					vsc := &snapv1.VolumeSnapshotClass{
+						ObjectMeta:     metav1.ObjectMeta{Name: newVSC},
+						Driver:         "test.csi.driver",
+						DeletionPolicy: snapv1.VolumeSnapshotContentDelete,
+					}
+					Expect(client.IgnoreAlreadyExists(k8sClient.Create(ctx, vsc))).To(Succeed())
 				})
 				It("is reflected in the new PVC", func() {
 					vh, err := NewVolumeHandler(
@@ -626,6 +1079,29 @@ var _ = Describe("Volumehandler", func() {
 					Expect(newPVC.Spec.AccessModes).To(Equal(newAccessModes))
 				})
 			})
+			When("the VolumeSnapshotClass does not exist", func() {
+				BeforeEach(func() {
+					rs.Spec.Rsync.VolumeSnapshotClassName = ptr.To("missing")
+				})
+				It("reports the sync as blocked and does not create the snapshot", func() {
+					vh, err := NewVolumeHandler(
+						WithClient(k8sClient),
+						WithOwner(rs),
+						FromSource(&rs.Spec.Rsync.ReplicationSourceVolumeOptions),
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					newPVC, err := vh.EnsurePVCFromSrc(ctx, logger, src, "newpvc", true)
+					Expect(newPVC).To(BeNil())
+					var blocked *volsyncerrors.SyncBlockedError
+					Expect(errors.As(err, &blocked)).To(BeTrue())
+					Expect(blocked.Reason).To(Equal(volsyncv1alpha1.SyncBlockedReasonSnapshotClassInvalid))
+
+					snap := &snapv1.VolumeSnapshot{}
+					err = k8sClient.Get(ctx, types.NamespacedName{Name: "newpvc", Namespace: ns.Name}, snap)
+					Expect(kerrors.IsNotFound(err)).To(BeTrue())
+				})
+			})
 		})
 	})
 })
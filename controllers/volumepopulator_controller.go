@@ -55,12 +55,16 @@ const (
 	populatorPvcPrefix      string = "vs-prime"
 	annotationSelectedNode  string = "volume.kubernetes.io/selected-node"
 	annotationPopulatedFrom string = "volsync.backube/populated-from"
-	labelPvcPrime           string = utils.VolsyncLabelPrefix + "/populator-pvc-for"
+	// Reports the progress of population from a ReplicationSource
+	annotationPopulatorProgress string = "volsync.backube/populator-progress"
+	labelPvcPrime               string = utils.VolsyncLabelPrefix + "/populator-pvc-for"
 
 	VolPopPVCToReplicationDestinationIndex string = "volPopPvc.spec.dataSourceRef.Name"
+	VolPopPVCToReplicationSourceIndex      string = "volPopPvc.spec.dataSourceRef.replicationSourceName"
 	VolPopPVCToStorageClassIndex           string = "volPopPvc.spec.storageClassName"
 
-	VolPopCRName string = "volsync-replicationdestination"
+	VolPopCRName                  string = "volsync-replicationdestination"
+	VolPopReplicationSourceCRName string = "volsync-replicationsource"
 )
 
 func IndexFieldsForVolumePopulator(ctx context.Context, fieldIndexer client.FieldIndexer) error {
@@ -73,8 +77,9 @@ func IndexFieldsForVolumePopulator(ctx context.Context, fieldIndexer client.Fiel
 				// This shouldn't happen
 				return res
 			}
-			if !pvcHasReplicationDestinationDataSourceRef(pvc) {
-				// This pvc is not using a ReplicationDestination as a DataSourceRef, don't add to index
+			if !pvcHasReplicationDestinationDataSourceRef(pvc) || pvcHasCrossNamespaceDataSourceRef(pvc) {
+				// This pvc is not using a ReplicationDestination (in its namespace) as a DataSourceRef,
+				// don't add to index
 				return res
 			}
 
@@ -87,8 +92,52 @@ func IndexFieldsForVolumePopulator(ctx context.Context, fieldIndexer client.Fiel
 		return err
 	}
 
+	// Index on PVCs - used to find pvc referring to (by dataSourceRef) a ReplicationDestination in another
+	// namespace
+	err = fieldIndexer.IndexField(ctx, &corev1.PersistentVolumeClaim{},
+		VolPopPVCToCrossNamespaceRDIndex, func(o client.Object) []string {
+			var res []string
+			pvc, ok := o.(*corev1.PersistentVolumeClaim)
+			if !ok {
+				// This shouldn't happen
+				return res
+			}
+			if !pvcHasCrossNamespaceDataSourceRef(pvc) {
+				return res
+			}
+
+			res = append(res, dataSourceRefNamespace(pvc)+"/"+pvc.Spec.DataSourceRef.Name)
+
+			return res
+		})
+	if err != nil {
+		return err
+	}
+
+	// Index on PVCs - used to find pvc referring to (by dataSourceRef) a ReplicationSource
+	err = fieldIndexer.IndexField(ctx, &corev1.PersistentVolumeClaim{},
+		VolPopPVCToReplicationSourceIndex, func(o client.Object) []string {
+			var res []string
+			pvc, ok := o.(*corev1.PersistentVolumeClaim)
+			if !ok {
+				// This shouldn't happen
+				return res
+			}
+			if !pvcHasReplicationSourceDataSourceRef(pvc) {
+				// This pvc is not using a ReplicationSource as a DataSourceRef, don't add to index
+				return res
+			}
+
+			res = append(res, pvc.Spec.DataSourceRef.Name)
+
+			return res
+		})
+	if err != nil {
+		return err
+	}
+
 	// Index on PVCs - used to find pvcs (for this volume populator) referring to a storageclass
-	// Will only index PVCs that are using a ReplicationDestination as DataSourceRef
+	// Will only index PVCs that are using a ReplicationDestination or ReplicationSource as DataSourceRef
 	return fieldIndexer.IndexField(ctx, &corev1.PersistentVolumeClaim{},
 		VolPopPVCToStorageClassIndex, func(o client.Object) []string {
 			var res []string
@@ -97,8 +146,8 @@ func IndexFieldsForVolumePopulator(ctx context.Context, fieldIndexer client.Fiel
 				// This shouldn't happen
 				return res
 			}
-			if !pvcHasReplicationDestinationDataSourceRef(pvc) {
-				// This pvc is not using a ReplicationDestination as a DataSourceRef, don't add to index
+			if !pvcHasVolSyncDataSourceRef(pvc) {
+				// This pvc is not using VolSync as a DataSourceRef, don't add to index
 				return res
 			}
 
@@ -112,11 +161,9 @@ func IndexFieldsForVolumePopulator(ctx context.Context, fieldIndexer client.Fiel
 }
 
 // If the VolumePopulator CRD is present (i.e. the VolumePopulator API is available), then make sure we have
-// VolumePopulator CR to register VolSync ReplicationDestination as a valid VolumePopulator
+// VolumePopulator CRs to register VolSync ReplicationDestination and ReplicationSource as valid VolumePopulators
 func EnsureVolSyncVolumePopulatorCRIfCRDPresent(ctx context.Context,
 	k8sClient client.Client, logger logr.Logger) error {
-	logger = logger.WithValues("VolPopCRName", VolPopCRName)
-
 	ok, err := isVolumePopulatorCRDPresent(ctx, k8sClient)
 	if err != nil {
 		return err
@@ -127,15 +174,25 @@ func EnsureVolSyncVolumePopulatorCRIfCRDPresent(ctx context.Context,
 		return nil // VolumePopulator kind is not present, nothing to do
 	}
 
+	if err := ensureVolumePopulatorCR(ctx, k8sClient, logger, VolPopCRName, "ReplicationDestination"); err != nil {
+		return err
+	}
+	return ensureVolumePopulatorCR(ctx, k8sClient, logger, VolPopReplicationSourceCRName, "ReplicationSource")
+}
+
+func ensureVolumePopulatorCR(ctx context.Context, k8sClient client.Client, logger logr.Logger,
+	name, kind string) error {
+	logger = logger.WithValues("VolPopCRName", name)
+
 	volSyncVP := &volumepopulatorv1beta1.VolumePopulator{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: VolPopCRName,
+			Name: name,
 		},
 	}
 	op, err := ctrlutil.CreateOrUpdate(ctx, k8sClient, volSyncVP, func() error {
 		volSyncVP.SourceKind = metav1.GroupKind{
 			Group: volsyncv1alpha1.GroupVersion.Group,
-			Kind:  "ReplicationDestination",
+			Kind:  kind,
 		}
 		// Add VolSync label
 		utils.SetOwnedByVolSync(volSyncVP)
@@ -173,14 +230,16 @@ func isVolumePopulatorCRDPresent(ctx context.Context, k8sClient client.Client) (
 //+kubebuilder:rbac:groups=core,resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=core,resources=persistentvolumeclaims/finalizers,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=core,resources=persistentvolumes,verbs=get;list;watch;patch
-//+kubebuilder:rbac:groups=volsync.backube,resources=replicationdestinations,verbs=get;list;watch
+//+kubebuilder:rbac:groups=volsync.backube,resources=replicationdestinations,verbs=get;list;watch;create;delete
+//+kubebuilder:rbac:groups=volsync.backube,resources=replicationsources,verbs=get;list;watch
 //+kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get;list;watch
 //+kubebuilder:rbac:groups=populator.storage.k8s.io,resources=volumepopulators,verbs=get;list;watch;create;update;patch
 
 // VolumePopulatorReconciler reconciles PVCs that use a dataSourceRef that refers to a
-// ReplicationDestination object.
+// ReplicationDestination or ReplicationSource object.
 // The VolumePopulatorReconciler will create a PVC from the latest snapshot image in
-// a ReplicationDestination.
+// a ReplicationDestination, or from the latest backup in the restic repository of a
+// ReplicationSource.
 type VolumePopulatorReconciler struct {
 	client.Client
 	Log           logr.Logger
@@ -212,7 +271,7 @@ func (r *VolumePopulatorReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	}
 
 	// Check to make sure we should be reconciling this PVC - just in case
-	if shouldReconcile := pvcHasReplicationDestinationDataSourceRef(pvc); !shouldReconcile {
+	if shouldReconcile := pvcHasVolSyncDataSourceRef(pvc); !shouldReconcile {
 		return ctrl.Result{}, nil
 	}
 
@@ -246,6 +305,13 @@ func (r *VolumePopulatorReconciler) reconcilePVC(ctx context.Context, logger log
 			return primeResult.result()
 		}
 
+		if pvcHasReplicationSourceDataSourceRef(pvc) {
+			// Wait for the restore into pvcPrime to complete
+			if restoreResult := r.reconcileRestore(ctx, logger, pvc, pvcPrime); restoreResult != nil {
+				return restoreResult.result()
+			}
+		}
+
 		// Make sure any snapshots we've tried to use have owner reference of pvcPrime (for future cleanup)
 		err = r.ensureOwnerReferenceOnSnapshots(ctx, pvc, pvcPrime)
 		if err != nil {
@@ -267,8 +333,16 @@ func (r *VolumePopulatorReconciler) reconcilePVC(ctx context.Context, logger log
 	}
 
 	// *** At this point the volume population is done and we're just cleaning up ***
-	r.EventRecorder.Eventf(pvc, corev1.EventTypeNormal, volsyncv1alpha1.EvRVolPopPVCPopulatorFinished,
-		"Populator finished")
+	if !pvcHasReplicationSourceDataSourceRef(pvc) {
+		r.EventRecorder.Eventf(pvc, corev1.EventTypeNormal, volsyncv1alpha1.EvRVolPopPVCPopulatorFinished,
+			"Populator finished")
+	} else {
+		r.EventRecorder.Eventf(pvc, corev1.EventTypeNormal, volsyncv1alpha1.EvRVolPopPVCPopulatorFinished,
+			"Populator finished, restored as %s", populatorIdentity(pvc))
+		if err := r.setPopulatorProgress(ctx, pvc, PopulatorProgressCompleted); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
 
 	// Cleanup
 	if err := r.cleanup(ctx, logger, pvc, pvcPrime); err != nil {
@@ -325,6 +399,10 @@ func (r *VolumePopulatorReconciler) checkStorageClass(ctx context.Context, logge
 func (r *VolumePopulatorReconciler) reconcilePVCPrime(ctx context.Context, logger logr.Logger,
 	pvc, pvcPrime *corev1.PersistentVolumeClaim,
 	waitForFirstConsumer bool, nodeName string) (*corev1.PersistentVolumeClaim, *vpResult) {
+	if pvcPrime == nil && pvcHasReplicationSourceDataSourceRef(pvc) {
+		return r.createPVCPrimeForReplicationSource(ctx, logger, pvc, waitForFirstConsumer, nodeName)
+	}
+
 	if pvcPrime == nil {
 		// pvcPrime doesn't exist yet
 		// Check for existence of ReplicationDestination here - if PVC' was already there, then it may
@@ -343,6 +421,15 @@ func (r *VolumePopulatorReconciler) reconcilePVCPrime(ctx context.Context, logge
 
 		logger = logger.WithValues("replication destination name", rd.GetName(), "namespace", rd.GetNamespace())
 
+		if !rdAllowsPopulatorNamespace(rd, pvc.GetNamespace()) {
+			logger.Info("ReplicationDestination does not allow populating PVCs in this namespace")
+			r.EventRecorder.Eventf(pvc, corev1.EventTypeWarning, volsyncv1alpha1.EvRVolPopPVCCrossNamespaceDenied,
+				"Unable to populate volume: namespace %s is not in populatorNamespaces of ReplicationDestination %s/%s",
+				pvc.GetNamespace(), rd.GetNamespace(), rd.GetName())
+			// Do not return error - will rely on watches to reconcile once the rd is updated
+			return nil, &vpResult{ctrl.Result{}, nil}
+		}
+
 		if rd.Status == nil || rd.Status.LatestImage == nil {
 			logger.Info("ReplicationDestination has no latestImage, cannot populate volume yet")
 			r.EventRecorder.Eventf(pvc, corev1.EventTypeWarning, volsyncv1alpha1.EvRVolPopPVCReplicationDestNoLatestImage,
@@ -363,11 +450,21 @@ func (r *VolumePopulatorReconciler) reconcilePVCPrime(ctx context.Context, logge
 			return nil, &vpResult{ctrl.Result{}, nil}
 		}
 
-		_, err = r.validateSnapshotAndLabel(ctx, logger, latestImage.Name, rd.GetNamespace(), pvc)
+		snapshot, err := r.validateSnapshotAndLabel(ctx, logger, latestImage.Name, rd.GetNamespace(), pvc)
 		if err != nil {
 			return nil, &vpResult{ctrl.Result{}, err}
 		}
 
+		// A VolumeSnapshot in another namespace cannot be used as the dataSourceRef of pvcPrime, so a copy is
+		// bound in the namespace of the pvc
+		if rd.GetNamespace() != pvc.GetNamespace() {
+			var csResult *vpResult
+			snapshot, csResult = r.ensureCrossNamespaceSnapshot(ctx, logger, pvc, snapshot)
+			if csResult != nil {
+				return nil, csResult
+			}
+		}
+
 		pvcPrime = &corev1.PersistentVolumeClaim{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      getPVCPrimeName(pvc),
@@ -381,8 +478,7 @@ func (r *VolumePopulatorReconciler) reconcilePVCPrime(ctx context.Context, logge
 				DataSourceRef: &corev1.TypedObjectReference{
 					APIGroup: latestImage.APIGroup,
 					Kind:     latestImage.Kind,
-					Name:     latestImage.Name,
-					//Namespace: &rd.GetNamespace(), // Future, if we support cross-namespace
+					Name:     snapshot.GetName(),
 				},
 			},
 		}
@@ -408,7 +504,7 @@ func (r *VolumePopulatorReconciler) reconcilePVCPrime(ctx context.Context, logge
 		}
 
 		r.EventRecorder.Eventf(pvc, corev1.EventTypeNormal, volsyncv1alpha1.EvRVolPopPVCCreationSuccess,
-			"Populator pvc created from snapshot %s", latestImage.Name)
+			"Populator pvc created from snapshot %s/%s", rd.GetNamespace(), latestImage.Name)
 	}
 
 	return pvcPrime, nil
@@ -442,12 +538,16 @@ func (r *VolumePopulatorReconciler) rebindPVClaim(ctx context.Context, logger lo
 		claimRef.Name != pvc.Name ||
 		claimRef.Namespace != pvc.Namespace ||
 		claimRef.UID != pvc.UID {
+		populatedFrom := pvc.Spec.DataSourceRef.Name
+		if pvcPrime.Spec.DataSourceRef != nil {
+			populatedFrom = pvcPrime.Spec.DataSourceRef.Name
+		}
 		// Make new PV with strategic patch values to perform the PV rebind
 		patchPv := &corev1.PersistentVolume{
 			ObjectMeta: metav1.ObjectMeta{
 				Name: pv.Name,
 				Annotations: map[string]string{
-					annotationPopulatedFrom: pvc.Namespace + "/" + pvcPrime.Spec.DataSourceRef.Name,
+					annotationPopulatedFrom: pvc.Namespace + "/" + populatedFrom,
 				},
 			},
 			Spec: corev1.PersistentVolumeSpec{
@@ -477,27 +577,36 @@ func (r *VolumePopulatorReconciler) rebindPVClaim(ctx context.Context, logger lo
 }
 
 func (r *VolumePopulatorReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		Named("volsync-volume-populator").
 		For(&corev1.PersistentVolumeClaim{}, builder.WithPredicates(pvcForVolumePopulatorFilterPredicate())).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: 100,
 		}).
 		Owns(&corev1.PersistentVolumeClaim{}, builder.WithPredicates(pvcOwnedByPredicate())).
+		Owns(&volsyncv1alpha1.ReplicationDestination{}, builder.WithPredicates(pvcOwnedByPredicate())).
 		Watches(&volsyncv1alpha1.ReplicationDestination{},
 			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, o client.Object) []reconcile.Request {
 				return mapFuncReplicationDestinationToVolumePopulatorPVC(ctx, mgr.GetClient(), o)
 			}), builder.WithPredicates(replicationDestinationPredicate())).
-		Watches(&storagev1.StorageClass{},
+		Watches(&volsyncv1alpha1.ReplicationSource{},
+			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, o client.Object) []reconcile.Request {
+				return mapFuncReplicationSourceToVolumePopulatorPVC(ctx, mgr.GetClient(), o)
+			}), builder.WithPredicates(replicationDestinationPredicate()))
+	// StorageClasses are cluster-scoped and only watched when VolSync watches
+	// all namespaces
+	if !utils.NamespaceScoped() {
+		b = b.Watches(&storagev1.StorageClass{},
 			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, o client.Object) []reconcile.Request {
 				return mapFuncStorageClassToVolumePopulatorPVC(ctx, mgr.GetClient(), o)
-			}), builder.WithPredicates(storageClassPredicate())).
-		Complete(r)
+			}), builder.WithPredicates(storageClassPredicate()))
+	}
+	return b.Complete(r)
 }
 
 // Predicate for PVCs with owner (and controller=true) of a PVC - this is to reconcile our temp populator pvc
 // (i.e. pvcPrime).  In case there are other PVCs owned by a PVC, predicate will check for our labelPvcPrime to filter
-// those out.
+// those out.  Also used for the ReplicationDestination restoring into pvcPrime.
 func pvcOwnedByPredicate() predicate.Predicate {
 	return predicate.Funcs{
 		CreateFunc: func(e event.CreateEvent) bool {
@@ -522,7 +631,7 @@ func pvcForVolumePopulatorFilterPredicate() predicate.Predicate {
 			if !ok {
 				return false
 			}
-			return pvcHasReplicationDestinationDataSourceRef(pvc)
+			return pvcHasVolSyncDataSourceRef(pvc)
 		},
 		DeleteFunc: func(_ event.DeleteEvent) bool {
 			// Do not reconcile on PVC deletes
@@ -533,14 +642,14 @@ func pvcForVolumePopulatorFilterPredicate() predicate.Predicate {
 			if !ok {
 				return false
 			}
-			return pvcHasReplicationDestinationDataSourceRef(pvc)
+			return pvcHasVolSyncDataSourceRef(pvc)
 		},
 		GenericFunc: func(e event.GenericEvent) bool {
 			pvc, ok := e.Object.(*corev1.PersistentVolumeClaim)
 			if !ok {
 				return false
 			}
-			return pvcHasReplicationDestinationDataSourceRef(pvc)
+			return pvcHasVolSyncDataSourceRef(pvc)
 		},
 	}
 }
@@ -603,6 +712,45 @@ func mapFuncReplicationDestinationToVolumePopulatorPVC(ctx context.Context, k8sC
 		return []reconcile.Request{}
 	}
 
+	// Also find PVCs in other namespaces that use this ReplicationDestination
+	crossNamespacePVCList := &corev1.PersistentVolumeClaimList{}
+	err = k8sClient.List(ctx, crossNamespacePVCList,
+		client.MatchingFields{
+			VolPopPVCToCrossNamespaceRDIndex: client.ObjectKeyFromObject(replicationDestination).String()})
+	if err != nil {
+		logger.Error(err, "Error looking up pvcs (using index) matching replication destination",
+			"rd name", replicationDestination.GetName(), "namespace", replicationDestination.GetNamespace(),
+			"index name", VolPopPVCToCrossNamespaceRDIndex)
+		return []reconcile.Request{}
+	}
+	pvcList.Items = append(pvcList.Items, crossNamespacePVCList.Items...)
+
+	// Only enqueue a reconcile request if our PVC for volume populator is not already bound
+	return filterRequestsOnlyUnboundPVCs(pvcList)
+}
+
+func mapFuncReplicationSourceToVolumePopulatorPVC(ctx context.Context, k8sClient client.Client,
+	o client.Object) []reconcile.Request {
+	logger := ctrl.Log.WithName("mapFuncReplicationSourceToVolumePopulatorPVC")
+
+	replicationSource, ok := o.(*volsyncv1alpha1.ReplicationSource)
+	if !ok {
+		return []reconcile.Request{}
+	}
+
+	// Find PVCs that use this ReplicationSource in their dataSourceRef (using index)
+	pvcList := &corev1.PersistentVolumeClaimList{}
+	err := k8sClient.List(ctx, pvcList,
+		client.MatchingFields{
+			VolPopPVCToReplicationSourceIndex: replicationSource.GetName()}, // custom index
+		client.InNamespace(replicationSource.GetNamespace()))
+	if err != nil {
+		logger.Error(err, "Error looking up pvcs (using index) matching replication source",
+			"rs name", replicationSource.GetName(), "namespace", replicationSource.GetNamespace(),
+			"index name", VolPopPVCToReplicationSourceIndex)
+		return []reconcile.Request{}
+	}
+
 	// Only enqueue a reconcile request if our PVC for volume populator is not already bound
 	return filterRequestsOnlyUnboundPVCs(pvcList)
 }
@@ -660,11 +808,7 @@ func (r VolumePopulatorReconciler) getReplicationDestinationFromDataSourceRef(ct
 	pvc *corev1.PersistentVolumeClaim) (*volsyncv1alpha1.ReplicationDestination, error) {
 	// dataSourceRef should be pointing to a ReplicationDestination (see predicates)
 	rdName := pvc.Spec.DataSourceRef.Name
-	rdNamespace := pvc.GetNamespace()
-	// Future, if we allow cross-namespace:
-	// if pvc.Spec.DataSourceRef.Namespace != nil && *pvc.Spec.DataSourceRef.Namespace != "" {
-	//		rdNamespace = *pvc.Spec.DataSourceRef.Namespace
-	//	}
+	rdNamespace := dataSourceRefNamespace(pvc)
 	replicationDestinationForVolPop := &volsyncv1alpha1.ReplicationDestination{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      rdName,
@@ -688,12 +832,21 @@ func (r VolumePopulatorReconciler) getReplicationDestinationFromDataSourceRef(ct
 //   - if any snapshots have our vol pop label for our PVC, remove the label
 //   - if do-not-delete label is on the snapshot, remove our ownerref so we will not cause GC of the snap to happen
 //   - if pvcPrime is not nil, we will assume it exists and needs to be cleaned up
+//   - if the pvc was populated from another namespace, the snapshot there is also unlabeled and the
+//     VolumeSnapshotContent that was bound in the namespace of the pvc is removed
 func (r *VolumePopulatorReconciler) cleanup(ctx context.Context, logger logr.Logger,
 	pvc, pvcPrime *corev1.PersistentVolumeClaim) error {
-	snapsForPVC, err := r.listSnapshotsUsedByVolPopForPVC(ctx, pvc)
+	snapsForPVC, err := r.listSnapshotsUsedByVolPopForPVC(ctx, pvc, pvc.GetNamespace())
 	if err != nil {
 		return err
 	}
+	if pvcHasCrossNamespaceDataSourceRef(pvc) {
+		srcSnaps, err := r.listSnapshotsUsedByVolPopForPVC(ctx, pvc, dataSourceRefNamespace(pvc))
+		if err != nil {
+			return err
+		}
+		snapsForPVC = append(snapsForPVC, srcSnaps...)
+	}
 	for i := range snapsForPVC {
 		snap := snapsForPVC[i]
 		// Remove our vol pop label
@@ -712,6 +865,18 @@ func (r *VolumePopulatorReconciler) cleanup(ctx context.Context, logger logr.Log
 		}
 	}
 
+	if pvcHasReplicationSourceDataSourceRef(pvc) {
+		if err := r.cleanupRestoreReplicationDestination(ctx, logger, pvc); err != nil {
+			return err
+		}
+	}
+
+	if pvcHasCrossNamespaceDataSourceRef(pvc) {
+		if err := r.cleanupCrossNamespaceSnapshot(ctx, logger, pvc); err != nil {
+			return err
+		}
+	}
+
 	// If PVC' still exists, delete it
 	if pvcPrime != nil && pvcPrime.GetDeletionTimestamp().IsZero() {
 		logger.Info("Cleanup - deleting temp volume populator PVC", "volpop pvc name", pvcPrime.GetName())
@@ -763,7 +928,7 @@ func (r *VolumePopulatorReconciler) ensureOwnerReferenceOnSnapshots(ctx context.
 	// if necessary when pvcPrime is removed
 	// Could possibly have multiple snapshots here if we previously marked one as do-not-delete but were not
 	// able to create pvcPrime pointing to it (i.e. ReplicationDestination.status.latestImage was updated in-between)
-	snapshots, err := r.listSnapshotsUsedByVolPopForPVC(ctx, pvc)
+	snapshots, err := r.listSnapshotsUsedByVolPopForPVC(ctx, pvc, pvc.GetNamespace())
 	if err != nil {
 		return err
 	}
@@ -783,7 +948,7 @@ func (r *VolumePopulatorReconciler) ensureOwnerReferenceOnSnapshots(ctx context.
 }
 
 func (r *VolumePopulatorReconciler) listSnapshotsUsedByVolPopForPVC(ctx context.Context,
-	pvc *corev1.PersistentVolumeClaim) ([]snapv1.VolumeSnapshot, error) {
+	pvc *corev1.PersistentVolumeClaim, namespace string) ([]snapv1.VolumeSnapshot, error) {
 	snapInUseLabelKey := getSnapshotInUseLabelKey(pvc)
 
 	// Find all snapshots in the namespace with our volume populator label corresponding to this pvc
@@ -796,7 +961,7 @@ func (r *VolumePopulatorReconciler) listSnapshotsUsedByVolPopForPVC(ctx context.
 		client.MatchingLabelsSelector{
 			Selector: ls,
 		},
-		client.InNamespace(pvc.GetNamespace()),
+		client.InNamespace(namespace),
 	}
 	snapList := &snapv1.VolumeSnapshotList{}
 	err = r.Client.List(ctx, snapList, listOptions...)
@@ -106,6 +106,55 @@ var _ = Describe("VolumePopulator - helper funcs", func() {
 			})
 		})
 	})
+
+	Describe("pvcHasReplicationSourceDataSourceRef", func() {
+		var pvc *corev1.PersistentVolumeClaim
+		BeforeEach(func() {
+			pvc = &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "vp-pvc",
+					Namespace: "vp-pvc-ns",
+				},
+			}
+		})
+		Context("When a PVC has no dataSourceRef", func() {
+			It("Should return false", func() {
+				Expect(pvcHasReplicationSourceDataSourceRef(pvc)).To(BeFalse())
+				Expect(pvcHasVolSyncDataSourceRef(pvc)).To(BeFalse())
+			})
+		})
+		Context("When a PVC has a datasourceRef of ReplicationDestination", func() {
+			It("Should return false", func() {
+				pvc.Spec.DataSourceRef = &corev1.TypedObjectReference{
+					APIGroup: &volsyncv1alpha1.GroupVersion.Group,
+					Kind:     "ReplicationDestination",
+					Name:     "myrd",
+				}
+				Expect(pvcHasReplicationSourceDataSourceRef(pvc)).To(BeFalse())
+				Expect(pvcHasVolSyncDataSourceRef(pvc)).To(BeTrue())
+			})
+		})
+		Context("When a PVC has a datasourceRef of ReplicationSource but no name", func() {
+			It("Should return false", func() {
+				pvc.Spec.DataSourceRef = &corev1.TypedObjectReference{
+					APIGroup: &volsyncv1alpha1.GroupVersion.Group,
+					Kind:     "ReplicationSource",
+				}
+				Expect(pvcHasReplicationSourceDataSourceRef(pvc)).To(BeFalse())
+			})
+		})
+		Context("When a PVC has a correct datasourceRef pointing to a ReplicationSource", func() {
+			It("Should return true", func() {
+				pvc.Spec.DataSourceRef = &corev1.TypedObjectReference{
+					APIGroup: &volsyncv1alpha1.GroupVersion.Group,
+					Kind:     "ReplicationSource",
+					Name:     "myrs",
+				}
+				Expect(pvcHasReplicationSourceDataSourceRef(pvc)).To(BeTrue())
+				Expect(pvcHasVolSyncDataSourceRef(pvc)).To(BeTrue())
+			})
+		})
+	})
 })
 
 var _ = Describe("VolumePopulator - Predicates", func() {
@@ -487,6 +536,12 @@ var _ = Describe("VolumePopulator - VolumePopulator CRD detection & ensuring CR
 				Expect(vpCR.GetName()).To(Equal(VolPopCRName))
 				Expect(vpCR.SourceKind.Group).To(Equal("volsync.backube"))
 				Expect(vpCR.SourceKind.Kind).To(Equal("ReplicationDestination"))
+
+				vpRSCR := &volumepopulatorv1beta1.VolumePopulator{}
+				Expect(k8sDirectClient.Get(ctx,
+					client.ObjectKey{Name: VolPopReplicationSourceCRName}, vpRSCR)).To(Succeed())
+				Expect(vpRSCR.SourceKind.Group).To(Equal("volsync.backube"))
+				Expect(vpRSCR.SourceKind.Kind).To(Equal("ReplicationSource"))
 			})
 
 			Context("When the Volsync VolumePopulator CR already exists", func() {
@@ -924,6 +979,154 @@ var _ = Describe("VolumePopulator", func() {
 	})
 })
 
+var _ = Describe("VolumePopulator from a ReplicationSource", func() {
+	var namespace *corev1.Namespace
+	var rs *volsyncv1alpha1.ReplicationSource
+	var pvc *corev1.PersistentVolumeClaim
+	var storageClassName string
+	pvcCap := resource.MustParse("2Gi")
+
+	BeforeEach(func() {
+		namespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "volsync-volpop-rs-test-",
+			},
+		}
+		createWithCacheReload(ctx, k8sClient, namespace)
+		Expect(namespace.Name).NotTo(BeEmpty())
+
+		storageClassName = "vp-rs-test-storageclass-" + utilrand.String(5)
+		createTestStorageClassWithCacheReload(ctx, storageClassName, false)
+
+		rs = &volsyncv1alpha1.ReplicationSource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "rs-for-volpop",
+				Namespace: namespace.Name,
+			},
+			Spec: volsyncv1alpha1.ReplicationSourceSpec{
+				SourcePVC: "some-source-pvc",
+				Trigger: &volsyncv1alpha1.ReplicationSourceTriggerSpec{
+					Manual: "never-run",
+				},
+				Restic: &volsyncv1alpha1.ReplicationSourceResticSpec{
+					Repository: "restic-repo-secret",
+				},
+			},
+		}
+
+		pvc = &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pvc-using-volpop-rs",
+				Namespace: namespace.Name,
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceStorage: pvcCap,
+					},
+				},
+				StorageClassName: &storageClassName,
+				DataSourceRef: &corev1.TypedObjectReference{
+					APIGroup: &volsyncv1alpha1.GroupVersion.Group,
+					Kind:     "ReplicationSource",
+					Name:     rs.GetName(),
+				},
+			},
+		}
+	})
+	AfterEach(func() {
+		deleteWithCacheReload(ctx, k8sClient, &storagev1.StorageClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: storageClassName,
+			},
+		})
+		Expect(k8sClient.Delete(ctx, namespace)).To(Succeed())
+	})
+
+	Context("When the ReplicationSource does not exist", func() {
+		JustBeforeEach(func() {
+			createWithCacheReload(ctx, k8sClient, pvc)
+		})
+
+		It("Should report that it is waiting for the source", func() {
+			Eventually(func() string {
+				Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(pvc), pvc)).To(Succeed())
+				return pvc.GetAnnotations()[annotationPopulatorProgress]
+			}, maxWait, interval).Should(Equal(PopulatorProgressWaitingForSource))
+
+			pvcPrime, err := GetVolumePopulatorPVCPrime(ctx, k8sClient, pvc)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pvcPrime).To(BeNil())
+		})
+	})
+
+	Context("When the ReplicationSource uses restic", func() {
+		var pvcPrime *corev1.PersistentVolumeClaim
+		var restoreRD *volsyncv1alpha1.ReplicationDestination
+
+		JustBeforeEach(func() {
+			createWithCacheReload(ctx, k8sClient, rs)
+			createWithCacheReload(ctx, k8sClient, pvc)
+
+			Eventually(func() *corev1.PersistentVolumeClaim {
+				var err error
+				pvcPrime, err = GetVolumePopulatorPVCPrime(ctx, k8sClient, pvc)
+				Expect(err).NotTo(HaveOccurred())
+				return pvcPrime
+			}, maxWait, interval).ShouldNot(BeNil())
+
+			// pvcPrime is empty, the data is restored into it
+			Expect(pvcPrime.Spec.DataSourceRef).To(BeNil())
+			Expect(pvcPrime.Spec.StorageClassName).To(Equal(pvc.Spec.StorageClassName))
+
+			restoreRD = &volsyncv1alpha1.ReplicationDestination{}
+			Eventually(func() error {
+				return k8sClient.Get(ctx, client.ObjectKeyFromObject(pvcPrime), restoreRD)
+			}, maxWait, interval).Should(Succeed())
+		})
+
+		It("Should restore from the repository into pvcPrime", func() {
+			Expect(restoreRD.Spec.Trigger).NotTo(BeNil())
+			Expect(restoreRD.Spec.Trigger.Manual).To(Equal(populatorRestoreTrigger))
+			Expect(restoreRD.Spec.Restic).NotTo(BeNil())
+			Expect(restoreRD.Spec.Restic.Repository).To(Equal(rs.Spec.Restic.Repository))
+			Expect(restoreRD.Spec.Restic.CopyMethod).To(Equal(volsyncv1alpha1.CopyMethodDirect))
+			Expect(restoreRD.Spec.Restic.DestinationPVC).NotTo(BeNil())
+			Expect(*restoreRD.Spec.Restic.DestinationPVC).To(Equal(pvcPrime.GetName()))
+			Expect(restoreRD.GetOwnerReferences()).To(HaveLen(1))
+			Expect(restoreRD.GetOwnerReferences()[0].UID).To(Equal(pvc.GetUID()))
+			Expect(restoreRD.GetAnnotations()).To(HaveKeyWithValue(utils.PopulatorIdentityAnnotation,
+				"volsync-populator/"+string(pvc.GetUID())))
+
+			Eventually(func() string {
+				Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(pvc), pvc)).To(Succeed())
+				return pvc.GetAnnotations()[annotationPopulatorProgress]
+			}, maxWait, interval).Should(Equal(PopulatorProgressRestoring))
+		})
+
+		Context("When the restore completes", func() {
+			JustBeforeEach(func() {
+				Eventually(func() error {
+					Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(restoreRD), restoreRD)).To(Succeed())
+					if restoreRD.Status == nil {
+						restoreRD.Status = &volsyncv1alpha1.ReplicationDestinationStatus{}
+					}
+					restoreRD.Status.LastManualSync = populatorRestoreTrigger
+					return k8sClient.Status().Update(ctx, restoreRD)
+				}, maxWait, interval).Should(Succeed())
+			})
+
+			It("Should report that the data has been restored", func() {
+				Eventually(func() string {
+					Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(pvc), pvc)).To(Succeed())
+					return pvc.GetAnnotations()[annotationPopulatorProgress]
+				}, maxWait, interval).Should(Equal(PopulatorProgressRestored))
+			})
+		})
+	})
+})
+
 func createTestStorageClassWithCacheReload(ctx context.Context,
 	storageClassName string, generateName bool) *storagev1.StorageClass {
 	scObjMeta := metav1.ObjectMeta{
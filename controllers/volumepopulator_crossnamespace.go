@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+//+kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshotcontents,verbs=get;list;watch;create;delete
+
+const (
+	// VolPopPVCToCrossNamespaceRDIndex indexes PVCs by the namespace/name of a
+	// ReplicationDestination in another namespace that they use as their
+	// dataSourceRef
+	VolPopPVCToCrossNamespaceRDIndex string = "volPopPvc.spec.dataSourceRef.crossNamespaceRD"
+)
+
+// dataSourceRefNamespace returns the namespace of the object referenced by the
+// dataSourceRef of the PVC
+func dataSourceRefNamespace(pvc *corev1.PersistentVolumeClaim) string {
+	if pvc.Spec.DataSourceRef != nil && pvc.Spec.DataSourceRef.Namespace != nil &&
+		*pvc.Spec.DataSourceRef.Namespace != "" {
+		return *pvc.Spec.DataSourceRef.Namespace
+	}
+	return pvc.GetNamespace()
+}
+
+// pvcHasCrossNamespaceDataSourceRef returns true if the PVC uses a
+// ReplicationDestination in another namespace as its dataSourceRef
+func pvcHasCrossNamespaceDataSourceRef(pvc *corev1.PersistentVolumeClaim) bool {
+	return pvcHasReplicationDestinationDataSourceRef(pvc) && dataSourceRefNamespace(pvc) != pvc.GetNamespace()
+}
+
+// rdAllowsPopulatorNamespace returns true if PVCs in the namespace may be
+// populated from the ReplicationDestination
+func rdAllowsPopulatorNamespace(rd *volsyncv1alpha1.ReplicationDestination, namespace string) bool {
+	return rd.GetNamespace() == namespace || slices.Contains(rd.Spec.PopulatorNamespaces, namespace)
+}
+
+// Name of both the VolumeSnapshotContent and the VolumeSnapshot in the
+// namespace of the PVC that are bound to the storage snapshot of the latest
+// image of a ReplicationDestination in another namespace
+func getCrossNamespaceSnapshotName(pvc *corev1.PersistentVolumeClaim) string {
+	return getPVCPrimeName(pvc)
+}
+
+// ensureCrossNamespaceSnapshot makes the storage snapshot of srcSnap (in the
+// namespace of the ReplicationDestination) available in the namespace of the
+// PVC. A new VolumeSnapshotContent with the same snapshot handle is bound to a
+// new VolumeSnapshot in the namespace of the PVC. The content uses the Retain
+// deletion policy so that removing it does not remove the storage snapshot
+// that is still used by the original.
+func (r *VolumePopulatorReconciler) ensureCrossNamespaceSnapshot(ctx context.Context, logger logr.Logger,
+	pvc *corev1.PersistentVolumeClaim, srcSnap *snapv1.VolumeSnapshot) (*snapv1.VolumeSnapshot, *vpResult) {
+	name := getCrossNamespaceSnapshotName(pvc)
+	snap := &snapv1.VolumeSnapshot{}
+	err := r.Client.Get(ctx, types.NamespacedName{Name: name, Namespace: pvc.GetNamespace()}, snap)
+	if err == nil {
+		return snap, nil
+	}
+	if !kerrors.IsNotFound(err) {
+		return nil, &vpResult{ctrl.Result{}, err}
+	}
+
+	if srcSnap.Status == nil || srcSnap.Status.BoundVolumeSnapshotContentName == nil {
+		err := fmt.Errorf("VolumeSnapshot %s/%s is not bound to a VolumeSnapshotContent",
+			srcSnap.GetNamespace(), srcSnap.GetName())
+		return nil, &vpResult{ctrl.Result{}, err}
+	}
+	srcContent := &snapv1.VolumeSnapshotContent{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Name: *srcSnap.Status.BoundVolumeSnapshotContentName},
+		srcContent); err != nil {
+		return nil, &vpResult{ctrl.Result{}, err}
+	}
+	if srcContent.Status == nil || srcContent.Status.SnapshotHandle == nil {
+		err := fmt.Errorf("VolumeSnapshotContent %s has no snapshot handle", srcContent.GetName())
+		return nil, &vpResult{ctrl.Result{}, err}
+	}
+
+	content := &snapv1.VolumeSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: snapv1.VolumeSnapshotContentSpec{
+			DeletionPolicy: snapv1.VolumeSnapshotContentRetain,
+			Driver:         srcContent.Spec.Driver,
+			Source: snapv1.VolumeSnapshotContentSource{
+				SnapshotHandle: srcContent.Status.SnapshotHandle,
+			},
+			SourceVolumeMode:        srcContent.Spec.SourceVolumeMode,
+			VolumeSnapshotClassName: srcContent.Spec.VolumeSnapshotClassName,
+			VolumeSnapshotRef: corev1.ObjectReference{
+				Name:      name,
+				Namespace: pvc.GetNamespace(),
+			},
+		},
+	}
+	utils.SetOwnedByVolSync(content)
+	logger.Info("Creating VolumeSnapshotContent for cross-namespace population",
+		"content", content.GetName(), "from content", srcContent.GetName())
+	if err := r.Client.Create(ctx, content); client.IgnoreAlreadyExists(err) != nil {
+		return nil, &vpResult{ctrl.Result{}, err}
+	}
+
+	snap = &snapv1.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: pvc.GetNamespace(),
+		},
+		Spec: snapv1.VolumeSnapshotSpec{
+			Source: snapv1.VolumeSnapshotSource{
+				VolumeSnapshotContentName: &content.Name,
+			},
+			VolumeSnapshotClassName: srcSnap.Spec.VolumeSnapshotClassName,
+		},
+	}
+	// The in-use label makes the snapshot owned by pvcPrime (and cleaned up
+	// along with it)
+	utils.AddLabel(snap, getSnapshotInUseLabelKey(pvc), pvc.GetName())
+	utils.SetOwnedByVolSync(snap)
+	logger.Info("Creating VolumeSnapshot for cross-namespace population", "snapshot", snap.GetName())
+	if err := r.Client.Create(ctx, snap); err != nil {
+		return nil, &vpResult{ctrl.Result{}, err}
+	}
+	return snap, nil
+}
+
+// cleanupCrossNamespaceSnapshot removes the VolumeSnapshotContent created by
+// ensureCrossNamespaceSnapshot. The VolumeSnapshot is removed along with
+// pvcPrime.
+func (r *VolumePopulatorReconciler) cleanupCrossNamespaceSnapshot(ctx context.Context, logger logr.Logger,
+	pvc *corev1.PersistentVolumeClaim) error {
+	content := &snapv1.VolumeSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: getCrossNamespaceSnapshotName(pvc),
+		},
+	}
+	if err := r.Client.Get(ctx, client.ObjectKeyFromObject(content), content); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if utils.IsOwnedByVolsync(content) && content.GetDeletionTimestamp().IsZero() {
+		logger.Info("Cleanup - deleting cross-namespace VolumeSnapshotContent", "content", content.GetName())
+		return client.IgnoreNotFound(r.Client.Delete(ctx, content))
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+package controllers
+
+import (
+	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+var _ = Describe("VolumePopulator - cross-namespace helper funcs", func() {
+	var pvc *corev1.PersistentVolumeClaim
+	BeforeEach(func() {
+		pvc = &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "vp-pvc",
+				Namespace: "vp-pvc-ns",
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				DataSourceRef: &corev1.TypedObjectReference{
+					APIGroup: &volsyncv1alpha1.GroupVersion.Group,
+					Kind:     "ReplicationDestination",
+					Name:     "myrd",
+				},
+			},
+		}
+	})
+
+	Context("When the dataSourceRef has no namespace", func() {
+		It("Should use the namespace of the PVC", func() {
+			Expect(dataSourceRefNamespace(pvc)).To(Equal("vp-pvc-ns"))
+			Expect(pvcHasCrossNamespaceDataSourceRef(pvc)).To(BeFalse())
+		})
+	})
+	Context("When the dataSourceRef has the namespace of the PVC", func() {
+		It("Should not be cross-namespace", func() {
+			pvc.Spec.DataSourceRef.Namespace = ptr.To("vp-pvc-ns")
+			Expect(pvcHasCrossNamespaceDataSourceRef(pvc)).To(BeFalse())
+		})
+	})
+	Context("When the dataSourceRef has another namespace", func() {
+		It("Should be cross-namespace", func() {
+			pvc.Spec.DataSourceRef.Namespace = ptr.To("dr-landing")
+			Expect(dataSourceRefNamespace(pvc)).To(Equal("dr-landing"))
+			Expect(pvcHasCrossNamespaceDataSourceRef(pvc)).To(BeTrue())
+		})
+	})
+
+	Describe("rdAllowsPopulatorNamespace", func() {
+		rd := &volsyncv1alpha1.ReplicationDestination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "myrd",
+				Namespace: "dr-landing",
+			},
+			Spec: volsyncv1alpha1.ReplicationDestinationSpec{
+				PopulatorNamespaces: []string{"restore"},
+			},
+		}
+		It("Should allow its own and the listed namespaces only", func() {
+			Expect(rdAllowsPopulatorNamespace(rd, "dr-landing")).To(BeTrue())
+			Expect(rdAllowsPopulatorNamespace(rd, "restore")).To(BeTrue())
+			Expect(rdAllowsPopulatorNamespace(rd, "other")).To(BeFalse())
+		})
+	})
+})
+
+var _ = Describe("VolumePopulator - cross-namespace snapshots", func() {
+	var srcNamespace, pvcNamespace *corev1.Namespace
+	var srcContent *snapv1.VolumeSnapshotContent
+	var srcSnap *snapv1.VolumeSnapshot
+	var pvc *corev1.PersistentVolumeClaim
+	var r *VolumePopulatorReconciler
+
+	BeforeEach(func() {
+		srcNamespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "volsync-volpop-test-",
+			},
+		}
+		createWithCacheReload(ctx, k8sClient, srcNamespace)
+		pvcNamespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "volsync-volpop-test-",
+			},
+		}
+		createWithCacheReload(ctx, k8sClient, pvcNamespace)
+
+		srcSnap = &snapv1.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "latest-image",
+				Namespace: srcNamespace.Name,
+			},
+			Spec: snapv1.VolumeSnapshotSpec{
+				Source: snapv1.VolumeSnapshotSource{
+					PersistentVolumeClaimName: ptr.To("dest-pvc"),
+				},
+				VolumeSnapshotClassName: ptr.To("snapclass"),
+			},
+		}
+		createWithCacheReload(ctx, k8sClient, srcSnap)
+
+		srcContent = &snapv1.VolumeSnapshotContent{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "snapcontent-" + srcNamespace.Name,
+			},
+			Spec: snapv1.VolumeSnapshotContentSpec{
+				DeletionPolicy: snapv1.VolumeSnapshotContentDelete,
+				Driver:         "test.csi.driver",
+				Source: snapv1.VolumeSnapshotContentSource{
+					VolumeHandle: ptr.To("volume-1"),
+				},
+				VolumeSnapshotRef: corev1.ObjectReference{
+					Name:      srcSnap.Name,
+					Namespace: srcNamespace.Name,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, srcContent)).To(Succeed())
+		srcContent.Status = &snapv1.VolumeSnapshotContentStatus{
+			SnapshotHandle: ptr.To("snapshot-1"),
+		}
+		Expect(k8sClient.Status().Update(ctx, srcContent)).To(Succeed())
+		srcSnap.Status = &snapv1.VolumeSnapshotStatus{
+			BoundVolumeSnapshotContentName: &srcContent.Name,
+			ReadyToUse:                     ptr.To(true),
+		}
+		Expect(k8sClient.Status().Update(ctx, srcSnap)).To(Succeed())
+
+		pvc = &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "restored",
+				Namespace: pvcNamespace.Name,
+				UID:       "1234-5678",
+			},
+		}
+		r = &VolumePopulatorReconciler{
+			Client:        k8sClient,
+			Log:           zap.New(zap.UseDevMode(true), zap.WriteTo(GinkgoWriter)),
+			EventRecorder: &record.FakeRecorder{},
+		}
+	})
+	AfterEach(func() {
+		for _, name := range []string{srcContent.Name, getCrossNamespaceSnapshotName(pvc)} {
+			content := &snapv1.VolumeSnapshotContent{ObjectMeta: metav1.ObjectMeta{Name: name}}
+			Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, content))).To(Succeed())
+		}
+		Expect(k8sClient.Delete(ctx, srcNamespace)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, pvcNamespace)).To(Succeed())
+	})
+
+	It("Should bind a copy of the content in the namespace of the PVC", func() {
+		var snap *snapv1.VolumeSnapshot
+		Eventually(func() *vpResult {
+			var result *vpResult
+			snap, result = r.ensureCrossNamespaceSnapshot(ctx, r.Log, pvc, srcSnap)
+			return result
+		}, maxWait, interval).Should(BeNil())
+		Expect(snap.GetNamespace()).To(Equal(pvcNamespace.Name))
+		Expect(snap.Spec.Source.VolumeSnapshotContentName).To(HaveValue(Equal(getCrossNamespaceSnapshotName(pvc))))
+		Expect(snap.GetLabels()).To(HaveKeyWithValue(getSnapshotInUseLabelKey(pvc), pvc.GetName()))
+		Expect(snap.Spec.VolumeSnapshotClassName).To(Equal(srcSnap.Spec.VolumeSnapshotClassName))
+
+		content := &snapv1.VolumeSnapshotContent{}
+		Eventually(func() error {
+			return k8sClient.Get(ctx, client.ObjectKey{Name: getCrossNamespaceSnapshotName(pvc)}, content)
+		}, maxWait, interval).Should(Succeed())
+		Expect(content.Spec.DeletionPolicy).To(Equal(snapv1.VolumeSnapshotContentRetain))
+		Expect(content.Spec.Driver).To(Equal(srcContent.Spec.Driver))
+		Expect(content.Spec.Source.SnapshotHandle).To(HaveValue(Equal("snapshot-1")))
+		Expect(content.Spec.VolumeSnapshotRef.Name).To(Equal(snap.GetName()))
+		Expect(content.Spec.VolumeSnapshotRef.Namespace).To(Equal(pvcNamespace.Name))
+		Expect(utils.IsOwnedByVolsync(content)).To(BeTrue())
+
+		By("returning the existing snapshot when called again")
+		Eventually(func() types.UID {
+			snap2, result := r.ensureCrossNamespaceSnapshot(ctx, r.Log, pvc, srcSnap)
+			if result != nil {
+				return ""
+			}
+			return snap2.GetUID()
+		}, maxWait, interval).Should(Equal(snap.GetUID()))
+
+		By("removing the content during cleanup")
+		Expect(r.cleanupCrossNamespaceSnapshot(ctx, r.Log, pvc)).To(Succeed())
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, client.ObjectKeyFromObject(content), content)
+			return err != nil || !content.GetDeletionTimestamp().IsZero()
+		}, maxWait, interval).Should(BeTrue())
+	})
+})
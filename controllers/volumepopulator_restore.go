@@ -0,0 +1,293 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/controllers/utils"
+)
+
+const (
+	// Progress of the population of a PVC that uses a ReplicationSource as
+	// its dataSourceRef, reported in annotationPopulatorProgress on the PVC
+	PopulatorProgressWaitingForSource = "WaitingForSource"
+	PopulatorProgressRestoring        = "Restoring"
+	PopulatorProgressRestoreFailed    = "RestoreFailed"
+	PopulatorProgressRestored         = "Restored"
+	PopulatorProgressCompleted        = "Completed"
+
+	// Manual trigger used for the one-shot restore ReplicationDestination
+	populatorRestoreTrigger = "volsync-populator-restore"
+)
+
+// populatorIdentity is the identity used for the operations that the volume
+// populator performs on behalf of pvc, so they can be told apart from those of
+// other ReplicationDestinations in audit trails
+func populatorIdentity(pvc *corev1.PersistentVolumeClaim) string {
+	return "volsync-populator/" + string(pvc.GetUID())
+}
+
+func pvcHasReplicationSourceDataSourceRef(pvc *corev1.PersistentVolumeClaim) bool {
+	if pvc.Spec.DataSourceRef == nil || pvc.Spec.DataSourceRef.APIGroup == nil {
+		return false
+	}
+
+	// This volume populator also responds to PVCs with dataSourceRef with group==volsync.backube
+	// and kind==ReplicationSource
+	return *pvc.Spec.DataSourceRef.APIGroup == volsyncv1alpha1.GroupVersion.Group &&
+		pvc.Spec.DataSourceRef.Kind == "ReplicationSource" &&
+		pvc.Spec.DataSourceRef.Name != ""
+}
+
+// pvcHasVolSyncDataSourceRef returns true if the PVC should be populated by
+// this volume populator
+func pvcHasVolSyncDataSourceRef(pvc *corev1.PersistentVolumeClaim) bool {
+	return pvcHasReplicationDestinationDataSourceRef(pvc) || pvcHasReplicationSourceDataSourceRef(pvc)
+}
+
+func (r VolumePopulatorReconciler) getReplicationSourceFromDataSourceRef(ctx context.Context, logger logr.Logger,
+	pvc *corev1.PersistentVolumeClaim) (*volsyncv1alpha1.ReplicationSource, error) {
+	rs := &volsyncv1alpha1.ReplicationSource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pvc.Spec.DataSourceRef.Name,
+			Namespace: pvc.GetNamespace(),
+		},
+	}
+	err := r.Client.Get(ctx, client.ObjectKeyFromObject(rs), rs)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			logger.Error(err, "Unable to populate volume - replicationsource not found",
+				"name", rs.GetName(), "namespace", rs.GetNamespace())
+		}
+		return nil, err
+	}
+
+	return rs, nil
+}
+
+// createPVCPrimeForReplicationSource creates an empty pvcPrime that the data from the repository of the
+// ReplicationSource will be restored into. Returns nil if pvcPrime was not created.
+func (r *VolumePopulatorReconciler) createPVCPrimeForReplicationSource(ctx context.Context, logger logr.Logger,
+	pvc *corev1.PersistentVolumeClaim, waitForFirstConsumer bool, nodeName string,
+) (*corev1.PersistentVolumeClaim, *vpResult) {
+	rs, err := r.getReplicationSourceFromDataSourceRef(ctx, logger, pvc)
+	if err != nil {
+		if !kerrors.IsNotFound(err) {
+			return nil, &vpResult{ctrl.Result{}, err}
+		}
+		r.EventRecorder.Eventf(pvc, corev1.EventTypeWarning, volsyncv1alpha1.EvVolPopPVCReplicationSourceMissing,
+			"Unable to populate volume: %s", err)
+		// Do not return error - will rely on watches to reconcile once the rs is created
+		return nil, &vpResult{ctrl.Result{}, r.setPopulatorProgress(ctx, pvc, PopulatorProgressWaitingForSource)}
+	}
+
+	if rs.Spec.Restic == nil {
+		dataSourceRefErr := fmt.Errorf("ReplicationSource does not use the restic mover")
+		logger.Error(dataSourceRefErr, "Unable to populate volume")
+		r.EventRecorder.Eventf(pvc, corev1.EventTypeWarning, volsyncv1alpha1.EvRVolPopPVCPopulatorError,
+			"Unable to populate volume: %s", dataSourceRefErr)
+		// Do not return error here - no use retrying
+		return nil, &vpResult{ctrl.Result{}, nil}
+	}
+
+	pvcPrime := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getPVCPrimeName(pvc),
+			Namespace: pvc.GetNamespace(),
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes:      pvc.Spec.AccessModes,
+			Resources:        pvc.Spec.Resources,
+			StorageClassName: pvc.Spec.StorageClassName,
+			VolumeMode:       pvc.Spec.VolumeMode,
+		},
+	}
+	if waitForFirstConsumer {
+		pvcPrime.Annotations = map[string]string{
+			annotationSelectedNode: nodeName,
+		}
+	}
+	if err := ctrl.SetControllerReference(pvc, pvcPrime, r.Client.Scheme()); err != nil {
+		logger.Error(err, utils.ErrUnableToSetControllerRef)
+		return nil, &vpResult{ctrl.Result{}, err}
+	}
+	utils.AddLabel(pvcPrime, labelPvcPrime, pvc.GetName())
+	utils.SetOwnedByVolSync(pvcPrime)
+
+	logger.Info("Creating temp populator pvc to restore into", "volpop pvc name", pvcPrime.GetName())
+	if err := r.Client.Create(ctx, pvcPrime); err != nil {
+		r.EventRecorder.Eventf(pvc, corev1.EventTypeWarning, volsyncv1alpha1.EvRVolPopPVCCreationError,
+			"Failed to create populator PVC: %s", err)
+		return nil, &vpResult{ctrl.Result{}, err}
+	}
+
+	r.EventRecorder.Eventf(pvc, corev1.EventTypeNormal, volsyncv1alpha1.EvRVolPopPVCCreationSuccess,
+		"Populator pvc created to restore from ReplicationSource %s", rs.GetName())
+
+	// Restore into pvcPrime using the repository of the ReplicationSource
+	if err := r.createRestoreReplicationDestination(ctx, logger, pvc, pvcPrime, rs); err != nil {
+		return nil, &vpResult{ctrl.Result{}, err}
+	}
+
+	return pvcPrime, nil
+}
+
+// createRestoreReplicationDestination creates the one-shot ReplicationDestination that restores the latest backup
+// in the repository of the ReplicationSource directly into pvcPrime.  It is named the same as pvcPrime.
+func (r *VolumePopulatorReconciler) createRestoreReplicationDestination(ctx context.Context, logger logr.Logger,
+	pvc, pvcPrime *corev1.PersistentVolumeClaim, rs *volsyncv1alpha1.ReplicationSource) error {
+	srcRestic := rs.Spec.Restic
+	rd := &volsyncv1alpha1.ReplicationDestination{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pvcPrime.GetName(),
+			Namespace: pvcPrime.GetNamespace(),
+			Annotations: map[string]string{
+				utils.PopulatorIdentityAnnotation: populatorIdentity(pvc),
+			},
+		},
+		Spec: volsyncv1alpha1.ReplicationDestinationSpec{
+			Trigger: &volsyncv1alpha1.ReplicationDestinationTriggerSpec{
+				Manual: populatorRestoreTrigger,
+			},
+			Restic: &volsyncv1alpha1.ReplicationDestinationResticSpec{
+				ReplicationDestinationVolumeOptions: volsyncv1alpha1.ReplicationDestinationVolumeOptions{
+					CopyMethod:     volsyncv1alpha1.CopyMethodDirect,
+					DestinationPVC: ptr.To(pvcPrime.GetName()),
+				},
+				Repository:            srcRestic.Repository,
+				RepositorySecretRef:   srcRestic.RepositorySecretRef.DeepCopy(),
+				CustomCA:              volsyncv1alpha1.ReplicationDestinationResticCA(srcRestic.CustomCA),
+				CacheCapacity:         srcRestic.CacheCapacity,
+				CacheStorageClassName: srcRestic.CacheStorageClassName,
+				CacheAccessModes:      srcRestic.CacheAccessModes,
+				CleanupCachePVC:       true,
+				FilesystemQuotas:      srcRestic.FilesystemQuotas,
+				MoverConfig:           *srcRestic.MoverConfig.DeepCopy(),
+			},
+		},
+	}
+	if err := ctrl.SetControllerReference(pvc, rd, r.Client.Scheme()); err != nil {
+		logger.Error(err, utils.ErrUnableToSetControllerRef)
+		return err
+	}
+	utils.AddLabel(rd, labelPvcPrime, pvc.GetName()) // Use this filter in predicates in the &Owns() watcher
+	utils.SetOwnedByVolSync(rd)
+
+	logger.Info("Creating replicationdestination to restore into temp populator pvc", "rd name", rd.GetName())
+	if err := r.Client.Create(ctx, rd); err != nil && !kerrors.IsAlreadyExists(err) {
+		r.EventRecorder.Eventf(pvc, corev1.EventTypeWarning, volsyncv1alpha1.EvRVolPopPVCPopulatorError,
+			"Failed to create restore ReplicationDestination: %s", err)
+		return err
+	}
+
+	r.EventRecorder.Eventf(pvc, corev1.EventTypeNormal, volsyncv1alpha1.EvRVolPopPVCRestoreStarted,
+		"Restoring from the repository of ReplicationSource %s as %s", rs.GetName(), populatorIdentity(pvc))
+	return r.setPopulatorProgress(ctx, pvc, PopulatorProgressRestoring)
+}
+
+// reconcileRestore waits for the restore into pvcPrime to complete, reporting progress on the pvc.
+// Returns nil once the restore has completed.
+func (r *VolumePopulatorReconciler) reconcileRestore(ctx context.Context, logger logr.Logger,
+	pvc, pvcPrime *corev1.PersistentVolumeClaim) *vpResult {
+	rd := &volsyncv1alpha1.ReplicationDestination{}
+	err := r.Client.Get(ctx, client.ObjectKeyFromObject(pvcPrime), rd)
+	if err != nil {
+		if !kerrors.IsNotFound(err) {
+			return &vpResult{ctrl.Result{}, err}
+		}
+		// The restore rd has been removed - if the restore already completed, pvcPrime can be rebound
+		if pvc.GetAnnotations()[annotationPopulatorProgress] == PopulatorProgressRestored {
+			return nil
+		}
+		// Otherwise (re)create it
+		rs, err := r.getReplicationSourceFromDataSourceRef(ctx, logger, pvc)
+		if err != nil {
+			return &vpResult{ctrl.Result{}, client.IgnoreNotFound(err)}
+		}
+		if rs.Spec.Restic == nil {
+			return &vpResult{ctrl.Result{}, nil}
+		}
+		return &vpResult{ctrl.Result{}, r.createRestoreReplicationDestination(ctx, logger, pvc, pvcPrime, rs)}
+	}
+
+	if rd.Status != nil && rd.Status.LastManualSync == populatorRestoreTrigger {
+		if pvc.GetAnnotations()[annotationPopulatorProgress] != PopulatorProgressRestored {
+			r.EventRecorder.Eventf(pvc, corev1.EventTypeNormal, volsyncv1alpha1.EvRVolPopPVCRestoreCompleted,
+				"Restore into populator pvc completed as %s", populatorIdentity(pvc))
+		}
+		if err := r.setPopulatorProgress(ctx, pvc, PopulatorProgressRestored); err != nil {
+			return &vpResult{ctrl.Result{}, err}
+		}
+		return nil
+	}
+
+	progress := PopulatorProgressRestoring
+	if rd.Status != nil && rd.Status.LatestMoverStatus != nil &&
+		rd.Status.LatestMoverStatus.Result == volsyncv1alpha1.MoverResultFailed {
+		// The restore will be retried by the replicationdestination
+		progress = PopulatorProgressRestoreFailed
+		if pvc.GetAnnotations()[annotationPopulatorProgress] != progress {
+			r.EventRecorder.Eventf(pvc, corev1.EventTypeWarning, volsyncv1alpha1.EvRVolPopPVCRestoreFailed,
+				"Restore into populator pvc as %s failed, retrying: %s", populatorIdentity(pvc),
+				rd.Status.LatestMoverStatus.Logs)
+		}
+	}
+
+	logger.V(1).Info("Waiting for restore into populator pvc", "progress", progress)
+	return &vpResult{ctrl.Result{}, r.setPopulatorProgress(ctx, pvc, progress)}
+}
+
+// Deletes the one-shot restore ReplicationDestination (if any) for pvc
+func (r *VolumePopulatorReconciler) cleanupRestoreReplicationDestination(ctx context.Context, logger logr.Logger,
+	pvc *corev1.PersistentVolumeClaim) error {
+	rd := &volsyncv1alpha1.ReplicationDestination{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getPVCPrimeName(pvc),
+			Namespace: pvc.GetNamespace(),
+		},
+	}
+	logger.Info("Cleanup - deleting restore replicationdestination", "rd name", rd.GetName())
+	return client.IgnoreNotFound(r.Client.Delete(ctx, rd, client.PropagationPolicy(metav1.DeletePropagationBackground)))
+}
+
+// setPopulatorProgress records the population progress in an annotation on the pvc
+func (r *VolumePopulatorReconciler) setPopulatorProgress(ctx context.Context, pvc *corev1.PersistentVolumeClaim,
+	progress string) error {
+	if pvc.GetAnnotations()[annotationPopulatorProgress] == progress {
+		return nil
+	}
+	patch := client.MergeFrom(pvc.DeepCopy())
+	annotations := pvc.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[annotationPopulatorProgress] = progress
+	pvc.SetAnnotations(annotations)
+	return r.Client.Patch(ctx, pvc, patch)
+}
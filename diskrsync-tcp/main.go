@@ -24,6 +24,7 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"strconv"
 	"time"
 
 	"go.uber.org/zap/zapcore"
@@ -150,7 +151,7 @@ func connectToTarget(sourceFile, targetAddress string, port int, opts *options,
 		return err
 	}
 
-	conn, err := net.Dial("tcp", fmt.Sprintf("%s:%d", targetAddress, port))
+	conn, err := net.Dial("tcp", net.JoinHostPort(targetAddress, strconv.Itoa(port)))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,455 @@
+/*
+Copyright © 2026 The VolSync authors
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	cron "github.com/robfig/cron/v3"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	kerrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+const (
+	// Label of the PodVolumeBackups of a Backup
+	veleroBackupNameLabel = "velero.io/backup-name"
+	// Label with the UID of the PVC of a PodVolumeBackup
+	veleroPVCUIDLabel = "velero.io/pvc-uid"
+	// Velero's Secret holding the password of its restic repositories
+	veleroRepoCredentialsSecret = "velero-repo-credentials"
+	veleroRepoPasswordKey       = "repository-password"
+	// Velero's default Secret holding the credentials of the object store
+	veleroCloudCredentialsSecret = "cloud-credentials"
+	veleroCloudCredentialsKey    = "cloud"
+)
+
+var veleroGroupVersion = schema.GroupVersion{Group: "velero.io", Version: "v1"}
+
+// Keys of an AWS credentials file and the restic variables they map to
+var awsCredentialVars = map[string]string{
+	"aws_access_key_id":     "AWS_ACCESS_KEY_ID",
+	"aws_secret_access_key": "AWS_SECRET_ACCESS_KEY",
+	"aws_session_token":     "AWS_SESSION_TOKEN",
+}
+
+type veleroImport struct {
+	// Cluster context name
+	Cluster string
+	// Namespace where Velero is installed
+	VeleroNamespace string
+	// Name of the Velero Backup whose volumes are imported
+	Backup string
+	// copyMethod of the generated ReplicationSources
+	CopyMethod *volsyncv1alpha1.CopyMethodType
+	// Cronspec of the generated ReplicationSources
+	Schedule *string
+	// Create the objects in the cluster instead of printing them
+	Apply bool
+	// client object to communicate with a cluster
+	client client.Client
+}
+
+// veleroVolume is a volume that Velero backed up with restic
+type veleroVolume struct {
+	// Namespace of the PVC
+	namespace string
+	// Name of the PVC
+	pvcName string
+	// Name of the BackupStorageLocation holding the repository
+	location string
+	// The repository of the namespace without restic/<namespace>
+	locationURL string
+}
+
+// veleroCmd represents the velero command
+var veleroCmd = &cobra.Command{
+	Use:   "velero",
+	Short: i18n.T("Move volumes backed up by Velero to VolSync"),
+	Long: templates.LongDesc(i18n.T(`
+	Convert the file system (restic) backups of Velero into VolSync
+	ReplicationSources.
+
+	The ReplicationSources continue to back up into the restic repositories
+	that Velero created, so the existing backups remain available for restores
+	with VolSync.
+	`)),
+}
+
+// veleroImportCmd represents the velero import command
+var veleroImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: i18n.T("Generate ReplicationSources for the volumes of a Velero backup"),
+	Long: templates.LongDesc(i18n.T(`
+	This command reads the PodVolumeBackups of a Velero Backup and generates a
+	restic ReplicationSource for each PVC in the backup, along with a
+	repository Secret per namespace.
+
+	The repository Secrets point at the BackupStorageLocation of the backup and
+	use the "Velero" repository layout, so that the ReplicationSources use the
+	repository Velero keeps for their namespace. The password of the
+	repositories is copied from Velero, as are the credentials of AWS (S3)
+	locations. The credentials of other providers have to be added to the
+	Secrets by hand.
+
+	By default, the objects are printed. With --apply, they are created.
+	`)),
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		vi, err := newVeleroImport(cmd)
+		if err != nil {
+			return err
+		}
+		return vi.Run(cmd.Context(), cmd.OutOrStdout())
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(veleroCmd)
+	initVeleroImportCmd(veleroImportCmd)
+}
+
+func initVeleroImportCmd(veleroImportCmd *cobra.Command) {
+	veleroCmd.AddCommand(veleroImportCmd)
+
+	veleroImportCmd.Flags().String("backup", "", "Velero Backup to import: [context/]namespace/name")
+	cobra.CheckErr(veleroImportCmd.MarkFlagRequired("backup"))
+	veleroImportCmd.Flags().String("copymethod", "Snapshot", "method used to create a point-in-time copy")
+	veleroImportCmd.Flags().String("cronspec", "", "Cronspec describing the backup schedule")
+	veleroImportCmd.Flags().Bool("apply", false, "create the objects instead of printing them")
+}
+
+func newVeleroImport(cmd *cobra.Command) (*veleroImport, error) {
+	vi := &veleroImport{}
+	if err := vi.parseCLI(cmd); err != nil {
+		return nil, err
+	}
+	return vi, nil
+}
+
+func (vi *veleroImport) parseCLI(cmd *cobra.Command) error {
+	backup, err := cmd.Flags().GetString("backup")
+	if err != nil || backup == "" {
+		return fmt.Errorf("failed to fetch the backup, err = %w", err)
+	}
+	xcr, err := ParseXClusterName(backup)
+	if err != nil {
+		return fmt.Errorf("failed to parse the backup name, err = %w", err)
+	}
+	vi.Cluster = xcr.Cluster
+	vi.VeleroNamespace = xcr.Namespace
+	vi.Backup = xcr.Name
+
+	if vi.CopyMethod, err = parseCopyMethod(cmd.Flags(), "copymethod", true); err != nil {
+		return err
+	}
+
+	cs, err := cmd.Flags().GetString("cronspec")
+	if err != nil {
+		return err
+	}
+	if cs != "" {
+		parser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+		if _, err = parser.Parse(cs); err != nil {
+			return err
+		}
+		vi.Schedule = &cs
+	}
+
+	vi.Apply, err = cmd.Flags().GetBool("apply")
+	return err
+}
+
+func (vi *veleroImport) Run(ctx context.Context, out io.Writer) error {
+	if vi.client == nil {
+		c, err := newClient(vi.Cluster)
+		if err != nil {
+			return err
+		}
+		vi.client = c
+	}
+
+	objs, err := vi.generate(ctx)
+	if err != nil {
+		return err
+	}
+	if vi.Apply {
+		return vi.apply(ctx, out, objs)
+	}
+	for _, obj := range objs {
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(out, "---\n%s", data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// generate returns the repository Secrets and ReplicationSources for the
+// volumes of the backup
+func (vi *veleroImport) generate(ctx context.Context) ([]client.Object, error) {
+	pvbs := &unstructured.UnstructuredList{}
+	pvbs.SetGroupVersionKind(veleroGroupVersion.WithKind("PodVolumeBackupList"))
+	if err := vi.client.List(ctx, pvbs, client.InNamespace(vi.VeleroNamespace),
+		client.MatchingLabels{veleroBackupNameLabel: vi.Backup}); err != nil {
+		return nil, fmt.Errorf("unable to list the PodVolumeBackups of backup %s: %w", vi.Backup, err)
+	}
+	if len(pvbs.Items) == 0 {
+		return nil, fmt.Errorf("backup %s has no PodVolumeBackups", vi.Backup)
+	}
+
+	objs := []client.Object{}
+	// The Secrets already generated, by namespace/name
+	secrets := map[string]bool{}
+	for i := range pvbs.Items {
+		vol, err := vi.parsePodVolumeBackup(ctx, &pvbs.Items[i])
+		if err != nil {
+			klog.Warningf("skipping PodVolumeBackup %s: %v", pvbs.Items[i].GetName(), err)
+			continue
+		}
+		secretName := "volsync-velero-" + vol.location
+		if key := vol.namespace + "/" + secretName; !secrets[key] {
+			secret, err := vi.repositorySecret(ctx, vol, secretName)
+			if err != nil {
+				return nil, err
+			}
+			objs = append(objs, secret)
+			secrets[key] = true
+		}
+		objs = append(objs, vi.replicationSource(vol, secretName))
+	}
+	if len(objs) == 0 {
+		return nil, fmt.Errorf("none of the volumes of backup %s can be imported", vi.Backup)
+	}
+	return objs, nil
+}
+
+func (vi *veleroImport) parsePodVolumeBackup(ctx context.Context,
+	pvb *unstructured.Unstructured) (*veleroVolume, error) {
+	uploader, _, _ := unstructured.NestedString(pvb.Object, "spec", "uploaderType")
+	if uploader != "" && uploader != "restic" {
+		return nil, fmt.Errorf("only restic backups can be imported, not %s", uploader)
+	}
+	phase, _, _ := unstructured.NestedString(pvb.Object, "status", "phase")
+	if phase != "Completed" {
+		return nil, fmt.Errorf("the backup of the volume has not completed")
+	}
+
+	vol := &veleroVolume{}
+	vol.namespace, _, _ = unstructured.NestedString(pvb.Object, "spec", "pod", "namespace")
+	vol.location, _, _ = unstructured.NestedString(pvb.Object, "spec", "backupStorageLocation")
+	repo, _, _ := unstructured.NestedString(pvb.Object, "spec", "repoIdentifier")
+	if vol.namespace == "" || vol.location == "" {
+		return nil, fmt.Errorf("the pod or the BackupStorageLocation is missing")
+	}
+	var err error
+	if vol.locationURL, err = veleroLocationURL(repo, vol.namespace); err != nil {
+		return nil, err
+	}
+	if vol.pvcName, err = vi.findPVC(ctx, pvb, vol.namespace); err != nil {
+		return nil, err
+	}
+	return vol, nil
+}
+
+// veleroLocationURL returns the location of the BackupStorageLocation from
+// the repository Velero uses for a namespace
+func veleroLocationURL(repo string, namespace string) (string, error) {
+	suffix := "/restic/" + namespace
+	if !strings.HasSuffix(repo, suffix) {
+		return "", fmt.Errorf("repository %q does not have the layout of Velero", repo)
+	}
+	return strings.TrimSuffix(repo, suffix), nil
+}
+
+// findPVC returns the name of the PVC that was backed up, by its UID or else
+// through the volumes of the pod
+func (vi *veleroImport) findPVC(ctx context.Context, pvb *unstructured.Unstructured,
+	namespace string) (string, error) {
+	if uid := pvb.GetLabels()[veleroPVCUIDLabel]; uid != "" {
+		pvcs := &corev1.PersistentVolumeClaimList{}
+		if err := vi.client.List(ctx, pvcs, client.InNamespace(namespace)); err != nil {
+			return "", err
+		}
+		for _, pvc := range pvcs.Items {
+			if string(pvc.UID) == uid {
+				return pvc.Name, nil
+			}
+		}
+	}
+
+	podName, _, _ := unstructured.NestedString(pvb.Object, "spec", "pod", "name")
+	volume, _, _ := unstructured.NestedString(pvb.Object, "spec", "volume")
+	pod := &corev1.Pod{}
+	if err := vi.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: podName}, pod); err != nil {
+		return "", fmt.Errorf("unable to find the PVC of the volume: %w", err)
+	}
+	for _, v := range pod.Spec.Volumes {
+		if v.Name == volume && v.PersistentVolumeClaim != nil {
+			return v.PersistentVolumeClaim.ClaimName, nil
+		}
+	}
+	return "", fmt.Errorf("volume %s of pod %s/%s is not a PVC", volume, namespace, podName)
+}
+
+// repositorySecret returns the repository Secret for the volumes of a
+// namespace that are in a BackupStorageLocation
+func (vi *veleroImport) repositorySecret(ctx context.Context, vol *veleroVolume,
+	name string) (*corev1.Secret, error) {
+	password, err := vi.veleroSecretValue(ctx, veleroRepoCredentialsSecret, veleroRepoPasswordKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the repository password of Velero: %w", err)
+	}
+	secret := &corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: vol.namespace},
+		StringData: map[string]string{
+			"RESTIC_REPOSITORY": vol.locationURL,
+			"RESTIC_PASSWORD":   password,
+		},
+	}
+
+	bsl := &unstructured.Unstructured{}
+	bsl.SetGroupVersionKind(veleroGroupVersion.WithKind("BackupStorageLocation"))
+	if err := vi.client.Get(ctx, client.ObjectKey{Namespace: vi.VeleroNamespace, Name: vol.location},
+		bsl); err != nil {
+		return nil, fmt.Errorf("unable to get BackupStorageLocation %s: %w", vol.location, err)
+	}
+	provider, _, _ := unstructured.NestedString(bsl.Object, "spec", "provider")
+	if provider != "aws" && provider != "velero.io/aws" {
+		klog.Warningf("the credentials of provider %s are not converted, add them to Secret %s/%s",
+			provider, vol.namespace, name)
+		return secret, nil
+	}
+
+	credName, _, _ := unstructured.NestedString(bsl.Object, "spec", "credential", "name")
+	credKey, _, _ := unstructured.NestedString(bsl.Object, "spec", "credential", "key")
+	if credName == "" {
+		credName, credKey = veleroCloudCredentialsSecret, veleroCloudCredentialsKey
+	}
+	creds, err := vi.veleroSecretValue(ctx, credName, credKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the credentials of BackupStorageLocation %s: %w", vol.location, err)
+	}
+	profile, _, _ := unstructured.NestedString(bsl.Object, "spec", "config", "profile")
+	if profile == "" {
+		profile = "default"
+	}
+	for k, v := range parseAWSCredentials(creds, profile) {
+		if envName, ok := awsCredentialVars[k]; ok {
+			secret.StringData[envName] = v
+		}
+	}
+	if region, _, _ := unstructured.NestedString(bsl.Object, "spec", "config", "region"); region != "" {
+		secret.StringData["AWS_DEFAULT_REGION"] = region
+	}
+	return secret, nil
+}
+
+func (vi *veleroImport) veleroSecretValue(ctx context.Context, name string, key string) (string, error) {
+	secret := &corev1.Secret{}
+	if err := vi.client.Get(ctx, client.ObjectKey{Namespace: vi.VeleroNamespace, Name: name}, secret); err != nil {
+		return "", err
+	}
+	value, ok := secret.Data[key]
+	if !ok {
+		return "", fmt.Errorf("secret %s has no key %s", name, key)
+	}
+	return string(value), nil
+}
+
+// parseAWSCredentials returns the keys of a profile in an AWS credentials file
+func parseAWSCredentials(data string, profile string) map[string]string {
+	values := map[string]string{}
+	section := ""
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			section = strings.TrimSpace(line[1 : len(line)-1])
+		case section == profile:
+			if k, v, ok := strings.Cut(line, "="); ok {
+				values[strings.TrimSpace(k)] = strings.TrimSpace(v)
+			}
+		}
+	}
+	return values
+}
+
+func (vi *veleroImport) replicationSource(vol *veleroVolume, secretName string) *volsyncv1alpha1.ReplicationSource {
+	rs := &volsyncv1alpha1.ReplicationSource{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: volsyncv1alpha1.GroupVersion.String(),
+			Kind:       "ReplicationSource",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      vol.pvcName + "-velero",
+			Namespace: vol.namespace,
+		},
+		Spec: volsyncv1alpha1.ReplicationSourceSpec{
+			SourcePVC: vol.pvcName,
+			Restic: &volsyncv1alpha1.ReplicationSourceResticSpec{
+				Repository:       secretName,
+				RepositoryLayout: volsyncv1alpha1.ResticRepositoryLayoutVelero,
+				// The volumes of the namespace share the repository, so the
+				// retain policy must only apply to this one
+				Tags: []string{"pvc=" + vol.pvcName},
+			},
+		},
+	}
+	if vi.CopyMethod != nil {
+		rs.Spec.Restic.CopyMethod = *vi.CopyMethod
+	}
+	if vi.Schedule != nil {
+		rs.Spec.Trigger = &volsyncv1alpha1.ReplicationSourceTriggerSpec{Schedule: vi.Schedule}
+	}
+	return rs
+}
+
+func (vi *veleroImport) apply(ctx context.Context, out io.Writer, objs []client.Object) error {
+	for _, obj := range objs {
+		kind := obj.GetObjectKind().GroupVersionKind().Kind
+		err := vi.client.Create(ctx, obj)
+		if kerrs.IsAlreadyExists(err) {
+			klog.Warningf("%s %s/%s already exists, leaving it unchanged", kind, obj.GetNamespace(), obj.GetName())
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(out, "%s %s/%s created\n", kind, obj.GetNamespace(), obj.GetName()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
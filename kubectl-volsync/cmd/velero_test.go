@@ -0,0 +1,183 @@
+/*
+Copyright © 2026 The VolSync authors
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+import (
+	"bytes"
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+var _ = Describe("velero import", func() {
+	var vi *veleroImport
+	var objs []client.Object
+
+	podVolumeBackup := func(name string, pvcUID string, phase string) *unstructured.Unstructured {
+		pvb := &unstructured.Unstructured{Object: map[string]interface{}{
+			"spec": map[string]interface{}{
+				"backupStorageLocation": "default",
+				"pod":                   map[string]interface{}{"namespace": "app", "name": "app-0"},
+				"repoIdentifier":        "s3:https://s3.example.com/bucket/velero/restic/app",
+				"uploaderType":          "restic",
+				"volume":                name,
+			},
+			"status": map[string]interface{}{"phase": phase},
+		}}
+		pvb.SetGroupVersionKind(veleroGroupVersion.WithKind("PodVolumeBackup"))
+		pvb.SetName("nightly-" + name)
+		pvb.SetNamespace("velero")
+		pvb.SetLabels(map[string]string{
+			veleroBackupNameLabel: "nightly",
+			veleroPVCUIDLabel:     pvcUID,
+		})
+		return pvb
+	}
+
+	BeforeEach(func() {
+		bsl := &unstructured.Unstructured{Object: map[string]interface{}{
+			"spec": map[string]interface{}{
+				"provider": "aws",
+				"config":   map[string]interface{}{"region": "us-east-1"},
+			},
+		}}
+		bsl.SetGroupVersionKind(veleroGroupVersion.WithKind("BackupStorageLocation"))
+		bsl.SetName("default")
+		bsl.SetNamespace("velero")
+
+		objs = []client.Object{
+			bsl,
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: veleroRepoCredentialsSecret, Namespace: "velero"},
+				Data:       map[string][]byte{veleroRepoPasswordKey: []byte("static-passw0rd")},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: veleroCloudCredentialsSecret, Namespace: "velero"},
+				Data: map[string][]byte{veleroCloudCredentialsKey: []byte(
+					"[default]\naws_access_key_id = key\naws_secret_access_key = secret\n")},
+			},
+			&corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: "data-app-0", Namespace: "app", UID: "pvc-uid"},
+			},
+			&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "app-0", Namespace: "app"},
+				Spec: corev1.PodSpec{
+					Volumes: []corev1.Volume{{
+						Name: "logs",
+						VolumeSource: corev1.VolumeSource{
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "logs-app-0"},
+						},
+					}},
+				},
+			},
+		}
+		vi = &veleroImport{
+			VeleroNamespace: "velero",
+			Backup:          "nightly",
+		}
+	})
+	JustBeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(volsyncv1alpha1.AddToScheme(scheme)).To(Succeed())
+		vi.client = fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	})
+
+	It("finds the location of Velero's repositories", func() {
+		url, err := veleroLocationURL("s3:https://s3.example.com/bucket/velero/restic/app", "app")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(url).To(Equal("s3:https://s3.example.com/bucket/velero"))
+		_, err = veleroLocationURL("s3:https://s3.example.com/bucket/kopia/app", "app")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("parses AWS credential files", func() {
+		creds := parseAWSCredentials("# comment\n[other]\naws_access_key_id=other\n"+
+			"[default]\naws_access_key_id = key\n", "default")
+		Expect(creds).To(Equal(map[string]string{"aws_access_key_id": "key"}))
+	})
+
+	It("fails when the backup has no volumes", func() {
+		vi.Backup = "missing"
+		_, err := vi.generate(context.Background())
+		Expect(err).To(HaveOccurred())
+	})
+
+	When("the backup has volumes", func() {
+		BeforeEach(func() {
+			objs = append(objs,
+				podVolumeBackup("data", "pvc-uid", "Completed"),
+				podVolumeBackup("logs", "", "Completed"),
+				podVolumeBackup("tmp", "", "Failed"))
+		})
+
+		It("generates a Secret for the namespace and a ReplicationSource for each PVC", func() {
+			generated, err := vi.generate(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(generated).To(HaveLen(3))
+
+			secret, ok := generated[0].(*corev1.Secret)
+			Expect(ok).To(BeTrue())
+			Expect(secret.Namespace).To(Equal("app"))
+			Expect(secret.Name).To(Equal("volsync-velero-default"))
+			Expect(secret.StringData).To(Equal(map[string]string{
+				"RESTIC_REPOSITORY":     "s3:https://s3.example.com/bucket/velero",
+				"RESTIC_PASSWORD":       "static-passw0rd",
+				"AWS_ACCESS_KEY_ID":     "key",
+				"AWS_SECRET_ACCESS_KEY": "secret",
+				"AWS_DEFAULT_REGION":    "us-east-1",
+			}))
+
+			pvcNames := []string{}
+			for _, obj := range generated[1:] {
+				rs, ok := obj.(*volsyncv1alpha1.ReplicationSource)
+				Expect(ok).To(BeTrue())
+				Expect(rs.Namespace).To(Equal("app"))
+				Expect(rs.Spec.Restic.Repository).To(Equal(secret.Name))
+				Expect(rs.Spec.Restic.RepositoryLayout).To(Equal(volsyncv1alpha1.ResticRepositoryLayoutVelero))
+				Expect(rs.Spec.Restic.Tags).To(Equal([]string{"pvc=" + rs.Spec.SourcePVC}))
+				pvcNames = append(pvcNames, rs.Spec.SourcePVC)
+			}
+			Expect(pvcNames).To(ConsistOf("data-app-0", "logs-app-0"))
+		})
+
+		It("prints the objects", func() {
+			out := &bytes.Buffer{}
+			Expect(vi.Run(context.Background(), out)).To(Succeed())
+			Expect(out.String()).To(ContainSubstring("kind: ReplicationSource"))
+			Expect(out.String()).To(ContainSubstring("repositoryLayout: Velero"))
+		})
+
+		It("creates the objects with --apply", func() {
+			vi.Apply = true
+			out := &bytes.Buffer{}
+			Expect(vi.Run(context.Background(), out)).To(Succeed())
+			rs := &volsyncv1alpha1.ReplicationSource{}
+			Expect(vi.client.Get(context.Background(),
+				client.ObjectKey{Namespace: "app", Name: "data-app-0-velero"}, rs)).To(Succeed())
+		})
+	})
+})
@@ -25,6 +25,9 @@ import (
 	"os"
 	"runtime"
 	"time"
+	// Embed the time zone database so trigger time zones work in minimal
+	// container images
+	_ "time/tzdata"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -39,10 +42,13 @@ import (
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 	"go.uber.org/zap/zapcore"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	kruntime "k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -53,6 +59,7 @@ import (
 	"github.com/backube/volsync/controllers"
 	"github.com/backube/volsync/controllers/mover"
 	"github.com/backube/volsync/controllers/platform"
+	sm "github.com/backube/volsync/controllers/statemachine"
 	"github.com/backube/volsync/controllers/utils"
 	//+kubebuilder:scaffold:imports
 )
@@ -124,6 +131,43 @@ func addCommandFlags(probeAddr *string, metricsAddr *string, enableLeaderElectio
 			"Enabling this will ensure there is only one active controller manager.")
 	flag.StringVar(&utils.SCCName, "scc-name",
 		utils.DefaultSCCName, "The name of the volsync security context constraint")
+	flag.IntVar(&sm.MaxConcurrentSyncs, "max-concurrent-syncs", 0,
+		"The maximum number of synchronizations that may run at the same time. "+
+			"Waiting synchronizations are started round-robin across namespaces. 0 means no limit.")
+	flag.IntVar(&sm.SyncHistoryLimit, "sync-history-limit", sm.DefaultSyncHistoryLimit,
+		"The number of synchronization attempts retained in .status.history of each "+
+			"ReplicationSource and ReplicationDestination. 0 disables the history.")
+	flag.StringVar(&utils.MoverLogSinkURL, "mover-log-sink-url", "",
+		"If set, the full logs of each mover pod are shipped to this http(s) endpoint.")
+	flag.StringVar(&utils.AllowedMoverImages, "allowed-mover-images", "",
+		"Comma-separated list of container images that ReplicationSources and ReplicationDestinations "+
+			"may use in place of the default mover image (moverImage). Entries ending in \"*\" match by prefix.")
+	flag.StringVar(&utils.AllowedNotificationHosts, "allowed-notification-hosts", "",
+		"Comma-separated list of the hosts that ReplicationSources and ReplicationDestinations may send "+
+			"notifications to. Entries starting with \"*.\" match all subdomains. Notifications are disabled if empty.")
+	flag.StringVar(&utils.MoverArchitectures, "mover-architectures", "",
+		"Comma-separated list of the node architectures (e.g., amd64,arm64) supported by the mover images. "+
+			"Mover pods are kept off of nodes with other architectures.")
+	flag.StringVar(&utils.DefaultVolumeSnapshotClasses, "default-volume-snapshot-classes", "",
+		"Comma-separated list of <storageClass>=<volumeSnapshotClass> pairs that select the VolumeSnapshotClass "+
+			"for snapshots of PVCs of each StorageClass when volumeSnapshotClassName is not set.")
+	flag.StringVar(&utils.MoverLogSinkType, "mover-log-sink-type", utils.MoverLogSinkTypeWebhook,
+		"The kind of mover log sink: \"webhook\" (POST JSON) or \"object\" (PUT <url>/<ns>/<job>/<pod>.log).")
+	flag.BoolVar(&controllers.StatusBackfillEnabled, "status-backfill", true,
+		"Once at startup, populate newly added status fields and normalize legacy conditions on existing "+
+			"ReplicationSources and ReplicationDestinations.")
+	flag.DurationVar(&controllers.OrphanCollectionInterval, "orphan-collection-interval", 0,
+		"How often to sweep the cluster for Jobs, PVCs, Secrets, Services, and VolumeSnapshots created by VolSync "+
+			"whose owner no longer exists, deleting them. 0 disables the sweep.")
+	flag.BoolVar(&controllers.OrphanCollectionDryRun, "orphan-collection-dry-run", false,
+		"Only log and count the orphaned objects found by the orphan collection sweep, without deleting them.")
+	flag.StringVar(&utils.DefaultMoverSecurityContext, "default-mover-security-context", "",
+		"The pod securityContext (as JSON, or the path of a JSON or YAML file) of unprivileged movers whose "+
+			"ReplicationSource or ReplicationDestination does not set moverSecurityContext.")
+	flag.StringVar(&utils.WatchNamespaces, "watch-namespaces", "",
+		"Comma-separated list of the namespaces to watch. If empty, all namespaces are watched. "+
+			"When set, VolSync only needs namespaced permissions and the cluster-scoped "+
+			"ReplicationPolicy, RestoreFanout, and VolumeSnapshotContent controllers are disabled.")
 	opts := zap.Options{
 		Development: true,
 		TimeEncoder: zapcore.ISO8601TimeEncoder,
@@ -149,21 +193,56 @@ func ensureCRs(cfg *rest.Config) {
 		os.Exit(1)
 	}
 
+	// When only watching some namespaces, VolSync may not have permission to
+	// manage these cluster-scoped objects. They are then expected to have been
+	// created by the cluster admin, so failures are not fatal.
+	fatal := !utils.NamespaceScoped()
+
 	// Privileged mover SCC required in OpenShift envs
 	setupLog.Info("Privileged Mover SCC", "scc-name", utils.SCCName)
 	err = platform.EnsureVolSyncMoverSCCIfOpenShift(context.Background(), setupClient, setupLog,
 		utils.SCCName, volsyncMoverSCCYamlRaw)
 	if err != nil {
 		setupLog.Error(err, "unable to reconcile volsync mover scc", "scc-name", utils.SCCName)
-		os.Exit(1)
+		if fatal {
+			os.Exit(1)
+		}
 	}
 
 	// VolumePopulator CR should be registered if the VolumePopulator CRD is present
 	err = controllers.EnsureVolSyncVolumePopulatorCRIfCRDPresent(context.Background(), setupClient, setupLog)
 	if err != nil {
 		setupLog.Error(err, "unable to reconcile VolumePopulator CR")
-		os.Exit(1)
+		if fatal {
+			os.Exit(1)
+		}
+	}
+}
+
+// cacheOptions restricts the manager's cache to the namespaces given by
+// --watch-namespaces. The cluster-scoped objects that VolSync reads are then
+// fetched directly from the API server instead of being watched.
+func cacheOptions() (cache.Options, client.Options) {
+	namespaces := utils.WatchedNamespaces()
+	if len(namespaces) == 0 {
+		return cache.Options{}, client.Options{}
+	}
+	setupLog.Info("Watching namespaces", "namespaces", namespaces)
+	defaultNamespaces := map[string]cache.Config{}
+	for _, ns := range namespaces {
+		defaultNamespaces[ns] = cache.Config{}
 	}
+	return cache.Options{DefaultNamespaces: defaultNamespaces},
+		client.Options{Cache: &client.CacheOptions{
+			DisableFor: []client.Object{
+				&corev1.Namespace{},
+				&corev1.Node{},
+				&corev1.PersistentVolume{},
+				&storagev1.StorageClass{},
+				&snapv1.VolumeSnapshotClass{},
+				&snapv1.VolumeSnapshotContent{},
+			},
+		}}
 }
 
 func initPodLogsClient(cfg *rest.Config) {
@@ -174,6 +253,14 @@ func initPodLogsClient(cfg *rest.Config) {
 	}
 	setupLog.Info("Mover Status Log", "log max bytes", utils.GetMoverLogMaxBytes(),
 		"tail lines", utils.GetMoverLogTailLines(), "debug", utils.IsMoverLogDebug())
+
+	if err = utils.ValidateMoverLogSink(); err != nil {
+		setupLog.Error(err, "invalid mover log sink configuration")
+		os.Exit(1)
+	}
+	if utils.MoverLogSinkURL != "" {
+		setupLog.Info("Mover Log Sink", "url", utils.MoverLogSinkURL, "type", utils.MoverLogSinkType)
+	}
 }
 
 // nolint: funlen
@@ -188,13 +275,21 @@ func main() {
 	addCommandFlags(&probeAddr, &metricsAddr, &enableLeaderElection)
 	printInfo()
 
+	if err := utils.LoadDefaultMoverSecurityContext(); err != nil {
+		setupLog.Error(err, "invalid default mover security context")
+		os.Exit(1)
+	}
+
 	leaseDuration := 137 * time.Second
 	renewDeadline := 107 * time.Second
 	retryPeriod := 26 * time.Second
 
 	cfg := ctrl.GetConfigOrDie()
+	cacheOpts, clientOpts := cacheOptions()
 	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
 		Scheme:                 scheme,
+		Cache:                  cacheOpts,
+		Client:                 clientOpts,
 		Metrics:                metricsserver.Options{BindAddress: metricsAddr},
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
@@ -243,6 +338,70 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err = (&controllers.ReplicationPairReconciler{
+		Client:        mgr.GetClient(),
+		Log:           ctrl.Log.WithName("controllers").WithName("ReplicationPair"),
+		Scheme:        mgr.GetScheme(),
+		EventRecorder: mgr.GetEventRecorderFor("volsync-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ReplicationPair")
+		os.Exit(1)
+	}
+
+	if err = (&controllers.ReplicationCredentialSyncReconciler{
+		Client:        mgr.GetClient(),
+		Log:           ctrl.Log.WithName("controllers").WithName("ReplicationCredentialSync"),
+		Scheme:        mgr.GetScheme(),
+		EventRecorder: mgr.GetEventRecorderFor("volsync-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ReplicationCredentialSync")
+		os.Exit(1)
+	}
+
+	if err = (&controllers.ReplicationLinkReconciler{
+		Client:        mgr.GetClient(),
+		Log:           ctrl.Log.WithName("controllers").WithName("ReplicationLink"),
+		Scheme:        mgr.GetScheme(),
+		EventRecorder: mgr.GetEventRecorderFor("volsync-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ReplicationLink")
+		os.Exit(1)
+	}
+
+	// ReplicationPolicies, RestoreFanouts, and VolumeSnapshotContents are
+	// cluster-scoped, so their controllers only run when all namespaces are
+	// watched
+	if !utils.NamespaceScoped() {
+		if err = (&controllers.ReplicationPolicyReconciler{
+			Client:        mgr.GetClient(),
+			Log:           ctrl.Log.WithName("controllers").WithName("ReplicationPolicy"),
+			Scheme:        mgr.GetScheme(),
+			EventRecorder: mgr.GetEventRecorderFor("volsync-controller"),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ReplicationPolicy")
+			os.Exit(1)
+		}
+
+		if err = (&controllers.RestoreFanoutReconciler{
+			Client:        mgr.GetClient(),
+			Log:           ctrl.Log.WithName("controllers").WithName("RestoreFanout"),
+			Scheme:        mgr.GetScheme(),
+			EventRecorder: mgr.GetEventRecorderFor("volsync-controller"),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "RestoreFanout")
+			os.Exit(1)
+		}
+
+		if err = (&controllers.SnapshotContentReconciler{
+			Client:        mgr.GetClient(),
+			Log:           ctrl.Log.WithName("controllers").WithName("VolumeSnapshotContent"),
+			EventRecorder: mgr.GetEventRecorderFor("volsync-controller"),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "VolumeSnapshotContent")
+			os.Exit(1)
+		}
+	}
+
 	// Index fields that are required for the VolumePopulator controller
 	if err := controllers.IndexFieldsForVolumePopulator(context.Background(), mgr.GetFieldIndexer()); err != nil {
 		setupLog.Error(err, "unable to index fields for controller", "controller", "VolumePopulator")
@@ -258,6 +417,29 @@ func main() {
 		os.Exit(1)
 	}
 	//+kubebuilder:scaffold:builder
+	if controllers.StatusBackfillEnabled {
+		if err := mgr.Add(&controllers.StatusBackfill{
+			Client: mgr.GetClient(),
+			Log:    ctrl.Log.WithName("StatusBackfill"),
+		}); err != nil {
+			setupLog.Error(err, "unable to add status backfill")
+			os.Exit(1)
+		}
+	}
+	if controllers.OrphanCollectionInterval > 0 && utils.NamespaceScoped() {
+		setupLog.Info("orphan collection is not available when watching a subset of namespaces")
+	} else if controllers.OrphanCollectionInterval > 0 {
+		if err := mgr.Add(&controllers.OrphanCollector{
+			Client:    mgr.GetClient(),
+			APIReader: mgr.GetAPIReader(),
+			Log:       ctrl.Log.WithName("OrphanCollector"),
+			Interval:  controllers.OrphanCollectionInterval,
+			DryRun:    controllers.OrphanCollectionDryRun,
+		}); err != nil {
+			setupLog.Error(err, "unable to add orphan collector")
+			os.Exit(1)
+		}
+	}
 	if err := configureChecks(mgr); err != nil {
 		setupLog.Error(err, "unable to setup checks")
 		os.Exit(1)
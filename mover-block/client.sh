@@ -0,0 +1,132 @@
+#! /bin/bash
+
+set -e -o pipefail
+
+DESTINATION_PORT="${DESTINATION_PORT:-8000}"
+if [[ -z "$DESTINATION_ADDRESS" ]]; then
+    echo "Remote host address must be provided in DESTINATION_ADDRESS"
+    exit 1
+fi
+
+STUNNEL_CONF=/tmp/stunnel-client.conf
+STUNNEL_PID_FILE=/tmp/stunnel-client.pid
+PSK_FILE=/keys/psk.txt
+STUNNEL_LISTEN_PORT=9000
+BLOCK_SOURCE="/dev/block"
+
+SCRIPT_FULLPATH="$(realpath "$0")"
+SCRIPT="$(basename "$SCRIPT_FULLPATH")"
+SCRIPT_DIR="$(dirname "$SCRIPT_FULLPATH")"
+
+# Do not do this debug mover code if this is already the
+# mover script copy in /tmp
+if [[ $DEBUG_MOVER -eq 1 && "$SCRIPT_DIR" != "/tmp" ]]; then
+  MOVER_SCRIPT_COPY="/tmp/$SCRIPT"
+  cp "$SCRIPT_FULLPATH" "$MOVER_SCRIPT_COPY"
+
+  END_DEBUG_FILE="/tmp/exit-debug-if-removed"
+  touch $END_DEBUG_FILE
+
+  echo ""
+  echo "##################################################################"
+  echo "DEBUG_MOVER is enabled, this pod will sleep indefinitely."
+  echo ""
+  echo "The mover script that would normally run has been copied to"
+  echo "$MOVER_SCRIPT_COPY".
+  echo ""
+  echo "To debug, you can modify this file and run it with:"
+  echo "$MOVER_SCRIPT_COPY" "$@"
+  echo ""
+  echo "If you wish to exit this pod after debugging, delete the"
+  echo "file $END_DEBUG_FILE from the system."
+  echo "##################################################################"
+
+  # Wait for user to delete the file before exiting
+  while [[ -f "${END_DEBUG_FILE}" ]]; do
+    sleep 10
+  done
+
+  echo ""
+  echo "##################################################################"
+  echo "Debug done, exiting."
+  echo "##################################################################"
+  sleep 2
+  exit 0
+fi
+
+cd "$SCRIPT_DIR"
+
+# shellcheck disable=SC2317  # It's reachable due to the TRAP
+function stop_stunnel() {
+    ## Terminate stunnel
+    kill -TERM "$(<"$STUNNEL_PID_FILE")"
+}
+
+if [[ ! -r $PSK_FILE ]]; then
+    echo "ERROR: Pre-shared key not found - $PSK_FILE"
+    exit 1
+fi
+
+if ! test -b $BLOCK_SOURCE; then
+    echo "ERROR: source block device not found - $BLOCK_SOURCE"
+    exit 1
+fi
+
+cat - > "$STUNNEL_CONF" <<STUNNEL_CONF
+; Global options
+debug = debug
+foreground = no
+output = /dev/stdout
+pid = $STUNNEL_PID_FILE
+socket = l:SO_KEEPALIVE=1
+socket = l:TCP_KEEPIDLE=180
+socket = r:SO_KEEPALIVE=1
+socket = r:TCP_KEEPIDLE=180
+syslog = no
+
+[diskrsync]
+ciphers = PSK
+PSKsecrets = $PSK_FILE
+; Port to listen for incoming connection from diskrsync
+accept = 127.0.0.1:$STUNNEL_LISTEN_PORT
+; We are the client
+client = yes
+connect = $DESTINATION_ADDRESS:$DESTINATION_PORT
+STUNNEL_CONF
+
+stunnel -version "$STUNNEL_CONF"
+
+##############################
+## Start stunnel to forward the outgoing connection
+stunnel "$STUNNEL_CONF"
+trap stop_stunnel EXIT
+
+# Sync the device
+START_TIME=$SECONDS
+MAX_RETRIES=5
+RETRY=0
+DELAY=2
+FACTOR=2
+rc=1
+set +e  # Don't exit on command failure
+echo "Syncing block device to ${DESTINATION_ADDRESS}:${DESTINATION_PORT} ..."
+while [[ $rc -ne 0 && $RETRY -lt $MAX_RETRIES ]]; do
+    RETRY=$(( RETRY + 1 ))
+    echo "calling diskrsync-tcp $BLOCK_SOURCE --source --target-address 127.0.0.1 --port $STUNNEL_LISTEN_PORT"
+    /diskrsync-tcp $BLOCK_SOURCE --source --target-address 127.0.0.1 --port $STUNNEL_LISTEN_PORT
+    rc=$?
+    if [[ $rc -ne 0 ]]; then
+        echo "Syncronization failed. Retrying in $DELAY seconds. Retry ${RETRY}/${MAX_RETRIES}."
+        sleep $DELAY
+        DELAY=$(( DELAY * FACTOR ))
+    fi
+done
+set -e  # Exit on command failure
+
+if [[ $rc -eq 0 ]]; then
+    echo "diskrsync completed in $(( SECONDS - START_TIME ))s"
+else
+    echo "Synchronization failed. diskrsync returned: $rc"
+fi
+
+exit $rc
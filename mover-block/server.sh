@@ -0,0 +1,137 @@
+#! /bin/bash
+
+set -e -o pipefail
+
+CONTROL_FILE=/tmp/control/complete
+STUNNEL_CONF=/tmp/stunnel.conf
+STUNNEL_PID_FILE=/tmp/stunnel.pid
+PSK_FILE=/keys/psk.txt
+DISKRSYNC_PORT=8888
+BLOCK_TARGET="/dev/block"
+IPV6_DISABLED=$(cat /sys/module/ipv6/parameters/disable)
+
+SCRIPT_FULLPATH="$(realpath "$0")"
+SCRIPT="$(basename "$SCRIPT_FULLPATH")"
+SCRIPT_DIR="$(dirname "$SCRIPT_FULLPATH")"
+
+# Do not do this debug mover code if this is already the
+# mover script copy in /tmp
+if [[ $DEBUG_MOVER -eq 1 && "$SCRIPT_DIR" != "/tmp" ]]; then
+  MOVER_SCRIPT_COPY="/tmp/$SCRIPT"
+  cp "$SCRIPT_FULLPATH" "$MOVER_SCRIPT_COPY"
+
+  END_DEBUG_FILE="/tmp/exit-debug-if-removed"
+  touch $END_DEBUG_FILE
+
+  echo ""
+  echo "##################################################################"
+  echo "DEBUG_MOVER is enabled, this pod will sleep indefinitely."
+  echo ""
+  echo "The mover script that would normally run has been copied to"
+  echo "$MOVER_SCRIPT_COPY".
+  echo ""
+  echo "To debug, you can modify this file and run it with:"
+  echo "$MOVER_SCRIPT_COPY" "$@"
+  echo ""
+  echo "If you wish to exit this pod after debugging, delete the"
+  echo "file $END_DEBUG_FILE from the system."
+  echo "##################################################################"
+
+  # Wait for user to delete the file before exiting
+  while [[ -f "${END_DEBUG_FILE}" ]]; do
+    sleep 10
+  done
+
+  echo ""
+  echo "##################################################################"
+  echo "Debug done, exiting."
+  echo "##################################################################"
+  sleep 2
+  exit 0
+fi
+
+cd "$SCRIPT_DIR"
+
+STUNNEL_LISTEN_PORT=:::8000
+# If IPv6 is in disable state, the output would be "1"
+if [[ $IPV6_DISABLED -eq 1 ]]; then
+    STUNNEL_LISTEN_PORT=8000
+fi
+
+if [[ ! -r $PSK_FILE ]]; then
+    echo "ERROR: Pre-shared key not found - $PSK_FILE"
+    exit 1
+fi
+
+if ! test -b $BLOCK_TARGET; then
+    echo "ERROR: target block device not found - $BLOCK_TARGET"
+    exit 1
+fi
+
+mkdir -p "$(dirname "$CONTROL_FILE")"
+rm -f "$CONTROL_FILE"
+
+##############################
+## Set up stunnel config
+cat - > "$STUNNEL_CONF" <<STUNNEL_CONF
+; Global options
+debug = debug
+foreground = no
+output = /dev/stdout
+pid = $STUNNEL_PID_FILE
+socket = l:SO_KEEPALIVE=1
+socket = l:TCP_KEEPIDLE=180
+socket = r:SO_KEEPALIVE=1
+socket = r:TCP_KEEPIDLE=180
+syslog = no
+
+[diskrsync]
+ciphers = PSK
+PSKsecrets = $PSK_FILE
+; Port to listen for incoming connections from remote
+accept = $STUNNEL_LISTEN_PORT
+; We are the server
+client = no
+connect = $DISKRSYNC_PORT
+STUNNEL_CONF
+
+stunnel -version "$STUNNEL_CONF"
+
+##############################
+## Start diskrsync-tcp, it will write the control file once the
+## transfer has completed
+/diskrsync-tcp $BLOCK_TARGET --target --port $DISKRSYNC_PORT --control-file $CONTROL_FILE &
+DISKRSYNC_PID=$!
+
+##############################
+## Start stunnel to wait for incoming connections
+echo "Starting stunnel..."
+stunnel "$STUNNEL_CONF"
+
+##############################
+## Wait for the control file to be created, signaling that we should
+## terminate. Stop waiting if diskrsync exits without creating it.
+echo "Waiting for control file to be created ($CONTROL_FILE)..."
+while [[ ! -e $CONTROL_FILE ]] && kill -0 "$DISKRSYNC_PID" 2>/dev/null; do
+    sleep 1
+done
+
+if [[ -e $CONTROL_FILE ]]; then
+    sleep 5  # Give time for the connection to finish
+fi
+
+##############################
+## Terminate stunnel
+echo "Shutting down..."
+kill -TERM "$(<"$STUNNEL_PID_FILE")"
+rc=0
+wait "$DISKRSYNC_PID" || rc=$?
+echo "Stunnel completed shut down."
+
+if [[ $rc -ne 0 ]]; then
+    echo "Synchronization failed. diskrsync returned: $rc"
+    exit $rc
+fi
+
+sync -f $BLOCK_TARGET
+echo "Sync complete, exiting."
@@ -0,0 +1,109 @@
+#! /bin/bash
+
+set -e -o pipefail
+
+if [[ "$DIRECTION" != "source" && "$DIRECTION" != "destination" ]]; then
+    echo "DIRECTION must be either source or destination"
+    exit 1
+fi
+
+DATA_DIR="/data"
+NFS_DIR="/nfs"
+
+SCRIPT_FULLPATH="$(realpath "$0")"
+SCRIPT="$(basename "$SCRIPT_FULLPATH")"
+SCRIPT_DIR="$(dirname "$SCRIPT_FULLPATH")"
+
+# Do not do this debug mover code if this is already the
+# mover script copy in /tmp
+if [[ $DEBUG_MOVER -eq 1 && "$SCRIPT_DIR" != "/tmp" ]]; then
+  MOVER_SCRIPT_COPY="/tmp/$SCRIPT"
+  cp "$SCRIPT_FULLPATH" "$MOVER_SCRIPT_COPY"
+
+  END_DEBUG_FILE="/tmp/exit-debug-if-removed"
+  touch $END_DEBUG_FILE
+
+  echo ""
+  echo "##################################################################"
+  echo "DEBUG_MOVER is enabled, this pod will sleep indefinitely."
+  echo ""
+  echo "The mover script that would normally run has been copied to"
+  echo "$MOVER_SCRIPT_COPY".
+  echo ""
+  echo "To debug, you can modify this file and run it with:"
+  echo "$MOVER_SCRIPT_COPY" "$@"
+  echo ""
+  echo "If you wish to exit this pod after debugging, delete the"
+  echo "file $END_DEBUG_FILE from the system."
+  echo "##################################################################"
+
+  # Wait for user to delete the file before exiting
+  while [[ -f "${END_DEBUG_FILE}" ]]; do
+    sleep 10
+  done
+
+  echo ""
+  echo "##################################################################"
+  echo "Debug done, exiting."
+  echo "##################################################################"
+  sleep 2
+  exit 0
+fi
+
+cd "$SCRIPT_DIR"
+
+# The source copies its volume to the export, the destination restores its
+# volume from the export
+if [[ "$DIRECTION" == "source" ]]; then
+    FROM="$DATA_DIR"
+    TO="$NFS_DIR"
+else
+    FROM="$NFS_DIR"
+    TO="$DATA_DIR"
+fi
+
+# Sync files
+START_TIME=$SECONDS
+MAX_RETRIES=5
+RETRY=0
+DELAY=2
+FACTOR=2
+rc=1
+set +e  # Don't exit on command failure
+echo "Syncing data from ${FROM} to ${TO} ..."
+while [[ $rc -ne 0 && $RETRY -lt $MAX_RETRIES ]]; do
+    RETRY=$(( RETRY + 1 ))
+    # Find all files/dirs at root of the volume, prepend / to each (rsync will use FROM as the base dir for these files)
+    find "${FROM}" -mindepth 1 -maxdepth 1 ! -name lost+found -printf '/%P\n' > /tmp/filelist.txt
+    rc_a=0
+    if [[ -s /tmp/filelist.txt ]]; then
+        # 1st run preserves as much as possible, but excludes the root directory
+        rsync -aAhHSx -r --exclude=lost+found --itemize-changes --info=stats2,misc2 --files-from=/tmp/filelist.txt "${FROM}/" "${TO}/"
+        rc_a=$?
+    else
+        echo "Skipping sync of empty directory ${FROM}"
+    fi
+
+    # To delete extra files, must sync at the directory-level, but need to avoid
+    # trying to modify the directory itself. This pass will only delete files
+    # that exist in TO but not in FROM, not make updates.
+    rsync -rx --exclude=lost+found --ignore-existing --ignore-non-existing --delete --itemize-changes --info=stats2,misc2 "${FROM}/" "${TO}/"
+    rc_b=$?
+    rc=$(( rc_a * 100 + rc_b ))
+    if [[ $rc -ne 0 ]]; then
+        echo "Syncronization failed. Retrying in $DELAY seconds. Retry ${RETRY}/${MAX_RETRIES}."
+        sleep $DELAY
+        DELAY=$(( DELAY * FACTOR ))
+    fi
+done
+set -e  # Exit on command failure
+
+echo "rsync completed in $(( SECONDS - START_TIME ))s"
+if [[ $rc -ne 0 ]]; then
+    echo "Synchronization failed. rsync returned: $rc"
+    exit $rc
+fi
+
+sync
+echo "Synchronization completed successfully"
+exit 0
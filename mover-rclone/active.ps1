@@ -0,0 +1,137 @@
+# Windows variant of active.sh, run by the rclone mover when moverOS is
+# "windows". It takes the same environment variables as active.sh.
+
+$ErrorActionPreference = "Stop"
+
+Write-Output "VolSync rclone container version: $(if ($env:version) { $env:version } else { 'unknown' })"
+
+# The permissions of the files are stored alongside the data, as with the
+# permissions.facl of the linux mover
+$TempDir = "C:\tmp"
+$AclFile = "permissions.acl"
+
+function Fail([int]$rc, [string]$msg) {
+    Write-Output "error: $msg"
+    exit $rc
+}
+
+if ($env:DEBUG_MOVER -eq "1") {
+    $endDebugFile = Join-Path $TempDir "exit-debug-if-removed"
+    New-Item -ItemType File -Force -Path $endDebugFile | Out-Null
+    Write-Output ""
+    Write-Output "##################################################################"
+    Write-Output "DEBUG_MOVER is enabled, this pod will sleep indefinitely."
+    Write-Output ""
+    Write-Output "To debug, run:"
+    Write-Output "pwsh -File $PSCommandPath"
+    Write-Output ""
+    Write-Output "If you wish to exit this pod after debugging, delete the"
+    Write-Output "file $endDebugFile from the system."
+    Write-Output "##################################################################"
+    while (Test-Path $endDebugFile) {
+        Start-Sleep -Seconds 10
+    }
+    Write-Output "Debug done, exiting."
+    exit 0
+}
+
+if (-not $env:RCLONE_DEST_PATH) { Fail 1 "RCLONE_DEST_PATH must be defined" }
+if (-not $env:DIRECTION) { Fail 1 "DIRECTION must be defined" }
+if (-not $env:PRIVILEGED_MOVER) { Fail 1 "PRIVILEGED_MOVER must be defined" }
+
+$Remote = "$($env:RCLONE_CONFIG_SECTION):$($env:RCLONE_DEST_PATH)"
+$MountPath = $env:MOUNT_PATH
+
+# Concurrency of the transfers (spec.rclone.parallelism), with twice as many checkers
+$Concurrency = @("--transfers", "10")
+if ($env:PARALLELISM) {
+    $Concurrency = @("--transfers", $env:PARALLELISM, "--checkers", "$([int]$env:PARALLELISM * 2)")
+}
+
+# Flags for the main sync operation (no --progress and no --stats-one-line-date so we can get a summary at the end)
+$FlagsSync = @("--checksum", "--one-file-system", "--create-empty-src-dirs", "--stats", "20s") + $Concurrency
+
+# Flags for the permissions copy
+$FlagsCopy = @("--checksum", "--one-file-system", "--create-empty-src-dirs", "--stats-one-line-date", "--stats", "20s") + $Concurrency
+
+# Flags for verifying the restored data
+$FlagsCheck = @("--one-file-system", "--exclude", $AclFile)
+
+if ($env:CUSTOM_CA) {
+    Write-Output "Using custom CA."
+    $FlagsSync += @("--ca-cert", $env:CUSTOM_CA)
+    $FlagsCopy += @("--ca-cert", $env:CUSTOM_CA)
+    $FlagsCheck += @("--ca-cert", $env:CUSTOM_CA)
+}
+
+# The progress of the sync is reported by periodically logging the stats from
+# rclone's remote control API. It only listens on localhost.
+$RcAddr = "127.0.0.1:5572"
+$FlagsSync += @("--rc", "--rc-addr", $RcAddr, "--rc-no-auth")
+$ProgressInterval = if ($env:PROGRESS_INTERVAL) { [int]$env:PROGRESS_INTERVAL } else { 30 }
+
+$progress = Start-Job -ArgumentList $RcAddr, $ProgressInterval -ScriptBlock {
+    param($addr, $interval)
+    while ($true) {
+        Start-Sleep -Seconds $interval
+        $stats = rclone rc --url "http://$addr/" core/stats 2>$null
+        if ($LASTEXITCODE -eq 0) {
+            Write-Output "volsync-progress: $(($stats -join '').Trim())"
+        }
+    }
+}
+
+function Invoke-Rclone {
+    & rclone @args
+    if ($LASTEXITCODE -ne 0) { Fail $LASTEXITCODE "rclone $($args[0]) failed" }
+}
+
+New-Item -ItemType Directory -Force -Path $TempDir | Out-Null
+$start = Get-Date
+try {
+    switch ($env:DIRECTION) {
+        "source" {
+            icacls "$MountPath\*" /save (Join-Path $TempDir $AclFile) /t /c /q | Out-Null
+            Invoke-Rclone sync @FlagsSync $MountPath $Remote --log-level DEBUG
+            Invoke-Rclone copy @FlagsCopy --include $AclFile $TempDir $Remote --log-level DEBUG
+        }
+        "destination" {
+            Invoke-Rclone sync @FlagsSync --exclude $AclFile $Remote $MountPath --log-level DEBUG
+            Invoke-Rclone copy @FlagsCopy --include $AclFile $Remote $TempDir --log-level DEBUG
+            if (Test-Path (Join-Path $TempDir $AclFile)) {
+                icacls $MountPath /restore (Join-Path $TempDir $AclFile) /c /q | Out-Null
+            }
+            if ($env:VERIFY_CHECKSUM -eq "1") {
+                # Compare the checksums of the restored files with the remote
+                & rclone check @FlagsCheck $Remote $MountPath
+                if ($LASTEXITCODE -eq 0) {
+                    Write-Output "VOLSYNC_VERIFY=Passed"
+                } else {
+                    Write-Output "VOLSYNC_VERIFY=Failed restored files do not match $($env:RCLONE_DEST_PATH)"
+                    Fail 4 "verification of the restored data failed"
+                }
+            }
+        }
+        "remote" {
+            # Sync between two remotes without a volume
+            if (-not $env:RCLONE_SOURCE_PATH) { Fail 1 "RCLONE_SOURCE_PATH must be defined" }
+            Invoke-Rclone sync @FlagsSync "$($env:RCLONE_SOURCE_CONFIG_SECTION):$($env:RCLONE_SOURCE_PATH)" $Remote --log-level DEBUG
+        }
+        "connection-test" {
+            # Read-only check that the remote can be accessed with the
+            # configured credentials, reporting the time it took
+            $caFlags = @()
+            if ($env:CUSTOM_CA) { $caFlags = @("--ca-cert", $env:CUSTOM_CA) }
+            $elapsed = Measure-Command { Invoke-Rclone lsd @caFlags $Remote | Out-Host }
+            Write-Output "VOLSYNC_CONNECTION_TEST=$([int64]$elapsed.TotalMilliseconds)"
+        }
+        default {
+            Fail 1 "unknown value for DIRECTION: $($env:DIRECTION)"
+        }
+    }
+} finally {
+    Receive-Job $progress
+    Stop-Job $progress
+    Remove-Job $progress
+}
+Write-Output "Rclone completed in $([int]((Get-Date) - $start).TotalSeconds)s"
@@ -58,18 +58,47 @@ function error {
 [[ -n "${PRIVILEGED_MOVER}" ]] || error 1 "PRIVILEGED_MOVER must be defined"
 
 
+# Concurrency of the transfers (spec.rclone.parallelism), with twice as many checkers
+TRANSFERS=10
+declare -a CHECKERS=()
+if [[ -n "${PARALLELISM}" ]]; then
+    TRANSFERS="${PARALLELISM}"
+    CHECKERS=(--checkers "$(( PARALLELISM * 2 ))")
+fi
+
 # Flags for the main sync operation (no --progress and no --stats-one-line-date so we can get a summary at the end)
-RCLONE_FLAGS_SYNC=(--checksum --one-file-system --create-empty-src-dirs --stats 20s --transfers 10)
+RCLONE_FLAGS_SYNC=(--checksum --one-file-system --create-empty-src-dirs --stats 20s --transfers "${TRANSFERS}" "${CHECKERS[@]}")
 
 # Flags for the permissions.facl copy
-RCLONE_FLAGS_COPY=(--checksum --one-file-system --create-empty-src-dirs --stats-one-line-date --stats 20s --transfers 10)
+RCLONE_FLAGS_COPY=(--checksum --one-file-system --create-empty-src-dirs --stats-one-line-date --stats 20s --transfers "${TRANSFERS}" "${CHECKERS[@]}")
+
+# Flags for verifying the restored data
+RCLONE_FLAGS_CHECK=(--one-file-system --exclude permissions.facl)
 
 if [[ -n "${CUSTOM_CA}" ]]; then
     echo "Using custom CA."
     RCLONE_FLAGS_SYNC+=(--ca-cert "${CUSTOM_CA}")
     RCLONE_FLAGS_COPY+=(--ca-cert "${CUSTOM_CA}")
+    RCLONE_FLAGS_CHECK+=(--ca-cert "${CUSTOM_CA}")
 fi
 
+# The progress of the sync is reported by periodically logging the stats from
+# rclone's remote control API. It only listens on localhost.
+RC_ADDR="127.0.0.1:5572"
+RCLONE_FLAGS_SYNC+=(--rc --rc-addr "${RC_ADDR}" --rc-no-auth)
+PROGRESS_INTERVAL="${PROGRESS_INTERVAL:-30}"
+
+function report_progress {
+    while sleep "${PROGRESS_INTERVAL}"; do
+        if stats="$(rclone rc --url "http://${RC_ADDR}/" core/stats 2>/dev/null)"; then
+            echo "volsync-progress: $(echo "${stats}" | tr -d '\n')"
+        fi
+    done
+}
+report_progress &
+PROGRESS_PID=$!
+trap 'kill "${PROGRESS_PID}" 2>/dev/null || true' EXIT
+
 START_TIME=$SECONDS
 case "${DIRECTION}" in
 source)
@@ -82,10 +111,42 @@ destination)
     rclone copy "${RCLONE_FLAGS_COPY[@]}" --include permissions.facl "${RCLONE_CONFIG_SECTION}:${RCLONE_DEST_PATH}" /tmp --log-level DEBUG
     stat /tmp/permissions.facl
     setfacl --restore=/tmp/permissions.facl || true
+    # Report the usage of the volume for capacity forecasting
+    { read -r _; read -r _ size used _; } < <(df -P -B1 "${MOUNT_PATH}")
+    echo "VOLSYNC_VOLUME_USAGE=${used} ${size}"
+    if [[ "${VERIFY_CHECKSUM}" -eq 1 ]]; then
+        # Compare the checksums of the restored files with the remote
+        if rclone check "${RCLONE_FLAGS_CHECK[@]}" "${RCLONE_CONFIG_SECTION}:${RCLONE_DEST_PATH}" "${MOUNT_PATH}"; then
+            echo "VOLSYNC_VERIFY=Passed"
+        else
+            echo "VOLSYNC_VERIFY=Failed restored files do not match ${RCLONE_DEST_PATH}"
+            error 4 "verification of the restored data failed"
+        fi
+    fi
+    ;;
+remote)
+    # Sync between two remotes without a volume. Rclone uses server-side
+    # copies when both paths are on the same remote.
+    [[ -n "${RCLONE_SOURCE_PATH}" ]] || error 1 "RCLONE_SOURCE_PATH must be defined"
+    rclone sync "${RCLONE_FLAGS_SYNC[@]}" "${RCLONE_SOURCE_CONFIG_SECTION}:${RCLONE_SOURCE_PATH}" "${RCLONE_CONFIG_SECTION}:${RCLONE_DEST_PATH}" --log-level DEBUG
+    ;;
+connection-test)
+    # Read-only check that the remote can be accessed with the configured
+    # credentials, reporting the time it took
+    declare -a CA_FLAGS=()
+    if [[ -n "${CUSTOM_CA}" ]]; then
+        CA_FLAGS=(--ca-cert "${CUSTOM_CA}")
+    fi
+    start=$(date +%s%N)
+    rclone lsd "${CA_FLAGS[@]}" "${RCLONE_CONFIG_SECTION}:${RCLONE_DEST_PATH}"
+    end=$(date +%s%N)
+    echo "VOLSYNC_CONNECTION_TEST=$(( (end - start) / 1000000 ))"
     ;;
 *)
     error 1 "unknown value for DIRECTION: ${DIRECTION}"
     ;;
 esac
-sync -f "${MOUNT_PATH}"
+if [[ -n "${MOUNT_PATH}" ]]; then
+    sync -f "${MOUNT_PATH}"
+fi
 echo "Rclone completed in $(( SECONDS - START_TIME ))s"
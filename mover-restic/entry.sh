@@ -49,6 +49,30 @@ if [[ $DEBUG_MOVER -eq 1 && "$SCRIPT_DIR" != "/tmp" ]]; then
   exit 0
 fi
 
+# Credentials mounted from a secret store are files named after the
+# environment variables they provide
+if [[ -n "${CREDENTIALS_DIR}" ]]; then
+    for cred_file in "${CREDENTIALS_DIR}"/*; do
+        cred_name="$(basename "${cred_file}")"
+        if [[ ! -f "${cred_file}" || ! ${cred_name} =~ ^[A-Z_][A-Z0-9_]*$ ]]; then
+            continue
+        fi
+        if [[ ${cred_name} == "GOOGLE_APPLICATION_CREDENTIALS" ]]; then
+            # restic expects the path of the credential file
+            export GOOGLE_APPLICATION_CREDENTIALS="${cred_file}"
+        else
+            export "${cred_name}"="$(<"${cred_file}")"
+        fi
+    done
+fi
+
+# With spec.restic.repositoryLayout: Velero, RESTIC_REPOSITORY is the location
+# of a Velero BackupStorageLocation and the repository is below it
+if [[ -n "${RESTIC_REPOSITORY_SUBPATH}" && -n "${RESTIC_REPOSITORY}" ]]; then
+    RESTIC_REPOSITORY="${RESTIC_REPOSITORY%/}/${RESTIC_REPOSITORY_SUBPATH}"
+    export RESTIC_REPOSITORY
+fi
+
 declare -a RESTIC
 RESTIC=("restic")
 if [[ -n "${CUSTOM_CA}" ]]; then
@@ -56,10 +80,52 @@ if [[ -n "${CUSTOM_CA}" ]]; then
     RESTIC+=(--cacert "${CUSTOM_CA}")
 fi
 
+# Number of concurrent connections to the backend (spec.restic.parallelism).
+# Backups also read this many files at a time.
+declare -a READ_CONCURRENCY=()
+if [[ -n "${PARALLELISM}" ]]; then
+    backend="${RESTIC_REPOSITORY%%:*}"
+    if [[ "${backend}" == "${RESTIC_REPOSITORY}" || "${backend}" == /* ]]; then
+        backend="local"
+    fi
+    case ${backend} in
+    azure|b2|gs|local|rclone|rest|s3|sftp|swift)
+        RESTIC+=(-o "${backend}.connections=${PARALLELISM}")
+        ;;
+    esac
+    READ_CONCURRENCY=(--read-concurrency "${PARALLELISM}")
+fi
+
 "${RESTIC[@]}" version
 
-# Force the associated backup host name to be "volsync"
-RESTIC_HOST="volsync"
+# The host name associated with the backups is "volsync" unless one is
+# configured
+RESTIC_HOST="${SNAPSHOT_HOST:-volsync}"
+
+# Backups get the configured tags, and forget and the selection of the snapshot
+# to restore only consider the snapshots with all of them (and of the configured
+# host)
+declare -a SNAPSHOT_TAG_OPTIONS=()
+declare -a SNAPSHOT_FILTER=()
+if [[ -n ${SNAPSHOT_TAGS} ]]; then
+    SNAPSHOT_TAG_OPTIONS=(--tag "${SNAPSHOT_TAGS}")
+    SNAPSHOT_FILTER+=(--tag "${SNAPSHOT_TAGS}")
+fi
+if [[ -n ${SNAPSHOT_HOST} ]]; then
+    SNAPSHOT_FILTER+=(--host "${SNAPSHOT_HOST}")
+fi
+
+# Backups of block volumes (BLOCK_DEVICE is set) hold a single image of the
+# device, and are tagged so they are not restored onto a filesystem
+BLOCK_IMAGE="volsync-block.img"
+BLOCK_TAG="volsync-block"
+
+# With ATOMIC_RESTORE, the data is restored into a staging directory on the
+# volume and the previous contents are moved aside while the restored data is
+# moved into place
+RESTORE_STAGING_DIR="${DATA_DIR}/.volsync-restore-staging"
+RESTORE_OLD_DIR="${DATA_DIR}/.volsync-restore-old"
+
 # Make restic output progress reports every 10s
 export RESTIC_PROGRESS_FPS=0.1
 
@@ -147,18 +213,291 @@ function ensure_initialized {
     rm -f "$outfile"
 }
 
+# Read-only check that the repository can be accessed with the credentials,
+# reporting the time it took
+function do_connection_test {
+    echo "=== Testing the connection to the repository ==="
+    start=$(date +%s%N)
+    "${RESTIC[@]}" cat config > /dev/null
+    end=$(date +%s%N)
+    echo "=== Repository is accessible ==="
+    echo "VOLSYNC_CONNECTION_TEST=$(( (end - start) / 1000000 ))"
+}
+
 function do_backup {
     echo "=== Starting backup ==="
-    pushd "${DATA_DIR}"
-    "${RESTIC[@]}" backup --host "${RESTIC_HOST}" --exclude='lost+found' .
-    popd
+    # Tag the backup with the ReplicationSource it came from
+    declare -a TAG_OPTIONS
+    if [[ -n ${VOLSYNC_SOURCE} ]]; then
+        TAG_OPTIONS=(--tag "volsync-source:${VOLSYNC_SOURCE}")
+    fi
+    local outfile
+    outfile=$(mktemp -q)
+    local rc
+    local attempt
+    for attempt in 1 2; do
+        rc=0
+        if [[ -n ${BLOCK_DEVICE} ]]; then
+            # Stream the raw device into a single file in the snapshot. Unused
+            # (zeroed) regions deduplicate to almost nothing in the repository.
+            "${RESTIC[@]}" backup --host "${RESTIC_HOST}" "${TAG_OPTIONS[@]}" "${SNAPSHOT_TAG_OPTIONS[@]}" \
+                --tag "${BLOCK_TAG}" \
+                --stdin --stdin-filename "${BLOCK_IMAGE}" < "${BLOCK_DEVICE}" 2>&1 \
+                | tee "$outfile" || rc=$?
+        else
+            pushd "${DATA_DIR}"
+            "${RESTIC[@]}" backup --host "${RESTIC_HOST}" "${TAG_OPTIONS[@]}" "${SNAPSHOT_TAG_OPTIONS[@]}" \
+                "${READ_CONCURRENCY[@]}" --exclude='lost+found' . 2>&1 \
+                | tee "$outfile" || rc=$?
+            popd
+        fi
+        # Retry once if the repository was held by stale locks
+        if [[ $attempt -eq 1 && $rc -ne 0 ]] && grep -q "repository is already locked" "$outfile" \
+            && remove_stale_locks; then
+            continue
+        fi
+        break
+    done
+    if [[ $rc -eq 3 ]]; then
+        # The snapshot was created, but some files could not be read
+        local failed_paths
+        local total
+        failed_paths=$(mktemp -q)
+        sed -n 's/^error: [a-z]* \(.*\): [^:]*$/\1/p' "$outfile" > "$failed_paths"
+        total=$(sed -n 's/^Files: *\([0-9]*\) new, *\([0-9]*\) changed, *\([0-9]*\) unmodified$/\1 \2 \3/p' "$outfile" \
+            | awk '{print $1 + $2 + $3}')
+        if ! check_error_budget "$failed_paths" "$(( ${total:-0} + $(grep -c . "$failed_paths" || true) ))"; then
+            error 3 "some files could not be read, exceeding the error budget"
+        fi
+        rm -f "$failed_paths"
+    elif [[ $rc -ne 0 ]]; then
+        error "$rc" "backup failed"
+    fi
+    local snapshot_id
+    snapshot_id=$(sed -n 's/^snapshot \([0-9a-f]*\) saved$/\1/p' "$outfile")
+    if [[ ${FILESYSTEM_QUOTAS} -eq 1 ]]; then
+        backup_quota_manifest "${snapshot_id}"
+    fi
+    if [[ ${INTEGRITY_MANIFEST} -eq 1 ]]; then
+        backup_integrity_manifest "${snapshot_id}"
+    fi
+    rm -f "$outfile"
+}
+
+#######################################
+# Removes the locks on the repository if all
+# of them are stale, reporting the removal as:
+#   VOLSYNC_AUTOMATIC_UNLOCK=<count> <age of oldest lock in seconds>
+# Globals:
+#   AUTOMATIC_UNLOCK_AGE
+# Returns:
+#   0 if the repository may be used again
+#######################################
+function remove_stale_locks() {
+    if [[ -z "${AUTOMATIC_UNLOCK_AGE}" ]]; then
+        return 1
+    fi
+    echo "=== Checking for stale locks ==="
+    local -a locks
+    mapfile -t locks < <("${RESTIC[@]}" list locks --no-lock)
+    local now
+    now=$(date +%s)
+    local oldest=0
+    local lock
+    local lock_time
+    local age
+    for lock in "${locks[@]}"; do
+        lock_time=$("${RESTIC[@]}" cat lock --no-lock "${lock}" | sed -n 's/^ *"time": *"\(.*\)",*$/\1/p')
+        if [[ -z "${lock_time}" ]]; then
+            echo "Unable to determine the age of lock ${lock}"
+            return 1
+        fi
+        age=$(( now - $(date -d "${lock_time}" +%s) ))
+        if [[ ${age} -lt ${AUTOMATIC_UNLOCK_AGE} ]]; then
+            echo "Lock ${lock} is ${age}s old, which is not stale"
+            return 1
+        fi
+        if [[ ${age} -gt ${oldest} ]]; then
+            oldest=${age}
+        fi
+    done
+    if [[ ${#locks[@]} -gt 0 ]]; then
+        "${RESTIC[@]}" unlock --remove-all
+        echo "VOLSYNC_AUTOMATIC_UNLOCK=${#locks[@]} ${oldest}"
+    fi
+}
+
+#######################################
+# Lists the paths that could not be copied
+# and reports whether they are within the
+# error budget of the error policy:
+#   VOLSYNC_FAILED_PATH=<path> (up to 50)
+#   VOLSYNC_ERRORS=<failed> <total> <Tolerated|Exceeded>
+# Globals:
+#   ERROR_POLICY
+#   MAX_ERRORS
+#   MAX_ERROR_PERCENT
+# Arguments:
+#   File listing the failed paths, one per line
+#   Total number of paths
+# Returns:
+#   0 if the errors are tolerated
+#######################################
+function check_error_budget() {
+    local paths_file="$1"
+    local total="${2:-0}"
+    local failed
+    failed=$(grep -c . "${paths_file}" || true)
+    head -n 50 "${paths_file}" | sed 's/^/VOLSYNC_FAILED_PATH=/'
+
+    local result="Tolerated"
+    if [[ "${ERROR_POLICY}" != "Tolerate" ]]; then
+        result="Exceeded"
+    elif [[ -n "${MAX_ERRORS}" && ${failed} -gt ${MAX_ERRORS} ]]; then
+        result="Exceeded"
+    elif [[ -n "${MAX_ERROR_PERCENT}" ]] && (( failed * 100 > MAX_ERROR_PERCENT * total )); then
+        result="Exceeded"
+    fi
+    echo "VOLSYNC_ERRORS=${failed} ${total} ${result}"
+    [[ "${result}" == "Tolerated" ]]
+}
+
+#######################################
+# Compares the checksums of the files in
+# DATA_DIR whose modification time and
+# size are unchanged against those saved
+# in the checksum database by the previous
+# sync. Fails if any have changed.
+# Globals:
+#   DATA_DIR
+#   RESTIC_CACHE_DIR
+#######################################
+function check_bitrot {
+    echo "=== Checking for silent data corruption ==="
+    local db="${RESTIC_CACHE_DIR}/volsync-checksums"
+    local newdb="${db}.new"
+
+    # path -> "mtime size checksum"
+    declare -A known
+    local mtime size sum file
+    if [[ -f ${db} ]]; then
+        while IFS=$'\t' read -r mtime size sum file; do
+            known[${file}]="${mtime} ${size} ${sum}"
+        done < "${db}"
+    fi
+
+    local -i checked=0
+    local -i corrupt=0
+    local prev
+    : > "${newdb}"
+    pushd "${DATA_DIR}" > /dev/null
+    while IFS= read -r -d '' file; do
+        # The database is line based
+        if [[ ${file} == *$'\n'* || ${file} == *$'\t'* ]]; then
+            continue
+        fi
+        read -r mtime size < <(stat -c '%Y %s' "${file}")
+        sum=$(sha256sum "${file}" | cut -d' ' -f1)
+        prev=${known[${file}]:-}
+        if [[ -n ${prev} && ${prev% *} == "${mtime} ${size}" ]]; then
+            checked+=1
+            if [[ ${sum} != "${prev##* }" ]]; then
+                echo "VOLSYNC_BITROT=${file#./}"
+                corrupt+=1
+                # Keep the checksum of the intact file
+                sum=${prev##* }
+            fi
+        fi
+        printf '%s\t%s\t%s\t%s\n' "${mtime}" "${size}" "${sum}" "${file}" >> "${newdb}"
+    done < <(find . -xdev -type f ! -path './lost+found/*' -print0)
+    popd > /dev/null
+
+    if ((corrupt > 0)); then
+        rm -f "${newdb}"
+        error 5 "${corrupt} file(s) changed without their modification time changing, not backing up"
+    fi
+    mv "${newdb}" "${db}"
+    echo "Verified ${checked} unchanged file(s)"
+}
+
+#######################################
+# Applies the cache cleanup policy to
+# the restic cache before a backup
+# Globals:
+#   RESTIC_CACHE_DIR
+#   CACHE_MAX_AGE_DAYS
+#   CACHE_MAX_SIZE
+#######################################
+function cleanup_cache {
+    echo "=== Cleaning up cache ==="
+    if [[ -n ${CACHE_MAX_AGE_DAYS} ]]; then
+        "${RESTIC[@]}" cache --cleanup --max-age "${CACHE_MAX_AGE_DAYS}"
+    fi
+    if [[ -n ${CACHE_MAX_SIZE} ]]; then
+        local -i used
+        used=$(du -sb "${RESTIC_CACHE_DIR}" | cut -f1)
+        if ((used > CACHE_MAX_SIZE)); then
+            echo "Cache size of ${used} bytes exceeds ${CACHE_MAX_SIZE} bytes, removing cached data"
+            # The checksum database is not restic data and can't be rebuilt
+            find "${RESTIC_CACHE_DIR}" -mindepth 1 -maxdepth 1 ! -name 'volsync-checksums' -exec rm -rf {} +
+        fi
+    fi
+}
+
+#######################################
+# Prints the space used by the restic
+# cache
+# Globals:
+#   RESTIC_CACHE_DIR
+#######################################
+function report_cache_usage {
+    echo "VOLSYNC_CACHE_USAGE=$(du -sb "${RESTIC_CACHE_DIR}" | cut -f1)"
+}
+
+#######################################
+# Prints the statistics of the repository:
+#   VOLSYNC_REPOSITORY_STATS=<stored> <uncompressed> <restore size> <snapshots> <source snapshots>
+# Failing to collect them does not fail the
+# backup.
+# Globals:
+#   VOLSYNC_SOURCE
+#######################################
+function report_repository_stats {
+    echo "=== Collecting repository statistics ==="
+    local raw_data
+    local restore_size
+    local source_snapshots
+    if ! raw_data=$("${RESTIC[@]}" stats --json --mode raw-data) || \
+        ! restore_size=$("${RESTIC[@]}" stats --json --mode restore-size); then
+        echo "Unable to collect repository statistics"
+        return
+    fi
+    local stored
+    local uncompressed
+    local snapshots
+    stored=$(grep -o '"total_size":[0-9]*' <<<"${raw_data}" | head -n1 | cut -d: -f2)
+    uncompressed=$(grep -o '"total_uncompressed_size":[0-9]*' <<<"${raw_data}" | head -n1 | cut -d: -f2)
+    # Repositories without compression don't report the uncompressed size
+    uncompressed=${uncompressed:-${stored}}
+    snapshots=$(grep -o '"snapshots_count":[0-9]*' <<<"${raw_data}" | head -n1 | cut -d: -f2)
+    restore_size=$(grep -o '"total_size":[0-9]*' <<<"${restore_size}" | head -n1 | cut -d: -f2)
+    if [[ -z ${stored} || -z ${restore_size} ]]; then
+        echo "Unable to parse repository statistics"
+        return
+    fi
+    source_snapshots=0
+    if [[ -n ${VOLSYNC_SOURCE} ]]; then
+        source_snapshots=$("${RESTIC[@]}" snapshots --json --tag "volsync-source:${VOLSYNC_SOURCE}" \
+            | grep -o '"id":"' | wc -l || true)
+    fi
+    echo "VOLSYNC_REPOSITORY_STATS=${stored} ${uncompressed} ${restore_size} ${snapshots:-0} ${source_snapshots:-0}"
 }
 
 function do_forget {
     echo "=== Starting forget ==="
     if [[ -n ${FORGET_OPTIONS} ]]; then
         #shellcheck disable=SC2086
-        "${RESTIC[@]}" forget --host "${RESTIC_HOST}" ${FORGET_OPTIONS}
+        "${RESTIC[@]}" forget --host "${RESTIC_HOST}" "${SNAPSHOT_TAG_OPTIONS[@]}" ${FORGET_OPTIONS}
     fi
 }
 
@@ -182,6 +521,41 @@ function do_unlock {
     rm -f "$outfile"
 }
 
+# Replaces the key of the current password (RESTIC_PASSWORD) with one for
+# NEW_PASSWORD. If the job is retried after the new key has been added, only
+# the old key is removed. The new password is used for the rest of the job.
+function do_change_password {
+    echo "=== Starting password change ==="
+    check_var_defined NEW_PASSWORD
+    if [[ "${NEW_PASSWORD}" == "${RESTIC_PASSWORD}" ]]; then
+        error 1 "NEW_PASSWORD must differ from the current password"
+    fi
+
+    local old_key_id
+    if "${RESTIC[@]}" cat config > /dev/null 2>&1; then
+        # The current key is marked with a "*" in the key list
+        old_key_id=$("${RESTIC[@]}" key list | awk '/^\*/ {print substr($1, 2)}')
+        if [[ -z ${old_key_id} ]]; then
+            error 3 "unable to determine the key of the current password"
+        fi
+        if ! RESTIC_PASSWORD="${NEW_PASSWORD}" "${RESTIC[@]}" cat config > /dev/null 2>&1; then
+            echo "Adding key for the new password"
+            local passfile
+            passfile=$(mktemp -q)
+            printf '%s' "${NEW_PASSWORD}" > "$passfile"
+            "${RESTIC[@]}" key add --new-password-file "$passfile"
+            rm -f "$passfile"
+        fi
+        echo "Removing key ${old_key_id} of the old password"
+        RESTIC_PASSWORD="${NEW_PASSWORD}" "${RESTIC[@]}" key remove "${old_key_id}"
+    elif RESTIC_PASSWORD="${NEW_PASSWORD}" "${RESTIC[@]}" cat config > /dev/null 2>&1; then
+        echo "Repository already uses the new password"
+    else
+        echo "Unable to open the repository with either password, it will be initialized with the new password if it does not exist"
+    fi
+    export RESTIC_PASSWORD="${NEW_PASSWORD}"
+}
+
 function do_prune {
     echo "=== Starting prune ==="
     "${RESTIC[@]}" prune
@@ -251,10 +625,15 @@ function reverse_array() {
 # is selected under the matching criteria.
 # If a snapshot satisfying the conditions is found, then its ID
 # is returned.
+# When restoring a block device only the snapshots holding a
+# block image are considered, otherwise only those of /data.
 #
 # Globals:
 #   SELECT_PREVIOUS
 #   RESTORE_AS_OF
+#   SNAPSHOT_FILTER
+#   BLOCK_DEVICE
+#   BLOCK_IMAGE
 # Arguments:
 #   None
 ################################################################
@@ -265,7 +644,7 @@ function select_restic_snapshot_to_restore() {
     declare -A epochs_to_snapshots
 
     local restic_snapshots
-    if ! restic_snapshots=$("${RESTIC[@]}" -r "${RESTIC_REPOSITORY}" snapshots); then
+    if ! restic_snapshots=$("${RESTIC[@]}" -r "${RESTIC_REPOSITORY}" snapshots "${SNAPSHOT_FILTER[@]}"); then
       error 3 "failure getting list of snapshots from repository"
     fi
 
@@ -274,10 +653,14 @@ function select_restic_snapshot_to_restore() {
     local snapshot_ts
     local trimmed_timestamp
     local snapshot_epoch
+    local snapshot_path="/data"
+    if [[ -n ${BLOCK_DEVICE} ]]; then
+        snapshot_path="/${BLOCK_IMAGE}"
+    fi
 
     # go through the timestamps received from restic
     IFS=$'\n'
-    for line in $(echo -e "${restic_snapshots}" | grep /data | awk '{print $1 "\t" $2 " " $3}'); do
+    for line in $(echo -e "${restic_snapshots}" | grep -F -- "${snapshot_path}" | awk '{print $1 "\t" $2 " " $3}'); do
         # extract the proper variables
         snapshot_id=$(echo -e "${line}" | cut -d$'\t' -f1)
         snapshot_ts=$(echo -e "${line}" | cut -d$'\t' -f2)
@@ -315,36 +698,441 @@ function select_restic_snapshot_to_restore() {
     fi
 }
 
+################################################################
+# Looks up a restic snapshot by its (possibly abbreviated) ID.
+# If the snapshot exists in the repository, its full ID is
+# returned.
+#
+# Arguments:
+#   ID of the snapshot
+################################################################
+function find_restic_snapshot() {
+    local snapshot_json
+    if ! snapshot_json=$("${RESTIC[@]}" snapshots --json "$1"); then
+        return
+    fi
+    grep -o '"id":"[^"]*"' <<<"${snapshot_json}" | head -n1 | cut -d'"' -f4 || true
+}
 
 #######################################
-# Restores from a selected snapshot if
-# RESTORE_AS_OF is provided, otherwise
-# restores from the latest restic snapshot
+# Returns success if the snapshot holds the
+# image of a block device
 # Globals:
+#   BLOCK_TAG
+# Arguments:
+#   ID of the snapshot
+#######################################
+function is_block_snapshot() {
+    local snapshot_json
+    snapshot_json=$("${RESTIC[@]}" snapshots --json --tag "${BLOCK_TAG}" "$1" 2>/dev/null) || return 1
+    grep -q '"id":' <<<"${snapshot_json}"
+}
+
+#######################################
+# Writes the block device image in the
+# snapshot to the device
+# Globals:
+#   BLOCK_DEVICE
+#   BLOCK_IMAGE
+# Arguments:
+#   ID of the snapshot to restore
+#######################################
+function restore_block_device() {
+    local snapshot_id="$1"
+    if ! is_block_snapshot "${snapshot_id}"; then
+        error 3 "snapshot ${snapshot_id} is not the backup of a block volume"
+    fi
+    if ! "${RESTIC[@]}" dump "${snapshot_id}" "/${BLOCK_IMAGE}" > "${BLOCK_DEVICE}"; then
+        error 1 "unable to write the image in snapshot ${snapshot_id} to the block device"
+    fi
+    sync
+}
+
+#######################################
+# Reports the used space and the size of the
+# filesystem of a directory, in bytes:
+#   VOLSYNC_VOLUME_USAGE=<used> <size>
+# Arguments:
+#   Directory on the filesystem
+#######################################
+function report_volume_usage() {
+    local size used
+    { read -r _; read -r _ size used _; } < <(df -P -B1 "$1")
+    echo "VOLSYNC_VOLUME_USAGE=${used} ${size}"
+}
+
+#######################################
+# Compares the amount of data in the snapshot
+# with the space on the data volume, reporting:
+#   VOLSYNC_RESTORE_SIZE=<snapshot id> <size> <capacity>
+# and fails before anything is restored if the
+# data does not fit. With ATOMIC_RESTORE, the
+# previous contents stay on the volume until the
+# restore completes, so only the free space is
+# available.
+# Globals:
+#   ATOMIC_RESTORE
+#   DATA_DIR
+# Arguments:
+#   ID of the snapshot to restore
+#######################################
+function check_restore_size() {
+    local snapshot_id="$1"
+    local stats_json restore_size
+    if ! stats_json=$("${RESTIC[@]}" stats --json --mode restore-size "${snapshot_id}"); then
+        echo "Unable to determine the size of snapshot ${snapshot_id}, not validating it"
+        return
+    fi
+    restore_size=$(grep -o '"total_size":[0-9]*' <<<"${stats_json}" | head -n1 | cut -d: -f2 || true)
+    if [[ -z ${restore_size} ]]; then
+        echo "Unable to determine the size of snapshot ${snapshot_id}, not validating it"
+        return
+    fi
+    local used avail capacity
+    { read -r _; read -r _ _ used avail _; } < <(df -P -B1 "${DATA_DIR}")
+    capacity=$(( avail + used ))
+    if [[ ${ATOMIC_RESTORE} -eq 1 ]]; then
+        capacity=${avail}
+    fi
+    echo "VOLSYNC_RESTORE_SIZE=${snapshot_id} ${restore_size} ${capacity}"
+    if (( restore_size > capacity )); then
+        error 5 "snapshot ${snapshot_id} needs ${restore_size} bytes, which does not fit on the destination volume (${capacity} bytes available)"
+    fi
+}
+
+#######################################
+# Restores the snapshot into the current
+# directory and verifies the content of the
+# restored files against the checksums in the
+# repository, reporting the result as:
+#   VOLSYNC_VERIFY=<Passed|Failed> [message]
+# Globals:
+#   RESTIC_HOST
+#   RESTORE_OPTIONS
+# Arguments:
+#   ID of the snapshot to restore
+#######################################
+function verified_restore() {
+    local snapshot_id="$1"
+    local restore_log="/tmp/restore.log"
+    local rc=0
+    # Running this cmd can be finicky with spaces, do not put quotes around ${RESTORE_OPTIONS}
+    #shellcheck disable=SC2086
+    "${RESTIC[@]}" restore "${snapshot_id}" -t . --host "${RESTIC_HOST}" --verify ${RESTORE_OPTIONS} 2>&1 \
+        | tee "${restore_log}" || rc=$?
+
+    if grep -q "^finished verifying" "${restore_log}"; then
+        echo "VOLSYNC_VERIFY=Passed"
+    elif grep -q "^verifying files in" "${restore_log}"; then
+        # The restore completed, but the restored data did not verify
+        echo "VOLSYNC_VERIFY=Failed restored files do not match snapshot ${snapshot_id}"
+        error 4 "verification of the restored data failed"
+    fi
+    if [[ $rc -ne 0 ]]; then
+        error 1 "restore failed"
+    fi
+}
+
+#######################################
+# Moves the restored data from the staging
+# directory into DATA_DIR, replacing its
+# previous contents. Only the top-level entries
+# are renamed, so the swap is quick regardless
+# of the amount of data. lost+found is left in
+# place.
+# Globals:
+#   DATA_DIR
+#   RESTORE_STAGING_DIR
+#   RESTORE_OLD_DIR
+#######################################
+function swap_restored_data() {
+    echo "Moving restored data into place"
+    rm -rf "${RESTORE_OLD_DIR}"
+    mkdir -p "${RESTORE_OLD_DIR}"
+    local entry
+    while IFS= read -r -d '' entry; do
+        case "${entry##*/}" in
+            "${RESTORE_STAGING_DIR##*/}"|"${RESTORE_OLD_DIR##*/}"|lost+found) continue ;;
+        esac
+        mv "${entry}" "${RESTORE_OLD_DIR}/" || error 1 "unable to move ${entry} out of the way"
+    done < <(find "${DATA_DIR}" -mindepth 1 -maxdepth 1 -print0)
+    while IFS= read -r -d '' entry; do
+        [[ "${entry##*/}" == "lost+found" ]] && continue
+        mv "${entry}" "${DATA_DIR}/" || error 1 "unable to move ${entry} into place"
+    done < <(find "${RESTORE_STAGING_DIR}" -mindepth 1 -maxdepth 1 -print0)
+    rm -rf "${RESTORE_OLD_DIR}" "${RESTORE_STAGING_DIR}"
+}
+
+#######################################
+# Prints the provenance manifest describing
+# the restored snapshot and, if requested,
+# writes it into the root of the volume
+# Globals:
+#   DATA_DIR
+#   WRITE_PROVENANCE
+# Arguments:
+#   ID of the restored snapshot
+#######################################
+function write_provenance() {
+    local snapshot_id="$1"
+    local snapshot_json
+    if ! snapshot_json=$("${RESTIC[@]}" snapshots --json "${snapshot_id}"); then
+        error 3 "failure getting snapshot details from repository"
+    fi
+
+    local snapshot_time
+    local source
+    snapshot_time=$(grep -o '"time":"[^"]*"' <<<"${snapshot_json}" | head -n1 | cut -d'"' -f4 || true)
+    source=$(grep -o '"volsync-source:[^"]*"' <<<"${snapshot_json}" | head -n1 | tr -d '"' | cut -d: -f2- || true)
+
+    local manifest
+    manifest=$(printf '{"source":"%s","snapshotID":"%s","snapshotTime":"%s","restoreTime":"%s","volsyncVersion":"%s"}' \
+        "${source}" "${snapshot_id}" "${snapshot_time}" "$(date -u +%Y-%m-%dT%H:%M:%SZ)" "${version:-unknown}")
+    echo "VOLSYNC_PROVENANCE=${manifest}"
+
+    if [[ ${WRITE_PROVENANCE} -eq 1 ]]; then
+        local manifest_file="${DATA_DIR}/.volsync-provenance.json"
+        echo "${manifest}" > "${manifest_file}"
+        echo "VOLSYNC_PROVENANCE_SHA256=$(sha256sum "${manifest_file}" | cut -d' ' -f1)"
+    fi
+}
+
+#######################################
+# Prints the quota manifest of DATA_DIR:
+#   fstype <filesystem type>
+#   project <project id> <directory>
+#   limit <project id> <block soft> <block hard> <inode soft> <inode hard>
+# Only directories that start a project (i.e.,
+# whose project differs from their parent's) are
+# listed. Block limits are in KiB.
+# Globals:
+#   DATA_DIR
+#######################################
+function generate_quota_manifest() {
+    local fstype
+    fstype=$(stat -f -c %T "${DATA_DIR}")
+    echo "fstype ${fstype}"
+
+    declare -A projects
+    local dir rel project parent
+    while IFS= read -r -d '' dir; do
+        project=$(lsattr -pd "${dir}" 2>/dev/null | awk '{print $1}')
+        projects[${dir}]=${project:-0}
+        parent=$(dirname "${dir}")
+        if [[ ${dir} != "${DATA_DIR}" && ${projects[${dir}]} != "${projects[${parent}]:-0}" ]]; then
+            rel=${dir#"${DATA_DIR}"/}
+            echo "project ${projects[${dir}]} ${rel}"
+        fi
+    done < <(find "${DATA_DIR}" -xdev -type d ! -name 'lost+found' -print0)
+
+    # Block and inode limits of all projects that have any
+    declare -A isoft ihard
+    local id used soft hard
+    while read -r id used soft hard _; do
+        isoft[${id#\#}]=${soft}
+        ihard[${id#\#}]=${hard}
+    done < <(xfs_quota "${XFS_QUOTA_OPTIONS[@]}" -c 'report -p -i -n -N' "${DATA_DIR}")
+    while read -r id used soft hard _; do
+        id=${id#\#}
+        if [[ ${id} != 0 && "${soft}${hard}${isoft[${id}]}${ihard[${id}]}" != "0000" ]]; then
+            echo "limit ${id} ${soft} ${hard} ${isoft[${id}]:-0} ${ihard[${id}]:-0}"
+        fi
+    done < <(xfs_quota "${XFS_QUOTA_OPTIONS[@]}" -c 'report -p -b -n -N' "${DATA_DIR}")
+}
+
+# xfs_quota manages project quotas on ext4 in "foreign" mode
+function set_xfs_quota_options() {
+    XFS_QUOTA_OPTIONS=(-x)
+    if [[ $(stat -f -c %T "${DATA_DIR}") != "xfs" ]]; then
+        XFS_QUOTA_OPTIONS+=(-f)
+    fi
+}
+
+#######################################
+# Stores the quota manifest of DATA_DIR
+# in the repository, tagged with the ID
+# of the backup it belongs to
+# Globals:
+#   DATA_DIR
+#   RESTIC_HOST
+#   SNAPSHOT_TAG_OPTIONS
+# Arguments:
+#   ID of the backup snapshot
+#######################################
+function backup_quota_manifest() {
+    local snapshot_id="$1"
+    echo "=== Saving filesystem quotas ==="
+    if [[ -z ${snapshot_id} ]]; then
+        error 3 "unable to determine the ID of the backup snapshot"
+    fi
+    set_xfs_quota_options
+    generate_quota_manifest | tee /dev/stderr | "${RESTIC[@]}" backup --host "${RESTIC_HOST}" \
+        --tag "volsync-quotas-for:${snapshot_id:0:8}" "${SNAPSHOT_TAG_OPTIONS[@]}" --stdin --stdin-filename volsync-quotas
+}
+
+#######################################
+# Stores a manifest of the SHA-256 digest of
+# every file in the backup in the repository,
+# tagged with the ID of the backup it belongs
+# to, and reports it as:
+#   VOLSYNC_INTEGRITY_MANIFEST=<backup ID> <manifest ID> <manifest SHA-256> <files>
+# The manifest uses the format of sha256sum, so
+# it can be checked with sha256sum -c.
+# Globals:
+#   BLOCK_DEVICE
+#   DATA_DIR
+#   RESTIC_HOST
+#   SNAPSHOT_TAG_OPTIONS
+# Arguments:
+#   ID of the backup snapshot
+#######################################
+function backup_integrity_manifest() {
+    local snapshot_id="$1"
+    echo "=== Saving integrity manifest ==="
+    if [[ -z ${snapshot_id} ]]; then
+        error 3 "unable to determine the ID of the backup snapshot"
+    fi
+    local manifest
+    manifest=$(mktemp -q)
+    if [[ -n ${BLOCK_DEVICE} ]]; then
+        echo "$(sha256sum < "${BLOCK_DEVICE}" | cut -d' ' -f1)  ${BLOCK_IMAGE}" > "${manifest}"
+    else
+        pushd "${DATA_DIR}"
+        # Files that can not be read (see the error policy) are left out
+        find . -path ./lost+found -prune -o -type f -print0 | LC_ALL=C sort -z \
+            | xargs -0 -r sha256sum > "${manifest}" || echo "Some files could not be hashed"
+        popd
+    fi
+
+    local outfile
+    outfile=$(mktemp -q)
+    "${RESTIC[@]}" backup --host "${RESTIC_HOST}" --tag "volsync-manifest-for:${snapshot_id:0:8}" \
+        "${SNAPSHOT_TAG_OPTIONS[@]}" --stdin --stdin-filename volsync-manifest < "${manifest}" 2>&1 \
+        | tee "${outfile}"
+    local manifest_id
+    manifest_id=$(sed -n 's/^snapshot \([0-9a-f]*\) saved$/\1/p' "${outfile}")
+    echo "VOLSYNC_INTEGRITY_MANIFEST=${snapshot_id} ${manifest_id} $(sha256sum < "${manifest}" | cut -d' ' -f1)" \
+        "$(grep -c . "${manifest}" || true)"
+    rm -f "${manifest}" "${outfile}"
+}
+
+#######################################
+# Applies the quota manifest stored with
+# the restored backup to DATA_DIR
+# Globals:
+#   DATA_DIR
+#   RESTIC_HOST
+# Arguments:
+#   ID of the restored snapshot
+#######################################
+function restore_quota_manifest() {
+    local snapshot_id="$1"
+    echo "=== Restoring filesystem quotas ==="
+    local manifest
+    if ! manifest=$("${RESTIC[@]}" dump --host "${RESTIC_HOST}" \
+        --tag "volsync-quotas-for:${snapshot_id:0:8}" latest /volsync-quotas); then
+        echo "No filesystem quotas were saved with snapshot ${snapshot_id}"
+        return
+    fi
+    set_xfs_quota_options
+
+    local kind id rest bsoft bhard isoft ihard
+    while read -r kind id rest; do
+        case ${kind} in
+            "project")
+                echo "Setting project ${id} on ${rest}"
+                xfs_quota "${XFS_QUOTA_OPTIONS[@]}" -c "project -s -p ${DATA_DIR}/${rest} ${id}" "${DATA_DIR}"
+                ;;
+            "limit")
+                read -r bsoft bhard isoft ihard <<<"${rest}"
+                echo "Setting limits of project ${id}"
+                xfs_quota "${XFS_QUOTA_OPTIONS[@]}" \
+                    -c "limit -p bsoft=${bsoft}k bhard=${bhard}k isoft=${isoft} ihard=${ihard} ${id}" "${DATA_DIR}"
+                ;;
+        esac
+    done <<<"${manifest}"
+}
+
+#######################################
+# Restores from the snapshot given by
+# RESTORE_SNAPSHOT_ID if provided, from a
+# selected snapshot if RESTORE_AS_OF is
+# provided, otherwise restores from the
+# latest restic snapshot
+# Globals:
+#   RESTORE_SNAPSHOT_ID
 #   RESTORE_AS_OF
 #   DATA_DIR
 #   RESTIC_HOST
+#   VERIFY_CHECKSUM
+#   BLOCK_DEVICE
 # Arguments:
 #   None
 #######################################
 function do_restore {
     echo "=== Starting restore ==="
-    # restore from specific snapshot specified by timestamp, or latest
+    if [[ -n ${VOLSYNC_IDENTITY} ]]; then
+        echo "Restoring as ${VOLSYNC_IDENTITY}"
+    fi
     local snapshot_id
-    snapshot_id=$(select_restic_snapshot_to_restore)
-    if [[ -z ${snapshot_id} ]]; then
+    if [[ -n ${RESTORE_SNAPSHOT_ID} ]]; then
+        # restore from exactly the requested snapshot
+        echo "Looking up requested restic snapshot: ${RESTORE_SNAPSHOT_ID}"
+        snapshot_id=$(find_restic_snapshot "${RESTORE_SNAPSHOT_ID}")
+        if [[ -z ${snapshot_id} ]]; then
+            error 3 "snapshot ${RESTORE_SNAPSHOT_ID} not found in repository"
+        fi
+    else
+        # restore from specific snapshot specified by timestamp, or latest
+        snapshot_id=$(select_restic_snapshot_to_restore)
+    fi
+    if [[ -z ${snapshot_id} && -n ${BLOCK_DEVICE} ]]; then
+        # Succeeding here would leave the device empty
+        error 3 "no eligible snapshots of a block volume found"
+    elif [[ -z ${snapshot_id} ]]; then
         echo "No eligible snapshots found"
         echo "=== No data will be restored ==="
     else
         if [[ -n ${RESTORE_OPTIONS} ]]; then
           echo "RESTORE_OPTIONS: ${RESTORE_OPTIONS}"
         fi
-        pushd "${DATA_DIR}"
         echo "Selected restic snapshot with id: ${snapshot_id}"
-        # Running this cmd can be finicky with spaces, do not put quotes around ${RESTORE_OPTIONS}
-        #shellcheck disable=SC2086
-        "${RESTIC[@]}" restore "${snapshot_id}" -t . --host "${RESTIC_HOST}" ${RESTORE_OPTIONS}
+        if [[ -n ${BLOCK_DEVICE} ]]; then
+            restore_block_device "${snapshot_id}"
+            write_provenance "${snapshot_id}"
+            return
+        fi
+        if is_block_snapshot "${snapshot_id}"; then
+            error 3 "snapshot ${snapshot_id} is the backup of a block volume, restore it with volumeMode: Block"
+        fi
+        if [[ ${VALIDATE_RESTORE_SIZE} -eq 1 ]]; then
+            check_restore_size "${snapshot_id}"
+        fi
+        local restore_dir="${DATA_DIR}"
+        if [[ ${ATOMIC_RESTORE} -eq 1 ]]; then
+            # Restore into an empty directory, the data is swapped into place
+            # once the restore has completed
+            echo "Restoring into ${RESTORE_STAGING_DIR}"
+            rm -rf "${RESTORE_STAGING_DIR}"
+            mkdir -p "${RESTORE_STAGING_DIR}"
+            restore_dir="${RESTORE_STAGING_DIR}"
+        fi
+        pushd "${restore_dir}"
+        if [[ ${VERIFY_CHECKSUM} -eq 1 ]]; then
+            verified_restore "${snapshot_id}"
+        else
+            # Running this cmd can be finicky with spaces, do not put quotes around ${RESTORE_OPTIONS}
+            #shellcheck disable=SC2086
+            "${RESTIC[@]}" restore "${snapshot_id}" -t . --host "${RESTIC_HOST}" ${RESTORE_OPTIONS}
+        fi
         popd
+        if [[ ${ATOMIC_RESTORE} -eq 1 ]]; then
+            swap_restored_data
+        fi
+        write_provenance "${snapshot_id}"
+        if [[ ${FILESYSTEM_QUOTAS} -eq 1 ]]; then
+            restore_quota_manifest "${snapshot_id}"
+        fi
     fi
 }
 
@@ -364,19 +1152,44 @@ for op in "$@"; do
         "unlock")
             do_unlock
             ;;
+        "change-password")
+            do_change_password
+            ;;
         "backup")
-            check_contents
+            if [[ -z ${BLOCK_DEVICE} ]]; then
+                check_contents
+            fi
+            if [[ ${DETECT_BITROT} -eq 1 ]]; then
+                check_bitrot
+            fi
+            if [[ -n ${CACHE_MAX_AGE_DAYS} || -n ${CACHE_MAX_SIZE} ]]; then
+                cleanup_cache
+            fi
             ensure_initialized
             do_backup
             do_forget
+            if [[ ${REPOSITORY_STATS} -eq 1 ]]; then
+                report_repository_stats
+            fi
+            report_cache_usage
             ;;
         "prune")
             do_prune
             ;;
+        "connection-test")
+            do_connection_test
+            ;;
+        "ensure-repository")
+            ensure_initialized
+            echo "=== Repository is ready ==="
+            ;;
         "restore")
             ensure_initialized
             do_restore
-            sync -f "${DATA_DIR}"
+            if [[ -z ${BLOCK_DEVICE} ]]; then
+                sync -f "${DATA_DIR}"
+                report_volume_usage "${DATA_DIR}"
+            fi
             ;;
         *)
             error 2 "unknown operation: $op"
@@ -133,6 +133,21 @@ stunnel -version "$STUNNEL_CONF"
 stunnel "$STUNNEL_CONF"
 trap stop_stunnel EXIT
 
+RSYNC_SPARSE_OPTS=()
+if [[ "$SPARSE_FILES" == "1" ]] && ! test -b $BLOCK_SOURCE; then
+    # Holes are always preserved (-S), also preallocate the files on the
+    # destination to avoid fragmentation
+    RSYNC_SPARSE_OPTS=(--preallocate)
+    SPARSE_SUPPORT="unknown"
+    if rsync rsync://127.0.0.1:$STUNNEL_LISTEN_PORT/control/sparse /tmp/sparse; then
+        SPARSE_SUPPORT="$(</tmp/sparse)"
+    fi
+    echo "Destination sparse file support: ${SPARSE_SUPPORT}"
+    if [[ "$SPARSE_SUPPORT" != "supported" ]]; then
+        echo "WARNING: sparse files will be fully allocated on the destination"
+    fi
+fi
+
 # Sync files
 START_TIME=$SECONDS
 MAX_RETRIES=5
@@ -153,7 +168,7 @@ while [[ $rc -ne 0 && $RETRY -lt $MAX_RETRIES ]]; do
         find "${SOURCE}" -mindepth 1 -maxdepth 1 -printf '/%P\n' > /tmp/filelist.txt
         if [[ -s /tmp/filelist.txt ]]; then
             # 1st run preserves as much as possible, but excludes the root directory
-            rsync -aAhHSxz -r --exclude=lost+found --itemize-changes --info=stats2,misc2 --files-from=/tmp/filelist.txt ${SOURCE}/ rsync://127.0.0.1:$STUNNEL_LISTEN_PORT/data
+            rsync -aAhHSxz "${RSYNC_SPARSE_OPTS[@]}" -r --exclude=lost+found --itemize-changes --info=stats2,misc2 --files-from=/tmp/filelist.txt ${SOURCE}/ rsync://127.0.0.1:$STUNNEL_LISTEN_PORT/data
         else
             echo "Skipping sync of empty source directory"
         fi
@@ -152,6 +152,21 @@ STUNNEL_CONF
     TAIL_PID="$!"
 
     rm -f "$CONTROL_FILE"
+
+    ##############################
+    ## Check whether the filesystem supports sparse files so the client can
+    ## report it. A file that is entirely a hole should have no blocks.
+    SPARSE_TEST_FILE="$TARGET/.volsync-sparse-check"
+    SPARSE_BLOCKS="-1"
+    if truncate -s 16M "$SPARSE_TEST_FILE" 2>/dev/null; then
+        SPARSE_BLOCKS="$(stat -c %b "$SPARSE_TEST_FILE")"
+    fi
+    rm -f "$SPARSE_TEST_FILE"
+    if [[ "$SPARSE_BLOCKS" == "0" ]]; then
+        echo "supported" > "$(dirname "$CONTROL_FILE")/sparse"
+    else
+        echo "unsupported" > "$(dirname "$CONTROL_FILE")/sparse"
+    fi
 fi
 
 if test -b $BLOCK_TARGET; then
@@ -24,12 +24,31 @@ function do_diskrsync {
     diskrsync --target /dev/block
 }
 
+function do_check_sparse {
+    # Create a file that is entirely a hole. If the filesystem supports sparse
+    # files, it will not have any blocks allocated.
+    local testfile="/data/.volsync-sparse-check"
+    local blocks="-1"
+    if truncate -s 16M "$testfile" 2>/dev/null; then
+        blocks="$(stat -c %b "$testfile")"
+    fi
+    rm -f "$testfile"
+    if [[ "$blocks" == "0" ]]; then
+        echo "supported"
+    else
+        echo "unsupported"
+    fi
+}
+
 #-- These are the only commands allowed to be executed by the source side:
 # Source can initiate an rsync
 if [[ "$SSH_ORIGINAL_COMMAND" =~ ^rsync( ) ]]; then
     do_rsync
 elif [[ "$SSH_ORIGINAL_COMMAND" =~ ^diskrsync( ) ]]; then
     do_diskrsync
+# Source can check whether our filesystem supports sparse files
+elif [[ "$SSH_ORIGINAL_COMMAND" == "check-sparse" ]]; then
+    do_check_sparse
 # Source can tell us (destination) to shutdown & pass a numeric result code
 elif [[ "$SSH_ORIGINAL_COMMAND" =~ ^shutdown( )+([0-9]+)$ ]]; then
     do_shutdown "${BASH_REMATCH[2]}"
@@ -68,10 +68,52 @@ echo "Source PVC volumeMode is $VOLUME_MODE"
 mkdir -p ~/.ssh/controlmasters
 chmod 711 ~/.ssh
 
-# Provide ssh host key to validate remote
-echo "$DESTINATION_ADDRESS $(</keys/destination.pub)" > ~/.ssh/known_hosts
+# Name of a host in known_hosts. Hosts on a non-standard port are recorded as
+# [host]:port
+function known_host_name() {
+    if [[ "$2" == "22" ]]; then
+        echo "$1"
+    else
+        echo "[$1]:$2"
+    fi
+}
+
+# Append the host key(s) in file $3 for host $1 on port $2 to known_hosts. There
+# may be more than one (one per line) while a host key is being rotated.
+function add_known_hosts() {
+    local name
+    name="$(known_host_name "$1" "$2")"
+    while read -r hostkey || [[ -n "$hostkey" ]]; do
+        if [[ -n "$hostkey" ]]; then
+            echo "$name $hostkey" >> ~/.ssh/known_hosts
+        fi
+    done < "$3"
+}
+
+# Provide ssh host key(s) to validate remote
+: > ~/.ssh/known_hosts
+add_known_hosts "$DESTINATION_ADDRESS" "$DESTINATION_PORT" /keys/destination.pub
+
+# Connect via a jump host, if one is configured. This must come before "Host *"
+# so that the jump host's settings take precedence.
+PROXY_CONFIG=""
+PROXY_JUMP="none"
+if [[ -n "$PROXY_HOST" ]]; then
+    PROXY_PORT="${PROXY_PORT:-22}"
+    echo "Connecting via jump host ${PROXY_USER:-root}@${PROXY_HOST}:${PROXY_PORT}"
+    add_known_hosts "$PROXY_HOST" "$PROXY_PORT" /proxy-keys/proxy-host.pub
+    PROXY_CONFIG="Host volsync-proxy
+  HostName ${PROXY_HOST}
+  Port ${PROXY_PORT}
+  User ${PROXY_USER:-root}
+  IdentityFile /proxy-keys/proxy
+  ProxyJump none
+"
+    PROXY_JUMP="volsync-proxy"
+fi
 
 cat - <<SSHCONFIG > ~/.ssh/config
+${PROXY_CONFIG}
 Host *
   # Wait max 30s to establish connection
   ConnectTimeout 30
@@ -84,6 +126,7 @@ Host *
   # Use the identity provided via attached Secret
   IdentityFile /keys/source
   Port ${DESTINATION_PORT}
+  ProxyJump ${PROXY_JUMP}
   # Enable protocol-level keepalive to detect connection failure
   ServerAliveCountMax 4
   ServerAliveInterval 30
@@ -95,21 +138,68 @@ SSHCONFIG
 
 URL_DESTINATION_ADDRESS=$DESTINATION_ADDRESS
 
-# If we get a bare ipv6 address it must be wrapped with [] for rsync
-# Looking for either:
-# 1) 8 groups of hex digits separated by ":"
-# 2) a "::" in the string
-IPV6_REGEX='(([0-9a-fA-F]{0,4}:){7}[0-9a-fA-F]{0,4})|(::)'
-
-if [[ "$DESTINATION_ADDRESS" =~ $IPV6_REGEX ]]; then
+# The operator provides the host without brackets or a port, so a ":" means it
+# is an ipv6 address, which must be wrapped with [] for rsync
+if [[ "$DESTINATION_ADDRESS" == *:* ]]; then
   echo "Destination address $DESTINATION_ADDRESS is ipv6"
+  URL_DESTINATION_ADDRESS="[$DESTINATION_ADDRESS]"
+fi
 
-  if [[ ! "$DESTINATION_ADDRESS" =~ \[.*\] ]]; then
-    echo "updating dest ipv6 address to include brackets"
-    URL_DESTINATION_ADDRESS="[$DESTINATION_ADDRESS]"
-  fi
+RSYNC_SPARSE_OPTS=()
+if [[ "$SPARSE_FILES" == "1" && "$VOLUME_MODE" == "filesystem" ]]; then
+    # Holes are always preserved (-S), also preallocate the files on the
+    # destination to avoid fragmentation
+    RSYNC_SPARSE_OPTS=(--preallocate)
+    SPARSE_SUPPORT="$(ssh "root@${DESTINATION_ADDRESS}" check-sparse || echo "unknown")"
+    echo "Destination sparse file support: ${SPARSE_SUPPORT}"
+    if [[ "$SPARSE_SUPPORT" != "supported" ]]; then
+        echo "WARNING: sparse files will be fully allocated on the destination"
+    fi
 fi
 
+# Additional options from spec.rsync.extraOptions. The operator only permits
+# options from an allow-list, none of which contain spaces.
+RSYNC_EXTRA_OPTS_ARR=()
+if [[ -n "${RSYNC_EXTRA_OPTS}" ]]; then
+    read -r -a RSYNC_EXTRA_OPTS_ARR <<< "${RSYNC_EXTRA_OPTS}"
+    echo "Additional rsync options: ${RSYNC_EXTRA_OPTS_ARR[*]}"
+fi
+
+#######################################
+# Lists the paths that could not be copied
+# and reports whether they are within the
+# error budget of the error policy:
+#   VOLSYNC_FAILED_PATH=<path> (up to 50)
+#   VOLSYNC_ERRORS=<failed> <total> <Tolerated|Exceeded>
+# Globals:
+#   ERROR_POLICY
+#   MAX_ERRORS
+#   MAX_ERROR_PERCENT
+# Arguments:
+#   File listing the failed paths, one per line
+#   Total number of paths
+# Returns:
+#   0 if the errors are tolerated
+#######################################
+function check_error_budget() {
+    local paths_file="$1"
+    local total="${2:-0}"
+    local failed
+    failed=$(grep -c . "${paths_file}" || true)
+    head -n 50 "${paths_file}" | sed 's/^/VOLSYNC_FAILED_PATH=/'
+
+    local result="Tolerated"
+    if [[ "${ERROR_POLICY}" != "Tolerate" ]]; then
+        result="Exceeded"
+    elif [[ -n "${MAX_ERRORS}" && ${failed} -gt ${MAX_ERRORS} ]]; then
+        result="Exceeded"
+    elif [[ -n "${MAX_ERROR_PERCENT}" ]] && (( failed * 100 > MAX_ERROR_PERCENT * total )); then
+        result="Exceeded"
+    fi
+    echo "VOLSYNC_ERRORS=${failed} ${total} ${result}"
+    [[ "${result}" == "Tolerated" ]]
+}
+
 MAX_RETRIES=5
 RETRY=0
 DELAY=2
@@ -126,9 +216,13 @@ do
       echo "calling diskrsync $BLOCK_SOURCE root@${URL_DESTINATION_ADDRESS}:/dev/block"
       diskrsync $BLOCK_SOURCE "root@${URL_DESTINATION_ADDRESS}":/dev/block
     else
-      rsync -aAhHSxz --delete --itemize-changes --info=stats2,misc2 $SOURCE/ "root@${URL_DESTINATION_ADDRESS}":.
+      rsync -aAhHSxz "${RSYNC_SPARSE_OPTS[@]}" "${RSYNC_EXTRA_OPTS_ARR[@]}" --delete --itemize-changes --info=stats2,misc2 $SOURCE/ "root@${URL_DESTINATION_ADDRESS}":. 2>&1 | tee /tmp/rsync.log
+    fi
+    rc=${PIPESTATUS[0]}
+    # Unreadable and vanished files will not succeed on a retry
+    if [[ ${rc} -eq 23 || ${rc} -eq 24 ]] && [[ "${ERROR_POLICY}" == "Tolerate" ]]; then
+        break
     fi
-    rc=$?
     if [[ ${rc} -ne 0 ]]; then
         echo "Syncronization failed. Retrying in ${DELAY} seconds. Retry ${RETRY}/${MAX_RETRIES}."
         sleep ${DELAY}
@@ -137,6 +231,14 @@ do
 done
 set -e
 echo "Rsync completed in $(( SECONDS - START_TIME ))s"
+if [[ ${rc} -eq 23 || ${rc} -eq 24 ]]; then
+    # Some files could not be transferred (rc 23) or vanished (rc 24)
+    sed -nE 's/^(rsync: |file has vanished: ).*"([^"]*)".*$/\2/p' /tmp/rsync.log > /tmp/failed-paths
+    TOTAL_FILES=$(sed -n 's/^Number of files: \([0-9,]*\).*$/\1/p' /tmp/rsync.log | tr -d ,)
+    if check_error_budget /tmp/failed-paths "${TOTAL_FILES:-0}"; then
+        rc=0
+    fi
+fi
 if [[ $rc -eq 0 ]]; then
     echo "Synchronization completed successfully. Notifying destination..."
     # ssh does not take [ip] format for ipv6, so use DESTINATION_ADDRESS rather than URL_DESTINATION_ADDRESS
@@ -112,17 +112,25 @@ preconfigure_folder() {
 }
 
 #####################################################
-# Generates the server certificate in the config
+# Installs the device certificate managed by VolSync
+# (if any) in the config directory. Otherwise,
+# generates the server certificate in the config
 # directory but only if the cert does not exist.
 # Arguments:
 # 	None
 # Globals:
 # 	SYNCTHING_CONFIG_DIR
+# 	SYNCTHING_IDENTITY_DIR
 # Returns:
 # 	None
 #####################################################
 ensure_server_certificates() {
-  if ! [[ -f "${SYNCTHING_CONFIG_DIR}/cert.pem" ]]; then
+  if [[ -n "${SYNCTHING_IDENTITY_DIR}" && -f "${SYNCTHING_IDENTITY_DIR}/cert.pem" ]]; then
+    log_msg "Installing device certificate from ${SYNCTHING_IDENTITY_DIR}"
+    cp "${SYNCTHING_IDENTITY_DIR}/cert.pem" "${SYNCTHING_CONFIG_DIR}/cert.pem"
+    cp "${SYNCTHING_IDENTITY_DIR}/key.pem" "${SYNCTHING_CONFIG_DIR}/key.pem"
+    chmod 600 "${SYNCTHING_CONFIG_DIR}/key.pem"
+  elif ! [[ -f "${SYNCTHING_CONFIG_DIR}/cert.pem" ]]; then
     # use openssl to generate a new server cert
     log_msg "Generating server certs in ${SYNCTHING_CONFIG_DIR}/cert.pem"
     openssl req -x509 -newkey rsa:4096 -keyout "${SYNCTHING_CONFIG_DIR}/key.pem" -out "${SYNCTHING_CONFIG_DIR}/cert.pem" -sha256 -days 3650 -nodes -subj "/CN=syncthing" -addext "extendedKeyUsage = serverAuth, clientAuth"
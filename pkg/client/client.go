@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package client provides helpers for controllers that create and drive
+// VolSync ReplicationSources and ReplicationDestinations programmatically.
+//
+// A manual sync is requested by setting spec.trigger.manual to a new value and
+// is complete once status.lastManualSync reports that same value. The helpers
+// here take care of generating unique trigger values and of the comparison,
+// including the cases where status has not been populated yet or another
+// client has replaced the trigger in the meantime.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+)
+
+var (
+	// ErrUnsupportedObject is returned when an object other than a
+	// ReplicationSource or ReplicationDestination is passed to a helper.
+	ErrUnsupportedObject = errors.New("object is not a ReplicationSource or ReplicationDestination")
+	// ErrTriggerSuperseded is returned by WaitForManualSync when
+	// spec.trigger.manual no longer holds the trigger being waited on, so the
+	// sync that was requested will never be reported as complete.
+	ErrTriggerSuperseded = errors.New("manual trigger was replaced before the sync completed")
+)
+
+// DefaultPollInterval is the interval WaitForManualSync uses when it is
+// passed an interval of zero.
+const DefaultPollInterval = 5 * time.Second
+
+// NewManualTrigger returns a trigger value that is unique for this point in
+// time.
+func NewManualTrigger() string {
+	return time.Now().UTC().Format(time.RFC3339Nano)
+}
+
+// ManualTrigger returns the value of spec.trigger.manual, or an empty string
+// if no manual trigger is set.
+func ManualTrigger(obj ctrlclient.Object) (string, error) {
+	switch o := obj.(type) {
+	case *volsyncv1alpha1.ReplicationSource:
+		if o.Spec.Trigger == nil {
+			return "", nil
+		}
+		return o.Spec.Trigger.Manual, nil
+	case *volsyncv1alpha1.ReplicationDestination:
+		if o.Spec.Trigger == nil {
+			return "", nil
+		}
+		return o.Spec.Trigger.Manual, nil
+	}
+	return "", ErrUnsupportedObject
+}
+
+// LastManualSync returns the value of status.lastManualSync, or an empty
+// string if status has not been populated yet.
+func LastManualSync(obj ctrlclient.Object) (string, error) {
+	switch o := obj.(type) {
+	case *volsyncv1alpha1.ReplicationSource:
+		if o.Status == nil {
+			return "", nil
+		}
+		return o.Status.LastManualSync, nil
+	case *volsyncv1alpha1.ReplicationDestination:
+		if o.Status == nil {
+			return "", nil
+		}
+		return o.Status.LastManualSync, nil
+	}
+	return "", ErrUnsupportedObject
+}
+
+// IsManualSyncComplete reports whether the sync requested with trigger has
+// completed. An empty trigger is never complete.
+func IsManualSyncComplete(obj ctrlclient.Object, trigger string) (bool, error) {
+	last, err := LastManualSync(obj)
+	if err != nil {
+		return false, err
+	}
+	return trigger != "" && last == trigger, nil
+}
+
+// TriggerManualSync sets spec.trigger.manual on obj to a new value and patches
+// it on the cluster. The other trigger settings are left untouched. The
+// trigger value is returned so that it can be passed to WaitForManualSync.
+func TriggerManualSync(ctx context.Context, c ctrlclient.Client, obj ctrlclient.Object) (string, error) {
+	trigger := NewManualTrigger()
+	patch := ctrlclient.MergeFrom(obj.DeepCopyObject().(ctrlclient.Object))
+	switch o := obj.(type) {
+	case *volsyncv1alpha1.ReplicationSource:
+		if o.Spec.Trigger == nil {
+			o.Spec.Trigger = &volsyncv1alpha1.ReplicationSourceTriggerSpec{}
+		}
+		o.Spec.Trigger.Manual = trigger
+	case *volsyncv1alpha1.ReplicationDestination:
+		if o.Spec.Trigger == nil {
+			o.Spec.Trigger = &volsyncv1alpha1.ReplicationDestinationTriggerSpec{}
+		}
+		o.Spec.Trigger.Manual = trigger
+	default:
+		return "", ErrUnsupportedObject
+	}
+	if err := c.Patch(ctx, obj, patch); err != nil {
+		return "", fmt.Errorf("unable to set manual trigger: %w", err)
+	}
+	return trigger, nil
+}
+
+// WaitForManualSync polls obj every interval until the sync requested with
+// trigger has completed, the trigger is replaced by a different value, or ctx
+// is done. obj is refreshed from the cluster on each poll and holds the latest
+// version of the object when the function returns.
+func WaitForManualSync(ctx context.Context, c ctrlclient.Client, obj ctrlclient.Object,
+	trigger string, interval time.Duration) error {
+	if trigger == "" {
+		return errors.New("manual trigger must not be empty")
+	}
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	key := ctrlclient.ObjectKeyFromObject(obj)
+	return wait.PollUntilContextCancel(ctx, interval, true, /*immediate*/
+		func(ctx context.Context) (bool, error) {
+			if err := c.Get(ctx, key, obj); err != nil {
+				return false, err
+			}
+			done, err := IsManualSyncComplete(obj, trigger)
+			if err != nil || done {
+				return done, err
+			}
+			current, err := ManualTrigger(obj)
+			if err != nil {
+				return false, err
+			}
+			if current != trigger {
+				return false, ErrTriggerSuperseded
+			}
+			return false, nil
+		})
+}
+
+// SyncAndWait triggers a manual sync of obj and waits for it to complete. See
+// TriggerManualSync and WaitForManualSync.
+func SyncAndWait(ctx context.Context, c ctrlclient.Client, obj ctrlclient.Object, interval time.Duration) error {
+	trigger, err := TriggerManualSync(ctx, c, obj)
+	if err != nil {
+		return err
+	}
+	return WaitForManualSync(ctx, c, obj, trigger, interval)
+}
+
+// LatestImage returns the image (PVC or VolumeSnapshot) holding the data of
+// the most recent sync of rd, or nil if there is none yet.
+func LatestImage(rd *volsyncv1alpha1.ReplicationDestination) *corev1.TypedLocalObjectReference {
+	if rd == nil || rd.Status == nil {
+		return nil
+	}
+	return rd.Status.LatestImage
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The VolSync authors.
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU Affero General Public License as published
+by the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU Affero General Public License for more details.
+
+You should have received a copy of the GNU Affero General Public License
+along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+package client_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/backube/volsync/pkg/client"
+)
+
+var _ = Describe("Manual sync helpers", func() {
+	var ctx context.Context
+	var c ctrlclient.Client
+	var rs *volsyncv1alpha1.ReplicationSource
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(volsyncv1alpha1.AddToScheme(scheme)).To(Succeed())
+		rs = &volsyncv1alpha1.ReplicationSource{
+			ObjectMeta: metav1.ObjectMeta{Name: "rs", Namespace: "ns"},
+			Spec: volsyncv1alpha1.ReplicationSourceSpec{
+				SourcePVC: "data",
+				Trigger: &volsyncv1alpha1.ReplicationSourceTriggerSpec{
+					Schedule: ptr.To("0 * * * *"),
+				},
+			},
+		}
+		c = fake.NewClientBuilder().WithScheme(scheme).WithObjects(rs).Build()
+	})
+
+	// completeSync records the current manual trigger as synced, as the
+	// operator would
+	completeSync := func() {
+		cur := &volsyncv1alpha1.ReplicationSource{}
+		Expect(c.Get(ctx, ctrlclient.ObjectKeyFromObject(rs), cur)).To(Succeed())
+		cur.Status = &volsyncv1alpha1.ReplicationSourceStatus{LastManualSync: cur.Spec.Trigger.Manual}
+		Expect(c.Update(ctx, cur)).To(Succeed())
+	}
+
+	It("never treats an empty trigger as complete", func() {
+		done, err := client.IsManualSyncComplete(rs, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(done).To(BeFalse())
+		rs.Status = &volsyncv1alpha1.ReplicationSourceStatus{}
+		done, err = client.IsManualSyncComplete(rs, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(done).To(BeFalse())
+	})
+
+	It("rejects other object types", func() {
+		_, err := client.ManualTrigger(&corev1.PersistentVolumeClaim{})
+		Expect(err).To(MatchError(client.ErrUnsupportedObject))
+	})
+
+	It("sets a new trigger and keeps the schedule", func() {
+		trigger, err := client.TriggerManualSync(ctx, c, rs)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(trigger).NotTo(BeEmpty())
+
+		cur := &volsyncv1alpha1.ReplicationSource{}
+		Expect(c.Get(ctx, ctrlclient.ObjectKeyFromObject(rs), cur)).To(Succeed())
+		Expect(cur.Spec.Trigger.Manual).To(Equal(trigger))
+		Expect(cur.Spec.Trigger.Schedule).To(Equal(ptr.To("0 * * * *")))
+	})
+
+	It("waits until the trigger is reported as synced", func() {
+		trigger, err := client.TriggerManualSync(ctx, c, rs)
+		Expect(err).NotTo(HaveOccurred())
+
+		waitCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		Expect(client.WaitForManualSync(waitCtx, c, rs, trigger, 10*time.Millisecond)).NotTo(Succeed())
+
+		completeSync()
+		Expect(client.WaitForManualSync(ctx, c, rs, trigger, 10*time.Millisecond)).To(Succeed())
+		Expect(rs.Status.LastManualSync).To(Equal(trigger))
+	})
+
+	It("stops waiting when the trigger is replaced", func() {
+		trigger, err := client.TriggerManualSync(ctx, c, rs)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = client.TriggerManualSync(ctx, c, rs)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(client.WaitForManualSync(ctx, c, rs, trigger, 10*time.Millisecond)).To(
+			MatchError(client.ErrTriggerSuperseded))
+	})
+
+	It("returns the latest image of a destination", func() {
+		Expect(client.LatestImage(nil)).To(BeNil())
+		rd := &volsyncv1alpha1.ReplicationDestination{}
+		Expect(client.LatestImage(rd)).To(BeNil())
+		img := &corev1.TypedLocalObjectReference{Kind: "PersistentVolumeClaim", Name: "x"}
+		rd.Status = &volsyncv1alpha1.ReplicationDestinationStatus{LatestImage: img}
+		Expect(client.LatestImage(rd)).To(Equal(img))
+	})
+})